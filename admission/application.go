@@ -0,0 +1,148 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// validateApplication checks that the Kubernetes user is allowed to perform the requested change of an application
+// and that the resulting application follows the constraints of its project
+func (s *Server) validateApplication(ctx context.Context, req *admissionv1.AdmissionRequest) error {
+	var app, oldApp v1alpha1.Application
+	if req.Operation != admissionv1.Delete {
+		if err := json.Unmarshal(req.Object.Raw, &app); err != nil {
+			return fmt.Errorf("failed to decode application: %w", err)
+		}
+	}
+	if req.Operation != admissionv1.Create {
+		if err := json.Unmarshal(req.OldObject.Raw, &oldApp); err != nil {
+			return fmt.Errorf("failed to decode application: %w", err)
+		}
+	}
+
+	switch req.Operation {
+	case admissionv1.Create:
+		if err := s.enforce(req.UserInfo, rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, app.RBACName(s.namespace)); err != nil {
+			return err
+		}
+		if err := s.validateOperation(req.UserInfo, nil, &app); err != nil {
+			return err
+		}
+		return s.validateApplicationSpec(ctx, &app)
+	case admissionv1.Update:
+		if !reflect.DeepEqual(oldApp.Spec, app.Spec) {
+			if err := s.enforce(req.UserInfo, rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, oldApp.RBACName(s.namespace)); err != nil {
+				return err
+			}
+			if oldApp.Spec.GetProject() != app.Spec.GetProject() {
+				// moving an application to another project requires the same permissions as creating it there
+				if err := s.enforce(req.UserInfo, rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, app.RBACName(s.namespace)); err != nil {
+					return err
+				}
+			}
+			if err := s.validateApplicationSpec(ctx, &app); err != nil {
+				return err
+			}
+		}
+		return s.validateOperation(req.UserInfo, oldApp.Operation, &app)
+	case admissionv1.Delete:
+		return s.enforce(req.UserInfo, rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, oldApp.RBACName(s.namespace))
+	}
+	return nil
+}
+
+// validateOperation checks that the Kubernetes user is allowed to set or approve the operation of an application, as
+// with the Argo CD API: a new operation requires the sync permission and must be initiated by the user without being
+// approved, and a pending approval can only be given by another user than the one who initiated the operation.
+func (s *Server) validateOperation(user authenticationv1.UserInfo, oldOp *v1alpha1.Operation, app *v1alpha1.Application) error {
+	op := app.Operation
+	if op == nil || s.isExempt(user) {
+		return nil
+	}
+	if oldOp == nil || !reflect.DeepEqual(withoutApproval(oldOp), withoutApproval(op)) {
+		if err := s.enforce(user, rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, app.RBACName(s.namespace)); err != nil {
+			return err
+		}
+		if op.InitiatedBy.Automated || op.InitiatedBy.Username != subject(user) {
+			return fmt.Errorf("operation must be initiated by '%s'", subject(user))
+		}
+		if op.Approval != nil && op.Approval.ApprovedBy != "" {
+			return fmt.Errorf("operation cannot be approved when it is initiated")
+		}
+		return nil
+	}
+	if reflect.DeepEqual(oldOp.Approval, op.Approval) {
+		return nil
+	}
+	if !oldOp.IsPendingApproval() || op.Approval == nil || op.Approval.ApprovedBy != subject(user) || op.Approval.ApprovedAt == nil {
+		return fmt.Errorf("operation can only be approved by '%s' while it is pending approval", subject(user))
+	}
+	if op.InitiatedBy.Username == subject(user) {
+		return fmt.Errorf("operation must be approved by a different subject than the one who initiated it")
+	}
+	return s.enforce(user, rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, app.RBACName(s.namespace))
+}
+
+func withoutApproval(op *v1alpha1.Operation) *v1alpha1.Operation {
+	op = op.DeepCopy()
+	op.Approval = nil
+	return op
+}
+
+// validateApplicationSpec checks that the application references an existing project it is allowed to use, and that
+// its destination, sources and spec are permitted by that project
+func (s *Server) validateApplicationSpec(ctx context.Context, app *v1alpha1.Application) error {
+	proj, err := argo.GetAppProject(app, s.projLister, s.namespace, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return fmt.Errorf("application references project %s which does not exist", app.Spec.GetProject())
+		}
+		return err
+	}
+	app = app.DeepCopy()
+	if err := argo.RenderDestinationNamespace(app); err != nil {
+		return err
+	}
+	if err := argo.ValidateDestination(ctx, &app.Spec.Destination, s.db); err != nil {
+		return fmt.Errorf("application destination spec for %s is invalid: %w", app.Name, err)
+	}
+	conditions, err := argo.ValidatePermissions(ctx, &app.Spec, proj, s.db)
+	if err != nil {
+		return err
+	}
+	if len(conditions) > 0 {
+		return fmt.Errorf("application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
+	}
+	if violations := proj.GetApplicationConstraintViolations(app); len(violations) > 0 {
+		return fmt.Errorf("application %s violates the constraints of project %s: %s", app.Name, proj.Name, strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// normalizeApplication returns the JSON patch normalizing the spec of the given application, or nil if it is
+// already normalized
+func normalizeApplication(raw []byte) ([]byte, error) {
+	var app v1alpha1.Application
+	if err := json.Unmarshal(raw, &app); err != nil {
+		return nil, fmt.Errorf("failed to decode application: %w", err)
+	}
+	normalized := app.DeepCopy()
+	// an invalid destination namespace is reported by the validating webhook
+	_ = argo.RenderDestinationNamespace(normalized)
+	normalized.Spec = *argo.NormalizeApplicationSpec(&normalized.Spec)
+	if reflect.DeepEqual(app.Spec, normalized.Spec) {
+		return nil, nil
+	}
+	return specPatch(normalized.Spec)
+}
@@ -0,0 +1,93 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+// validateProject checks that the Kubernetes user is allowed to perform the requested change of a project and that
+// the resulting project is valid
+func (s *Server) validateProject(ctx context.Context, req *admissionv1.AdmissionRequest) error {
+	var proj, oldProj v1alpha1.AppProject
+	if req.Operation != admissionv1.Delete {
+		if err := json.Unmarshal(req.Object.Raw, &proj); err != nil {
+			return fmt.Errorf("failed to decode project: %w", err)
+		}
+	}
+	if req.Operation != admissionv1.Create {
+		if err := json.Unmarshal(req.OldObject.Raw, &oldProj); err != nil {
+			return fmt.Errorf("failed to decode project: %w", err)
+		}
+	}
+
+	switch req.Operation {
+	case admissionv1.Create:
+		if err := s.enforce(req.UserInfo, rbacpolicy.ResourceProjects, rbacpolicy.ActionCreate, proj.Name); err != nil {
+			return err
+		}
+		return validateProjectSpec(&proj)
+	case admissionv1.Update:
+		if reflect.DeepEqual(oldProj.Spec, proj.Spec) {
+			return nil
+		}
+		if err := s.enforce(req.UserInfo, rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, proj.Name); err != nil {
+			return err
+		}
+		return validateProjectSpec(&proj)
+	case admissionv1.Delete:
+		// the exempt users include the Kubernetes controllers which delete the projects along with their namespace
+		if s.isExempt(req.UserInfo) {
+			return nil
+		}
+		if oldProj.Name == v1alpha1.DefaultAppProjectName {
+			return fmt.Errorf("name '%s' is reserved and cannot be deleted", oldProj.Name)
+		}
+		if err := s.enforce(req.UserInfo, rbacpolicy.ResourceProjects, rbacpolicy.ActionDelete, oldProj.Name); err != nil {
+			return err
+		}
+		appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing applications: %w", err)
+		}
+		if apps := argo.FilterByProjects(appsList.Items, []string{oldProj.Name}); len(apps) > 0 {
+			return fmt.Errorf("project is referenced by %d applications", len(apps))
+		}
+	}
+	return nil
+}
+
+// validateProjectSpec checks that the spec and the policies of the project are valid
+func validateProjectSpec(proj *v1alpha1.AppProject) error {
+	if err := proj.ValidateProject(); err != nil {
+		return err
+	}
+	if err := rbac.ValidatePolicy(proj.ProjectPoliciesString()); err != nil {
+		return fmt.Errorf("policy syntax error: %w", err)
+	}
+	return nil
+}
+
+// normalizeProject returns the JSON patch normalizing the policies of the given project, or nil if they are already
+// normalized
+func normalizeProject(raw []byte) ([]byte, error) {
+	var proj v1alpha1.AppProject
+	if err := json.Unmarshal(raw, &proj); err != nil {
+		return nil, fmt.Errorf("failed to decode project: %w", err)
+	}
+	normalized := proj.DeepCopy()
+	normalized.NormalizePolicies()
+	if reflect.DeepEqual(proj.Spec, normalized.Spec) {
+		return nil, nil
+	}
+	return specPatch(normalized.Spec)
+}
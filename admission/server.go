@@ -0,0 +1,214 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// ValidatePath is the path of the validating admission webhook of the Applications and AppProjects
+	ValidatePath = "/validate"
+	// MutatePath is the path of the mutating admission webhook of the Applications and AppProjects
+	MutatePath = "/mutate"
+	// HealthzPath is the path of the health check of the admission webhook server
+	HealthzPath = "/healthz"
+)
+
+// maxAdmissionReviewSize is the maximum size of the admission reviews sent by the Kubernetes API server
+const maxAdmissionReviewSize = 3 * 1024 * 1024
+
+// SubjectPrefix prefixes the usernames and the groups of the Kubernetes users to form their subject and groups in the
+// Argo CD RBAC, so that they cannot be mistaken for Argo CD users or SSO groups of the same name
+const SubjectPrefix = "k8s:"
+
+// Server validates and normalizes the Applications and AppProjects created, updated or deleted directly through the
+// Kubernetes API, which bypasses the validation of the Argo CD API server
+type Server struct {
+	namespace      string
+	appclientset   appclientset.Interface
+	projLister     applisters.AppProjectLister
+	settingsMgr    *settings.SettingsManager
+	db             db.ArgoDB
+	enf            *rbac.Enforcer
+	exemptSubjects []string
+}
+
+// DefaultExemptSubjects returns the Kubernetes users exempt from the RBAC enforcement by default, which are the service
+// accounts of the Argo CD components in the given namespace and of the Kubernetes controllers deleting the Applications
+// and AppProjects along with their namespace or owner
+func DefaultExemptSubjects(namespace string) []string {
+	return []string{
+		fmt.Sprintf("system:serviceaccount:%s:argocd-*", namespace),
+		"system:serviceaccount:kube-system:namespace-controller",
+		"system:serviceaccount:kube-system:generic-garbage-collector",
+	}
+}
+
+// NewServer returns a new admission webhook server of the Applications and AppProjects of the given namespace. The
+// requests of the Kubernetes users matching any of the exempt subjects skip the RBAC enforcement.
+func NewServer(namespace string, appclientset appclientset.Interface, projLister applisters.AppProjectLister, settingsMgr *settings.SettingsManager, db db.ArgoDB, enf *rbac.Enforcer, exemptSubjects []string) *Server {
+	return &Server{
+		namespace:      namespace,
+		appclientset:   appclientset,
+		projLister:     projLister,
+		settingsMgr:    settingsMgr,
+		db:             db,
+		enf:            enf,
+		exemptSubjects: exemptSubjects,
+	}
+}
+
+// Handler returns the HTTP handler serving the admission webhooks
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatePath, s.handleReview(s.validate))
+	mux.HandleFunc(MutatePath, s.handleReview(s.mutate))
+	mux.HandleFunc(HealthzPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	return mux
+}
+
+type reviewFunc func(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse
+
+// handleReview decodes the admission reviews sent by the Kubernetes API server and responds with the result of review
+func (s *Server) handleReview(review reviewFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxAdmissionReviewSize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read admission review: %v", err), http.StatusBadRequest)
+			return
+		}
+		var admissionReview admissionv1.AdmissionReview
+		if err := json.Unmarshal(body, &admissionReview); err != nil || admissionReview.Request == nil {
+			http.Error(w, "invalid admission review", http.StatusBadRequest)
+			return
+		}
+		admissionReview.Response = review(r.Context(), admissionReview.Request)
+		admissionReview.Response.UID = admissionReview.Request.UID
+		admissionReview.Request = nil
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(admissionReview); err != nil {
+			log.Warnf("Failed to write admission review response: %v", err)
+		}
+	}
+}
+
+// validate returns the admission response of the given request, denying invalid or unauthorized changes
+func (s *Server) validate(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	var err error
+	switch req.Kind.Kind {
+	case application.ApplicationKind:
+		err = s.validateApplication(ctx, req)
+	case application.AppProjectKind:
+		if req.Namespace != s.namespace {
+			return allowed()
+		}
+		err = s.validateProject(ctx, req)
+	default:
+		return allowed()
+	}
+	if err != nil {
+		log.Infof("Denied %s of %s '%s/%s' by '%s': %v", req.Operation, req.Kind.Kind, req.Namespace, req.Name, req.UserInfo.Username, err)
+		return denied(err)
+	}
+	return allowed()
+}
+
+// mutate returns the admission response of the given request, normalizing the spec of the created or updated objects
+func (s *Server) mutate(_ context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return allowed()
+	}
+	var patch []byte
+	var err error
+	switch req.Kind.Kind {
+	case application.ApplicationKind:
+		patch, err = normalizeApplication(req.Object.Raw)
+	case application.AppProjectKind:
+		patch, err = normalizeProject(req.Object.Raw)
+	default:
+		return allowed()
+	}
+	if err != nil {
+		return denied(err)
+	}
+	res := allowed()
+	if patch != nil {
+		patchType := admissionv1.PatchTypeJSONPatch
+		res.Patch = patch
+		res.PatchType = &patchType
+	}
+	return res
+}
+
+// isExempt returns whether the RBAC enforcement is skipped for the given Kubernetes user, which is typically the
+// case of the Argo CD components themselves
+func (s *Server) isExempt(user authenticationv1.UserInfo) bool {
+	return glob.MatchStringInList(s.exemptSubjects, user.Username, false)
+}
+
+// subject returns the subject of the given Kubernetes user in the Argo CD RBAC
+func subject(user authenticationv1.UserInfo) string {
+	return SubjectPrefix + user.Username
+}
+
+// enforce checks whether the given Kubernetes user is allowed to perform the action according to the Argo CD RBAC,
+// the prefixed username and groups of the user being treated like the subject and the groups claims of an Argo CD user
+func (s *Server) enforce(user authenticationv1.UserInfo, resource, action, object string) error {
+	if s.isExempt(user) {
+		return nil
+	}
+	groups := make([]string, len(user.Groups))
+	for i, group := range user.Groups {
+		groups[i] = SubjectPrefix + group
+	}
+	claims := jwt.MapClaims{"sub": subject(user), "groups": groups}
+	if !s.enf.Enforce(claims, resource, action, object) {
+		return fmt.Errorf("user '%s' is not permitted to %s %s '%s'", user.Username, action, resource, object)
+	}
+	return nil
+}
+
+func allowed() *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+func denied(err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result:  &metav1.Status{Status: metav1.StatusFailure, Message: err.Error(), Reason: metav1.StatusReasonForbidden},
+	}
+}
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// specPatch returns the JSON patch replacing the spec of an object with the given one
+func specPatch(spec interface{}) ([]byte, error) {
+	return json.Marshal([]patchOperation{{Op: "replace", Path: "/spec", Value: spec}})
+}
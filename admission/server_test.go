@@ -0,0 +1,323 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testNamespace = "argocd"
+
+const testPolicy = `
+p, role:deployer, applications, create, default/*, allow
+p, role:deployer, applications, update, default/*, allow
+g, k8s:deployers, role:deployer
+p, role:syncer, applications, sync, default/*, allow
+g, k8s:syncers, role:syncer
+g, k8s:admin, role:admin
+`
+
+func newTestServer(t *testing.T, objects ...runtime.Object) *Server {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "argocd-secret"},
+		Data:       map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
+
+	objects = append(objects, &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "guestbook"}},
+		},
+	})
+	appClientset := apps.NewSimpleClientset(objects...)
+	factory := appinformer.NewSharedInformerFactoryWithOptions(appClientset, 0, appinformer.WithNamespace(testNamespace))
+	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
+	projLister := factory.Argoproj().V1alpha1().AppProjects().Lister()
+	go projInformer.Run(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), projInformer.HasSynced))
+
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	require.NoError(t, enf.SetUserPolicy(testPolicy))
+	enf.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enf, projLister.AppProjects(testNamespace)).EnforceClaims)
+
+	return NewServer(testNamespace, appClientset, projLister, settingsMgr, db.NewDB(testNamespace, settingsMgr, kubeclientset), enf, DefaultExemptSubjects(testNamespace))
+}
+
+func review(t *testing.T, s *Server, path string, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	req.UID = types.UID("123")
+	body, err := json.Marshal(admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request:  req,
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var res admissionv1.AdmissionReview
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.NotNil(t, res.Response)
+	assert.Equal(t, types.UID("123"), res.Response.UID)
+	return res.Response
+}
+
+func raw(t *testing.T, obj interface{}) runtime.RawExtension {
+	if obj == nil {
+		return runtime.RawExtension{}
+	}
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return runtime.RawExtension{Raw: data}
+}
+
+func newApp(project, namespace string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     project,
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: namespace},
+		},
+	}
+}
+
+func appRequest(t *testing.T, operation admissionv1.Operation, user authenticationv1.UserInfo, app, oldApp *v1alpha1.Application) *admissionv1.AdmissionRequest {
+	return &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"},
+		Name:      "guestbook",
+		Namespace: testNamespace,
+		Operation: operation,
+		UserInfo:  user,
+		Object:    raw(t, app),
+		OldObject: raw(t, oldApp),
+	}
+}
+
+func TestValidateApplication(t *testing.T) {
+	s := newTestServer(t)
+	deployer := authenticationv1.UserInfo{Username: "alice", Groups: []string{"deployers"}}
+	stranger := authenticationv1.UserInfo{Username: "bob", Groups: []string{"system:authenticated"}}
+	controller := authenticationv1.UserInfo{Username: "system:serviceaccount:argocd:argocd-application-controller"}
+
+	t.Run("Create", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Create, deployer, newApp("default", "guestbook"), nil))
+		assert.True(t, res.Allowed)
+	})
+	t.Run("CreateWithoutPermission", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Create, stranger, newApp("default", "guestbook"), nil))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "user 'bob' is not permitted to create applications 'default/guestbook'")
+
+		// the Kubernetes users are not mistaken for the Argo CD users of the same name
+		local := authenticationv1.UserInfo{Username: "alice", Groups: []string{"role:admin"}}
+		res = review(t, s, ValidatePath, appRequest(t, admissionv1.Delete, local, nil, newApp("default", "guestbook")))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "user 'alice' is not permitted to delete applications 'default/guestbook'")
+	})
+	t.Run("CreateInForbiddenDestination", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Create, deployer, newApp("default", "kube-system"), nil))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "is not permitted in project 'default'")
+	})
+	t.Run("CreateInMissingProject", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Create, controller, newApp("missing", "guestbook"), nil))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "application references project missing which does not exist")
+	})
+	t.Run("UpdateStatus", func(t *testing.T) {
+		app := newApp("default", "kube-system")
+		updated := app.DeepCopy()
+		updated.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, stranger, updated, app))
+		assert.True(t, res.Allowed)
+	})
+	t.Run("Sync", func(t *testing.T) {
+		app := newApp("default", "guestbook")
+		updated := app.DeepCopy()
+		updated.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, deployer, updated, app))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "user 'alice' is not permitted to sync applications 'default/guestbook'")
+
+		res = review(t, s, ValidatePath, appRequest(t, admissionv1.Update, controller, updated, app))
+		assert.True(t, res.Allowed)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Delete, deployer, nil, newApp("default", "guestbook")))
+		assert.False(t, res.Allowed)
+
+		res = review(t, s, ValidatePath, appRequest(t, admissionv1.Delete, controller, nil, newApp("default", "guestbook")))
+		assert.True(t, res.Allowed)
+
+		namespaceController := authenticationv1.UserInfo{Username: "system:serviceaccount:kube-system:namespace-controller"}
+		res = review(t, s, ValidatePath, appRequest(t, admissionv1.Delete, namespaceController, nil, newApp("default", "guestbook")))
+		assert.True(t, res.Allowed)
+
+		otherServiceAccount := authenticationv1.UserInfo{Username: "system:serviceaccount:kube-system:default"}
+		res = review(t, s, ValidatePath, appRequest(t, admissionv1.Delete, otherServiceAccount, nil, newApp("default", "guestbook")))
+		assert.False(t, res.Allowed)
+	})
+}
+
+func TestValidateApplicationOperation(t *testing.T) {
+	s := newTestServer(t)
+	syncer := authenticationv1.UserInfo{Username: "carol", Groups: []string{"syncers"}}
+	approver := authenticationv1.UserInfo{Username: "dave", Groups: []string{"syncers"}}
+	app := newApp("default", "guestbook")
+	pending := app.DeepCopy()
+	pending.Operation = &v1alpha1.Operation{
+		Sync:        &v1alpha1.SyncOperation{},
+		InitiatedBy: v1alpha1.OperationInitiator{Username: "k8s:carol"},
+		Approval:    &v1alpha1.OperationApproval{},
+	}
+	approve := func(app *v1alpha1.Application, approvedBy string) *v1alpha1.Application {
+		app = app.DeepCopy()
+		now := metav1.Now()
+		app.Operation.Approval = &v1alpha1.OperationApproval{ApprovedBy: approvedBy, ApprovedAt: &now}
+		return app
+	}
+
+	t.Run("Initiate", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, syncer, pending, app))
+		assert.True(t, res.Allowed)
+	})
+	t.Run("InitiateAsOtherSubject", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, approver, pending, app))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "operation must be initiated by 'k8s:dave'")
+	})
+	t.Run("InitiateApproved", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, syncer, approve(pending, "k8s:dave"), app))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "operation cannot be approved when it is initiated")
+	})
+	t.Run("Approve", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, approver, approve(pending, "k8s:dave"), pending))
+		assert.True(t, res.Allowed)
+	})
+	t.Run("ApproveOwnOperation", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, syncer, approve(pending, "k8s:carol"), pending))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "operation must be approved by a different subject than the one who initiated it")
+	})
+	t.Run("ApproveOnBehalfOfOtherSubject", func(t *testing.T) {
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, syncer, approve(pending, "k8s:dave"), pending))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "operation can only be approved by 'k8s:carol' while it is pending approval")
+	})
+	t.Run("ReplaceApprovedOperation", func(t *testing.T) {
+		approved := approve(pending, "k8s:dave")
+		replaced := approved.DeepCopy()
+		replaced.Operation.Sync.Revision = "other-revision"
+		res := review(t, s, ValidatePath, appRequest(t, admissionv1.Update, syncer, replaced, approved))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "operation cannot be approved when it is initiated")
+	})
+}
+
+func TestValidateProject(t *testing.T) {
+	s := newTestServer(t, newApp("default", "guestbook"))
+	admin := authenticationv1.UserInfo{Username: "admin"}
+	stranger := authenticationv1.UserInfo{Username: "bob"}
+	projRequest := func(operation admissionv1.Operation, user authenticationv1.UserInfo, proj, oldProj *v1alpha1.AppProject) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "AppProject"},
+			Namespace: testNamespace,
+			Operation: operation,
+			UserInfo:  user,
+			Object:    raw(t, proj),
+			OldObject: raw(t, oldProj),
+		}
+	}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Namespace: testNamespace},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		res := review(t, s, ValidatePath, projRequest(admissionv1.Create, admin, proj, nil))
+		assert.True(t, res.Allowed)
+
+		res = review(t, s, ValidatePath, projRequest(admissionv1.Create, stranger, proj, nil))
+		assert.False(t, res.Allowed)
+	})
+	t.Run("CreateInvalid", func(t *testing.T) {
+		invalid := proj.DeepCopy()
+		invalid.Spec.Roles = []v1alpha1.ProjectRole{{Name: "my-role", Policies: []string{"p, proj:other-proj:my-role, applications, get, my-proj/*, allow"}}}
+		res := review(t, s, ValidatePath, projRequest(admissionv1.Create, admin, invalid, nil))
+		assert.False(t, res.Allowed)
+	})
+	t.Run("DeleteReferenced", func(t *testing.T) {
+		res := review(t, s, ValidatePath, projRequest(admissionv1.Delete, admin, nil, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace}}))
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "name 'default' is reserved and cannot be deleted")
+
+		res = review(t, s, ValidatePath, projRequest(admissionv1.Delete, admin, nil, proj))
+		assert.True(t, res.Allowed)
+	})
+}
+
+func TestMutate(t *testing.T) {
+	s := newTestServer(t)
+	t.Run("Application", func(t *testing.T) {
+		app := newApp("", "guestbook")
+		app.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{}
+		res := review(t, s, MutatePath, appRequest(t, admissionv1.Create, authenticationv1.UserInfo{}, app, nil))
+		require.True(t, res.Allowed)
+		require.NotNil(t, res.PatchType)
+		assert.Equal(t, admissionv1.PatchTypeJSONPatch, *res.PatchType)
+
+		var patch []struct {
+			Op    string                   `json:"op"`
+			Path  string                   `json:"path"`
+			Value v1alpha1.ApplicationSpec `json:"value"`
+		}
+		require.NoError(t, json.Unmarshal(res.Patch, &patch))
+		require.Len(t, patch, 1)
+		assert.Equal(t, "replace", patch[0].Op)
+		assert.Equal(t, "/spec", patch[0].Path)
+		assert.Equal(t, "default", patch[0].Value.Project)
+		assert.Nil(t, patch[0].Value.Source.Helm)
+	})
+	t.Run("NormalizedApplication", func(t *testing.T) {
+		res := review(t, s, MutatePath, appRequest(t, admissionv1.Create, authenticationv1.UserInfo{}, newApp("default", "guestbook"), nil))
+		assert.True(t, res.Allowed)
+		assert.Nil(t, res.Patch)
+	})
+}
@@ -0,0 +1,201 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	agentpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
+	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+const (
+	// chunkSize is the maximum size of the parts of the responses sent to the API server
+	chunkSize = 32 * 1024
+
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+// Agent opens a tunnel to the API server of Argo CD, and performs the requests the API server sends through it
+// against the Kubernetes API server of the cluster the agent runs in. The cluster does not need to be reachable by
+// Argo CD, nor to share its credentials.
+type Agent struct {
+	name      string
+	token     string
+	client    apiclient.Client
+	host      string
+	transport http.RoundTripper
+}
+
+// NewAgent returns an agent registered with the given name and token, which performs the requests with the given
+// Kubernetes configuration
+func NewAgent(name string, token string, client apiclient.Client, config *rest.Config) (*Agent, error) {
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating transport: %w", err)
+	}
+	host := config.Host
+	if !strings.HasPrefix(host, "http://") && !strings.HasPrefix(host, "https://") {
+		host = "https://" + host
+	}
+	return &Agent{
+		name:      name,
+		token:     token,
+		client:    client,
+		host:      strings.TrimSuffix(host, "/"),
+		transport: transport,
+	}, nil
+}
+
+// Run keeps the tunnel open until the context is canceled, reconnecting whenever it is closed
+func (a *Agent) Run(ctx context.Context) {
+	delay := minReconnectDelay
+	for {
+		connectedAt := time.Now()
+		err := a.connect(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(connectedAt) > maxReconnectDelay {
+			delay = minReconnectDelay
+		}
+		log.Warnf("Tunnel closed: %v. Reconnecting in %v", err, delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// connect opens the tunnel and serves the requests sent through it until it is closed
+func (a *Agent) connect(ctx context.Context) error {
+	closer, client, err := a.client.NewAgentClient()
+	if err != nil {
+		return err
+	}
+	defer ioutil.Close(closer)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.Connect(ctx, grpc_retry.Disable())
+	if err != nil {
+		return err
+	}
+	s := &session{agent: a, stream: stream, cancels: map[int64]context.CancelFunc{}}
+	if err := s.send(&agentpkg.AgentMessage{Hello: &agentpkg.AgentHello{Name: a.name, Token: a.token}}); err != nil {
+		return err
+	}
+	log.Infof("Tunnel to the API server opened")
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if req := msg.Request; req != nil {
+			reqCtx, cancel := context.WithCancel(ctx)
+			s.setCancel(req.Id, cancel)
+			go s.serve(reqCtx, req)
+		}
+		if msg.Cancel != 0 {
+			s.cancel(msg.Cancel)
+		}
+	}
+}
+
+// session serves the requests sent through a tunnel
+type session struct {
+	agent  *Agent
+	stream agentpkg.AgentService_ConnectClient
+
+	// sendLock serializes the messages sent to the API server, which the stream does not support concurrently
+	sendLock sync.Mutex
+
+	lock    sync.Mutex
+	cancels map[int64]context.CancelFunc
+}
+
+func (s *session) send(msg *agentpkg.AgentMessage) error {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	return s.stream.Send(msg)
+}
+
+func (s *session) setCancel(id int64, cancel context.CancelFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancels[id] = cancel
+}
+
+func (s *session) cancel(id int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if cancel, ok := s.cancels[id]; ok {
+		cancel()
+		delete(s.cancels, id)
+	}
+}
+
+// serve performs a request and streams its response back to the API server
+func (s *session) serve(ctx context.Context, req *agentpkg.AgentRequest) {
+	defer s.cancel(req.Id)
+	if err := s.do(ctx, req); err != nil && ctx.Err() == nil {
+		log.Warnf("Failed to perform request %s %s: %v", req.Method, req.Path, err)
+		_ = s.send(&agentpkg.AgentMessage{Response: &agentpkg.AgentResponse{Id: req.Id, Error: err.Error(), End: true}})
+	}
+}
+
+func (s *session) do(ctx context.Context, req *agentpkg.AgentRequest) error {
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, s.agent.host+req.Path, bytes.NewReader(req.Body))
+	if err != nil {
+		return err
+	}
+	for _, h := range req.Headers {
+		for _, v := range h.Values {
+			httpReq.Header.Add(h.Name, v)
+		}
+	}
+	resp, err := s.agent.transport.RoundTrip(httpReq)
+	if err != nil {
+		return err
+	}
+	defer ioutil.Close(resp.Body)
+
+	res := &agentpkg.AgentResponse{Id: req.Id, Status: int32(resp.StatusCode)}
+	for name, values := range resp.Header {
+		res.Headers = append(res.Headers, &agentpkg.Header{Name: name, Values: values})
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			res.Body = append([]byte(nil), buf[:n]...)
+			if sendErr := s.send(&agentpkg.AgentMessage{Response: res}); sendErr != nil {
+				return sendErr
+			}
+			res = &agentpkg.AgentResponse{Id: req.Id}
+		}
+		if errors.Is(err, io.EOF) {
+			res.End = true
+			return s.send(&agentpkg.AgentMessage{Response: res})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package agent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/rest"
+
+	agentpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
+)
+
+// fakeStream is the agent side of a tunnel
+type fakeStream struct {
+	grpc.ClientStream
+	sent []*agentpkg.AgentMessage
+}
+
+func (s *fakeStream) Send(msg *agentpkg.AgentMessage) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func (s *fakeStream) Recv() (*agentpkg.ServerMessage, error) {
+	panic("not implemented")
+}
+
+func newTestSession(t *testing.T, handler http.HandlerFunc) (*session, *fakeStream) {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	a, err := NewAgent("edge", "token", nil, &rest.Config{Host: ts.URL, BearerToken: "cluster-token"})
+	require.NoError(t, err)
+	stream := &fakeStream{}
+	return &session{agent: a, stream: stream, cancels: map[int64]context.CancelFunc{}}, stream
+}
+
+func TestServe(t *testing.T) {
+	body := strings.Repeat("a", chunkSize+1)
+	s, stream := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces", r.URL.Path)
+		assert.Equal(t, "limit=1", r.URL.RawQuery)
+		assert.Equal(t, "Bearer cluster-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+
+	s.serve(context.Background(), &agentpkg.AgentRequest{
+		Id:      1,
+		Method:  http.MethodGet,
+		Path:    "/api/v1/namespaces?limit=1",
+		Headers: []*agentpkg.Header{{Name: "Accept", Values: []string{"application/json"}}},
+	})
+
+	require.NotEmpty(t, stream.sent)
+	first := stream.sent[0].Response
+	assert.Equal(t, int32(http.StatusOK), first.Status)
+	var received strings.Builder
+	for i, msg := range stream.sent {
+		assert.Equal(t, int64(1), msg.Response.Id)
+		assert.Empty(t, msg.Response.Error)
+		assert.LessOrEqual(t, len(msg.Response.Body), chunkSize)
+		assert.Equal(t, i == len(stream.sent)-1, msg.Response.End)
+		received.Write(msg.Response.Body)
+	}
+	assert.Equal(t, body, received.String())
+}
+
+func TestServe_Error(t *testing.T) {
+	s, stream := newTestSession(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	s.serve(context.Background(), &agentpkg.AgentRequest{Id: 2, Method: "INVALID METHOD", Path: "/"})
+
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, int64(2), stream.sent[0].Response.Id)
+	assert.NotEmpty(t, stream.sent[0].Response.Error)
+	assert.True(t, stream.sent[0].Response.End)
+}
@@ -17,6 +17,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -29,9 +30,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
@@ -56,6 +60,7 @@ var (
 	preservedAnnotations = []string{
 		NotifiedAnnotationKey,
 		argov1alpha1.AnnotationKeyRefresh,
+		argov1alpha1.AnnotationKeyExpiresAt,
 	}
 )
 
@@ -70,6 +75,8 @@ type ApplicationSetReconciler struct {
 	KubeClientset    kubernetes.Interface
 	utils.Policy
 	utils.Renderer
+	// DuckTypeWatcher, if set, requeues the ApplicationSets whose duck-typed cluster decision resources changed
+	DuckTypeWatcher *generators.DuckTypeWatcher
 
 	EnableProgressiveSyncs bool
 }
@@ -86,6 +93,8 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	if err := r.Get(ctx, req.NamespacedName, &applicationSetInfo); err != nil {
 		if client.IgnoreNotFound(err) != nil {
 			logCtx.WithError(err).Infof("unable to get ApplicationSet: '%v' ", err)
+		} else if r.DuckTypeWatcher != nil {
+			r.DuckTypeWatcher.Forget(req.NamespacedName)
 		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -98,8 +107,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	// Log a warning if there are unrecognized generators
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
-	desiredApplications, applicationSetReason, err := r.generateApplications(applicationSetInfo)
+	desiredApplications, generatorsStatus, applicationSetReason, err := r.generateApplications(applicationSetInfo)
 	if err != nil {
+		_ = r.setApplicationSetGeneratorsStatus(ctx, &applicationSetInfo, generatorsStatus)
 		_ = r.setApplicationSetStatusCondition(ctx,
 			&applicationSetInfo,
 			argov1alpha1.ApplicationSetCondition{
@@ -270,6 +280,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	if err := r.setApplicationSetGeneratorsStatus(ctx, &applicationSetInfo, generatorsStatus); err != nil {
+		logCtx.Warnf("error occurred while updating the generators status of the ApplicationSet: %v", err)
+	}
+
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
 	logCtx.WithField("requeueAfter", requeueAfter).Info("end reconcile")
 
@@ -292,6 +306,56 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}, nil
 }
 
+// setApplicationSetGeneratorsStatus sets the status of the generators of the ApplicationSet and the aggregated status
+// of its Applications
+func (r *ApplicationSetReconciler) setApplicationSetGeneratorsStatus(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, generatorsStatus []argov1alpha1.ApplicationSetGeneratorStatus) error {
+	apps, err := r.getCurrentApplications(ctx, *applicationSet)
+	if err != nil {
+		return fmt.Errorf("error getting current applications: %w", err)
+	}
+
+	// fetch updated Application Set object before updating it
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	if err := r.Get(ctx, namespacedName, applicationSet); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return nil
+		}
+		return fmt.Errorf("error fetching updated application set: %v", err)
+	}
+
+	applicationSet.Status.Generators = generatorsStatus
+	applicationSet.Status.Summary = argov1alpha1.SummarizeApplications(apps)
+
+	err = r.Client.Status().Update(ctx, applicationSet)
+	if err != nil && !apierr.IsNotFound(err) {
+		return fmt.Errorf("unable to set application set generators status: %v", err)
+	}
+	return nil
+}
+
+// isGeneratorsStatusUpdate returns whether the update of the ApplicationSet only changed the status of its generators
+// or the aggregated status of its Applications. These are updated at the end of every reconciliation and must not
+// trigger another one.
+func isGeneratorsStatusUpdate(e event.UpdateEvent) bool {
+	oldAppSet, ok := e.ObjectOld.(*argov1alpha1.ApplicationSet)
+	if !ok {
+		return false
+	}
+	newAppSet, ok := e.ObjectNew.(*argov1alpha1.ApplicationSet)
+	if !ok {
+		return false
+	}
+	oldAppSet = oldAppSet.DeepCopy()
+	newAppSet = newAppSet.DeepCopy()
+	for _, appSet := range []*argov1alpha1.ApplicationSet{oldAppSet, newAppSet} {
+		appSet.ResourceVersion = ""
+		appSet.ManagedFields = nil
+		appSet.Status.Generators = nil
+		appSet.Status.Summary = nil
+	}
+	return reflect.DeepEqual(oldAppSet, newAppSet)
+}
+
 func getParametersGeneratedCondition(parametersGenerated bool, message string) argov1alpha1.ApplicationSetCondition {
 	var paramtersGeneratedCondition argov1alpha1.ApplicationSetCondition
 	if parametersGenerated {
@@ -468,13 +532,22 @@ func getTempApplication(applicationSetTemplate argov1alpha1.ApplicationSetTempla
 	return &tmplApplication
 }
 
-func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argov1alpha1.ApplicationSet) ([]argov1alpha1.Application, argov1alpha1.ApplicationSetReasonType, error) {
+// generateApplications returns the Applications generated by the generators of the ApplicationSet, along with the
+// status of each generator
+func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argov1alpha1.ApplicationSet) ([]argov1alpha1.Application, []argov1alpha1.ApplicationSetGeneratorStatus, argov1alpha1.ApplicationSetReasonType, error) {
 	var res []argov1alpha1.Application
+	generatorsStatus := make([]argov1alpha1.ApplicationSetGeneratorStatus, 0, len(applicationSetInfo.Spec.Generators))
 
 	var firstError error
 	var applicationSetReason argov1alpha1.ApplicationSetReasonType
 
-	for _, requestedGenerator := range applicationSetInfo.Spec.Generators {
+	for i, requestedGenerator := range applicationSetInfo.Spec.Generators {
+		now := metav1.Now()
+		generatorStatus := argov1alpha1.ApplicationSetGeneratorStatus{
+			Index:           int64(i),
+			Type:            generators.GetGeneratorType(&requestedGenerator),
+			LastRefreshTime: &now,
+		}
 		t, err := generators.Transform(requestedGenerator, r.Generators, applicationSetInfo.Spec.Template, &applicationSetInfo, map[string]interface{}{})
 		if err != nil {
 			log.WithError(err).WithField("generator", requestedGenerator).
@@ -483,10 +556,13 @@ func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argov
 				firstError = err
 				applicationSetReason = argov1alpha1.ApplicationSetReasonApplicationParamsGenerationError
 			}
+			generatorStatus.LastError = err.Error()
+			generatorsStatus = append(generatorsStatus, generatorStatus)
 			continue
 		}
 
 		for _, a := range t {
+			generatorStatus.ParameterCount += int64(len(a.Params))
 			tmplApplication := getTempApplication(a.Template)
 
 			for _, p := range a.Params {
@@ -499,17 +575,21 @@ func (r *ApplicationSetReconciler) generateApplications(applicationSetInfo argov
 						firstError = err
 						applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
 					}
+					if generatorStatus.LastError == "" {
+						generatorStatus.LastError = err.Error()
+					}
 					continue
 				}
 				res = append(res, *app)
 			}
 		}
+		generatorsStatus = append(generatorsStatus, generatorStatus)
 
 		log.WithField("generator", requestedGenerator).Infof("generated %d applications", len(res))
 		log.WithField("generator", requestedGenerator).Debugf("apps from generator: %+v", res)
 	}
 
-	return res, applicationSetReason, firstError
+	return res, generatorsStatus, applicationSetReason, firstError
 }
 
 func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -531,17 +611,24 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return fmt.Errorf("error setting up with manager: %w", err)
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&argov1alpha1.ApplicationSet{}).
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&argov1alpha1.ApplicationSet{}, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return !isGeneratorsStatusUpdate(e)
+			},
+		})).
 		Owns(&argov1alpha1.Application{}).
 		Watches(
 			&source.Kind{Type: &corev1.Secret{}},
 			&clusterSecretEventHandler{
 				Client: mgr.GetClient(),
 				Log:    log.WithField("type", "createSecretEventHandler"),
-			}).
-		// TODO: also watch Applications and respond on changes if we own them.
-		Complete(r)
+			})
+	if r.DuckTypeWatcher != nil {
+		controllerBuilder = controllerBuilder.Watches(&source.Channel{Source: r.DuckTypeWatcher.Events()}, &handler.EnqueueRequestForObject{})
+	}
+	// TODO: also watch Applications and respond on changes if we own them.
+	return controllerBuilder.Complete(r)
 }
 
 // createOrUpdateInCluster will create / update application resources in the cluster.
@@ -588,9 +675,21 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 					generatedApp.Annotations[key] = state
 				}
 			}
+			// The annotation preserving the resources on deletion may be set on the live Application rather than in the template
+			if state, exists := found.ObjectMeta.Annotations[argov1alpha1.AnnotationKeyApplicationSetPreserveResourcesOnDeletion]; exists {
+				if _, defined := generatedApp.Annotations[argov1alpha1.AnnotationKeyApplicationSetPreserveResourcesOnDeletion]; !defined {
+					if generatedApp.Annotations == nil {
+						generatedApp.Annotations = map[string]string{}
+					}
+					generatedApp.Annotations[argov1alpha1.AnnotationKeyApplicationSetPreserveResourcesOnDeletion] = state
+				}
+			}
 			found.ObjectMeta.Annotations = generatedApp.Annotations
 
 			found.ObjectMeta.Finalizers = generatedApp.Finalizers
+			if preserveResourcesOnDeletion(found) {
+				found.ObjectMeta.Finalizers = withoutResourcesFinalizer(found.ObjectMeta.Finalizers)
+			}
 			found.ObjectMeta.Labels = generatedApp.Labels
 			return controllerutil.SetControllerReference(&applicationSet, found, r.Scheme)
 		})
@@ -649,9 +748,17 @@ func (r *ApplicationSetReconciler) getCurrentApplications(_ context.Context, app
 	return current.Items, nil
 }
 
-// deleteInCluster will delete Applications that are currently on the cluster, but not in appList.
+// deleteInCluster will delete Applications that are currently on the cluster, but not in appList. Depending on the
+// applications removal policy of the ApplicationSet, the Applications are orphaned or suspended rather than deleted.
 // The function must be called after all generators had been called and generated applications
 func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
+	removalPolicy := applicationSet.Spec.SyncPolicy.GetApplicationsRemovalPolicy()
+	switch removalPolicy {
+	case argov1alpha1.ApplicationsRemovalPolicyDelete, argov1alpha1.ApplicationsRemovalPolicyOrphan, argov1alpha1.ApplicationsRemovalPolicySuspend:
+	default:
+		return fmt.Errorf("unknown applications removal policy '%s'", removalPolicy)
+	}
+
 	// settingsMgr := settings.NewSettingsManager(context.TODO(), r.KubeClientset, applicationSet.Namespace)
 	// argoDB := db.NewDB(applicationSet.Namespace, settingsMgr, r.KubeClientset)
 	// clusterList, err := argoDB.ListClusters(ctx)
@@ -680,6 +787,31 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, applicat
 
 		if !exists {
 
+			if removalPolicy != argov1alpha1.ApplicationsRemovalPolicyDelete {
+				err := r.releaseApplication(ctx, applicationSet, &app, removalPolicy, appLog)
+				if err != nil {
+					appLog.WithError(err).Errorf("failed to %s Application", removalPolicy)
+					if firstError == nil {
+						firstError = err
+					}
+				}
+				continue
+			}
+
+			if preserveResourcesOnDeletion(&app) {
+				if finalizers := withoutResourcesFinalizer(app.Finalizers); len(finalizers) != len(app.Finalizers) {
+					app.Finalizers = finalizers
+					if err := r.Client.Update(ctx, &app, &client.UpdateOptions{}); err != nil {
+						appLog.WithError(err).Error("failed to update Application")
+						if firstError == nil {
+							firstError = err
+						}
+						continue
+					}
+					appLog.Log(log.InfoLevel, "Removed resources finalizer before deletion to preserve the application resources")
+				}
+			}
+
 			// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 			err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, appLog)
 			if err != nil {
@@ -705,6 +837,60 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, applicat
 	return firstError
 }
 
+// releaseApplication orphans or suspends, depending on the given removal policy, an Application which is no longer
+// generated by the ApplicationSet
+func (r *ApplicationSetReconciler) releaseApplication(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application, removalPolicy argov1alpha1.ApplicationsRemovalPolicy, appLog *log.Entry) error {
+	switch removalPolicy {
+	case argov1alpha1.ApplicationsRemovalPolicyOrphan:
+		var ownerReferences []metav1.OwnerReference
+		for _, ref := range app.OwnerReferences {
+			if ref.UID != applicationSet.UID {
+				ownerReferences = append(ownerReferences, ref)
+			}
+		}
+		app.OwnerReferences = ownerReferences
+		if err := r.Client.Update(ctx, app, &client.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error removing owner reference: %w", err)
+		}
+		r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Orphaned", "Orphaned Application %q", app.Name)
+		appLog.Log(log.InfoLevel, "Orphaned application")
+	case argov1alpha1.ApplicationsRemovalPolicySuspend:
+		if app.Annotations[argov1alpha1.AnnotationKeyApplicationSetSuspended] == "true" {
+			return nil
+		}
+		if app.Annotations == nil {
+			app.Annotations = map[string]string{}
+		}
+		app.Annotations[argov1alpha1.AnnotationKeyApplicationSetSuspended] = "true"
+		if app.Spec.SyncPolicy != nil {
+			app.Spec.SyncPolicy.Automated = nil
+		}
+		if err := r.Client.Update(ctx, app, &client.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error suspending application: %w", err)
+		}
+		r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Suspended", "Suspended Application %q", app.Name)
+		appLog.Log(log.InfoLevel, "Suspended application")
+	}
+	return nil
+}
+
+// preserveResourcesOnDeletion returns whether the resources of the given Application must be preserved when the
+// ApplicationSet deletes it
+func preserveResourcesOnDeletion(app *argov1alpha1.Application) bool {
+	return app.Annotations[argov1alpha1.AnnotationKeyApplicationSetPreserveResourcesOnDeletion] == "true"
+}
+
+// withoutResourcesFinalizer returns the given finalizers without the Argo CD resources finalizer
+func withoutResourcesFinalizer(finalizers []string) []string {
+	var res []string
+	for _, finalizer := range finalizers {
+		if finalizer != argov1alpha1.ResourcesFinalizerName {
+			res = append(res, finalizer)
+		}
+	}
+	return res
+}
+
 // removeFinalizerOnInvalidDestination removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application, clusterList *argov1alpha1.ClusterList, appLog *log.Entry) error {
 
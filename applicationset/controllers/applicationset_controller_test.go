@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -24,6 +25,7 @@ import (
 	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	"github.com/argoproj/argo-cd/v2/applicationset/generators"
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
@@ -184,7 +186,7 @@ func TestExtractApplications(t *testing.T) {
 				KubeClientset: kubefake.NewSimpleClientset(),
 			}
 
-			got, reason, err := r.generateApplications(argov1alpha1.ApplicationSet{
+			got, generatorsStatus, reason, err := r.generateApplications(argov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
 					Namespace: "namespace",
@@ -202,6 +204,17 @@ func TestExtractApplications(t *testing.T) {
 			}
 			assert.Equal(t, expectedApps, got)
 			assert.Equal(t, cc.expectedReason, reason)
+			if assert.Len(t, generatorsStatus, 1) {
+				assert.Equal(t, int64(0), generatorsStatus[0].Index)
+				assert.Equal(t, "List", generatorsStatus[0].Type)
+				assert.NotNil(t, generatorsStatus[0].LastRefreshTime)
+				if cc.expectErr {
+					assert.NotEmpty(t, generatorsStatus[0].LastError)
+				} else {
+					assert.Empty(t, generatorsStatus[0].LastError)
+					assert.Equal(t, int64(len(cc.params)), generatorsStatus[0].ParameterCount)
+				}
+			}
 			generatorMock.AssertNumberOfCalls(t, "GenerateParams", 1)
 
 			if cc.generateParamsError == nil {
@@ -297,7 +310,7 @@ func TestMergeTemplateApplications(t *testing.T) {
 				KubeClientset: kubefake.NewSimpleClientset(),
 			}
 
-			got, _, _ := r.generateApplications(argov1alpha1.ApplicationSet{
+			got, _, _, _ := r.generateApplications(argov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
 					Namespace: "namespace",
@@ -757,7 +770,7 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 			},
 		},
 		{
-			name: "Ensure that argocd notifications state and refresh annotation is preserved from an existing app",
+			name: "Ensure that argocd notifications state, refresh and expiry annotations are preserved from an existing app",
 			appSet: argov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "name",
@@ -783,9 +796,10 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						ResourceVersion: "2",
 						Labels:          map[string]string{"label-key": "label-value"},
 						Annotations: map[string]string{
-							"annot-key":                       "annot-value",
-							NotifiedAnnotationKey:             `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
-							argov1alpha1.AnnotationKeyRefresh: string(argov1alpha1.RefreshTypeNormal),
+							"annot-key":                         "annot-value",
+							NotifiedAnnotationKey:               `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
+							argov1alpha1.AnnotationKeyRefresh:   string(argov1alpha1.RefreshTypeNormal),
+							argov1alpha1.AnnotationKeyExpiresAt: "2023-01-02T15:04:05Z",
 						},
 					},
 					Spec: argov1alpha1.ApplicationSpec{
@@ -814,8 +828,9 @@ func TestCreateOrUpdateInCluster(t *testing.T) {
 						Namespace:       "namespace",
 						ResourceVersion: "3",
 						Annotations: map[string]string{
-							NotifiedAnnotationKey:             `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
-							argov1alpha1.AnnotationKeyRefresh: string(argov1alpha1.RefreshTypeNormal),
+							NotifiedAnnotationKey:               `{"b620d4600c771a6f4cxxxxxxx:on-deployed:[0].y7b5sbwa2Q329JYHxxxxxx-fBs:slack:slack-test":1617144614}`,
+							argov1alpha1.AnnotationKeyRefresh:   string(argov1alpha1.RefreshTypeNormal),
+							argov1alpha1.AnnotationKeyExpiresAt: "2023-01-02T15:04:05Z",
 						},
 					},
 					Spec: argov1alpha1.ApplicationSpec{
@@ -1493,6 +1508,102 @@ func TestDeleteInCluster(t *testing.T) {
 	}
 }
 
+func TestDeleteInClusterApplicationsRemovalPolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	newAppSet := func(policy argov1alpha1.ApplicationsRemovalPolicy) argov1alpha1.ApplicationSet {
+		return argov1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace", UID: "appset-uid"},
+			Spec: argov1alpha1.ApplicationSetSpec{
+				SyncPolicy: &argov1alpha1.ApplicationSetSyncPolicy{ApplicationsRemoval: policy},
+			},
+		}
+	}
+	getRemovedApp := func(t *testing.T, appSet argov1alpha1.ApplicationSet) (*argov1alpha1.Application, error) {
+		app := &argov1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "removed", Namespace: "namespace", Finalizers: []string{argov1alpha1.ResourcesFinalizerName}},
+			Spec: argov1alpha1.ApplicationSpec{
+				Project:    "project",
+				SyncPolicy: &argov1alpha1.SyncPolicy{Automated: &argov1alpha1.SyncPolicyAutomated{Prune: true}},
+			},
+		}
+		err := controllerutil.SetControllerReference(&appSet, app, scheme)
+		assert.Nil(t, err)
+
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, app).Build()
+		r := ApplicationSetReconciler{
+			Client:        client,
+			Scheme:        scheme,
+			Recorder:      record.NewFakeRecorder(2),
+			KubeClientset: kubefake.NewSimpleClientset(),
+		}
+		if err := r.deleteInCluster(context.TODO(), appSet, nil); err != nil {
+			return nil, err
+		}
+		got := &argov1alpha1.Application{}
+		return got, client.Get(context.Background(), crtclient.ObjectKey{Namespace: "namespace", Name: "removed"}, got)
+	}
+
+	t.Run("Orphan", func(t *testing.T) {
+		got, err := getRemovedApp(t, newAppSet(argov1alpha1.ApplicationsRemovalPolicyOrphan))
+		assert.NoError(t, err)
+		assert.Empty(t, got.OwnerReferences)
+		assert.NotNil(t, got.Spec.SyncPolicy.Automated)
+		assert.Equal(t, []string{argov1alpha1.ResourcesFinalizerName}, got.Finalizers)
+	})
+	t.Run("Suspend", func(t *testing.T) {
+		got, err := getRemovedApp(t, newAppSet(argov1alpha1.ApplicationsRemovalPolicySuspend))
+		assert.NoError(t, err)
+		assert.Len(t, got.OwnerReferences, 1)
+		assert.Nil(t, got.Spec.SyncPolicy.Automated)
+		assert.Equal(t, "true", got.Annotations[argov1alpha1.AnnotationKeyApplicationSetSuspended])
+	})
+	t.Run("Delete", func(t *testing.T) {
+		_, err := getRemovedApp(t, newAppSet(""))
+		assert.True(t, apierr.IsNotFound(err))
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := getRemovedApp(t, newAppSet("unknown"))
+		assert.EqualError(t, err, "unknown applications removal policy 'unknown'")
+	})
+}
+
+func TestCreateOrUpdateInClusterPreserveResourcesOnDeletion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := argov1alpha1.AddToScheme(scheme)
+	assert.Nil(t, err)
+
+	appSet := argov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"}}
+	existing := &argov1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "app",
+			Namespace:   "namespace",
+			Annotations: map[string]string{argov1alpha1.AnnotationKeyApplicationSetPreserveResourcesOnDeletion: "true"},
+		},
+	}
+	err = controllerutil.SetControllerReference(&appSet, existing, scheme)
+	assert.Nil(t, err)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet, existing).Build()
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(1),
+	}
+	err = r.createOrUpdateInCluster(context.TODO(), appSet, []argov1alpha1.Application{{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Finalizers: []string{argov1alpha1.ResourcesFinalizerName}},
+	}})
+	assert.Nil(t, err)
+
+	got := &argov1alpha1.Application{}
+	err = client.Get(context.Background(), crtclient.ObjectKey{Namespace: "namespace", Name: "app"}, got)
+	assert.Nil(t, err)
+	assert.Equal(t, "true", got.Annotations[argov1alpha1.AnnotationKeyApplicationSetPreserveResourcesOnDeletion])
+	assert.Empty(t, got.Finalizers)
+}
+
 func TestGetMinRequeueAfter(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := argov1alpha1.AddToScheme(scheme)
@@ -2014,7 +2125,7 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 				KubeClientset: kubefake.NewSimpleClientset(),
 			}
 
-			gotApp, _, _ := appSetReconciler.generateApplications(argov1alpha1.ApplicationSet{
+			gotApp, _, _, _ := appSetReconciler.generateApplications(argov1alpha1.ApplicationSet{
 				Spec: argov1alpha1.ApplicationSetSpec{
 					GoTemplate: true,
 					Generators: []argov1alpha1.ApplicationSetGenerator{{
@@ -4838,3 +4949,26 @@ func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGeneratorsStatusUpdate(t *testing.T) {
+	appSet := &argov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd", ResourceVersion: "1"},
+		Spec: argov1alpha1.ApplicationSetSpec{
+			Generators: []argov1alpha1.ApplicationSetGenerator{{List: &argov1alpha1.ListGenerator{}}},
+		},
+	}
+
+	statusUpdated := appSet.DeepCopy()
+	statusUpdated.ResourceVersion = "2"
+	statusUpdated.Status.Generators = []argov1alpha1.ApplicationSetGeneratorStatus{{Type: "List", ParameterCount: 2}}
+	statusUpdated.Status.Summary = &argov1alpha1.ApplicationSetSummary{Total: 2}
+	assert.True(t, isGeneratorsStatusUpdate(event.UpdateEvent{ObjectOld: appSet, ObjectNew: statusUpdated}))
+
+	specUpdated := statusUpdated.DeepCopy()
+	specUpdated.Spec.GoTemplate = true
+	assert.False(t, isGeneratorsStatusUpdate(event.UpdateEvent{ObjectOld: appSet, ObjectNew: specUpdated}))
+
+	conditionsUpdated := statusUpdated.DeepCopy()
+	conditionsUpdated.Status.Conditions = []argov1alpha1.ApplicationSetCondition{{Type: argov1alpha1.ApplicationSetConditionErrorOccurred}}
+	assert.False(t, isGeneratorsStatusUpdate(event.UpdateEvent{ObjectOld: appSet, ObjectNew: conditionsUpdated}))
+}
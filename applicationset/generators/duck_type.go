@@ -29,9 +29,10 @@ type DuckTypeGenerator struct {
 	clientset       kubernetes.Interface
 	namespace       string // namespace is the Argo CD namespace
 	settingsManager *settings.SettingsManager
+	watcher         *DuckTypeWatcher // watcher is optional and regenerates ApplicationSets when their duck-typed resources change
 }
 
-func NewDuckTypeGenerator(ctx context.Context, dynClient dynamic.Interface, clientset kubernetes.Interface, namespace string) Generator {
+func NewDuckTypeGenerator(ctx context.Context, dynClient dynamic.Interface, clientset kubernetes.Interface, namespace string, watcher *DuckTypeWatcher) Generator {
 
 	settingsManager := settings.NewSettingsManager(ctx, clientset, namespace)
 
@@ -41,6 +42,7 @@ func NewDuckTypeGenerator(ctx context.Context, dynClient dynamic.Interface, clie
 		clientset:       clientset,
 		namespace:       namespace,
 		settingsManager: settingsManager,
+		watcher:         watcher,
 	}
 	return g
 }
@@ -116,6 +118,12 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 
 	duckGVR := schema.GroupVersionResource{Group: group, Version: version, Resource: kind}
 
+	if g.watcher != nil && appSet != nil {
+		if err := g.watcher.Watch(duckGVR, appSet, resourceName, labelSelector); err != nil {
+			log.WithField("GVK", duckGVR).Warnf("unable to watch duck-typed resources: %v", err)
+		}
+	}
+
 	listOptions := metav1.ListOptions{}
 	if resourceName == "" {
 		listOptions.LabelSelector = metav1.FormatLabelSelector(&labelSelector)
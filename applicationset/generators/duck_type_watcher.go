@@ -0,0 +1,165 @@
+package generators
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// duckTypeSelection is the selection of duck-typed resources made by a ClusterDecisionResource generator
+type duckTypeSelection struct {
+	name     string
+	selector labels.Selector
+}
+
+func (s duckTypeSelection) matches(obj *unstructured.Unstructured) bool {
+	if s.name != "" {
+		return obj.GetName() == s.name
+	}
+	return s.selector.Matches(labels.Set(obj.GetLabels()))
+}
+
+// DuckTypeWatcher watches the duck-typed resources read by the ClusterDecisionResource generators and emits an event
+// for each ApplicationSet reading a resource which changed, so that the ApplicationSet is regenerated without waiting
+// for its requeue delay.
+type DuckTypeWatcher struct {
+	ctx       context.Context
+	dynClient dynamic.Interface
+	namespace string
+	events    chan event.GenericEvent
+
+	lock sync.Mutex
+	// watched holds the resources which are watched
+	watched map[schema.GroupVersionResource]bool
+	// appSets holds the selections of duck-typed resources of each ApplicationSet, per resource
+	appSets map[schema.GroupVersionResource]map[types.NamespacedName]map[string]duckTypeSelection
+}
+
+// NewDuckTypeWatcher creates a watcher of the duck-typed resources of the given namespace, which stops watching when
+// the given context is done
+func NewDuckTypeWatcher(ctx context.Context, dynClient dynamic.Interface, namespace string) *DuckTypeWatcher {
+	return &DuckTypeWatcher{
+		ctx:       ctx,
+		dynClient: dynClient,
+		namespace: namespace,
+		events:    make(chan event.GenericEvent),
+		watched:   make(map[schema.GroupVersionResource]bool),
+		appSets:   make(map[schema.GroupVersionResource]map[types.NamespacedName]map[string]duckTypeSelection),
+	}
+}
+
+// Events returns the channel of the events of the ApplicationSets which must be regenerated
+func (w *DuckTypeWatcher) Events() <-chan event.GenericEvent {
+	return w.events
+}
+
+// Watch registers the duck-typed resources selected by the given ApplicationSet, either by name or label selector,
+// and starts watching their resource if not watched yet
+func (w *DuckTypeWatcher) Watch(gvr schema.GroupVersionResource, appSet *argoprojiov1alpha1.ApplicationSet, name string, labelSelector metav1.LabelSelector) error {
+	selection := duckTypeSelection{name: name}
+	if name == "" {
+		selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+		if err != nil {
+			return err
+		}
+		selection.selector = selector
+	}
+	key := types.NamespacedName{Namespace: appSet.Namespace, Name: appSet.Name}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.appSets[gvr] == nil {
+		w.appSets[gvr] = make(map[types.NamespacedName]map[string]duckTypeSelection)
+	}
+	if w.appSets[gvr][key] == nil {
+		w.appSets[gvr][key] = make(map[string]duckTypeSelection)
+	}
+	selectionKey := name
+	if selection.selector != nil {
+		selectionKey = "selector:" + selection.selector.String()
+	}
+	w.appSets[gvr][key][selectionKey] = selection
+
+	if !w.watched[gvr] {
+		w.watched[gvr] = true
+		w.startInformer(gvr)
+	}
+	return nil
+}
+
+// Forget unregisters the duck-typed resources selected by the given ApplicationSet
+func (w *DuckTypeWatcher) Forget(appSet types.NamespacedName) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for _, appSets := range w.appSets {
+		delete(appSets, appSet)
+	}
+}
+
+func (w *DuckTypeWatcher) startInformer(gvr schema.GroupVersionResource) {
+	log.WithField("resource", gvr.String()).Info("Watching duck-typed resources")
+	informer := dynamicinformer.NewFilteredDynamicInformer(w.dynClient, gvr, w.namespace, 0, cache.Indexers{}, nil).Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.notify(gvr, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			w.notify(gvr, oldObj, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			w.notify(gvr, obj)
+		},
+	})
+	go informer.Run(w.ctx.Done())
+}
+
+// notify emits an event for each ApplicationSet selecting any of the given resources
+func (w *DuckTypeWatcher) notify(gvr schema.GroupVersionResource, objs ...interface{}) {
+	var appSets []types.NamespacedName
+	w.lock.Lock()
+	for appSet, selections := range w.appSets[gvr] {
+		if selectsAny(selections, objs) {
+			appSets = append(appSets, appSet)
+		}
+	}
+	w.lock.Unlock()
+
+	for _, appSet := range appSets {
+		log.WithFields(log.Fields{"resource": gvr.String(), "applicationset": appSet.String()}).Debug("duck-typed resource changed, requeuing ApplicationSet")
+		select {
+		case w.events <- event.GenericEvent{Object: &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Namespace: appSet.Namespace, Name: appSet.Name}}}:
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+func selectsAny(selections map[string]duckTypeSelection, objs []interface{}) bool {
+	for _, obj := range objs {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		for _, selection := range selections {
+			if selection.matches(un) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package generators
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newDuck(name string, labels map[string]string) *unstructured.Unstructured {
+	duck := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": resourceApiVersion,
+		"kind":       "Duck",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "namespace",
+		},
+	}}
+	duck.SetLabels(labels)
+	return duck
+}
+
+func receiveAppSetEvent(t *testing.T, events <-chan event.GenericEvent) types.NamespacedName {
+	select {
+	case e := <-events:
+		return types.NamespacedName{Namespace: e.Object.GetNamespace(), Name: e.Object.GetName()}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an ApplicationSet event")
+		return types.NamespacedName{}
+	}
+}
+
+func TestDuckTypeWatcher(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "mallard.io", Version: "v1", Resource: resourceKind}
+	fakeDynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "DuckList"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher := NewDuckTypeWatcher(ctx, fakeDynClient, "namespace")
+
+	byName := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "by-name", Namespace: "namespace"}}
+	bySelector := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "by-selector", Namespace: "namespace"}}
+	require.NoError(t, watcher.Watch(gvr, byName, resourceName, metav1.LabelSelector{}))
+	require.NoError(t, watcher.Watch(gvr, bySelector, "", metav1.LabelSelector{MatchLabels: map[string]string{"duck": "spotted"}}))
+
+	// give the informer the time to start watching
+	time.Sleep(100 * time.Millisecond)
+
+	ducks := fakeDynClient.Resource(gvr).Namespace("namespace")
+	_, err := ducks.Create(ctx, newDuck(resourceName, nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, types.NamespacedName{Namespace: "namespace", Name: "by-name"}, receiveAppSetEvent(t, watcher.Events()))
+
+	_, err = ducks.Create(ctx, newDuck("spotted", map[string]string{"duck": "spotted"}), metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, types.NamespacedName{Namespace: "namespace", Name: "by-selector"}, receiveAppSetEvent(t, watcher.Events()))
+
+	watcher.Forget(types.NamespacedName{Namespace: "namespace", Name: "by-selector"})
+	_, err = ducks.Create(ctx, newDuck("other", map[string]string{"duck": "spotted"}), metav1.CreateOptions{})
+	require.NoError(t, err)
+	err = ducks.Delete(ctx, resourceName, metav1.DeleteOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, types.NamespacedName{Namespace: "namespace", Name: "by-name"}, receiveAppSetEvent(t, watcher.Events()))
+}
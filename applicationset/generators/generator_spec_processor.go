@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"encoding/json"
 	"reflect"
+	"strings"
 
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 
@@ -122,6 +123,29 @@ func GetRelevantGenerators(requestedGenerator *argoprojiov1alpha1.ApplicationSet
 	return res
 }
 
+// GetGeneratorType returns the type of the given generator, e.g. List, Clusters or Matrix
+func GetGeneratorType(requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator) string {
+	var types []string
+
+	v := reflect.Indirect(reflect.ValueOf(requestedGenerator))
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanInterface() {
+			continue
+		}
+		name := v.Type().Field(i).Name
+		if name == selectorKey {
+			continue
+		}
+
+		if !reflect.ValueOf(field.Interface()).IsNil() {
+			types = append(types, name)
+		}
+	}
+
+	return strings.Join(types, ",")
+}
+
 func mergeGeneratorTemplate(g Generator, requestedGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetTemplate argoprojiov1alpha1.ApplicationSetTemplate) (argoprojiov1alpha1.ApplicationSetTemplate, error) {
 	// Make a copy of the value from `GetTemplate()` before merge, rather than copying directly into
 	// the provided parameter (which will touch the original resource object returned by client-go)
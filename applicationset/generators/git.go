@@ -1,8 +1,11 @@
 package generators
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"path"
 	"sort"
 	"strconv"
@@ -11,6 +14,7 @@ import (
 
 	"github.com/jeremywohl/flatten"
 	log "github.com/sirupsen/logrus"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v2/applicationset/services"
@@ -99,16 +103,26 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 
 	// Get all files that match the requested path string, removing duplicates
 	allFiles := make(map[string][]byte)
+	excludedFiles := make(map[string]bool)
 	for _, requestedPath := range appSetGenerator.Git.Files {
 		files, err := g.repos.GetFiles(context.TODO(), appSetGenerator.Git.RepoURL, appSetGenerator.Git.Revision, requestedPath.Path)
 		if err != nil {
 			return nil, err
 		}
 		for filePath, content := range files {
-			allFiles[filePath] = content
+			if requestedPath.Exclude {
+				excludedFiles[filePath] = true
+			} else {
+				allFiles[filePath] = content
+			}
 		}
 	}
 
+	// Whenever there is a path with exclude: true it wont be included, even if it is included in a different path pattern
+	for filePath := range excludedFiles {
+		delete(allFiles, filePath)
+	}
+
 	// Extract the unduplicated map into a list, and sort by path to ensure a deterministic
 	// processing order in the subsequent step
 	allPaths := []string{}
@@ -122,7 +136,7 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	for _, path := range allPaths {
 
 		// A JSON / YAML file path can contain multiple sets of parameters (ie it is an array)
-		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path], useGoTemplate, appSetGenerator.Git.PathParamPrefix)
+		paramsArray, err := g.generateParamsFromGitFile(path, allFiles[path], useGoTemplate, appSetGenerator.Git.PathParamPrefix, appSetGenerator.Git.FlattenDelimiter)
 		if err != nil {
 			return nil, fmt.Errorf("unable to process file '%s': %v", path, err)
 		}
@@ -134,19 +148,34 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	return res, nil
 }
 
-func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, useGoTemplate bool, pathParamPrefix string) ([]map[string]interface{}, error) {
+func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, useGoTemplate bool, pathParamPrefix string, flattenDelimiter string) ([]map[string]interface{}, error) {
 	objectsFound := []map[string]interface{}{}
 
-	// First, we attempt to parse as an array
-	err := yaml.Unmarshal(fileContent, &objectsFound)
+	// A YAML file can contain multiple documents, each of which is parsed separately
+	documents, err := splitYAMLDocuments(fileContent)
 	if err != nil {
-		// If unable to parse as an array, attempt to parse as a single object
-		singleObj := make(map[string]interface{})
-		err = yaml.Unmarshal(fileContent, &singleObj)
+		return nil, fmt.Errorf("unable to parse file: %v", err)
+	}
+	for _, document := range documents {
+		documentObjects := []map[string]interface{}{}
+
+		// First, we attempt to parse as an array
+		err := yaml.Unmarshal(document, &documentObjects)
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse file: %v", err)
+			// If unable to parse as an array, attempt to parse as a single object
+			singleObj := make(map[string]interface{})
+			err = yaml.Unmarshal(document, &singleObj)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse file: %v", err)
+			}
+			documentObjects = append(documentObjects, singleObj)
 		}
-		objectsFound = append(objectsFound, singleObj)
+		objectsFound = append(objectsFound, documentObjects...)
+	}
+
+	flattenStyle := flatten.DotStyle
+	if flattenDelimiter != "" {
+		flattenStyle = flatten.SeparatorStyle{Middle: flattenDelimiter}
 	}
 
 	res := []map[string]interface{}{}
@@ -174,7 +203,7 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 				params["path"] = paramPath
 			}
 		} else {
-			flat, err := flatten.Flatten(objectFound, "", flatten.DotStyle)
+			flat, err := flatten.Flatten(objectFound, "", flattenStyle)
 			if err != nil {
 				return nil, err
 			}
@@ -203,6 +232,27 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 	return res, nil
 }
 
+// splitYAMLDocuments splits the given YAML content into its documents, ignoring empty documents. JSON content is a
+// single document.
+func splitYAMLDocuments(content []byte) ([][]byte, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(content)))
+	var documents [][]byte
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
+
 func (g *GitGenerator) filterApps(Directories []argoprojiov1alpha1.GitDirectoryGeneratorItem, allPaths []string) []string {
 	res := []string{}
 	for _, appPath := range allPaths {
@@ -51,7 +51,7 @@ func Test_generateParamsFromGitFile(t *testing.T) {
 	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
 foo:
   bar: baz
-`), false, "")
+`), false, "", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -73,7 +73,7 @@ func Test_generatePrefixedParamsFromGitFile(t *testing.T) {
 	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
 foo:
   bar: baz
-`), false, "myRepo")
+`), false, "myRepo", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -91,11 +91,46 @@ foo:
 	}, params)
 }
 
+func Test_generateParamsFromGitFileMultiDocument(t *testing.T) {
+	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
+foo: bar
+---
+- foo: baz
+- foo: qux
+---
+`), false, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, params, 3)
+	assert.Equal(t, "bar", params[0]["foo"])
+	assert.Equal(t, "baz", params[1]["foo"])
+	assert.Equal(t, "qux", params[2]["foo"])
+	for _, p := range params {
+		assert.Equal(t, "path/dir", p["path"])
+	}
+}
+
+func Test_generateParamsFromGitFileFlattenDelimiter(t *testing.T) {
+	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
+foo:
+  bar:
+    baz: qux
+`), false, "", "_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, params, 1)
+	assert.Equal(t, "qux", params[0]["foo_bar_baz"])
+	assert.NotContains(t, params[0], "foo.bar.baz")
+	assert.Equal(t, "dir", params[0]["path.basename"])
+}
+
 func Test_generateParamsFromGitFileGoTemplate(t *testing.T) {
 	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
 foo:
   bar: baz
-`), true, "")
+`), true, "", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -123,7 +158,7 @@ func Test_generatePrefixedParamsFromGitFileGoTemplate(t *testing.T) {
 	params, err := (*GitGenerator)(nil).generateParamsFromGitFile("path/dir/file_name.yaml", []byte(`
 foo:
   bar: baz
-`), true, "myRepo")
+`), true, "myRepo", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -892,6 +927,47 @@ cluster:
 	}
 }
 
+func TestGitGenerateParamsFromFilesWithExclusions(t *testing.T) {
+	argoCDServiceMock := argoCDServiceMock{mock: &mock.Mock{}}
+	argoCDServiceMock.mock.On("GetFiles", mock.Anything, "RepoURL", "Revision", "**/config.yaml").
+		Return(map[string][]byte{
+			"cluster-config/production/config.yaml": []byte("cluster: production"),
+			"cluster-config/staging/config.yaml":    []byte("cluster: staging"),
+			"cluster-config/sandbox/config.yaml":    []byte("cluster: sandbox"),
+		}, nil)
+	argoCDServiceMock.mock.On("GetFiles", mock.Anything, "RepoURL", "Revision", "cluster-config/s*/config.yaml").
+		Return(map[string][]byte{
+			"cluster-config/staging/config.yaml": []byte("cluster: staging"),
+			"cluster-config/sandbox/config.yaml": []byte("cluster: sandbox"),
+		}, nil)
+
+	var gitGenerator = NewGitGenerator(argoCDServiceMock)
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+				Git: &argoprojiov1alpha1.GitGenerator{
+					RepoURL:  "RepoURL",
+					Revision: "Revision",
+					Files: []argoprojiov1alpha1.GitFileGeneratorItem{
+						{Path: "cluster-config/s*/config.yaml", Exclude: true},
+						{Path: "**/config.yaml"},
+					},
+				},
+			}},
+		},
+	}
+
+	got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo)
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "production", got[0]["cluster"])
+	}
+	argoCDServiceMock.mock.AssertExpectations(t)
+}
+
 func TestGitGenerateParamsFromFilesGoTemplate(t *testing.T) {
 
 	cases := []struct {
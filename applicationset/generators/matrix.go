@@ -1,10 +1,12 @@
 package generators
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/imdario/mergo"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -13,8 +15,7 @@ import (
 var _ Generator = (*MatrixGenerator)(nil)
 
 var (
-	ErrMoreThanTwoGenerators      = fmt.Errorf("found more than two generators, Matrix support only two")
-	ErrLessThanTwoGenerators      = fmt.Errorf("found less than two generators, Matrix support only two")
+	ErrLessThanTwoGenerators      = fmt.Errorf("found less than two generators, Matrix requires two or more")
 	ErrMoreThenOneInnerGenerators = fmt.Errorf("found more than one generator in matrix.Generators")
 )
 
@@ -27,6 +28,14 @@ func NewMatrixGenerator(supportedGenerators map[string]Generator) Generator {
 	m := &MatrixGenerator{
 		supportedGenerators: supportedGenerators,
 	}
+	if _, ok := supportedGenerators["Matrix"]; !ok {
+		// a nested matrix generator may itself combine nested matrix generators (matrix-of-matrix composition), which
+		// are evaluated by the same generator
+		m.supportedGenerators = map[string]Generator{"Matrix": m}
+		for name, g := range supportedGenerators {
+			m.supportedGenerators[name] = g
+		}
+	}
 	return m
 }
 
@@ -40,56 +49,61 @@ func (m *MatrixGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 		return nil, ErrLessThanTwoGenerators
 	}
 
-	if len(appSetGenerator.Matrix.Generators) > 2 {
-		return nil, ErrMoreThanTwoGenerators
-	}
-
-	res := []map[string]interface{}{}
-
-	g0, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil)
+	res, err := m.getParams(appSetGenerator.Matrix.Generators[0], appSet, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	for _, a := range g0 {
-		g1, err := m.getParams(appSetGenerator.Matrix.Generators[1], appSet, a)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get params for second generator in the matrix generator: %w", err)
-		}
-		for _, b := range g1 {
 
-			if appSet.Spec.GoTemplate {
-				tmp := map[string]interface{}{}
-				if err := mergo.Merge(&tmp, a); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the first generator in the matrix generator with temp map: %w", err)
-				}
-				if err := mergo.Merge(&tmp, b); err != nil {
-					return nil, fmt.Errorf("failed to merge params from the first generator in the matrix generator with the second: %w", err)
-				}
-				res = append(res, tmp)
-			} else {
-				val, err := utils.CombineStringMaps(a, b)
+	// Each child generator is evaluated with the params of the combinations of the previous ones, so that it can be
+	// templated from them. The children are evaluated lazily: a child generator is evaluated only once per distinct
+	// interpolated spec, and the remaining children are not evaluated at all once there is no combination left.
+	for i := 1; i < len(appSetGenerator.Matrix.Generators) && len(res) > 0; i++ {
+		cache := map[string][]map[string]interface{}{}
+		combinations := []map[string]interface{}{}
+		for _, a := range res {
+			children, err := m.getParams(appSetGenerator.Matrix.Generators[i], appSet, a, cache)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get params for generator %d in the matrix generator: %w", i, err)
+			}
+			for _, b := range children {
+				combination, err := combineParams(a, b, appSet.Spec.GoTemplate)
 				if err != nil {
-					return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+					return nil, err
 				}
-				res = append(res, utils.ConvertToMapStringInterface(val))
+				combinations = append(combinations, combination)
 			}
 		}
+		res = combinations
 	}
 
 	return res, nil
 }
 
-func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]interface{}) ([]map[string]interface{}, error) {
-	var matrix *argoprojiov1alpha1.MatrixGenerator
-	if appSetBaseGenerator.Matrix != nil {
-		// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMatrix, err := argoprojiov1alpha1.ToNestedMatrixGenerator(appSetBaseGenerator.Matrix)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshall nested matrix generator: %v", err)
+func combineParams(a map[string]interface{}, b map[string]interface{}, useGoTemplate bool) (map[string]interface{}, error) {
+	if useGoTemplate {
+		tmp := map[string]interface{}{}
+		if err := mergo.Merge(&tmp, a); err != nil {
+			return nil, fmt.Errorf("failed to merge params from the previous generators in the matrix generator with temp map: %w", err)
 		}
-		if nestedMatrix != nil {
-			matrix = nestedMatrix.ToMatrixGenerator()
+		if err := mergo.Merge(&tmp, b); err != nil {
+			return nil, fmt.Errorf("failed to merge params from the previous generators in the matrix generator with the next one: %w", err)
 		}
+		return tmp, nil
+	}
+	val, err := utils.CombineStringMaps(a, b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to combine string maps with merging params for the matrix generator: %w", err)
+	}
+	return utils.ConvertToMapStringInterface(val), nil
+}
+
+// getParams returns the params of the given child generator, interpolated with the given params. If a cache is given,
+// the params are cached per interpolated child generator, so that a child generator which does not depend on the
+// given params is evaluated only once.
+func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.ApplicationSetNestedGenerator, appSet *argoprojiov1alpha1.ApplicationSet, params map[string]interface{}, cache map[string][]map[string]interface{}) ([]map[string]interface{}, error) {
+	matrix, err := getMatrixGenerator(appSetBaseGenerator)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshall nested matrix generator: %v", err)
 	}
 
 	var mergeGenerator *argoprojiov1alpha1.MergeGenerator
@@ -104,18 +118,40 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 		}
 	}
 
+	generator := argoprojiov1alpha1.ApplicationSetGenerator{
+		List:                    appSetBaseGenerator.List,
+		Clusters:                appSetBaseGenerator.Clusters,
+		Git:                     appSetBaseGenerator.Git,
+		SCMProvider:             appSetBaseGenerator.SCMProvider,
+		ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
+		PullRequest:             appSetBaseGenerator.PullRequest,
+		Matrix:                  matrix,
+		Merge:                   mergeGenerator,
+		Selector:                appSetBaseGenerator.Selector,
+	}
+
+	var cacheKey string
+	if cache != nil {
+		interpolatedGenerator := generator
+		if len(params) != 0 {
+			var err error
+			interpolatedGenerator, err = InterpolateGenerator(&generator, params, appSet.Spec.GoTemplate)
+			if err != nil {
+				return nil, fmt.Errorf("failed to interpolate child generator: %w", err)
+			}
+		}
+		key, err := json.Marshal(interpolatedGenerator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal child generator: %w", err)
+		}
+		cacheKey = string(key)
+		if cached, ok := cache[cacheKey]; ok {
+			return cached, nil
+		}
+	}
+
 	t, err := Transform(
-		argoprojiov1alpha1.ApplicationSetGenerator{
-			List:                    appSetBaseGenerator.List,
-			Clusters:                appSetBaseGenerator.Clusters,
-			Git:                     appSetBaseGenerator.Git,
-			SCMProvider:             appSetBaseGenerator.SCMProvider,
-			ClusterDecisionResource: appSetBaseGenerator.ClusterDecisionResource,
-			PullRequest:             appSetBaseGenerator.PullRequest,
-			Matrix:                  matrix,
-			Merge:                   mergeGenerator,
-			Selector:                appSetBaseGenerator.Selector,
-		},
+		generator,
 		m.supportedGenerators,
 		argoprojiov1alpha1.ApplicationSetTemplate{},
 		appSet,
@@ -133,9 +169,25 @@ func (m *MatrixGenerator) getParams(appSetBaseGenerator argoprojiov1alpha1.Appli
 		return nil, ErrMoreThenOneInnerGenerators
 	}
 
+	if cache != nil {
+		cache[cacheKey] = t[0].Params
+	}
 	return t[0].Params, nil
 }
 
+// getMatrixGenerator returns the nested matrix generator of the given generator, if any
+func getMatrixGenerator(r argoprojiov1alpha1.ApplicationSetNestedGenerator) (*argoprojiov1alpha1.MatrixGenerator, error) {
+	if r.Matrix == nil {
+		return nil, nil
+	}
+	// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
+	nestedMatrix, err := argoprojiov1alpha1.ToNestedMatrixGenerator(r.Matrix)
+	if err != nil {
+		return nil, err
+	}
+	return nestedMatrix.ToMatrixGenerator(), nil
+}
+
 const maxDuration time.Duration = 1<<63 - 1
 
 func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
@@ -143,11 +195,18 @@ func (m *MatrixGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.Ap
 	var found bool
 
 	for _, r := range appSetGenerator.Matrix.Generators {
+		matrix, err := getMatrixGenerator(r)
+		if err != nil {
+			log.Warnf("unable to unmarshall nested matrix generator: %v", err)
+		}
 		base := &argoprojiov1alpha1.ApplicationSetGenerator{
-			List:        r.List,
-			Clusters:    r.Clusters,
-			Git:         r.Git,
-			PullRequest: r.PullRequest,
+			List:                    r.List,
+			Clusters:                r.Clusters,
+			Git:                     r.Git,
+			SCMProvider:             r.SCMProvider,
+			ClusterDecisionResource: r.ClusterDecisionResource,
+			PullRequest:             r.PullRequest,
+			Matrix:                  matrix,
 		}
 		generators := GetRelevantGenerators(base, m.supportedGenerators)
 
@@ -89,19 +89,38 @@ func TestMatrixGenerate(t *testing.T) {
 			expectedErr: ErrLessThanTwoGenerators,
 		},
 		{
-			name: "returns error if there is more than two base generators",
+			name: "happy flow - generate params from three lists",
 			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
 				{
-					List: listGenerator,
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"a": "1"}`)},
+							{Raw: []byte(`{"a": "2"}`)},
+						},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"b": "1"}`)},
+						},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"c": "1"}`)},
+							{Raw: []byte(`{"c": "2"}`)},
+						},
+					},
 				},
 			},
-			expectedErr: ErrMoreThanTwoGenerators,
+			expected: []map[string]interface{}{
+				{"a": "1", "b": "1", "c": "1"},
+				{"a": "1", "b": "1", "c": "2"},
+				{"a": "2", "b": "1", "c": "1"},
+				{"a": "2", "b": "1", "c": "2"},
+			},
 		},
 		{
 			name: "returns error if there is more than one inner generator in the first base generator",
@@ -278,19 +297,38 @@ func TestMatrixGenerateGoTemplate(t *testing.T) {
 			expectedErr: ErrLessThanTwoGenerators,
 		},
 		{
-			name: "returns error if there is more than two base generators",
+			name: "happy flow - generate params from three lists",
 			baseGenerators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
 				{
-					List: listGenerator,
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"a": "1"}`)},
+							{Raw: []byte(`{"a": "2"}`)},
+						},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"b": "1"}`)},
+						},
+					},
 				},
 				{
-					List: listGenerator,
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"c": "1"}`)},
+							{Raw: []byte(`{"c": "2"}`)},
+						},
+					},
 				},
 			},
-			expectedErr: ErrMoreThanTwoGenerators,
+			expected: []map[string]interface{}{
+				{"a": "1", "b": "1", "c": "1"},
+				{"a": "1", "b": "1", "c": "2"},
+				{"a": "2", "b": "1", "c": "1"},
+				{"a": "2", "b": "1", "c": "2"},
+			},
 		},
 		{
 			name: "returns error if there is more than one inner generator in the first base generator",
@@ -835,6 +873,141 @@ func TestInterpolatedMatrixGenerateGoTemplate(t *testing.T) {
 	}
 }
 
+func TestMatrixGenerateEvaluatesChildGeneratorsOnce(t *testing.T) {
+	testCases := []struct {
+		name          string
+		gitGenerator  *argoprojiov1alpha1.GitGenerator
+		expectedCalls int
+	}{
+		{
+			name:          "child generator which does not depend on the params",
+			gitGenerator:  &argoprojiov1alpha1.GitGenerator{RepoURL: "RepoURL"},
+			expectedCalls: 1,
+		},
+		{
+			name:          "child generator which depends on the params",
+			gitGenerator:  &argoprojiov1alpha1.GitGenerator{RepoURL: "{{env}}"},
+			expectedCalls: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCaseCopy := testCase // Since tests may run in parallel
+
+		t.Run(testCaseCopy.name, func(t *testing.T) {
+			genMock := &generatorMock{}
+			appSet := &argoprojiov1alpha1.ApplicationSet{}
+			genMock.On("GenerateParams", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator"), appSet).Return([]map[string]interface{}{{"path": "app"}}, nil)
+			genMock.On("GetTemplate", mock.AnythingOfType("*v1alpha1.ApplicationSetGenerator")).
+				Return(&argoprojiov1alpha1.ApplicationSetTemplate{})
+
+			var matrixGenerator = NewMatrixGenerator(
+				map[string]Generator{
+					"Git":  genMock,
+					"List": &ListGenerator{},
+				},
+			)
+
+			got, err := matrixGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				Matrix: &argoprojiov1alpha1.MatrixGenerator{
+					Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+						{
+							List: &argoprojiov1alpha1.ListGenerator{
+								Elements: []apiextensionsv1.JSON{
+									{Raw: []byte(`{"env": "dev", "cluster": "a"}`)},
+									{Raw: []byte(`{"env": "dev", "cluster": "b"}`)},
+									{Raw: []byte(`{"env": "prod", "cluster": "c"}`)},
+								},
+							},
+						},
+						{
+							Git: testCaseCopy.gitGenerator,
+						},
+					},
+				},
+			}, appSet)
+
+			assert.NoError(t, err)
+			assert.Len(t, got, 3)
+			genMock.AssertNumberOfCalls(t, "GenerateParams", testCaseCopy.expectedCalls)
+		})
+	}
+}
+
+func TestMatrixGenerateSkipsChildGeneratorsWithoutCombinations(t *testing.T) {
+	genMock := &generatorMock{}
+	appSet := &argoprojiov1alpha1.ApplicationSet{}
+
+	var matrixGenerator = NewMatrixGenerator(
+		map[string]Generator{
+			"Git":  genMock,
+			"List": &ListGenerator{},
+		},
+	)
+
+	got, err := matrixGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Matrix: &argoprojiov1alpha1.MatrixGenerator{
+			Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &argoprojiov1alpha1.ListGenerator{},
+				},
+				{
+					Git: &argoprojiov1alpha1.GitGenerator{RepoURL: "RepoURL"},
+				},
+			},
+		},
+	}, appSet)
+
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+	genMock.AssertNotCalled(t, "GenerateParams", mock.Anything, mock.Anything)
+}
+
+func TestMatrixGenerateNestedMatrices(t *testing.T) {
+	appSet := &argoprojiov1alpha1.ApplicationSet{}
+	var matrixGenerator = NewMatrixGenerator(
+		map[string]Generator{
+			"List": &ListGenerator{},
+		},
+	)
+
+	got, err := matrixGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		Matrix: &argoprojiov1alpha1.MatrixGenerator{
+			Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+				{
+					List: &argoprojiov1alpha1.ListGenerator{
+						Elements: []apiextensionsv1.JSON{
+							{Raw: []byte(`{"cluster": "a"}`)},
+							{Raw: []byte(`{"cluster": "b"}`)},
+						},
+					},
+				},
+				{
+					Matrix: &apiextensionsv1.JSON{Raw: []byte(`{
+						"generators": [
+							{"list": {"elements": [{"region": "eu"}]}},
+							{"matrix": {
+								"generators": [
+									{"list": {"elements": [{"team": "x"}, {"team": "y"}]}},
+									{"list": {"elements": [{"tier": "1"}]}}
+								]
+							}}
+						]
+					}`)},
+				},
+			},
+		},
+	}, appSet)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"cluster": "a", "region": "eu", "team": "x", "tier": "1"},
+		{"cluster": "a", "region": "eu", "team": "y", "tier": "1"},
+		{"cluster": "b", "region": "eu", "team": "x", "tier": "1"},
+		{"cluster": "b", "region": "eu", "team": "y", "tier": "1"},
+	}, got)
+}
+
 type generatorMock struct {
 	mock.Mock
 }
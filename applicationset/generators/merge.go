@@ -29,6 +29,13 @@ func NewMergeGenerator(supportedGenerators map[string]Generator) Generator {
 	m := &MergeGenerator{
 		supportedGenerators: supportedGenerators,
 	}
+	if _, ok := supportedGenerators["Matrix"]; !ok {
+		// a nested merge generator may combine nested matrix generators
+		m.supportedGenerators = map[string]Generator{"Matrix": NewMatrixGenerator(supportedGenerators)}
+		for name, g := range supportedGenerators {
+			m.supportedGenerators[name] = g
+		}
+	}
 	return m
 }
 
@@ -162,6 +162,8 @@ func (g *AzureDevOpsProvider) GetBranches(ctx context.Context, repo *Repository)
 			URL:          repo.URL,
 			Labels:       []string{},
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 
 		return repos, nil
@@ -191,6 +193,8 @@ func (g *AzureDevOpsProvider) GetBranches(ctx context.Context, repo *Repository)
 			URL:          repo.URL,
 			Labels:       []string{},
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 	}
 
@@ -82,6 +82,8 @@ func (g *BitBucketCloudProvider) GetBranches(ctx context.Context, repo *Reposito
 			SHA:          hash,
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 	}
 	return repos, nil
@@ -112,6 +114,7 @@ func (g *BitBucketCloudProvider) ListRepos(ctx context.Context, cloneProtocol st
 			URL:          *cloneUrl,
 			Labels:       []string{},
 			RepositoryId: bitBucketRepo.Uuid,
+			Language:     bitBucketRepo.Language,
 		})
 	}
 	return repos, nil
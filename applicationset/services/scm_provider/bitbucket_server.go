@@ -137,6 +137,8 @@ func (b *BitbucketServerProvider) GetBranches(_ context.Context, repo *Repositor
 			SHA:          branch.LatestCommit,
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 	}
 	return repos, nil
@@ -62,6 +62,8 @@ func (g *GiteaProvider) GetBranches(ctx context.Context, repo *Repository) ([]*R
 				SHA:          branch.Commit.ID,
 				Labels:       repo.Labels,
 				RepositoryId: repo.RepositoryId,
+				Language:     repo.Language,
+				Archived:     repo.Archived,
 			},
 		}, nil
 	}
@@ -80,6 +82,8 @@ func (g *GiteaProvider) GetBranches(ctx context.Context, repo *Repository) ([]*R
 			SHA:          branch.Commit.ID,
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 	}
 	return repos, nil
@@ -119,6 +123,7 @@ func (g *GiteaProvider) ListRepos(ctx context.Context, cloneProtocol string) ([]
 			URL:          url,
 			Labels:       labels,
 			RepositoryId: int(repo.ID),
+			Archived:     repo.Archived,
 		})
 	}
 	return repos, nil
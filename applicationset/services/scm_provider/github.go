@@ -59,6 +59,8 @@ func (g *GithubProvider) GetBranches(ctx context.Context, repo *Repository) ([]*
 			SHA:          branch.GetCommit().GetSHA(),
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 	}
 	return repos, nil
@@ -92,6 +94,8 @@ func (g *GithubProvider) ListRepos(ctx context.Context, cloneProtocol string) ([
 				URL:          url,
 				Labels:       githubRepo.Topics,
 				RepositoryId: githubRepo.ID,
+				Language:     githubRepo.GetLanguage(),
+				Archived:     githubRepo.GetArchived(),
 			})
 		}
 		if resp.NextPage == 0 {
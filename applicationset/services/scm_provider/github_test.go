@@ -90,7 +90,7 @@ func githubMockHandler(t *testing.T) func(http.ResponseWriter, *http.Request) {
 				  "hooks_url": "https://api.github.com/repos/argoproj/argo-cd/hooks",
 				  "svn_url": "https://svn.github.com/argoproj/argo-cd",
 				  "homepage": "https://github.com",
-				  "language": null,
+				  "language": "Go",
 				  "forks_count": 9,
 				  "stargazers_count": 80,
 				  "watchers_count": 80,
@@ -234,6 +234,17 @@ func TestGithubListRepos(t *testing.T) {
 			url:         "git@github.com:argoproj/argo-cd.git",
 			branches:    []string{"master"},
 		},
+		{
+			name:     "language and archived filters",
+			url:      "git@github.com:argoproj/argo-cd.git",
+			branches: []string{"master"},
+			filters: []v1alpha1.SCMProviderGeneratorFilter{
+				{
+					Languages: []string{"go"},
+					Archived:  boolp(false),
+				},
+			},
+		},
 	}
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		githubMockHandler(t)(w, r)
@@ -258,6 +269,8 @@ func TestGithubListRepos(t *testing.T) {
 				}
 				assert.NotEmpty(t, repos)
 				assert.Equal(t, c.url, repos[0].URL)
+				assert.Equal(t, "Go", repos[0].Language)
+				assert.False(t, repos[0].Archived)
 				for _, b := range c.branches {
 					assert.Contains(t, branches, b)
 				}
@@ -56,6 +56,8 @@ func (g *GitlabProvider) GetBranches(ctx context.Context, repo *Repository) ([]*
 			SHA:          branch.Commit.ID,
 			Labels:       repo.Labels,
 			RepositoryId: repo.RepositoryId,
+			Language:     repo.Language,
+			Archived:     repo.Archived,
 		})
 	}
 	return repos, nil
@@ -91,6 +93,7 @@ func (g *GitlabProvider) ListRepos(ctx context.Context, cloneProtocol string) ([
 				Branch:       gitlabRepo.DefaultBranch,
 				Labels:       gitlabRepo.TagList,
 				RepositoryId: gitlabRepo.ID,
+				Archived:     gitlabRepo.Archived,
 			})
 		}
 		if resp.CurrentPage >= resp.TotalPages {
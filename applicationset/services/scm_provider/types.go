@@ -14,6 +14,9 @@ type Repository struct {
 	SHA          string
 	Labels       []string
 	RepositoryId interface{}
+	// Language is the primary language of the repository, if known
+	Language string
+	Archived bool
 }
 
 type SCMProviderService interface {
@@ -29,6 +32,9 @@ type Filter struct {
 	PathsDoNotExist []string
 	LabelMatch      *regexp.Regexp
 	BranchMatch     *regexp.Regexp
+	Topics          []string
+	Languages       []string
+	Archived        *bool
 	FilterType      FilterType
 }
 
@@ -28,6 +28,18 @@ func compileFilters(filters []argoprojiov1alpha1.SCMProviderGeneratorFilter) ([]
 			}
 			outFilter.FilterType = FilterTypeRepo
 		}
+		if filter.Topics != nil {
+			outFilter.Topics = filter.Topics
+			outFilter.FilterType = FilterTypeRepo
+		}
+		if filter.Languages != nil {
+			outFilter.Languages = filter.Languages
+			outFilter.FilterType = FilterTypeRepo
+		}
+		if filter.Archived != nil {
+			outFilter.Archived = filter.Archived
+			outFilter.FilterType = FilterTypeRepo
+		}
 		if filter.PathsExist != nil {
 			outFilter.PathsExist = filter.PathsExist
 			outFilter.FilterType = FilterTypeBranch
@@ -70,6 +82,36 @@ func matchFilter(ctx context.Context, provider SCMProviderService, repo *Reposit
 		}
 	}
 
+	for _, topic := range filter.Topics {
+		found := false
+		for _, label := range repo.Labels {
+			if strings.EqualFold(label, topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if len(filter.Languages) != 0 {
+		found := false
+		for _, language := range filter.Languages {
+			if strings.EqualFold(repo.Language, language) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if filter.Archived != nil && *filter.Archived != repo.Archived {
+		return false, nil
+	}
+
 	if len(filter.PathsExist) != 0 {
 		for _, path := range filter.PathsExist {
 			path = strings.TrimRight(path, "/")
@@ -14,6 +14,10 @@ func strp(s string) *string {
 	return &s
 }
 
+func boolp(b bool) *bool {
+	return &b
+}
+
 func TestFilterRepoMatch(t *testing.T) {
 	provider := &MockProvider{
 		Repos: []*Repository{
@@ -72,6 +76,95 @@ func TestFilterLabelMatch(t *testing.T) {
 	assert.Equal(t, "two", repos[1].Repository)
 }
 
+func TestFilterTopics(t *testing.T) {
+	provider := &MockProvider{
+		Repos: []*Repository{
+			{
+				Repository: "one",
+				Labels:     []string{"argocd", "Prod"},
+			},
+			{
+				Repository: "two",
+				Labels:     []string{"argocd"},
+			},
+			{
+				Repository: "three",
+				Labels:     []string{"prod"},
+			},
+		},
+	}
+	filters := []argoprojiov1alpha1.SCMProviderGeneratorFilter{
+		{
+			Topics: []string{"argocd", "prod"},
+		},
+	}
+	repos, err := ListRepos(context.Background(), provider, filters, "")
+	assert.Nil(t, err)
+	assert.Len(t, repos, 1)
+	assert.Equal(t, "one", repos[0].Repository)
+}
+
+func TestFilterLanguages(t *testing.T) {
+	provider := &MockProvider{
+		Repos: []*Repository{
+			{
+				Repository: "one",
+				Language:   "Go",
+			},
+			{
+				Repository: "two",
+				Language:   "Python",
+			},
+			{
+				Repository: "three",
+				Language:   "TypeScript",
+			},
+			{
+				Repository: "four",
+			},
+		},
+	}
+	filters := []argoprojiov1alpha1.SCMProviderGeneratorFilter{
+		{
+			Languages: []string{"go", "typescript"},
+		},
+	}
+	repos, err := ListRepos(context.Background(), provider, filters, "")
+	assert.Nil(t, err)
+	assert.Len(t, repos, 2)
+	assert.Equal(t, "one", repos[0].Repository)
+	assert.Equal(t, "three", repos[1].Repository)
+}
+
+func TestFilterArchived(t *testing.T) {
+	provider := &MockProvider{
+		Repos: []*Repository{
+			{
+				Repository: "one",
+			},
+			{
+				Repository: "two",
+				Archived:   true,
+			},
+		},
+	}
+	filters := []argoprojiov1alpha1.SCMProviderGeneratorFilter{
+		{
+			Archived: boolp(false),
+		},
+	}
+	repos, err := ListRepos(context.Background(), provider, filters, "")
+	assert.Nil(t, err)
+	assert.Len(t, repos, 1)
+	assert.Equal(t, "one", repos[0].Repository)
+
+	filters[0].Archived = boolp(true)
+	repos, err = ListRepos(context.Background(), provider, filters, "")
+	assert.Nil(t, err)
+	assert.Len(t, repos, 1)
+	assert.Equal(t, "two", repos[0].Repository)
+}
+
 func TestFilterPathExists(t *testing.T) {
 	provider := &MockProvider{
 		Repos: []*Repository{
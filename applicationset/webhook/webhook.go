@@ -363,143 +363,105 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 	}
 
 	// Silently ignore, the ApplicationSetReconciler will log the error as part of the reconcile
-	if len(gen.Generators) < 2 || len(gen.Generators) > 2 {
+	if len(gen.Generators) < 2 {
 		return false
 	}
 
-	g0 := gen.Generators[0]
-
-	// Check first child generator for Git or Pull Request Generator
-	if shouldRefreshGitGenerator(g0.Git, gitGenInfo) ||
-		shouldRefreshPRGenerator(g0.PullRequest, prGenInfo) {
-		return true
-	}
-
-	// Check first child generator for nested Matrix generator
-	var matrixGenerator0 *v1alpha1.MatrixGenerator
-	if g0.Matrix != nil {
-		// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMatrix, err := v1alpha1.ToNestedMatrixGenerator(g0.Matrix)
+	// params holds the params generated by the child generators preceding the current one
+	var params []map[string]interface{}
+	for i, g := range gen.Generators {
+		// Create ApplicationSetGenerator for the child generator from its ApplicationSetNestedGenerator
+		requestedGenerator, err := toApplicationSetGenerator(g)
 		if err != nil {
-			log.Errorf("Failed to unmarshall nested matrix generator: %v", err)
+			log.Error(err)
 			return false
 		}
-		if nestedMatrix != nil {
-			matrixGenerator0 = nestedMatrix.ToMatrixGenerator()
-			if h.shouldRefreshMatrixGenerator(matrixGenerator0, appSet, gitGenInfo, prGenInfo) {
-				return true
+
+		// Interpolate the child generator with params from the preceding child generators, if there are any params
+		for _, p := range params {
+			interpolatedGenerator, err := generators.InterpolateGenerator(requestedGenerator, p, appSet.Spec.GoTemplate)
+			if err != nil {
+				log.Error(err)
+				return false
 			}
-		}
-	}
 
-	// Check first child generator for nested Merge generator
-	var mergeGenerator0 *v1alpha1.MergeGenerator
-	if g0.Merge != nil {
-		// Since nested merge generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMerge, err := v1alpha1.ToNestedMergeGenerator(g0.Merge)
-		if err != nil {
-			log.Errorf("Failed to unmarshall nested merge generator: %v", err)
-			return false
-		}
-		if nestedMerge != nil {
-			mergeGenerator0 = nestedMerge.ToMergeGenerator()
-			if h.shouldRefreshMergeGenerator(mergeGenerator0, appSet, gitGenInfo, prGenInfo) {
+			// Check all interpolated child generators
+			if h.shouldRefreshChildGenerator(&interpolatedGenerator, appSet, gitGenInfo, prGenInfo) {
 				return true
 			}
 		}
-	}
 
-	// Create ApplicationSetGenerator for first child generator from its ApplicationSetNestedGenerator
-	requestedGenerator0 := &v1alpha1.ApplicationSetGenerator{
-		List:                    g0.List,
-		Clusters:                g0.Clusters,
-		Git:                     g0.Git,
-		SCMProvider:             g0.SCMProvider,
-		ClusterDecisionResource: g0.ClusterDecisionResource,
-		PullRequest:             g0.PullRequest,
-		Matrix:                  matrixGenerator0,
-		Merge:                   mergeGenerator0,
-	}
-
-	// Generate params for first child generator
-	relGenerators := generators.GetRelevantGenerators(requestedGenerator0, h.generators)
-	params := []map[string]interface{}{}
-	for _, g := range relGenerators {
-		p, err := g.GenerateParams(requestedGenerator0, appSet)
-		if err != nil {
-			log.Error(err)
-			return false
+		// The preceding child generators didn't return any params, just check the child generator
+		if len(params) == 0 && h.shouldRefreshChildGenerator(requestedGenerator, appSet, gitGenInfo, prGenInfo) {
+			return true
 		}
-		params = append(params, p...)
-	}
-
-	g1 := gen.Generators[1]
 
-	// Create Matrix generator for nested Matrix generator as second child generator
-	var matrixGenerator1 *v1alpha1.MatrixGenerator
-	if g1.Matrix != nil {
-		// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMatrix, err := v1alpha1.ToNestedMatrixGenerator(g1.Matrix)
-		if err != nil {
-			log.Errorf("Failed to unmarshall nested matrix generator: %v", err)
-			return false
-		}
-		if nestedMatrix != nil {
-			matrixGenerator1 = nestedMatrix.ToMatrixGenerator()
+		if i == len(gen.Generators)-1 {
+			break
 		}
-	}
 
-	// Create Merge generator for nested Merge generator as second child generator
-	var mergeGenerator1 *v1alpha1.MergeGenerator
-	if g1.Merge != nil {
-		// Since nested merge generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
-		nestedMerge, err := v1alpha1.ToNestedMergeGenerator(g1.Merge)
-		if err != nil {
-			log.Errorf("Failed to unmarshall nested merge generator: %v", err)
-			return false
-		}
-		if nestedMerge != nil {
-			mergeGenerator1 = nestedMerge.ToMergeGenerator()
+		// Generate params for the child generators so far, with which the next child generator is interpolated
+		paramsGenerator := requestedGenerator
+		if i > 0 {
+			paramsGenerator = &v1alpha1.ApplicationSetGenerator{
+				Matrix: &v1alpha1.MatrixGenerator{Generators: gen.Generators[:i+1]},
+			}
 		}
-	}
-
-	// Create ApplicationSetGenerator for second child generator from its ApplicationSetNestedGenerator
-	requestedGenerator1 := &v1alpha1.ApplicationSetGenerator{
-		List:                    g1.List,
-		Clusters:                g1.Clusters,
-		Git:                     g1.Git,
-		SCMProvider:             g1.SCMProvider,
-		ClusterDecisionResource: g1.ClusterDecisionResource,
-		PullRequest:             g1.PullRequest,
-		Matrix:                  matrixGenerator1,
-		Merge:                   mergeGenerator1,
-	}
-
-	// Interpolate second child generator with params from first child generator, if there are any params
-	if len(params) != 0 {
-		for _, p := range params {
-			tempInterpolatedGenerator, err := generators.InterpolateGenerator(requestedGenerator1, p, appSet.Spec.GoTemplate)
-			interpolatedGenerator := &tempInterpolatedGenerator
+		params = []map[string]interface{}{}
+		for _, relGenerator := range generators.GetRelevantGenerators(paramsGenerator, h.generators) {
+			p, err := relGenerator.GenerateParams(paramsGenerator, appSet)
 			if err != nil {
 				log.Error(err)
 				return false
 			}
-
-			// Check all interpolated child generators
-			if shouldRefreshGitGenerator(interpolatedGenerator.Git, gitGenInfo) ||
-				shouldRefreshPRGenerator(interpolatedGenerator.PullRequest, prGenInfo) ||
-				h.shouldRefreshMatrixGenerator(interpolatedGenerator.Matrix, appSet, gitGenInfo, prGenInfo) ||
-				h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo) {
-				return true
-			}
+			params = append(params, p...)
 		}
 	}
 
-	// First child generator didn't return any params, just check the second child generator
-	return shouldRefreshGitGenerator(requestedGenerator1.Git, gitGenInfo) ||
-		shouldRefreshPRGenerator(requestedGenerator1.PullRequest, prGenInfo) ||
-		h.shouldRefreshMatrixGenerator(requestedGenerator1.Matrix, appSet, gitGenInfo, prGenInfo) ||
-		h.shouldRefreshMergeGenerator(requestedGenerator1.Merge, appSet, gitGenInfo, prGenInfo)
+	return false
+}
+
+// shouldRefreshChildGenerator checks the child generator of a combination-type generator for Git, Pull Request and
+// nested Matrix and Merge generators
+func (h *WebhookHandler) shouldRefreshChildGenerator(gen *v1alpha1.ApplicationSetGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
+	return shouldRefreshGitGenerator(gen.Git, gitGenInfo) ||
+		shouldRefreshPRGenerator(gen.PullRequest, prGenInfo) ||
+		h.shouldRefreshMatrixGenerator(gen.Matrix, appSet, gitGenInfo, prGenInfo) ||
+		h.shouldRefreshMergeGenerator(gen.Merge, appSet, gitGenInfo, prGenInfo)
+}
+
+// toApplicationSetGenerator converts the child generator of a combination-type generator to an ApplicationSetGenerator
+func toApplicationSetGenerator(g v1alpha1.ApplicationSetNestedGenerator) (*v1alpha1.ApplicationSetGenerator, error) {
+	// Since nested matrix generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
+	var matrixGenerator *v1alpha1.MatrixGenerator
+	nestedMatrix, err := v1alpha1.ToNestedMatrixGenerator(g.Matrix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshall nested matrix generator: %v", err)
+	}
+	if nestedMatrix != nil {
+		matrixGenerator = nestedMatrix.ToMatrixGenerator()
+	}
+
+	// Since nested merge generator is represented as a JSON object in the CRD, we unmarshall it back to a Go struct here.
+	var mergeGenerator *v1alpha1.MergeGenerator
+	nestedMerge, err := v1alpha1.ToNestedMergeGenerator(g.Merge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshall nested merge generator: %v", err)
+	}
+	if nestedMerge != nil {
+		mergeGenerator = nestedMerge.ToMergeGenerator()
+	}
+
+	return &v1alpha1.ApplicationSetGenerator{
+		List:                    g.List,
+		Clusters:                g.Clusters,
+		Git:                     g.Git,
+		SCMProvider:             g.SCMProvider,
+		ClusterDecisionResource: g.ClusterDecisionResource,
+		PullRequest:             g.PullRequest,
+		Matrix:                  matrixGenerator,
+		Merge:                   mergeGenerator,
+	}, nil
 }
 
 func (h *WebhookHandler) shouldRefreshMergeGenerator(gen *v1alpha1.MergeGenerator, appSet *v1alpha1.ApplicationSet, gitGenInfo *gitGeneratorInfo, prGenInfo *prGeneratorInfo) bool {
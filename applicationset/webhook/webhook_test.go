@@ -62,7 +62,7 @@ func TestWebhookHandler(t *testing.T) {
 			headerKey:          "X-GitHub-Event",
 			headerValue:        "push",
 			payloadFile:        "github-commit-event.json",
-			effectedAppSets:    []string{"git-github", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "merge-nested-git-github"},
+			effectedAppSets:    []string{"git-github", "matrix-git-github", "merge-git-github", "matrix-scm-git-github", "matrix-nested-git-github", "matrix-three-git-github", "merge-nested-git-github"},
 			expectedStatusCode: http.StatusOK,
 			expectedRefresh:    true,
 		},
@@ -169,6 +169,7 @@ func TestWebhookHandler(t *testing.T) {
 				fakeAppWithMatrixAndScmWithGitGenerator("matrix-scm-git-github", namespace, "org"),
 				fakeAppWithMatrixAndScmWithPullRequestGenerator("matrix-scm-pull-request-github", namespace, "Codertocat"),
 				fakeAppWithMatrixAndNestedGitGenerator("matrix-nested-git-github", namespace, "https://github.com/org/repo"),
+				fakeAppWithMatrixOfThreeAndGitGenerator("matrix-three-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMergeAndGitGenerator("merge-git-github", namespace, "https://github.com/org/repo"),
 				fakeAppWithMergeAndPullRequestGenerator("merge-pull-request-github", namespace, "Codertocat", "Hello-World"),
 				fakeAppWithMergeAndNestedGitGenerator("merge-nested-git-github", namespace, "https://github.com/org/repo"),
@@ -457,6 +458,36 @@ func fakeAppWithMatrixAndScmWithPullRequestGenerator(name, namespace, owner stri
 	}
 }
 
+func fakeAppWithMatrixOfThreeAndGitGenerator(name, namespace, repo string) *argoprojiov1alpha1.ApplicationSet {
+	return &argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{
+				{
+					Matrix: &argoprojiov1alpha1.MatrixGenerator{
+						Generators: []argoprojiov1alpha1.ApplicationSetNestedGenerator{
+							{
+								List: &argoprojiov1alpha1.ListGenerator{},
+							},
+							{
+								List: &argoprojiov1alpha1.ListGenerator{},
+							},
+							{
+								Git: &argoprojiov1alpha1.GitGenerator{
+									RepoURL: repo,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func fakeAppWithMatrixAndNestedGitGenerator(name, namespace, repo string) *argoprojiov1alpha1.ApplicationSet {
 	return &argoprojiov1alpha1.ApplicationSet{
 		ObjectMeta: metav1.ObjectMeta{
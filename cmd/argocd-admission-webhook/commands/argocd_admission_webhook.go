@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/admission"
+	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/tls"
+)
+
+const (
+	// CLIName is the name of the CLI
+	cliName = "argocd-admission-webhook"
+)
+
+func NewCommand() *cobra.Command {
+	var (
+		clientConfig   clientcmd.ClientConfig
+		listenPort     int
+		tlsCertFile    string
+		tlsKeyFile     string
+		exemptSubjects []string
+	)
+	var command = cobra.Command{
+		Use:               cliName,
+		Short:             "Run ArgoCD Admission Webhook",
+		Long:              "ArgoCD admission webhook validates and normalizes the Applications and AppProjects created, updated or deleted directly through the Kubernetes API. It enforces the constraints of the projects and the Argo CD RBAC on the changes, which are otherwise only enforced by the Argo CD API server.",
+		DisableAutoGenTag: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			if len(exemptSubjects) == 0 {
+				exemptSubjects = admission.DefaultExemptSubjects(namespace)
+			}
+			vers := common.GetVersion()
+			vers.LogStartupInfo(
+				"ArgoCD Admission Webhook",
+				map[string]any{
+					"namespace":      namespace,
+					"port":           listenPort,
+					"exemptSubjects": exemptSubjects,
+				},
+			)
+
+			cli.SetLogFormat(cmdutil.LogFormat)
+			cli.SetLogLevel(cmdutil.LogLevel)
+
+			if tlsCertFile == "" || tlsKeyFile == "" {
+				return fmt.Errorf("the certificate and the key of the admission webhook must be specified")
+			}
+			tlsConfig, err := tls.CreateServerTLSConfig(tlsCertFile, tlsKeyFile, nil)
+			errors.CheckError(err)
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			errors.CheckError(v1alpha1.SetK8SConfigDefaults(config))
+			config.UserAgent = fmt.Sprintf("argocd-admission-webhook/%s (%s)", vers.Version, vers.Platform)
+
+			kubeClientset := kubernetes.NewForConfigOrDie(config)
+			appClientset := appclientset.NewForConfigOrDie(config)
+
+			ctx, stop := signal.NotifyContext(c.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, namespace)
+			argoDB := db.NewDB(namespace, settingsMgr, kubeClientset)
+
+			projFactory := appinformer.NewSharedInformerFactoryWithOptions(appClientset, 0, appinformer.WithNamespace(namespace), appinformer.WithTweakListOptions(func(options *metav1.ListOptions) {}))
+			projInformer := projFactory.Argoproj().V1alpha1().AppProjects().Informer()
+			projLister := projFactory.Argoproj().V1alpha1().AppProjects().Lister()
+			go projInformer.Run(ctx.Done())
+			if !cache.WaitForCacheSync(ctx.Done(), projInformer.HasSynced) {
+				return fmt.Errorf("timed out waiting for the project cache to sync")
+			}
+
+			enf := rbac.NewEnforcer(kubeClientset, namespace, common.GetArgoCDRBACConfigMapName(), nil)
+			errors.CheckError(enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+			enf.EnableLog(os.Getenv(common.EnvVarRBACDebug) == "1")
+			policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister.AppProjects(namespace))
+			enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+			go func() {
+				// the groups of the Kubernetes users are always matched against the default scopes
+				errors.CheckError(enf.RunPolicyLoader(ctx, func(cm *v1.ConfigMap) error {
+					return nil
+				}))
+			}()
+
+			server := admission.NewServer(namespace, appClientset, projLister, settingsMgr, argoDB, enf, exemptSubjects)
+			httpServer := &http.Server{
+				Addr:      fmt.Sprintf(":%d", listenPort),
+				Handler:   server.Handler(),
+				TLSConfig: tlsConfig,
+			}
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = httpServer.Shutdown(shutdownCtx)
+			}()
+			log.Infof("admission webhook listening on :%d", listenPort)
+			if err := httpServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+				return err
+			}
+			log.Info("Admission webhook stopped")
+			return nil
+		},
+	}
+
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().IntVar(&listenPort, "port", env.ParseNumFromEnv("ARGOCD_ADMISSION_WEBHOOK_LISTEN_PORT", common.DefaultPortArgoCDAdmissionWebhook, 0, 65535), "Listen on given port")
+	command.Flags().StringVar(&tlsCertFile, "tls-cert-file", env.StringFromEnv("ARGOCD_ADMISSION_WEBHOOK_TLS_CERT_FILE", ""), "Path of the TLS certificate served to the Kubernetes API server")
+	command.Flags().StringVar(&tlsKeyFile, "tls-key-file", env.StringFromEnv("ARGOCD_ADMISSION_WEBHOOK_TLS_KEY_FILE", ""), "Path of the private key of the TLS certificate")
+	command.Flags().StringSliceVar(&exemptSubjects, "exempt-subjects", env.StringsFromEnv("ARGOCD_ADMISSION_WEBHOOK_EXEMPT_SUBJECTS", []string{}, ","), "Glob patterns of the Kubernetes users skipping the RBAC enforcement. Defaults to the service accounts of Argo CD, of the namespace controller and of the garbage collector")
+	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", env.StringFromEnv("ARGOCD_ADMISSION_WEBHOOK_LOGFORMAT", "text"), "Set the logging format. One of: text|json")
+	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_ADMISSION_WEBHOOK_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
+	return &command
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/agent"
+	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+)
+
+const (
+	// CLIName is the name of the CLI
+	cliName = "argocd-agent"
+)
+
+func NewCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		serverAddr   string
+		name         string
+		token        string
+		insecure     bool
+		plainText    bool
+		certFile     string
+	)
+	var command = cobra.Command{
+		Use:               cliName,
+		Short:             "Run ArgoCD Agent",
+		Long:              "ArgoCD agent opens a tunnel to the API server of Argo CD, through which Argo CD manages the cluster the agent runs in without reaching it directly. The agent has to be registered first with the 'argocd cluster register-agent' command.",
+		DisableAutoGenTag: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			vers := common.GetVersion()
+			vers.LogStartupInfo(
+				"ArgoCD Agent",
+				map[string]any{
+					"name":   name,
+					"server": serverAddr,
+				},
+			)
+
+			cli.SetLogFormat(cmdutil.LogFormat)
+			cli.SetLogLevel(cmdutil.LogLevel)
+
+			if name == "" || token == "" {
+				return fmt.Errorf("the name and the token of the agent must be specified")
+			}
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			config.UserAgent = fmt.Sprintf("argocd-agent/%s (%s)", vers.Version, vers.Platform)
+
+			client, err := apiclient.NewClient(&apiclient.ClientOptions{
+				ServerAddr: serverAddr,
+				Insecure:   insecure,
+				PlainText:  plainText,
+				CertFile:   certFile,
+				UserAgent:  fmt.Sprintf("argocd-agent/%s", vers.Version),
+			})
+			errors.CheckError(err)
+
+			a, err := agent.NewAgent(name, token, client, config)
+			errors.CheckError(err)
+
+			ctx, stop := signal.NotifyContext(c.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			a.Run(ctx)
+			log.Info("Agent stopped")
+			return nil
+		},
+	}
+
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringVar(&serverAddr, "server", env.StringFromEnv("ARGOCD_AGENT_SERVER", ""), "Address of the Argo CD API server")
+	command.Flags().StringVar(&name, "name", env.StringFromEnv("ARGOCD_AGENT_NAME", ""), "Name the agent has been registered with")
+	command.Flags().StringVar(&token, "token", env.StringFromEnv("ARGOCD_AGENT_TOKEN", ""), "Token issued to the agent when it has been registered")
+	command.Flags().BoolVar(&insecure, "insecure", env.ParseBoolFromEnv("ARGOCD_AGENT_INSECURE", false), "Skip the verification of the certificate of the Argo CD API server")
+	command.Flags().BoolVar(&plainText, "plaintext", env.ParseBoolFromEnv("ARGOCD_AGENT_PLAINTEXT", false), "Connect to the Argo CD API server without TLS")
+	command.Flags().StringVar(&certFile, "server-crt", env.StringFromEnv("ARGOCD_AGENT_SERVER_CRT", ""), "Certificate of the Argo CD API server")
+	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", env.StringFromEnv("ARGOCD_AGENT_LOGFORMAT", "text"), "Set the logging format. One of: text|json")
+	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_AGENT_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
+	return &command
+}
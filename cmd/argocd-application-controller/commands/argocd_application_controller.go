@@ -4,28 +4,41 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
 	"github.com/go-redis/redis/v8"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/controller"
+	"github.com/argoproj/argo-cd/v2/controller/configsync"
+	"github.com/argoproj/argo-cd/v2/controller/registration"
 	"github.com/argoproj/argo-cd/v2/controller/sharding"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/customizations"
+	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
+	"github.com/argoproj/argo-cd/v2/util/migration"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 	"github.com/argoproj/argo-cd/v2/util/trace"
@@ -48,12 +61,21 @@ func NewCommand() *cobra.Command {
 		repoServerAddress        string
 		repoServerTimeoutSeconds int
 		selfHealTimeoutSeconds   int
+		operationStaleTimeout    time.Duration
+		statusWarmupDuration     time.Duration
+		adaptiveRefreshThreshold int
+		adaptiveRefreshMaxPeriod time.Duration
+		statusPatchStrategy      string
+		statusMaxSize            int
 		statusProcessors         int
 		operationProcessors      int
 		glogLevel                int
 		metricsPort              int
 		metricsCacheExpiration   time.Duration
 		metricsAplicationLabels  []string
+		metricsProjects          []string
+		metricsAuth              *metricsutil.AuthOptions
+		metricsDropLabels        *[]string
 		kubectlParallelismLimit  int64
 		cacheSrc                 func() (*appstatecache.Cache, error)
 		redisClient              *redis.Client
@@ -62,6 +84,26 @@ func NewCommand() *cobra.Command {
 		otlpAddress              string
 		applicationNamespaces    []string
 		persistResourceHealth    bool
+		enableDebugEndpoints     bool
+		configSyncRepo           string
+		configSyncPath           string
+		configSyncRevision       string
+		configSyncInterval       time.Duration
+		bundleSyncInterval       time.Duration
+		registrationInterval     time.Duration
+		registrationPolicy       registration.Policy
+		leaderElection           bool
+		dryRun                   bool
+		commitStatusReporting    bool
+		commitStatusHosts        []string
+		credentialExpiryWarning  time.Duration
+		credentialMaxAge         time.Duration
+		hardDependencies         []string
+		runMigrations            bool
+		appLabelSelector         string
+		appFieldSelector         string
+		refreshRateLimits        string
+		leaderElectionConfig     controller.LeaderElectionConfig
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -90,6 +132,13 @@ func NewCommand() *cobra.Command {
 			errors.CheckError(err)
 			errors.CheckError(v1alpha1.SetK8SConfigDefaults(config))
 			config.UserAgent = fmt.Sprintf("argocd-application-controller/%s (%s)", vers.Version, vers.Platform)
+			if dryRun {
+				if leaderElection {
+					return fmt.Errorf("dry run cannot be combined with leader election")
+				}
+				log.Warn("Running in dry run mode: applications are reconciled without persisting any change and without syncing")
+				kubeutil.AddDryRunWrapper(config)
+			}
 
 			kubeClient := kubernetes.NewForConfigOrDie(config)
 			appClient := appclientset.NewForConfigOrDie(config)
@@ -134,7 +183,8 @@ func NewCommand() *cobra.Command {
 				appController.InvalidateProjectsCache()
 			}))
 			kubectl := kubeutil.NewKubectl()
-			clusterFilter := getClusterFilter()
+			replicas, shard := getShard(leaderElection)
+			clusterFilter := getClusterFilter(replicas, shard)
 			appController, err = controller.NewApplicationController(
 				namespace,
 				settingsMgr,
@@ -146,6 +196,7 @@ func NewCommand() *cobra.Command {
 				resyncDuration,
 				hardResyncDuration,
 				time.Duration(selfHealTimeoutSeconds)*time.Second,
+				operationStaleTimeout,
 				metricsPort,
 				metricsCacheExpiration,
 				metricsAplicationLabels,
@@ -155,6 +206,47 @@ func NewCommand() *cobra.Command {
 				applicationNamespaces)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer())
+			errors.CheckError(appController.GetMetricsServer().SetAuth(metricsAuth))
+			appController.GetMetricsServer().SetProjects(metricsProjects)
+			dropLabels, err := metricsutil.ParseLabelRules(*metricsDropLabels)
+			errors.CheckError(err)
+			appController.GetMetricsServer().SetDropLabels(dropLabels)
+			if enableDebugEndpoints {
+				appController.EnableDebugEndpoints()
+			}
+			appController.SetStatusWarmupDuration(statusWarmupDuration)
+			appController.SetAdaptiveRefresh(adaptiveRefreshThreshold, adaptiveRefreshMaxPeriod)
+			errors.CheckError(appController.SetStatusPatchStrategy(statusPatchStrategy))
+			appController.SetStatusMaxSize(statusMaxSize)
+			appController.SetDryRun(dryRun)
+			errors.CheckError(appController.SetCommitStatusReporting(commitStatusReporting, commitStatusHosts))
+			appController.SetCredentialExpiryMonitoring(credentialExpiryWarning, credentialMaxAge)
+			errors.CheckError(appController.SetHardDependencies(hardDependencies))
+			appSelectors, err := argo.NewApplicationSelectors(appLabelSelector, appFieldSelector)
+			errors.CheckError(err)
+			appController.SetApplicationSelectors(appSelectors)
+			limits, err := controller.ParseRefreshRateLimits(refreshRateLimits)
+			errors.CheckError(err)
+			appController.SetRefreshRateLimits(limits)
+
+			if runMigrations && !dryRun {
+				// The migrations are applied by a single replica of the controller, the other ones wait for it
+				hostname, err := os.Hostname()
+				errors.CheckError(err)
+				lock := &resourcelock.LeaseLock{
+					LeaseMeta:  metav1.ObjectMeta{Name: migration.GetLeaseName(), Namespace: namespace},
+					Client:     kubeClient.CoordinationV1(),
+					LockConfig: resourcelock.ResourceLockConfig{Identity: hostname},
+				}
+				migrator := migration.NewMigrator(&migration.Context{
+					Namespace:             namespace,
+					ApplicationNamespaces: applicationNamespaces,
+					KubeClientset:         kubeClient,
+					AppClientset:          appClient,
+					SettingsMgr:           settingsMgr,
+				}, migration.DefaultMigrations())
+				errors.CheckError(migrator.MigrateWithLeaderElection(ctx, lock, leaderElectionConfig.LeaseDuration, leaderElectionConfig.RenewDeadline, leaderElectionConfig.RetryPeriod))
+			}
 
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
@@ -168,7 +260,41 @@ func NewCommand() *cobra.Command {
 				defer closeTracer()
 			}
 
-			go appController.Run(ctx, statusProcessors, operationProcessors)
+			run := func(ctx context.Context) {
+				// The Argo CD configuration is synced from Git by a single controller replica
+				if configSyncRepo != "" && shard <= 0 && !dryRun {
+					source := configsync.Source{RepoURL: configSyncRepo, Path: configSyncPath, TargetRevision: configSyncRevision}
+					reconciler := configsync.NewReconciler(namespace, source, kubeClient, db.NewDB(namespace, settingsMgr, kubeClient), repoClientset)
+					go reconciler.Run(ctx, configSyncInterval)
+				}
+				// Repository and cluster registrations are reconciled into secrets by a single controller replica as well
+				if registrationInterval > 0 && shard <= 0 && !dryRun {
+					reconciler := registration.NewReconciler(namespace, kubeClient, appClient, db.NewDB(namespace, settingsMgr, kubeClient), repoClientset, kubectl, registrationPolicy)
+					go reconciler.Run(ctx, registrationInterval)
+				}
+				bundleSyncer := customizations.NewBundleSyncer(namespace, settingsMgr, db.NewDB(namespace, settingsMgr, kubeClient), repoClientset)
+				go bundleSyncer.Run(ctx, bundleSyncInterval)
+				appController.Run(ctx, statusProcessors, operationProcessors)
+			}
+
+			if leaderElection {
+				// Release the lease on termination so that a standby replica takes over right away
+				ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+				defer stop()
+				hostname, err := os.Hostname()
+				errors.CheckError(err)
+				lock := &resourcelock.LeaseLock{
+					LeaseMeta:  metav1.ObjectMeta{Name: controller.LeaseName(shard), Namespace: namespace},
+					Client:     kubeClient.CoordinationV1(),
+					LockConfig: resourcelock.ResourceLockConfig{Identity: hostname},
+				}
+				errors.CheckError(appController.RunWithLeaderElection(ctx, lock, leaderElectionConfig, run))
+				// The controller state cannot be reused once the leadership is lost, the replica restarts as a standby
+				log.Info("Leader election stopped, exiting")
+				return nil
+			}
+
+			go run(ctx)
 
 			// Wait forever
 			select {}
@@ -178,7 +304,7 @@ func NewCommand() *cobra.Command {
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().Int64Var(&appResyncPeriod, "app-resync", int64(env.ParseDurationFromEnv("ARGOCD_RECONCILIATION_TIMEOUT", defaultAppResyncPeriod*time.Second, 0, math.MaxInt64).Seconds()), "Time period in seconds for application resync.")
 	command.Flags().Int64Var(&appHardResyncPeriod, "app-hard-resync", int64(env.ParseDurationFromEnv("ARGOCD_HARD_RECONCILIATION_TIMEOUT", defaultAppHardResyncPeriod*time.Second, 0, math.MaxInt64).Seconds()), "Time period in seconds for application hard resync.")
-	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER", common.DefaultRepoServerAddr), "Repo server address.")
+	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER", common.GetDefaultRepoServerAddr()), "Repo server address.")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_TIMEOUT_SECONDS", 60, 0, math.MaxInt64), "Repo server RPC call timeout seconds.")
 	command.Flags().IntVar(&statusProcessors, "status-processors", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_STATUS_PROCESSORS", 20, 0, math.MaxInt32), "Number of application status processors")
 	command.Flags().IntVar(&operationProcessors, "operation-processors", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_OPERATION_PROCESSORS", 10, 0, math.MaxInt32), "Number of application operation processors")
@@ -188,29 +314,73 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDMetrics, "Start metrics server on given port")
 	command.Flags().DurationVar(&metricsCacheExpiration, "metrics-cache-expiration", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_METRICS_CACHE_EXPIRATION", 0*time.Second, 0, math.MaxInt64), "Prometheus metrics cache expiration (disabled  by default. e.g. 24h0m0s)")
 	command.Flags().IntVar(&selfHealTimeoutSeconds, "self-heal-timeout-seconds", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_SELF_HEAL_TIMEOUT_SECONDS", 5, 0, math.MaxInt32), "Specifies timeout between application self heal attempts")
+	command.Flags().DurationVar(&operationStaleTimeout, "operation-stale-timeout", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_OPERATION_STALE_TIMEOUT", 0, 0, math.MaxInt64), "Duration after which operations still running are considered stale and marked as failed (disabled by default. e.g. 24h0m0s)")
+	command.Flags().DurationVar(&statusWarmupDuration, "status-warmup-duration", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_STATUS_WARMUP_DURATION", 3*time.Minute, 0, math.MaxInt64), "Duration after the controller start over which the comparison of the applications whose comparison results are still cached is spread. Set to 0 to compare all expired applications on start")
+	command.Flags().IntVar(&adaptiveRefreshThreshold, "adaptive-refresh-threshold", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_ADAPTIVE_REFRESH_THRESHOLD", 0, 0, math.MaxInt32), "Number of consecutive periodic refreshes without change after which the refresh period of an application doubles after each refresh without change, until the application changes or its refresh is requested. Set to 0 to disable the adaptive refresh")
+	command.Flags().DurationVar(&adaptiveRefreshMaxPeriod, "adaptive-refresh-max-timeout", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_ADAPTIVE_REFRESH_MAX_TIMEOUT", time.Hour, 0, math.MaxInt64), "Maximum refresh period of the applications with the adaptive refresh")
+	command.Flags().StringVar(&statusPatchStrategy, "status-patch-strategy", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_STATUS_PATCH_STRATEGY", controller.StatusPatchStrategyMergePatch), fmt.Sprintf("How the status of applications is persisted, one of: %s. The status fields are owned by a dedicated field manager either way", strings.Join(controller.StatusPatchStrategies, ", ")))
+	command.Flags().IntVar(&statusMaxSize, "status-max-size", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_STATUS_MAX_SIZE", 0, 0, math.MaxInt32), "Maximum size in bytes of applications above which the list of resources is moved from the status to Redis and the operation messages are truncated (disabled by default, e.g. 1048576)")
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", 20, "Number of allowed concurrent kubectl fork/execs. Any value less the 1 means no limit.")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Disable TLS on connections to repo server")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
 	command.Flags().StringSliceVar(&metricsAplicationLabels, "metrics-application-labels", []string{}, "List of Application labels that will be added to the argocd_application_labels metric")
+	command.Flags().StringSliceVar(&metricsProjects, "metrics-projects", env.StringsFromEnv("ARGOCD_APPLICATION_CONTROLLER_METRICS_PROJECTS", []string{}, ","), "List of projects, which can be glob patterns, whose applications are exported by the metrics endpoint (all projects by default)")
+	metricsAuth = metricsutil.AddAuthFlagsToCmd(&command, "ARGOCD_APPLICATION_CONTROLLER")
+	metricsDropLabels = metricsutil.AddDropLabelsFlagToCmd(&command, "ARGOCD_APPLICATION_CONTROLLER")
 	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
+	command.Flags().StringVar(&appLabelSelector, "application-label-selector", env.StringFromEnv("ARGOCD_APPLICATION_LABEL_SELECTOR", ""), "Label selector restricting the applications watched by the controller, e.g. to the applications of this instance when several Argo CD instances share a cluster")
+	command.Flags().StringVar(&appFieldSelector, "application-field-selector", env.StringFromEnv("ARGOCD_APPLICATION_FIELD_SELECTOR", ""), "Field selector restricting the applications watched by the controller. Only the metadata.name and metadata.namespace fields are supported")
+	command.Flags().StringVar(&refreshRateLimits, "refresh-rate-limits", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_REFRESH_RATE_LIMITS", ""), "Maximum number of requested refreshes of each type (hard, normal or comparison) started per second, e.g. hard=0.5,normal=10. The requested refreshes exceeding the limits are delayed, and dropped if they cannot start before their deadline")
 	command.Flags().BoolVar(&persistResourceHealth, "persist-resource-health", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_PERSIST_RESOURCE_HEALTH", true), "Enables storing the managed resources health in the Application CRD")
+	command.Flags().BoolVar(&enableDebugEndpoints, "enable-debug-endpoints", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_ENABLE_DEBUG_ENDPOINTS", false), "Expose expvar variables and controller debug information, such as reconcile timings and cluster cache sizes, on the metrics port")
+	command.Flags().StringVar(&configSyncRepo, "config-sync-repo", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_CONFIG_SYNC_REPO", ""), "URL of the repository to sync the argocd-cm, argocd-rbac-cm and argocd-notifications-cm config maps from (disabled by default)")
+	command.Flags().StringVar(&configSyncPath, "config-sync-path", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_CONFIG_SYNC_PATH", "."), "Path of the repository holding the config maps to sync")
+	command.Flags().StringVar(&configSyncRevision, "config-sync-revision", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_CONFIG_SYNC_REVISION", "HEAD"), "Revision of the repository to sync the config maps from")
+	command.Flags().DurationVar(&configSyncInterval, "config-sync-interval", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_CONFIG_SYNC_INTERVAL", 3*time.Minute, time.Second, math.MaxInt64), "Interval at which the config maps are synced from the repository")
+	command.Flags().DurationVar(&registrationInterval, "registration-reconciliation-interval", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_REGISTRATION_RECONCILIATION_INTERVAL", 0, 0, math.MaxInt64), "Interval at which RepositoryRegistration and ClusterRegistration resources are reconciled into repository and cluster secrets (disabled by default, e.g. 3m0s)")
+	command.Flags().StringSliceVar(&registrationPolicy.AllowedProjects, "registration-allowed-projects", env.StringsFromEnv("ARGOCD_APPLICATION_CONTROLLER_REGISTRATION_ALLOWED_PROJECTS", []string{}, ","), "List of projects, which can be glob patterns, RepositoryRegistration and ClusterRegistration resources are allowed to be scoped to (none by default)")
+	command.Flags().BoolVar(&registrationPolicy.AllowInCluster, "registration-allow-in-cluster", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REGISTRATION_ALLOW_IN_CLUSTER", false), "Allow ClusterRegistration resources to register the in-cluster server, which is reached with the credentials of the controller")
+	command.Flags().DurationVar(&bundleSyncInterval, "resource-customizations-bundle-sync-interval", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_RESOURCE_CUSTOMIZATIONS_BUNDLE_SYNC_INTERVAL", 3*time.Minute, time.Second, math.MaxInt64), "Interval at which the resource customizations bundle configured in the argocd-cm config map is synced from Git")
+	command.Flags().BoolVar(&leaderElection, "leader-election", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_LEADER_ELECTION", false), "Run the controller only once this replica holds the lease of its shard, allowing multiple replicas of a shard to run as hot standbys")
+	command.Flags().DurationVar(&leaderElectionConfig.LeaseDuration, "leader-election-lease-duration", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_LEADER_ELECTION_LEASE_DURATION", 15*time.Second, time.Second, math.MaxInt64), "Duration standby replicas wait before taking over a lease which was not renewed by its leader")
+	command.Flags().DurationVar(&leaderElectionConfig.RenewDeadline, "leader-election-renew-deadline", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second, time.Second, math.MaxInt64), "Duration the leader retries renewing its lease before giving up the leadership")
+	command.Flags().DurationVar(&leaderElectionConfig.RetryPeriod, "leader-election-retry-period", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_LEADER_ELECTION_RETRY_PERIOD", 2*time.Second, 0, math.MaxInt64), "Interval between two attempts to acquire or renew the lease")
+	command.Flags().BoolVar(&runMigrations, "migrations", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_MIGRATIONS", true), "Apply the pending migrations of the Argo CD configuration and state on start, recording them in the argocd-migration-history secret")
+	command.Flags().BoolVar(&dryRun, "dry-run", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_DRY_RUN", false), "Reconcile applications without persisting any change and without syncing, and report the differences with the status persisted by the active controller. Requires a dedicated Redis")
+	command.Flags().BoolVar(&commitStatusReporting, "commit-status-reporting", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_COMMIT_STATUS_REPORTING", false), "Set a commit status on the revisions synced by applications in GitHub, GitLab or Bitbucket Cloud, with the outcome of the syncs and a link to the applications")
+	command.Flags().StringSliceVar(&commitStatusHosts, "commit-status-provider-hosts", env.StringsFromEnv("ARGOCD_APPLICATION_CONTROLLER_COMMIT_STATUS_PROVIDER_HOSTS", []string{}, ","), "List of host=provider pairs configuring the providers of the self-hosted Git platforms on which commit statuses are set, e.g. github.example.com=github, the provider being github, gitlab or bitbucket. Only github.com, gitlab.com and bitbucket.org are known by default")
+	command.Flags().DurationVar(&credentialExpiryWarning, "credential-expiry-warning-period", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_CREDENTIAL_EXPIRY_WARNING_PERIOD", 0, 0, math.MaxInt64), "Period before the expiry of the credentials of the repositories and clusters during which the applications using them get a CredentialExpiringWarning condition, and the expiries are exposed as metrics (disabled by default, e.g. 336h0m0s)")
+	command.Flags().DurationVar(&credentialMaxAge, "credential-max-age", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_CREDENTIAL_MAX_AGE", 0, 0, math.MaxInt64), "Maximum age of the credentials which do not expire, such as the private keys of GitHub Apps, after which they are reported as expiring (disabled by default, e.g. 2160h0m0s)")
+	command.Flags().StringSliceVar(&hardDependencies, "hard-dependencies", env.StringsFromEnv("ARGOCD_APPLICATION_CONTROLLER_HARD_DEPENDENCIES", []string{}, ","), "List of the dependencies which must be ready for the controller to be ready, among redis, kubernetes, informers and repo-server. The states of all the dependencies are reported by /healthz?full=true on the metrics port")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
 		redisClient = client
 	})
 	return &command
 }
 
-func getClusterFilter() func(cluster *v1alpha1.Cluster) bool {
+// getShard returns the number of controller replicas and the shard of this replica, or -1 if sharding is disabled. With
+// leader election, the replicas whose ordinal exceeds the number of shards are standbys of the shard ordinal % replicas.
+func getShard(leaderElection bool) (int, int) {
 	replicas := env.ParseNumFromEnv(common.EnvControllerReplicas, 0, 0, math.MaxInt32)
+	if replicas <= 1 {
+		return replicas, -1
+	}
 	shard := env.ParseNumFromEnv(common.EnvControllerShard, -1, -math.MaxInt32, math.MaxInt32)
+	if shard < 0 {
+		var err error
+		shard, err = sharding.InferShard()
+		errors.CheckError(err)
+		if leaderElection {
+			shard = shard % replicas
+		}
+	}
+	return replicas, shard
+}
+
+func getClusterFilter(replicas int, shard int) func(cluster *v1alpha1.Cluster) bool {
 	var clusterFilter func(cluster *v1alpha1.Cluster) bool
 	if replicas > 1 {
-		if shard < 0 {
-			var err error
-			shard, err = sharding.InferShard()
-			errors.CheckError(err)
-		}
 		log.Infof("Processing clusters from shard %d", shard)
 		clusterFilter = sharding.GetClusterFilter(replicas, shard)
 	} else {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"syscall"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/common"
 
@@ -25,6 +26,8 @@ import (
 
 const (
 	cliName = "argocd-dex"
+	// dexRestartDelay is how long to wait before restarting dex after it exited unexpectedly
+	dexRestartDelay = 5 * time.Second
 )
 
 func NewCommand() *cobra.Command {
@@ -90,15 +93,17 @@ func NewRunDexCommand() *cobra.Command {
 			}
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClientset, namespace)
-			prevSettings, err := settingsMgr.GetSettings()
+			argoCDSettings, err := settingsMgr.GetSettings()
 			errors.CheckError(err)
 			updateCh := make(chan *settings.ArgoCDSettings, 1)
 			settingsMgr.Subscribe(updateCh)
 
+			dexCfgBytes, err := dex.GenerateDexConfigYAML(argoCDSettings, disableTLS)
+			errors.CheckError(err)
 			for {
 				var cmd *exec.Cmd
-				dexCfgBytes, err := dex.GenerateDexConfigYAML(prevSettings, disableTLS)
-				errors.CheckError(err)
+				// exitCh receives the error of dex when it exits by itself
+				exitCh := make(chan error, 1)
 				if len(dexCfgBytes) == 0 {
 					log.Infof("dex is not configured")
 				} else {
@@ -110,25 +115,39 @@ func NewRunDexCommand() *cobra.Command {
 					cmd.Stderr = os.Stderr
 					err = cmd.Start()
 					errors.CheckError(err)
+					go func(cmd *exec.Cmd) {
+						exitCh <- cmd.Wait()
+					}(cmd)
 				}
 
-				// loop until the dex config changes
-				for {
-					newSettings := <-updateCh
-					newDexCfgBytes, err := dex.GenerateDexConfigYAML(newSettings, disableTLS)
-					errors.CheckError(err)
-					if string(newDexCfgBytes) != string(dexCfgBytes) {
-						prevSettings = newSettings
+				// loop until the dex config changes or dex exits
+				restart := false
+				for !restart {
+					select {
+					case err := <-exitCh:
+						log.Warnf("dex exited unexpectedly: %v. restarting dex in %v", err, dexRestartDelay)
+						time.Sleep(dexRestartDelay)
+						restart = true
+					case newSettings := <-updateCh:
+						if err := dex.ValidateDexConfig(newSettings); err != nil {
+							// keep dex running with its previous config until the config is fixed
+							log.Errorf("invalid dex config, keeping the previous config: %v", err)
+							continue
+						}
+						newDexCfgBytes, err := dex.GenerateDexConfigYAML(newSettings, disableTLS)
+						errors.CheckError(err)
+						if string(newDexCfgBytes) == string(dexCfgBytes) {
+							log.Infof("dex config unmodified")
+							continue
+						}
+						dexCfgBytes = newDexCfgBytes
 						log.Infof("dex config modified. restarting dex")
 						if cmd != nil && cmd.Process != nil {
 							err = cmd.Process.Signal(syscall.SIGTERM)
 							errors.CheckError(err)
-							_, err = cmd.Process.Wait()
-							errors.CheckError(err)
+							<-exitCh
 						}
-						break
-					} else {
-						log.Infof("dex config unmodified")
+						restart = true
 					}
 				}
 			}
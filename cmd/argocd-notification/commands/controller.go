@@ -2,16 +2,20 @@ package commands
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 
 	notificationscontroller "github.com/argoproj/argo-cd/v2/notification_controller/controller"
@@ -55,6 +59,9 @@ func NewCommand() *cobra.Command {
 		argocdRepoServerStrictTLS bool
 		configMapName             string
 		secretName                string
+		deliveryMaxRetries        int
+		deliveryRetryBaseDelay    time.Duration
+		cacheSrc                  func() (*cacheutil.Cache, error)
 	)
 	var command = cobra.Command{
 		Use:   "controller",
@@ -129,6 +136,11 @@ func NewCommand() *cobra.Command {
 			}
 			defer argocdService.Close()
 
+			cache, err := cacheSrc()
+			if err != nil {
+				return err
+			}
+
 			registry := controller.NewMetricsRegistry("argocd")
 			http.Handle("/metrics", promhttp.HandlerFor(prometheus.Gatherers{registry, prometheus.DefaultGatherer}, promhttp.HandlerOpts{}))
 
@@ -138,7 +150,11 @@ func NewCommand() *cobra.Command {
 			log.Infof("serving metrics on port %d", metricsPort)
 			log.Infof("loading configuration %d", metricsPort)
 
-			ctrl := notificationscontroller.NewController(k8sClient, dynamicClient, argocdService, namespace, appLabelSelector, registry, secretName, configMapName)
+			ctrl := notificationscontroller.NewController(k8sClient, dynamicClient, argocdService, namespace, appLabelSelector, registry, secretName, configMapName, notificationscontroller.DeliveryOpts{
+				Store:          delivery.NewStore(cache),
+				MaxRetries:     deliveryMaxRetries,
+				RetryBaseDelay: deliveryRetryBaseDelay,
+			})
 			err = ctrl.Init(ctx)
 			if err != nil {
 				return err
@@ -156,10 +172,13 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().StringVar(&logFormat, "logformat", "text", "Set the logging format. One of: text|json")
 	command.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Metrics port")
-	command.Flags().StringVar(&argocdRepoServer, "argocd-repo-server", common.DefaultRepoServerAddr, "Argo CD repo server address")
+	command.Flags().StringVar(&argocdRepoServer, "argocd-repo-server", common.GetDefaultRepoServerAddr(), "Argo CD repo server address")
 	command.Flags().BoolVar(&argocdRepoServerPlaintext, "argocd-repo-server-plaintext", false, "Use a plaintext client (non-TLS) to connect to repository server")
 	command.Flags().BoolVar(&argocdRepoServerStrictTLS, "argocd-repo-server-strict-tls", false, "Perform strict validation of TLS certificates when connecting to repo server")
-	command.Flags().StringVar(&configMapName, "config-map-name", "argocd-notifications-cm", "Set notifications ConfigMap name")
-	command.Flags().StringVar(&secretName, "secret-name", "argocd-notifications-secret", "Set notifications Secret name")
+	command.Flags().StringVar(&configMapName, "config-map-name", common.GetArgoCDNotificationsConfigMapName(), "Set notifications ConfigMap name")
+	command.Flags().StringVar(&secretName, "secret-name", common.GetArgoCDNotificationsSecretName(), "Set notifications Secret name")
+	command.Flags().IntVar(&deliveryMaxRetries, "delivery-max-retries", env.ParseNumFromEnv("ARGOCD_NOTIFICATIONS_DELIVERY_MAX_RETRIES", 5, 0, math.MaxInt32), "Number of times a failed notification delivery is retried before giving up. Failed deliveries are not retried if 0.")
+	command.Flags().DurationVar(&deliveryRetryBaseDelay, "delivery-retry-base-delay", env.ParseDurationFromEnv("ARGOCD_NOTIFICATIONS_DELIVERY_RETRY_BASE_DELAY", 5*time.Second, 0, math.MaxInt64), "Delay before the first retry of a failed notification delivery, doubled after every failed attempt")
+	cacheSrc = cacheutil.AddCacheFlagsToCmd(&command)
 	return &command
 }
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
@@ -27,9 +28,12 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
+	"github.com/argoproj/argo-cd/v2/util/helm"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v2/util/trace"
 )
@@ -68,6 +72,27 @@ func getSubmoduleEnabled() bool {
 	return env.ParseBoolFromEnv(common.EnvGitSubmoduleEnabled, true)
 }
 
+// parseHelmIndexProviders parses <Helm repository URL>=<provider> pairs into the index providers of the repositories
+func parseHelmIndexProviders(pairs []string) (map[string]string, error) {
+	providers := map[string]string{}
+	for _, pair := range pairs {
+		i := strings.LastIndex(pair, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid Helm index provider '%s', expected <Helm repository URL>=<provider>", pair)
+		}
+		repoURL, provider := strings.TrimSuffix(pair[:i], "/"), pair[i+1:]
+		known := false
+		for _, p := range helm.IndexProviders {
+			known = known || p == provider
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown Helm index provider '%s' of repository %s, expected one of: %s", provider, repoURL, strings.Join(helm.IndexProviders, ", "))
+		}
+		providers[repoURL] = provider
+	}
+	return providers, nil
+}
+
 func NewCommand() *cobra.Command {
 	var (
 		parallelismLimit                  int64
@@ -84,6 +109,10 @@ func NewCommand() *cobra.Command {
 		allowOutOfBoundsSymlinks          bool
 		streamedManifestMaxTarSize        string
 		streamedManifestMaxExtractedSize  string
+		manifestSchemaLocation            string
+		helmIndexProviders                []string
+		hydratedBranch                    string
+		metricsDropLabels                 *[]string
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -122,8 +151,15 @@ func NewCommand() *cobra.Command {
 			streamedManifestMaxExtractedSizeQuantity, err := resource.ParseQuantity(streamedManifestMaxExtractedSize)
 			errors.CheckError(err)
 
+			helmIndexProvidersByURL, err := parseHelmIndexProviders(helmIndexProviders)
+			errors.CheckError(err)
+
+			dropLabels, err := metricsutil.ParseLabelRules(*metricsDropLabels)
+			errors.CheckError(err)
+
 			askPassServer := askpass.NewServer()
 			metricsServer := metrics.NewMetricsServer()
+			metricsServer.SetDropLabels(dropLabels)
 			cacheutil.CollectMetrics(redisClient, metricsServer)
 			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, repository.RepoServerInitConstants{
 				ParallelismLimit: parallelismLimit,
@@ -136,6 +172,9 @@ func NewCommand() *cobra.Command {
 				AllowOutOfBoundsSymlinks:                     allowOutOfBoundsSymlinks,
 				StreamedManifestMaxExtractedSize:             streamedManifestMaxExtractedSizeQuantity.ToDec().Value(),
 				StreamedManifestMaxTarSize:                   streamedManifestMaxTarSizeQuantity.ToDec().Value(),
+				ManifestSchemaLocation:                       manifestSchemaLocation,
+				HelmIndexProviders:                           helmIndexProvidersByURL,
+				HydratedBranch:                               hydratedBranch,
 			}, askPassServer)
 			errors.CheckError(err)
 
@@ -192,6 +231,10 @@ func NewCommand() *cobra.Command {
 				go func() { errors.CheckError(reposerver.StartGPGWatcher(getGnuPGSourcePath())) }()
 			}
 
+			if schemes := git.FetcherSchemes(); len(schemes) > 0 {
+				log.Infof("Fetching sources with the repository URL schemes %s", strings.Join(schemes, ", "))
+			}
+
 			log.Infof("argocd-repo-server is listening on %s", listener.Addr())
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
@@ -216,6 +259,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&allowOutOfBoundsSymlinks, "allow-oob-symlinks", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ALLOW_OUT_OF_BOUNDS_SYMLINKS", false), "Allow out-of-bounds symlinks in repositories (not recommended)")
 	command.Flags().StringVar(&streamedManifestMaxTarSize, "streamed-manifest-max-tar-size", env.StringFromEnv("ARGOCD_REPO_SERVER_STREAMED_MANIFEST_MAX_TAR_SIZE", "100M"), "Maximum size of streamed manifest archives")
 	command.Flags().StringVar(&streamedManifestMaxExtractedSize, "streamed-manifest-max-extracted-size", env.StringFromEnv("ARGOCD_REPO_SERVER_STREAMED_MANIFEST_MAX_EXTRACTED_SIZE", "1G"), "Maximum size of streamed manifest archives when extracted")
+	command.Flags().StringSliceVar(&helmIndexProviders, "helm-index-providers", env.StringsFromEnv("ARGOCD_REPO_SERVER_HELM_INDEX_PROVIDERS", []string{}, ","), fmt.Sprintf("Comma separated list of <Helm repository URL>=<provider> pairs listing the chart versions of the repositories with the API of the provider rather than by downloading their index, one of: %s. Artifactory and Harbor repositories are detected from their URL", strings.Join(helm.IndexProviders, ", ")))
+	command.Flags().StringVar(&hydratedBranch, "hydrated-branch", env.StringFromEnv("ARGOCD_REPO_SERVER_HYDRATED_BRANCH", ""), "Branch of their Git repository the generated manifests of applications are written to. Manifests are not written if empty.")
+	command.Flags().StringVar(&manifestSchemaLocation, "manifest-schema-location", env.StringFromEnv("ARGOCD_REPO_SERVER_MANIFEST_SCHEMA_LOCATION", ""), "Location template of the JSON schemas the generated manifests are validated against, e.g. https://raw.githubusercontent.com/yannh/kubernetes-json-schema/master/{{ .NormalizedKubernetesVersion }}-standalone-strict/{{ .ResourceKind }}{{ .KindSuffix }}.json. Manifests are not validated if empty.")
+	metricsDropLabels = metricsutil.AddDropLabelsFlagToCmd(&command, "ARGOCD_REPO_SERVER")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
 		redisClient = client
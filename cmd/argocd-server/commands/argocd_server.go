@@ -20,11 +20,14 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/server"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/dex"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	httputil "github.com/argoproj/argo-cd/v2/util/http"
 	"github.com/argoproj/argo-cd/v2/util/kube"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 	traceutil "github.com/argoproj/argo-cd/v2/util/trace"
 )
@@ -61,6 +64,7 @@ func NewCommand() *cobra.Command {
 		dexServerAddress         string
 		disableAuth              bool
 		enableGZip               bool
+		gzipPaths                []string
 		tlsConfigCustomizerSrc   func() (tls.ConfigCustomizer, error)
 		cacheSrc                 func() (*servercache.Cache, error)
 		frameOptions             string
@@ -72,6 +76,24 @@ func NewCommand() *cobra.Command {
 		staticAssetsDir          string
 		applicationNamespaces    []string
 		enableProxyExtension     bool
+		repoHealthCheckInterval  time.Duration
+		bundleSyncInterval       time.Duration
+		enableSettingsAdmission  bool
+		settingsAdmissionPort    int
+		settingsAdmissionCert    string
+		settingsAdmissionKey     string
+		readOnly                 bool
+		agentProxyURL            string
+		metricsAuth              *metricsutil.AuthOptions
+		metricsDropLabels        *[]string
+		manifestWarmParallelism  int
+		previewParallelism       int
+		previewForks             bool
+		appLabelSelector         string
+		appFieldSelector         string
+		cookieOptions            httputil.CookieOptions
+		corsOptions              httputil.CORSOptions
+		hardDependencies         []string
 	)
 	var command = &cobra.Command{
 		Use:               cliName,
@@ -104,6 +126,8 @@ func NewCommand() *cobra.Command {
 			errors.CheckError(err)
 			cache, err := cacheSrc()
 			errors.CheckError(err)
+			dropLabels, err := metricsutil.ParseLabelRules(*metricsDropLabels)
+			errors.CheckError(err)
 
 			kubeclientset := kubernetes.NewForConfigOrDie(config)
 
@@ -164,28 +188,51 @@ func NewCommand() *cobra.Command {
 				baseHRef = rootPath
 			}
 
+			appSelectors, err := argo.NewApplicationSelectors(appLabelSelector, appFieldSelector)
+			errors.CheckError(err)
+			errors.CheckError(cookieOptions.Validate())
+			errors.CheckError(corsOptions.Validate())
+
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:              insecure,
-				ListenPort:            listenPort,
-				MetricsPort:           metricsPort,
-				Namespace:             namespace,
-				BaseHRef:              baseHRef,
-				RootPath:              rootPath,
-				KubeClientset:         kubeclientset,
-				AppClientset:          appClientSet,
-				RepoClientset:         repoclientset,
-				DexServerAddr:         dexServerAddress,
-				DexTLSConfig:          dexTlsConfig,
-				DisableAuth:           disableAuth,
-				EnableGZip:            enableGZip,
-				TLSConfigCustomizer:   tlsConfigCustomizer,
-				Cache:                 cache,
-				XFrameOptions:         frameOptions,
-				ContentSecurityPolicy: contentSecurityPolicy,
-				RedisClient:           redisClient,
-				StaticAssetsDir:       staticAssetsDir,
-				ApplicationNamespaces: applicationNamespaces,
-				EnableProxyExtension:  enableProxyExtension,
+				Insecure:                     insecure,
+				ListenPort:                   listenPort,
+				MetricsPort:                  metricsPort,
+				Namespace:                    namespace,
+				BaseHRef:                     baseHRef,
+				RootPath:                     rootPath,
+				KubeClientset:                kubeclientset,
+				AppClientset:                 appClientSet,
+				RepoClientset:                repoclientset,
+				DexServerAddr:                dexServerAddress,
+				DexTLSConfig:                 dexTlsConfig,
+				DisableAuth:                  disableAuth,
+				EnableGZip:                   enableGZip,
+				TLSConfigCustomizer:          tlsConfigCustomizer,
+				Cache:                        cache,
+				XFrameOptions:                frameOptions,
+				ContentSecurityPolicy:        contentSecurityPolicy,
+				RedisClient:                  redisClient,
+				StaticAssetsDir:              staticAssetsDir,
+				ApplicationNamespaces:        applicationNamespaces,
+				EnableProxyExtension:         enableProxyExtension,
+				RepoHealthCheckInterval:      repoHealthCheckInterval,
+				BundleSyncInterval:           bundleSyncInterval,
+				EnableSettingsAdmission:      enableSettingsAdmission,
+				SettingsAdmissionPort:        settingsAdmissionPort,
+				SettingsAdmissionTLSCertFile: settingsAdmissionCert,
+				SettingsAdmissionTLSKeyFile:  settingsAdmissionKey,
+				GZipPaths:                    gzipPaths,
+				ReadOnly:                     readOnly,
+				AgentProxyURL:                agentProxyURL,
+				MetricsAuth:                  metricsAuth,
+				MetricsDropLabels:            dropLabels,
+				ManifestWarmParallelism:      manifestWarmParallelism,
+				PreviewParallelism:           previewParallelism,
+				PreviewForks:                 previewForks,
+				ApplicationSelectors:         appSelectors,
+				CookieOptions:                cookieOptions,
+				CORSOptions:                  corsOptions,
+				HardDependencies:             hardDependencies,
 			}
 
 			stats.RegisterStackDumper()
@@ -221,15 +268,23 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", env.StringFromEnv("ARGOCD_SERVER_LOGFORMAT", "text"), "Set the logging format. One of: text|json")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_SERVER_LOG_LEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
-	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER", common.DefaultRepoServerAddr), "Repo server address")
-	command.Flags().StringVar(&dexServerAddress, "dex-server", env.StringFromEnv("ARGOCD_SERVER_DEX_SERVER", common.DefaultDexServerAddr), "Dex server address")
+	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER", common.GetDefaultRepoServerAddr()), "Repo server address")
+	command.Flags().StringVar(&dexServerAddress, "dex-server", env.StringFromEnv("ARGOCD_SERVER_DEX_SERVER", common.GetDefaultDexServerAddr()), "Dex server address")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", env.ParseBoolFromEnv("ARGOCD_SERVER_DISABLE_AUTH", false), "Disable client authentication")
 	command.Flags().BoolVar(&enableGZip, "enable-gzip", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_GZIP", false), "Enable GZIP compression")
+	command.Flags().StringSliceVar(&gzipPaths, "gzip-paths", env.StringsFromEnv("ARGOCD_SERVER_GZIP_PATHS", []string{}, ","), "List of glob patterns of the request paths whose responses are compressed when GZIP compression is enabled, e.g. /api/v1/applications/*/resource-tree. Responses to all requests are compressed if empty")
 	command.AddCommand(cli.NewVersionCmd(cliName))
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortAPIServer, "Listen on given port")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDAPIServerMetrics, "Start metrics on given port")
 	command.Flags().StringVar(&otlpAddress, "otlp-address", env.StringFromEnv("ARGOCD_SERVER_OTLP_ADDRESS", ""), "OpenTelemetry collector address to send traces to")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", env.ParseNumFromEnv("ARGOCD_SERVER_REPO_SERVER_TIMEOUT_SECONDS", 60, 0, math.MaxInt64), "Repo server RPC call timeout seconds.")
+	command.Flags().StringVar(&cookieOptions.Name, "cookie-name", env.StringFromEnv("ARGOCD_SERVER_COOKIE_NAME", common.AuthCookieName), "Name of the cookies holding the auth token")
+	command.Flags().StringVar(&cookieOptions.Domain, "cookie-domain", env.StringFromEnv("ARGOCD_SERVER_COOKIE_DOMAIN", ""), "Domain of the cookies holding the auth token, e.g. to send them to the API when the UI is served from a distinct subdomain. The cookies are only sent to the host which set them if empty")
+	command.Flags().StringVar(&cookieOptions.SameSite, "cookie-samesite", env.StringFromEnv("ARGOCD_SERVER_COOKIE_SAMESITE", httputil.SameSiteLax), "SameSite mode of the cookies holding the auth token. One of: lax|strict|none. The cookies are only sent over HTTPS if none")
+	command.Flags().BoolVar(&cookieOptions.Partitioned, "cookie-partitioned", env.ParseBoolFromEnv("ARGOCD_SERVER_COOKIE_PARTITIONED", false), "Store the cookies holding the auth token in partitioned storage (CHIPS), e.g. when the UI is embedded in another site. The cookies are only sent over HTTPS if enabled")
+	command.Flags().StringSliceVar(&corsOptions.AllowedOrigins, "cors-allowed-origins", env.StringsFromEnv("ARGOCD_SERVER_CORS_ALLOWED_ORIGINS", []string{}, ","), "List of origins allowed to call the REST API from the browser, e.g. https://portal.example.com, or * for all the origins. Cross-origin requests are not allowed if empty")
+	command.Flags().StringSliceVar(&corsOptions.AllowedHeaders, "cors-allowed-headers", env.StringsFromEnv("ARGOCD_SERVER_CORS_ALLOWED_HEADERS", httputil.DefaultCORSAllowedHeaders, ","), "List of request headers allowed in cross-origin requests to the REST API")
+	command.Flags().BoolVar(&corsOptions.AllowCredentials, "cors-allow-credentials", env.ParseBoolFromEnv("ARGOCD_SERVER_CORS_ALLOW_CREDENTIALS", false), "Allow cross-origin requests to the REST API to send the cookies holding the auth token. Cannot be enabled if all the origins are allowed")
 	command.Flags().StringVar(&frameOptions, "x-frame-options", env.StringFromEnv("ARGOCD_SERVER_X_FRAME_OPTIONS", "sameorigin"), "Set X-Frame-Options header in HTTP responses to `value`. To disable, set to \"\".")
 	command.Flags().StringVar(&contentSecurityPolicy, "content-security-policy", env.StringFromEnv("ARGOCD_SERVER_CONTENT_SECURITY_POLICY", "frame-ancestors 'self';"), "Set Content-Security-Policy header in HTTP responses to `value`. To disable, set to \"\".")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to repository server")
@@ -237,7 +292,23 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&dexServerPlaintext, "dex-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to dex server")
 	command.Flags().BoolVar(&dexServerStrictTLS, "dex-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to dex server")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces where application resources can be managed in")
+	command.Flags().StringVar(&appLabelSelector, "application-label-selector", env.StringFromEnv("ARGOCD_APPLICATION_LABEL_SELECTOR", ""), "Label selector restricting the applications watched and served by the API server, e.g. to the applications of this instance when several Argo CD instances share a cluster")
+	command.Flags().StringVar(&appFieldSelector, "application-field-selector", env.StringFromEnv("ARGOCD_APPLICATION_FIELD_SELECTOR", ""), "Field selector restricting the applications watched and served by the API server. Only the metadata.name and metadata.namespace fields are supported")
 	command.Flags().BoolVar(&enableProxyExtension, "enable-proxy-extension", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_PROXY_EXTENSION", false), "Enable Proxy Extension feature")
+	command.Flags().DurationVar(&repoHealthCheckInterval, "repo-health-check-interval", env.ParseDurationFromEnv("ARGOCD_SERVER_REPO_HEALTH_CHECK_INTERVAL", 0, 0, math.MaxInt64), "Interval at which the connection to configured repositories is checked and exposed as metrics. Set to 0 to disable")
+	command.Flags().DurationVar(&bundleSyncInterval, "resource-customizations-bundle-sync-interval", env.ParseDurationFromEnv("ARGOCD_SERVER_RESOURCE_CUSTOMIZATIONS_BUNDLE_SYNC_INTERVAL", 3*time.Minute, time.Second, math.MaxInt64), "Interval at which the resource customizations bundle configured in the argocd-cm config map is synced from Git")
+	command.Flags().BoolVar(&enableSettingsAdmission, "enable-settings-admission-webhook", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_SETTINGS_ADMISSION_WEBHOOK", false), "Serve a validating admission webhook rejecting invalid argocd-cm and argocd-rbac-cm config maps to the Kubernetes API server on a distinct TLS listener")
+	command.Flags().IntVar(&settingsAdmissionPort, "settings-admission-webhook-port", env.ParseNumFromEnv("ARGOCD_SERVER_SETTINGS_ADMISSION_WEBHOOK_PORT", common.DefaultPortArgoCDSettingsAdmissionWebhook, 0, 65535), "Port of the settings admission webhook")
+	command.Flags().StringVar(&settingsAdmissionCert, "settings-admission-webhook-tls-cert-file", env.StringFromEnv("ARGOCD_SERVER_SETTINGS_ADMISSION_WEBHOOK_TLS_CERT_FILE", ""), "Path of the TLS certificate served to the Kubernetes API server by the settings admission webhook")
+	command.Flags().StringVar(&settingsAdmissionKey, "settings-admission-webhook-tls-key-file", env.StringFromEnv("ARGOCD_SERVER_SETTINGS_ADMISSION_WEBHOOK_TLS_KEY_FILE", ""), "Path of the private key of the TLS certificate of the settings admission webhook")
+	metricsAuth = metricsutil.AddAuthFlagsToCmd(command, "ARGOCD_SERVER")
+	metricsDropLabels = metricsutil.AddDropLabelsFlagToCmd(command, "ARGOCD_SERVER")
+	command.Flags().IntVar(&manifestWarmParallelism, "webhook-manifest-warming-parallelism", env.ParseNumFromEnv("ARGOCD_SERVER_WEBHOOK_MANIFEST_WARMING_PARALLELISM", 0, 0, math.MaxInt32), "Maximum number of applications whose manifests are generated at once when a Git webhook affects them, before they are refreshed, so that their comparison hits the manifest cache of the repo server. Set to 0 to refresh the applications without generating their manifests")
+	command.Flags().IntVar(&previewParallelism, "webhook-pull-request-preview-parallelism", env.ParseNumFromEnv("ARGOCD_SERVER_WEBHOOK_PULL_REQUEST_PREVIEW_PARALLELISM", 0, 0, math.MaxInt32), "Maximum number of pull requests whose changes are previewed at once when a Git webhook notifies that they are opened or updated. The differences between the manifests of the affected applications are posted as a comment and a commit status of the pull requests. Set to 0 to disable the previews")
+	command.Flags().BoolVar(&previewForks, "webhook-pull-request-preview-forks", env.ParseBoolFromEnv("ARGOCD_SERVER_WEBHOOK_PULL_REQUEST_PREVIEW_FORKS", false), "Preview the changes of the pull requests opened from forks too. Their manifests are generated from commits which can be pushed by anyone")
+	command.Flags().StringVar(&agentProxyURL, "agent-proxy-url", env.StringFromEnv("ARGOCD_SERVER_AGENT_PROXY_URL", ""), "URL of the API server through which the application controller reaches the clusters of the agents. Defaults to the URL of the argocd-server service")
+	command.Flags().StringSliceVar(&hardDependencies, "hard-dependencies", env.StringsFromEnv("ARGOCD_SERVER_HARD_DEPENDENCIES", []string{}, ","), "List of the dependencies which must be ready for the API server to be ready, among redis, dex, kubernetes, informers and repo-server. The states of all the dependencies are reported by /healthz?full=true")
+	command.Flags().BoolVar(&readOnly, "read-only", env.ParseBoolFromEnv("ARGOCD_SERVER_READ_ONLY", false), "Run the API server in read-only mode: all the RPCs mutating state, the Git webhooks and the terminal are rejected")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, func(client *redis.Client) {
 		redisClient = client
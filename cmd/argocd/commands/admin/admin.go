@@ -57,6 +57,8 @@ func NewAdminCommand() *cobra.Command {
 	command.AddCommand(NewDashboardCommand())
 	command.AddCommand(NewNotificationsCommand())
 	command.AddCommand(NewInitialPasswordCommand())
+	command.AddCommand(NewMigrationCommand())
+	command.AddCommand(NewCredentialsCommand())
 
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", "text", "Set the logging format. One of: text|json")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
@@ -145,7 +147,7 @@ func getReferencedSecrets(un unstructured.Unstructured) map[string]bool {
 // (e.g. argocd-secret, repo credentials, or cluster credentials)
 func isArgoCDSecret(repoSecretRefs map[string]bool, un unstructured.Unstructured) bool {
 	secretName := un.GetName()
-	if secretName == common.ArgoCDSecretName {
+	if secretName == common.GetArgoCDSecretName() {
 		return true
 	}
 	if repoSecretRefs != nil {
@@ -169,7 +171,7 @@ func isArgoCDSecret(repoSecretRefs map[string]bool, un unstructured.Unstructured
 // isArgoCDConfigMap returns true if the configmap name is one of argo cd's well known configmaps
 func isArgoCDConfigMap(name string) bool {
 	switch name {
-	case common.ArgoCDConfigMapName, common.ArgoCDRBACConfigMapName, common.ArgoCDKnownHostsConfigMapName, common.ArgoCDTLSCertsConfigMapName:
+	case common.GetArgoCDConfigMapName(), common.GetArgoCDRBACConfigMapName(), common.GetArgoCDKnownHostsConfigMapName(), common.GetArgoCDTLSCertsConfigMapName():
 		return true
 	}
 	return false
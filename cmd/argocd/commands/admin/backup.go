@@ -55,16 +55,16 @@ func NewExportCommand() *cobra.Command {
 			}
 
 			acdClients := newArgoCDClientsets(config, namespace)
-			acdConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDConfigMapName, v1.GetOptions{})
+			acdConfigMap, err := acdClients.configMaps.Get(ctx, common.GetArgoCDConfigMapName(), v1.GetOptions{})
 			errors.CheckError(err)
 			export(writer, *acdConfigMap)
-			acdRBACConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDRBACConfigMapName, v1.GetOptions{})
+			acdRBACConfigMap, err := acdClients.configMaps.Get(ctx, common.GetArgoCDRBACConfigMapName(), v1.GetOptions{})
 			errors.CheckError(err)
 			export(writer, *acdRBACConfigMap)
-			acdKnownHostsConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDKnownHostsConfigMapName, v1.GetOptions{})
+			acdKnownHostsConfigMap, err := acdClients.configMaps.Get(ctx, common.GetArgoCDKnownHostsConfigMapName(), v1.GetOptions{})
 			errors.CheckError(err)
 			export(writer, *acdKnownHostsConfigMap)
-			acdTLSCertsConfigMap, err := acdClients.configMaps.Get(ctx, common.ArgoCDTLSCertsConfigMapName, v1.GetOptions{})
+			acdTLSCertsConfigMap, err := acdClients.configMaps.Get(ctx, common.GetArgoCDTLSCertsConfigMapName(), v1.GetOptions{})
 			errors.CheckError(err)
 			export(writer, *acdTLSCertsConfigMap)
 
@@ -161,7 +161,7 @@ func NewImportCommand() *cobra.Command {
 				if isArgoCDConfigMap(cm.GetName()) {
 					pruneObjects[kube.ResourceKey{Group: "", Kind: "ConfigMap", Name: cm.GetName()}] = cm
 				}
-				if cm.GetName() == common.ArgoCDConfigMapName {
+				if cm.GetName() == common.GetArgoCDConfigMapName() {
 					referencedSecrets = getReferencedSecrets(cm)
 				}
 			}
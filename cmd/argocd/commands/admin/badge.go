@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/server/badge"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// NewBadgeTokenCommand defines a new command to issue the token of a status badge
+func NewBadgeTokenCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		name         string
+		projects     []string
+		selector     string
+	)
+	var command = &cobra.Command{
+		Use:   "badge-token",
+		Short: "Issue a token allowing to display the status badge of applications even if status badges are disabled",
+		Example: `  # Issue the token of the badge of an application
+  argocd admin app badge-token --name guestbook
+
+  # Issue the token of the badge of the applications of projects matching a label selector
+  argocd admin app badge-token --project default --selector team=backend`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if name == "" && len(projects) == 0 && selector == "" {
+				c.HelpFunc()(c, args)
+				errors.CheckError(fmt.Errorf("one of --name, --project or --selector is required"))
+			}
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+
+			settingsMgr := settings.NewSettingsManager(ctx, kubernetes.NewForConfigOrDie(config), namespace)
+			argoSettings, err := settingsMgr.GetSettings()
+			errors.CheckError(err)
+
+			query := url.Values{}
+			if name != "" {
+				query.Set("name", name)
+			}
+			if len(projects) > 0 {
+				query["project"] = projects
+			}
+			if selector != "" {
+				query.Set("selector", selector)
+			}
+			query.Set("token", badge.Token(argoSettings.ServerSignature, query))
+			fmt.Println(query.Encode())
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&name, "name", "", "Name of the application")
+	command.Flags().StringArrayVar(&projects, "project", nil, "Project of the applications")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Label selector of the applications")
+	return command
+}
@@ -0,0 +1,104 @@
+package admin
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func NewCredentialsCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "credentials",
+		Short: "Manage credentials of repositories and clusters",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewCredentialsExpiryCommand())
+	return command
+}
+
+func NewCredentialsExpiryCommand() *cobra.Command {
+	var (
+		clientConfig  clientcmd.ClientConfig
+		warningPeriod time.Duration
+		maxAge        time.Duration
+		expiringOnly  bool
+	)
+	var command = &cobra.Command{
+		Use:   "expiry",
+		Short: "Report the expiry of the credentials of repositories and clusters",
+		Long: "Report the expiry of the TLS client certificates, of the tokens which are JWTs, and the age of the GitHub App private keys " +
+			"of the repositories, repository credentials and clusters. Exits with code 2 if credentials expire within the warning period.",
+		Example: `  # Report the credentials expiring within 30 days, or older than 90 days if they do not expire
+  argocd admin credentials expiry --warning-period 720h --max-age 2160h --expiring-only`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			log.SetLevel(log.WarnLevel)
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(config)
+			argoDB := db.NewDB(namespace, settings.NewSettingsManager(ctx, kubeClient, namespace), kubeClient)
+
+			expiries, err := argoDB.ListCredentialExpiries(ctx)
+			errors.CheckError(err)
+			if printCredentialExpiries(os.Stdout, expiries, time.Now(), warningPeriod, maxAge, expiringOnly) > 0 {
+				os.Exit(2)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().DurationVar(&warningPeriod, "warning-period", 14*24*time.Hour, "Period before their expiry during which the credentials are reported as expiring")
+	command.Flags().DurationVar(&maxAge, "max-age", 0, "Maximum age of the credentials which do not expire, such as the private keys of GitHub Apps, after which they are reported as expiring")
+	command.Flags().BoolVar(&expiringOnly, "expiring-only", false, "Only report the expired and expiring credentials")
+	return command
+}
+
+// printCredentialExpiries prints a table of the given credential expiries, and returns the number of expired or
+// expiring credentials
+func printCredentialExpiries(out io.Writer, expiries []db.CredentialExpiry, now time.Time, warningPeriod time.Duration, maxAge time.Duration, expiringOnly bool) int {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	expiring := 0
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "KIND\tOWNER\tTYPE\tISSUED\tDEADLINE\tSTATUS\n")
+	for _, expiry := range expiries {
+		deadline := expiry.Deadline(maxAge)
+		status := "OK"
+		switch {
+		case deadline == nil:
+			status = "Unknown"
+		case !deadline.After(now):
+			status = "Expired"
+		case expiry.ExpiresWithin(now, warningPeriod, maxAge):
+			status = "Expiring"
+		}
+		if status == "Expired" || status == "Expiring" {
+			expiring++
+		} else if expiringOnly {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", expiry.OwnerKind, expiry.Owner, expiry.Type, formatTime(expiry.IssuedAt), formatTime(deadline), status)
+	}
+	_ = w.Flush()
+	return expiring
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/util/db"
+)
+
+func TestPrintCredentialExpiries(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Hour)
+	soon := now.Add(24 * time.Hour)
+	later := now.Add(365 * 24 * time.Hour)
+	issued := now.Add(-100 * 24 * time.Hour)
+	expiries := []db.CredentialExpiry{
+		{OwnerKind: db.CredentialOwnerCluster, Owner: "https://kubernetes.example.com", Type: db.CredentialTypeToken, ExpiresAt: &expired},
+		{OwnerKind: db.CredentialOwnerRepository, Owner: "https://git.example.com/a", Type: db.CredentialTypeTLSClientCertificate, ExpiresAt: &soon},
+		{OwnerKind: db.CredentialOwnerRepository, Owner: "https://git.example.com/b", Type: db.CredentialTypeTLSClientCertificate, ExpiresAt: &later},
+		{OwnerKind: db.CredentialOwnerRepositoryCredentials, Owner: "https://github.com/org", Type: db.CredentialTypeGitHubAppPrivateKey, IssuedAt: &issued},
+	}
+
+	out := &bytes.Buffer{}
+	assert.Equal(t, 2, printCredentialExpiries(out, expiries, now, 7*24*time.Hour, 0, false))
+	assert.Equal(t, `KIND                   OWNER                           TYPE                  ISSUED                DEADLINE              STATUS
+Cluster                https://kubernetes.example.com  Token                 -                     2023-05-31T23:00:00Z  Expired
+Repository             https://git.example.com/a       TLSClientCertificate  -                     2023-06-02T00:00:00Z  Expiring
+Repository             https://git.example.com/b       TLSClientCertificate  -                     2024-05-31T00:00:00Z  OK
+RepositoryCredentials  https://github.com/org          GitHubAppPrivateKey   2023-02-21T00:00:00Z  -                     Unknown
+`, out.String())
+
+	out.Reset()
+	assert.Equal(t, 3, printCredentialExpiries(out, expiries, now, 7*24*time.Hour, 90*24*time.Hour, true))
+	assert.NotContains(t, out.String(), "https://git.example.com/b")
+	assert.Contains(t, out.String(), "2023-05-22T00:00:00Z  Expired")
+}
@@ -0,0 +1,108 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/migration"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// NewMigrationCommand defines a new command to manage the migrations of the Argo CD configuration and state
+func NewMigrationCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "migration",
+		Short: "Manage the migrations of the Argo CD configuration and state",
+		Long:  "The migrations are applied by the application controller on start. Their history is recorded in the argocd-migration-history secret.",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewMigrationListCommand())
+	command.AddCommand(NewMigrationRunCommand())
+	return command
+}
+
+func newMigrator(ctx context.Context, clientConfig clientcmd.ClientConfig, applicationNamespaces []string) *migration.Migrator {
+	config, err := clientConfig.ClientConfig()
+	errors.CheckError(err)
+	errors.CheckError(v1alpha1.SetK8SConfigDefaults(config))
+	namespace, _, err := clientConfig.Namespace()
+	errors.CheckError(err)
+	kubeClientset := kubernetes.NewForConfigOrDie(config)
+	return migration.NewMigrator(&migration.Context{
+		Namespace:             namespace,
+		ApplicationNamespaces: applicationNamespaces,
+		KubeClientset:         kubeClientset,
+		AppClientset:          appclientset.NewForConfigOrDie(config),
+		SettingsMgr:           settings.NewSettingsManager(ctx, kubeClientset, namespace),
+	}, migration.DefaultMigrations())
+}
+
+// NewMigrationListCommand defines a new command to list the migrations and whether they are applied
+func NewMigrationListCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List the migrations and whether they are applied",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			migrator := newMigrator(ctx, clientConfig, nil)
+			history, err := migrator.History(ctx)
+			errors.CheckError(err)
+			applied := make(map[int]migration.Record)
+			for _, record := range history {
+				applied[record.Version] = record
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT\tARGO CD VERSION\n")
+			for _, m := range migrator.Migrations() {
+				if record, ok := applied[m.Version]; ok {
+					_, _ = fmt.Fprintf(w, "%d\t%s\tApplied\t%s\t%s\n", m.Version, m.Name, record.AppliedAt.String(), record.ArgoCDVersion)
+				} else {
+					_, _ = fmt.Fprintf(w, "%d\t%s\tPending\t\t\n", m.Version, m.Name)
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+// NewMigrationRunCommand defines a new command to apply the pending migrations
+func NewMigrationRunCommand() *cobra.Command {
+	var (
+		clientConfig          clientcmd.ClientConfig
+		applicationNamespaces []string
+	)
+	var command = &cobra.Command{
+		Use:   "run",
+		Short: "Apply the pending migrations",
+		Long:  "Apply the pending migrations, which the application controller otherwise applies on start. The application controller must be stopped, or started with --migrations=false, while running this command.",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			migrator := newMigrator(ctx, clientConfig, applicationNamespaces)
+			errors.CheckError(migrator.Migrate(ctx))
+			fmt.Println("All migrations are applied")
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", []string{}, "List of additional namespaces of the applications")
+	return command
+}
@@ -119,6 +119,18 @@ func NewGenRepoSpecCommand() *cobra.Command {
 				}
 			}
 
+			// Specifying tls-ca-cert-path is only valid for HTTPS repositories
+			if repoOpts.TlsCACertPath != "" {
+				if git.IsHTTPSURL(repoOpts.Repo.Repo) {
+					tlsCACertData, err := os.ReadFile(repoOpts.TlsCACertPath)
+					errors.CheckError(err)
+					repoOpts.Repo.TLSCACertData = string(tlsCACertData)
+				} else {
+					err := fmt.Errorf("--tls-ca-cert-path is only supported for HTTPS repositories")
+					errors.CheckError(err)
+				}
+			}
+
 			// Set repository connection properties only when creating repository, not
 			// when creating repository credentials.
 			// InsecureIgnoreHostKey is deprecated and only here for backwards compat
@@ -143,7 +155,7 @@ func NewGenRepoSpecCommand() *cobra.Command {
 					APIVersion: "v1",
 				},
 				ObjectMeta: v1.ObjectMeta{
-					Name:      common.ArgoCDConfigMapName,
+					Name:      common.GetArgoCDConfigMapName(),
 					Namespace: ArgoCDNamespace,
 					Labels: map[string]string{
 						"app.kubernetes.io/part-of": "argocd",
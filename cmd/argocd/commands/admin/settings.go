@@ -71,7 +71,7 @@ func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.
 			return nil, err
 		}
 
-		argocdCM, err = realClientset.CoreV1().ConfigMaps(ns).Get(ctx, common.ArgoCDConfigMapName, v1.GetOptions{})
+		argocdCM, err = realClientset.CoreV1().ConfigMaps(ns).Get(ctx, common.GetArgoCDConfigMapName(), v1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -103,14 +103,14 @@ func (opts *settingsOpts) createSettingsManager(ctx context.Context) (*settings.
 		if err != nil {
 			return nil, err
 		}
-		argocdSecret, err = realClientset.CoreV1().Secrets(ns).Get(ctx, common.ArgoCDSecretName, v1.GetOptions{})
+		argocdSecret, err = realClientset.CoreV1().Secrets(ns).Get(ctx, common.GetArgoCDSecretName(), v1.GetOptions{})
 		if err != nil {
 			return nil, err
 		}
 	} else {
 		argocdSecret = &corev1.Secret{
 			ObjectMeta: v1.ObjectMeta{
-				Name: common.ArgoCDSecretName,
+				Name: common.GetArgoCDSecretName(),
 			},
 			Data: map[string][]byte{
 				"admin.password":   []byte("test"),
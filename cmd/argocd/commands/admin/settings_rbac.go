@@ -41,6 +41,7 @@ var resourceMap map[string]string = map[string]string{
 	"repo":        rbacpolicy.ResourceRepositories,
 	"repos":       rbacpolicy.ResourceRepositories,
 	"repository":  rbacpolicy.ResourceRepositories,
+	"settings":    rbacpolicy.ResourceSettings,
 }
 
 // List of allowed RBAC resources
@@ -54,6 +55,7 @@ var validRBACResources map[string]bool = map[string]bool{
 	rbacpolicy.ResourceExec:         true,
 	rbacpolicy.ResourceProjects:     true,
 	rbacpolicy.ResourceRepositories: true,
+	rbacpolicy.ResourceSettings:     true,
 }
 
 // List of allowed RBAC actions
@@ -304,7 +306,7 @@ func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
 
 // getPolicyConfigMap fetches the RBAC config map from K8s cluster
 func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namespace string) (*corev1.ConfigMap, error) {
-	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDRBACConfigMapName, v1.GetOptions{})
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, common.GetArgoCDRBACConfigMapName(), v1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
+	argocommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/controller"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
@@ -76,6 +77,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationCreateCommand(clientOpts))
 	command.AddCommand(NewApplicationGetCommand(clientOpts))
 	command.AddCommand(NewApplicationDiffCommand(clientOpts))
+	command.AddCommand(NewApplicationDiffRevisionsCommand(clientOpts))
 	command.AddCommand(NewApplicationSetCommand(clientOpts))
 	command.AddCommand(NewApplicationUnsetCommand(clientOpts))
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
@@ -86,12 +88,18 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationApproveOpCommand(clientOpts))
+	command.AddCommand(NewApplicationExtendTTLCommand(clientOpts))
+	command.AddCommand(NewApplicationDeletionProgressCommand(clientOpts))
+	command.AddCommand(NewApplicationForceDetachCommand(clientOpts))
+	command.AddCommand(NewApplicationDoctorCommand(clientOpts))
 	command.AddCommand(NewApplicationEditCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
+	command.AddCommand(NewApplicationListResourceEventsCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	return command
 }
@@ -315,6 +323,12 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				if showOperation && app.Status.OperationState != nil {
 					fmt.Println()
 					printOperationResult(app.Status.OperationState)
+					if syncRes := app.Status.OperationState.SyncResult; syncRes != nil && len(syncRes.ResourceOperations) > 0 {
+						fmt.Println()
+						w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+						printResourceOperations(w, syncRes.ResourceOperations)
+						_ = w.Flush()
+					}
 				}
 				if showParams {
 					printParams(app)
@@ -917,7 +931,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			})
 			errors.CheckError(err)
 
-			resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
+			resources, err := getManagedResources(ctx, appIf, &applicationpkg.ResourcesQuery{ApplicationName: &appName, AppNamespace: &appNs})
 			errors.CheckError(err)
 			conn, settingsIf := clientset.NewSettingsClientOrDie()
 			defer argoio.Close(conn)
@@ -1486,6 +1500,17 @@ func printAppResources(w io.Writer, app *argoappv1.Application) {
 	}
 }
 
+func printResourceOperations(w io.Writer, traces []argoappv1.ResourceOperationTrace) {
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tOPERATION\tDURATION\tMESSAGE\n")
+	for _, trace := range traces {
+		message := trace.Message
+		if trace.Failed {
+			message = "failed: " + message
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", trace.Group, trace.Kind, trace.Namespace, trace.Name, trace.Operation, time.Duration(trace.DurationMilliseconds)*time.Millisecond, message)
+	}
+}
+
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -1712,7 +1737,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					}
 				}
 				if diffChanges {
-					resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{
+					resources, err := getManagedResources(ctx, appIf, &applicationpkg.ResourcesQuery{
 						ApplicationName: &appName,
 						AppNamespace:    &appNs,
 					})
@@ -1735,10 +1760,12 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						fmt.Printf("====== No Differences found ======\n")
 					}
 				}
-				_, err = appIf.Sync(ctx, &syncReq)
+				syncedApp, err := appIf.Sync(ctx, &syncReq)
 				errors.CheckError(err)
 
-				if !async {
+				if syncedApp.Operation != nil && syncedApp.Operation.IsPendingApproval() {
+					fmt.Printf("Application '%s' operation is pending approval\n", appQualifiedName)
+				} else if !async {
 					app, err := waitOnApplicationStatus(ctx, acdClient, appQualifiedName, timeout, watchOpts{operation: true}, selectedResources)
 					errors.CheckError(err)
 
@@ -2134,17 +2161,31 @@ func printApplicationHistoryIds(revHistory []argoappv1.RevisionHistory) {
 // Print a history table for an application.
 func printApplicationHistoryTable(revHistory []argoappv1.RevisionHistory) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\n")
+	_, _ = fmt.Fprintf(w, "ID\tDATE\tREVISION\tINITIATED BY\n")
 	for _, depInfo := range revHistory {
 		rev := depInfo.Source.TargetRevision
 		if len(depInfo.Revision) >= 7 {
 			rev = fmt.Sprintf("%s (%s)", rev, depInfo.Revision[0:7])
 		}
-		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev)
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, rev, formatOperationInitiator(depInfo.InitiatedBy))
 	}
 	_ = w.Flush()
 }
 
+// formatOperationInitiator returns a human readable description of the initiator of an operation, e.g. admin (CLI)
+func formatOperationInitiator(initiator argoappv1.OperationInitiator) string {
+	switch {
+	case initiator.Automated && initiator.Username != "":
+		return fmt.Sprintf("%s (automated)", initiator.Username)
+	case initiator.Automated:
+		return "automated sync policy"
+	case initiator.Username != "" && initiator.Client != "":
+		return fmt.Sprintf("%s (%s)", initiator.Username, initiator.Client)
+	default:
+		return initiator.Username
+	}
+}
+
 // NewApplicationHistoryCommand returns a new instance of an `argocd app history` command
 func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -2177,6 +2218,72 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
+	command.AddCommand(NewApplicationHistoryDiffCommand(clientOpts))
+	return command
+}
+
+// NewApplicationHistoryDiffCommand returns a new instance of an `argocd app history diff` command
+func NewApplicationHistoryDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		exitCode bool
+		output   string
+	)
+	shortDesc := "Perform a diff between the manifests deployed by two entries of the history of an application."
+	var command = &cobra.Command{
+		Use:   "diff APPNAME ID1 ID2",
+		Short: shortDesc,
+		Long:  shortDesc + "\nThe manifests are generated from the current source of the application at the revisions of the history entries.\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
+		Example: `  # Show what the deployment with the history ID 5 changed
+  argocd app history diff my-app 4 5
+
+  # Print the changes in JSON
+  argocd app history diff my-app 4 5 -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(2)
+			}
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := clientset.NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			var revisions []string
+			for _, arg := range args[1:] {
+				historyId, err := strconv.ParseInt(arg, 10, 64)
+				errors.CheckError(err)
+				depInfo, err := findRevisionHistory(app, historyId)
+				errors.CheckError(err)
+				revision := depInfo.Revision
+				if revision == "" && len(depInfo.Revisions) > 0 {
+					// the manifests are generated from the first source of multi-source applications
+					revision = depInfo.Revisions[0]
+				}
+				revisions = append(revisions, revision)
+			}
+			res, err := getRevisionsDiff(ctx, clientset, appIf, &applicationpkg.ApplicationRevisionsDiffQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				BaseRevision: pointer.String(revisions[0]),
+				HeadRevision: pointer.String(revisions[1]),
+			})
+			errors.CheckError(err)
+
+			foundDiffs := printRevisionsDiff(res, output)
+			if foundDiffs && exitCode {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff")
+	command.Flags().StringVarP(&output, "output", "o", "unified", "Output format. One of: unified|json|yaml")
 	return command
 }
 
@@ -2282,12 +2389,23 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	var (
 		source        string
 		revision      string
+		diffRevision  string
 		local         string
 		localRepoRoot string
+		kustomization string
+		patches       string
 	)
 	var command = &cobra.Command{
 		Use:   "manifests APPNAME",
 		Short: "Print manifests of an application",
+		Example: `  # Print the manifests of an application at a revision
+  argocd app manifests my-app --revision v1.2.0
+
+  # Print the differences between the manifests of an application at two revisions
+  argocd app manifests my-app --revision v1.2.0 --diff-revision v1.3.0
+
+  # Print the manifests of an application as if a kustomize overlay and patches were applied to them
+  argocd app manifests my-app --post-renderer-kustomization kustomization.yaml --post-renderer-patches patches.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -2296,10 +2414,35 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				os.Exit(1)
 			}
 			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			postRenderer := getPostRenderer(kustomization, patches)
+			if postRenderer != nil && (source != "git" || local != "" || diffRevision != "") {
+				log.Fatal("--post-renderer-kustomization and --post-renderer-patches can only be used with the manifests stored in git")
+			}
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
 			defer argoio.Close(conn)
-			resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{
+
+			if diffRevision != "" {
+				if source != "git" || local != "" {
+					log.Fatal("--diff-revision can only be used with the manifests stored in git")
+				}
+				baseRevision := revision
+				if baseRevision == "" {
+					app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+					errors.CheckError(err)
+					baseRevision = app.Spec.GetSource().TargetRevision
+				}
+				res, err := getRevisionsDiff(ctx, clientset, appIf, &applicationpkg.ApplicationRevisionsDiffQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					BaseRevision: pointer.String(baseRevision),
+					HeadRevision: pointer.String(diffRevision),
+				})
+				errors.CheckError(err)
+				printRevisionsDiff(res, "unified")
+				return
+			}
+			resources, err := getManagedResources(ctx, appIf, &applicationpkg.ResourcesQuery{
 				ApplicationName: &appName,
 				AppNamespace:    &appNs,
 			})
@@ -2323,11 +2466,14 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 					errors.CheckError(err)
 
 					unstructureds = getLocalObjects(context.Background(), app, local, localRepoRoot, argoSettings.AppLabelKey, cluster.ServerVersion, cluster.Info.APIVersions, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins, argoSettings.TrackingMethod)
-				} else if revision != "" {
+				} else if revision != "" || postRenderer != nil {
 					q := applicationpkg.ApplicationManifestQuery{
 						Name:         &appName,
 						AppNamespace: &appNs,
-						Revision:     pointer.String(revision),
+						PostRenderer: postRenderer,
+					}
+					if revision != "" {
+						q.Revision = pointer.String(revision)
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
@@ -2360,13 +2506,201 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	}
 	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git")
 	command.Flags().StringVar(&revision, "revision", "", "Show manifests at a specific revision")
+	command.Flags().StringVar(&diffRevision, "diff-revision", "", "Show the differences between the manifests at --revision, or at the target revision if omitted, and at this revision")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().StringVar(&kustomization, "post-renderer-kustomization", "", "Path to a kustomization.yaml file applied as an overlay to the generated manifests, without modifying the application")
+	command.Flags().StringVar(&patches, "post-renderer-patches", "", "Path to a YAML file with a list of patches applied to the generated manifests, without modifying the application. Each patch has a patch, a patchType (json, merge or strategic) and optionally a group, kind, name and namespace selecting the patched manifests")
+	return command
+}
+
+// getPostRenderer returns the post-renderer made of the given kustomization and patches files, or nil if none
+func getPostRenderer(kustomizationPath string, patchesPath string) *repoapiclient.ManifestPostRenderer {
+	if kustomizationPath == "" && patchesPath == "" {
+		return nil
+	}
+	postRenderer := &repoapiclient.ManifestPostRenderer{}
+	if kustomizationPath != "" {
+		data, err := os.ReadFile(kustomizationPath)
+		errors.CheckError(err)
+		postRenderer.Kustomization = string(data)
+	}
+	if patchesPath != "" {
+		data, err := os.ReadFile(patchesPath)
+		errors.CheckError(err)
+		errors.CheckError(yaml.Unmarshal(data, &postRenderer.Patches))
+	}
+	return postRenderer
+}
+
+// NewApplicationDiffRevisionsCommand returns a new instance of an `argocd app diff-revisions` command
+func NewApplicationDiffRevisionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		exitCode bool
+		output   string
+	)
+	shortDesc := "Perform a diff between the manifests of an application at two revisions."
+	var command = &cobra.Command{
+		Use:   "diff-revisions APPNAME BASE_REVISION HEAD_REVISION",
+		Short: shortDesc,
+		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found",
+		Example: `  # Show what changes between the deployed revision and a pull request
+  argocd app diff-revisions my-app $(argocd app get my-app -o json | jq -r .status.sync.revision) feature-branch`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(2)
+			}
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := clientset.NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			res, err := getRevisionsDiff(ctx, clientset, appIf, &applicationpkg.ApplicationRevisionsDiffQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				BaseRevision: pointer.String(args[1]),
+				HeadRevision: pointer.String(args[2]),
+			})
+			errors.CheckError(err)
+
+			foundDiffs := printRevisionsDiff(res, output)
+			if foundDiffs && exitCode {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff")
+	command.Flags().StringVarP(&output, "output", "o", "unified", "Output format. One of: unified|json|yaml")
 	return command
 }
 
+// getRevisionsDiff returns the differences between the manifests of an application at two revisions. The manifests are
+// diffed by the CLI if the API server does not support diffing revisions.
+func getRevisionsDiff(ctx context.Context, acdClient argocdclient.Client, appIf applicationpkg.ApplicationServiceClient, q *applicationpkg.ApplicationRevisionsDiffQuery) (*applicationpkg.ApplicationRevisionsDiffResponse, error) {
+	if serverHasFeature(getServerVersionIfAvailable(ctx, acdClient), argocommon.FeatureRevisionsDiff) {
+		return appIf.RevisionsDiff(ctx, q)
+	}
+	log.Warn("The API server does not support diffing revisions, the manifests are diffed by the CLI without comparing the data of secrets")
+	base, err := appIf.GetManifests(ctx, &applicationpkg.ApplicationManifestQuery{Name: q.Name, AppNamespace: q.AppNamespace, Revision: q.BaseRevision})
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifests at base revision: %w", err)
+	}
+	head, err := appIf.GetManifests(ctx, &applicationpkg.ApplicationManifestQuery{Name: q.Name, AppNamespace: q.AppNamespace, Revision: q.HeadRevision})
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifests at head revision: %w", err)
+	}
+	return diffManifests(base, head)
+}
+
+// diffManifests returns the differences between the given manifests of an application
+func diffManifests(base *repoapiclient.ManifestResponse, head *repoapiclient.ManifestResponse) (*applicationpkg.ApplicationRevisionsDiffResponse, error) {
+	objsByKey := func(manifests []string) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+		objs := map[kube.ResourceKey]*unstructured.Unstructured{}
+		for _, manifest := range manifests {
+			obj, err := argoappv1.UnmarshalToUnstructured(manifest)
+			if err != nil {
+				return nil, err
+			}
+			objs[kube.GetResourceKey(obj)] = obj
+		}
+		return objs, nil
+	}
+	baseObjs, err := objsByKey(base.Manifests)
+	if err != nil {
+		return nil, err
+	}
+	headObjs, err := objsByKey(head.Manifests)
+	if err != nil {
+		return nil, err
+	}
+	var keys []kube.ResourceKey
+	for key := range baseObjs {
+		keys = append(keys, key)
+	}
+	for key := range headObjs {
+		if _, ok := baseObjs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	res := &applicationpkg.ApplicationRevisionsDiffResponse{
+		BaseRevision: pointer.String(base.Revision),
+		HeadRevision: pointer.String(head.Revision),
+	}
+	for _, key := range keys {
+		baseObj, headObj := baseObjs[key], headObjs[key]
+		item := &applicationpkg.ResourceRevisionsDiff{
+			Group:     pointer.String(key.Group),
+			Kind:      pointer.String(key.Kind),
+			Namespace: pointer.String(key.Namespace),
+			Name:      pointer.String(key.Name),
+			Modified:  pointer.Bool(baseObj == nil || headObj == nil || !reflect.DeepEqual(baseObj.Object, headObj.Object)),
+		}
+		if baseObj != nil {
+			data, err := json.Marshal(baseObj)
+			if err != nil {
+				return nil, err
+			}
+			item.BaseState = pointer.String(string(data))
+		}
+		if headObj != nil {
+			data, err := json.Marshal(headObj)
+			if err != nil {
+				return nil, err
+			}
+			item.HeadState = pointer.String(string(data))
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
+// printRevisionsDiff prints the differences between the manifests of an application at two revisions, returns boolean
+// as true if difference is found else returns false
+func printRevisionsDiff(res *applicationpkg.ApplicationRevisionsDiffResponse, output string) bool {
+	var modified []*applicationpkg.ResourceRevisionsDiff
+	for _, item := range res.Items {
+		if item.GetModified() {
+			modified = append(modified, item)
+		}
+	}
+	switch output {
+	case "json", "yaml":
+		err := PrintResource(&applicationpkg.ApplicationRevisionsDiffResponse{
+			Items:        modified,
+			BaseRevision: res.BaseRevision,
+			HeadRevision: res.HeadRevision,
+		}, output)
+		errors.CheckError(err)
+	case "unified":
+		fmt.Printf("====== Differences between revisions %s and %s ======\n", res.GetBaseRevision(), res.GetHeadRevision())
+		for _, item := range modified {
+			fmt.Printf("\n===== %s/%s %s/%s ======\n", item.GetGroup(), item.GetKind(), item.GetNamespace(), item.GetName())
+			var base, head *unstructured.Unstructured
+			var err error
+			if item.GetBaseState() != "" {
+				base, err = argoappv1.UnmarshalToUnstructured(item.GetBaseState())
+				errors.CheckError(err)
+			}
+			if item.GetHeadState() != "" {
+				head, err = argoappv1.UnmarshalToUnstructured(item.GetHeadState())
+				errors.CheckError(err)
+			}
+			_ = cli.PrintDiff(item.GetName(), base, head)
+		}
+	default:
+		errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+	}
+	return len(modified) > 0
+}
+
 // NewApplicationTerminateOpCommand returns a new instance of an `argocd app terminate-op` command
 func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var force bool
 	var command = &cobra.Command{
 		Use:   "terminate-op APPNAME",
 		Short: "Terminate running operation of an application",
@@ -2383,14 +2717,228 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 			_, err := appIf.TerminateOperation(ctx, &applicationpkg.OperationTerminateRequest{
 				Name:         &appName,
 				AppNamespace: &appNs,
+				Force:        &force,
 			})
 			errors.CheckError(err)
-			fmt.Printf("Application '%s' operation terminating\n", appName)
+			if force {
+				fmt.Printf("Application '%s' operation cleared\n", appName)
+			} else {
+				fmt.Printf("Application '%s' operation terminating\n", appName)
+			}
 		},
 	}
+	command.Flags().BoolVar(&force, "force", false, "Clear the operation and its state immediately, e.g. if the operation is stuck because the controller which was processing it is gone")
 	return command
 }
 
+// NewApplicationApproveOpCommand returns a new instance of an `argocd app approve-op` command
+func NewApplicationApproveOpCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "approve-op APPNAME",
+		Short: "Approve the operation of an application pending approval",
+		Example: `  # Approve the sync of a production application initiated by another user
+  argocd app approve-op guestbook-prod`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.ApproveOperation(ctx, &applicationpkg.OperationApproveRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' operation approved\n", appName)
+		},
+	}
+	return command
+}
+
+// NewApplicationExtendTTLCommand returns a new instance of an `argocd app extend-ttl` command
+func NewApplicationExtendTTLCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var duration time.Duration
+	var command = &cobra.Command{
+		Use:   "extend-ttl APPNAME",
+		Short: "Postpone the expiry of an application with a TTL",
+		Example: `  # Postpone the expiry of a preview application by one day
+  argocd app extend-ttl guestbook-pr-42 --duration 24h`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			durationStr := duration.String()
+			app, err := appIf.ExtendTTL(ctx, &applicationpkg.ApplicationExtendTTLRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Duration:     &durationStr,
+			})
+			errors.CheckError(err)
+			expiresAt, err := app.ExpiresAt()
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' expires at %s\n", appName, expiresAt.UTC().Format(time.RFC3339))
+		},
+	}
+	command.Flags().DurationVar(&duration, "duration", time.Hour, "Duration added to the expiry of the application, or to the current time if the application expires earlier")
+	return command
+}
+
+// NewApplicationDeletionProgressCommand returns a new instance of an `argocd app deletion-progress` command
+func NewApplicationDeletionProgressCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "deletion-progress APPNAME",
+		Short: "Show the resources which remain while an application is deleted and why",
+		Example: `  # Show why the deletion of an application is stuck
+  argocd app deletion-progress guestbook`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			progress, err := appIf.GetDeletionProgress(ctx, &applicationpkg.ApplicationDeletionProgressQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(progress, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printDeletionProgress(progress)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printDeletionProgress(progress *applicationpkg.ApplicationDeletionProgress) {
+	fmt.Printf(printOpFmtStr, "Deleting:", strconv.FormatBool(progress.GetDeleting()))
+	fmt.Printf(printOpFmtStr, "Cascade:", strconv.FormatBool(progress.GetCascade()))
+	if progress.GetPropagationPolicy() != "" {
+		fmt.Printf(printOpFmtStr, "Propagation Policy:", progress.GetPropagationPolicy())
+	}
+	fmt.Printf(printOpFmtStr, "Finalizers:", strings.Join(progress.Finalizers, ","))
+	for _, err := range progress.Errors {
+		fmt.Printf(printOpFmtStr, "Error:", err)
+	}
+	if len(progress.Resources) == 0 {
+		return
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tDELETING\tMESSAGE\n")
+	for _, res := range progress.Resources {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n", res.GetGroup(), res.GetKind(), res.GetNamespace(), res.GetName(), res.GetDeleting(), res.GetMessage())
+	}
+	_ = w.Flush()
+}
+
+// NewApplicationForceDetachCommand returns a new instance of an `argocd app force-detach` command
+func NewApplicationForceDetachCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var yes bool
+	var command = &cobra.Command{
+		Use:   "force-detach APPNAME",
+		Short: "Complete the deletion of an application without waiting for its resources, which are left in the cluster",
+		Example: `  # Complete the deletion of an application stuck on resources which cannot be deleted
+  argocd app force-detach guestbook`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			if !yes && !cli.AskToProceed(fmt.Sprintf("The remaining resources of application '%s' will be left in the cluster. Are you sure you want to continue? [y/n] ", appName)) {
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			_, err := appIf.ForceDetach(ctx, &applicationpkg.ApplicationForceDetachRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' detached from its resources\n", appName)
+		},
+	}
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Turn off prompting to confirm the detachment")
+	return command
+}
+
+// NewApplicationDoctorCommand returns a new instance of an `argocd app doctor` command
+func NewApplicationDoctorCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "doctor APPNAME",
+		Short: "Run diagnostics of the repositories, revisions, manifests and destination of an application",
+		Long:  "Run diagnostics of the repositories, revisions, manifests and destination of an application. Exits with code 2 if a check failed.",
+		Example: `  # Find out why an application cannot be synced
+  argocd app doctor guestbook`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			report, err := appIf.Doctor(ctx, &applicationpkg.ApplicationDoctorQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(report, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printDoctorReport(report)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+			for _, finding := range report.Findings {
+				if finding.GetStatus() == "Failed" {
+					os.Exit(2)
+				}
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func printDoctorReport(report *applicationpkg.ApplicationDoctorReport) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "CHECK\tSTATUS\tSUBJECT\tMESSAGE\n")
+	for _, finding := range report.Findings {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", finding.GetCheck(), finding.GetStatus(), finding.GetSubject(), finding.GetMessage())
+	}
+	_ = w.Flush()
+}
+
 func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "edit APPNAME",
@@ -2448,6 +2996,8 @@ func NewApplicationEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var patch string
 	var patchType string
+	var resourceVersion string
+	var dryRun bool
 
 	command := cobra.Command{
 		Use:   "patch APPNAME",
@@ -2457,7 +3007,13 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	argocd app patch myapplication --patch='[{"op": "replace", "path": "/spec/source/path", "value": "newPath"}]' --type json
 
 	# Update an application's repository target revision using merge patch
-	argocd app patch myapplication --patch '{"spec": { "source": { "targetRevision": "master" } }}' --type merge`,
+	argocd app patch myapplication --patch '{"spec": { "source": { "targetRevision": "master" } }}' --type merge
+
+	# Update an application's repository target revision only if it has not been modified since it has been read
+	argocd app patch myapplication --patch '{"spec": { "source": { "targetRevision": "master" } }}' --type merge --resource-version 123456
+
+	# Show an application as patched without updating it
+	argocd app patch myapplication --patch '{"spec": { "source": { "targetRevision": "master" } }}' --type strategic --dry-run`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -2470,10 +3026,12 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 			defer argoio.Close(conn)
 
 			patchedApp, err := appIf.Patch(ctx, &applicationpkg.ApplicationPatchRequest{
-				Name:         &appName,
-				Patch:        &patch,
-				PatchType:    &patchType,
-				AppNamespace: &appNs,
+				Name:            &appName,
+				Patch:           &patch,
+				PatchType:       &patchType,
+				AppNamespace:    &appNs,
+				ResourceVersion: &resourceVersion,
+				DryRun:          &dryRun,
 			})
 			errors.CheckError(err)
 
@@ -2485,6 +3043,8 @@ func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	}
 
 	command.Flags().StringVar(&patch, "patch", "", "Patch body")
-	command.Flags().StringVar(&patchType, "type", "json", "The type of patch being provided; one of [json merge]")
+	command.Flags().StringVar(&patchType, "type", "json", "The type of patch being provided; one of [json merge strategic]")
+	command.Flags().StringVar(&resourceVersion, "resource-version", "", "Only patch the application if it has this resource version")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Show the patched application without updating it")
 	return &command
 }
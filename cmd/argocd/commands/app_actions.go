@@ -188,7 +188,7 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 }
 
 func getActionableResourcesForApplication(appIf applicationpkg.ApplicationServiceClient, ctx context.Context, appNs *string, appName *string) ([]*v1alpha1.ResourceDiff, error) {
-	resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{
+	resources, err := getManagedResources(ctx, appIf, &applicationpkg.ResourcesQuery{
 		ApplicationName: appName,
 		AppNamespace:    appNs,
 	})
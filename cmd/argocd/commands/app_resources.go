@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 
@@ -10,6 +13,8 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
@@ -58,7 +63,7 @@ func NewApplicationPatchResourceCommand(clientOpts *argocdclient.ClientOptions)
 
 		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 		defer argoio.Close(conn)
-		resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{
+		resources, err := getManagedResources(ctx, appIf, &applicationpkg.ResourcesQuery{
 			ApplicationName: &appName,
 			AppNamespace:    &appNs,
 		})
@@ -120,7 +125,7 @@ func NewApplicationDeleteResourceCommand(clientOpts *argocdclient.ClientOptions)
 
 		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 		defer argoio.Close(conn)
-		resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{
+		resources, err := getManagedResources(ctx, appIf, &applicationpkg.ResourcesQuery{
 			ApplicationName: &appName,
 			AppNamespace:    &appNs,
 		})
@@ -185,7 +190,7 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer argoio.Close(conn)
-			appResourceTree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{
+			appResourceTree, err := getResourceTree(ctx, appIf, &applicationpkg.ResourcesQuery{
 				ApplicationName: &appName,
 				AppNamespace:    &appNs,
 			})
@@ -196,3 +201,97 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 	command.Flags().BoolVar(&orphaned, "orphaned", false, "Lists only orphaned resources")
 	return command
 }
+
+// NewApplicationListResourceEventsCommand returns a new instance of an `argocd app resource-events` command
+func NewApplicationListResourceEventsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var eventType string
+	var command = &cobra.Command{
+		Use:   "resource-events APPNAME",
+		Short: "List the deduplicated events of all the resources of an application",
+		Example: `  # List the events of all the resources of an application
+  argocd app resource-events guestbook
+
+  # List only the warning events
+  argocd app resource-events guestbook --type Warning`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseAppQualifiedName(args[0], "")
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			res, err := appIf.ListAggregatedResourceEvents(ctx, &applicationpkg.ApplicationAggregatedEventsQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Type:         &eventType,
+			})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmtStr := "%s\t%s\t%s\t%s\t%s\t%d\t%s\n"
+			_, _ = fmt.Fprintf(w, "LAST SEEN\tTYPE\tREASON\tKIND\tNAME\tCOUNT\tMESSAGE\n")
+			for _, event := range res.Items {
+				lastSeen := ""
+				if event.LastTimestamp != nil {
+					lastSeen = event.LastTimestamp.UTC().Format(time.RFC3339)
+				}
+				_, _ = fmt.Fprintf(w, fmtStr, lastSeen, event.GetType(), event.GetReason(), event.ResourceRef.Kind, event.ResourceRef.Name, event.GetCount(), event.GetMessage())
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringVar(&eventType, "type", "", "Lists only the events of the given type, i.e. Normal or Warning")
+	return command
+}
+
+// getManagedResources returns the managed resources of an application. The resources are streamed in chunks, unless the
+// server does not support it, so that the resources of large applications do not exceed the maximum gRPC message size.
+func getManagedResources(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, q *applicationpkg.ResourcesQuery) (*applicationpkg.ManagedResourcesResponse, error) {
+	stream, err := appIf.StreamManagedResources(ctx, q)
+	if err == nil {
+		res := &applicationpkg.ManagedResourcesResponse{}
+		for {
+			var chunk *applicationpkg.ManagedResourcesResponse
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				return res, nil
+			}
+			if err != nil {
+				break
+			}
+			res.Items = append(res.Items, chunk.Items...)
+		}
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return nil, err
+	}
+	return appIf.ManagedResources(ctx, q)
+}
+
+// getResourceTree returns the resource tree of an application. The tree is streamed in chunks, unless the server does
+// not support it, so that the tree of large applications does not exceed the maximum gRPC message size.
+func getResourceTree(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, q *applicationpkg.ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
+	stream, err := appIf.StreamResourceTree(ctx, q)
+	if err == nil {
+		tree := &v1alpha1.ApplicationTree{}
+		for {
+			var chunk *v1alpha1.ApplicationTree
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				return tree, nil
+			}
+			if err != nil {
+				break
+			}
+			tree.Nodes = append(tree.Nodes, chunk.Nodes...)
+			tree.OrphanedNodes = append(tree.OrphanedNodes, chunk.OrphanedNodes...)
+			tree.Hosts = append(tree.Hosts, chunk.Hosts...)
+		}
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return nil, err
+	}
+	return appIf.ResourceTree(ctx, q)
+}
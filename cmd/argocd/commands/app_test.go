@@ -7,8 +7,10 @@ import (
 	"time"
 
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/google/go-cmp/cmp"
@@ -16,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
 )
 
 func Test_getInfos(t *testing.T) {
@@ -468,6 +471,14 @@ func TestPrintApplicationHistoryTable(t *testing.T) {
 			Source: v1alpha1.ApplicationSource{
 				TargetRevision: "3",
 			},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "admin", Client: v1alpha1.OperationInitiatorClientCLI},
+		},
+		{
+			ID: 4,
+			Source: v1alpha1.ApplicationSource{
+				TargetRevision: "4",
+			},
+			InitiatedBy: v1alpha1.OperationInitiator{Automated: true},
 		},
 	}
 
@@ -476,13 +487,83 @@ func TestPrintApplicationHistoryTable(t *testing.T) {
 		return nil
 	})
 
-	expectation := "ID  DATE                           REVISION\n1   0001-01-01 00:00:00 +0000 UTC  1\n2   0001-01-01 00:00:00 +0000 UTC  2\n3   0001-01-01 00:00:00 +0000 UTC  3\n"
+	expectation := "ID  DATE                           REVISION  INITIATED BY\n1   0001-01-01 00:00:00 +0000 UTC  1         \n2   0001-01-01 00:00:00 +0000 UTC  2         \n3   0001-01-01 00:00:00 +0000 UTC  3         admin (CLI)\n4   0001-01-01 00:00:00 +0000 UTC  4         automated sync policy\n"
 
 	if output != expectation {
 		t.Fatalf("Incorrect print operation output %q, should be %q", output, expectation)
 	}
 }
 
+func TestPrintRevisionsDiff_JSON(t *testing.T) {
+	res := &applicationpkg.ApplicationRevisionsDiffResponse{
+		Items: []*applicationpkg.ResourceRevisionsDiff{
+			{Kind: pointer.String("ConfigMap"), Name: pointer.String("unchanged"), Modified: pointer.Bool(false)},
+			{Kind: pointer.String("ConfigMap"), Name: pointer.String("added"), HeadState: pointer.String("{}"), Modified: pointer.Bool(true)},
+		},
+		BaseRevision: pointer.String("a"),
+		HeadRevision: pointer.String("b"),
+	}
+	var foundDiffs bool
+	output, err := captureOutput(func() error {
+		foundDiffs = printRevisionsDiff(res, "json")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.True(t, foundDiffs)
+	assert.Contains(t, output, `"name": "added"`)
+	assert.NotContains(t, output, "unchanged")
+}
+
+func TestPrintRevisionsDiff_NoDiff(t *testing.T) {
+	res := &applicationpkg.ApplicationRevisionsDiffResponse{
+		Items: []*applicationpkg.ResourceRevisionsDiff{
+			{Kind: pointer.String("ConfigMap"), Name: pointer.String("unchanged"), Modified: pointer.Bool(false)},
+		},
+		BaseRevision: pointer.String("a"),
+		HeadRevision: pointer.String("b"),
+	}
+	var foundDiffs bool
+	output, err := captureOutput(func() error {
+		foundDiffs = printRevisionsDiff(res, "unified")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.False(t, foundDiffs)
+	assert.Equal(t, "====== Differences between revisions a and b ======\n", output)
+}
+
+func TestDiffManifests(t *testing.T) {
+	base := &repoapiclient.ManifestResponse{
+		Revision: "a",
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"modified"},"data":{"key":"value"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"removed"}}`,
+		},
+	}
+	head := &repoapiclient.ManifestResponse{
+		Revision: "b",
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"modified"},"data":{"key":"changed"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"added"}}`,
+		},
+	}
+
+	res, err := diffManifests(base, head)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "a", res.GetBaseRevision())
+	assert.Equal(t, "b", res.GetHeadRevision())
+	modified := map[string]bool{}
+	for _, item := range res.Items {
+		modified[item.GetName()] = item.GetModified()
+	}
+	assert.Equal(t, map[string]bool{"unchanged": false, "modified": true, "removed": true, "added": true}, modified)
+}
+
 func TestPrintAppSummaryTable(t *testing.T) {
 	output, _ := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -1498,3 +1579,18 @@ func testApp(name, project string, labels map[string]string, annotations map[str
 		},
 	}
 }
+
+func Test_printDoctorReport(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printDoctorReport(&applicationpkg.ApplicationDoctorReport{Findings: []*applicationpkg.ApplicationDoctorFinding{
+			{Check: pointer.String("RepositoryReachable"), Status: pointer.String("Passed"), Subject: pointer.String("https://github.com/argoproj/argocd-example-apps"), Message: pointer.String("Repository is reachable")},
+			{Check: pointer.String("NamespaceExists"), Status: pointer.String("Failed"), Subject: pointer.String("guestbook"), Message: pointer.String("Namespace does not exist")},
+		}})
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `CHECK                STATUS  SUBJECT                                          MESSAGE
+RepositoryReachable  Passed  https://github.com/argoproj/argocd-example-apps  Repository is reachable
+NamespaceExists      Failed  guestbook                                        Namespace does not exist
+`, output)
+}
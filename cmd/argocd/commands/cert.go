@@ -15,6 +15,7 @@ import (
 	certificatepkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/certificate"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	certutil "github.com/argoproj/argo-cd/v2/util/cert"
+	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/io"
 )
@@ -37,6 +38,9 @@ func NewCertCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
   # Add SSH known host entries for cd.example.com to ArgoCD by scanning host
   ssh-keyscan cd.example.com | argocd cert add-ssh --batch
 
+  # Let the Argo CD server scan the SSH host keys of cd.example.com and store them after confirmation
+  argocd cert scan-ssh cd.example.com
+
   # List all known TLS certificates
   argocd cert list --cert-type https
 
@@ -52,6 +56,7 @@ func NewCertCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewCertAddTLSCommand(clientOpts))
 	command.AddCommand(NewCertListCommand(clientOpts))
 	command.AddCommand(NewCertRemoveCommand(clientOpts))
+	command.AddCommand(NewCertScanSSHCommand(clientOpts))
 	return command
 }
 
@@ -207,6 +212,70 @@ func NewCertAddSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	return command
 }
 
+// NewCertScanSSHCommand returns a new instance of an `argocd cert scan-ssh` command
+func NewCertScanSSHCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		port int32
+		yes  bool
+	)
+	var command = &cobra.Command{
+		Use:   "scan-ssh HOSTNAME",
+		Short: "Scan the public host keys of an SSH server and store them as known host entries",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, certIf := headless.NewClientOrDie(clientOpts, c).NewCertClientOrDie()
+			defer io.Close(conn)
+
+			scanRequest := &certificatepkg.SSHHostKeyScanRequest{HostName: args[0], Port: port}
+			response, err := certIf.ScanHostKeys(ctx, scanRequest)
+			errors.CheckError(err)
+			printSSHHostKeyTable(response.Items)
+
+			for _, hostKey := range response.Items {
+				if hostKey.Status != "unchanged" {
+					scanRequest.Fingerprints = append(scanRequest.Fingerprints, hostKey.Fingerprint)
+				}
+			}
+			if len(scanRequest.Fingerprints) == 0 {
+				fmt.Println("SSH known host entries are up to date")
+				return
+			}
+			if !yes && !cli.AskToProceed("Do you confirm the fingerprints and want to update the SSH known host entries (y/n)? ") {
+				return
+			}
+
+			response, err = certIf.ScanHostKeys(ctx, scanRequest)
+			errors.CheckError(err)
+			applied := 0
+			for _, hostKey := range response.Items {
+				if hostKey.Applied {
+					applied++
+				}
+			}
+			fmt.Printf("Successfully updated %d SSH known host entries\n", applied)
+		},
+	}
+	command.Flags().Int32Var(&port, "port", 22, "Port of the SSH server")
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Update the SSH known host entries without confirmation of the fingerprints")
+	return command
+}
+
+// Print a table of scanned SSH host keys
+func printSSHHostKeyTable(hostKeys []*certificatepkg.SSHHostKey) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "HOSTNAME\tTYPE\tFINGERPRINT\tSTATUS\n")
+	for _, hostKey := range hostKeys {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", hostKey.ServerName, hostKey.KeyType, hostKey.Fingerprint, hostKey.Status)
+	}
+	_ = w.Flush()
+}
+
 // NewCertRemoveCommand returns a new instance of an `argocd cert rm` command
 func NewCertRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -10,6 +10,7 @@ import (
 	"github.com/mattn/go-isatty"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -18,6 +19,7 @@ import (
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/common"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	agentpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
 	clusterpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/cluster"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/cli"
@@ -34,6 +36,8 @@ const (
 	clusterFieldName = "name"
 	// cluster field is 'namespaces'
 	clusterFieldNamespaces = "namespaces"
+	// cluster field is 'maxConcurrentSyncs'
+	clusterFieldMaxConcurrentSyncs = "maxConcurrentSyncs"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -69,7 +73,9 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 	command.AddCommand(NewClusterListCommand(clientOpts))
 	command.AddCommand(NewClusterRemoveCommand(clientOpts, pathOpts))
 	command.AddCommand(NewClusterRotateAuthCommand(clientOpts))
+	command.AddCommand(NewClusterRegisterAgentCommand(clientOpts))
 	command.AddCommand(NewClusterSetCommand(clientOpts))
+	command.AddCommand(NewClusterAPIResourcesCommand(clientOpts))
 	return command
 }
 
@@ -156,6 +162,9 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if clusterOpts.Project != "" {
 				clst.Project = clusterOpts.Project
 			}
+			if clusterOpts.MaxConcurrentSyncs > 0 {
+				clst.MaxConcurrentSyncs = clusterOpts.MaxConcurrentSyncs
+			}
 			clstCreateReq := clusterpkg.ClusterCreateRequest{
 				Cluster: clst,
 				Upsert:  clusterOpts.Upsert,
@@ -167,7 +176,7 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 	}
 	command.PersistentFlags().StringVar(&pathOpts.LoadingRules.ExplicitPath, pathOpts.ExplicitFileFlag, pathOpts.LoadingRules.ExplicitPath, "use a particular kubeconfig file")
 	command.Flags().BoolVar(&clusterOpts.Upsert, "upsert", false, "Override an existing cluster with the same name even if the spec differs")
-	command.Flags().StringVar(&clusterOpts.ServiceAccount, "service-account", "", fmt.Sprintf("System namespace service account to use for kubernetes resource management. If not set then default \"%s\" SA will be created", clusterauth.ArgoCDManagerServiceAccount))
+	command.Flags().StringVar(&clusterOpts.ServiceAccount, "service-account", "", fmt.Sprintf("System namespace service account to use for kubernetes resource management. If not set then default \"%s\" SA will be created", clusterauth.GetArgoCDManagerServiceAccount()))
 	command.Flags().StringVar(&clusterOpts.SystemNamespace, "system-namespace", common.DefaultSystemNamespace, "Use different system namespace")
 	command.Flags().BoolVarP(&skipConfirmation, "yes", "y", false, "Skip explicit confirmation")
 	command.Flags().StringArrayVar(&labels, "label", nil, "Set metadata labels (e.g. --label key=value)")
@@ -232,8 +241,9 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 			if updatedFields != nil {
 				clusterUpdateRequest := clusterpkg.ClusterUpdateRequest{
 					Cluster: &argoappv1.Cluster{
-						Name:       clusterOptions.Name,
-						Namespaces: namespaces,
+						Name:               clusterOptions.Name,
+						Namespaces:         namespaces,
+						MaxConcurrentSyncs: clusterOptions.MaxConcurrentSyncs,
 					},
 					UpdatedFields: updatedFields,
 					Id: &clusterpkg.ClusterID{
@@ -251,6 +261,7 @@ func NewClusterSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	}
 	command.Flags().StringVar(&clusterOptions.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringArrayVar(&clusterOptions.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage. Specify '*' to manage all namespaces")
+	command.Flags().Int64Var(&clusterOptions.MaxConcurrentSyncs, "max-concurrent-syncs", -1, "Maximum number of concurrent sync operations for applications deployed to the cluster. Specify 0 to use the default limit")
 	return command
 }
 
@@ -263,6 +274,9 @@ func checkFieldsToUpdate(clusterOptions cmdutil.ClusterOptions) []string {
 	if clusterOptions.Namespaces != nil {
 		updatedFields = append(updatedFields, clusterFieldNamespaces)
 	}
+	if clusterOptions.MaxConcurrentSyncs >= 0 {
+		updatedFields = append(updatedFields, clusterFieldMaxConcurrentSyncs)
+	}
 	return updatedFields
 }
 
@@ -503,3 +517,104 @@ argocd cluster rotate-auth cluster-name`,
 	}
 	return command
 }
+
+// NewClusterAPIResourcesCommand returns a new instance of an `argocd cluster api-resources` command
+func NewClusterAPIResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output   string
+		apiGroup string
+	)
+	var command = &cobra.Command{
+		Use:   "api-resources SERVER/NAME",
+		Short: "List the API resources discovered on a cluster",
+		Example: `argocd cluster api-resources https://12.34.567.89
+argocd cluster api-resources in-cluster --api-group apps`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer io.Close(conn)
+			resources, err := clusterIf.ListAPIResources(ctx, getQueryBySelector(args[0]))
+			errors.CheckError(err)
+			if c.Flags().Changed("api-group") {
+				var filtered []argoappv1.ClusterAPIResource
+				for _, r := range resources.Items {
+					if r.Group == apiGroup {
+						filtered = append(filtered, r)
+					}
+				}
+				resources.Items = filtered
+			}
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(resources.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printClusterAPIResourcesTable(resources.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVar(&apiGroup, "api-group", "", "Only list the resources of the given API group, an empty group being the core group")
+	return command
+}
+
+// Print table of the API resources of a cluster
+func printClusterAPIResourcesTable(resources []argoappv1.ClusterAPIResource) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tAPIVERSION\tKIND\tNAMESPACED\tEXCLUDED\n")
+	for _, r := range resources {
+		apiVersion := schema.GroupVersion{Group: r.Group, Version: r.Version}.String()
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%t\n", r.Name, apiVersion, r.Kind, r.Namespaced, r.Excluded)
+	}
+	_ = w.Flush()
+}
+
+// NewClusterRegisterAgentCommand returns a new instance of an `argocd cluster register-agent` command
+func NewClusterRegisterAgentCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		project    string
+		namespaces []string
+		upsert     bool
+	)
+	var command = &cobra.Command{
+		Use:   "register-agent NAME",
+		Short: fmt.Sprintf("%s cluster register-agent NAME", cliName),
+		Example: `  # Register the agent of a cluster which Argo CD cannot reach, and print the token the agent authenticates with
+  argocd cluster register-agent edge-cluster
+
+  # Issue a new token to an agent which is already registered
+  argocd cluster register-agent edge-cluster --upsert`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, agentIf := headless.NewClientOrDie(clientOpts, c).NewAgentClientOrDie()
+			defer io.Close(conn)
+
+			res, err := agentIf.Register(ctx, &agentpkg.AgentRegisterRequest{
+				Name:       args[0],
+				Project:    project,
+				Namespaces: namespaces,
+				Upsert:     upsert,
+			})
+			errors.CheckError(err)
+
+			fmt.Printf("Agent '%s' registered as cluster '%s'\n", args[0], res.Server)
+			fmt.Printf("Token: %s\n", res.Token)
+		},
+	}
+	command.Flags().StringVar(&project, "project", "", "project of the cluster")
+	command.Flags().StringArrayVar(&namespaces, "namespace", nil, "List of namespaces which are allowed to manage")
+	command.Flags().BoolVar(&upsert, "upsert", false, "Issue a new token if the agent is already registered")
+	return command
+}
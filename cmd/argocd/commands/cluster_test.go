@@ -47,6 +47,21 @@ func Test_printClusterTable(t *testing.T) {
 	})
 }
 
+func Test_printClusterAPIResourcesTable(t *testing.T) {
+	out, err := captureOutput(func() error {
+		printClusterAPIResourcesTable([]v1alpha1.ClusterAPIResource{
+			{Version: "v1", Kind: "ConfigMap", Name: "configmaps", Namespaced: true},
+			{Group: "cilium.io", Version: "v2", Kind: "CiliumIdentity", Name: "ciliumidentities", Excluded: true},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `NAME              APIVERSION    KIND            NAMESPACED  EXCLUDED
+configmaps        v1            ConfigMap       true        false
+ciliumidentities  cilium.io/v2  CiliumIdentity  false       true
+`, out)
+}
+
 func Test_getRestConfig(t *testing.T) {
 	type args struct {
 		pathOpts *clientcmd.PathOptions
@@ -23,6 +23,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -51,7 +52,7 @@ func (c *forwardCacheClient) doLazy(action func(client cache.CacheClient) error)
 			CurrentContext: c.context,
 		}
 		redisPort, err := kubeutil.PortForward(6379, c.namespace, &overrides,
-			"app.kubernetes.io/name=argocd-redis-ha-haproxy", "app.kubernetes.io/name=argocd-redis")
+			common.InstanceLabelSelector("app.kubernetes.io/name=argocd-redis-ha-haproxy"), common.InstanceLabelSelector("app.kubernetes.io/name=argocd-redis"))
 		if err != nil {
 			c.err = err
 			return
@@ -96,6 +97,22 @@ func (c *forwardCacheClient) NotifyUpdated(key string) error {
 	})
 }
 
+func (c *forwardCacheClient) IncrCounter(key string, delta float64, expiration time.Duration) error {
+	return c.doLazy(func(client cache.CacheClient) error {
+		return client.IncrCounter(key, delta, expiration)
+	})
+}
+
+func (c *forwardCacheClient) GetCounter(key string) (float64, error) {
+	var counter float64
+	err := c.doLazy(func(client cache.CacheClient) error {
+		var err error
+		counter, err = client.GetCounter(key)
+		return err
+	})
+	return counter, err
+}
+
 type forwardRepoClientset struct {
 	namespace     string
 	context       string
@@ -109,7 +126,7 @@ func (c *forwardRepoClientset) NewRepoServerClient() (io.Closer, repoapiclient.R
 		overrides := clientcmd.ConfigOverrides{
 			CurrentContext: c.context,
 		}
-		repoServerPort, err := kubeutil.PortForward(8081, c.namespace, &overrides, "app.kubernetes.io/name=argocd-repo-server")
+		repoServerPort, err := kubeutil.PortForward(8081, c.namespace, &overrides, common.InstanceLabelSelector("app.kubernetes.io/name=argocd-repo-server"))
 		if err != nil {
 			c.err = err
 			return
@@ -36,12 +36,15 @@ import (
 // NewLoginCommand returns a new instance of `argocd login` command
 func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		ctxName     string
-		username    string
-		password    string
-		sso         bool
-		ssoPort     int
-		skipTestTLS bool
+		ctxName       string
+		username      string
+		password      string
+		sso           bool
+		ssoPort       int
+		ssoDeviceCode bool
+		ssoScopes     []string
+		tokenExpiry   time.Duration
+		skipTestTLS   bool
 	)
 	var command = &cobra.Command{
 		Use:   "login SERVER",
@@ -53,6 +56,12 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD using SSO from an environment without a browser, e.g. over SSH
+argocd login cd.argoproj.io --sso --sso-device-code
+
+# Login to Argo CD using a username and password, with a token valid for an hour
+argocd login cd.argoproj.io --token-expiry 1h
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -65,6 +74,13 @@ argocd login cd.argoproj.io --core`,
 				os.Exit(1)
 			}
 
+			if (ssoDeviceCode || len(ssoScopes) > 0) && !sso {
+				log.Fatal("--sso-device-code and --sso-scopes can only be used with --sso")
+			}
+			if tokenExpiry != 0 && sso {
+				log.Fatal("--token-expiry cannot be used with --sso, the expiry of SSO tokens is set by the identity provider")
+			}
+
 			if globalClientOpts.PortForward {
 				server = "port-forward"
 			} else if globalClientOpts.Core {
@@ -121,10 +137,11 @@ argocd login cd.argoproj.io --core`,
 			var refreshToken string
 			if !globalClientOpts.Core {
 				acdClient := headless.NewClientOrDie(&clientOpts, c)
+				checkCLIVersion(getServerVersionIfAvailable(ctx, acdClient))
 				setConn, setIf := acdClient.NewSettingsClientOrDie()
 				defer io.Close(setConn)
 				if !sso {
-					tokenString = passwordLogin(ctx, acdClient, username, password)
+					tokenString = passwordLogin(ctx, acdClient, username, password, tokenExpiry)
 				} else {
 					httpClient, err := acdClient.HTTPClient()
 					errors.CheckError(err)
@@ -133,7 +150,14 @@ argocd login cd.argoproj.io --core`,
 					errors.CheckError(err)
 					oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 					errors.CheckError(err)
-					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
+					if len(ssoScopes) > 0 {
+						oauth2conf.Scopes = ssoScopes
+					}
+					if ssoDeviceCode {
+						tokenString, refreshToken = deviceCodeLogin(ctx, httpClient, oauth2conf, provider)
+					} else {
+						tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
+					}
 				}
 				parser := jwt.NewParser(jwt.WithoutClaimsValidation())
 				claims := jwt.MapClaims{}
@@ -180,6 +204,9 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().StringVar(&password, "password", "", "the password of an account to authenticate")
 	command.Flags().BoolVar(&sso, "sso", false, "perform SSO login")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "port to run local OAuth2 login application")
+	command.Flags().BoolVar(&ssoDeviceCode, "sso-device-code", false, "perform SSO login with the device authorization grant, which lets you log in with a browser running on another device")
+	command.Flags().StringSliceVar(&ssoScopes, "sso-scopes", nil, "OIDC scopes to request instead of the scopes configured in Argo CD, e.g. when the identity provider requires other scopes for the CLI")
+	command.Flags().DurationVar(&tokenExpiry, "token-expiry", 0, "expiry of the token of the local account, which cannot exceed the session duration configured in Argo CD")
 	command.Flags().
 		BoolVar(&skipTestTLS, "skip-test-tls", false, "Skip testing whether the server is configured with TLS (this can help when the command hangs for no apparent reason)")
 	return command
@@ -345,13 +372,38 @@ func oauth2Login(
 	return tokenString, refreshToken
 }
 
-func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username, password string) string {
+// deviceCodeLogin performs the OAuth2 device authorization grant, which lets the user log in with a browser running on
+// another device, and returns the JWT token and a refresh token (if supported)
+func deviceCodeLogin(ctx context.Context, httpClient *http.Client, oauth2conf *oauth2.Config, provider *oidc.Provider) (string, string) {
+	oidcConf, err := oidcutil.ParseConfig(provider)
+	errors.CheckError(err)
+	if oidcConf.DeviceAuthorizationEndpoint == "" {
+		log.Fatalf("%s does not support the device authorization grant", oidcConf.Issuer)
+	}
+	auth, err := oidcutil.RequestDeviceAuthorization(ctx, httpClient, oidcConf.DeviceAuthorizationEndpoint, oauth2conf)
+	errors.CheckError(err)
+	if auth.VerificationURIComplete != "" {
+		fmt.Printf("To authenticate, visit %s\n", auth.VerificationURIComplete)
+		fmt.Printf("or visit %s and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+	} else {
+		fmt.Printf("To authenticate, visit %s and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+	}
+	tokenString, refreshToken, err := oidcutil.PollDeviceToken(ctx, httpClient, oauth2conf, auth)
+	errors.CheckError(err)
+	fmt.Printf("Authentication successful\n")
+	log.Debugf("Token: %s", tokenString)
+	log.Debugf("Refresh Token: %s", refreshToken)
+	return tokenString, refreshToken
+}
+
+func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username, password string, expiry time.Duration) string {
 	username, password = cli.PromptCredentials(username, password)
 	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
 	defer io.Close(sessConn)
 	sessionRequest := sessionpkg.SessionCreateRequest{
-		Username: username,
-		Password: password,
+		Username:  username,
+		Password:  password,
+		ExpiresIn: int64(expiry.Seconds()),
 	}
 	createdSession, err := sessionIf.Create(ctx, &sessionRequest)
 	errors.CheckError(err)
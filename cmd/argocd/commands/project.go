@@ -51,10 +51,13 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectListCommand(clientOpts))
 	command.AddCommand(NewProjectSetCommand(clientOpts))
 	command.AddCommand(NewProjectEditCommand(clientOpts))
+	command.AddCommand(NewProjectPatchCommand(clientOpts))
 	command.AddCommand(NewProjectAddSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSignatureKeyCommand(clientOpts))
 	command.AddCommand(NewProjectAddDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
+	command.AddCommand(NewProjectAddDestinationServiceAccountCommand(clientOpts))
+	command.AddCommand(NewProjectRemoveDestinationServiceAccountCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewProjectAllowClusterResourceCommand(clientOpts))
@@ -64,6 +67,10 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectWindowsCommand(clientOpts))
 	command.AddCommand(NewProjectAddOrphanedIgnoreCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveOrphanedIgnoreCommand(clientOpts))
+	command.AddCommand(NewProjectPauseAutomationCommand(clientOpts))
+	command.AddCommand(NewProjectResumeAutomationCommand(clientOpts))
+	command.AddCommand(NewProjectUsageCommand(clientOpts))
+	command.AddCommand(NewProjectCheckResourceCommand(clientOpts))
 	return command
 }
 
@@ -312,6 +319,85 @@ func NewProjectRemoveDestinationCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewProjectAddDestinationServiceAccountCommand returns a new instance of an `argocd proj add-destination-service-account` command
+func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT",
+		Short: "Add the service account impersonated to sync the applications of the project to a destination",
+		Example: `  # Sync the applications deployed to the team-a namespace with the deployer service account of the namespace
+  argocd proj add-destination-service-account my-project https://kubernetes.default.svc team-a deployer
+
+  # Sync the applications deployed to any namespace with a service account of the argocd namespace
+  argocd proj add-destination-service-account my-project https://kubernetes.default.svc '*' argocd:deployer`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 4 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			serviceAccount := v1alpha1.ApplicationDestinationServiceAccount{Server: args[1], Namespace: args[2], DefaultServiceAccount: args[3]}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			for _, item := range proj.Spec.DestinationServiceAccounts {
+				if item.Server == serviceAccount.Server && item.Namespace == serviceAccount.Namespace {
+					log.Fatal("Specified destination service account is already defined in project")
+				}
+			}
+			proj.Spec.DestinationServiceAccounts = append(proj.Spec.DestinationServiceAccounts, serviceAccount)
+			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+			errors.CheckError(err)
+		},
+	}
+	return command
+}
+
+// NewProjectRemoveDestinationServiceAccountCommand returns a new instance of an `argocd proj remove-destination-service-account` command
+func NewProjectRemoveDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "remove-destination-service-account PROJECT SERVER NAMESPACE",
+		Short: "Remove the service account impersonated to sync the applications of the project to a destination",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			server := args[1]
+			namespace := args[2]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+
+			index := -1
+			for i, item := range proj.Spec.DestinationServiceAccounts {
+				if item.Server == server && item.Namespace == namespace {
+					index = i
+					break
+				}
+			}
+			if index == -1 {
+				log.Fatal("Specified destination service account does not exist in project")
+			} else {
+				proj.Spec.DestinationServiceAccounts = append(proj.Spec.DestinationServiceAccounts[:index], proj.Spec.DestinationServiceAccounts[index+1:]...)
+				_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
+				errors.CheckError(err)
+			}
+		},
+	}
+
+	return command
+}
+
 // NewProjectAddOrphanedIgnoreCommand returns a new instance of an `argocd proj add-orphaned-ignore` command
 func NewProjectAddOrphanedIgnoreCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -621,6 +707,73 @@ func NewProjectDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	return command
 }
 
+// NewProjectPauseAutomationCommand returns a new instance of an `argocd proj pause-automation` command
+func NewProjectPauseAutomationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		all    bool
+		reason string
+	)
+	var command = &cobra.Command{
+		Use:   "pause-automation PROJECT",
+		Short: "Pause the automated syncs of the applications of a project",
+		Long:  "Pause the automated syncs and prunes of the applications of a project, or of all the applications with --all. The applications are still compared and report the pause in their conditions.",
+		Example: `  # Pause the automated syncs of the applications of the project 'team-a'
+  argocd proj pause-automation team-a --reason "incident INC-123"
+
+  # Pause the automated syncs of all the applications during a cluster upgrade
+  argocd proj pause-automation --all --reason "cluster upgrade"`,
+		Run: func(c *cobra.Command, args []string) {
+			setProjectAutomationPaused(c, clientOpts, args, all, true, reason)
+		},
+	}
+	command.Flags().BoolVar(&all, "all", false, "Pause the automated syncs of all the applications")
+	command.Flags().StringVar(&reason, "reason", "", "Reason why the automated syncs are paused, surfaced in the application conditions")
+	return command
+}
+
+// NewProjectResumeAutomationCommand returns a new instance of an `argocd proj resume-automation` command
+func NewProjectResumeAutomationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var all bool
+	var command = &cobra.Command{
+		Use:   "resume-automation PROJECT",
+		Short: "Resume the automated syncs of the applications of a project",
+		Example: `  # Resume the automated syncs of the applications of the project 'team-a'
+  argocd proj resume-automation team-a
+
+  # Resume the automated syncs of all the applications
+  argocd proj resume-automation --all`,
+		Run: func(c *cobra.Command, args []string) {
+			setProjectAutomationPaused(c, clientOpts, args, all, false, "")
+		},
+	}
+	command.Flags().BoolVar(&all, "all", false, "Resume the automated syncs of all the applications")
+	return command
+}
+
+func setProjectAutomationPaused(c *cobra.Command, clientOpts *argocdclient.ClientOptions, args []string, all bool, paused bool, reason string) {
+	if all == (len(args) == 1) || len(args) > 1 {
+		c.HelpFunc()(c, args)
+		os.Exit(1)
+	}
+	name := "*"
+	if !all {
+		name = args[0]
+	}
+	conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+	defer argoio.Close(conn)
+	res, err := projIf.SetAutomationPaused(c.Context(), &projectpkg.ProjectAutomationPauseRequest{Name: name, Paused: paused, Reason: reason})
+	errors.CheckError(err)
+	target := fmt.Sprintf("project '%s'", name)
+	if all {
+		target = "all projects"
+	}
+	if res.Paused {
+		fmt.Printf("Automation paused for %s\n", target)
+	} else {
+		fmt.Printf("Automation resumed for %s\n", target)
+	}
+}
+
 // Print list of project names
 func printProjectNames(projects []v1alpha1.AppProject) {
 	for _, p := range projects {
@@ -839,6 +992,120 @@ func NewProjectGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 	return command
 }
 
+// NewProjectUsageCommand returns a new instance of an `argocd proj usage` command
+func NewProjectUsageCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		from   string
+		to     string
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "usage PROJECT",
+		Short: "Get the usage statistics of a project",
+		Long:  "Get the sync operations, manifest generation time and API calls of the applications of a project over a time range, and the number of resources they currently manage.",
+		Example: `  # Get the usage of the project 'team-a' over the last 30 days
+  argocd proj usage team-a
+
+  # Get the usage of the project 'team-a' in January 2024
+  argocd proj usage team-a --from 2024-01-01T00:00:00Z --to 2024-01-31T23:59:59Z -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+			usage, err := projIf.GetUsage(ctx, &projectpkg.ProjectUsageRequest{Name: args[0], From: from, To: to})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(usage, output)
+				errors.CheckError(err)
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "From:\t%s\n", usage.From)
+				fmt.Fprintf(w, "To:\t%s\n", usage.To)
+				fmt.Fprintf(w, "Syncs:\t%d\n", usage.Syncs)
+				fmt.Fprintf(w, "Manifest Generation Seconds:\t%.1f\n", usage.ManifestGenerationSeconds)
+				fmt.Fprintf(w, "API Calls:\t%d\n", usage.ApiCalls)
+				fmt.Fprintf(w, "Applications:\t%d\n", usage.Applications)
+				fmt.Fprintf(w, "Managed Resources:\t%d\n", usage.ManagedResources)
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&from, "from", "", "Start of the time range, in RFC 3339 format. Defaults to 30 days before the end of the time range")
+	command.Flags().StringVar(&to, "to", "", "End of the time range, in RFC 3339 format. Defaults to now")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewProjectCheckResourceCommand returns a new instance of an `argocd proj check-resource` command
+func NewProjectCheckResourceCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		version       string
+		clusterScoped bool
+		output        string
+	)
+	var command = &cobra.Command{
+		Use:   "check-resource PROJECT GROUP KIND",
+		Short: "Check whether an API resource is permitted in a project",
+		Long:  "Check whether an API resource is permitted to be deployed in a project, and show the rule of the allow and deny lists of the project which permitted or denied it. The resource is not deployed.",
+		Example: `  # Check whether Deployments are permitted in the project 'team-a'
+  argocd proj check-resource team-a apps Deployment
+
+  # Check whether v1beta1 CronJobs are permitted in the project 'team-a'
+  argocd proj check-resource team-a batch CronJob --version v1beta1
+
+  # Check whether ClusterRoles are permitted in the project 'team-a'
+  argocd proj check-resource team-a rbac.authorization.k8s.io ClusterRole --cluster-scoped`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+			permission, err := projIf.CheckResourcePermission(ctx, &projectpkg.ProjectResourcePermissionQuery{
+				Name:       args[0],
+				Group:      args[1],
+				Version:    version,
+				Kind:       args[2],
+				Namespaced: !clusterScoped,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(permission, output)
+				errors.CheckError(err)
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "Permitted:\t%t\n", permission.Permitted)
+				fmt.Fprintf(w, "List:\t%s\n", permission.List)
+				if permission.Rule != nil {
+					fmt.Fprintf(w, "Rule:\t%s/%s\n", permission.Rule.Group, permission.Rule.Kind)
+				}
+				fmt.Fprintf(w, "Message:\t%s\n", permission.Message)
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&version, "version", "", "Version of the resource. The version constraints of the rules are ignored if empty")
+	command.Flags().BoolVar(&clusterScoped, "cluster-scoped", false, "Whether the resource is cluster-scoped")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
 func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "edit PROJECT",
@@ -885,3 +1152,54 @@ func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	}
 	return command
 }
+
+// NewProjectPatchCommand returns a new instance of an `argocd proj patch` command
+func NewProjectPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		patch           string
+		patchType       string
+		resourceVersion string
+		dryRun          bool
+	)
+	var command = &cobra.Command{
+		Use:   "patch PROJECT",
+		Short: "Patch project",
+		Example: `  # Add a source repository to a project using json patch
+  argocd proj patch myproject --patch='[{"op": "add", "path": "/spec/sourceRepos/-", "value": "https://github.com/argoproj/argocd-example-apps.git"}]' --type json
+
+  # Update the description of a project only if it has not been modified since it has been read
+  argocd proj patch myproject --patch '{"spec": {"description": "My project"}}' --type merge --resource-version 123456
+
+  # Show a project as patched without updating it
+  argocd proj patch myproject --patch '{"spec": {"description": "My project"}}' --type strategic --dry-run`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer argoio.Close(conn)
+
+			patchedProj, err := projIf.Patch(ctx, &projectpkg.ProjectPatchRequest{
+				Name:            args[0],
+				Patch:           patch,
+				PatchType:       patchType,
+				ResourceVersion: resourceVersion,
+				DryRun:          dryRun,
+			})
+			errors.CheckError(err)
+
+			yamlBytes, err := yaml.Marshal(patchedProj)
+			errors.CheckError(err)
+
+			fmt.Println(string(yamlBytes))
+		},
+	}
+	command.Flags().StringVar(&patch, "patch", "", "Patch body")
+	command.Flags().StringVar(&patchType, "type", "json", "The type of patch being provided; one of [json merge strategic]")
+	command.Flags().StringVar(&resourceVersion, "resource-version", "", "Only patch the project if it has this resource version")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Show the patched project without updating it")
+	return command
+}
@@ -290,12 +290,16 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	fmt.Fprintf(w, fmtStr, headers...)
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
+			schedule, duration := window.Schedule, window.Duration
+			if window.Calendar != nil {
+				schedule, duration = fmt.Sprintf("%s calendar %s", window.Calendar.Type, window.Calendar.URL), "-"
+			}
 			vals := []interface{}{
 				strconv.Itoa(i),
 				formatBoolOutput(window.Active()),
 				window.Kind,
-				window.Schedule,
-				window.Duration,
+				schedule,
+				duration,
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
 				formatListOutput(window.Clusters),
@@ -126,6 +126,18 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				}
 			}
 
+			// Specifying tls-ca-cert-path is only valid for HTTPS repositories
+			if repoOpts.TlsCACertPath != "" {
+				if git.IsHTTPSURL(repoOpts.Repo.Repo) {
+					tlsCACertData, err := os.ReadFile(repoOpts.TlsCACertPath)
+					errors.CheckError(err)
+					repoOpts.Repo.TLSCACertData = string(tlsCACertData)
+				} else {
+					err := fmt.Errorf("--tls-ca-cert-path is only supported for HTTPS repositories")
+					errors.CheckError(err)
+				}
+			}
+
 			// Specifying github-app-private-key-path is only valid for HTTPS repositories
 			if repoOpts.GithubAppPrivateKeyPath != "" {
 				if git.IsHTTPSURL(repoOpts.Repo.Repo) {
@@ -191,6 +203,8 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				SshPrivateKey:              repoOpts.Repo.SSHPrivateKey,
 				TlsClientCertData:          repoOpts.Repo.TLSClientCertData,
 				TlsClientCertKey:           repoOpts.Repo.TLSClientCertKey,
+				TlsCACertData:              repoOpts.Repo.TLSCACertData,
+				TlsServerName:              repoOpts.Repo.TLSServerName,
 				Insecure:                   repoOpts.Repo.IsInsecure(),
 				EnableOci:                  repoOpts.Repo.EnableOCI,
 				GithubAppPrivateKey:        repoOpts.Repo.GithubAppPrivateKey,
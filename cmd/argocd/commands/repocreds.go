@@ -46,6 +46,7 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 		tlsClientCertKeyPath     string
 		githubAppPrivateKeyPath  string
 		gcpServiceAccountKeyPath string
+		tlsCACertPath            string
 	)
 
 	// For better readability and easier formatting
@@ -66,6 +67,9 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 
   # Add credentials with GCP credentials for all repositories under https://source.developers.google.com/p/my-google-cloud-project/r/
   argocd repocreds add https://source.developers.google.com/p/my-google-cloud-project/r/ --gcp-service-account-key-path service-account-key.json
+
+  # Add credentials matching repository URLs by regular expression, taking precedence over other matching templates
+  argocd repocreds add '^https://git\.example\.com/.*/infra$' --url-regex --priority 10 --username git --password secret
 `
 
 	var command = &cobra.Command{
@@ -143,6 +147,12 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 				}
 			}
 
+			if tlsCACertPath != "" {
+				tlsCACertData, err := os.ReadFile(tlsCACertPath)
+				errors.CheckError(err)
+				repo.TLSCACertData = string(tlsCACertData)
+			}
+
 			conn, repoIf := headless.NewClientOrDie(clientOpts, c).NewRepoCredsClientOrDie()
 			defer io.Close(conn)
 
@@ -176,6 +186,13 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	command.Flags().StringVar(&repo.Type, "type", common.DefaultRepoType, "type of the repository, \"git\" or \"helm\"")
 	command.Flags().StringVar(&gcpServiceAccountKeyPath, "gcp-service-account-key-path", "", "service account key for the Google Cloud Platform")
 	command.Flags().BoolVar(&repo.ForceHttpBasicAuth, "force-http-basic-auth", false, "whether to force basic auth when connecting via HTTP")
+	command.Flags().BoolVar(&repo.URLRegex, "url-regex", false, "whether the URL is a regular expression matched against repository URLs")
+	command.Flags().Int64Var(&repo.Priority, "priority", 0, "priority of the credentials when several templates match a repository URL")
+	command.Flags().BoolVar(&repo.EnableLFS, "enable-lfs", false, "whether to force git-lfs support on for matching repositories")
+	command.Flags().StringVar(&repo.Proxy, "proxy", "", "use proxy to access matching repositories")
+	command.Flags().BoolVar(&repo.ForceProxy, "force-proxy", false, "whether the proxy overrides the proxy configured on matching repositories")
+	command.Flags().StringVar(&tlsCACertPath, "tls-ca-cert-path", "", "path to a CA certificate used to verify matching HTTPS repositories (must be PEM format)")
+	command.Flags().StringVar(&repo.TLSServerName, "tls-server-name", "", "server name sent with SNI and used to verify the certificates of matching HTTPS repositories, instead of the host of their URL")
 	return command
 }
 
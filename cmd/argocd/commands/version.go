@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	log "github.com/sirupsen/logrus"
@@ -80,6 +81,7 @@ func NewVersionCmd(clientOpts *argocdclient.ClientOptions, serverVersion *versio
 						sv = serverVersion
 					}
 					fmt.Fprint(cmd.OutOrStdout(), printServerVersion(sv, short || (output == "short")))
+					checkCLIVersion(sv)
 				}
 			default:
 				log.Fatalf("unknown output format: %s", output)
@@ -102,6 +104,53 @@ func getServerVersion(ctx context.Context, options *argocdclient.ClientOptions,
 	return v
 }
 
+// getServerVersionIfAvailable returns the version of the API server, or nil if it cannot be retrieved
+func getServerVersionIfAvailable(ctx context.Context, acdClient argocdclient.Client) *version.VersionMessage {
+	conn, versionIf, err := acdClient.NewVersionClient()
+	if err != nil {
+		log.Debugf("Failed to create version client: %v", err)
+		return nil
+	}
+	defer argoio.Close(conn)
+	sv, err := versionIf.Version(ctx, &empty.Empty{})
+	if err != nil {
+		log.Debugf("Failed to get server version: %v", err)
+		return nil
+	}
+	return sv
+}
+
+// checkCLIVersion warns if the version of the CLI is not supported by the API server, so that the commands failing
+// because of a version mismatch do not fail mysteriously
+func checkCLIVersion(sv *version.VersionMessage) {
+	if sv == nil || sv.MinCLIVersion == "" || sv.MaxCLIVersion == "" {
+		// the API server does not tell which CLI versions it supports
+		return
+	}
+	cv := common.GetVersion()
+	supported, err := common.CLIVersionSupported(cv.Version, sv.MinCLIVersion, sv.MaxCLIVersion)
+	if err != nil {
+		log.Debugf("Failed to check CLI version: %v", err)
+		return
+	}
+	if !supported {
+		log.Warnf("%s %s is not supported by argocd-server %s, which supports the CLI versions %s to %s. Some commands may fail or behave differently.", cliName, cv.Version, sv.Version, sv.MinCLIVersion, sv.MaxCLIVersion)
+	}
+}
+
+// serverHasFeature returns whether the API server with the given version supports the given feature
+func serverHasFeature(sv *version.VersionMessage, feature string) bool {
+	if sv == nil {
+		return false
+	}
+	for _, f := range sv.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
 func printClientVersion(version *common.Version, short bool) string {
 	output := fmt.Sprintf("%s: %s\n", cliName, version)
 	if short {
@@ -159,5 +208,11 @@ func printServerVersion(version *version.VersionMessage, short bool) string {
 	if version.JsonnetVersion != "" {
 		output += fmt.Sprintf("  Jsonnet Version: %s\n", version.JsonnetVersion)
 	}
+	if version.MinCLIVersion != "" && version.MaxCLIVersion != "" {
+		output += fmt.Sprintf("  Supported CLI Versions: %s - %s\n", version.MinCLIVersion, version.MaxCLIVersion)
+	}
+	if len(version.Features) > 0 {
+		output += fmt.Sprintf("  Features: %s\n", strings.Join(version.Features, ", "))
+	}
 	return output
 }
@@ -35,3 +35,21 @@ func TestShortVersion(t *testing.T) {
 	output := buf.String()
 	assert.Equal(t, output, "argocd: v99.99.99+unknown\nargocd-server: v99.99.99+unknown\n")
 }
+
+func TestServerHasFeature(t *testing.T) {
+	serverVersion := &version.VersionMessage{Version: "v2.7.0", Features: []string{"revisions-diff"}}
+	assert.True(t, serverHasFeature(serverVersion, "revisions-diff"))
+	assert.False(t, serverHasFeature(serverVersion, "agents"))
+	assert.False(t, serverHasFeature(&version.VersionMessage{Version: "v2.6.0"}, "revisions-diff"))
+	assert.False(t, serverHasFeature(nil, "revisions-diff"))
+}
+
+func TestPrintServerVersion_SupportedCLIVersions(t *testing.T) {
+	output := printServerVersion(&version.VersionMessage{
+		Version:       "v2.7.0",
+		MinCLIVersion: "v2.6",
+		MaxCLIVersion: "v2.8",
+		Features:      []string{"api-v2", "revisions-diff"},
+	}, false)
+	assert.Equal(t, "argocd-server: v2.7.0\n  Supported CLI Versions: v2.6 - v2.8\n  Features: api-v2, revisions-diff\n", output)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	admissionwebhook "github.com/argoproj/argo-cd/v2/cmd/argocd-admission-webhook/commands"
+	agent "github.com/argoproj/argo-cd/v2/cmd/argocd-agent/commands"
 	appcontroller "github.com/argoproj/argo-cd/v2/cmd/argocd-application-controller/commands"
 	applicationset "github.com/argoproj/argo-cd/v2/cmd/argocd-applicationset-controller/commands"
 	cmpserver "github.com/argoproj/argo-cd/v2/cmd/argocd-cmp-server/commands"
@@ -16,6 +18,9 @@ import (
 	reposerver "github.com/argoproj/argo-cd/v2/cmd/argocd-repo-server/commands"
 	apiserver "github.com/argoproj/argo-cd/v2/cmd/argocd-server/commands"
 	cli "github.com/argoproj/argo-cd/v2/cmd/argocd/commands"
+
+	// register the fetchers of manifests stored in buckets
+	_ "github.com/argoproj/argo-cd/v2/util/bucket"
 )
 
 const (
@@ -50,6 +55,10 @@ func main() {
 		command = applicationset.NewCommand()
 	case "argocd-k8s-auth":
 		command = k8sauth.NewCommand()
+	case "argocd-agent":
+		command = agent.NewCommand()
+	case "argocd-admission-webhook":
+		command = admissionwebhook.NewCommand()
 	default:
 		command = cli.NewCommand()
 	}
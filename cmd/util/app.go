@@ -37,6 +37,7 @@ type AppOptions struct {
 	destName                        string
 	destServer                      string
 	destNamespace                   string
+	destNamespaceTemplate           string
 	Parameters                      []string
 	valuesFiles                     []string
 	ignoreMissingValueFiles         bool
@@ -77,6 +78,11 @@ type AppOptions struct {
 	retryBackoffDuration            time.Duration
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
+	syncSchedule                    string
+	syncScheduleJitter              time.Duration
+	ttl                             time.Duration
+	ttlWarningPeriod                time.Duration
+	deletionPropagationPolicy       string
 }
 
 func AddAppFlags(command *cobra.Command, opts *AppOptions) {
@@ -89,6 +95,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (e.g. https://kubernetes.default.svc)")
 	command.Flags().StringVar(&opts.destName, "dest-name", "", "K8s cluster Name (e.g. minikube)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace")
+	command.Flags().StringVar(&opts.destNamespaceTemplate, "dest-namespace-template", "", "Template of the K8s target namespace (e.g. team-{{.app.name}})")
 	command.Flags().StringArrayVarP(&opts.Parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
 	command.Flags().BoolVar(&opts.ignoreMissingValueFiles, "ignore-missing-value-files", false, "Ignore locally missing valueFiles when setting helm template --values")
@@ -129,6 +136,11 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().DurationVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Sync retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
+	command.Flags().StringVar(&opts.syncSchedule, "sync-schedule", "", "Cron expression of the times at which the application is synced regardless of the detected changes (e.g. '0 2 * * *'). Remove the schedule using an empty value")
+	command.Flags().DurationVar(&opts.syncScheduleJitter, "sync-schedule-jitter", 0, "Maximum delay added to the scheduled sync times. Input needs to be a duration (e.g. 10m, 1h)")
+	command.Flags().DurationVar(&opts.ttl, "ttl", 0, "Lifetime of the application from its creation, after which it is deleted along with its resources (e.g. 72h). Remove the TTL using 0")
+	command.Flags().DurationVar(&opts.ttlWarningPeriod, "ttl-warning-period", argoappv1.DefaultTTLWarningPeriod, "Period before the expiry of the application during which it has an ExpiringWarning condition (e.g. 24h)")
+	command.Flags().StringVar(&opts.deletionPropagationPolicy, "deletion-propagation-policy", "", "Propagation policy used to delete the resources of the application when it is deleted with cascade, unless another one is requested: foreground or background. Remove the option using an empty value")
 }
 
 func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, appOpts *AppOptions) int {
@@ -210,6 +222,8 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			spec.Destination.Server = appOpts.destServer
 		case "dest-namespace":
 			spec.Destination.Namespace = appOpts.destNamespace
+		case "dest-namespace-template":
+			spec.Destination.NamespaceTemplate = appOpts.destNamespaceTemplate
 		case "project":
 			spec.Project = appOpts.project
 		case "nameprefix":
@@ -299,6 +313,21 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			} else {
 				log.Fatalf("Invalid sync-retry-limit [%d]", appOpts.retryLimit)
 			}
+		case "sync-schedule":
+			if appOpts.syncSchedule != "" {
+				if spec.SyncPolicy == nil {
+					spec.SyncPolicy = &argoappv1.SyncPolicy{}
+				}
+				spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: appOpts.syncSchedule}
+				if err := spec.SyncPolicy.Schedule.Validate(); err != nil {
+					log.Fatalf("Invalid sync-schedule: %v", err)
+				}
+			} else if spec.SyncPolicy != nil {
+				spec.SyncPolicy.Schedule = nil
+				if spec.SyncPolicy.IsZero() {
+					spec.SyncPolicy = nil
+				}
+			}
 		}
 		spec.Source = source
 	})
@@ -320,6 +349,51 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		}
 		spec.SyncPolicy.Automated.AllowEmpty = appOpts.allowEmpty
 	}
+	if flags.Changed("ttl") {
+		if appOpts.ttl < 0 {
+			log.Fatalf("Invalid ttl [%v]", appOpts.ttl)
+		}
+		if appOpts.ttl == 0 {
+			spec.TTL = nil
+		} else {
+			if spec.TTL == nil {
+				spec.TTL = &argoappv1.ApplicationTTL{}
+			}
+			spec.TTL.Duration = appOpts.ttl.String()
+		}
+	}
+	if flags.Changed("ttl-warning-period") {
+		if spec.TTL == nil {
+			log.Fatal("Cannot set --ttl-warning-period: application not configured with a TTL")
+		}
+		if appOpts.ttlWarningPeriod < 0 {
+			log.Fatalf("Invalid ttl-warning-period [%v]", appOpts.ttlWarningPeriod)
+		}
+		spec.TTL.WarningPeriod = appOpts.ttlWarningPeriod.String()
+	}
+	if flags.Changed("deletion-propagation-policy") {
+		if appOpts.deletionPropagationPolicy == "" {
+			spec.Deletion = nil
+		} else {
+			deletion := &argoappv1.ApplicationDeletion{PropagationPolicy: appOpts.deletionPropagationPolicy}
+			if err := deletion.Validate(); err != nil {
+				log.Fatalf("Invalid deletion-propagation-policy: %v", err)
+			}
+			spec.Deletion = deletion
+		}
+	}
+	if flags.Changed("sync-schedule-jitter") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Schedule == nil {
+			log.Fatal("Cannot set --sync-schedule-jitter: application not configured with a sync schedule")
+		}
+		if appOpts.syncScheduleJitter < 0 {
+			log.Fatalf("Invalid sync-schedule-jitter [%v]", appOpts.syncScheduleJitter)
+		}
+		spec.SyncPolicy.Schedule.Jitter = ""
+		if appOpts.syncScheduleJitter > 0 {
+			spec.SyncPolicy.Schedule.Jitter = appOpts.syncScheduleJitter.String()
+		}
+	}
 
 	return visited
 }
@@ -191,6 +191,32 @@ func Test_setAppSpecOptions(t *testing.T) {
 		assert.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("SyncSchedule", func(t *testing.T) {
+		f := newAppOptionsFixture()
+		assert.NoError(t, f.SetFlag("sync-schedule", "0 2 * * *"))
+		assert.Equal(t, &v1alpha1.SyncSchedule{Cron: "0 2 * * *"}, f.spec.SyncPolicy.Schedule)
+
+		assert.NoError(t, f.SetFlag("sync-schedule-jitter", "10m"))
+		assert.Equal(t, &v1alpha1.SyncSchedule{Cron: "0 2 * * *", Jitter: "10m0s"}, f.spec.SyncPolicy.Schedule)
+
+		f = newAppOptionsFixture()
+		f.spec.SyncPolicy = &v1alpha1.SyncPolicy{Schedule: &v1alpha1.SyncSchedule{Cron: "0 2 * * *"}}
+		assert.NoError(t, f.SetFlag("sync-schedule", ""))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
+	t.Run("TTL", func(t *testing.T) {
+		f := newAppOptionsFixture()
+		assert.NoError(t, f.SetFlag("ttl", "72h"))
+		assert.Equal(t, &v1alpha1.ApplicationTTL{Duration: "72h0m0s"}, f.spec.TTL)
+
+		assert.NoError(t, f.SetFlag("ttl-warning-period", "24h"))
+		assert.Equal(t, &v1alpha1.ApplicationTTL{Duration: "72h0m0s", WarningPeriod: "24h0m0s"}, f.spec.TTL)
+
+		f = newAppOptionsFixture()
+		f.spec.TTL = &v1alpha1.ApplicationTTL{Duration: "72h"}
+		assert.NoError(t, f.SetFlag("ttl", "0"))
+		assert.Nil(t, f.spec.TTL)
+	})
 }
 
 func Test_setAnnotations(t *testing.T) {
@@ -114,6 +114,7 @@ type ClusterOptions struct {
 	Name                    string
 	Project                 string
 	Shard                   int64
+	MaxConcurrentSyncs      int64
 	ExecProviderCommand     string
 	ExecProviderArgs        []string
 	ExecProviderEnv         map[string]string
@@ -130,6 +131,7 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringVar(&opts.Project, "project", "", "project of the cluster")
 	command.Flags().Int64Var(&opts.Shard, "shard", -1, "Cluster shard number; inferred from hostname if not set")
+	command.Flags().Int64Var(&opts.MaxConcurrentSyncs, "max-concurrent-syncs", 0, "Maximum number of concurrent sync operations for applications deployed to the cluster; the default limit is used if not set")
 	command.Flags().StringVar(&opts.ExecProviderCommand, "exec-command", "", "Command to run to provide client credentials to the cluster. You may need to build a custom ArgoCD image to ensure the command is available at runtime.")
 	command.Flags().StringArrayVar(&opts.ExecProviderArgs, "exec-command-args", nil, "Arguments to supply to the --exec-command executable")
 	command.Flags().StringToStringVar(&opts.ExecProviderEnv, "exec-command-env", nil, "Environment vars to set when running the --exec-command executable")
@@ -25,6 +25,7 @@ type ProjectOpts struct {
 	Sources          []string
 	SignatureKeys    []string
 	SourceNamespaces []string
+	SourceRevisions  []string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -32,6 +33,7 @@ type ProjectOpts struct {
 	deniedClusterResources     []string
 	allowedNamespacedResources []string
 	deniedNamespacedResources  []string
+	disableScheduledSyncs      bool
 }
 
 func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
@@ -47,6 +49,8 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringArrayVar(&opts.allowedNamespacedResources, "allow-namespaced-resource", []string{}, "List of allowed namespaced resources")
 	command.Flags().StringArrayVar(&opts.deniedNamespacedResources, "deny-namespaced-resource", []string{}, "List of denied namespaced resources")
 	command.Flags().StringSliceVar(&opts.SourceNamespaces, "source-namespaces", []string{}, "List of source namespaces for applications")
+	command.Flags().StringSliceVar(&opts.SourceRevisions, "source-revisions", []string{}, "List of permitted target revisions of the application sources (e.g. main,v*,!release-*)")
+	command.Flags().BoolVar(&opts.disableScheduledSyncs, "disable-scheduled-syncs", false, "Disables the syncs scheduled by the sync policy of the applications")
 
 }
 
@@ -164,6 +168,10 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.NamespaceResourceBlacklist = projOpts.GetDeniedNamespacedResources()
 		case "source-namespaces":
 			spec.SourceNamespaces = projOpts.GetSourceNamespaces()
+		case "source-revisions":
+			spec.SourceRevisions = projOpts.SourceRevisions
+		case "disable-scheduled-syncs":
+			spec.DisableScheduledSyncs = projOpts.disableScheduledSyncs
 		}
 	})
 	if flags.Changed("orphaned-resources") || flags.Changed("orphaned-resources-warn") {
@@ -15,6 +15,7 @@ type RepoOptions struct {
 	InsecureSkipServerVerification bool
 	TlsClientCertPath              string
 	TlsClientCertKeyPath           string
+	TlsCACertPath                  string
 	EnableLfs                      bool
 	EnableOci                      bool
 	GithubAppId                    int64
@@ -35,6 +36,8 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().StringVar(&opts.SshPrivateKeyPath, "ssh-private-key-path", "", "path to the private ssh key (e.g. ~/.ssh/id_rsa)")
 	command.Flags().StringVar(&opts.TlsClientCertPath, "tls-client-cert-path", "", "path to the TLS client cert (must be PEM format)")
 	command.Flags().StringVar(&opts.TlsClientCertKeyPath, "tls-client-cert-key-path", "", "path to the TLS client cert's key path (must be PEM format)")
+	command.Flags().StringVar(&opts.TlsCACertPath, "tls-ca-cert-path", "", "path to a CA certificate used to verify the HTTPS repository (must be PEM format)")
+	command.Flags().StringVar(&opts.Repo.TLSServerName, "tls-server-name", "", "server name sent with SNI and used to verify the certificate of the HTTPS repository, instead of the host of its URL")
 	command.Flags().BoolVar(&opts.InsecureIgnoreHostKey, "insecure-ignore-host-key", false, "disables SSH strict host key checking (deprecated, use --insecure-skip-server-verification instead)")
 	command.Flags().BoolVar(&opts.InsecureSkipServerVerification, "insecure-skip-server-verification", false, "disables server certificate and host key checks")
 	command.Flags().BoolVar(&opts.EnableLfs, "enable-lfs", false, "enable git-lfs (Large File Support) on this repository")
@@ -18,6 +18,8 @@ const (
 	DefaultDexServerAddr = "argocd-dex-server:5556"
 	// DefaultRedisAddr is the default redis address
 	DefaultRedisAddr = "argocd-redis:6379"
+	// DefaultServerServiceName is the name of the service of the API server
+	DefaultServerServiceName = "argocd-server"
 )
 
 // Kubernetes ConfigMap and Secret resource names which hold Argo CD settings
@@ -27,6 +29,8 @@ const (
 	ArgoCDNotificationsConfigMapName = "argocd-notifications-cm"
 	ArgoCDNotificationsSecretName    = "argocd-notifications-secret"
 	ArgoCDRBACConfigMapName          = "argocd-rbac-cm"
+	// Contains the asymmetric keys signing the tokens issued by the API server. Managed by the API server.
+	ArgoCDTokenSigningKeysSecretName = "argocd-token-signing-keys"
 	// Contains SSH known hosts data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDKnownHostsConfigMapName = "argocd-ssh-known-hosts-cm"
 	// Contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
@@ -34,6 +38,95 @@ const (
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
 )
 
+// GetInstanceName returns the name of the Argo CD instance, which is taken from the ARGOCD_INSTANCE_NAME environment
+// variable. The names of the resources of a named instance are prefixed with its name, so that the cluster-scoped
+// resources of several instances sharing a cluster do not collide.
+func GetInstanceName() string {
+	return os.Getenv(EnvInstanceName)
+}
+
+// InstanceResourceName returns the name of the given resource of the Argo CD instance, i.e. the name prefixed with
+// the name of the instance if any
+func InstanceResourceName(name string) string {
+	if instanceName := GetInstanceName(); instanceName != "" {
+		return instanceName + "-" + name
+	}
+	return name
+}
+
+// InstanceLabelSelector restricts the given label selector of the pods of Argo CD to the pods of the Argo CD instance
+func InstanceLabelSelector(selector string) string {
+	if instanceName := GetInstanceName(); instanceName != "" {
+		return selector + "," + LabelKeyInstanceName + "=" + instanceName
+	}
+	return selector
+}
+
+// GetDefaultRepoServerAddr returns the gRPC address of the repo server of the Argo CD instance
+func GetDefaultRepoServerAddr() string {
+	return InstanceResourceName(DefaultRepoServerAddr)
+}
+
+// GetDefaultDexServerAddr returns the HTTP address of the Dex server of the Argo CD instance
+func GetDefaultDexServerAddr() string {
+	return InstanceResourceName(DefaultDexServerAddr)
+}
+
+// GetDefaultRedisAddr returns the address of the redis of the Argo CD instance
+func GetDefaultRedisAddr() string {
+	return InstanceResourceName(DefaultRedisAddr)
+}
+
+// GetDefaultServerServiceName returns the name of the service of the API server of the Argo CD instance
+func GetDefaultServerServiceName() string {
+	return InstanceResourceName(DefaultServerServiceName)
+}
+
+// GetArgoCDConfigMapName returns the name of the argocd-cm config map of the Argo CD instance
+func GetArgoCDConfigMapName() string {
+	return InstanceResourceName(ArgoCDConfigMapName)
+}
+
+// GetArgoCDSecretName returns the name of the argocd-secret secret of the Argo CD instance
+func GetArgoCDSecretName() string {
+	return InstanceResourceName(ArgoCDSecretName)
+}
+
+// GetArgoCDNotificationsConfigMapName returns the name of the argocd-notifications-cm config map of the Argo CD instance
+func GetArgoCDNotificationsConfigMapName() string {
+	return InstanceResourceName(ArgoCDNotificationsConfigMapName)
+}
+
+// GetArgoCDNotificationsSecretName returns the name of the argocd-notifications-secret secret of the Argo CD instance
+func GetArgoCDNotificationsSecretName() string {
+	return InstanceResourceName(ArgoCDNotificationsSecretName)
+}
+
+// GetArgoCDRBACConfigMapName returns the name of the argocd-rbac-cm config map of the Argo CD instance
+func GetArgoCDRBACConfigMapName() string {
+	return InstanceResourceName(ArgoCDRBACConfigMapName)
+}
+
+// GetArgoCDTokenSigningKeysSecretName returns the name of the argocd-token-signing-keys secret of the Argo CD instance
+func GetArgoCDTokenSigningKeysSecretName() string {
+	return InstanceResourceName(ArgoCDTokenSigningKeysSecretName)
+}
+
+// GetArgoCDKnownHostsConfigMapName returns the name of the argocd-ssh-known-hosts-cm config map of the Argo CD instance
+func GetArgoCDKnownHostsConfigMapName() string {
+	return InstanceResourceName(ArgoCDKnownHostsConfigMapName)
+}
+
+// GetArgoCDTLSCertsConfigMapName returns the name of the argocd-tls-certs-cm config map of the Argo CD instance
+func GetArgoCDTLSCertsConfigMapName() string {
+	return InstanceResourceName(ArgoCDTLSCertsConfigMapName)
+}
+
+// GetArgoCDGPGKeysConfigMapName returns the name of the argocd-gpg-keys-cm config map of the Argo CD instance
+func GetArgoCDGPGKeysConfigMapName() string {
+	return InstanceResourceName(ArgoCDGPGKeysConfigMapName)
+}
+
 // Some default configurables
 const (
 	DefaultSystemNamespace = "kube-system"
@@ -42,11 +135,13 @@ const (
 
 // Default listener ports for ArgoCD components
 const (
-	DefaultPortAPIServer              = 8080
-	DefaultPortRepoServer             = 8081
-	DefaultPortArgoCDMetrics          = 8082
-	DefaultPortArgoCDAPIServerMetrics = 8083
-	DefaultPortRepoServerMetrics      = 8084
+	DefaultPortAPIServer                      = 8080
+	DefaultPortRepoServer                     = 8081
+	DefaultPortArgoCDMetrics                  = 8082
+	DefaultPortArgoCDAPIServerMetrics         = 8083
+	DefaultPortRepoServerMetrics              = 8084
+	DefaultPortArgoCDAdmissionWebhook         = 8085
+	DefaultPortArgoCDSettingsAdmissionWebhook = 8086
 )
 
 // Default listener address for ArgoCD components
@@ -109,6 +204,8 @@ const (
 	LogoutEndpoint = "/auth/logout"
 	// CallbackEndpoint is Argo CD's final callback endpoint we reach after OAuth 2.0 login flow has been completed
 	CallbackEndpoint = "/auth/callback"
+	// JWKSEndpoint is the endpoint publishing the public keys verifying the tokens signed with asymmetric keys
+	JWKSEndpoint = "/.well-known/jwks.json"
 	// DexCallbackEndpoint is Argo CD's final callback endpoint when Dex is configured
 	DexCallbackEndpoint = "/api/dex/callback"
 	// ArgoCDClientAppName is name of the Oauth client app used when registering our web app to dex
@@ -128,6 +225,8 @@ const (
 	LabelKeyAppInstance = "app.kubernetes.io/instance"
 	// LabelKeyLegacyApplicationName is the legacy label (v0.10 and below) and is superseded by 'app.kubernetes.io/instance'
 	LabelKeyLegacyApplicationName = "applications.argoproj.io/app-name"
+	// LabelKeyInstanceName is the label key identifying the pods of a named Argo CD instance
+	LabelKeyInstanceName = "argocd.argoproj.io/instance-name"
 	// LabelKeySecretType contains the type of argocd secret (currently: 'cluster', 'repository', 'repo-config' or 'repo-creds')
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
@@ -136,6 +235,10 @@ const (
 	LabelValueSecretTypeRepository = "repository"
 	// LabelValueSecretTypeRepoCreds indicates a secret type of repository credentials
 	LabelValueSecretTypeRepoCreds = "repo-creds"
+	// LabelValueSecretTypeRepositoryWrite indicates a secret type of repository credentials allowed to push
+	LabelValueSecretTypeRepositoryWrite = "repository-write"
+	// LabelKeyRegistrationSecret is the label opting a secret in to be referenced by repository and cluster registrations
+	LabelKeyRegistrationSecret = "argocd.argoproj.io/registration-secret"
 
 	// The Argo CD application name is used as the instance name
 	AnnotationKeyAppInstance = "argocd.argoproj.io/tracking-id"
@@ -157,6 +260,26 @@ const (
 	// Ex: "http://grafana.example.com/d/yu5UH4MMz/deployments"
 	// Ex: "Go to Dashboard|http://grafana.example.com/d/yu5UH4MMz/deployments"
 	AnnotationKeyLinkPrefix = "link.argocd.argoproj.io/"
+
+	// AnnotationKeyConfigSourceRevision is the annotation set on Argo CD config maps synced from Git, holding the revision they were synced from
+	AnnotationKeyConfigSourceRevision = "argocd.argoproj.io/config-source-revision"
+
+	// AnnotationKeyAutomationPaused is the annotation which pauses the automated syncs of the applications of a project when set to "true"
+	AnnotationKeyAutomationPaused = "argocd.argoproj.io/automation-paused"
+	// AnnotationKeyAutomationPausedReason is the annotation holding the reason why the automated syncs of a project are paused
+	AnnotationKeyAutomationPausedReason = "argocd.argoproj.io/automation-paused-reason"
+	// AnnotationKeyReconcilePriority is the annotation of applications or of their project holding the integer priority
+	// in which applications are reconciled after the application controller started. Higher priorities come first.
+	AnnotationKeyReconcilePriority = "argocd.argoproj.io/reconcile-priority"
+	// AnnotationKeyAdaptiveRefreshThreshold is the annotation of projects overriding the number of consecutive unchanged
+	// comparisons of their applications after which their refresh period grows. 0 disables the adaptive refresh.
+	AnnotationKeyAdaptiveRefreshThreshold = "argocd.argoproj.io/adaptive-refresh-threshold"
+	// AnnotationKeyAdaptiveRefreshMaxTimeout is the annotation of projects overriding the maximum refresh period of their
+	// applications with the adaptive refresh, e.g. 1h
+	AnnotationKeyAdaptiveRefreshMaxTimeout = "argocd.argoproj.io/adaptive-refresh-max-timeout"
+	// AnnotationKeyAgentTokenHash is the annotation of the clusters of the agents holding the SHA-256 hash of the token
+	// the agent authenticates with, which is distinct from the token the clusters are reached with through the API server
+	AnnotationKeyAgentTokenHash = "argocd.argoproj.io/agent-token-sha256"
 )
 
 // Environment variables for tuning and debugging Argo CD
@@ -165,6 +288,8 @@ const (
 	EnvVarSSODebug = "ARGOCD_SSO_DEBUG"
 	// EnvVarRBACDebug is an environment variable to enable additional RBAC debugging in the API server
 	EnvVarRBACDebug = "ARGOCD_RBAC_DEBUG"
+	// EnvInstanceName is the name of the Argo CD instance, which prefixes the names of its resources
+	EnvInstanceName = "ARGOCD_INSTANCE_NAME"
 	// Overrides the location where SSH known hosts for repo access data is stored
 	EnvVarSSHDataPath = "ARGOCD_SSH_DATA_PATH"
 	// Overrides the location where TLS certificate for repo access data is stored
@@ -316,3 +441,16 @@ const (
 const TokenVerificationError = "failed to verify the token"
 
 var TokenVerificationErr = errors.New(TokenVerificationError)
+
+// Features of the API server, which the CLI checks before relying on them
+const (
+	// FeatureAPIV2 is the REST API served under /api/v2
+	FeatureAPIV2 = "api-v2"
+	// FeatureAgents is the registration of the agents of the clusters which Argo CD cannot reach
+	FeatureAgents = "agents"
+	// FeatureRevisionsDiff is the diff of the manifests of an application at two revisions
+	FeatureRevisionsDiff = "revisions-diff"
+)
+
+// ServerFeatures are the features of this version of the API server
+var ServerFeatures = []string{FeatureAPIV2, FeatureAgents, FeatureRevisionsDiff}
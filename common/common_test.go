@@ -0,0 +1,21 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceResourceName(t *testing.T) {
+	t.Setenv(EnvInstanceName, "")
+	assert.Equal(t, "argocd-cm", InstanceResourceName("argocd-cm"))
+	assert.Equal(t, "argocd-cm", GetArgoCDConfigMapName())
+	assert.Equal(t, "argocd-repo-server:8081", GetDefaultRepoServerAddr())
+	assert.Equal(t, "app.kubernetes.io/name=argocd-server", InstanceLabelSelector("app.kubernetes.io/name=argocd-server"))
+
+	t.Setenv(EnvInstanceName, "team-a")
+	assert.Equal(t, "team-a-argocd-cm", InstanceResourceName("argocd-cm"))
+	assert.Equal(t, "team-a-argocd-cm", GetArgoCDConfigMapName())
+	assert.Equal(t, "team-a-argocd-repo-server:8081", GetDefaultRepoServerAddr())
+	assert.Equal(t, "app.kubernetes.io/name=argocd-server,argocd.argoproj.io/instance-name=team-a", InstanceLabelSelector("app.kubernetes.io/name=argocd-server"))
+}
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"runtime"
 
+	"github.com/Masterminds/semver/v3"
 	log "github.com/sirupsen/logrus"
 )
 
+// CLIVersionSkew is the number of minor versions the CLI may be behind or ahead of the API server
+const CLIVersionSkew = 1
+
 // Version information set by link flags during build. We fall back to these sane
 // default values when we build outside the Makefile context (e.g. go run, go build, or go test).
 var (
@@ -78,3 +82,41 @@ func GetVersion() Version {
 		KubectlVersion: kubectlVersion,
 	}
 }
+
+// SupportedCLIVersions returns the oldest and the newest minor versions of the CLI supported by the API server with the
+// given version, e.g. v2.6 and v2.8 for v2.7.3
+func SupportedCLIVersions(serverVersion string) (string, string, error) {
+	v, err := semver.NewVersion(serverVersion)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing server version %s: %w", serverVersion, err)
+	}
+	minMinor := uint64(0)
+	if v.Minor() > CLIVersionSkew {
+		minMinor = v.Minor() - CLIVersionSkew
+	}
+	return fmt.Sprintf("v%d.%d", v.Major(), minMinor), fmt.Sprintf("v%d.%d", v.Major(), v.Minor()+CLIVersionSkew), nil
+}
+
+// CLIVersionSupported returns whether the minor version of the CLI with the given version is between the given oldest
+// and newest minor versions
+func CLIVersionSupported(cliVersion string, minVersion string, maxVersion string) (bool, error) {
+	v, err := semver.NewVersion(cliVersion)
+	if err != nil {
+		return false, fmt.Errorf("error parsing CLI version %s: %w", cliVersion, err)
+	}
+	// prereleases are compared as their releases, e.g. v2.8.0-rc1 as v2.8.0
+	constraint, err := semver.NewConstraint(fmt.Sprintf(">= %s.0-0, < %s", minVersion, nextMinor(maxVersion)))
+	if err != nil {
+		return false, fmt.Errorf("error parsing supported CLI versions: %w", err)
+	}
+	return constraint.Check(v), nil
+}
+
+// nextMinor returns the minor version following the given one, e.g. v2.9.0-0 for v2.8
+func nextMinor(minorVersion string) string {
+	v, err := semver.NewVersion(minorVersion)
+	if err != nil {
+		return minorVersion
+	}
+	return fmt.Sprintf("v%d.%d.0-0", v.Major(), v.Minor()+1)
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedCLIVersions(t *testing.T) {
+	minVersion, maxVersion, err := SupportedCLIVersions("v2.7.3+abcdef0")
+	require.NoError(t, err)
+	assert.Equal(t, "v2.6", minVersion)
+	assert.Equal(t, "v2.8", maxVersion)
+
+	minVersion, maxVersion, err = SupportedCLIVersions("v3.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v3.0", minVersion)
+	assert.Equal(t, "v3.1", maxVersion)
+
+	_, _, err = SupportedCLIVersions("unknown")
+	assert.Error(t, err)
+}
+
+func TestCLIVersionSupported(t *testing.T) {
+	for version, expected := range map[string]bool{
+		"v2.5.9":         false,
+		"v2.6.0":         true,
+		"v2.6.0-rc1":     true,
+		"v2.7.3+abcdef0": true,
+		"v2.8.12":        true,
+		"v2.9.0-rc1":     false,
+		"v2.9.0":         false,
+		"v3.7.0":         false,
+	} {
+		supported, err := CLIVersionSupported(version, "v2.6", "v2.8")
+		require.NoError(t, err)
+		assert.Equal(t, expected, supported, version)
+	}
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+)
+
+// adaptiveRefreshState is the state of the adaptive refresh of an application
+type adaptiveRefreshState struct {
+	// fingerprint is the fingerprint of the result of the last comparison of the application
+	fingerprint uint64
+	// unchanged is the number of consecutive comparisons whose result did not change
+	unchanged int
+}
+
+// SetAdaptiveRefresh enables the adaptive refresh of the applications: once the result of the comparison of an
+// application did not change for the given number of consecutive periodic refreshes, the refresh period of the
+// application doubles after each unchanged comparison, up to the given maximum period. The refresh period is reset
+// when the application changes or when its refresh is requested, e.g. by a webhook. The adaptive refresh is disabled
+// if the threshold is 0. Projects can override both settings with annotations.
+func (ctrl *ApplicationController) SetAdaptiveRefresh(threshold int, maxTimeout time.Duration) {
+	ctrl.adaptiveRefreshThreshold = threshold
+	ctrl.adaptiveRefreshMaxTimeout = maxTimeout
+}
+
+// adaptiveRefreshSettings returns the adaptive refresh settings of the given application, which are the settings of
+// the controller unless they are overridden by the annotations of the project of the application
+func (ctrl *ApplicationController) adaptiveRefreshSettings(app *appv1.Application) (int, time.Duration) {
+	threshold := ctrl.adaptiveRefreshThreshold
+	maxTimeout := ctrl.adaptiveRefreshMaxTimeout
+	if ctrl.projInformer == nil {
+		return threshold, maxTimeout
+	}
+	proj, err := applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()).AppProjects(ctrl.namespace).Get(app.Spec.GetProject())
+	if err != nil {
+		return threshold, maxTimeout
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName(), "project": proj.Name})
+	if value, ok := proj.Annotations[common.AnnotationKeyAdaptiveRefreshThreshold]; ok {
+		if parsed, err := strconv.Atoi(value); err != nil || parsed < 0 {
+			logCtx.Warnf("Ignoring invalid %s annotation %q", common.AnnotationKeyAdaptiveRefreshThreshold, value)
+		} else {
+			threshold = parsed
+		}
+	}
+	if value, ok := proj.Annotations[common.AnnotationKeyAdaptiveRefreshMaxTimeout]; ok {
+		if parsed, err := time.ParseDuration(value); err != nil || parsed <= 0 {
+			logCtx.Warnf("Ignoring invalid %s annotation %q", common.AnnotationKeyAdaptiveRefreshMaxTimeout, value)
+		} else {
+			maxTimeout = parsed
+		}
+	}
+	return threshold, maxTimeout
+}
+
+// appStatusRefreshTimeout returns the period after which the status of the given application expires, which grows
+// exponentially once the result of its comparison did not change for the adaptive refresh threshold
+func (ctrl *ApplicationController) appStatusRefreshTimeout(app *appv1.Application) time.Duration {
+	timeout := ctrl.statusRefreshTimeout
+	threshold, maxTimeout := ctrl.adaptiveRefreshSettings(app)
+	if threshold <= 0 || timeout <= 0 || maxTimeout <= timeout {
+		return timeout
+	}
+	value, ok := ctrl.adaptiveRefreshStates.Load(ctrl.toAppKey(app.QualifiedName()))
+	if !ok {
+		return timeout
+	}
+	for i := threshold; i <= value.(*adaptiveRefreshState).unchanged && timeout < maxTimeout; i++ {
+		timeout *= 2
+	}
+	if timeout > maxTimeout {
+		return maxTimeout
+	}
+	return timeout
+}
+
+// resetAdaptiveRefresh resets the refresh period of the given application, which changed or whose refresh was
+// requested
+func (ctrl *ApplicationController) resetAdaptiveRefresh(app *appv1.Application) {
+	ctrl.adaptiveRefreshStates.Delete(ctrl.toAppKey(app.QualifiedName()))
+}
+
+// recordAdaptiveRefresh records the result of the comparison of the given application, counting the consecutive
+// comparisons whose result did not change
+func (ctrl *ApplicationController) recordAdaptiveRefresh(app *appv1.Application, compareResult *comparisonResult) {
+	key := ctrl.toAppKey(app.QualifiedName())
+	fingerprint := comparisonFingerprint(compareResult)
+	state := &adaptiveRefreshState{fingerprint: fingerprint}
+	if value, ok := ctrl.adaptiveRefreshStates.Load(key); ok {
+		previous := value.(*adaptiveRefreshState)
+		if previous.fingerprint == fingerprint {
+			state.unchanged = previous.unchanged + 1
+		}
+	}
+	ctrl.adaptiveRefreshStates.Store(key, state)
+}
+
+// comparisonFingerprint returns a fingerprint of the revisions, the statuses and the resources of a comparison result
+func comparisonFingerprint(compareResult *comparisonResult) uint64 {
+	h := fnv.New64a()
+	if syncStatus := compareResult.syncStatus; syncStatus != nil {
+		_, _ = fmt.Fprintf(h, "%s|%s|%v|", syncStatus.Status, syncStatus.Revision, syncStatus.Revisions)
+	}
+	if healthStatus := compareResult.healthStatus; healthStatus != nil {
+		_, _ = fmt.Fprintf(h, "%s|%s|", healthStatus.Status, healthStatus.Message)
+	}
+	resources := make([]string, 0, len(compareResult.resources))
+	for _, res := range compareResult.resources {
+		health := ""
+		if res.Health != nil {
+			health = string(res.Health.Status)
+		}
+		resources = append(resources, fmt.Sprintf("%s|%s|%s|%s", resourceStatusKey(res), res.Version, res.Status, health))
+	}
+	sort.Strings(resources)
+	for _, res := range resources {
+		_, _ = fmt.Fprintln(h, res)
+	}
+	return h.Sum64()
+}
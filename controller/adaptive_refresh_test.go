@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func newFakeComparisonResult(revision string) *comparisonResult {
+	return &comparisonResult{
+		syncStatus:   &argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced, Revision: revision},
+		healthStatus: &argoappv1.HealthStatus{Status: "Healthy"},
+		resources:    []argoappv1.ResourceStatus{{Kind: "Deployment", Namespace: "default", Name: "guestbook", Status: argoappv1.SyncStatusCodeSynced}},
+	}
+}
+
+func TestAppStatusRefreshTimeout(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.statusRefreshTimeout = 3 * time.Minute
+	ctrl.SetAdaptiveRefresh(2, 20*time.Minute)
+
+	var timeouts []time.Duration
+	for i := 0; i < 6; i++ {
+		ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("abc"))
+		timeouts = append(timeouts, ctrl.appStatusRefreshTimeout(app))
+	}
+	assert.Equal(t, []time.Duration{3 * time.Minute, 3 * time.Minute, 6 * time.Minute, 12 * time.Minute, 20 * time.Minute, 20 * time.Minute}, timeouts)
+
+	// a change of the comparison result resets the refresh period
+	ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("def"))
+	assert.Equal(t, 3*time.Minute, ctrl.appStatusRefreshTimeout(app))
+
+	ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("def"))
+	ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("def"))
+	assert.Equal(t, 6*time.Minute, ctrl.appStatusRefreshTimeout(app))
+	ctrl.resetAdaptiveRefresh(app)
+	assert.Equal(t, 3*time.Minute, ctrl.appStatusRefreshTimeout(app))
+}
+
+func TestAppStatusRefreshTimeout_Disabled(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.statusRefreshTimeout = 3 * time.Minute
+	for i := 0; i < 5; i++ {
+		ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("abc"))
+	}
+	assert.Equal(t, 3*time.Minute, ctrl.appStatusRefreshTimeout(app))
+}
+
+func TestAppStatusRefreshTimeout_ProjectAnnotations(t *testing.T) {
+	staticProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "static", Namespace: test.FakeArgoCDNamespace, Annotations: map[string]string{
+			common.AnnotationKeyAdaptiveRefreshThreshold:  "1",
+			common.AnnotationKeyAdaptiveRefreshMaxTimeout: "2h",
+		}},
+	}
+	disabledProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "disabled", Namespace: test.FakeArgoCDNamespace, Annotations: map[string]string{
+			common.AnnotationKeyAdaptiveRefreshThreshold:  "0",
+			common.AnnotationKeyAdaptiveRefreshMaxTimeout: "invalid",
+		}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{staticProj, disabledProj}})
+	ctrl.statusRefreshTimeout = 3 * time.Minute
+	ctrl.SetAdaptiveRefresh(5, 20*time.Minute)
+
+	threshold, maxTimeout := ctrl.adaptiveRefreshSettings(newPrioritizedFakeApp("app", "static", ""))
+	assert.Equal(t, 1, threshold)
+	assert.Equal(t, 2*time.Hour, maxTimeout)
+	threshold, maxTimeout = ctrl.adaptiveRefreshSettings(newPrioritizedFakeApp("app", "disabled", ""))
+	assert.Equal(t, 0, threshold)
+	assert.Equal(t, 20*time.Minute, maxTimeout)
+	threshold, _ = ctrl.adaptiveRefreshSettings(newPrioritizedFakeApp("app", "default", ""))
+	assert.Equal(t, 5, threshold)
+
+	app := newPrioritizedFakeApp("app", "static", "")
+	for i := 0; i < 3; i++ {
+		ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("abc"))
+	}
+	assert.Equal(t, 12*time.Minute, ctrl.appStatusRefreshTimeout(app))
+}
+
+func TestNeedRefreshAppStatus_AdaptiveRefresh(t *testing.T) {
+	app := newExpiredFakeApp()
+	reconciledAt := metav1.NewTime(time.Now().Add(-90 * time.Minute))
+	app.Status.ReconciledAt = &reconciledAt
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.statusRefreshTimeout = time.Hour
+	ctrl.SetAdaptiveRefresh(1, 4*time.Hour)
+	ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("abc"))
+	ctrl.recordAdaptiveRefresh(app, newFakeComparisonResult("abc"))
+
+	// the application reconciled 90 minutes ago is not refreshed since its refresh period doubled
+	needRefresh, _, _ := ctrl.needRefreshAppStatus(app, ctrl.appStatusRefreshTimeout(app), 0)
+	assert.False(t, needRefresh)
+
+	// a requested refresh resets the refresh period
+	app.Annotations = map[string]string{argoappv1.AnnotationKeyRefresh: string(argoappv1.RefreshTypeNormal)}
+	needRefresh, _, _ = ctrl.needRefreshAppStatus(app, ctrl.appStatusRefreshTimeout(app), 0)
+	assert.True(t, needRefresh)
+	assert.Equal(t, time.Hour, ctrl.appStatusRefreshTimeout(app))
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math"
+	"math/rand"
 	"net/http"
 	"reflect"
 	"runtime/debug"
@@ -22,6 +24,7 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,12 +54,19 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/healthz"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
+	"github.com/argoproj/argo-cd/v2/util/lua"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
+	"github.com/argoproj/argo-cd/v2/util/scm"
 	settings_util "github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 const (
 	updateOperationStateTimeout = 1 * time.Second
+	// settingsChangedRefreshSpread is the duration over which the refreshes requested by a change of the settings
+	// affecting the comparison of the applications are spread
+	settingsChangedRefreshSpread = time.Minute
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
 )
@@ -104,16 +114,66 @@ type ApplicationController struct {
 	statusRefreshTimeout          time.Duration
 	statusHardRefreshTimeout      time.Duration
 	selfHealTimeout               time.Duration
+	operationStaleTimeout         time.Duration
 	repoClientset                 apiclient.Clientset
 	db                            db.ArgoDB
 	settingsMgr                   *settings_util.SettingsManager
 	refreshRequestedApps          map[string]CompareWith
 	refreshRequestedAppsMutex     *sync.Mutex
 	metricsServer                 *metrics.MetricsServer
+	reconcileTimings              *reconcileTimings
 	kubectlSemaphore              *semaphore.Weighted
 	clusterFilter                 func(cluster *appv1.Cluster) bool
 	projByNameCache               sync.Map
 	applicationNamespaces         []string
+	clusterSyncLimiter            *clusterSyncLimiter
+	statusWarmupDuration          time.Duration
+	startedAt                     time.Time
+	// deferredComparisons holds the keys of the applications whose comparison was deferred during the warm-up
+	deferredComparisons sync.Map
+	// dependencyChecker reports the states of the services the controller depends on
+	dependencyChecker *healthz.DependencyChecker
+	// metricsServerOnce ensures the metrics server is started once, either by Run or by RunWithLeaderElection
+	metricsServerOnce sync.Once
+	// appsQueued indicates whether the applications which existed when the controller started have been queued
+	appsQueued     bool
+	appsQueuedLock sync.Mutex
+	// statusPatchStrategy is how the status of applications is persisted, see SetStatusPatchStrategy
+	statusPatchStrategy string
+	// statusMaxSize is the maximum size of applications in bytes above which their status is capped, see SetStatusMaxSize
+	statusMaxSize int
+	// dryRun indicates whether the controller reconciles applications without persisting anything, see SetDryRun
+	dryRun bool
+	// refreshLimiters rate limit the requested refreshes of each type, see SetRefreshRateLimits
+	refreshLimiters map[appv1.RefreshType]*rate.Limiter
+	// refreshReservations holds the time at which the throttled requested refreshes are allowed to start, by app key
+	refreshReservations      map[string]refreshReservation
+	refreshReservationsMutex sync.Mutex
+	// adaptiveRefreshThreshold and adaptiveRefreshMaxTimeout configure the adaptive refresh, see SetAdaptiveRefresh
+	adaptiveRefreshThreshold  int
+	adaptiveRefreshMaxTimeout time.Duration
+	// adaptiveRefreshStates holds the adaptive refresh state of each application
+	adaptiveRefreshStates sync.Map
+	// appSelectors restricts the applications watched by the controller, see SetApplicationSelectors
+	appSelectors *argo.ApplicationSelectors
+	// comparisonSettingsKey is the key of the last recorded settings affecting the comparison of the applications, see
+	// updateComparisonSettings
+	comparisonSettingsKey string
+	// commitStatusReporting indicates whether the outcome of the syncs is reported as commit statuses, see
+	// SetCommitStatusReporting
+	commitStatusReporting bool
+	// commitStatusProviderHosts are the providers of the self-hosted instances on which the commit statuses are set
+	commitStatusProviderHosts map[string]scm.Provider
+	// newSCMClient creates the clients of the SCM providers on which the commit statuses are set
+	newSCMClient func(ctx context.Context, provider scm.Provider, repo *appv1.Repository) (scm.Client, error)
+	// credentialExpiryWarningPeriod and credentialMaxAge configure the monitoring of the expiry of the credentials, see
+	// SetCredentialExpiryMonitoring
+	credentialExpiryWarningPeriod time.Duration
+	credentialMaxAge              time.Duration
+	// credentialExpiries caches the expiries of the credentials listed at credentialExpiriesListedAt
+	credentialExpiries         []db.CredentialExpiry
+	credentialExpiriesListedAt time.Time
+	credentialExpiriesMutex    sync.Mutex
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -128,6 +188,7 @@ func NewApplicationController(
 	appResyncPeriod time.Duration,
 	appHardResyncPeriod time.Duration,
 	selfHealTimeout time.Duration,
+	operationStaleTimeout time.Duration,
 	metricsPort int,
 	metricsCacheExpiration time.Duration,
 	metricsApplicationLabels []string,
@@ -146,7 +207,9 @@ func NewApplicationController(
 		applicationClientset:          applicationClientset,
 		repoClientset:                 repoClientset,
 		appRefreshQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "app_reconciliation_queue"),
+		statusPatchStrategy:           StatusPatchStrategyMergePatch,
 		appOperationQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "app_operation_processing_queue"),
+		reconcileTimings:              newReconcileTimings(),
 		projectRefreshQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "project_reconciliation_queue"),
 		appComparisonTypeRefreshQueue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
 		db:                            db,
@@ -157,9 +220,12 @@ func NewApplicationController(
 		auditLogger:                   argo.NewAuditLogger(namespace, kubeClientset, "argocd-application-controller"),
 		settingsMgr:                   settingsMgr,
 		selfHealTimeout:               selfHealTimeout,
+		operationStaleTimeout:         operationStaleTimeout,
 		clusterFilter:                 clusterFilter,
 		projByNameCache:               sync.Map{},
 		applicationNamespaces:         applicationNamespaces,
+		clusterSyncLimiter:            newClusterSyncLimiter(),
+		refreshReservations:           map[string]refreshReservation{},
 	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
@@ -209,13 +275,15 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterFilter, argo.NewResourceTracking())
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, ctrl.handleSettingsChanged, clusterFilter, argo.NewResourceTracking())
 	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
 	ctrl.appStateManager = appStateManager
 	ctrl.stateCache = stateCache
+	ctrl.dependencyChecker = healthz.NewDependencyChecker(ctrl.dependencies()...)
+	ctrl.metricsServer.SetDependencyChecker(ctrl.dependencyChecker)
 
 	return &ctrl, nil
 }
@@ -308,6 +376,75 @@ func (ctrl *ApplicationController) getAppProj(app *appv1.Application) (*appv1.Ap
 	return proj, nil
 }
 
+// comparisonSettingsKey returns a key of the given settings affecting the comparison of the applications with their
+// live state. The resource actions and the ignored resource updates are left out, since they do not affect it.
+func comparisonSettingsKey(resourceOverrides map[string]appv1.ResourceOverride, resourcesFilter *settings_util.ResourcesFilter, appLabelKey string, trackingMethod string, compareOptions settings_util.ArgoCDDiffOptions) (string, error) {
+	overrides := make(map[string]appv1.ResourceOverride, len(resourceOverrides))
+	for key, override := range resourceOverrides {
+		override.Actions = ""
+		overrides[key] = override
+	}
+	data, err := json.Marshal([]interface{}{overrides, resourcesFilter, appLabelKey, trackingMethod, compareOptions})
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return strconv.FormatUint(h.Sum64(), 16), nil
+}
+
+// updateComparisonSettings records the current settings affecting the comparison of the applications, and returns
+// whether they changed since they were last recorded
+func (ctrl *ApplicationController) updateComparisonSettings() (bool, error) {
+	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return false, err
+	}
+	resourcesFilter, err := ctrl.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return false, err
+	}
+	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return false, err
+	}
+	trackingMethod, err := ctrl.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return false, err
+	}
+	compareOptions, err := ctrl.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return false, err
+	}
+	key, err := comparisonSettingsKey(resourceOverrides, resourcesFilter, appLabelKey, trackingMethod, compareOptions)
+	if err != nil {
+		return false, err
+	}
+	changed := key != ctrl.comparisonSettingsKey
+	ctrl.comparisonSettingsKey = key
+	return changed, nil
+}
+
+// handleSettingsChanged requests the refresh of all the applications when the settings affecting their comparison
+// changed, so that updated resource customizations, ignored differences and resource filters are applied without
+// waiting for the next reconciliation. The refreshes are spread over settingsChangedRefreshSpread, so that large
+// instances do not compare all their applications at once.
+func (ctrl *ApplicationController) handleSettingsChanged() {
+	changed, err := ctrl.updateComparisonSettings()
+	if err != nil {
+		log.Warnf("Failed to read the updated settings, refreshing all the applications: %v", err)
+	} else if !changed {
+		log.Debug("Updated settings do not affect the comparison of the applications, skipping their refresh")
+		return
+	}
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		if app, ok := obj.(*appv1.Application); ok && ctrl.canProcessApp(app) {
+			after := time.Duration(rand.Int63n(int64(settingsChangedRefreshSpread)))
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &after)
+		}
+	}
+}
+
 func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]bool, ref v1.ObjectReference) {
 	// if namespaced resource is not managed by any app it might be orphaned resource of some other apps
 	if len(managedByApp) == 0 && ref.Namespace != "" {
@@ -386,6 +523,7 @@ func (ctrl *ApplicationController) setAppManagedResources(a *appv1.Application,
 	if err != nil {
 		return nil, fmt.Errorf("error setting app managed resources: %s", err)
 	}
+	ctrl.setAppComparisonState(a, comparisonResult.syncStatus)
 	return tree, nil
 }
 
@@ -420,6 +558,11 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
+	resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource overrides: %w", err)
+	}
+
 	orphanedNodesMap := make(map[kube.ResourceKey]appv1.ResourceNode)
 	warnOrphaned := true
 	if proj.Spec.OrphanedResources != nil {
@@ -454,8 +597,9 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 				},
 			})
 		} else {
+			start := len(nodes)
 			err := ctrl.stateCache.IterateHierarchy(a.Spec.Destination.Server, kube.GetResourceKey(live), func(child appv1.ResourceNode, appName string) bool {
-				permitted, _ := proj.IsResourcePermitted(schema.GroupKind{Group: child.ResourceRef.Group, Kind: child.ResourceRef.Kind}, child.Namespace, a.Spec.Destination, func(project string) ([]*appv1.Cluster, error) {
+				permitted, _ := proj.IsResourcePermitted(schema.GroupVersionKind{Group: child.ResourceRef.Group, Version: child.ResourceRef.Version, Kind: child.ResourceRef.Kind}, child.Namespace, a.Spec.Destination, func(project string) ([]*appv1.Cluster, error) {
 					clusters, err := ctrl.db.GetProjectClusters(context.TODO(), project)
 					if err != nil {
 						return nil, fmt.Errorf("failed to get project clusters: %w", err)
@@ -471,6 +615,9 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 			if err != nil {
 				return nil, fmt.Errorf("failed to iterate resource hierarchy: %w", err)
 			}
+			if aggregation := resourceOverrides[lua.GetConfigMapKey(live.GroupVersionKind())].HealthAggregation; aggregation != nil {
+				setAggregatedNodeHealth(aggregation, kube.GetResourceKey(live), nodes[start:])
+			}
 		}
 	}
 	orphanedNodes := make([]appv1.ResourceNode, 0)
@@ -489,7 +636,7 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 					return false
 				}
 
-				permitted, _ := proj.IsResourcePermitted(schema.GroupKind{Group: child.ResourceRef.Group, Kind: child.ResourceRef.Kind}, child.Namespace, a.Spec.Destination, func(project string) ([]*appv1.Cluster, error) {
+				permitted, _ := proj.IsResourcePermitted(schema.GroupVersionKind{Group: child.ResourceRef.Group, Version: child.ResourceRef.Version, Kind: child.ResourceRef.Kind}, child.Namespace, a.Spec.Destination, func(project string) ([]*appv1.Cluster, error) {
 					return ctrl.db.GetProjectClusters(context.TODO(), project)
 				})
 
@@ -708,21 +855,35 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer ctrl.appOperationQueue.ShutDown()
 	defer ctrl.projectRefreshQueue.ShutDown()
 
+	ctrl.startedAt = time.Now()
 	ctrl.metricsServer.RegisterClustersInfoSource(ctx, ctrl.stateCache)
 	ctrl.RegisterClusterSecretUpdater(ctx)
 
 	go ctrl.appInformer.Run(ctx.Done())
 	go ctrl.projInformer.Run(ctx.Done())
 
+	if _, err := ctrl.updateComparisonSettings(); err != nil {
+		log.Warnf("Failed to read the settings affecting the comparison of the applications: %v", err)
+	}
 	errors.CheckError(ctrl.stateCache.Init())
 
 	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced, ctrl.projInformer.HasSynced) {
 		log.Error("Timed out waiting for caches to sync")
 		return
 	}
+	errors.CheckError(ctrl.queueInitialApps())
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
-	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	ctrl.startMetricsServer(ctx)
+
+	if ctrl.operationStaleTimeout > 0 && !ctrl.dryRun {
+		go wait.Until(ctrl.failStaleOperations, operationJanitorInterval, ctx.Done())
+	}
+
+	// the operations are left to the active controller in dry run
+	if ctrl.dryRun {
+		operationProcessors = 0
+	}
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -750,13 +911,20 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	<-ctx.Done()
 }
 
+func (ctrl *ApplicationController) startMetricsServer(ctx context.Context) {
+	ctrl.metricsServerOnce.Do(func() {
+		go ctrl.dependencyChecker.Run(ctx, healthz.DependencyCheckInterval)
+		go func() { errors.CheckError(metricsutil.ListenAndServe(ctrl.metricsServer.Server)) }()
+	})
+}
+
 // requestAppRefresh adds a request for given app to the refresh queue. appName
 // needs to be the qualified name of the application, i.e. <namespace>/<name>.
 func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
 	key := ctrl.toAppKey(appName)
 
 	if compareWith != nil && after != nil {
-		ctrl.appComparisonTypeRefreshQueue.AddAfter(fmt.Sprintf("%s/%d", key, compareWith), *after)
+		ctrl.appComparisonTypeRefreshQueue.AddAfter(fmt.Sprintf("%s/%d", key, *compareWith), *after)
 	} else {
 		if compareWith != nil {
 			ctrl.refreshRequestedAppsMutex.Lock()
@@ -804,6 +972,7 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
+		ctrl.clusterSyncLimiter.release(appKey.(string))
 		return
 	}
 	origApp, ok := obj.(*appv1.Application)
@@ -823,9 +992,33 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 			return
 		}
 		app = freshApp
+		if ctrl.requireOperationApproval(app) {
+			return
+		}
 	}
 
-	if app.Operation != nil {
+	if app.Operation == nil || app.Operation.IsPendingApproval() {
+		// release the slot of an operation which completed or was removed outside of the controller
+		ctrl.clusterSyncLimiter.release(appKey.(string))
+	}
+
+	// operations pending approval are left alone until they are approved or terminated
+	if app.Operation != nil && !app.Operation.IsPendingApproval() {
+		// the slot is held until the operation completes, the limit is only checked before its first step
+		if !ctrl.clusterSyncLimiter.holds(appKey.(string)) {
+			server, limit, err := ctrl.getClusterSyncLimit(app)
+			if err != nil {
+				// let the operation fail with a meaningful message if the destination is invalid
+				log.Warnf("Failed to get sync concurrency limit of application '%s' destination: %v", app.QualifiedName(), err)
+				ctrl.processRequestedAppOperation(app)
+				return
+			}
+			if !ctrl.clusterSyncLimiter.tryAcquire(appKey.(string), server, limit) {
+				log.Infof("Postponing operation of application '%s': concurrent sync limit of %d reached for cluster %s", app.QualifiedName(), limit, server)
+				ctrl.appOperationQueue.AddAfter(appKey, clusterSyncLimitRetryDelay)
+				return
+			}
+		}
 		ctrl.processRequestedAppOperation(app)
 	} else if app.DeletionTimestamp != nil && app.CascadedDeletion() {
 		_, err = ctrl.finalizeApplicationDeletion(app, func(project string) ([]*appv1.Cluster, error) {
@@ -843,6 +1036,35 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 	return
 }
 
+// requireOperationApproval puts the operations which did not start yet and were not approved by a different subject
+// than their initiator back into the pending approval state, if the project of the application requires syncs to be
+// approved. Operations can be set without going through the API server, e.g. by ApplicationSets or by editing the
+// application, so the approval cannot be enforced by the API server only. Returns true if the operation was reset.
+func (ctrl *ApplicationController) requireOperationApproval(app *appv1.Application) bool {
+	if app.Operation == nil || app.Operation.IsPendingApproval() || app.Operation.IsApproved() || app.Operation.DryRun() {
+		return false
+	}
+	if app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed() {
+		// the operation already started
+		return false
+	}
+	proj, err := ctrl.getAppProj(app)
+	if err != nil || !proj.IsSyncApprovalRequired(app.Spec.Destination) {
+		// operations of applications with an invalid project fail when they are processed
+		return false
+	}
+	logCtx := log.WithField("application", app.QualifiedName())
+	logCtx.Warnf("Operation initiated by '%s' requires an approval from a different subject, waiting for approval", app.Operation.InitiatedBy.Username)
+	app.Operation.Approval = &appv1.OperationApproval{}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(context.Background(), app, metav1.UpdateOptions{})
+	if err != nil {
+		logCtx.Errorf("Failed to require the approval of the operation: %v", err)
+		ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(app.QualifiedName()))
+	}
+	ctrl.clusterSyncLimiter.release(ctrl.toAppKey(app.QualifiedName()))
+	return true
+}
+
 func (ctrl *ApplicationController) processAppComparisonTypeQueueItem() (processNext bool) {
 	key, shutdown := ctrl.appComparisonTypeRefreshQueue.Get()
 	processNext = true
@@ -1026,10 +1248,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 
 		filteredObjs := FilterObjectsForDeletion(objs)
 
-		propagationPolicy := metav1.DeletePropagationForeground
-		if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
-			propagationPolicy = metav1.DeletePropagationBackground
-		}
+		propagationPolicy := app.GetDeletionPropagationPolicy()
 		logCtx.Infof("Deleting application's resources with %s propagation policy", propagationPolicy)
 
 		err = kube.RunAllAsync(len(filteredObjs), func(i int) error {
@@ -1064,6 +1283,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		return objs, err
 	}
 
+	if err := ctrl.cache.SetAppResourcesStatus(app.InstanceName(ctrl.namespace), nil); err != nil {
+		return objs, err
+	}
+
 	if err := ctrl.removeCascadeFinalizer(app); err != nil {
 		return objs, err
 	}
@@ -1151,6 +1374,8 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			retryAfter := time.Until(retryAt)
 			if retryAfter > 0 {
 				logCtx.Infof("Skipping retrying in-progress operation. Attempting again at: %s", retryAt.Format(time.RFC3339))
+				// the sync slot is not held while waiting for the retry
+				ctrl.clusterSyncLimiter.release(ctrl.toAppKey(app.QualifiedName()))
 				ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &retryAfter)
 				return
 			} else {
@@ -1244,6 +1469,9 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			now := metav1.Now()
 			state.FinishedAt = &now
 		}
+		if ctrl.statusMaxSize > 0 {
+			truncateOperationMessages(state)
+		}
 		patch := map[string]interface{}{
 			"status": map[string]interface{}{
 				"operationState": state,
@@ -1280,6 +1508,10 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 		}
 		log.Infof("updated '%s' operation (phase: %s)", app.QualifiedName(), state.Phase)
 		if state.Phase.Completed() {
+			ctrl.clusterSyncLimiter.release(ctrl.toAppKey(app.QualifiedName()))
+			if err := ctrl.cache.IncrementProjectUsage(app.Spec.GetProject(), appstatecache.ProjectUsageSyncs, 1); err != nil {
+				log.Warnf("Failed to record sync usage of project '%s': %v", app.Spec.GetProject(), err)
+			}
 			eventInfo := argo.EventInfo{Reason: argo.EventReasonOperationCompleted}
 			var messages []string
 			if state.Operation.Sync != nil && len(state.Operation.Sync.Resources) > 0 {
@@ -1299,6 +1531,9 @@ func (ctrl *ApplicationController) setOperationState(app *appv1.Application, sta
 			}
 			ctrl.auditLogger.LogAppEvent(app, eventInfo, strings.Join(messages, " "))
 			ctrl.metricsServer.IncSync(app, state)
+			if ctrl.commitStatusReporting && !ctrl.dryRun {
+				go ctrl.reportCommitStatuses(app.DeepCopy(), state.DeepCopy())
+			}
 		}
 		return nil
 	})
@@ -1324,6 +1559,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
+		ctrl.adaptiveRefreshStates.Delete(appKey)
 		return
 	}
 	origApp, ok := obj.(*appv1.Application)
@@ -1332,7 +1568,18 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return
 	}
 	origApp = origApp.DeepCopy()
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
+	if !ctrl.dryRun && ctrl.deleteExpiredApp(origApp) {
+		return
+	}
+	if !ctrl.dryRun {
+		if delay, dropped := ctrl.throttleRequestedRefresh(origApp); dropped {
+			return
+		} else if delay > 0 {
+			ctrl.appRefreshQueue.AddAfter(appKey, delay)
+			return
+		}
+	}
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.appStatusRefreshTimeout(origApp), ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
 		return
@@ -1344,6 +1591,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	defer func() {
 		reconcileDuration := time.Since(startTime)
 		ctrl.metricsServer.IncReconcile(origApp, reconcileDuration)
+		ctrl.reconcileTimings.observe(appKey.(string), origApp.QualifiedName(), comparisonLevel, reconcileDuration)
 		logCtx.WithFields(log.Fields{
 			"time_ms":        reconcileDuration.Milliseconds(),
 			"level":          comparisonLevel,
@@ -1437,8 +1685,33 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+	var pausedConds []appv1.ApplicationCondition
+	if pausedCond := ctrl.automationPausedCondition(app, project); pausedCond != nil {
+		pausedConds = append(pausedConds, *pausedCond)
+	}
+	app.Status.SetConditions(pausedConds, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionAutomationPausedWarning: true})
+
+	var expiringConds []appv1.ApplicationCondition
+	if expiringCond := ctrl.expiringCondition(app); expiringCond != nil {
+		expiringConds = append(expiringConds, *expiringCond)
+	}
+	app.Status.SetConditions(expiringConds, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionExpiringWarning: true})
+
+	var credentialExpiringConds []appv1.ApplicationCondition
+	if credentialExpiringCond := ctrl.credentialExpiringCondition(app); credentialExpiringCond != nil {
+		credentialExpiringConds = append(credentialExpiringConds, *credentialExpiringCond)
+	}
+	app.Status.SetConditions(credentialExpiringConds, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionCredentialExpiringWarning: true})
+
+	if len(pausedConds) > 0 {
+		logCtx.Info("Sync prevented by paused automation")
+	} else if ctrl.dryRun {
+		logCtx.Debug("Sync skipped in dry run")
+	} else if project.Spec.SyncWindows.Matches(app).CanSync(false) {
+		syncErrCond := ctrl.scheduledSync(app, project, compareResult.syncStatus)
+		if syncErrCond == nil {
+			syncErrCond = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+		}
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
@@ -1456,6 +1729,10 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 
 	if app.Status.ReconciledAt == nil || comparisonLevel >= CompareWithLatest {
 		app.Status.ReconciledAt = &now
+		ctrl.recordAdaptiveRefresh(app, compareResult)
+	}
+	if isDrift(origApp, compareResult.syncStatus) {
+		ctrl.recordDrift(app, compareResult)
 	}
 	app.Status.Sync = *compareResult.syncStatus
 	app.Status.Health = *compareResult.healthStatus
@@ -1463,6 +1740,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	sort.Slice(app.Status.Resources, func(i, j int) bool {
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
 	})
+	app.Status.ResourcesSource = appv1.ResourceStatusLocationInline
 	app.Status.SourceType = compareResult.appSourceType
 	app.Status.SourceTypes = compareResult.appSourceTypes
 	ctrl.persistAppStatus(origApp, &app.Status)
@@ -1480,6 +1758,8 @@ func resourceStatusKey(res appv1.ResourceStatus) string {
 func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application, statusRefreshTimeout, statusHardRefreshTimeout time.Duration) (bool, appv1.RefreshType, CompareWith) {
 	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
 	var reason string
+	// expired indicates whether the refresh is periodic, rather than caused by a change or requested
+	expired := false
 	compareWith := CompareWithLatest
 	refreshType := appv1.RefreshTypeNormal
 	softExpired := app.Status.ReconciledAt == nil || app.Status.ReconciledAt.Add(statusRefreshTimeout).Before(time.Now().UTC())
@@ -1487,6 +1767,10 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 
 	if requestedType, ok := app.IsRefreshRequested(); ok {
 		compareWith = CompareWithLatestForceResolve
+		// comparisons only compare the live state against the most recently compared revision, unless the sources changed
+		if requestedType == appv1.RefreshTypeComparison && app.Status.ReconciledAt != nil && sourcesCompared(app) {
+			compareWith = CompareWithRecent
+		}
 		// user requested app refresh.
 		refreshType = requestedType
 		reason = fmt.Sprintf("%s refresh requested", refreshType)
@@ -1499,6 +1783,12 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 		} else if !app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source) {
 			reason = "spec.source differs"
 			compareWith = CompareWithLatestForceResolve
+		} else if due := nextScheduledSync(app); due != nil && !due.After(time.Now()) && (app.Status.ReconciledAt == nil || app.Status.ReconciledAt.Time.Before(*due)) {
+			reason = fmt.Sprintf("scheduled sync is due since %v, requesting hard refresh", due)
+			refreshType = appv1.RefreshTypeHard
+			compareWith = CompareWithLatestForceResolve
+		} else if softExpired && !hardExpired && app.Spec.Destination.Equals(app.Status.Sync.ComparedTo.Destination) && ctrl.deferComparison(app) {
+			return false, refreshType, compareWith
 		} else if hardExpired || softExpired {
 			// The commented line below mysteriously crashes if app.Status.ReconciledAt is nil
 			// reason = fmt.Sprintf("comparison expired. reconciledAt: %v, expiry: %v", app.Status.ReconciledAt, statusRefreshTimeout)
@@ -1508,6 +1798,7 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 				reconciledAtStr = app.Status.ReconciledAt.String()
 			}
 			reason = fmt.Sprintf("comparison expired, requesting refresh. reconciledAt: %v, expiry: %v", reconciledAtStr, statusRefreshTimeout)
+			expired = true
 			if hardExpired {
 				reason = fmt.Sprintf("comparison expired, requesting hard refresh. reconciledAt: %v, expiry: %v", reconciledAtStr, statusHardRefreshTimeout)
 				refreshType = appv1.RefreshTypeHard
@@ -1521,17 +1812,33 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	}
 
 	if reason != "" {
+		if !expired {
+			ctrl.resetAdaptiveRefresh(app)
+		}
 		logCtx.Infof("Refreshing app status (%s), level (%d)", reason, compareWith)
 		return true, refreshType, compareWith
 	}
 	return false, refreshType, compareWith
 }
 
+// sourcesCompared returns whether the sources of the given application are the ones of its most recent comparison
+func sourcesCompared(app *appv1.Application) bool {
+	if app.Spec.HasMultipleSources() {
+		return reflect.DeepEqual(app.Spec.Sources, app.Status.Sync.ComparedTo.Sources)
+	}
+	return app.Spec.Source.Equals(app.Status.Sync.ComparedTo.Source)
+}
+
 func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application) (*appv1.AppProject, bool) {
 	errorConditions := make([]appv1.ApplicationCondition, 0)
 	proj, err := ctrl.getAppProj(app)
 	if err != nil {
 		errorConditions = append(errorConditions, ctrl.projectErrorToCondition(err, app))
+	} else if err := argo.RenderDestinationNamespace(app); err != nil {
+		errorConditions = append(errorConditions, appv1.ApplicationCondition{
+			Type:    appv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
 	} else {
 		specConditions, err := argo.ValidatePermissions(context.Background(), &app.Spec, proj, ctrl.db)
 		if err != nil {
@@ -1572,6 +1879,10 @@ func (ctrl *ApplicationController) normalizeApplication(orig, app *appv1.Applica
 
 // persistAppStatus persists updates to application status. If no changes were made, it is a no-op
 func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus) {
+	if ctrl.dryRun {
+		ctrl.reportDryRunDiff(orig, newStatus)
+		return
+	}
 	logCtx := log.WithFields(log.Fields{"application": orig.QualifiedName()})
 	if orig.Status.Sync.Status != newStatus.Sync.Status {
 		message := fmt.Sprintf("Updated sync status: %s -> %s", orig.Status.Sync.Status, newStatus.Sync.Status)
@@ -1581,13 +1892,16 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 		message := fmt.Sprintf("Updated health status: %s -> %s", orig.Status.Health.Status, newStatus.Health.Status)
 		ctrl.auditLogger.LogAppEvent(orig, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: v1.EventTypeNormal}, message)
 	}
+	ctrl.capAppStatus(orig, newStatus)
 	var newAnnotations map[string]string
 	if orig.GetAnnotations() != nil {
 		newAnnotations = make(map[string]string)
 		for k, v := range orig.GetAnnotations() {
 			newAnnotations[k] = v
 		}
-		delete(newAnnotations, appv1.AnnotationKeyRefresh)
+		for _, key := range refreshAnnotationKeys {
+			delete(newAnnotations, key)
+		}
 	}
 	patch, modified, err := diff.CreateTwoWayMergePatch(
 		&appv1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: orig.GetAnnotations()}, Status: orig.Status},
@@ -1600,8 +1914,12 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 		logCtx.Infof("No status changes. Skipping patch")
 		return
 	}
-	appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(orig.Namespace)
-	_, err = appClient.Patch(context.Background(), orig.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if ctrl.statusPatchStrategy == StatusPatchStrategyServerSideApply {
+		err = ctrl.applyAppStatus(orig, newStatus)
+	} else {
+		appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(orig.Namespace)
+		_, err = appClient.Patch(context.Background(), orig.Name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: statusFieldManager})
+	}
 	if err != nil {
 		logCtx.Warnf("Error updating application: %v", err)
 	} else {
@@ -1609,6 +1927,101 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	}
 }
 
+// automationPausedCondition returns a warning condition if the application is automatically synced or has a sync
+// schedule but the automated syncs are paused, either globally or for the project of the application. The comparisons are not affected.
+func (ctrl *ApplicationController) automationPausedCondition(app *appv1.Application, project *appv1.AppProject) *appv1.ApplicationCondition {
+	if app.Spec.SyncPolicy == nil || (app.Spec.SyncPolicy.Automated == nil && app.Spec.SyncPolicy.Schedule == nil) {
+		return nil
+	}
+	var message string
+	if paused, reason, err := ctrl.settingsMgr.GetAutomationPaused(); err != nil {
+		log.Warnf("Failed to get whether automation is paused: %v", err)
+	} else if paused {
+		message = "Automated sync is paused globally"
+		if reason != "" {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+	}
+	if paused, reason := project.IsAutomationPaused(); message == "" && paused {
+		message = fmt.Sprintf("Automated sync is paused for project '%s'", project.Name)
+		if reason != "" {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+	}
+	if message == "" {
+		return nil
+	}
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionAutomationPausedWarning, Message: message}
+}
+
+// deleteExpiredApp deletes an application along with its resources once its TTL has expired, and returns true if the
+// deletion has been requested. Otherwise, it requests a refresh of the application at the beginning of the warning
+// period or at the expiry.
+func (ctrl *ApplicationController) deleteExpiredApp(app *appv1.Application) bool {
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		return false
+	}
+	expiresAt, err := app.ExpiresAt()
+	if err != nil || expiresAt == nil {
+		return false
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
+
+	now := time.Now()
+	if expiresAt.After(now) {
+		refreshAfter := expiresAt.Sub(now)
+		if untilWarning := refreshAfter - app.Spec.TTL.GetWarningPeriod(); untilWarning > 0 {
+			refreshAfter = untilWarning
+		}
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithRecent.Pointer(), &refreshAfter)
+		return false
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if !app.CascadedDeletion() {
+		app.SetCascadedDeletion(appv1.ResourcesFinalizerName)
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"finalizers": app.Finalizers,
+			},
+		})
+		if err != nil {
+			logCtx.Errorf("Failed to marshal finalizers of expired application: %v", err)
+			return false
+		}
+		if _, err = appIf.Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			logCtx.Errorf("Failed to set finalizer of expired application: %v", err)
+			return false
+		}
+	}
+	if err := appIf.Delete(context.Background(), app.Name, metav1.DeleteOptions{}); err != nil {
+		logCtx.Errorf("Failed to delete expired application: %v", err)
+		return false
+	}
+	message := fmt.Sprintf("Deleted application expired at %s", expiresAt.UTC().Format(time.RFC3339))
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceDeleted, Type: v1.EventTypeNormal}, message)
+	logCtx.Info(message)
+	return true
+}
+
+// expiringCondition returns a warning condition if the TTL of the application expires within its warning period. An
+// event is emitted when the application enters the warning period.
+func (ctrl *ApplicationController) expiringCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	expiresAt, err := app.ExpiresAt()
+	if err != nil || expiresAt == nil || time.Until(*expiresAt) > app.Spec.TTL.GetWarningPeriod() {
+		return nil
+	}
+	message := fmt.Sprintf("Application expires at %s", expiresAt.UTC().Format(time.RFC3339))
+	cond := &appv1.ApplicationCondition{Type: appv1.ApplicationConditionExpiringWarning, Message: message}
+	for _, existing := range app.Status.Conditions {
+		if existing.Type == cond.Type && existing.Message == cond.Message {
+			return cond
+		}
+	}
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonApplicationExpiring, Type: v1.EventTypeWarning}, message)
+	return cond
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus) *appv1.ApplicationCondition {
 	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
@@ -1663,6 +2076,9 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	if app.Spec.SyncPolicy.Retry != nil {
 		op.Retry = *app.Spec.SyncPolicy.Retry
 	}
+	if proj, err := ctrl.getAppProj(app); err == nil && proj.IsSyncApprovalRequired(app.Spec.Destination) {
+		op.Approval = &appv1.OperationApproval{}
+	}
 	// It is possible for manifests to remain OutOfSync even after a sync/kubectl apply (e.g.
 	// auto-sync with pruning disabled). We need to ensure that we do not keep Syncing an
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
@@ -1719,6 +2135,79 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil
 }
 
+// nextScheduledSync returns the time of the next sync scheduled by the sync policy of the application, or nil if the
+// application has no valid sync schedule. The schedule is evaluated from the start of the last sync operation, so
+// that a scheduled time missed while the controller was down or the sync was prevented is caught up.
+func nextScheduledSync(app *appv1.Application) *time.Time {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Schedule == nil {
+		return nil
+	}
+	reference := app.CreationTimestamp.Time
+	if app.Status.OperationState != nil && app.Status.OperationState.StartedAt.After(reference) {
+		reference = app.Status.OperationState.StartedAt.Time
+	}
+	next, err := app.Spec.SyncPolicy.Schedule.Next(reference, app.QualifiedName())
+	if err != nil {
+		return nil
+	}
+	return &next
+}
+
+// scheduledSync initiates a sync operation for an application with a sync schedule if a scheduled time has passed,
+// regardless of its sync status. Otherwise, it requests a refresh of the application at the next scheduled time.
+func (ctrl *ApplicationController) scheduledSync(app *appv1.Application, project *appv1.AppProject, syncStatus *appv1.SyncStatus) *appv1.ApplicationCondition {
+	due := nextScheduledSync(app)
+	if due == nil {
+		return nil
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
+
+	if project.Spec.DisableScheduledSyncs {
+		logCtx.Infof("Skipping scheduled sync: scheduled syncs are disabled for project '%s'", project.Name)
+		return nil
+	}
+	if untilDue := time.Until(*due); untilDue > 0 {
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &untilDue)
+		return nil
+	}
+	if app.Operation != nil {
+		logCtx.Infof("Skipping scheduled sync: another operation is in progress")
+		return nil
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		logCtx.Infof("Skipping scheduled sync: deletion in progress")
+		return nil
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:    syncStatus.Revision,
+			Revisions:   syncStatus.Revisions,
+			Prune:       app.Spec.SyncPolicy.Automated != nil && app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions: app.Spec.SyncPolicy.SyncOptions,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+	if project.IsSyncApprovalRequired(app.Spec.Destination) {
+		op.Approval = &appv1.OperationApproval{}
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		logCtx.Errorf("Failed to initiate scheduled sync to %s: %v", syncStatus.Revision, err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+	}
+	// the operation is now in progress, so that the automated sync is skipped
+	app.Operation = updatedApp.Operation
+	message := fmt.Sprintf("Initiated scheduled sync to '%s'", syncStatus.Revision)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message)
+	logCtx.Info(message)
+	return nil
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
 // commitSHA and with the same app source config which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string, commitSHAsMS []string, hasMultipleSources bool) (bool, synccommon.OperationPhase) {
@@ -1795,6 +2284,12 @@ func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
 	return true
 }
 
+// SetApplicationSelectors restricts the applications watched by the controller to the ones matching the given
+// selectors. It must be called before the controller runs.
+func (ctrl *ApplicationController) SetApplicationSelectors(selectors *argo.ApplicationSelectors) {
+	ctrl.appSelectors = selectors
+}
+
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
 	watchNamespace := ctrl.namespace
 	// If we have at least one additional namespace configured, we need to
@@ -1809,6 +2304,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (apiruntime.Object, error) {
+				ctrl.appSelectors.TweakListOptions(&options)
 				// We are only interested in apps that exist in namespaces the
 				// user wants to be enabled.
 				appList, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(watchNamespace).List(context.TODO(), options)
@@ -1825,6 +2321,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				return appList, nil
 			},
 			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				ctrl.appSelectors.TweakListOptions(&options)
 				return ctrl.applicationClientset.ArgoprojV1alpha1().Applications(watchNamespace).Watch(context.TODO(), options)
 			},
 		},
@@ -1874,7 +2371,8 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
-				if !ctrl.canProcessApp(obj) {
+				// the applications which exist when the controller starts are queued by priority once synced
+				if !ctrl.canProcessApp(obj) || !ctrl.shouldQueueAddedApp() {
 					return
 				}
 				key, err := cache.MetaNamespaceKeyFunc(obj)
@@ -1899,6 +2397,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 					log.WithField("application", newApp.QualifiedName()).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
 				}
+				if ctrl.dryRun && oldOK && newOK && activeControllerReconciled(oldApp, newApp) {
+					compareWith = CompareWithLatest.Pointer()
+				}
 				ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, nil)
 				ctrl.appOperationQueue.Add(key)
 			},
@@ -1912,6 +2413,13 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				if err == nil {
 					ctrl.appRefreshQueue.Add(key)
 				}
+				// the resources moved to the cache do not expire, delete them along with applications deleted
+				// without finalizer
+				if app, ok := obj.(*appv1.Application); ok && app.Status.ResourcesSource == appv1.ResourceStatusLocationCache {
+					if err := ctrl.cache.SetAppResourcesStatus(app.InstanceName(ctrl.namespace), nil); err != nil {
+						log.WithField("application", app.QualifiedName()).Warnf("Failed to delete the resources of the application from the cache: %v", err)
+					}
+				}
 			},
 		},
 	)
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -20,15 +21,18 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 
 	mockstatecache "github.com/argoproj/argo-cd/v2/controller/cache/mocks"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -37,6 +41,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	mockrepoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/settings"
@@ -48,12 +53,16 @@ type namespacedResource struct {
 }
 
 type fakeData struct {
-	apps                   []runtime.Object
-	manifestResponse       *apiclient.ManifestResponse
-	managedLiveObjs        map[kube.ResourceKey]*unstructured.Unstructured
-	namespacedResources    map[kube.ResourceKey]namespacedResource
-	configMapData          map[string]string
-	metricsCacheExpiration time.Duration
+	apps                     []runtime.Object
+	manifestResponse         *apiclient.ManifestResponse
+	managedLiveObjs          map[kube.ResourceKey]*unstructured.Unstructured
+	namespacedResources      map[kube.ResourceKey]namespacedResource
+	configMapData            map[string]string
+	metricsCacheExpiration   time.Duration
+	policyEvaluationResponse *apiclient.PolicyEvaluationResponse
+	updateRevisionForPaths   *apiclient.UpdateRevisionForPathsResponse
+	cappedResourceKinds      map[schema.GroupKind]int
+	serverVersion            string
 }
 
 func newFakeController(data *fakeData) *ApplicationController {
@@ -66,6 +75,8 @@ func newFakeController(data *fakeData) *ApplicationController {
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
 	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, nil)
+	mockRepoClient.On("EvaluatePolicies", mock.Anything, mock.Anything).Return(data.policyEvaluationResponse, nil)
+	mockRepoClient.On("UpdateRevisionForPaths", mock.Anything, mock.Anything).Return(data.updateRevisionForPaths, nil)
 	mockRepoClientset := mockrepoclient.Clientset{RepoServerServiceClient: &mockRepoClient}
 
 	secret := corev1.Secret{
@@ -105,6 +116,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 		time.Minute,
 		time.Hour,
 		time.Minute,
+		0,
 		common.DefaultPortArgoCDMetrics,
 		data.metricsCacheExpiration,
 		[]string{},
@@ -130,7 +142,11 @@ func newFakeController(data *fakeData) *ApplicationController {
 	ctrl.stateCache = &mockStateCache
 	mockStateCache.On("IsNamespaced", mock.Anything, mock.Anything).Return(true, nil)
 	mockStateCache.On("GetManagedLiveObjs", mock.Anything, mock.Anything).Return(data.managedLiveObjs, nil)
-	mockStateCache.On("GetVersionsInfo", mock.Anything).Return("v1.2.3", nil, nil)
+	serverVersion := "v1.2.3"
+	if data.serverVersion != "" {
+		serverVersion = data.serverVersion
+	}
+	mockStateCache.On("GetVersionsInfo", mock.Anything).Return(serverVersion, nil, nil)
 	response := make(map[kube.ResourceKey]argoappv1.ResourceNode)
 	for k, v := range data.namespacedResources {
 		response[k] = v.ResourceNode
@@ -138,6 +154,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 	mockStateCache.On("GetNamespaceTopLevelResources", mock.Anything, mock.Anything).Return(response, nil)
 	mockStateCache.On("IterateResources", mock.Anything, mock.Anything).Return(nil)
 	mockStateCache.On("GetClusterCache", mock.Anything).Return(&clusterCacheMock, nil)
+	mockStateCache.On("GetCappedResourceKinds", mock.Anything).Return(data.cappedResourceKinds)
 	mockStateCache.On("IterateHierarchy", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
 		key := args[1].(kube.ResourceKey)
 		action := args[2].(func(child argoappv1.ResourceNode, appName string) bool)
@@ -305,6 +322,229 @@ func TestAutoSync(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncApprovalRequired(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:              []string{"*"},
+			Destinations:             []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncApprovalDestinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond := ctrl.autoSync(app, &syncStatus, []argoappv1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync}})
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.True(t, app.Operation.IsPendingApproval())
+}
+
+func TestRequireOperationApproval(t *testing.T) {
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:              []string{"*"},
+			Destinations:             []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncApprovalDestinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	approvedAt := metav1.Now()
+	tests := []struct {
+		name     string
+		approval *argoappv1.OperationApproval
+		reset    bool
+	}{
+		{name: "NotApproved", approval: nil, reset: true},
+		{name: "SelfApproved", approval: &argoappv1.OperationApproval{ApprovedBy: "alice", ApprovedAt: &approvedAt}, reset: true},
+		{name: "PendingApproval", approval: &argoappv1.OperationApproval{}, reset: false},
+		{name: "Approved", approval: &argoappv1.OperationApproval{ApprovedBy: "bob", ApprovedAt: &approvedAt}, reset: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeApp()
+			app.Operation = &argoappv1.Operation{
+				Sync:        &argoappv1.SyncOperation{Revision: "abc123"},
+				InitiatedBy: argoappv1.OperationInitiator{Username: "alice"},
+				Approval:    tt.approval,
+			}
+			ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+
+			assert.Equal(t, tt.reset, ctrl.requireOperationApproval(app))
+			app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.NotNil(t, app.Operation)
+			if tt.reset {
+				assert.True(t, app.Operation.IsPendingApproval())
+			} else {
+				assert.Equal(t, tt.approval, app.Operation.Approval)
+			}
+		})
+	}
+
+	t.Run("ApprovalNotRequired", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "abc123"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+		assert.False(t, ctrl.requireOperationApproval(app))
+	})
+}
+
+func TestAutomationPausedCondition(t *testing.T) {
+	app := newFakeApp()
+	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	assert.Nil(t, ctrl.automationPausedCondition(app, proj))
+
+	t.Run("Global", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}, configMapData: map[string]string{
+			"automation.paused":        "true",
+			"automation.paused.reason": "cluster upgrade",
+		}})
+		cond := ctrl.automationPausedCondition(app, proj)
+		require.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionAutomationPausedWarning, cond.Type)
+		assert.Equal(t, "Automated sync is paused globally: cluster upgrade", cond.Message)
+	})
+
+	t.Run("Project", func(t *testing.T) {
+		proj := proj.DeepCopy()
+		proj.Annotations = map[string]string{"argocd.argoproj.io/automation-paused": "true"}
+		cond := ctrl.automationPausedCondition(app, proj)
+		require.NotNil(t, cond)
+		assert.Equal(t, "Automated sync is paused for project 'default'", cond.Message)
+	})
+
+	t.Run("NotAutomated", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Spec.SyncPolicy = nil
+		proj := proj.DeepCopy()
+		proj.Annotations = map[string]string{"argocd.argoproj.io/automation-paused": "true"}
+		assert.Nil(t, ctrl.automationPausedCondition(app, proj))
+	})
+}
+
+func TestDeleteExpiredApp(t *testing.T) {
+	t.Run("Expired", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		app.Spec.TTL = &argoappv1.ApplicationTTL{Duration: "1h"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		var patched, deleted bool
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, nil, nil
+		})
+		fakeAppCs.PrependReactor("delete", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			deleted = true
+			return true, nil, nil
+		})
+		assert.True(t, ctrl.deleteExpiredApp(app))
+		assert.True(t, patched)
+		assert.True(t, deleted)
+		assert.True(t, app.IsFinalizerPresent(argoappv1.ResourcesFinalizerName))
+	})
+
+	t.Run("NotExpired", func(t *testing.T) {
+		app := newFakeApp()
+		app.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		app.Spec.TTL = &argoappv1.ApplicationTTL{Duration: "1h"}
+		app.Annotations = map[string]string{argoappv1.AnnotationKeyExpiresAt: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		assert.False(t, ctrl.deleteExpiredApp(app))
+		_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestExpiringCondition(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	assert.Nil(t, ctrl.expiringCondition(app))
+
+	app.CreationTimestamp = metav1.NewTime(time.Now().Add(-90 * time.Minute))
+	app.Spec.TTL = &argoappv1.ApplicationTTL{Duration: "2h"}
+	cond := ctrl.expiringCondition(app)
+	require.NotNil(t, cond)
+	assert.Equal(t, argoappv1.ApplicationConditionExpiringWarning, cond.Type)
+
+	app.Spec.TTL.WarningPeriod = "10m"
+	assert.Nil(t, ctrl.expiringCondition(app))
+}
+
+func TestScheduledSync(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeSynced,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	newScheduledApp := func(startedAt time.Time) *argoappv1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedule = &argoappv1.SyncSchedule{Cron: "0 * * * *"}
+		app.Status.OperationState.StartedAt = metav1.NewTime(startedAt)
+		return app
+	}
+
+	t.Run("Due", func(t *testing.T) {
+		app := newScheduledApp(time.Now().Add(-2 * time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		require.NotNil(t, app.Operation.Sync)
+		assert.Equal(t, syncStatus.Revision, app.Operation.Sync.Revision)
+		assert.True(t, app.Operation.InitiatedBy.Automated)
+	})
+
+	t.Run("NotDue", func(t *testing.T) {
+		app := newScheduledApp(time.Now())
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("DisabledForProject", func(t *testing.T) {
+		app := newScheduledApp(time.Now().Add(-2 * time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		cond := ctrl.scheduledSync(app, &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{DisableScheduledSyncs: true}}, &syncStatus)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("NeedRefresh", func(t *testing.T) {
+		app := newScheduledApp(time.Now().Add(-2 * time.Hour))
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		now := metav1.Now()
+		app.Status.ReconciledAt = &now
+		app.Status.Sync.ComparedTo = argoappv1.ComparedTo{Source: app.Spec.GetSource(), Destination: app.Spec.Destination}
+
+		// the application is not refreshed again if it has been reconciled since the scheduled time
+		needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
+		assert.False(t, needRefresh)
+
+		reconciledAt := metav1.NewTime(time.Now().Add(-150 * time.Minute))
+		app.Status.ReconciledAt = &reconciledAt
+		needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 3*time.Hour, 4*time.Hour)
+		assert.True(t, needRefresh)
+		assert.Equal(t, argoappv1.RefreshTypeHard, refreshType)
+		assert.Equal(t, CompareWithLatestForceResolve, compareWith)
+	})
+}
+
 func TestAutoSyncNotAllowEmpty(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy.Automated.Prune = true
@@ -1006,6 +1246,45 @@ func TestRefreshAppConditions(t *testing.T) {
 		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
 		assert.Equal(t, "Application referencing project wrong project which does not exist", app.Status.Conditions[0].Message)
 	})
+
+	t.Run("RendersDestinationNamespace", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination.NamespaceTemplate = "team-{{.app.name}}"
+
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+
+		_, hasErrors := ctrl.refreshAppConditions(app)
+		assert.False(t, hasErrors)
+		assert.Equal(t, "team-my-app", app.Spec.Destination.Namespace)
+	})
+
+	t.Run("DestinationNamespaceNotPermitted", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination.NamespaceTemplate = "team-{{.app.name}}"
+		proj := defaultProj.DeepCopy()
+		proj.Spec.Destinations = []argoappv1.ApplicationDestination{{Server: "*", Namespace: "apps-*"}}
+
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+
+		_, hasErrors := ctrl.refreshAppConditions(app)
+		assert.True(t, hasErrors)
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, "team-my-app")
+	})
+
+	t.Run("InvalidDestinationNamespaceTemplate", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination.NamespaceTemplate = "team-{{.app.owner}}"
+
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+
+		_, hasErrors := ctrl.refreshAppConditions(app)
+		assert.True(t, hasErrors)
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, "failed to render namespace template")
+	})
 }
 
 func TestUpdateReconciledAt(t *testing.T) {
@@ -1401,3 +1680,74 @@ func Test_canProcessApp(t *testing.T) {
 		assert.False(t, canProcess)
 	})
 }
+
+func TestSetApplicationSelectors(t *testing.T) {
+	teamA := newFakeApp()
+	teamA.Name = "team-a"
+	teamA.Labels = map[string]string{"team": "a"}
+	teamB := newFakeApp()
+	teamB.Name = "team-b"
+	teamB.Labels = map[string]string{"team": "b"}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{teamA, teamB}})
+	ctrl.SetApplicationSelectors(&argo.ApplicationSelectors{LabelSelector: "team=a"})
+
+	informer, lister := ctrl.newApplicationInformerAndLister()
+	cancel := test.StartInformer(informer)
+	defer cancel()
+	apps, err := lister.List(labels.Everything())
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "team-a", apps[0].Name)
+}
+
+// recordingQueue records the keys added with a delay to a queue
+type recordingQueue struct {
+	workqueue.RateLimitingInterface
+	added map[string]time.Duration
+}
+
+func (q *recordingQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.added[item.(string)] = duration
+}
+
+func TestComparisonSettingsKey(t *testing.T) {
+	overrides := map[string]argoappv1.ResourceOverride{"apps/Deployment": {HealthLua: "health"}}
+	key, err := comparisonSettingsKey(overrides, nil, "app.kubernetes.io/instance", "label", settings.ArgoCDDiffOptions{})
+	require.NoError(t, err)
+
+	withActions, err := comparisonSettingsKey(map[string]argoappv1.ResourceOverride{"apps/Deployment": {HealthLua: "health", Actions: "actions"}}, nil, "app.kubernetes.io/instance", "label", settings.ArgoCDDiffOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, key, withActions)
+
+	withIgnoredDifferences, err := comparisonSettingsKey(map[string]argoappv1.ResourceOverride{"apps/Deployment": {HealthLua: "health", IgnoreDifferences: argoappv1.OverrideIgnoreDiff{JSONPointers: []string{"/spec/replicas"}}}}, nil, "app.kubernetes.io/instance", "label", settings.ArgoCDDiffOptions{})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, withIgnoredDifferences)
+
+	withCompareOptions, err := comparisonSettingsKey(overrides, nil, "app.kubernetes.io/instance", "label", settings.ArgoCDDiffOptions{IgnoreResourceStatusField: settings.IgnoreResourceStatusInAll})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, withCompareOptions)
+}
+
+func TestHandleSettingsChanged(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	queue := &recordingQueue{RateLimitingInterface: ctrl.appComparisonTypeRefreshQueue, added: map[string]time.Duration{}}
+	ctrl.appComparisonTypeRefreshQueue = queue
+	_, err := ctrl.updateComparisonSettings()
+	require.NoError(t, err)
+
+	t.Run("Unchanged", func(t *testing.T) {
+		ctrl.handleSettingsChanged()
+		assert.Empty(t, queue.added)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		ctrl.comparisonSettingsKey = "outdated"
+		ctrl.handleSettingsChanged()
+		require.Len(t, queue.added, 1)
+		for key, after := range queue.added {
+			assert.Equal(t, fmt.Sprintf("%s/%d", ctrl.toAppKey(app.QualifiedName()), CompareWithRecent), key)
+			assert.Less(t, after, settingsChangedRefreshSpread)
+		}
+	})
+}
@@ -60,6 +60,9 @@ const (
 
 	// EnvClusterCacheRetryUseBackoff is the env variable to control whether to use a backoff strategy with the retry during cluster cache sync
 	EnvClusterCacheRetryUseBackoff = "ARGOCD_CLUSTER_CACHE_RETRY_USE_BACKOFF"
+
+	// EnvClusterCacheMaxResourcesPerKind is the env variable to control the maximum number of resources of a group kind cached per cluster
+	EnvClusterCacheMaxResourcesPerKind = "ARGOCD_CLUSTER_CACHE_MAX_RESOURCES_PER_KIND"
 )
 
 // GitOps engine cluster cache tuning options
@@ -88,6 +91,10 @@ var (
 
 	// clusterCacheRetryUseBackoff specifies whether to use a backoff strategy on cluster cache sync, if retry is enabled
 	clusterCacheRetryUseBackoff bool = false
+
+	// clusterCacheMaxResourcesPerKind caps the number of resources of a group kind cached per cluster. The group kinds
+	// exceeding the cap stop being cached. If set to 0, the number of cached resources is not limited.
+	clusterCacheMaxResourcesPerKind int64 = 0
 )
 
 func init() {
@@ -98,6 +105,7 @@ func init() {
 	clusterCacheListSemaphoreSize = env.ParseInt64FromEnv(EnvClusterCacheListSemaphore, clusterCacheListSemaphoreSize, 0, math.MaxInt64)
 	clusterCacheAttemptLimit = int32(env.ParseInt64FromEnv(EnvClusterCacheAttemptLimit, 1, 1, math.MaxInt32))
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
+	clusterCacheMaxResourcesPerKind = env.ParseInt64FromEnv(EnvClusterCacheMaxResourcesPerKind, clusterCacheMaxResourcesPerKind, 0, math.MaxInt32)
 }
 
 type LiveStateCache interface {
@@ -119,12 +127,18 @@ type LiveStateCache interface {
 	Run(ctx context.Context) error
 	// Returns information about monitored clusters
 	GetClustersInfo() []clustercache.ClusterInfo
+	// Returns the group kinds of the given cluster which exceeded the cache limit, with the number of resources observed
+	// when the limit was exceeded
+	GetCappedResourceKinds(server string) map[schema.GroupKind]int
 	// Init must be executed before cache can be used
 	Init() error
 }
 
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref v1.ObjectReference)
 
+// SettingsChangedHandler is called once updated settings have been applied to the cache
+type SettingsChangedHandler = func()
+
 type PodInfo struct {
 	NodeName         string
 	ResourceRequests v1.ResourceList
@@ -142,6 +156,8 @@ type ResourceInfo struct {
 	AppName string
 	Images  []string
 	Health  *health.HealthStatus
+	// Labels are the labels of the resource, which the health scripts can look up related resources by
+	Labels map[string]string
 	// NetworkingInfo are available only for known types involved into networking: Ingress, Service, Pod
 	NetworkingInfo *appv1.ResourceNetworkingInfo
 	// PodInfo is available for pods only
@@ -157,41 +173,51 @@ func NewLiveStateCache(
 	kubectl kube.Kubectl,
 	metricsServer *metrics.MetricsServer,
 	onObjectUpdated ObjectUpdatedHandler,
+	onSettingsChanged SettingsChangedHandler,
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	resourceTracking argo.ResourceTracking) LiveStateCache {
 
 	return &liveStateCache{
-		appInformer:      appInformer,
-		db:               db,
-		clusters:         make(map[string]clustercache.ClusterCache),
-		onObjectUpdated:  onObjectUpdated,
-		kubectl:          kubectl,
-		settingsMgr:      settingsMgr,
-		metricsServer:    metricsServer,
-		clusterFilter:    clusterFilter,
-		resourceTracking: resourceTracking,
+		appInformer:       appInformer,
+		db:                db,
+		clusters:          make(map[string]clustercache.ClusterCache),
+		limiters:          make(map[string]*resourceKindLimiter),
+		onObjectUpdated:   onObjectUpdated,
+		onSettingsChanged: onSettingsChanged,
+		kubectl:           kubectl,
+		settingsMgr:       settingsMgr,
+		metricsServer:     metricsServer,
+		clusterFilter:     clusterFilter,
+		resourceTracking:  resourceTracking,
 	}
 }
 
 type cacheSettings struct {
-	clusterSettings     clustercache.Settings
-	appInstanceLabelKey string
-	trackingMethod      appv1.TrackingMethod
+	clusterSettings      clustercache.Settings
+	resourcesFilter      *settings.ResourcesFilter
+	appInstanceLabelKey  string
+	trackingMethod       appv1.TrackingMethod
+	resourceCustomLabels []string
 }
 
 type liveStateCache struct {
-	db               db.ArgoDB
-	appInformer      cache.SharedIndexInformer
-	onObjectUpdated  ObjectUpdatedHandler
-	kubectl          kube.Kubectl
-	settingsMgr      *settings.SettingsManager
-	metricsServer    *metrics.MetricsServer
-	clusterFilter    func(cluster *appv1.Cluster) bool
-	resourceTracking argo.ResourceTracking
-
-	clusters      map[string]clustercache.ClusterCache
+	db                db.ArgoDB
+	appInformer       cache.SharedIndexInformer
+	onObjectUpdated   ObjectUpdatedHandler
+	onSettingsChanged SettingsChangedHandler
+	kubectl           kube.Kubectl
+	settingsMgr       *settings.SettingsManager
+	metricsServer     *metrics.MetricsServer
+	clusterFilter     func(cluster *appv1.Cluster) bool
+	resourceTracking  argo.ResourceTracking
+
+	clusters map[string]clustercache.ClusterCache
+	// limiters holds the limiter of the number of cached resources of each cluster
+	limiters      map[string]*resourceKindLimiter
 	cacheSettings cacheSettings
-	lock          sync.RWMutex
+	// settingsGeneration is incremented every time updated settings are applied to the cache
+	settingsGeneration int64
+	lock               sync.RWMutex
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -207,11 +233,27 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
+	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
+	if err != nil {
+		return nil, err
+	}
 	clusterSettings := clustercache.Settings{
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
-		ResourcesFilter:        resourcesFilter,
 	}
-	return &cacheSettings{clusterSettings, appInstanceLabelKey, argo.GetTrackingMethod(c.settingsMgr)}, nil
+	return &cacheSettings{clusterSettings, resourcesFilter, appInstanceLabelKey, argo.GetTrackingMethod(c.settingsMgr), resourceCustomLabels}, nil
+}
+
+// forCluster returns the settings of the cache of the given cluster, whose resources filter is scoped to the cluster
+// and excludes the group kinds capped by the given limiter
+func (s cacheSettings) forCluster(cluster *appv1.Cluster, limiter *resourceKindLimiter) clustercache.Settings {
+	clusterSettings := s.clusterSettings
+	if s.resourcesFilter != nil {
+		clusterSettings.ResourcesFilter = s.resourcesFilter.ForCluster(cluster)
+	}
+	if limiter != nil {
+		clusterSettings.ResourcesFilter = &limitedResourcesFilter{filter: clusterSettings.ResourcesFilter, limiter: limiter}
+	}
+	return clusterSettings
 }
 
 func asResourceNode(r *clustercache.Resource) appv1.ResourceNode {
@@ -389,9 +431,9 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
 	}
 
-	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
-	if err != nil {
-		return nil, fmt.Errorf("error getting custom label: %w", err)
+	var limiter *resourceKindLimiter
+	if clusterCacheMaxResourcesPerKind > 0 {
+		limiter = newResourceKindLimiter(int(clusterCacheMaxResourcesPerKind))
 	}
 
 	clusterCacheOpts := []clustercache.UpdateSettingsFunc{
@@ -400,15 +442,15 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		clustercache.SetWatchResyncTimeout(clusterCacheWatchResyncDuration),
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
-		clustercache.SetSettings(cacheSettings.clusterSettings),
+		clustercache.SetSettings(cacheSettings.forCluster(cluster, limiter)),
 		clustercache.SetNamespaces(cluster.Namespaces),
 		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
 			res := &ResourceInfo{}
-			populateNodeInfo(un, res, resourceCustomLabels)
 			c.lock.RLock()
 			cacheSettings := c.cacheSettings
 			c.lock.RUnlock()
+			populateNodeInfo(un, res, cacheSettings.resourceCustomLabels)
 			res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
 
 			appName := c.resourceTracking.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod)
@@ -428,6 +470,18 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 	clusterCache = clustercache.NewClusterCache(cluster.RESTConfig(), clusterCacheOpts...)
 
 	_ = clusterCache.OnResourceUpdated(func(newRes *clustercache.Resource, oldRes *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) {
+		if limiter != nil {
+			if gk, count, capped := limiter.observe(newRes, oldRes); capped {
+				log.Warnf("Cluster %s has more than %d resources of kind %s, which stop being cached", cluster.Server, limiter.maxPerKind, gk.String())
+				c.metricsServer.SetCappedResourcesCount(cluster.Server, gk.Group, gk.Kind, count)
+				// resource update handlers are called while the cluster cache is locked so the cache must be invalidated asynchronously
+				go func() {
+					limiter.reset(false)
+					clusterCache.Invalidate()
+					_ = clusterCache.EnsureSynced()
+				}()
+			}
+		}
 		toNotify := make(map[string]bool)
 		var ref v1.ObjectReference
 		if newRes != nil {
@@ -454,6 +508,9 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 	})
 
 	c.clusters[server] = clusterCache
+	if limiter != nil {
+		c.limiters[server] = limiter
+	}
 
 	return clusterCache, nil
 }
@@ -476,8 +533,17 @@ func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	defer c.lock.Unlock()
 
 	c.cacheSettings = cacheSettings
-	for _, clust := range c.clusters {
-		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
+	for server, clust := range c.clusters {
+		cluster, err := c.db.GetCluster(context.Background(), server)
+		if err != nil {
+			log.Warnf("Failed to get cluster %s, resource filters scoped to cluster names, labels or projects are ignored: %v", server, err)
+			cluster = &appv1.Cluster{Server: server}
+		}
+		limiter := c.limiters[server]
+		if limiter != nil {
+			limiter.reset(false)
+		}
+		clust.Invalidate(clustercache.SetSettings(cacheSettings.forCluster(cluster, limiter)))
 	}
 	log.Info("live state cache invalidated")
 }
@@ -580,12 +646,21 @@ func (c *liveStateCache) watchSettings(ctx context.Context) {
 			c.lock.Lock()
 			needInvalidate := false
 			if !reflect.DeepEqual(c.cacheSettings, *nextCacheSettings) {
+				// swap all the settings at once, so that health scripts, ignored differences and resource filters
+				// are never mixed from different versions of the settings
 				c.cacheSettings = *nextCacheSettings
+				c.settingsGeneration++
 				needInvalidate = true
 			}
+			generation := c.settingsGeneration
 			c.lock.Unlock()
 			if needInvalidate {
+				log.Infof("Settings updated, applying settings generation %d", generation)
+				c.metricsServer.SetSettingsGeneration(generation)
 				c.invalidate(*nextCacheSettings)
+				if c.onSettingsChanged != nil {
+					c.onSettingsChanged()
+				}
 			}
 		case <-ctx.Done():
 			done = true
@@ -602,6 +677,8 @@ func (c *liveStateCache) Init() error {
 		return fmt.Errorf("error loading cache settings: %w", err)
 	}
 	c.cacheSettings = *cacheSettings
+	c.settingsGeneration = 1
+	c.metricsServer.SetSettingsGeneration(c.settingsGeneration)
 	return nil
 }
 
@@ -653,10 +730,15 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
+			c.deleteLimiter(newCluster.Server)
 			c.lock.Unlock()
 			return
 		}
 
+		c.lock.RLock()
+		limiter := c.limiters[newCluster.Server]
+		c.lock.RUnlock()
+
 		var updateSettings []clustercache.UpdateSettingsFunc
 		if !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
 			updateSettings = append(updateSettings, clustercache.SetConfig(newCluster.RESTConfig()))
@@ -667,6 +749,12 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
 			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
 		}
+		if oldCluster.Name != newCluster.Name || oldCluster.Project != newCluster.Project || !reflect.DeepEqual(oldCluster.Labels, newCluster.Labels) {
+			c.lock.RLock()
+			cacheSettings := c.cacheSettings
+			c.lock.RUnlock()
+			updateSettings = append(updateSettings, clustercache.SetSettings(cacheSettings.forCluster(newCluster, limiter)))
+		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
 			cluster.GetClusterInfo().LastCacheSyncTime != nil &&
@@ -675,6 +763,13 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		}
 
 		if len(updateSettings) > 0 || forceInvalidate {
+			if limiter != nil {
+				// a requested refresh gives another chance to the group kinds which exceeded the cache limit
+				limiter.reset(forceInvalidate)
+				if forceInvalidate {
+					c.metricsServer.ResetCappedResourcesCount(newCluster.Server)
+				}
+			}
 			cluster.Invalidate(updateSettings...)
 			go func() {
 				// warm up cluster cache
@@ -692,7 +787,26 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 	if ok {
 		cluster.Invalidate()
 		delete(c.clusters, clusterServer)
+		c.deleteLimiter(clusterServer)
+	}
+}
+
+// deleteLimiter forgets the limiter of the given cluster. It must be called with the cache lock held.
+func (c *liveStateCache) deleteLimiter(server string) {
+	if _, ok := c.limiters[server]; ok {
+		delete(c.limiters, server)
+		c.metricsServer.ResetCappedResourcesCount(server)
+	}
+}
+
+func (c *liveStateCache) GetCappedResourceKinds(server string) map[schema.GroupKind]int {
+	c.lock.RLock()
+	limiter := c.limiters[server]
+	c.lock.RUnlock()
+	if limiter == nil {
+		return nil
 	}
+	return limiter.cappedKinds()
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
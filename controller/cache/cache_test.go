@@ -1,22 +1,33 @@
 package cache
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/controller/metrics"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	"github.com/argoproj/argo-cd/v2/util/lua"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 type netError string
@@ -202,3 +213,92 @@ func Test_asResourceNode_owner_refs(t *testing.T) {
 	}
 	assert.Equal(t, expected, resNode)
 }
+
+func TestWatchSettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            common.ArgoCDConfigMapName,
+			Namespace:       "argocd",
+			Labels:          map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			ResourceVersion: "1",
+		},
+		Data: map[string]string{},
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"server.secretkey": []byte("test")},
+	}
+	kubeClient := fake.NewSimpleClientset(cm, secret)
+	metricsServer, err := metrics.NewMetricsServer("localhost:8082", nil, func(obj interface{}) bool { return true }, func(r *http.Request) error { return nil }, nil)
+	require.NoError(t, err)
+
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return()
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("GetCluster", mock.Anything, "https://mycluster").Return(&appv1.Cluster{Server: "https://mycluster", Name: "mycluster"}, nil)
+	settingsChanged := make(chan bool, 1)
+	clustersCache := liveStateCache{
+		db:                argoDB,
+		clusters:          map[string]cache.ClusterCache{"https://mycluster": clusterCache},
+		settingsMgr:       settings.NewSettingsManager(ctx, kubeClient, "argocd"),
+		metricsServer:     metricsServer,
+		onSettingsChanged: func() { settingsChanged <- true },
+	}
+	require.NoError(t, clustersCache.Init())
+	assert.Equal(t, int64(1), clustersCache.settingsGeneration)
+	go clustersCache.watchSettings(ctx)
+
+	cm = cm.DeepCopy()
+	cm.Data["resource.customLabels"] = "team"
+	cm.Data["resource.customizations.health.apps_Deployment"] = "return {}"
+	// the update is retried since the informer watch might not be established yet
+	handled := false
+	for i := 2; !handled && i < 100; i++ {
+		cm.ResourceVersion = fmt.Sprintf("%d", i)
+		_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(ctx, cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		select {
+		case <-settingsChanged:
+			handled = true
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	require.True(t, handled, "settings change was not handled")
+	clustersCache.lock.RLock()
+	defer clustersCache.lock.RUnlock()
+	assert.Equal(t, int64(2), clustersCache.settingsGeneration)
+	assert.Equal(t, []string{"team"}, clustersCache.cacheSettings.resourceCustomLabels)
+	assert.Equal(t, "return {}", clustersCache.cacheSettings.clusterSettings.ResourceHealthOverride.(lua.ResourceHealthOverrides)["apps/Deployment"].HealthLua)
+	clusterCache.AssertCalled(t, "Invalidate", mock.Anything)
+	argoDB.AssertCalled(t, "GetCluster", mock.Anything, "https://mycluster")
+}
+
+func TestHandleModEvent_ClusterScopeChanged(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return().Once()
+	clusterCache.On("EnsureSynced").Return(nil).Once()
+
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+		cacheSettings: cacheSettings{resourcesFilter: &settings.ResourcesFilter{}},
+	}
+
+	clustersCache.handleModEvent(&appv1.Cluster{
+		Server: "https://mycluster",
+		Labels: map[string]string{"env": "dev"},
+	}, &appv1.Cluster{
+		Server: "https://mycluster",
+		Labels: map[string]string{"env": "prod"},
+	})
+
+	clusterCache.AssertCalled(t, "Invalidate", mock.Anything)
+}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"sync"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// resourceKindLimiter caps the number of resources of each group kind cached for a cluster. Once the resources of a
+// group kind exceed the cap, the group kind is capped: its resources are excluded from the cache and only the number
+// of resources observed when the cap was exceeded is kept.
+type resourceKindLimiter struct {
+	maxPerKind int
+
+	lock sync.Mutex
+	// keys holds the keys of the cached resources of each group kind which is not capped
+	keys map[schema.GroupKind]map[kube.ResourceKey]bool
+	// capped holds the number of resources observed when each capped group kind exceeded the cap
+	capped map[schema.GroupKind]int
+}
+
+func newResourceKindLimiter(maxPerKind int) *resourceKindLimiter {
+	return &resourceKindLimiter{
+		maxPerKind: maxPerKind,
+		keys:       make(map[schema.GroupKind]map[kube.ResourceKey]bool),
+		capped:     make(map[schema.GroupKind]int),
+	}
+}
+
+// observe tracks the given resource update and returns the group kind and the number of its resources if the update
+// made the group kind exceed the cap
+func (l *resourceKindLimiter) observe(newRes *clustercache.Resource, oldRes *clustercache.Resource) (schema.GroupKind, int, bool) {
+	if l.maxPerKind <= 0 {
+		return schema.GroupKind{}, 0, false
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if newRes == nil {
+		key := oldRes.ResourceKey()
+		delete(l.keys[key.GroupKind()], key)
+		return schema.GroupKind{}, 0, false
+	}
+	key := newRes.ResourceKey()
+	gk := key.GroupKind()
+	if _, ok := l.capped[gk]; ok {
+		return schema.GroupKind{}, 0, false
+	}
+	keys, ok := l.keys[gk]
+	if !ok {
+		keys = make(map[kube.ResourceKey]bool)
+		l.keys[gk] = keys
+	}
+	keys[key] = true
+	if len(keys) <= l.maxPerKind {
+		return schema.GroupKind{}, 0, false
+	}
+	count := len(keys)
+	l.capped[gk] = count
+	delete(l.keys, gk)
+	return gk, count, true
+}
+
+// isCapped returns whether the resources of the given group kind exceeded the cap
+func (l *resourceKindLimiter) isCapped(gk schema.GroupKind) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	_, ok := l.capped[gk]
+	return ok
+}
+
+// cappedKinds returns the number of resources observed when each capped group kind exceeded the cap
+func (l *resourceKindLimiter) cappedKinds() map[schema.GroupKind]int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	res := make(map[schema.GroupKind]int, len(l.capped))
+	for gk, count := range l.capped {
+		res[gk] = count
+	}
+	return res
+}
+
+// reset forgets the tracked resources, which must be done every time the cluster cache is invalidated. Capped group
+// kinds are uncapped as well if uncap is true.
+func (l *resourceKindLimiter) reset(uncap bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.keys = make(map[schema.GroupKind]map[kube.ResourceKey]bool)
+	if uncap {
+		l.capped = make(map[schema.GroupKind]int)
+	}
+}
+
+// limitedResourcesFilter excludes the capped group kinds in addition to the resources excluded by the wrapped filter
+type limitedResourcesFilter struct {
+	filter  kube.ResourceFilter
+	limiter *resourceKindLimiter
+}
+
+func (f *limitedResourcesFilter) IsExcludedResource(group, kind, cluster string) bool {
+	if f.limiter.isCapped(schema.GroupKind{Group: group, Kind: kind}) {
+		return true
+	}
+	return f.filter != nil && f.filter.IsExcludedResource(group, kind, cluster)
+}
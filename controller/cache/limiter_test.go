@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"testing"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newLimiterTestResource(kind, name string) *clustercache.Resource {
+	return &clustercache.Resource{Ref: v1.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: "default", Name: name}}
+}
+
+func TestResourceKindLimiter(t *testing.T) {
+	podGK := schema.GroupKind{Kind: "Pod"}
+	limiter := newResourceKindLimiter(2)
+	filter := &limitedResourcesFilter{limiter: limiter}
+
+	pod1 := newLimiterTestResource("Pod", "pod-1")
+	pod2 := newLimiterTestResource("Pod", "pod-2")
+	pod3 := newLimiterTestResource("Pod", "pod-3")
+
+	_, _, capped := limiter.observe(pod1, nil)
+	assert.False(t, capped)
+	_, _, capped = limiter.observe(pod2, nil)
+	assert.False(t, capped)
+	// updates of known resources are not counted twice
+	_, _, capped = limiter.observe(pod2, pod2)
+	assert.False(t, capped)
+	// removals free a slot
+	_, _, capped = limiter.observe(nil, pod1)
+	assert.False(t, capped)
+	_, _, capped = limiter.observe(pod3, nil)
+	assert.False(t, capped)
+	assert.False(t, filter.IsExcludedResource("", "Pod", ""))
+
+	gk, count, capped := limiter.observe(pod1, nil)
+	assert.True(t, capped)
+	assert.Equal(t, podGK, gk)
+	assert.Equal(t, 3, count)
+	assert.True(t, filter.IsExcludedResource("", "Pod", ""))
+	assert.False(t, filter.IsExcludedResource("", "Service", ""))
+	assert.Equal(t, map[schema.GroupKind]int{podGK: 3}, limiter.cappedKinds())
+
+	// capped kinds are reported only once
+	_, _, capped = limiter.observe(newLimiterTestResource("Pod", "pod-4"), nil)
+	assert.False(t, capped)
+
+	limiter.reset(false)
+	assert.True(t, filter.IsExcludedResource("", "Pod", ""))
+	limiter.reset(true)
+	assert.False(t, filter.IsExcludedResource("", "Pod", ""))
+	assert.Empty(t, limiter.cappedKinds())
+}
+
+func TestResourceKindLimiter_Disabled(t *testing.T) {
+	limiter := newResourceKindLimiter(0)
+	for _, name := range []string{"pod-1", "pod-2", "pod-3"} {
+		_, _, capped := limiter.observe(newLimiterTestResource("Pod", name), nil)
+		assert.False(t, capped)
+	}
+	assert.Empty(t, limiter.cappedKinds())
+}
+
+func TestLimitedResourcesFilter_WrapsFilter(t *testing.T) {
+	filter := &limitedResourcesFilter{filter: excludeKindFilter("Secret"), limiter: newResourceKindLimiter(1)}
+	assert.True(t, filter.IsExcludedResource("", "Secret", ""))
+	assert.False(t, filter.IsExcludedResource("", "Pod", ""))
+}
+
+type excludeKindFilter string
+
+func (f excludeKindFilter) IsExcludedResource(_, kind, _ string) bool {
+	return kind == string(f)
+}
+
+var _ kube.ResourceFilter = excludeKindFilter("")
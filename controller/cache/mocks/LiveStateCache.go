@@ -25,6 +25,22 @@ type LiveStateCache struct {
 	mock.Mock
 }
 
+// GetCappedResourceKinds provides a mock function with given fields: server
+func (_m *LiveStateCache) GetCappedResourceKinds(server string) map[schema.GroupKind]int {
+	ret := _m.Called(server)
+
+	var r0 map[schema.GroupKind]int
+	if rf, ok := ret.Get(0).(func(string) map[schema.GroupKind]int); ok {
+		r0 = rf(server)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[schema.GroupKind]int)
+		}
+	}
+
+	return r0
+}
+
 // GetClusterCache provides a mock function with given fields: server
 func (_m *LiveStateCache) GetClusterCache(server string) (cache.ClusterCache, error) {
 	ret := _m.Called(server)
@@ -137,5 +137,15 @@ func (c *clusterInfoUpdater) updateClusterInfo(cluster appv1.Cluster, info *cach
 		}
 	}
 
+	if info != nil && info.LastCacheSyncTime != nil {
+		discoveryTime := metav1.NewTime(*info.LastCacheSyncTime)
+		if err := c.cache.SetClusterAPIResources(cluster.Server, &appv1.ClusterAPIResourceList{
+			Items:             argo.APIResourcesToClusterAPIResources(info.APIResources),
+			LastDiscoveryTime: &discoveryTime,
+		}); err != nil {
+			return fmt.Errorf("error saving the API resources of the cluster: %w", err)
+		}
+	}
+
 	return c.cache.SetClusterInfo(cluster.Server, &clusterInfo)
 }
@@ -8,6 +8,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v2/common"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
@@ -83,6 +85,11 @@ func TestClusterSecretUpdater(t *testing.T) {
 			K8SVersion:        updatedK8sVersion,
 			LastCacheSyncTime: test.LastCacheSyncTime,
 			SyncError:         test.SyncError,
+			APIResources: []kube.APIResourceInfo{{
+				GroupKind:            schema.GroupKind{Group: "apps", Kind: "Deployment"},
+				GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"},
+				Meta:                 metav1.APIResource{Namespaced: true},
+			}},
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
@@ -96,5 +103,14 @@ func TestClusterSecretUpdater(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, updatedK8sVersion, clusterInfo.ServerVersion)
 		assert.Equal(t, test.ExpectedStatus, clusterInfo.ConnectionState.Status)
+
+		var apiResources v1alpha1.ClusterAPIResourceList
+		err = appCache.GetClusterAPIResources(cluster.Server, &apiResources)
+		if test.LastCacheSyncTime == nil {
+			assert.ErrorIs(t, err, appstate.ErrCacheMiss)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, []v1alpha1.ClusterAPIResource{{Group: "apps", Version: "v1", Kind: "Deployment", Name: "deployments", Namespaced: true}}, apiResources.Items)
+		}
 	}
 }
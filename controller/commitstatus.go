@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/scm"
+)
+
+// commitStatusTimeout is the maximum duration of the reporting of the outcome of a sync to the SCM providers
+const commitStatusTimeout = time.Minute
+
+// SetCommitStatusReporting sets whether the controller sets a commit status on the revisions synced by applications,
+// with the outcome of the syncs and a link to the applications, so that the SCM providers show which commits reached
+// which environments. The statuses are set with the credentials of the repositories, on the provider of the host of
+// the repositories: github.com, gitlab.com, bitbucket.org, or the self-hosted instances configured by the given
+// host=provider pairs.
+func (ctrl *ApplicationController) SetCommitStatusReporting(enabled bool, providerHosts []string) error {
+	hosts, err := scm.ParseProviderHosts(providerHosts)
+	if err != nil {
+		return err
+	}
+	ctrl.commitStatusReporting = enabled
+	ctrl.commitStatusProviderHosts = hosts
+	if ctrl.newSCMClient == nil {
+		ctrl.newSCMClient = scm.NewClient
+	}
+	return nil
+}
+
+// commitStatusContext returns the context of the commit statuses of the given application, which tells apart the
+// statuses of the applications syncing the same commits
+func commitStatusContext(app *appv1.Application) string {
+	return "argocd/" + app.QualifiedName()
+}
+
+// syncCommitStatus returns the commit status reporting the outcome of the given completed sync. The statuses may be
+// visible to anyone who can read the repositories, so they only tell the application and the phase of the sync, not
+// its destination nor its messages.
+func syncCommitStatus(app *appv1.Application, state *appv1.OperationState, argoCDURL string) scm.CommitStatus {
+	status := scm.CommitStatus{
+		Context:     commitStatusContext(app),
+		Description: fmt.Sprintf("Sync of %s: %s", app.Name, state.Phase),
+	}
+	switch state.Phase {
+	case synccommon.OperationSucceeded:
+		status.State = scm.CommitStateSuccess
+	case synccommon.OperationFailed:
+		status.State = scm.CommitStateFailure
+	default:
+		status.State = scm.CommitStateError
+	}
+	if argoCDURL != "" {
+		status.TargetURL = fmt.Sprintf("%s/applications/%s/%s", strings.TrimSuffix(argoCDURL, "/"), app.Namespace, app.Name)
+	}
+	return status
+}
+
+// syncedCommits returns the Git sources synced by the given sync and their synced commits. The partial syncs are not
+// reported, since they do not deploy the commits as a whole.
+func syncedCommits(state *appv1.OperationState) ([]appv1.ApplicationSource, []string) {
+	if state.Operation.Sync == nil || len(state.Operation.Sync.Resources) > 0 || state.SyncResult == nil {
+		return nil, nil
+	}
+	sources, revisions := state.SyncResult.Sources, state.SyncResult.Revisions
+	if len(sources) == 0 {
+		sources, revisions = []appv1.ApplicationSource{state.SyncResult.Source}, []string{state.SyncResult.Revision}
+	}
+	var gitSources []appv1.ApplicationSource
+	var commits []string
+	for i, source := range sources {
+		// the charts of Helm repositories have no commit
+		if i >= len(revisions) || source.IsHelm() || !git.IsCommitSHA(revisions[i]) {
+			continue
+		}
+		gitSources = append(gitSources, source)
+		commits = append(commits, revisions[i])
+	}
+	return gitSources, commits
+}
+
+// reportCommitStatuses sets the commit status reporting the outcome of the given completed sync on the synced commits
+func (ctrl *ApplicationController) reportCommitStatuses(app *appv1.Application, state *appv1.OperationState) {
+	sources, commits := syncedCommits(state)
+	if len(sources) == 0 {
+		return
+	}
+	logCtx := log.WithField("application", app.QualifiedName())
+	ctx, cancel := context.WithTimeout(context.Background(), commitStatusTimeout)
+	defer cancel()
+	argoCDSettings, err := ctrl.settingsMgr.GetSettings()
+	if err != nil {
+		logCtx.Warnf("Failed to get settings: %v", err)
+		return
+	}
+	status := syncCommitStatus(app, state, argoCDSettings.URL)
+	for i, source := range sources {
+		provider := scm.DetectProvider(source.RepoURL, ctrl.commitStatusProviderHosts)
+		if provider == "" {
+			logCtx.Debugf("Skipped setting the commit status of %s, since its SCM provider is unknown", source.RepoURL)
+			continue
+		}
+		repo, err := ctrl.db.GetRepository(ctx, source.RepoURL)
+		if err != nil {
+			logCtx.Warnf("Failed to get repository %s: %v", source.RepoURL, err)
+			continue
+		}
+		client, err := ctrl.newSCMClient(ctx, provider, repo)
+		if err != nil {
+			logCtx.Warnf("Failed to create %s client for %s: %v", provider, source.RepoURL, err)
+			continue
+		}
+		if err := client.SetCommitStatus(ctx, commits[i], status); err != nil {
+			logCtx.Warnf("Failed to set the status of commit %s of %s: %v", commits[i], source.RepoURL, err)
+			continue
+		}
+		logCtx.Infof("Set the status of commit %s of %s to %s", commits[i], source.RepoURL, status.State)
+	}
+}
@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/scm"
+)
+
+const fakeCommitSHA = "a0c5b8ba8c4b3b4ed4d7b4e1ff52b39c5ad9ba2f"
+
+// fakeSCMClient records the commit statuses it is asked to set
+type fakeSCMClient struct {
+	statuses map[string]scm.CommitStatus
+}
+
+func (c *fakeSCMClient) SetCommitStatus(_ context.Context, sha string, status scm.CommitStatus) error {
+	c.statuses[sha] = status
+	return nil
+}
+
+func (c *fakeSCMClient) UpsertPullRequestComment(context.Context, int, string, string) error {
+	return nil
+}
+
+func TestReportCommitStatuses(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}, configMapData: map[string]string{"url": "https://argocd.example.com"}})
+	require.NoError(t, ctrl.SetCommitStatusReporting(true, []string{"gitlab.example.com=gitlab"}))
+	client := &fakeSCMClient{statuses: map[string]scm.CommitStatus{}}
+	var providers []scm.Provider
+	ctrl.newSCMClient = func(_ context.Context, provider scm.Provider, repo *argoappv1.Repository) (scm.Client, error) {
+		providers = append(providers, provider)
+		assert.Equal(t, app.Spec.Source.RepoURL, repo.Repo)
+		return client, nil
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		ctrl.reportCommitStatuses(app, &argoappv1.OperationState{
+			Phase:      common.OperationSucceeded,
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+			SyncResult: &argoappv1.SyncOperationResult{Source: *app.Spec.Source, Revision: fakeCommitSHA},
+		})
+		assert.Equal(t, []scm.Provider{scm.ProviderGitHub}, providers)
+		require.Contains(t, client.statuses, fakeCommitSHA)
+		assert.Equal(t, scm.CommitStatus{
+			State:       scm.CommitStateSuccess,
+			Context:     "argocd/" + app.QualifiedName(),
+			Description: "Sync of " + app.Name + ": Succeeded",
+			TargetURL:   "https://argocd.example.com/applications/" + app.Namespace + "/" + app.Name,
+		}, client.statuses[fakeCommitSHA])
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		ctrl.reportCommitStatuses(app, &argoappv1.OperationState{
+			Phase:      common.OperationFailed,
+			Message:    "one or more objects failed to apply",
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+			SyncResult: &argoappv1.SyncOperationResult{Source: *app.Spec.Source, Revision: fakeCommitSHA},
+		})
+		assert.Equal(t, scm.CommitStateFailure, client.statuses[fakeCommitSHA].State)
+		assert.Equal(t, "Sync of "+app.Name+": Failed", client.statuses[fakeCommitSHA].Description)
+	})
+
+	t.Run("ConfiguredHost", func(t *testing.T) {
+		providers = nil
+		source := argoappv1.ApplicationSource{RepoURL: "https://gitlab.example.com/group/project.git"}
+		ctrl.newSCMClient = func(_ context.Context, provider scm.Provider, repo *argoappv1.Repository) (scm.Client, error) {
+			providers = append(providers, provider)
+			return client, nil
+		}
+		ctrl.reportCommitStatuses(app, &argoappv1.OperationState{
+			Phase:      common.OperationSucceeded,
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+			SyncResult: &argoappv1.SyncOperationResult{Source: source, Revision: fakeCommitSHA},
+		})
+		assert.Equal(t, []scm.Provider{scm.ProviderGitLab}, providers)
+	})
+
+	t.Run("Skipped", func(t *testing.T) {
+		providers = nil
+		// partial sync
+		ctrl.reportCommitStatuses(app, &argoappv1.OperationState{
+			Phase:      common.OperationSucceeded,
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{Resources: []argoappv1.SyncOperationResource{{Kind: "ConfigMap", Name: "a"}}}},
+			SyncResult: &argoappv1.SyncOperationResult{Source: *app.Spec.Source, Revision: fakeCommitSHA},
+		})
+		// Helm chart
+		ctrl.reportCommitStatuses(app, &argoappv1.OperationState{
+			Phase:      common.OperationSucceeded,
+			Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+			SyncResult: &argoappv1.SyncOperationResult{Source: argoappv1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "chart"}, Revision: "1.0.0"},
+		})
+		// unknown provider, even if the host looks like a known one
+		for _, repoURL := range []string{"https://git.example.com/org/repo.git", "https://github.example.com/org/repo.git"} {
+			ctrl.reportCommitStatuses(app, &argoappv1.OperationState{
+				Phase:      common.OperationSucceeded,
+				Operation:  argoappv1.Operation{Sync: &argoappv1.SyncOperation{}},
+				SyncResult: &argoappv1.SyncOperationResult{Source: argoappv1.ApplicationSource{RepoURL: repoURL}, Revision: fakeCommitSHA},
+			})
+		}
+		assert.Empty(t, providers)
+	})
+}
@@ -0,0 +1,237 @@
+package configsync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// Source is the location in Git of the Argo CD configuration
+type Source struct {
+	// RepoURL is the URL of the repository holding the configuration
+	RepoURL string
+	// Path is the directory of the repository holding the configuration manifests
+	Path string
+	// TargetRevision is the revision of the repository to sync the configuration from
+	TargetRevision string
+}
+
+// Result is the outcome of a reconciliation of the Argo CD configuration
+type Result struct {
+	// Revision is the resolved revision the configuration was reconciled from
+	Revision string
+	// Updated holds the names of the config maps which were drifted from Git and got created or updated
+	Updated []string
+	// InSync holds the names of the config maps which already matched Git
+	InSync []string
+}
+
+// validators returns the validation of each config map which can be synced from Git, by name of the config map of the
+// Argo CD instance
+func validators() map[string]func(cm *apiv1.ConfigMap) error {
+	return map[string]func(cm *apiv1.ConfigMap) error{
+		common.GetArgoCDConfigMapName():     settings.ValidateArgoCDConfigMap,
+		common.GetArgoCDRBACConfigMapName(): rbac.ValidateConfigMap,
+		common.GetArgoCDNotificationsConfigMapName(): func(cm *apiv1.ConfigMap) error {
+			_, err := api.ParseConfig(cm, &apiv1.Secret{})
+			return err
+		},
+	}
+}
+
+// Reconciler keeps the Argo CD config maps in sync with their declarative definition stored in Git
+type Reconciler struct {
+	namespace     string
+	source        Source
+	kubeClientset kubernetes.Interface
+	db            db.ArgoDB
+	repoClientset apiclient.Clientset
+}
+
+// NewReconciler creates a reconciler of the Argo CD config maps of the given namespace
+func NewReconciler(namespace string, source Source, kubeClientset kubernetes.Interface, db db.ArgoDB, repoClientset apiclient.Clientset) *Reconciler {
+	return &Reconciler{
+		namespace:     namespace,
+		source:        source,
+		kubeClientset: kubeClientset,
+		db:            db,
+		repoClientset: repoClientset,
+	}
+}
+
+// Run reconciles the Argo CD configuration at the given interval until the context is done
+func (r *Reconciler) Run(ctx context.Context, interval time.Duration) {
+	log.Infof("Syncing Argo CD configuration from %s (path '%s', revision '%s') every %v", r.source.RepoURL, r.source.Path, r.source.TargetRevision, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := r.Reconcile(ctx); err != nil {
+			log.Warnf("Failed to sync Argo CD configuration from Git: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile generates the Argo CD config maps from Git, validates them and updates the live config maps drifted from
+// Git. Nothing is applied if any of the config maps is invalid.
+func (r *Reconciler) Reconcile(ctx context.Context) (*Result, error) {
+	revision, desired, err := r.getDesiredConfigMaps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cm := range desired {
+		if err := validators()[cm.Name](cm); err != nil {
+			return nil, fmt.Errorf("config map '%s' at revision %s is invalid: %w", cm.Name, revision, err)
+		}
+	}
+
+	result := &Result{Revision: revision}
+	for _, cm := range desired {
+		updated, err := r.applyConfigMap(ctx, cm, revision)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply config map '%s': %w", cm.Name, err)
+		}
+		if updated {
+			log.Infof("Config map '%s' drifted from Git and was synced to revision %s", cm.Name, revision)
+			result.Updated = append(result.Updated, cm.Name)
+		} else {
+			result.InSync = append(result.InSync, cm.Name)
+		}
+	}
+	return result, nil
+}
+
+// getDesiredConfigMaps returns the resolved revision and the Argo CD config maps defined in Git
+func (r *Reconciler) getDesiredConfigMaps(ctx context.Context) (string, []*apiv1.ConfigMap, error) {
+	repo, err := r.db.GetRepository(ctx, r.source.RepoURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get repository '%s': %w", r.source.RepoURL, err)
+	}
+	conn, repoClient, err := r.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to connect to the repo server: %w", err)
+	}
+	defer io.Close(conn)
+
+	source := appv1.ApplicationSource{RepoURL: r.source.RepoURL, Path: r.source.Path, TargetRevision: r.source.TargetRevision}
+	res, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
+		Repo:              repo,
+		Revision:          r.source.TargetRevision,
+		Namespace:         r.namespace,
+		ApplicationSource: &source,
+		NoRevisionCache:   true,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate manifests: %w", err)
+	}
+
+	var configMaps []*apiv1.ConfigMap
+	seen := map[string]bool{}
+	for _, manifest := range res.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return "", nil, err
+		}
+		if obj.GetAPIVersion() != "v1" || obj.GetKind() != "ConfigMap" || validators()[obj.GetName()] == nil {
+			log.Debugf("Ignoring %s/%s which is not an Argo CD config map", obj.GetKind(), obj.GetName())
+			continue
+		}
+		if obj.GetNamespace() != "" && obj.GetNamespace() != r.namespace {
+			log.Debugf("Ignoring config map %s/%s which is outside of namespace %s", obj.GetNamespace(), obj.GetName(), r.namespace)
+			continue
+		}
+		if seen[obj.GetName()] {
+			return "", nil, fmt.Errorf("config map '%s' is defined more than once", obj.GetName())
+		}
+		seen[obj.GetName()] = true
+		var cm apiv1.ConfigMap
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &cm); err != nil {
+			return "", nil, fmt.Errorf("failed to convert config map '%s': %w", obj.GetName(), err)
+		}
+		configMaps = append(configMaps, &cm)
+	}
+	sort.Slice(configMaps, func(i, j int) bool {
+		return configMaps[i].Name < configMaps[j].Name
+	})
+	return res.Revision, configMaps, nil
+}
+
+// applyConfigMap creates or updates the live config map if its data or labels differ from the desired one and returns
+// whether it was changed
+func (r *Reconciler) applyConfigMap(ctx context.Context, desired *apiv1.ConfigMap, revision string) (bool, error) {
+	configMaps := r.kubeClientset.CoreV1().ConfigMaps(r.namespace)
+	live, err := configMaps.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		cm := &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        desired.Name,
+				Namespace:   r.namespace,
+				Labels:      map[string]string{"app.kubernetes.io/part-of": "argocd"},
+				Annotations: map[string]string{common.AnnotationKeyConfigSourceRevision: revision},
+			},
+			Data: desired.Data,
+		}
+		for k, v := range desired.Labels {
+			cm.Labels[k] = v
+		}
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		return err == nil, err
+	} else if err != nil {
+		return false, err
+	}
+
+	labelsInSync := true
+	for k, v := range desired.Labels {
+		if live.Labels[k] != v {
+			labelsInSync = false
+		}
+	}
+	if labelsInSync && dataEqual(live.Data, desired.Data) {
+		return false, nil
+	}
+
+	cm := live.DeepCopy()
+	cm.Data = desired.Data
+	if cm.Labels == nil {
+		cm.Labels = map[string]string{}
+	}
+	for k, v := range desired.Labels {
+		cm.Labels[k] = v
+	}
+	if cm.Annotations == nil {
+		cm.Annotations = map[string]string{}
+	}
+	cm.Annotations[common.AnnotationKeyConfigSourceRevision] = revision
+	_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	return err == nil, err
+}
+
+// dataEqual returns whether the two config map data are equal, considering empty and nil data as equal
+func dataEqual(a, b map[string]string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
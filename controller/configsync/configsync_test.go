@@ -0,0 +1,114 @@
+package configsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+const (
+	testNamespace = "argocd"
+	testRepoURL   = "https://github.com/argoproj/argocd-config"
+)
+
+var (
+	argoCDCMManifest = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"argocd-cm","labels":{"team":"platform"}},"data":{"url":"https://argocd.example.com"}}`
+	rbacCMManifest   = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"argocd-rbac-cm","namespace":"argocd"},"data":{"policy.default":"role:readonly","policy.csv":"g, admins, role:admin"}}`
+	otherCMManifest  = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"other-cm"},"data":{"foo":"bar"}}`
+)
+
+func newReconciler(manifests []string, objects ...*apiv1.ConfigMap) (*Reconciler, *fake.Clientset) {
+	repoClient := &repomocks.RepoServerServiceClient{}
+	repoClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+		return req.ApplicationSource.RepoURL == testRepoURL && req.ApplicationSource.Path == "config" && req.Revision == "HEAD"
+	})).Return(&apiclient.ManifestResponse{Manifests: manifests, Revision: "abc123"}, nil)
+
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("GetRepository", mock.Anything, testRepoURL).Return(&appv1.Repository{Repo: testRepoURL}, nil)
+
+	var runtimeObjects []runtime.Object
+	for _, obj := range objects {
+		runtimeObjects = append(runtimeObjects, obj)
+	}
+	kubeClient := fake.NewSimpleClientset(runtimeObjects...)
+	source := Source{RepoURL: testRepoURL, Path: "config", TargetRevision: "HEAD"}
+	return NewReconciler(testNamespace, source, kubeClient, argoDB, &repomocks.Clientset{RepoServerServiceClient: repoClient}), kubeClient
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("CreatesAndUpdatesDriftedConfigMaps", func(t *testing.T) {
+		live := &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data:       map[string]string{"url": "https://old.example.com"},
+		}
+		r, kubeClient := newReconciler([]string{argoCDCMManifest, rbacCMManifest, otherCMManifest}, live)
+
+		res, err := r.Reconcile(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", res.Revision)
+		assert.Equal(t, []string{common.ArgoCDConfigMapName, common.ArgoCDRBACConfigMapName}, res.Updated)
+		assert.Empty(t, res.InSync)
+
+		cm, err := kubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"url": "https://argocd.example.com"}, cm.Data)
+		assert.Equal(t, map[string]string{"app.kubernetes.io/part-of": "argocd", "team": "platform"}, cm.Labels)
+		assert.Equal(t, "abc123", cm.Annotations[common.AnnotationKeyConfigSourceRevision])
+
+		cm, err = kubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDRBACConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "role:readonly", cm.Data["policy.default"])
+		assert.Equal(t, "argocd", cm.Labels["app.kubernetes.io/part-of"])
+
+		_, err = kubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), "other-cm", metav1.GetOptions{})
+		assert.Error(t, err)
+
+		res, err = r.Reconcile(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, res.Updated)
+		assert.Equal(t, []string{common.ArgoCDConfigMapName, common.ArgoCDRBACConfigMapName}, res.InSync)
+	})
+	t.Run("InvalidConfigIsNotApplied", func(t *testing.T) {
+		live := &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace},
+			Data:       map[string]string{"url": "https://old.example.com"},
+		}
+		invalidRBACCM := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"argocd-rbac-cm"},"data":{"policy.matchMode":"fuzzy"}}`
+		r, kubeClient := newReconciler([]string{argoCDCMManifest, invalidRBACCM}, live)
+
+		_, err := r.Reconcile(context.Background())
+		assert.ErrorContains(t, err, "config map 'argocd-rbac-cm' at revision abc123 is invalid")
+
+		cm, err := kubeClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "https://old.example.com", cm.Data["url"])
+	})
+	t.Run("DuplicateConfigMap", func(t *testing.T) {
+		r, _ := newReconciler([]string{argoCDCMManifest, argoCDCMManifest})
+
+		_, err := r.Reconcile(context.Background())
+		assert.ErrorContains(t, err, "defined more than once")
+	})
+	t.Run("ConfigMapInOtherNamespaceIsIgnored", func(t *testing.T) {
+		otherNamespaceCM := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"argocd-cm","namespace":"other"},"data":{"url":"https://argocd.example.com"}}`
+		r, _ := newReconciler([]string{otherNamespaceCM})
+
+		res, err := r.Reconcile(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, res.Updated)
+		assert.Empty(t, res.InSync)
+	})
+}
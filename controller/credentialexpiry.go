@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/db"
+)
+
+// credentialExpiriesRefreshInterval is the interval at which the expiries of the credentials are listed again
+const credentialExpiriesRefreshInterval = time.Minute
+
+// SetCredentialExpiryMonitoring sets the period before the expiry of the credentials of the repositories and clusters
+// during which the applications using them get a CredentialExpiringWarning condition, and the maximum age of the
+// credentials which do not expire, such as the private keys of GitHub Apps, after which they should be rotated. The
+// monitoring is disabled if the warning period is 0, and the age of the credentials is not monitored if the maximum
+// age is 0.
+func (ctrl *ApplicationController) SetCredentialExpiryMonitoring(warningPeriod time.Duration, maxAge time.Duration) {
+	ctrl.credentialExpiryWarningPeriod = warningPeriod
+	ctrl.credentialMaxAge = maxAge
+	if warningPeriod > 0 {
+		ctrl.metricsServer.RegisterCredentialExpiriesSource(ctrl)
+	}
+}
+
+// GetCredentialExpiries returns the expiries of the credentials of the repositories and clusters, which are listed
+// again at most every credentialExpiriesRefreshInterval
+func (ctrl *ApplicationController) GetCredentialExpiries() []db.CredentialExpiry {
+	ctrl.credentialExpiriesMutex.Lock()
+	defer ctrl.credentialExpiriesMutex.Unlock()
+	if time.Since(ctrl.credentialExpiriesListedAt) < credentialExpiriesRefreshInterval {
+		return ctrl.credentialExpiries
+	}
+	expiries, err := ctrl.db.ListCredentialExpiries(context.Background())
+	if err != nil {
+		log.Warnf("Failed to list credential expiries: %v", err)
+		return ctrl.credentialExpiries
+	}
+	ctrl.credentialExpiries = expiries
+	ctrl.credentialExpiriesListedAt = time.Now()
+	return expiries
+}
+
+// credentialExpiringCondition returns a warning condition if credentials of the repositories of the sources or of the
+// destination cluster of the application expire within the warning period
+func (ctrl *ApplicationController) credentialExpiringCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	if ctrl.credentialExpiryWarningPeriod <= 0 {
+		return nil
+	}
+	server := app.Spec.Destination.Server
+	if server == "" && app.Spec.Destination.Name != "" {
+		servers, err := ctrl.db.GetClusterServersByName(context.Background(), app.Spec.Destination.Name)
+		if err == nil && len(servers) == 1 {
+			server = servers[0]
+		}
+	}
+	now := time.Now()
+	var messages []string
+	for _, expiry := range ctrl.GetCredentialExpiries() {
+		used := expiry.OwnerKind == db.CredentialOwnerCluster && expiry.Owner == server
+		for _, source := range app.Spec.GetSources() {
+			used = used || expiry.AppliesToRepository(source.RepoURL)
+		}
+		if used && expiry.ExpiresWithin(now, ctrl.credentialExpiryWarningPeriod, ctrl.credentialMaxAge) {
+			messages = append(messages, expiry.Message(ctrl.credentialMaxAge))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionCredentialExpiringWarning, Message: strings.Join(messages, "; ")}
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/db"
+)
+
+func TestCredentialExpiringCondition(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(60 * 24 * time.Hour)
+	ctrl.credentialExpiries = []db.CredentialExpiry{
+		{OwnerKind: db.CredentialOwnerRepositoryCredentials, Owner: "https://github.com/argoproj/", Type: db.CredentialTypeTLSClientCertificate, ExpiresAt: &soon},
+		{OwnerKind: db.CredentialOwnerCluster, Owner: app.Spec.Destination.Server, Type: db.CredentialTypeToken, ExpiresAt: &later},
+		{OwnerKind: db.CredentialOwnerRepository, Owner: "https://github.com/other/repo.git", Type: db.CredentialTypeToken, ExpiresAt: &soon},
+	}
+	ctrl.credentialExpiriesListedAt = time.Now()
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert.Nil(t, ctrl.credentialExpiringCondition(app))
+	})
+
+	ctrl.SetCredentialExpiryMonitoring(7*24*time.Hour, 0)
+
+	t.Run("Expiring", func(t *testing.T) {
+		cond := ctrl.credentialExpiringCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, argoappv1.ApplicationConditionCredentialExpiringWarning, cond.Type)
+		assert.Equal(t, "TLSClientCertificate of repositorycredentials https://github.com/argoproj/ expires at "+soon.UTC().Format(time.RFC3339), cond.Message)
+	})
+
+	t.Run("NotExpiring", func(t *testing.T) {
+		ctrl.credentialExpiryWarningPeriod = time.Hour
+		assert.Nil(t, ctrl.credentialExpiringCondition(app))
+	})
+}
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DebugVarsPath is the endpoint exposing the expvar variables of the application controller
+	DebugVarsPath = "/debug/vars"
+	// DebugControllerPath is the endpoint exposing the controller debug information
+	DebugControllerPath = "/debug/controller"
+)
+
+// AppReconcileInfo holds the reconciliation timings of an application
+type AppReconcileInfo struct {
+	// Name is the qualified name of the application
+	Name string `json:"name"`
+	// LastReconciledAt is the time the most recent reconciliation completed
+	LastReconciledAt time.Time `json:"lastReconciledAt"`
+	// LastDurationMs is the duration of the most recent reconciliation in milliseconds
+	LastDurationMs int64 `json:"lastDurationMs"`
+	// MaxDurationMs is the duration of the slowest reconciliation in milliseconds
+	MaxDurationMs int64 `json:"maxDurationMs"`
+	// LastComparisonLevel is the comparison level of the most recent reconciliation
+	LastComparisonLevel CompareWith `json:"lastComparisonLevel"`
+	// Count is the number of reconciliations
+	Count int64 `json:"count"`
+}
+
+// ClusterCacheInfo holds the size of the cache of a cluster
+type ClusterCacheInfo struct {
+	Server            string     `json:"server"`
+	K8SVersion        string     `json:"k8sVersion,omitempty"`
+	ResourcesCount    int        `json:"resourcesCount"`
+	APIsCount         int        `json:"apisCount"`
+	LastCacheSyncTime *time.Time `json:"lastCacheSyncTime,omitempty"`
+	SyncError         string     `json:"syncError,omitempty"`
+}
+
+// DebugInfo holds runtime information of the application controller which helps to diagnose performance issues
+type DebugInfo struct {
+	Goroutines              int                `json:"goroutines"`
+	HeapAllocBytes          uint64             `json:"heapAllocBytes"`
+	HeapObjects             uint64             `json:"heapObjects"`
+	NumGC                   uint32             `json:"numGC"`
+	AppRefreshQueueLength   int                `json:"appRefreshQueueLength"`
+	AppOperationQueueLength int                `json:"appOperationQueueLength"`
+	Applications            []AppReconcileInfo `json:"applications"`
+	Clusters                []ClusterCacheInfo `json:"clusters"`
+}
+
+// reconcileTimings keeps track of the reconciliation timings per application
+type reconcileTimings struct {
+	lock sync.Mutex
+	apps map[string]*AppReconcileInfo
+}
+
+func newReconcileTimings() *reconcileTimings {
+	return &reconcileTimings{apps: make(map[string]*AppReconcileInfo)}
+}
+
+func (r *reconcileTimings) observe(appKey string, appName string, level CompareWith, duration time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	info, ok := r.apps[appKey]
+	if !ok {
+		info = &AppReconcileInfo{Name: appName}
+		r.apps[appKey] = info
+	}
+	info.LastReconciledAt = time.Now()
+	info.LastDurationMs = duration.Milliseconds()
+	if info.LastDurationMs > info.MaxDurationMs {
+		info.MaxDurationMs = info.LastDurationMs
+	}
+	info.LastComparisonLevel = level
+	info.Count++
+}
+
+// list returns the timings of the applications for which exists returns true, slowest first. The timings of other
+// applications are dropped.
+func (r *reconcileTimings) list(exists func(appKey string) bool) []AppReconcileInfo {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	apps := make([]AppReconcileInfo, 0, len(r.apps))
+	for appKey, info := range r.apps {
+		if !exists(appKey) {
+			delete(r.apps, appKey)
+			continue
+		}
+		apps = append(apps, *info)
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		if apps[i].LastDurationMs != apps[j].LastDurationMs {
+			return apps[i].LastDurationMs > apps[j].LastDurationMs
+		}
+		return apps[i].Name < apps[j].Name
+	})
+	return apps
+}
+
+// GetDebugInfo returns runtime information of the application controller
+func (ctrl *ApplicationController) GetDebugInfo() DebugInfo {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	info := DebugInfo{
+		Goroutines:              runtime.NumGoroutine(),
+		HeapAllocBytes:          memStats.HeapAlloc,
+		HeapObjects:             memStats.HeapObjects,
+		NumGC:                   memStats.NumGC,
+		AppRefreshQueueLength:   ctrl.appRefreshQueue.Len(),
+		AppOperationQueueLength: ctrl.appOperationQueue.Len(),
+		Applications: ctrl.reconcileTimings.list(func(appKey string) bool {
+			_, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
+			return err == nil && exists
+		}),
+		Clusters: make([]ClusterCacheInfo, 0),
+	}
+	for _, cluster := range ctrl.stateCache.GetClustersInfo() {
+		clusterInfo := ClusterCacheInfo{
+			Server:            cluster.Server,
+			K8SVersion:        cluster.K8SVersion,
+			ResourcesCount:    cluster.ResourcesCount,
+			APIsCount:         cluster.APIsCount,
+			LastCacheSyncTime: cluster.LastCacheSyncTime,
+		}
+		if cluster.SyncError != nil {
+			clusterInfo.SyncError = cluster.SyncError.Error()
+		}
+		info.Clusters = append(info.Clusters, clusterInfo)
+	}
+	sort.Slice(info.Clusters, func(i, j int) bool {
+		return info.Clusters[i].Server < info.Clusters[j].Server
+	})
+	return info
+}
+
+// debugHandler serves the controller debug information as JSON. The number of returned applications can be limited
+// using the 'limit' query parameter.
+func (ctrl *ApplicationController) debugHandler(w http.ResponseWriter, r *http.Request) {
+	info := ctrl.GetDebugInfo()
+	if limitParam := r.URL.Query().Get("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 0 {
+			http.Error(w, "invalid limit: "+limitParam, http.StatusBadRequest)
+			return
+		}
+		if limit < len(info.Applications) {
+			info.Applications = info.Applications[:limit]
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(info); err != nil {
+		log.Warnf("Failed to write controller debug information: %v", err)
+	}
+}
+
+// EnableDebugEndpoints exposes the expvar variables and the controller debug information on the metrics server
+func (ctrl *ApplicationController) EnableDebugEndpoints() {
+	ctrl.metricsServer.RegisterHandler(DebugVarsPath, expvar.Handler())
+	ctrl.metricsServer.RegisterHandler(DebugControllerPath, http.HandlerFunc(ctrl.debugHandler))
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	mockstatecache "github.com/argoproj/argo-cd/v2/controller/cache/mocks"
+)
+
+func TestGetDebugInfo(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.stateCache.(*mockstatecache.LiveStateCache).On("GetClustersInfo").Return([]clustercache.ClusterInfo{
+		{Server: "https://remote", ResourcesCount: 10, APIsCount: 2},
+		{Server: "https://kubernetes.default.svc", ResourcesCount: 100, APIsCount: 20},
+	})
+
+	appKey := app.Namespace + "/" + app.Name
+	ctrl.reconcileTimings.observe(appKey, app.QualifiedName(), CompareWithLatest, 3*time.Second)
+	ctrl.reconcileTimings.observe(appKey, app.QualifiedName(), CompareWithRecent, time.Second)
+	ctrl.reconcileTimings.observe("argocd/deleted-app", "deleted-app", CompareWithLatest, 5*time.Second)
+
+	info := ctrl.GetDebugInfo()
+	assert.Greater(t, info.Goroutines, 0)
+	assert.Greater(t, info.HeapAllocBytes, uint64(0))
+	require.Len(t, info.Applications, 1)
+	assert.Equal(t, app.QualifiedName(), info.Applications[0].Name)
+	assert.Equal(t, int64(1000), info.Applications[0].LastDurationMs)
+	assert.Equal(t, int64(3000), info.Applications[0].MaxDurationMs)
+	assert.Equal(t, CompareWithRecent, info.Applications[0].LastComparisonLevel)
+	assert.Equal(t, int64(2), info.Applications[0].Count)
+	require.Len(t, info.Clusters, 2)
+	assert.Equal(t, "https://kubernetes.default.svc", info.Clusters[0].Server)
+	assert.Equal(t, 100, info.Clusters[0].ResourcesCount)
+	// timings of deleted applications are dropped
+	assert.NotContains(t, ctrl.reconcileTimings.apps, "argocd/deleted-app")
+}
+
+func TestDebugHandler(t *testing.T) {
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{newFakeApp()}})
+	ctrl.stateCache.(*mockstatecache.LiveStateCache).On("GetClustersInfo").Return([]clustercache.ClusterInfo{})
+	app := newFakeApp()
+	ctrl.reconcileTimings.observe(app.Namespace+"/"+app.Name, app.QualifiedName(), CompareWithLatest, time.Second)
+
+	t.Run("Limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctrl.debugHandler(w, httptest.NewRequest(http.MethodGet, DebugControllerPath+"?limit=0", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		var info DebugInfo
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+		assert.Empty(t, info.Applications)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctrl.debugHandler(w, httptest.NewRequest(http.MethodGet, DebugControllerPath, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		var info DebugInfo
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+		assert.Len(t, info.Applications, 1)
+	})
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		ctrl.debugHandler(w, httptest.NewRequest(http.MethodGet, DebugControllerPath+"?limit=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"context"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/healthz"
+)
+
+// SetHardDependencies designates the dependencies which must be ready for the controller to be ready, among the ones
+// returned by dependencies. The dependencies are all soft requirements by default.
+func (ctrl *ApplicationController) SetHardDependencies(names []string) error {
+	return ctrl.dependencyChecker.SetHardDependencies(names)
+}
+
+// dependencies returns the services the controller depends on, whose states are reported by the health check endpoint
+func (ctrl *ApplicationController) dependencies() []healthz.Dependency {
+	redis := healthz.Dependency{Name: healthz.DependencyRedis, Check: func(ctx context.Context) error {
+		return ctrl.cache.Cache.CheckConnection()
+	}}
+	informers := healthz.InformersDependency(map[string]cache.InformerSynced{
+		"applications": ctrl.appInformer.HasSynced,
+		"appprojects":  ctrl.projInformer.HasSynced,
+	})
+	repoServer := healthz.Dependency{Name: healthz.DependencyRepoServer, Check: func(ctx context.Context) error {
+		return apiclient.CheckConnection(ctx, ctrl.repoClientset)
+	}}
+	return []healthz.Dependency{redis, healthz.KubernetesDependency(ctrl.kubeClientset.Discovery()), informers, repoServer}
+}
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// isDrift returns whether the new sync status of the application is a drift of its live state from the target state,
+// i.e. the application became OutOfSync outside of a sync operation and while being compared to the same revision(s).
+func isDrift(app *appv1.Application, syncStatus *appv1.SyncStatus) bool {
+	if app.Status.Sync.Status != appv1.SyncStatusCodeSynced || syncStatus.Status != appv1.SyncStatusCodeOutOfSync {
+		return false
+	}
+	if app.Operation != nil || (app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()) {
+		return false
+	}
+	return app.Status.Sync.Revision == syncStatus.Revision && reflect.DeepEqual(app.Status.Sync.Revisions, syncStatus.Revisions)
+}
+
+// recordDrift records the drift of the application live state as a Kubernetes event and in the application drift history
+func (ctrl *ApplicationController) recordDrift(app *appv1.Application, compareResult *comparisonResult) {
+	record := appv1.DriftRecord{
+		DetectedAt: metav1.Now(),
+		Revision:   compareResult.syncStatus.Revision,
+		Revisions:  compareResult.syncStatus.Revisions,
+	}
+	var resources []string
+	for _, res := range compareResult.managedResources {
+		if res.Hook || !res.Diff.Modified {
+			continue
+		}
+		drifted := appv1.DriftedResource{
+			Group:     res.Group,
+			Version:   res.Version,
+			Kind:      res.Kind,
+			Namespace: res.Namespace,
+			Name:      res.Name,
+		}
+		drifted.Manager, drifted.ModifiedAt = lastFieldManager(res.Live)
+		record.Resources = append(record.Resources, drifted)
+
+		resource := fmt.Sprintf("%s/%s", res.Kind, res.Name)
+		if res.Namespace != "" {
+			resource = fmt.Sprintf("%s/%s", res.Namespace, resource)
+		}
+		if drifted.Manager != "" {
+			resource = fmt.Sprintf("%s (modified by %s)", resource, drifted.Manager)
+		}
+		resources = append(resources, resource)
+	}
+
+	message := "Live state drifted from target state"
+	if len(resources) > 0 {
+		message = fmt.Sprintf("%s: %s", message, strings.Join(resources, ", "))
+	}
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonDriftDetected, Type: v1.EventTypeWarning}, message)
+
+	if err := ctrl.cache.AddAppDriftRecord(app.InstanceName(ctrl.namespace), record); err != nil {
+		log.WithField("application", app.QualifiedName()).Warnf("Failed to record drift history: %v", err)
+	}
+}
+
+// lastFieldManager returns the field manager which most recently modified the live resource and when it did so
+func lastFieldManager(live *unstructured.Unstructured) (string, *metav1.Time) {
+	if live == nil {
+		return "", nil
+	}
+	var manager string
+	var modifiedAt *metav1.Time
+	for _, entry := range live.GetManagedFields() {
+		if entry.Time == nil {
+			continue
+		}
+		if modifiedAt == nil || modifiedAt.Before(entry.Time) {
+			manager = entry.Manager
+			modifiedAt = entry.Time.DeepCopy()
+		}
+	}
+	return manager, modifiedAt
+}
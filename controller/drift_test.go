@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestIsDrift(t *testing.T) {
+	newApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Operation = nil
+		app.Status.OperationState = nil
+		app.Status.Sync = v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "abc"}
+		return app
+	}
+	outOfSync := &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "abc"}
+
+	t.Run("Drift", func(t *testing.T) {
+		assert.True(t, isDrift(newApp(), outOfSync))
+	})
+	t.Run("StillSynced", func(t *testing.T) {
+		assert.False(t, isDrift(newApp(), &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: "abc"}))
+	})
+	t.Run("AlreadyOutOfSync", func(t *testing.T) {
+		app := newApp()
+		app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+		assert.False(t, isDrift(app, outOfSync))
+	})
+	t.Run("RevisionChanged", func(t *testing.T) {
+		assert.False(t, isDrift(newApp(), &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "def"}))
+	})
+	t.Run("OperationRunning", func(t *testing.T) {
+		app := newApp()
+		app.Status.OperationState = &v1alpha1.OperationState{Phase: common.OperationRunning}
+		assert.False(t, isDrift(app, outOfSync))
+	})
+	t.Run("OperationRequested", func(t *testing.T) {
+		app := newApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		assert.False(t, isDrift(app, outOfSync))
+	})
+}
+
+func TestRecordDrift(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	live := NewPod()
+	older := metav1.NewTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "argocd-controller", Time: &older},
+		{Manager: "kubectl-edit", Time: &newer},
+	})
+	ctrl.recordDrift(app, &comparisonResult{
+		syncStatus: &v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "abc"},
+		managedResources: []managedResource{{
+			Live: live, Target: NewPod(), Diff: diff.DiffResult{Modified: true},
+			Kind: "Pod", Namespace: live.GetNamespace(), Name: live.GetName(),
+		}, {
+			Live: NewService(), Target: NewService(), Diff: diff.DiffResult{Modified: false},
+			Kind: "Service", Name: "my-service",
+		}},
+	})
+
+	history := make([]v1alpha1.DriftRecord, 0)
+	require.NoError(t, ctrl.cache.GetAppDriftHistory(app.InstanceName(ctrl.namespace), &history))
+	require.Len(t, history, 1)
+	assert.Equal(t, "abc", history[0].Revision)
+	require.Len(t, history[0].Resources, 1)
+	assert.Equal(t, "Pod", history[0].Resources[0].Kind)
+	assert.Equal(t, "kubectl-edit", history[0].Resources[0].Manager)
+	assert.True(t, history[0].Resources[0].ModifiedAt.Equal(&newer))
+}
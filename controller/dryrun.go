@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	dryRunFieldSyncStatus   = "sync_status"
+	dryRunFieldRevision     = "revision"
+	dryRunFieldHealthStatus = "health_status"
+	dryRunFieldResources    = "resources"
+	dryRunFieldConditions   = "conditions"
+)
+
+// dryRunFields are the fields of the status of applications compared by the dry run controller
+var dryRunFields = []string{dryRunFieldSyncStatus, dryRunFieldRevision, dryRunFieldHealthStatus, dryRunFieldResources, dryRunFieldConditions}
+
+// dryRunDiff is a field of the status which the dry run controller computed differently than the active controller
+type dryRunDiff struct {
+	field  string
+	active string
+	dryRun string
+}
+
+// SetDryRun sets whether the controller reconciles applications without persisting anything and without syncing. The
+// status computed by the controller is compared to the status persisted by the active controller instead, so that a
+// new version of the controller can be validated against the applications before it replaces the active controller.
+func (ctrl *ApplicationController) SetDryRun(dryRun bool) {
+	ctrl.dryRun = dryRun
+}
+
+// activeControllerReconciled returns whether the active controller persisted a new reconciliation of the application.
+// The dry run controller then reconciles the application as well, so that both compare the same revisions.
+func activeControllerReconciled(old, new *appv1.Application) bool {
+	return new.Status.ReconciledAt != nil && !new.Status.ReconciledAt.Equal(old.Status.ReconciledAt)
+}
+
+// reportDryRunDiff reports the differences between the status persisted by the active controller and the status
+// computed by the dry run controller
+func (ctrl *ApplicationController) reportDryRunDiff(orig *appv1.Application, newStatus *appv1.ApplicationStatus) {
+	diffs := diffDryRunStatus(&orig.Status, newStatus)
+	differs := map[string]bool{}
+	logCtx := log.WithField("application", orig.QualifiedName())
+	for _, diff := range diffs {
+		differs[diff.field] = true
+		logCtx.Infof("Dry run: %s differs from the active controller: active %s, dry run %s", diff.field, diff.active, diff.dryRun)
+	}
+	for _, field := range dryRunFields {
+		ctrl.metricsServer.SetDryRunDiff(orig, field, differs[field])
+	}
+	if len(diffs) == 0 {
+		logCtx.Debug("Dry run: status matches the active controller")
+	}
+}
+
+// diffDryRunStatus compares the fields of the status computed by the dry run controller which do not depend on the
+// time of the reconciliation. The resources are only compared if both lists are stored in the status, and their health
+// only if both controllers store it inline.
+func diffDryRunStatus(active, dryRun *appv1.ApplicationStatus) []dryRunDiff {
+	var diffs []dryRunDiff
+	addDiff := func(field string, active, dryRun string) {
+		if active != dryRun {
+			diffs = append(diffs, dryRunDiff{field: field, active: active, dryRun: dryRun})
+		}
+	}
+	addDiff(dryRunFieldSyncStatus, string(active.Sync.Status), string(dryRun.Sync.Status))
+	addDiff(dryRunFieldRevision, strings.Join(append([]string{active.Sync.Revision}, active.Sync.Revisions...), ","), strings.Join(append([]string{dryRun.Sync.Revision}, dryRun.Sync.Revisions...), ","))
+	addDiff(dryRunFieldHealthStatus, string(active.Health.Status), string(dryRun.Health.Status))
+	if active.ResourcesSource == appv1.ResourceStatusLocationInline && dryRun.ResourcesSource == appv1.ResourceStatusLocationInline {
+		compareHealth := active.ResourceHealthSource == appv1.ResourceHealthLocationInline && dryRun.ResourceHealthSource == appv1.ResourceHealthLocationInline
+		activeResources := resourceStatuses(active.Resources, compareHealth)
+		dryRunResources := resourceStatuses(dryRun.Resources, compareHealth)
+		var activeDiffs, dryRunDiffs []string
+		for _, key := range unionKeys(activeResources, dryRunResources) {
+			if activeResources[key] != dryRunResources[key] {
+				activeDiffs = append(activeDiffs, fmt.Sprintf("%s=%s", key, orNone(activeResources[key])))
+				dryRunDiffs = append(dryRunDiffs, fmt.Sprintf("%s=%s", key, orNone(dryRunResources[key])))
+			}
+		}
+		addDiff(dryRunFieldResources, strings.Join(activeDiffs, ","), strings.Join(dryRunDiffs, ","))
+	}
+	addDiff(dryRunFieldConditions, conditionTypes(active.Conditions), conditionTypes(dryRun.Conditions))
+	return diffs
+}
+
+// resourceStatuses returns the sync status, and optionally the health, of the resources by their key
+func resourceStatuses(resources []appv1.ResourceStatus, withHealth bool) map[string]string {
+	statuses := map[string]string{}
+	for _, res := range resources {
+		status := string(res.Status)
+		if withHealth && res.Health != nil {
+			status = fmt.Sprintf("%s/%s", status, res.Health.Status)
+		}
+		statuses[resourceStatusKey(res)] = status
+	}
+	return statuses
+}
+
+func unionKeys(a, b map[string]string) []string {
+	var keys []string
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func orNone(status string) string {
+	if status == "" {
+		return "<none>"
+	}
+	return status
+}
+
+func conditionTypes(conditions []appv1.ApplicationCondition) string {
+	var types []string
+	for _, condition := range conditions {
+		types = append(types, condition.Type)
+	}
+	sort.Strings(types)
+	return strings.Join(types, ",")
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestDiffDryRunStatus(t *testing.T) {
+	active := &argoappv1.ApplicationStatus{
+		Sync:   argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced, Revision: "abc"},
+		Health: argoappv1.HealthStatus{Status: health.HealthStatusHealthy},
+		Resources: []argoappv1.ResourceStatus{
+			{Kind: "ConfigMap", Name: "a", Status: argoappv1.SyncStatusCodeSynced, Health: &argoappv1.HealthStatus{Status: health.HealthStatusHealthy}},
+			{Kind: "ConfigMap", Name: "b", Status: argoappv1.SyncStatusCodeSynced},
+		},
+		Conditions: []argoappv1.ApplicationCondition{{Type: argoappv1.ApplicationConditionSyncError, Message: "failed"}},
+	}
+	assert.Empty(t, diffDryRunStatus(active, active.DeepCopy()))
+
+	dryRun := active.DeepCopy()
+	dryRun.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	dryRun.Resources[0].Health = &argoappv1.HealthStatus{Status: health.HealthStatusDegraded}
+	dryRun.Resources = append(dryRun.Resources[:1], argoappv1.ResourceStatus{Kind: "ConfigMap", Name: "c", Status: argoappv1.SyncStatusCodeOutOfSync})
+	dryRun.Conditions[0].Message = "failed again"
+	assert.Equal(t, []dryRunDiff{
+		{field: dryRunFieldSyncStatus, active: "Synced", dryRun: "OutOfSync"},
+		{field: dryRunFieldResources, active: "/ConfigMap//a=Synced/Healthy,/ConfigMap//b=Synced,/ConfigMap//c=<none>", dryRun: "/ConfigMap//a=Synced/Degraded,/ConfigMap//b=<none>,/ConfigMap//c=OutOfSync"},
+	}, diffDryRunStatus(active, dryRun))
+
+	t.Run("HealthInTree", func(t *testing.T) {
+		dryRun := active.DeepCopy()
+		dryRun.ResourceHealthSource = argoappv1.ResourceHealthLocationAppTree
+		dryRun.Resources[0].Health = nil
+		assert.Empty(t, diffDryRunStatus(active, dryRun))
+	})
+
+	t.Run("ResourcesInCache", func(t *testing.T) {
+		dryRun := active.DeepCopy()
+		dryRun.ResourcesSource = argoappv1.ResourceStatusLocationCache
+		dryRun.Resources = nil
+		assert.Empty(t, diffDryRunStatus(active, dryRun))
+	})
+}
+
+func TestPersistAppStatus_DryRun(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.SetDryRun(true)
+	patches := recordPatches(t, ctrl)
+
+	newStatus := app.Status.DeepCopy()
+	newStatus.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	ctrl.persistAppStatus(app, newStatus)
+
+	assert.Empty(t, *patches)
+}
+
+func TestActiveControllerReconciled(t *testing.T) {
+	old := newFakeApp()
+	old.Status.ReconciledAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	assert.False(t, activeControllerReconciled(old, old.DeepCopy()))
+
+	reconciled := old.DeepCopy()
+	reconciled.Status.ReconciledAt = &metav1.Time{Time: time.Now()}
+	assert.True(t, activeControllerReconciled(old, reconciled))
+}
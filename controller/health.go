@@ -1,19 +1,28 @@
 package controller
 
 import (
+	"fmt"
+	"sort"
+
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	hookutil "github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 )
 
+// iterateHierarchyFunc iterates over a live resource and its descendants in the resource tree
+type iterateHierarchyFunc func(key kubeutil.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error
+
 // setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (*appv1.HealthStatus, error) {
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool, iterateHierarchy iterateHierarchyFunc, relatedResources lua.RelatedResourcesGetter) (*appv1.HealthStatus, error) {
 	var savedErr error
 	appHealth := appv1.HealthStatus{Status: health.HealthStatusHealthy}
 	for i, res := range resources {
@@ -36,7 +45,15 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 			if isSelfReferencedApp(app, kubeutil.GetObjectRef(res.Live)) {
 				continue
 			}
-			healthStatus, err = health.GetResourceHealth(res.Live, healthOverrides)
+			if aggregation := resourceOverrides[lua.GetConfigMapKey(gvk)].HealthAggregation; aggregation != nil && iterateHierarchy != nil {
+				healthStatus, err = getAggregatedHealth(aggregation, kubeutil.GetResourceKey(res.Live), iterateHierarchy)
+			} else {
+				var healthOverride health.HealthOverride = healthOverrides
+				if relatedResources != nil {
+					healthOverride = healthOverrides.WithRelatedResources(relatedResources)
+				}
+				healthStatus, err = health.GetResourceHealth(res.Live, healthOverride)
+			}
 			if err != nil && savedErr == nil {
 				savedErr = err
 			}
@@ -74,3 +91,170 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 	}
 	return &appHealth, savedErr
 }
+
+// getAggregatedHealth returns the health of a resource aggregated from the health of the child resources it owns
+func getAggregatedHealth(aggregation *appv1.HealthAggregation, key kubeutil.ResourceKey, iterateHierarchy iterateHierarchyFunc) (*health.HealthStatus, error) {
+	var nodes []appv1.ResourceNode
+	err := iterateHierarchy(key, func(child appv1.ResourceNode, _ string) bool {
+		nodes = append(nodes, child)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting the children of %s: %w", key.String(), err)
+	}
+	res, err := aggregateChildrenHealth(aggregation, key, nodes)
+	if err != nil {
+		return nil, fmt.Errorf("error aggregating the health of %s: %w", key.String(), err)
+	}
+	return &health.HealthStatus{Status: res.Status, Message: res.Message}, nil
+}
+
+// aggregateChildrenHealth returns the health of the given parent resource aggregated from the health of its direct
+// children among the given nodes. Children without health are ignored.
+func aggregateChildrenHealth(aggregation *appv1.HealthAggregation, parent kubeutil.ResourceKey, nodes []appv1.ResourceNode) (*appv1.HealthStatus, error) {
+	var children []*appv1.HealthStatus
+	for _, node := range nodes {
+		if node.Health == nil {
+			continue
+		}
+		for _, ref := range node.ParentRefs {
+			if kubeutil.NewResourceKey(ref.Group, ref.Kind, ref.Namespace, ref.Name) == parent {
+				children = append(children, node.Health)
+				break
+			}
+		}
+	}
+	if len(children) == 0 {
+		return &appv1.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for child resources"}, nil
+	}
+
+	counts := make(map[health.HealthStatusCode]int)
+	worst := health.HealthStatusHealthy
+	for _, child := range children {
+		counts[child.Status]++
+		if health.IsWorse(worst, child.Status) {
+			worst = child.Status
+		}
+	}
+	message := fmt.Sprintf("%d of %d child resources are healthy", counts[health.HealthStatusHealthy], len(children))
+
+	switch aggregation.Strategy {
+	case appv1.HealthAggregationStrategyWorst:
+		if worst == health.HealthStatusHealthy {
+			message = ""
+		}
+		return &appv1.HealthStatus{Status: worst, Message: message}, nil
+	case appv1.HealthAggregationStrategyQuorum:
+		quorum, err := aggregation.GetQuorum(len(children))
+		if err != nil {
+			return nil, err
+		}
+		message = fmt.Sprintf("%s, %d required", message, quorum)
+		switch {
+		case counts[health.HealthStatusHealthy] >= quorum:
+			return &appv1.HealthStatus{Status: health.HealthStatusHealthy, Message: message}, nil
+		case counts[health.HealthStatusHealthy]+counts[health.HealthStatusProgressing] >= quorum:
+			return &appv1.HealthStatus{Status: health.HealthStatusProgressing, Message: message}, nil
+		default:
+			return &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: message}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported health aggregation strategy '%s'", aggregation.Strategy)
+	}
+}
+
+// setAggregatedNodeHealth sets the health of the node of the given parent resource, among the given nodes of its
+// hierarchy, to the health aggregated from its children
+func setAggregatedNodeHealth(aggregation *appv1.HealthAggregation, parent kubeutil.ResourceKey, nodes []appv1.ResourceNode) {
+	for i := range nodes {
+		if kubeutil.NewResourceKey(nodes[i].Group, nodes[i].Kind, nodes[i].Namespace, nodes[i].Name) != parent {
+			continue
+		}
+		res, err := aggregateChildrenHealth(aggregation, parent, nodes)
+		if err != nil {
+			res = &appv1.HealthStatus{Status: health.HealthStatusUnknown, Message: err.Error()}
+		}
+		nodes[i].Health = res
+		return
+	}
+}
+
+// clusterRelatedResources gives the health scripts access to the resources related to a resource, from the cache of
+// its cluster
+type clusterRelatedResources struct {
+	getClusterCache func() (clustercache.ClusterCache, error)
+}
+
+func (r *clusterRelatedResources) GetOwnedResources(obj *unstructured.Unstructured) ([]lua.RelatedResource, error) {
+	uid := obj.GetUID()
+	return r.findResources(obj.GetNamespace(), func(res *clustercache.Resource) bool {
+		for _, ref := range res.OwnerRefs {
+			if ref.UID == uid {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (r *clusterRelatedResources) GetResourcesByLabels(obj *unstructured.Unstructured, group string, kind string, labels map[string]string) ([]lua.RelatedResource, error) {
+	return r.findResources(obj.GetNamespace(), func(res *clustercache.Resource) bool {
+		if res.ResourceKey().Group != group || res.Ref.Kind != kind {
+			return false
+		}
+		info, ok := res.Info.(*statecache.ResourceInfo)
+		if !ok {
+			return false
+		}
+		for k, v := range labels {
+			if value, ok := info.Labels[k]; !ok || value != v {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (r *clusterRelatedResources) findResources(namespace string, predicate func(res *clustercache.Resource) bool) ([]lua.RelatedResource, error) {
+	clusterCache, err := r.getClusterCache()
+	if err != nil {
+		return nil, err
+	}
+	found := clusterCache.FindResources(namespace, predicate)
+	keys := make([]kubeutil.ResourceKey, 0, len(found))
+	for key := range found {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	related := make([]lua.RelatedResource, 0, len(keys))
+	for _, key := range keys {
+		res := found[key]
+		info, _ := res.Info.(*statecache.ResourceInfo)
+		obj := res.Resource
+		if obj == nil {
+			// only the manifests of the managed resources are cached
+			obj = &unstructured.Unstructured{}
+			obj.SetAPIVersion(res.Ref.APIVersion)
+			obj.SetKind(res.Ref.Kind)
+			obj.SetNamespace(res.Ref.Namespace)
+			obj.SetName(res.Ref.Name)
+			obj.SetUID(res.Ref.UID)
+			obj.SetResourceVersion(res.ResourceVersion)
+			obj.SetOwnerReferences(res.OwnerRefs)
+			if res.CreationTimestamp != nil {
+				obj.SetCreationTimestamp(*res.CreationTimestamp)
+			}
+			if info != nil {
+				obj.SetLabels(info.Labels)
+			}
+		}
+		relatedResource := lua.RelatedResource{Object: obj}
+		if info != nil {
+			relatedResource.Health = info.Health
+		}
+		related = append(related, relatedResource)
+	}
+	return related, nil
+}
@@ -4,15 +4,21 @@ import (
 	"os"
 	"testing"
 
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
+	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/lua"
@@ -51,7 +57,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 
@@ -60,7 +66,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, true, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 }
@@ -73,7 +79,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 
@@ -87,7 +93,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 		Group: "", Version: "v1", Kind: "Pod", Target: &pod}, {}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
 }
@@ -100,7 +106,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 	resourceStatuses := initStatuses(resources)
 
 	t.Run("NoOverride", func(t *testing.T) {
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 		assert.Equal(t, resourceStatuses[0].Health.Status, health.HealthStatusMissing)
@@ -111,7 +117,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 			lua.GetConfigMapKey(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}): appv1.ResourceOverride{
 				HealthLua: "some health check",
 			},
-		}, app, true)
+		}, app, true, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
 	})
@@ -161,7 +167,7 @@ return hs`,
 			Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Live: degradedApp}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 	})
@@ -172,8 +178,150 @@ return hs`,
 			Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Live: degradedApp}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, nil, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 	})
 }
+
+func TestAggregateChildrenHealth(t *testing.T) {
+	parent := kube.NewResourceKey("example.com", "Umbrella", "default", "umbrella")
+	newNode := func(name string, status health.HealthStatusCode) appv1.ResourceNode {
+		return appv1.ResourceNode{
+			ResourceRef: appv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: name},
+			ParentRefs:  []appv1.ResourceRef{{Group: "example.com", Kind: "Umbrella", Namespace: "default", Name: "umbrella"}},
+			Health:      &appv1.HealthStatus{Status: status},
+		}
+	}
+	nodes := []appv1.ResourceNode{
+		{ResourceRef: appv1.ResourceRef{Group: "example.com", Kind: "Umbrella", Namespace: "default", Name: "umbrella"}},
+		newNode("a", health.HealthStatusHealthy),
+		newNode("b", health.HealthStatusHealthy),
+		newNode("c", health.HealthStatusDegraded),
+		// grandchildren are not aggregated
+		{
+			ResourceRef: appv1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "c-1"},
+			ParentRefs:  []appv1.ResourceRef{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "c"}},
+			Health:      &appv1.HealthStatus{Status: health.HealthStatusUnknown},
+		},
+	}
+
+	t.Run("Worst", func(t *testing.T) {
+		res, err := aggregateChildrenHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyWorst}, parent, nodes)
+		assert.NoError(t, err)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "2 of 3 child resources are healthy"}, res)
+
+		res, err = aggregateChildrenHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyWorst}, parent, nodes[:3])
+		assert.NoError(t, err)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusHealthy}, res)
+	})
+
+	t.Run("Quorum", func(t *testing.T) {
+		res, err := aggregateChildrenHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyQuorum, Quorum: "2"}, parent, nodes)
+		assert.NoError(t, err)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusHealthy, Message: "2 of 3 child resources are healthy, 2 required"}, res)
+
+		res, err = aggregateChildrenHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyQuorum, Quorum: "100%"}, parent, nodes)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, res.Status)
+
+		progressing := append(append([]appv1.ResourceNode{}, nodes[:3]...), newNode("c", health.HealthStatusProgressing))
+		res, err = aggregateChildrenHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyQuorum, Quorum: "100%"}, parent, progressing)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, res.Status)
+	})
+
+	t.Run("NoChildren", func(t *testing.T) {
+		res, err := aggregateChildrenHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyWorst}, parent, nodes[:1])
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, res.Status)
+	})
+
+	t.Run("SetApplicationHealth", func(t *testing.T) {
+		umbrella := &unstructured.Unstructured{}
+		umbrella.SetAPIVersion("example.com/v1")
+		umbrella.SetKind("Umbrella")
+		umbrella.SetNamespace("default")
+		umbrella.SetName("umbrella")
+		resources := []managedResource{{Group: "example.com", Version: "v1", Kind: "Umbrella", Namespace: "default", Name: "umbrella", Live: umbrella}}
+		resourceStatuses := initStatuses(resources)
+		overrides := map[string]appv1.ResourceOverride{
+			"example.com/Umbrella": {HealthAggregation: &appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyWorst}},
+		}
+
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true, func(key kube.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error {
+			assert.Equal(t, parent, key)
+			for _, node := range nodes {
+				action(node, "")
+			}
+			return nil
+		}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Equal(t, "2 of 3 child resources are healthy", resourceStatuses[0].Health.Message)
+	})
+
+	t.Run("SetAggregatedNodeHealth", func(t *testing.T) {
+		tree := append([]appv1.ResourceNode{}, nodes...)
+		setAggregatedNodeHealth(&appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyWorst}, parent, tree)
+		assert.Equal(t, health.HealthStatusDegraded, tree[0].Health.Status)
+	})
+}
+
+func TestClusterRelatedResources(t *testing.T) {
+	owner := &unstructured.Unstructured{}
+	owner.SetAPIVersion("apps/v1")
+	owner.SetKind(kube.ReplicaSetKind)
+	owner.SetNamespace("default")
+	owner.SetName("my-rs")
+	owner.SetUID("rs-uid")
+
+	newPod := func(name string, ownerUID types.UID, labels map[string]string) *clustercache.Resource {
+		return &clustercache.Resource{
+			Ref:       corev1.ObjectReference{APIVersion: "v1", Kind: kube.PodKind, Namespace: "default", Name: name, UID: types.UID(name)},
+			OwnerRefs: []metav1.OwnerReference{{UID: ownerUID}},
+			Info:      &statecache.ResourceInfo{Labels: labels, Health: &health.HealthStatus{Status: health.HealthStatusHealthy}},
+		}
+	}
+	resources := []*clustercache.Resource{
+		newPod("pod-b", "rs-uid", map[string]string{"app": "my-app"}),
+		newPod("pod-a", "rs-uid", map[string]string{"app": "my-app", "tier": "web"}),
+		newPod("pod-c", "other-uid", map[string]string{"app": "other"}),
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("FindResources", "default", mock.Anything).Return(func(_ string, predicates ...func(*clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+		found := make(map[kube.ResourceKey]*clustercache.Resource)
+		for _, res := range resources {
+			if predicates[0](res) {
+				found[res.ResourceKey()] = res
+			}
+		}
+		return found
+	})
+	relatedResources := &clusterRelatedResources{getClusterCache: func() (clustercache.ClusterCache, error) {
+		return clusterCache, nil
+	}}
+
+	t.Run("OwnedResources", func(t *testing.T) {
+		related, err := relatedResources.GetOwnedResources(owner)
+		assert.NoError(t, err)
+		if assert.Len(t, related, 2) {
+			assert.Equal(t, "pod-a", related[0].Object.GetName())
+			assert.Equal(t, "pod-b", related[1].Object.GetName())
+			assert.Equal(t, map[string]string{"app": "my-app"}, related[1].Object.GetLabels())
+			assert.Equal(t, health.HealthStatusHealthy, related[1].Health.Status)
+		}
+	})
+
+	t.Run("ResourcesByLabels", func(t *testing.T) {
+		related, err := relatedResources.GetResourcesByLabels(owner, "", kube.PodKind, map[string]string{"tier": "web"})
+		assert.NoError(t, err)
+		if assert.Len(t, related, 1) {
+			assert.Equal(t, "pod-a", related[0].Object.GetName())
+		}
+
+		related, err = relatedResources.GetResourcesByLabels(owner, "apps", kube.PodKind, map[string]string{"tier": "web"})
+		assert.NoError(t, err)
+		assert.Empty(t, related)
+	})
+}
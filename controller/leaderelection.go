@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+// LeaderElectionConfig holds the timings of the election of the replica running the controller among the replicas
+// of a shard
+type LeaderElectionConfig struct {
+	// LeaseDuration is the duration standby replicas wait before taking over a lease which was not renewed
+	LeaseDuration time.Duration
+	// RenewDeadline is the duration the leader retries renewing the lease before giving up the leadership
+	RenewDeadline time.Duration
+	// RetryPeriod is the interval between two attempts to acquire or renew the lease
+	RetryPeriod time.Duration
+}
+
+// LeaseName returns the name of the lease held by the leader of the given shard, or by the leader of all the replicas
+// if sharding is disabled
+func LeaseName(shard int) string {
+	if shard < 0 {
+		return common.InstanceResourceName("argocd-application-controller")
+	}
+	return common.InstanceResourceName(fmt.Sprintf("argocd-application-controller-shard-%d", shard))
+}
+
+// RunWithLeaderElection calls run once the given lock is acquired, so that multiple replicas of a shard can run as
+// hot standbys of its leader. The metrics server is started right away, standby replicas therefore report ready and
+// expose the leadership metrics. RunWithLeaderElection returns once the leadership is lost or the context is done, in
+// which case the lock is released so that a standby replica takes over without waiting for the lease to expire.
+func (ctrl *ApplicationController) RunWithLeaderElection(ctx context.Context, lock resourcelock.Interface, config LeaderElectionConfig, run func(ctx context.Context)) error {
+	lease := lock.Describe()
+	identity := lock.Identity()
+	ctrl.startMetricsServer(ctx)
+	ctrl.metricsServer.SetLeader(lease, false)
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   config.LeaseDuration,
+		RenewDeadline:   config.RenewDeadline,
+		RetryPeriod:     config.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            lease,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Acquired lease %s, starting the controller", lease)
+				ctrl.metricsServer.SetLeader(lease, true)
+				run(ctx)
+			},
+			OnStoppedLeading: func() {
+				log.Infof("Stopped leading lease %s", lease)
+				ctrl.metricsServer.SetLeader(lease, false)
+			},
+			OnNewLeader: func(leader string) {
+				ctrl.metricsServer.IncLeaderChanges(lease)
+				if leader != identity {
+					log.Infof("Replica %s is the leader of lease %s, standing by", leader, lease)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating leader elector: %w", err)
+	}
+	elector.Run(ctx)
+	return nil
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func TestLeaseName(t *testing.T) {
+	assert.Equal(t, "argocd-application-controller", LeaseName(-1))
+	assert.Equal(t, "argocd-application-controller-shard-0", LeaseName(0))
+	assert.Equal(t, "argocd-application-controller-shard-2", LeaseName(2))
+}
+
+func TestRunWithLeaderElection(t *testing.T) {
+	ctrl := newFakeController(&fakeData{})
+	// do not listen on the metrics port
+	ctrl.metricsServerOnce.Do(func() {})
+
+	kubeClient := fake.NewSimpleClientset()
+	newLock := func(identity string) resourcelock.Interface {
+		return &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Name: LeaseName(0), Namespace: test.FakeArgoCDNamespace},
+			Client:     kubeClient.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		}
+	}
+	config := LeaderElectionConfig{LeaseDuration: 10 * time.Second, RenewDeadline: 5 * time.Second, RetryPeriod: 100 * time.Millisecond}
+
+	leading := make(chan string, 2)
+	runReplica := func(ctx context.Context, identity string) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- ctrl.RunWithLeaderElection(ctx, newLock(identity), config, func(ctx context.Context) {
+				leading <- identity
+				<-ctx.Done()
+			})
+		}()
+		return done
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := runReplica(leaderCtx, "replica-0")
+	select {
+	case identity := <-leading:
+		assert.Equal(t, "replica-0", identity)
+	case <-time.After(5 * time.Second):
+		t.Fatal("replica-0 did not acquire the lease")
+	}
+
+	standbyCtx, cancelStandby := context.WithCancel(context.Background())
+	defer cancelStandby()
+	standbyDone := runReplica(standbyCtx, "replica-1")
+	select {
+	case identity := <-leading:
+		t.Fatalf("%s acquired the lease held by replica-0", identity)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// the lease is released when the leader stops, the standby takes over before the lease expires
+	cancelLeader()
+	require.NoError(t, <-leaderDone)
+	select {
+	case identity := <-leading:
+		assert.Equal(t, "replica-1", identity)
+	case <-time.After(5 * time.Second):
+		t.Fatal("replica-1 did not take over the lease")
+	}
+
+	cancelStandby()
+	require.NoError(t, <-standbyDone)
+}
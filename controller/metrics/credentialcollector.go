@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/argoproj/argo-cd/v2/util/db"
+)
+
+var (
+	descCredentialDefaultLabels = []string{"owner_kind", "owner", "type"}
+
+	descCredentialExpiryTimestamp = prometheus.NewDesc(
+		"argocd_credential_expiry_timestamp_seconds",
+		"Time at which a credential of a repository or cluster expires, in seconds since the epoch.",
+		descCredentialDefaultLabels,
+		nil,
+	)
+	descCredentialIssuedTimestamp = prometheus.NewDesc(
+		"argocd_credential_issued_timestamp_seconds",
+		"Time at which a credential of a repository or cluster was issued or last updated, in seconds since the epoch.",
+		descCredentialDefaultLabels,
+		nil,
+	)
+)
+
+// HasCredentialExpiries is a source of the expiries of the credentials of the repositories and clusters
+type HasCredentialExpiries interface {
+	GetCredentialExpiries() []db.CredentialExpiry
+}
+
+type credentialCollector struct {
+	source HasCredentialExpiries
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *credentialCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descCredentialExpiryTimestamp
+	ch <- descCredentialIssuedTimestamp
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *credentialCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, expiry := range c.source.GetCredentialExpiries() {
+		labels := []string{expiry.OwnerKind, expiry.Owner, string(expiry.Type)}
+		if expiry.ExpiresAt != nil {
+			ch <- prometheus.MustNewConstMetric(descCredentialExpiryTimestamp, prometheus.GaugeValue, float64(expiry.ExpiresAt.Unix()), labels...)
+		}
+		if expiry.IssuedAt != nil {
+			ch <- prometheus.MustNewConstMetric(descCredentialIssuedTimestamp, prometheus.GaugeValue, float64(expiry.IssuedAt.Unix()), labels...)
+		}
+	}
+}
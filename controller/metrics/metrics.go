@@ -13,6 +13,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
@@ -21,11 +22,13 @@ import (
 	applister "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 	"github.com/argoproj/argo-cd/v2/util/profile"
 )
 
 type MetricsServer struct {
 	*http.Server
+	mux                     *http.ServeMux
 	syncCounter             *prometheus.CounterVec
 	kubectlExecCounter      *prometheus.CounterVec
 	kubectlExecPendingGauge *prometheus.GaugeVec
@@ -34,9 +37,21 @@ type MetricsServer struct {
 	redisRequestCounter     *prometheus.CounterVec
 	reconcileHistogram      *prometheus.HistogramVec
 	redisRequestHistogram   *prometheus.HistogramVec
+	settingsGenerationGauge *prometheus.GaugeVec
+	cappedResourcesGauge    *prometheus.GaugeVec
+	leaderGauge             *prometheus.GaugeVec
+	leaderChangesCounter    *prometheus.CounterVec
+	dryRunDiffGauge         *prometheus.GaugeVec
+	dependencyReadyGauge    *prometheus.GaugeVec
 	registry                *prometheus.Registry
 	hostname                string
 	cron                    *cron.Cron
+	// projects restricts the exported series of the applications to the projects matching these patterns
+	projects []string
+	// dropLabels designates the labels dropped from the exported series
+	dropLabels []metricsutil.LabelRule
+	// dependencyChecker reports the states of the dependencies of the controller to the health check endpoint
+	dependencyChecker *healthz.DependencyChecker
 }
 
 const (
@@ -138,6 +153,36 @@ var (
 		},
 		[]string{"hostname", "initiator"},
 	)
+
+	settingsGenerationGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_controller_settings_generation",
+		Help: "Generation of the settings applied by the application controller, incremented on every settings change.",
+	}, []string{"hostname"})
+
+	cappedResourcesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_cluster_cache_capped_resources",
+		Help: "Number of k8s resources of a kind observed when the kind exceeded the cluster cache limit and stopped being cached.",
+	}, append(descClusterDefaultLabels, "group", "kind"))
+
+	leaderGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_controller_leader",
+		Help: "Whether the application controller replica holds the leader election lease (1) or is a standby (0).",
+	}, []string{"hostname", "lease"})
+
+	leaderChangesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_app_controller_leader_changes_total",
+		Help: "Number of leader changes of the leader election lease observed by the application controller replica.",
+	}, []string{"hostname", "lease"})
+
+	dryRunDiffGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_dry_run_diff",
+		Help: "Whether the status of the application computed by the dry run controller differs from the status persisted by the active controller (1) or not (0).",
+	}, append(descAppDefaultLabels, "field"))
+
+	dependencyReadyGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_dependency_ready",
+		Help: "Whether a dependency of the application controller was ready at its last check (1) or not (0).",
+	}, []string{"dependency", "requirement"})
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -160,14 +205,22 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	mux := http.NewServeMux()
 	registry := NewAppRegistry(appLister, appFilter, appLabels)
 	registry.MustRegister(depth, adds, latency, workDuration, unfinished, longestRunningProcessor, retries)
-	mux.Handle(MetricsPath, promhttp.HandlerFor(prometheus.Gatherers{
+	m := &MetricsServer{}
+	gatherers := prometheus.Gatherers{
 		// contains app controller specific metrics
 		registry,
 		// contains process, golang and controller workqueues metrics
 		prometheus.DefaultGatherer,
-	}, promhttp.HandlerOpts{}))
+	}
+	mux.Handle(MetricsPath, promhttp.HandlerFor(prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := gatherers.Gather()
+		return metricsutil.DropLabels(metricsutil.FilterProjects(families, m.projects), m.dropLabels), err
+	}), promhttp.HandlerOpts{}))
 	profile.RegisterProfiler(mux)
-	healthz.ServeHealthCheck(mux, healthCheck)
+	// the dependency checker is set after the metrics server is created, see SetDependencyChecker
+	mux.HandleFunc(healthz.HealthPath, func(w http.ResponseWriter, r *http.Request) {
+		healthz.DependencyHealthCheckHandler(healthCheck, m.dependencyChecker)(w, r)
+	})
 
 	registry.MustRegister(syncCounter)
 	registry.MustRegister(k8sRequestCounter)
@@ -177,13 +230,20 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(clusterEventsCounter)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
-
-	return &MetricsServer{
+	registry.MustRegister(settingsGenerationGauge)
+	registry.MustRegister(cappedResourcesGauge)
+	registry.MustRegister(leaderGauge)
+	registry.MustRegister(leaderChangesCounter)
+	registry.MustRegister(dryRunDiffGauge)
+	registry.MustRegister(dependencyReadyGauge)
+
+	*m = MetricsServer{
 		registry: registry,
 		Server: &http.Server{
 			Addr:    addr,
 			Handler: mux,
 		},
+		mux:                     mux,
 		syncCounter:             syncCounter,
 		k8sRequestCounter:       k8sRequestCounter,
 		kubectlExecCounter:      kubectlExecCounter,
@@ -192,12 +252,19 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		clusterEventsCounter:    clusterEventsCounter,
 		redisRequestCounter:     redisRequestCounter,
 		redisRequestHistogram:   redisRequestHistogram,
+		settingsGenerationGauge: settingsGenerationGauge,
+		cappedResourcesGauge:    cappedResourcesGauge,
+		leaderGauge:             leaderGauge,
+		leaderChangesCounter:    leaderChangesCounter,
+		dryRunDiffGauge:         dryRunDiffGauge,
+		dependencyReadyGauge:    dependencyReadyGauge,
 		hostname:                hostname,
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
 		// so there is no possibility of panic, but we will add a chain to keep robfig/cron v1 behavior.
 		cron: cron.New(cron.WithChain(cron.Recover(cron.PrintfLogger(log.StandardLogger())))),
-	}, nil
+	}
+	return m, nil
 }
 
 // Prometheus invalid labels, more info: https://prometheus.io/docs/concepts/data_model/#metric-names-and-labels.
@@ -214,12 +281,58 @@ func normalizeLabels(prefix string, appLabels []string) []string {
 	return results
 }
 
+// SetAuth requires the requests made to the metrics server to be authorized as configured by the given options,
+// except the health checks
+func (m *MetricsServer) SetAuth(auth *metricsutil.AuthOptions) error {
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		return err
+	}
+	m.TLSConfig = tlsConfig
+	m.Handler = auth.Handler(m.mux, healthz.HealthPath)
+	return nil
+}
+
+// SetProjects restricts the exported series of the applications to the projects matching the given patterns
+func (m *MetricsServer) SetProjects(projects []string) {
+	m.projects = projects
+}
+
+// SetDropLabels drops the labels designated by the given rules from the exported series, aggregating the series left
+// with the same labels
+func (m *MetricsServer) SetDropLabels(rules []metricsutil.LabelRule) {
+	m.dropLabels = rules
+}
+
+// SetDependencyChecker reports the states of the dependencies checked by the given checker to the health check
+// endpoint and as metrics
+func (m *MetricsServer) SetDependencyChecker(checker *healthz.DependencyChecker) {
+	m.dependencyChecker = checker
+	checker.SetStateObserver(func(state healthz.DependencyState) {
+		ready := 0.0
+		if state.Ready {
+			ready = 1
+		}
+		m.dependencyReadyGauge.WithLabelValues(state.Name, string(state.Requirement)).Set(ready)
+	})
+}
+
+// RegisterHandler serves the given handler at the given path in addition to the metrics
+func (m *MetricsServer) RegisterHandler(path string, handler http.Handler) {
+	m.mux.Handle(path, handler)
+}
+
 func (m *MetricsServer) RegisterClustersInfoSource(ctx context.Context, source HasClustersInfo) {
 	collector := &clusterCollector{infoSource: source}
 	go collector.Run(ctx)
 	m.registry.MustRegister(collector)
 }
 
+// RegisterCredentialExpiriesSource exposes the expiries of the credentials of the given source
+func (m *MetricsServer) RegisterCredentialExpiriesSource(source HasCredentialExpiries) {
+	m.registry.MustRegister(&credentialCollector{source: source})
+}
+
 // IncSync increments the sync counter for an application
 func (m *MetricsServer) IncSync(app *argoappv1.Application, state *argoappv1.OperationState) {
 	if !state.Phase.Completed() {
@@ -273,6 +386,37 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
 }
 
+// SetSettingsGeneration sets the generation of the settings applied by the controller
+func (m *MetricsServer) SetSettingsGeneration(generation int64) {
+	m.settingsGenerationGauge.WithLabelValues(m.hostname).Set(float64(generation))
+}
+
+// SetCappedResourcesCount sets the number of resources of a kind observed when the kind exceeded the cluster cache limit
+func (m *MetricsServer) SetCappedResourcesCount(server, group, kind string, count int) {
+	m.cappedResourcesGauge.WithLabelValues(server, group, kind).Set(float64(count))
+}
+
+// ResetCappedResourcesCount removes the capped resources counts of the given cluster
+func (m *MetricsServer) ResetCappedResourcesCount(server string) {
+	m.cappedResourcesGauge.DeletePartialMatch(prometheus.Labels{"server": server})
+}
+
+// SetLeader sets whether the controller holds the given leader election lease
+func (m *MetricsServer) SetLeader(lease string, leader bool) {
+	m.leaderGauge.WithLabelValues(m.hostname, lease).Set(boolFloat64(leader))
+}
+
+// IncLeaderChanges increments the number of leader changes observed for the given leader election lease
+func (m *MetricsServer) IncLeaderChanges(lease string) {
+	m.leaderChangesCounter.WithLabelValues(m.hostname, lease).Inc()
+}
+
+// SetDryRunDiff sets whether a field of the status of the application computed by the dry run controller differs from
+// the status persisted by the active controller
+func (m *MetricsServer) SetDryRunDiff(app *argoappv1.Application, field string, differs bool) {
+	m.dryRunDiffGauge.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), field).Set(boolFloat64(differs))
+}
+
 // HasExpiration return true if expiration is set
 func (m *MetricsServer) HasExpiration() bool {
 	return len(m.cron.Entries()) > 0
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -22,6 +23,7 @@ import (
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
 	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
 	applister "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 )
 
 const fakeApp = `
@@ -248,6 +250,42 @@ argocd_app_info{dest_namespace="dummy-namespace",dest_server="https://localhost:
 	}
 }
 
+func TestMetricsProjects(t *testing.T) {
+	cancel, appLister := newFakeLister(fakeApp2, fakeDefaultApp)
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	assert.NoError(t, err)
+	metricsServ.SetProjects([]string{"default"})
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assert.Contains(t, body, `project="default"`)
+	assert.NotContains(t, body, `project="important-project"`)
+}
+
+func TestMetricsDropLabels(t *testing.T) {
+	cancel, appLister := newFakeLister(fakeApp, fakeApp2)
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	assert.NoError(t, err)
+	metricsServ.SetDropLabels([]metricsutil.LabelRule{{Metric: "argocd_app_*", Label: "name"}, {Metric: "argocd_app_info", Label: "operation"}})
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, `
+argocd_app_info{dest_namespace="dummy-namespace",dest_server="https://localhost:6443",health_status="Healthy",namespace="argocd",project="important-project",repo="https://github.com/argoproj/argocd-example-apps",sync_status="Synced"} 2
+`, body)
+	assert.NotContains(t, body, `name="my-app"`)
+}
+
 func TestMetricLabels(t *testing.T) {
 	type testCases struct {
 		testCombination
@@ -437,3 +475,47 @@ argocd_app_sync_total{dest_server="https://localhost:6443",name="my-app",namespa
 	err = metricsServ.SetExpiration(time.Second)
 	assert.Error(t, err)
 }
+
+func TestLeaderMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	assert.NoError(t, err)
+
+	metricsServ.SetLeader("argocd-application-controller-shard-0", true)
+	metricsServ.IncLeaderChanges("argocd-application-controller-shard-0")
+	metricsServ.IncLeaderChanges("argocd-application-controller-shard-0")
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, fmt.Sprintf(`
+argocd_app_controller_leader{hostname="%[1]s",lease="argocd-application-controller-shard-0"} 1
+argocd_app_controller_leader_changes_total{hostname="%[1]s",lease="argocd-application-controller-shard-0"} 2
+`, metricsServ.hostname), body)
+}
+
+func TestDryRunDiffMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{})
+	assert.NoError(t, err)
+
+	app := newFakeApp(fakeApp)
+	metricsServ.SetDryRunDiff(app, "sync_status", true)
+	metricsServ.SetDryRunDiff(app, "health_status", false)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, `
+argocd_app_dry_run_diff{field="health_status",name="my-app",namespace="argocd",project="important-project"} 0
+argocd_app_dry_run_diff{field="sync_status",name="my-app",namespace="argocd",project="important-project"} 1
+`, body)
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// operationJanitorInterval is the interval at which the controller looks for stale operations
+const operationJanitorInterval = time.Minute
+
+// isStaleOperation returns whether the operation of the application has been running or terminating for longer than
+// the given timeout, which happens if it was interrupted by a controller restart or its processing got stuck.
+func isStaleOperation(app *appv1.Application, timeout time.Duration, now time.Time) bool {
+	state := app.Status.OperationState
+	if state == nil || state.Phase.Completed() {
+		return false
+	}
+	return state.StartedAt.Add(timeout).Before(now)
+}
+
+// failStaleOperations marks the stale operations of the applications processed by the controller as failed
+func (ctrl *ApplicationController) failStaleOperations() {
+	now := time.Now()
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok || !ctrl.canProcessApp(app) || !isStaleOperation(app, ctrl.operationStaleTimeout, now) {
+			continue
+		}
+		app = app.DeepCopy()
+		state := app.Status.OperationState.DeepCopy()
+		log.WithField("application", app.QualifiedName()).Warnf("Operation started at %s is stale, marking it as failed", state.StartedAt)
+		state.Phase = synccommon.OperationFailed
+		state.Message = fmt.Sprintf("Operation did not complete within %v and was considered stale, likely because it was interrupted by a controller restart", ctrl.operationStaleTimeout)
+		ctrl.setOperationState(app, state)
+	}
+}
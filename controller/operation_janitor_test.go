@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+)
+
+func TestIsStaleOperation(t *testing.T) {
+	now := time.Now()
+	newApp := func(phase synccommon.OperationPhase, startedAt time.Time) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Status.OperationState = &v1alpha1.OperationState{Phase: phase, StartedAt: metav1.NewTime(startedAt)}
+		return app
+	}
+
+	assert.True(t, isStaleOperation(newApp(synccommon.OperationRunning, now.Add(-2*time.Hour)), time.Hour, now))
+	assert.True(t, isStaleOperation(newApp(synccommon.OperationTerminating, now.Add(-2*time.Hour)), time.Hour, now))
+	assert.False(t, isStaleOperation(newApp(synccommon.OperationRunning, now.Add(-time.Minute)), time.Hour, now))
+	assert.False(t, isStaleOperation(newApp(synccommon.OperationSucceeded, now.Add(-2*time.Hour)), time.Hour, now))
+	assert.False(t, isStaleOperation(newFakeApp(), time.Hour, now))
+
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	assert.False(t, isStaleOperation(app, time.Hour, now))
+}
+
+func TestFailStaleOperations(t *testing.T) {
+	stale := newFakeApp()
+	stale.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	stale.Status.OperationState = &v1alpha1.OperationState{
+		Operation: *stale.Operation,
+		Phase:     synccommon.OperationRunning,
+		StartedAt: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{stale, &defaultProj}})
+	ctrl.operationStaleTimeout = time.Hour
+
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patches []map[string]interface{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patch := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(action.(kubetesting.PatchAction).GetPatch(), &patch))
+		patches = append(patches, patch)
+		return true, nil, nil
+	})
+
+	ctrl.failStaleOperations()
+
+	require.Len(t, patches, 1)
+	assert.Contains(t, patches[0], "operation")
+	assert.Nil(t, patches[0]["operation"])
+	state := patches[0]["status"].(map[string]interface{})["operationState"].(map[string]interface{})
+	assert.Equal(t, string(synccommon.OperationFailed), state["phase"])
+	assert.Contains(t, state["message"], "considered stale")
+	assert.NotEmpty(t, state["finishedAt"])
+}
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// refreshAnnotationKeys are the annotations of a requested refresh, which are removed once the application is refreshed
+var refreshAnnotationKeys = []string{
+	appv1.AnnotationKeyRefresh,
+	appv1.AnnotationKeyRefreshDeadline,
+	appv1.AnnotationKeyRefreshDropped,
+}
+
+// RefreshRateLimits holds the maximum number of requested refreshes of each type started per second. The refresh
+// types without a limit are not rate limited.
+type RefreshRateLimits map[appv1.RefreshType]float64
+
+// ParseRefreshRateLimits parses rate limits of the form <refresh type>=<refreshes per second>, separated by commas,
+// e.g. hard=0.5,normal=10
+func ParseRefreshRateLimits(value string) (RefreshRateLimits, error) {
+	limits := RefreshRateLimits{}
+	for _, limit := range strings.Split(value, ",") {
+		limit = strings.TrimSpace(limit)
+		if limit == "" {
+			continue
+		}
+		parts := strings.SplitN(limit, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid refresh rate limit '%s': expected <refresh type>=<refreshes per second>", limit)
+		}
+		refreshType := appv1.RefreshType(strings.TrimSpace(parts[0]))
+		if appv1.ParseRefreshType(string(refreshType)) != refreshType {
+			return nil, fmt.Errorf("invalid refresh rate limit '%s': unknown refresh type '%s'", limit, refreshType)
+		}
+		qps, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || qps <= 0 {
+			return nil, fmt.Errorf("invalid refresh rate limit '%s': the number of refreshes per second must be positive", limit)
+		}
+		limits[refreshType] = qps
+	}
+	return limits, nil
+}
+
+// refreshReservation is the time at which the requested refresh of an application is allowed to start by the rate
+// limit of its type
+type refreshReservation struct {
+	reservation *rate.Reservation
+	startAt     time.Time
+}
+
+// SetRefreshRateLimits limits the number of requested refreshes of each type started per second. It must be called
+// before the controller runs.
+func (ctrl *ApplicationController) SetRefreshRateLimits(limits RefreshRateLimits) {
+	ctrl.refreshLimiters = map[appv1.RefreshType]*rate.Limiter{}
+	for refreshType, qps := range limits {
+		ctrl.refreshLimiters[refreshType] = rate.NewLimiter(rate.Limit(qps), int(math.Max(1, math.Ceil(qps))))
+	}
+}
+
+// throttleRequestedRefresh applies the rate limit of the type of the refresh requested for the given application, if
+// any. It returns the delay after which the refresh is allowed to start, or whether the refresh was dropped because it
+// cannot start before its deadline.
+func (ctrl *ApplicationController) throttleRequestedRefresh(app *appv1.Application) (time.Duration, bool) {
+	refreshType, requested := app.IsRefreshRequested()
+	if !requested {
+		return 0, false
+	}
+	key := app.QualifiedName()
+	now := time.Now()
+
+	ctrl.refreshReservationsMutex.Lock()
+	reservation, ok := ctrl.refreshReservations[key]
+	if !ok {
+		reservation = refreshReservation{startAt: now}
+		if limiter := ctrl.refreshLimiters[refreshType]; limiter != nil {
+			reservation.reservation = limiter.ReserveN(now, 1)
+			reservation.startAt = now.Add(reservation.reservation.DelayFrom(now))
+		}
+	}
+	deadline, hasDeadline := app.GetRefreshDeadline()
+	dropped := hasDeadline && reservation.startAt.After(deadline)
+	if dropped || !reservation.startAt.After(now) {
+		delete(ctrl.refreshReservations, key)
+	} else {
+		ctrl.refreshReservations[key] = reservation
+	}
+	ctrl.refreshReservationsMutex.Unlock()
+
+	if dropped {
+		if reservation.reservation != nil {
+			reservation.reservation.CancelAt(now)
+		}
+		ctrl.dropRequestedRefresh(app, refreshType, deadline)
+		return 0, true
+	}
+	return reservation.startAt.Sub(now), false
+}
+
+// dropRequestedRefresh removes the refresh requested for the given application, recording why it was dropped
+func (ctrl *ApplicationController) dropRequestedRefresh(app *appv1.Application, refreshType appv1.RefreshType, deadline time.Time) {
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName()})
+	reason := fmt.Sprintf("%s refresh dropped: it could not start before its deadline %s", refreshType, deadline.UTC().Format(time.RFC3339))
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				appv1.AnnotationKeyRefresh:         nil,
+				appv1.AnnotationKeyRefreshDeadline: nil,
+				appv1.AnnotationKeyRefreshDropped:  reason,
+			},
+		},
+	})
+	if err != nil {
+		logCtx.Errorf("Error constructing refresh annotations patch: %v", err)
+		return
+	}
+	appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if _, err := appClient.Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		logCtx.Errorf("Error dropping requested refresh: %v", err)
+		return
+	}
+	logCtx.Warn(reason)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonRefreshDropped, Type: v1.EventTypeWarning}, reason)
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestParseRefreshRateLimits(t *testing.T) {
+	limits, err := ParseRefreshRateLimits("hard=0.5, normal=10,comparison=100")
+	require.NoError(t, err)
+	assert.Equal(t, RefreshRateLimits{argoappv1.RefreshTypeHard: 0.5, argoappv1.RefreshTypeNormal: 10, argoappv1.RefreshTypeComparison: 100}, limits)
+
+	limits, err = ParseRefreshRateLimits("")
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	for _, value := range []string{"hard", "soft=1", "hard=0", "hard=-1", "normal=fast"} {
+		_, err := ParseRefreshRateLimits(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func newFakeAppWithRefresh(refreshType argoappv1.RefreshType, deadline *time.Time) *argoappv1.Application {
+	app := newFakeApp()
+	app.Annotations = map[string]string{argoappv1.AnnotationKeyRefresh: string(refreshType)}
+	if deadline != nil {
+		app.Annotations[argoappv1.AnnotationKeyRefreshDeadline] = deadline.UTC().Format(time.RFC3339)
+	}
+	return app
+}
+
+func TestThrottleRequestedRefresh(t *testing.T) {
+	app := newFakeAppWithRefresh(argoappv1.RefreshTypeHard, nil)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.SetRefreshRateLimits(RefreshRateLimits{argoappv1.RefreshTypeHard: 0.01})
+
+	delay, dropped := ctrl.throttleRequestedRefresh(app)
+	assert.False(t, dropped)
+	assert.Zero(t, delay)
+
+	// the burst of the limiter is exhausted, so the refresh of another application is delayed
+	other := newFakeAppWithRefresh(argoappv1.RefreshTypeHard, nil)
+	other.Name = "other"
+	delay, dropped = ctrl.throttleRequestedRefresh(other)
+	assert.False(t, dropped)
+	assert.Greater(t, delay, time.Minute)
+
+	// the reservation of the delayed application is kept when it is processed again
+	delay, _ = ctrl.throttleRequestedRefresh(other)
+	assert.Greater(t, delay, time.Minute)
+	assert.Len(t, ctrl.refreshReservations, 1)
+
+	// the refreshes of the types without a limit are not delayed
+	normal := newFakeAppWithRefresh(argoappv1.RefreshTypeNormal, nil)
+	normal.Name = "normal"
+	delay, dropped = ctrl.throttleRequestedRefresh(normal)
+	assert.False(t, dropped)
+	assert.Zero(t, delay)
+}
+
+func TestThrottleRequestedRefresh_Deadline(t *testing.T) {
+	deadline := time.Now().Add(time.Second)
+	app := newFakeAppWithRefresh(argoappv1.RefreshTypeHard, &deadline)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	ctrl.SetRefreshRateLimits(RefreshRateLimits{argoappv1.RefreshTypeHard: 0.01})
+	ctrl.refreshLimiters[argoappv1.RefreshTypeHard].Allow()
+
+	_, dropped := ctrl.throttleRequestedRefresh(app)
+	assert.True(t, dropped)
+	assert.Empty(t, ctrl.refreshReservations)
+
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, updated.Annotations, argoappv1.AnnotationKeyRefresh)
+	assert.NotContains(t, updated.Annotations, argoappv1.AnnotationKeyRefreshDeadline)
+	assert.Contains(t, updated.Annotations[argoappv1.AnnotationKeyRefreshDropped], "hard refresh dropped")
+}
+
+func TestNeedRefreshAppStatus_Comparison(t *testing.T) {
+	app := newFakeAppWithRefresh(argoappv1.RefreshTypeComparison, nil)
+	app.Status.ReconciledAt = &metav1.Time{Time: time.Now()}
+	app.Status.Sync.ComparedTo = argoappv1.ComparedTo{Source: app.Spec.GetSource(), Destination: app.Spec.Destination}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, time.Hour, time.Hour)
+	assert.True(t, needRefresh)
+	assert.Equal(t, argoappv1.RefreshTypeComparison, refreshType)
+	assert.Equal(t, CompareWithRecent, compareWith)
+}
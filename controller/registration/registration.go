@@ -0,0 +1,354 @@
+package registration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// Reconciler reconciles the repositories and clusters declared by RepositoryRegistration and ClusterRegistration
+// resources into the repository and cluster secrets Argo CD reads its configuration from
+type Reconciler struct {
+	namespace     string
+	kubeClientset kubernetes.Interface
+	appClientset  appclientset.Interface
+	db            db.ArgoDB
+	repoClientset apiclient.Clientset
+	kubectl       kube.Kubectl
+	policy        Policy
+}
+
+// Policy restricts what registrations are allowed to declare. Creating a registration does not require access to
+// secrets, so everything which would let a registration use credentials or permissions it was not given is refused
+// unless the administrator allowed it.
+type Policy struct {
+	// AllowedProjects is the list of projects, which can be glob patterns, registrations are allowed to be scoped to
+	AllowedProjects []string
+	// AllowInCluster allows registrations of the in-cluster server, which is reached with the credentials of the controller
+	AllowInCluster bool
+}
+
+// NewReconciler creates a reconciler of the repository and cluster registrations of the given namespace
+func NewReconciler(namespace string, kubeClientset kubernetes.Interface, appClientset appclientset.Interface, db db.ArgoDB, repoClientset apiclient.Clientset, kubectl kube.Kubectl, policy Policy) *Reconciler {
+	return &Reconciler{
+		namespace:     namespace,
+		kubeClientset: kubeClientset,
+		appClientset:  appClientset,
+		db:            db,
+		repoClientset: repoClientset,
+		kubectl:       kubectl,
+		policy:        policy,
+	}
+}
+
+// Run reconciles the registrations at the given interval until the context is done
+func (r *Reconciler) Run(ctx context.Context, interval time.Duration) {
+	log.Infof("Reconciling repository and cluster registrations every %v", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Reconcile(ctx); err != nil {
+			log.Warnf("Failed to reconcile repository and cluster registrations: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile writes every registration into its secret, tests the connection to the declared repository or cluster
+// and records the outcome in the status of the registration. A failing registration does not prevent the others from
+// being reconciled.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	repos, err := r.appClientset.ArgoprojV1alpha1().RepositoryRegistrations(r.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list repository registrations: %w", err)
+	}
+	for i := range repos.Items {
+		if err := r.reconcileRepository(ctx, &repos.Items[i]); err != nil {
+			log.Warnf("Failed to reconcile repository registration '%s': %v", repos.Items[i].Name, err)
+		}
+	}
+
+	clusters, err := r.appClientset.ArgoprojV1alpha1().ClusterRegistrations(r.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list cluster registrations: %w", err)
+	}
+	for i := range clusters.Items {
+		if err := r.reconcileCluster(ctx, &clusters.Items[i]); err != nil {
+			log.Warnf("Failed to reconcile cluster registration '%s': %v", clusters.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcileRepository(ctx context.Context, registration *appv1.RepositoryRegistration) error {
+	secretName := db.RepoURLToSecretName("repo", registration.Spec.URL)
+	status := appv1.RegistrationStatus{SecretName: secretName, ObservedGeneration: registration.Generation}
+
+	repo, err := r.getRepository(ctx, registration)
+	if err == nil {
+		secret := &apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName}}
+		db.RepositoryToSecret(repo, secret)
+		err = r.applySecret(ctx, secret, registration.Status.SecretName, newOwnerReference(registration.ObjectMeta, appv1.RepositoryRegistrationSchemaGroupVersionKind.Kind))
+	}
+	if err == nil {
+		err = r.testRepository(ctx, repo)
+	}
+	status.ConnectionState = newConnectionState(registration.Status.ConnectionState, err)
+
+	if reflect.DeepEqual(registration.Status, status) {
+		return nil
+	}
+	registration.Status = status
+	_, updateErr := r.appClientset.ArgoprojV1alpha1().RepositoryRegistrations(r.namespace).UpdateStatus(ctx, registration, metav1.UpdateOptions{})
+	if updateErr != nil {
+		return fmt.Errorf("failed to update status: %w", updateErr)
+	}
+	return err
+}
+
+// getRepository returns the declared repository, along with the credentials read from its credentials secret
+func (r *Reconciler) getRepository(ctx context.Context, registration *appv1.RepositoryRegistration) (*appv1.Repository, error) {
+	spec := registration.Spec
+	repo := &appv1.Repository{
+		Repo:                       spec.URL,
+		Type:                       spec.Type,
+		Name:                       spec.Name,
+		Project:                    spec.Project,
+		Insecure:                   spec.Insecure,
+		EnableLFS:                  spec.EnableLFS,
+		EnableOCI:                  spec.EnableOCI,
+		Proxy:                      spec.Proxy,
+		GithubAppId:                spec.GithubAppId,
+		GithubAppInstallationId:    spec.GithubAppInstallationId,
+		GitHubAppEnterpriseBaseURL: spec.GitHubAppEnterpriseBaseURL,
+		ForceHttpBasicAuth:         spec.ForceHttpBasicAuth,
+	}
+	if err := r.checkProject(repo.Project); err != nil {
+		return nil, err
+	}
+	if spec.CredentialsSecretName == "" {
+		return repo, nil
+	}
+	creds, err := r.getSecret(ctx, spec.CredentialsSecretName)
+	if err != nil {
+		return nil, err
+	}
+	repo.Username = string(creds.Data["username"])
+	repo.Password = string(creds.Data["password"])
+	repo.SSHPrivateKey = string(creds.Data["sshPrivateKey"])
+	repo.TLSClientCertData = string(creds.Data["tlsClientCertData"])
+	repo.TLSClientCertKey = string(creds.Data["tlsClientCertKey"])
+	repo.GithubAppPrivateKey = string(creds.Data["githubAppPrivateKey"])
+	repo.GCPServiceAccountKey = string(creds.Data["gcpServiceAccountKey"])
+	return repo, nil
+}
+
+func (r *Reconciler) testRepository(ctx context.Context, repo *appv1.Repository) error {
+	// Like the API server, repositories without credentials are tested with the matching credential template, if any
+	testRepo := repo.DeepCopy()
+	if !testRepo.HasCredentials() {
+		repoCreds, err := r.db.GetRepositoryCredentials(ctx, testRepo.Repo)
+		if err != nil {
+			return fmt.Errorf("failed to get repository credentials: %w", err)
+		}
+		if repoCreds != nil {
+			testRepo.CopyCredentialsFrom(repoCreds)
+		}
+	}
+	conn, repoClient, err := r.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the repo server: %w", err)
+	}
+	defer io.Close(conn)
+	_, err = repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{Repo: testRepo})
+	return err
+}
+
+func (r *Reconciler) reconcileCluster(ctx context.Context, registration *appv1.ClusterRegistration) error {
+	secretName, err := db.URIToSecretName("cluster", registration.Spec.Server)
+	status := appv1.RegistrationStatus{SecretName: secretName, ObservedGeneration: registration.Generation}
+
+	var cluster *appv1.Cluster
+	if err == nil {
+		cluster, err = r.getCluster(ctx, registration)
+	}
+	if err == nil {
+		secret := &apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName}}
+		err = db.ClusterToSecret(cluster, secret)
+		if err == nil {
+			err = r.applySecret(ctx, secret, registration.Status.SecretName, newOwnerReference(registration.ObjectMeta, appv1.ClusterRegistrationSchemaGroupVersionKind.Kind))
+		}
+	}
+	if err == nil {
+		_, err = r.kubectl.GetServerVersion(cluster.RESTConfig())
+	}
+	status.ConnectionState = newConnectionState(registration.Status.ConnectionState, err)
+
+	if reflect.DeepEqual(registration.Status, status) {
+		return nil
+	}
+	registration.Status = status
+	_, updateErr := r.appClientset.ArgoprojV1alpha1().ClusterRegistrations(r.namespace).UpdateStatus(ctx, registration, metav1.UpdateOptions{})
+	if updateErr != nil {
+		return fmt.Errorf("failed to update status: %w", updateErr)
+	}
+	return err
+}
+
+// getCluster returns the declared cluster, along with the connection configuration read from its config secret
+func (r *Reconciler) getCluster(ctx context.Context, registration *appv1.ClusterRegistration) (*appv1.Cluster, error) {
+	spec := registration.Spec
+	cluster := &appv1.Cluster{
+		Server:           strings.TrimRight(spec.Server, "/"),
+		Name:             spec.Name,
+		Namespaces:       spec.Namespaces,
+		ClusterResources: spec.ClusterResources,
+		Project:          spec.Project,
+		Shard:            spec.Shard,
+		Labels:           spec.Labels,
+	}
+	if err := r.checkProject(cluster.Project); err != nil {
+		return nil, err
+	}
+	if cluster.Server == appv1.KubernetesInternalAPIServerAddr && !r.policy.AllowInCluster {
+		return nil, fmt.Errorf("registrations of the in-cluster server '%s' are not allowed", cluster.Server)
+	}
+	if spec.ConfigSecretName == "" {
+		if cluster.Server != appv1.KubernetesInternalAPIServerAddr {
+			return nil, fmt.Errorf("a config secret is required to connect to cluster '%s'", cluster.Server)
+		}
+		return cluster, nil
+	}
+	secret, err := r.getSecret(ctx, spec.ConfigSecretName)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(secret.Data["config"], &cluster.Config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the config of secret '%s': %w", spec.ConfigSecretName, err)
+	}
+	return cluster, nil
+}
+
+// checkProject returns an error if registrations are not allowed to be scoped to the given project
+func (r *Reconciler) checkProject(project string) error {
+	if project == "" {
+		return nil
+	}
+	for _, pattern := range r.policy.AllowedProjects {
+		if glob.Match(pattern, project) {
+			return nil
+		}
+	}
+	return fmt.Errorf("registrations are not allowed to be scoped to project '%s'", project)
+}
+
+// getSecret returns a secret referenced by a registration. Only the secrets labelled for registrations can be
+// referenced, which prevents registrations from sending the credentials of other secrets, such as repository
+// credential templates, to the URL of their choice.
+func (r *Reconciler) getSecret(ctx context.Context, name string) (*apiv1.Secret, error) {
+	secret, err := r.kubeClientset.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret '%s': %w", name, err)
+	}
+	if secret.Labels[common.LabelKeyRegistrationSecret] != "true" {
+		return nil, fmt.Errorf("secret '%s' cannot be referenced by registrations: it is not labelled %s=true", name, common.LabelKeyRegistrationSecret)
+	}
+	return secret, nil
+}
+
+// applySecret creates or updates the secret of a registration, and deletes the secret it was previously reconciled
+// into if the secret name changed, e.g. because the URL of the repository was updated. Secrets which are not owned by
+// the registration are never overwritten.
+func (r *Reconciler) applySecret(ctx context.Context, desired *apiv1.Secret, previousName string, owner metav1.OwnerReference) error {
+	secrets := r.kubeClientset.CoreV1().Secrets(r.namespace)
+	desired.Namespace = r.namespace
+	desired.OwnerReferences = []metav1.OwnerReference{owner}
+
+	live, err := secrets.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = secrets.Create(ctx, desired, metav1.CreateOptions{})
+	} else if err == nil {
+		if !isOwnedBy(live.ObjectMeta, owner) {
+			return fmt.Errorf("secret '%s' already exists and is not managed by the registration", desired.Name)
+		}
+		if !reflect.DeepEqual(live.Data, desired.Data) || !reflect.DeepEqual(live.Labels, desired.Labels) || !reflect.DeepEqual(live.Annotations, desired.Annotations) {
+			live.Data = desired.Data
+			live.Labels = desired.Labels
+			live.Annotations = desired.Annotations
+			_, err = secrets.Update(ctx, live, metav1.UpdateOptions{})
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply secret '%s': %w", desired.Name, err)
+	}
+
+	if previousName == "" || previousName == desired.Name {
+		return nil
+	}
+	previous, err := secrets.Get(ctx, previousName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get previous secret '%s': %w", previousName, err)
+	}
+	if isOwnedBy(previous.ObjectMeta, owner) {
+		log.Infof("Deleting secret '%s' superseded by secret '%s'", previousName, desired.Name)
+		if err := secrets.Delete(ctx, previousName, metav1.DeleteOptions{}); err != nil && !apierr.IsNotFound(err) {
+			return fmt.Errorf("failed to delete previous secret '%s': %w", previousName, err)
+		}
+	}
+	return nil
+}
+
+// newOwnerReference returns the owner reference of the secret of a registration, which lets Kubernetes delete the
+// secret along with the registration
+func newOwnerReference(meta metav1.ObjectMeta, kind string) metav1.OwnerReference {
+	return *metav1.NewControllerRef(&meta, appv1.SchemeGroupVersion.WithKind(kind))
+}
+
+func isOwnedBy(meta metav1.ObjectMeta, owner metav1.OwnerReference) bool {
+	for _, ref := range meta.OwnerReferences {
+		if ref.UID == owner.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// newConnectionState returns the connection state resulting from the given error. The modification time of the
+// previous state is kept if the state did not change, so that the status is only updated on changes.
+func newConnectionState(previous appv1.ConnectionState, err error) appv1.ConnectionState {
+	state := appv1.ConnectionState{Status: appv1.ConnectionStatusSuccessful}
+	if err != nil {
+		state = appv1.ConnectionState{Status: appv1.ConnectionStatusFailed, Message: err.Error()}
+	}
+	if previous.Status == state.Status && previous.Message == state.Message {
+		state.ModifiedAt = previous.ModifiedAt
+	} else {
+		now := metav1.Now()
+		state.ModifiedAt = &now
+	}
+	return state
+}
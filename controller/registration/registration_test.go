@@ -0,0 +1,260 @@
+package registration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appsfake "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+)
+
+const (
+	testNamespace = "argocd"
+	testRepoURL   = "https://github.com/argoproj/argocd-example-apps"
+	testServer    = "https://cluster.example.com"
+)
+
+func newReconciler(testRepoErr error, kubeObjects []runtime.Object, appObjects ...runtime.Object) (*Reconciler, *fake.Clientset, *appsfake.Clientset) {
+	return newReconcilerWithPolicy(Policy{AllowedProjects: []string{"default"}}, testRepoErr, kubeObjects, appObjects...)
+}
+
+func newReconcilerWithPolicy(policy Policy, testRepoErr error, kubeObjects []runtime.Object, appObjects ...runtime.Object) (*Reconciler, *fake.Clientset, *appsfake.Clientset) {
+	repoClient := &repomocks.RepoServerServiceClient{}
+	repoClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{VerifiedRepository: testRepoErr == nil}, testRepoErr)
+
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("GetRepositoryCredentials", mock.Anything, mock.Anything).Return(nil, nil)
+
+	kubeClient := fake.NewSimpleClientset(kubeObjects...)
+	appClient := appsfake.NewSimpleClientset(appObjects...)
+	kubectl := &kubetest.MockKubectlCmd{Version: "v1.25.0"}
+	return NewReconciler(testNamespace, kubeClient, appClient, argoDB, &repomocks.Clientset{RepoServerServiceClient: repoClient}, kubectl, policy), kubeClient, appClient
+}
+
+func newRepositoryRegistration(url string) *appv1.RepositoryRegistration {
+	return &appv1.RepositoryRegistration{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apps", Namespace: testNamespace, UID: "repo-uid", Generation: 1},
+		Spec:       appv1.RepositoryRegistrationSpec{URL: url, Project: "default", CredentialsSecretName: "example-apps-creds"},
+	}
+}
+
+func newCredentialsSecret() *apiv1.Secret {
+	return &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-apps-creds", Namespace: testNamespace, Labels: map[string]string{common.LabelKeyRegistrationSecret: "true"}},
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+}
+
+func TestReconcileRepository(t *testing.T) {
+	secretName := db.RepoURLToSecretName("repo", testRepoURL)
+
+	t.Run("CreatesSecret", func(t *testing.T) {
+		r, kubeClient, appClient := newReconciler(nil, []runtime.Object{newCredentialsSecret()}, newRepositoryRegistration(testRepoURL))
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, testRepoURL, string(secret.Data["url"]))
+		assert.Equal(t, "admin", string(secret.Data["username"]))
+		assert.Equal(t, "secret", string(secret.Data["password"]))
+		assert.Equal(t, "default", string(secret.Data["project"]))
+		assert.Equal(t, common.LabelValueSecretTypeRepository, secret.Labels[common.LabelKeySecretType])
+		if assert.Len(t, secret.OwnerReferences, 1) {
+			assert.Equal(t, "RepositoryRegistration", secret.OwnerReferences[0].Kind)
+			assert.Equal(t, "example-apps", secret.OwnerReferences[0].Name)
+		}
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusSuccessful, registration.Status.ConnectionState.Status)
+		assert.Equal(t, secretName, registration.Status.SecretName)
+		assert.Equal(t, int64(1), registration.Status.ObservedGeneration)
+		modifiedAt := registration.Status.ConnectionState.ModifiedAt
+
+		require.NoError(t, r.Reconcile(context.Background()))
+		registration, err = appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, modifiedAt, registration.Status.ConnectionState.ModifiedAt)
+	})
+
+	t.Run("ConnectionFailed", func(t *testing.T) {
+		r, _, appClient := newReconciler(errors.New("authentication required"), []runtime.Object{newCredentialsSecret()}, newRepositoryRegistration(testRepoURL))
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Equal(t, "authentication required", registration.Status.ConnectionState.Message)
+	})
+
+	t.Run("MissingCredentialsSecret", func(t *testing.T) {
+		r, kubeClient, appClient := newReconciler(nil, nil, newRepositoryRegistration(testRepoURL))
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Contains(t, registration.Status.ConnectionState.Message, "failed to get secret 'example-apps-creds'")
+		_, err = kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("UnlabelledCredentialsSecret", func(t *testing.T) {
+		creds := newCredentialsSecret()
+		creds.Labels = nil
+		r, kubeClient, appClient := newReconciler(nil, []runtime.Object{creds}, newRepositoryRegistration(testRepoURL))
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Contains(t, registration.Status.ConnectionState.Message, "cannot be referenced by registrations")
+		_, err = kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("ProjectNotAllowed", func(t *testing.T) {
+		r, kubeClient, appClient := newReconcilerWithPolicy(Policy{AllowedProjects: []string{"team-*"}}, nil, []runtime.Object{newCredentialsSecret()}, newRepositoryRegistration(testRepoURL))
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Equal(t, "registrations are not allowed to be scoped to project 'default'", registration.Status.ConnectionState.Message)
+		_, err = kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("DoesNotOverwriteUnmanagedSecret", func(t *testing.T) {
+		existing := &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: testNamespace},
+			Data:       map[string][]byte{"url": []byte(testRepoURL), "username": []byte("other")},
+		}
+		r, kubeClient, appClient := newReconciler(nil, []runtime.Object{newCredentialsSecret(), existing}, newRepositoryRegistration(testRepoURL))
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Contains(t, registration.Status.ConnectionState.Message, "is not managed by the registration")
+		secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "other", string(secret.Data["username"]))
+	})
+
+	t.Run("DeletesSupersededSecret", func(t *testing.T) {
+		r, kubeClient, appClient := newReconciler(nil, []runtime.Object{newCredentialsSecret()}, newRepositoryRegistration(testRepoURL))
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Get(context.Background(), "example-apps", metav1.GetOptions{})
+		require.NoError(t, err)
+		registration.Spec.URL = "https://github.com/argoproj/argo-cd"
+		_, err = appClient.ArgoprojV1alpha1().RepositoryRegistrations(testNamespace).Update(context.Background(), registration, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		_, err = kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+		secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), db.RepoURLToSecretName("repo", "https://github.com/argoproj/argo-cd"), metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.com/argoproj/argo-cd", string(secret.Data["url"]))
+	})
+}
+
+func TestReconcileCluster(t *testing.T) {
+	secretName, err := db.URIToSecretName("cluster", testServer)
+	require.NoError(t, err)
+	newRegistration := func() *appv1.ClusterRegistration {
+		return &appv1.ClusterRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "production", Namespace: testNamespace, UID: "cluster-uid", Generation: 2},
+			Spec: appv1.ClusterRegistrationSpec{
+				Server:           testServer,
+				Name:             "production",
+				Namespaces:       []string{"apps"},
+				Labels:           map[string]string{"env": "production"},
+				ConfigSecretName: "production-config",
+			},
+		}
+	}
+
+	t.Run("CreatesSecret", func(t *testing.T) {
+		config, err := json.Marshal(appv1.ClusterConfig{BearerToken: "token"})
+		require.NoError(t, err)
+		configSecret := &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "production-config", Namespace: testNamespace, Labels: map[string]string{common.LabelKeyRegistrationSecret: "true"}},
+			Data:       map[string][]byte{"config": config},
+		}
+		r, kubeClient, appClient := newReconciler(nil, []runtime.Object{configSecret}, newRegistration())
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		secret, err := kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, testServer, string(secret.Data["server"]))
+		assert.Equal(t, "production", string(secret.Data["name"]))
+		assert.Equal(t, "apps", string(secret.Data["namespaces"]))
+		assert.JSONEq(t, string(config), string(secret.Data["config"]))
+		assert.Equal(t, "production", secret.Labels["env"])
+		assert.Equal(t, common.LabelValueSecretTypeCluster, secret.Labels[common.LabelKeySecretType])
+		if assert.Len(t, secret.OwnerReferences, 1) {
+			assert.Equal(t, "ClusterRegistration", secret.OwnerReferences[0].Kind)
+		}
+
+		registration, err := appClient.ArgoprojV1alpha1().ClusterRegistrations(testNamespace).Get(context.Background(), "production", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusSuccessful, registration.Status.ConnectionState.Status)
+		assert.Equal(t, secretName, registration.Status.SecretName)
+		assert.Equal(t, int64(2), registration.Status.ObservedGeneration)
+	})
+
+	t.Run("MissingConfigSecret", func(t *testing.T) {
+		registration := newRegistration()
+		registration.Spec.ConfigSecretName = ""
+		r, kubeClient, appClient := newReconciler(nil, nil, registration)
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().ClusterRegistrations(testNamespace).Get(context.Background(), "production", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Equal(t, "a config secret is required to connect to cluster 'https://cluster.example.com'", registration.Status.ConnectionState.Message)
+		_, err = kubeClient.CoreV1().Secrets(testNamespace).Get(context.Background(), secretName, metav1.GetOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("InClusterNotAllowed", func(t *testing.T) {
+		registration := newRegistration()
+		registration.Spec.Server = appv1.KubernetesInternalAPIServerAddr
+		registration.Spec.ConfigSecretName = ""
+		r, _, appClient := newReconciler(nil, nil, registration)
+
+		require.NoError(t, r.Reconcile(context.Background()))
+
+		registration, err := appClient.ArgoprojV1alpha1().ClusterRegistrations(testNamespace).Get(context.Background(), "production", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ConnectionStatusFailed, registration.Status.ConnectionState.Status)
+		assert.Equal(t, "registrations of the in-cluster server 'https://kubernetes.default.svc' are not allowed", registration.Status.ConnectionState.Message)
+	})
+}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
+	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	hookutil "github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
@@ -30,12 +33,14 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	argopath "github.com/argoproj/argo-cd/v2/util/app/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	argokube "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/stats"
 )
@@ -153,6 +158,16 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alp
 		return nil, nil, err
 	}
 
+	sourcePathChangeDetection, err := m.settingsMgr.GetSourcePathChangeDetectionEnabled()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	featureFlags, err := m.settingsMgr.GetFeatureFlags()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	ts.AddCheckpoint("build_options_ms")
 	serverVersion, apiResources, err := m.liveStateCache.GetVersionsInfo(app.Spec.Destination.Server)
 	if err != nil {
@@ -188,7 +203,43 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alp
 		}
 
 		ts.AddCheckpoint("version_ms")
+		// If none of the paths affecting the manifest generation changed since the last comparison, the repo server
+		// reuses the previously generated manifests for the new revision instead of generating them again.
+		refreshPaths := argopath.GetAppRefreshPaths(app, sourcePathChangeDetection)
+		if !noCache && !noRevisionCache && !app.Spec.HasMultipleSources() && len(refreshPaths) > 0 && app.Status.Sync.Revision != "" {
+			_, err := repoClient.UpdateRevisionForPaths(context.Background(), &apiclient.UpdateRevisionForPathsRequest{
+				Repo:               repo,
+				AppLabelKey:        appLabelKey,
+				AppName:            app.InstanceName(m.namespace),
+				Namespace:          app.Spec.Destination.Namespace,
+				ApplicationSource:  &source,
+				TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
+				RefSources:         refSources,
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				HasMultipleSources: app.Spec.HasMultipleSources(),
+				SyncedRevision:     app.Status.Sync.Revision,
+				Revision:           revisions[i],
+				Paths:              refreshPaths,
+			})
+			if err != nil {
+				log.WithField("application", app.QualifiedName()).Warnf("Failed to compare revision %s with %s: %v", revisions[i], app.Status.Sync.Revision, err)
+			}
+		}
+
+		var hydration *apiclient.ManifestHydration
+		if !app.Spec.HasMultipleSources() {
+			// the generated manifests are written to the hydrated branch with the credentials allowed to push, if any
+			writeRepo, err := m.db.GetWriteRepository(context.Background(), source.RepoURL)
+			if err != nil {
+				log.WithField("application", app.QualifiedName()).Warnf("Failed to get the write credentials of repository %s: %v", source.RepoURL, err)
+			} else if writeRepo != nil {
+				hydration = &apiclient.ManifestHydration{Repo: writeRepo, AppName: app.Name, AppNamespace: app.Namespace}
+			}
+		}
+
 		log.Debugf("Generating Manifest for source %s revision %s", source, revisions[i])
+		manifestGenerationStart := time.Now()
 		manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
 			Repo:               repo,
 			Repos:              permittedHelmRepos,
@@ -210,7 +261,11 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alp
 			HelmOptions:        helmOptions,
 			HasMultipleSources: app.Spec.HasMultipleSources(),
 			RefSources:         refSources,
+			FeatureFlags:       featureFlags,
+			Hydration:          hydration,
+			RefreshPaths:       refreshPaths,
 		})
+		m.recordProjectUsage(app.Spec.GetProject(), appstatecache.ProjectUsageManifestGenerationSeconds, time.Since(manifestGenerationStart).Seconds())
 		if err != nil {
 			return nil, nil, err
 		}
@@ -241,6 +296,13 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, sources []v1alp
 	return targetObjs, manifestInfoMap, nil
 }
 
+// recordProjectUsage adds the value to the usage statistic of the project, which is exposed for chargeback
+func (m *appStateManager) recordProjectUsage(project string, metric string, value float64) {
+	if err := m.cache.IncrementProjectUsage(project, metric, value); err != nil {
+		log.Warnf("Failed to record %s usage of project '%s': %v", metric, project, err)
+	}
+}
+
 func unmarshalManifests(manifests []string) ([]*unstructured.Unstructured, error) {
 	targetObjs := make([]*unstructured.Unstructured, 0)
 	for _, manifest := range manifests {
@@ -351,9 +413,54 @@ func verifyGnuPGSignature(revision string, project *appv1.AppProject, manifestIn
 	return conditions
 }
 
+// getDeprecatedAPIsMessage returns a message listing the given resources which use APIs deprecated or removed in the
+// given Kubernetes version or in the next minor version, or an empty string if there is none
+func getDeprecatedAPIsMessage(objs []*unstructured.Unstructured, kubeVersion string) string {
+	var usages []string
+	for _, obj := range objs {
+		deprecation := argokube.GetAPIDeprecation(obj.GroupVersionKind(), kubeVersion)
+		if deprecation == nil {
+			continue
+		}
+		resource := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+		if obj.GetNamespace() != "" {
+			resource = fmt.Sprintf("%s/%s", obj.GetNamespace(), resource)
+		}
+		usages = append(usages, fmt.Sprintf("%s: %s", resource, deprecation.Message()))
+	}
+	if len(usages) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Resources use APIs deprecated in Kubernetes %s: %s", kubeVersion, strings.Join(usages, "; "))
+}
+
+// getManifestValidationErrors returns the sorted errors of the validation of the manifests of all the sources
+func getManifestValidationErrors(manifestInfoMap map[*v1alpha1.ApplicationSource]*apiclient.ManifestResponse) []string {
+	var validationErrors []string
+	for _, manifestInfo := range manifestInfoMap {
+		validationErrors = append(validationErrors, manifestInfo.ValidationErrors...)
+	}
+	sort.Strings(validationErrors)
+	return validationErrors
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied source. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
+// serverSideApplyEnabled returns whether the resources are synced with server-side apply according to the given sync
+// options, unless server-side apply is disabled by its feature flag
+func (m *appStateManager) serverSideApplyEnabled(syncOptions v1alpha1.SyncOptions) bool {
+	if !syncOptions.HasOption(synccommon.SyncOptionServerSideApply) {
+		return false
+	}
+	featureFlags, err := m.settingsMgr.GetFeatureFlags()
+	if err != nil {
+		log.Warnf("Could not get feature flags from ConfigMap (assuming defaults): %v", err)
+		featureFlags = settings.FeatureFlags{}
+	}
+	return featureFlags.Enabled(settings.FeatureFlagServerSideApply)
+}
+
 func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revisions []string, sources []v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string, hasMultipleSources bool) *comparisonResult {
 	ts := stats.NewTimingStats()
 	appLabelKey, resourceOverrides, resFilter, err := m.getComparisonSettings()
@@ -416,6 +523,10 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 			failedToLoadObjs = true
+		} else if validationErrors := getManifestValidationErrors(manifestInfoMap); len(validationErrors) > 0 {
+			// invalid manifests are reported as comparison errors, which prevent them from being synced
+			msg := fmt.Sprintf("Manifests are invalid: %s", strings.Join(validationErrors, "; "))
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 		}
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
@@ -450,10 +561,14 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 	}
 	conditions = append(conditions, dedupConditions...)
+	destCluster, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		destCluster = &v1alpha1.Cluster{Server: app.Spec.Destination.Server}
+	}
 	for i := len(targetObjs) - 1; i >= 0; i-- {
 		targetObj := targetObjs[i]
 		gvk := targetObj.GroupVersionKind()
-		if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, app.Spec.Destination.Server) {
+		if resFilter.IsExcludedClusterResource(gvk.Group, gvk.Kind, destCluster) {
 			targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{
 				Type:               v1alpha1.ApplicationConditionExcludedResourceWarning,
@@ -462,6 +577,25 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 			})
 		}
 	}
+	if cappedKinds := m.liveStateCache.GetCappedResourceKinds(app.Spec.Destination.Server); len(cappedKinds) > 0 {
+		for i := len(targetObjs) - 1; i >= 0; i-- {
+			targetObj := targetObjs[i]
+			gvk := targetObj.GroupVersionKind()
+			if count, ok := cappedKinds[gvk.GroupKind()]; ok {
+				targetObjs = append(targetObjs[:i], targetObjs[i+1:]...)
+				conditions = append(conditions, v1alpha1.ApplicationCondition{
+					Type:               v1alpha1.ApplicationConditionCacheLimitWarning,
+					Message:            fmt.Sprintf("Resource %s/%s %s is not cached since the cluster has more than %d resources of this kind", gvk.Group, gvk.Kind, targetObj.GetName(), count-1),
+					LastTransitionTime: &now,
+				})
+			}
+		}
+	}
+	if serverVersion, _, err := m.liveStateCache.GetVersionsInfo(app.Spec.Destination.Server); err == nil {
+		if msg := getDeprecatedAPIsMessage(targetObjs, serverVersion); msg != "" {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionDeprecatedAPIWarning, Message: msg, LastTransitionTime: &now})
+		}
+	}
 	ts.AddCheckpoint("dedup_ms")
 
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(app, targetObjs)
@@ -545,7 +679,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	diffConfigBuilder.WithManager(common.ArgoCDSSAManager)
 
 	// enable structured merge diff if application syncs with server-side apply
-	if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.SyncOptions.HasOption("ServerSideApply=true") {
+	if app.Spec.SyncPolicy != nil && m.serverSideApplyEnabled(app.Spec.SyncPolicy.SyncOptions) {
 		diffConfigBuilder.WithStructuredMergeDiff(true)
 	}
 
@@ -617,7 +751,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		}
 		// set unknown status to all resource that are not permitted in the app project
 		isNamespaced, err := m.liveStateCache.IsNamespaced(app.Spec.Destination.Server, gvk.GroupKind())
-		if !project.IsGroupKindPermitted(gvk.GroupKind(), isNamespaced && err == nil) {
+		if !project.IsGroupVersionKindPermitted(gvk, isNamespaced && err == nil) {
 			resState.Status = v1alpha1.SyncStatusCodeUnknown
 		}
 
@@ -680,7 +814,11 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth, func(key kubeutil.ResourceKey, action func(child appv1.ResourceNode, appName string) bool) error {
+		return m.liveStateCache.IterateHierarchy(app.Spec.Destination.Server, key, action)
+	}, &clusterRelatedResources{getClusterCache: func() (clustercache.ClusterCache, error) {
+		return m.liveStateCache.GetClusterCache(app.Spec.Destination.Server)
+	}})
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 	}
@@ -720,13 +858,15 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		appv1.ApplicationConditionSharedResourceWarning:   true,
 		appv1.ApplicationConditionRepeatedResourceWarning: true,
 		appv1.ApplicationConditionExcludedResourceWarning: true,
+		appv1.ApplicationConditionCacheLimitWarning:       true,
+		appv1.ApplicationConditionDeprecatedAPIWarning:    true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
 	return &compRes
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, revisions []string, sources []v1alpha1.ApplicationSource, hasMultipleSources bool, startedAt metav1.Time) error {
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, revisions []string, sources []v1alpha1.ApplicationSource, hasMultipleSources bool, startedAt metav1.Time, operation v1alpha1.Operation) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History.LastRevisionHistory().ID + 1
@@ -739,6 +879,8 @@ func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revi
 			ID:              nextID,
 			Sources:         sources,
 			Revisions:       revisions,
+			InitiatedBy:     operation.InitiatedBy,
+			Approval:        operation.Approval,
 		})
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
@@ -747,6 +889,8 @@ func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revi
 			DeployStartedAt: &startedAt,
 			ID:              nextID,
 			Source:          source,
+			InitiatedBy:     operation.InitiatedBy,
+			Approval:        operation.Approval,
 		})
 	}
 
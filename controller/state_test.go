@@ -11,16 +11,19 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 )
@@ -51,6 +54,81 @@ func TestCompareAppStateEmpty(t *testing.T) {
 	assert.Len(t, app.Status.Conditions, 0)
 }
 
+func TestCompareAppStateInvalidManifests(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests:        []string{},
+			Namespace:        test.FakeDestNamespace,
+			Server:           test.FakeClusterURL,
+			Revision:         "abc123",
+			ValidationErrors: []string{"Deployment/guestbook: spec.replicas in body must be of type integer: \"string\"", "ConfigMap/guestbook: spec in body is a forbidden property"},
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	sources := []argoappv1.ApplicationSource{app.Spec.GetSource()}
+	revisions := []string{""}
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false)
+	assert.NotNil(t, compRes)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Manifests are invalid: ConfigMap/guestbook: spec in body is a forbidden property; Deployment/guestbook: spec.replicas in body must be of type integer: \"string\"", app.Status.Conditions[0].Message)
+}
+
+func TestCompareAppStateDeprecatedAPIs(t *testing.T) {
+	app := newFakeApp()
+	cronJob := `{"apiVersion": "batch/v1beta1", "kind": "CronJob", "metadata": {"name": "guestbook", "namespace": "` + test.FakeDestNamespace + `"}}`
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{cronJob},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		serverVersion:   "1.24",
+	}
+	ctrl := newFakeController(&data)
+	sources := []argoappv1.ApplicationSource{app.Spec.GetSource()}
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false)
+	assert.NotNil(t, compRes)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionDeprecatedAPIWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Resources use APIs deprecated in Kubernetes 1.24: "+test.FakeDestNamespace+"/CronJob/guestbook: batch/v1beta1 is deprecated since Kubernetes 1.21 and removed in 1.25, use batch/v1 instead", app.Status.Conditions[0].Message)
+}
+
+func TestCompareAppStateUpdateRevisionForPaths(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{argoappv1.AnnotationKeyManifestGeneratePaths: "."}
+	app.Status.Sync.Revision = "abc123"
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "def456",
+		},
+		managedLiveObjs:        make(map[kube.ResourceKey]*unstructured.Unstructured),
+		updateRevisionForPaths: &apiclient.UpdateRevisionForPathsResponse{Revision: "def456"},
+	}
+	ctrl := newFakeController(&data)
+	sources := []argoappv1.ApplicationSource{app.Spec.GetSource()}
+
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, false, false, nil, false)
+	assert.NotNil(t, compRes)
+	repoClient := ctrl.repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+	repoClient.AssertCalled(t, "UpdateRevisionForPaths", mock.Anything, mock.MatchedBy(func(q *apiclient.UpdateRevisionForPathsRequest) bool {
+		return q.SyncedRevision == "abc123" && q.Revision == app.Spec.GetSource().TargetRevision && len(q.Paths) == 1 && q.Paths[0] == app.Spec.GetSource().Path
+	}))
+
+	// a hard refresh always regenerates the manifests
+	repoClient.Calls = nil
+	compRes = ctrl.appStateManager.CompareAppState(app, &defaultProj, []string{""}, sources, true, false, nil, false)
+	assert.NotNil(t, compRes)
+	repoClient.AssertNotCalled(t, "UpdateRevisionForPaths", mock.Anything, mock.Anything)
+}
+
 // TestCompareAppStateMissing tests when there is a manifest defined in the repo which doesn't exist in live
 func TestCompareAppStateMissing(t *testing.T) {
 	app := newFakeApp()
@@ -280,6 +358,40 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 	assert.Equal(t, 4, len(compRes.resources))
 }
 
+func TestCompareAppStateCappedResourceKind(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+	svc := NewService()
+	svc.SetNamespace(test.FakeDestNamespace)
+
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod), toJSON(t, svc)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(svc): svc,
+		},
+		cappedResourceKinds: map[schema.GroupKind]int{{Kind: "Pod"}: 11},
+	}
+	ctrl := newFakeController(&data)
+	sources := make([]argoappv1.ApplicationSource, 0)
+	sources = append(sources, app.Spec.GetSource())
+	revisions := make([]string, 0)
+	revisions = append(revisions, "")
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, revisions, sources, false, false, nil, false)
+
+	assert.NotNil(t, compRes)
+	assert.Equal(t, 1, len(app.Status.Conditions))
+	assert.Equal(t, argoappv1.ApplicationConditionCacheLimitWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Resource /Pod my-pod is not cached since the cluster has more than 10 resources of this kind", app.Status.Conditions[0].Message)
+	assert.Equal(t, 1, len(compRes.resources))
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+}
+
 var defaultProj = argoappv1.AppProject{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:      "default",
@@ -482,7 +594,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1.Time{})
+		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1.Time{}, argoappv1.Operation{})
 		assert.NoError(t, err)
 	}
 	addHistory()
@@ -518,7 +630,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1NowTime)
+	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, []string{}, []argoappv1.ApplicationSource{}, false, metav1NowTime, argoappv1.Operation{})
 	assert.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
 }
@@ -1034,3 +1146,16 @@ func TestIsLiveResourceManaged(t *testing.T) {
 		assert.True(t, manager.isSelfReferencedObj(managedWrongAPIGroup, config, appName, common.AnnotationKeyAppInstance, argo.TrackingMethodAnnotation))
 	})
 }
+
+func TestServerSideApplyEnabled(t *testing.T) {
+	syncOptions := argoappv1.SyncOptions{"ServerSideApply=true"}
+
+	ctrl := newFakeController(&fakeData{})
+	manager := ctrl.appStateManager.(*appStateManager)
+	assert.True(t, manager.serverSideApplyEnabled(syncOptions))
+	assert.False(t, manager.serverSideApplyEnabled(argoappv1.SyncOptions{}))
+
+	ctrl = newFakeController(&fakeData{configMapData: map[string]string{"featureFlags": "serverSideApply: false"}})
+	manager = ctrl.appStateManager.(*appStateManager)
+	assert.False(t, manager.serverSideApplyEnabled(syncOptions))
+}
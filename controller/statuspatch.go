@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// StatusPatchStrategyMergePatch persists the changes of the status of applications with JSON merge patches
+	StatusPatchStrategyMergePatch = "merge-patch"
+	// StatusPatchStrategyServerSideApply persists the status of applications with server-side apply
+	StatusPatchStrategyServerSideApply = "server-side-apply"
+
+	// statusFieldManager is the field manager owning the status fields of applications in their managed fields
+	statusFieldManager = "argocd-controller-status"
+)
+
+// StatusPatchStrategies are the supported status patch strategies
+var StatusPatchStrategies = []string{StatusPatchStrategyMergePatch, StatusPatchStrategyServerSideApply}
+
+// SetStatusPatchStrategy sets how the status of applications is persisted. Either strategy only sends the status, and
+// records a dedicated field manager as the owner of the status fields.
+func (ctrl *ApplicationController) SetStatusPatchStrategy(strategy string) error {
+	switch strategy {
+	case StatusPatchStrategyMergePatch, StatusPatchStrategyServerSideApply:
+		ctrl.statusPatchStrategy = strategy
+		return nil
+	default:
+		return fmt.Errorf("unknown status patch strategy '%s', expected one of: %s, %s", strategy, StatusPatchStrategyMergePatch, StatusPatchStrategyServerSideApply)
+	}
+}
+
+// applyAppStatus persists the status of an application with server-side apply. Only the status is applied, so that the
+// status fields are owned by the status field manager, and the fields it stops applying are removed. The refresh
+// annotation is not owned by the controller, so its removal is patched.
+func (ctrl *ApplicationController) applyAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus) error {
+	appClient := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(orig.Namespace)
+	// an Application would carry the zero values of the spec, which would then be owned by the status field manager
+	data, err := json.Marshal(map[string]interface{}{
+		"apiVersion": appv1.SchemeGroupVersion.String(),
+		"kind":       application.ApplicationKind,
+		"metadata": map[string]interface{}{
+			"name":      orig.Name,
+			"namespace": orig.Namespace,
+		},
+		"status": newStatus,
+	})
+	if err != nil {
+		return err
+	}
+	force := true
+	if _, err := appClient.Patch(context.Background(), orig.Name, types.ApplyPatchType, data, metav1.PatchOptions{FieldManager: statusFieldManager, Force: &force}); err != nil {
+		return err
+	}
+	annotations := map[string]interface{}{}
+	for _, key := range refreshAnnotationKeys {
+		if _, ok := orig.GetAnnotations()[key]; ok {
+			annotations[key] = nil
+		}
+	}
+	if len(annotations) > 0 {
+		patch, err := json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"annotations": annotations}})
+		if err != nil {
+			return err
+		}
+		if _, err := appClient.Patch(context.Background(), orig.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubetesting "k8s.io/client-go/testing"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+)
+
+func TestSetStatusPatchStrategy(t *testing.T) {
+	ctrl := newFakeController(&fakeData{})
+	assert.Equal(t, StatusPatchStrategyMergePatch, ctrl.statusPatchStrategy)
+	require.NoError(t, ctrl.SetStatusPatchStrategy(StatusPatchStrategyServerSideApply))
+	assert.Equal(t, StatusPatchStrategyServerSideApply, ctrl.statusPatchStrategy)
+	assert.Error(t, ctrl.SetStatusPatchStrategy("update"))
+}
+
+type recordedPatch struct {
+	patchType types.PatchType
+	patch     map[string]interface{}
+}
+
+func recordPatches(t *testing.T, ctrl *ApplicationController) *[]recordedPatch {
+	var patches []recordedPatch
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patchAction := action.(kubetesting.PatchAction)
+		patch := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &patch))
+		patches = append(patches, recordedPatch{patchType: patchAction.GetPatchType(), patch: patch})
+		return true, &argoappv1.Application{}, nil
+	})
+	return &patches
+}
+
+func TestPersistAppStatus_MergePatch(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	patches := recordPatches(t, ctrl)
+
+	newStatus := app.Status.DeepCopy()
+	newStatus.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	ctrl.persistAppStatus(app, newStatus)
+
+	require.Len(t, *patches, 1)
+	assert.Equal(t, types.MergePatchType, (*patches)[0].patchType)
+	assert.Equal(t, map[string]interface{}{"status": map[string]interface{}{"sync": map[string]interface{}{"status": "OutOfSync"}}}, (*patches)[0].patch)
+}
+
+func TestPersistAppStatus_ServerSideApply(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{argoappv1.AnnotationKeyRefresh: string(argoappv1.RefreshTypeNormal)}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	require.NoError(t, ctrl.SetStatusPatchStrategy(StatusPatchStrategyServerSideApply))
+	patches := recordPatches(t, ctrl)
+
+	newStatus := app.Status.DeepCopy()
+	newStatus.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	ctrl.persistAppStatus(app, newStatus)
+
+	require.Len(t, *patches, 2)
+	applied := (*patches)[0]
+	assert.Equal(t, types.ApplyPatchType, applied.patchType)
+	assert.Equal(t, "argoproj.io/v1alpha1", applied.patch["apiVersion"])
+	assert.Equal(t, "Application", applied.patch["kind"])
+	assert.Equal(t, map[string]interface{}{"name": app.Name, "namespace": app.Namespace}, applied.patch["metadata"])
+	assert.NotContains(t, applied.patch, "spec")
+	assert.Equal(t, "OutOfSync", applied.patch["status"].(map[string]interface{})["sync"].(map[string]interface{})["status"])
+
+	// the refresh annotation is removed with a merge patch
+	assert.Equal(t, types.MergePatchType, (*patches)[1].patchType)
+	assert.Equal(t, map[string]interface{}{"metadata": map[string]interface{}{"annotations": map[string]interface{}{argoappv1.AnnotationKeyRefresh: nil}}}, (*patches)[1].patch)
+
+	t.Run("NoChanges", func(t *testing.T) {
+		*patches = nil
+		app.Annotations = nil
+		ctrl.persistAppStatus(app, app.Status.DeepCopy())
+		assert.Empty(t, *patches)
+	})
+}
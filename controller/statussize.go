@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+const (
+	// maxOperationMessageLength is the maximum length of the messages of operations once the status size is capped
+	maxOperationMessageLength = 4096
+	truncatedMessageSuffix    = "... (truncated)"
+)
+
+// SetStatusMaxSize sets the maximum size in bytes of applications above which the list of resources is moved from the
+// status of applications to the cache, and the messages of operations are truncated. The API server serves the list of
+// resources from the cache. A size of zero disables capping the status.
+func (ctrl *ApplicationController) SetStatusMaxSize(size int) {
+	ctrl.statusMaxSize = size
+}
+
+// capAppStatus moves the list of resources of the new status to the cache if the application would exceed the maximum
+// status size. The list is rewritten every time the resources are compared. The resources of a status which still
+// refers to the cache have not been compared again: if they are missing from the cache, e.g. because Redis was
+// restarted, a comparison is requested to store them again.
+func (ctrl *ApplicationController) capAppStatus(orig *appv1.Application, newStatus *appv1.ApplicationStatus) {
+	logCtx := log.WithField("application", orig.QualifiedName())
+	if newStatus.ResourcesSource == appv1.ResourceStatusLocationCache {
+		var resources []appv1.ResourceStatus
+		if err := ctrl.cache.GetAppResourcesStatus(orig.InstanceName(ctrl.namespace), &resources); err == appstatecache.ErrCacheMiss {
+			logCtx.Info("Resources of the application are missing from the cache, requesting a comparison")
+			ctrl.requestAppRefresh(orig.QualifiedName(), CompareWithLatest.Pointer(), nil)
+		}
+		return
+	}
+	if ctrl.statusMaxSize <= 0 {
+		return
+	}
+	size, err := appSize(orig, newStatus)
+	if err != nil {
+		logCtx.Warnf("Failed to compute the size of the application: %v", err)
+		return
+	}
+	if size > ctrl.statusMaxSize && len(newStatus.Resources) > 0 {
+		if err := ctrl.cache.SetAppResourcesStatus(orig.InstanceName(ctrl.namespace), newStatus.Resources); err != nil {
+			logCtx.Warnf("Failed to move the resources of the application to the cache: %v", err)
+			return
+		}
+		logCtx.Infof("Application size of %d bytes exceeds %d bytes, moved %d resources from the status to the cache", size, ctrl.statusMaxSize, len(newStatus.Resources))
+		newStatus.Resources = nil
+		newStatus.ResourcesSource = appv1.ResourceStatusLocationCache
+		if size, err = appSize(orig, newStatus); err != nil {
+			return
+		}
+	} else if orig.Status.ResourcesSource == appv1.ResourceStatusLocationCache {
+		if err := ctrl.cache.SetAppResourcesStatus(orig.InstanceName(ctrl.namespace), nil); err != nil {
+			logCtx.Warnf("Failed to delete the resources of the application from the cache: %v", err)
+		}
+	}
+	if size > ctrl.statusMaxSize {
+		logCtx.Warnf("Application size of %d bytes exceeds %d bytes", size, ctrl.statusMaxSize)
+	}
+}
+
+// appSize returns the size in bytes of the application with the given status
+func appSize(app *appv1.Application, status *appv1.ApplicationStatus) (int, error) {
+	withStatus := *app
+	withStatus.Status = *status
+	data, err := json.Marshal(&withStatus)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// truncateOperationMessages truncates the messages of an operation and of its synced resources, since the messages of
+// failed applies may include entire manifests
+func truncateOperationMessages(state *appv1.OperationState) {
+	state.Message = truncateMessage(state.Message)
+	if state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			res.Message = truncateMessage(res.Message)
+		}
+	}
+}
+
+func truncateMessage(message string) string {
+	if len(message) <= maxOperationMessageLength {
+		return message
+	}
+	end := maxOperationMessageLength - len(truncatedMessageSuffix)
+	// do not split multi-byte characters
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end] + truncatedMessageSuffix
+}
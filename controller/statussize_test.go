@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+func TestCapAppStatus(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	resources := []argoappv1.ResourceStatus{{Kind: "ConfigMap", Name: strings.Repeat("a", 1000)}}
+
+	t.Run("Disabled", func(t *testing.T) {
+		newStatus := &argoappv1.ApplicationStatus{Resources: resources}
+		ctrl.capAppStatus(app, newStatus)
+		assert.Equal(t, resources, newStatus.Resources)
+	})
+
+	ctrl.SetStatusMaxSize(1000)
+
+	t.Run("Offloaded", func(t *testing.T) {
+		newStatus := &argoappv1.ApplicationStatus{Resources: resources}
+		ctrl.capAppStatus(app, newStatus)
+		assert.Nil(t, newStatus.Resources)
+		assert.Equal(t, argoappv1.ResourceStatusLocationCache, newStatus.ResourcesSource)
+		var cached []argoappv1.ResourceStatus
+		require.NoError(t, ctrl.cache.GetAppResourcesStatus(app.InstanceName(ctrl.namespace), &cached))
+		assert.Equal(t, resources, cached)
+
+		// a status whose resources were not compared again still refers to the cache
+		ctrl.capAppStatus(app, newStatus)
+		assert.Equal(t, argoappv1.ResourceStatusLocationCache, newStatus.ResourcesSource)
+		requested, _ := ctrl.isRefreshRequested(ctrl.toAppKey(app.QualifiedName()))
+		assert.False(t, requested)
+	})
+
+	t.Run("MissingFromCache", func(t *testing.T) {
+		require.NoError(t, ctrl.cache.SetAppResourcesStatus(app.InstanceName(ctrl.namespace), nil))
+		newStatus := &argoappv1.ApplicationStatus{ResourcesSource: argoappv1.ResourceStatusLocationCache}
+		ctrl.capAppStatus(app, newStatus)
+		assert.Equal(t, argoappv1.ResourceStatusLocationCache, newStatus.ResourcesSource)
+		requested, level := ctrl.isRefreshRequested(ctrl.toAppKey(app.QualifiedName()))
+		assert.True(t, requested)
+		assert.Equal(t, CompareWithLatest, level)
+	})
+
+	t.Run("Inline", func(t *testing.T) {
+		orig := app.DeepCopy()
+		orig.Status.ResourcesSource = argoappv1.ResourceStatusLocationCache
+		small := []argoappv1.ResourceStatus{{Kind: "ConfigMap", Name: "a"}}
+		newStatus := &argoappv1.ApplicationStatus{Resources: small}
+		ctrl.capAppStatus(orig, newStatus)
+		assert.Equal(t, small, newStatus.Resources)
+		assert.Equal(t, argoappv1.ResourceStatusLocationInline, newStatus.ResourcesSource)
+		var cached []argoappv1.ResourceStatus
+		assert.Equal(t, appstate.ErrCacheMiss, ctrl.cache.GetAppResourcesStatus(app.InstanceName(ctrl.namespace), &cached))
+	})
+}
+
+func TestTruncateOperationMessages(t *testing.T) {
+	state := &argoappv1.OperationState{
+		Message: "short",
+		SyncResult: &argoappv1.SyncOperationResult{Resources: argoappv1.ResourceResults{
+			{Message: strings.Repeat("é", maxOperationMessageLength)},
+		}},
+	}
+	truncateOperationMessages(state)
+	assert.Equal(t, "short", state.Message)
+	message := state.SyncResult.Resources[0].Message
+	assert.LessOrEqual(t, len(message), maxOperationMessageLength)
+	assert.True(t, strings.HasSuffix(message, truncatedMessageSuffix))
+	assert.True(t, strings.HasPrefix(message, "éé"))
+	assert.Equal(t, strings.Repeat("é", (len(message)-len(truncatedMessageSuffix))/2)+truncatedMessageSuffix, message)
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
 
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
@@ -141,6 +144,16 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	// the revisions and sources requested by a manual sync or a rollback override the target revisions of the
+	// application, which are validated against the project with the rest of the application spec
+	if !state.Operation.InitiatedBy.Automated {
+		if revision, permitted := isSyncRevisionPermitted(proj, app, syncOp); !permitted {
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("Revision %s is not permitted in project '%s'", revision, proj.Name)
+			return
+		}
+	}
+
 	if app.Spec.HasMultipleSources() {
 		revisions = syncRes.Revisions
 	} else {
@@ -169,6 +182,23 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	// Evaluate the rendered manifests against the project policies before anything is applied to the cluster
+	var policyWarnings []string
+	if state.Phase != common.OperationTerminating {
+		denied, warnings, err := m.evaluatePolicyBundles(proj, compareResult.reconciliationResult.Target, compareResult.reconciliationResult.Hooks)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to evaluate project policies: %v", err)
+			return
+		}
+		if len(denied) > 0 {
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("Manifests violate project policies: %s", strings.Join(denied, "; "))
+			return
+		}
+		policyWarnings = warnings
+	}
+
 	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
 	if err != nil {
 		state.Phase = common.OperationError
@@ -179,6 +209,25 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	rawConfig := clst.RawRestConfig()
 	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clst.RESTConfig())
 
+	impersonationEnabled, err := m.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to check whether impersonation is enabled: %v", err)
+		return
+	}
+	if impersonationEnabled {
+		// the resources are applied with the permissions of the service account configured for the destination rather
+		// than with the permissions of the cluster credentials
+		serviceAccount, err := proj.GetImpersonatedServiceAccount(app.Spec.Destination)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to find the service account to impersonate: %v", err)
+			return
+		}
+		rawConfig.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+		restConfig.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		state.Phase = common.OperationError
@@ -254,7 +303,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
 		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
-			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
+			if !proj.IsGroupVersionKindPermitted(un.GroupVersionKind(), res.Namespaced) {
 				return fmt.Errorf("resource %s:%s is not permitted in project %s", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
 			}
 			if res.Namespaced {
@@ -285,7 +334,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
 		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
-		sync.WithServerSideApply(syncOp.SyncOptions.HasOption(common.SyncOptionServerSideApply)),
+		sync.WithServerSideApply(m.serverSideApplyEnabled(syncOp.SyncOptions)),
 		sync.WithServerSideApplyManager(cdcommon.ArgoCDSSAManager),
 	}
 
@@ -293,12 +342,20 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(m.resourceTracking, appLabelKey, trackingMethod, app.Name, app.Spec.SyncPolicy)))
 	}
 
+	maxTraces := maxResourceOperationTraces()
+	kubectl := m.kubectl
+	var tracer *resourceOperationTracer
+	if maxTraces > 0 {
+		tracer = newResourceOperationTracer(m.kubectl)
+		kubectl = tracer
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		kubectl,
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		opts...,
@@ -321,6 +378,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	if len(policyWarnings) > 0 {
+		state.Message = fmt.Sprintf("%s (policy warnings: %s)", state.Message, strings.Join(policyWarnings, "; "))
+	}
 	state.SyncResult.Resources = nil
 	for _, res := range resState {
 		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
@@ -336,11 +396,14 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			Message:   res.Message,
 		})
 	}
+	if tracer != nil {
+		state.SyncResult.ResourceOperations = tracer.mergeInto(state.SyncResult.ResourceOperations, maxTraces)
+	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, app.Spec.HasMultipleSources(), state.StartedAt)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, app.Spec.HasMultipleSources(), state.StartedAt, state.Operation)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -348,6 +411,69 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 }
 
+// isSyncRevisionPermitted returns whether the revisions and the target revisions of the sources requested by the sync
+// operation are permitted by the project. Otherwise, it returns the first revision which is not permitted. The
+// revisions of a rollback are the commits deployed from the target revisions of the sources in the history, so only
+// the target revisions are checked when the operation matches a deployment of the history.
+func isSyncRevisionPermitted(proj *v1alpha1.AppProject, app *v1alpha1.Application, syncOp v1alpha1.SyncOperation) (string, bool) {
+	if app.Spec.HasMultipleSources() {
+		sources := app.Spec.Sources
+		if syncOp.Sources != nil {
+			for i, source := range syncOp.Sources {
+				if i < len(app.Spec.Sources) && source.TargetRevision == app.Spec.Sources[i].TargetRevision {
+					continue
+				}
+				if !proj.IsSourceRevisionPermitted(source.TargetRevision) {
+					return source.TargetRevision, false
+				}
+			}
+			if isRollbackOperation(app, syncOp) {
+				return "", true
+			}
+			sources = syncOp.Sources
+		}
+		for i, revision := range syncOp.Revisions {
+			if revision == "" || i >= len(sources) || revision == sources[i].TargetRevision {
+				continue
+			}
+			if !proj.IsSourceRevisionPermitted(revision) {
+				return revision, false
+			}
+		}
+		return "", true
+	}
+	targetRevision := app.Spec.GetSource().TargetRevision
+	if syncOp.Source != nil {
+		if syncOp.Source.TargetRevision != targetRevision && !proj.IsSourceRevisionPermitted(syncOp.Source.TargetRevision) {
+			return syncOp.Source.TargetRevision, false
+		}
+		if isRollbackOperation(app, syncOp) {
+			return "", true
+		}
+		targetRevision = syncOp.Source.TargetRevision
+	}
+	revision := syncOp.Revision
+	if revision == "" || revision == targetRevision {
+		return "", true
+	}
+	return revision, proj.IsSourceRevisionPermitted(revision)
+}
+
+// isRollbackOperation returns whether the sources and the revisions of the sync operation are the ones of a
+// deployment of the history of the application
+func isRollbackOperation(app *v1alpha1.Application, syncOp v1alpha1.SyncOperation) bool {
+	for _, history := range app.Status.History {
+		if app.Spec.HasMultipleSources() {
+			if reflect.DeepEqual(history.Sources, syncOp.Sources) && reflect.DeepEqual(history.Revisions, syncOp.Revisions) {
+				return true
+			}
+		} else if syncOp.Source != nil && history.Source.Equals(*syncOp.Source) && history.Revision == syncOp.Revision {
+			return true
+		}
+	}
+	return false
+}
+
 // normalizeTargetResources will apply the diff normalization in all live and target resources.
 // Then it calculates the merge patch between the normalized live and the current live resources.
 // Finally it applies the merge patch in the normalized target resources. This is done to ensure
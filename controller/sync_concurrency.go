@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+)
+
+// clusterSyncLimitRetryDelay is the delay after which an operation postponed because of the sync concurrency limit of
+// its destination cluster is processed again
+const clusterSyncLimitRetryDelay = 5 * time.Second
+
+// clusterSyncLimiter limits the number of sync operations running concurrently per destination cluster. An operation
+// holds its slot from its first sync step until it completes, since the operations of multi-wave or hook syncs run
+// over several steps.
+type clusterSyncLimiter struct {
+	lock sync.Mutex
+	// running holds the keys of the applications whose operation holds a slot, per destination cluster
+	running map[string]map[string]bool
+	// servers holds the destination cluster of the applications whose operation holds a slot
+	servers map[string]string
+}
+
+func newClusterSyncLimiter() *clusterSyncLimiter {
+	return &clusterSyncLimiter{running: make(map[string]map[string]bool), servers: make(map[string]string)}
+}
+
+// holds returns whether the operation of the given application holds a sync slot
+func (l *clusterSyncLimiter) holds(appKey string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	_, ok := l.servers[appKey]
+	return ok
+}
+
+// tryAcquire reserves a sync slot of the given cluster for the operation of the given application and returns true,
+// unless the limit is already reached
+func (l *clusterSyncLimiter) tryAcquire(appKey string, server string, limit int64) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if _, ok := l.servers[appKey]; ok {
+		return true
+	}
+	if limit > 0 && int64(len(l.running[server])) >= limit {
+		return false
+	}
+	if l.running[server] == nil {
+		l.running[server] = make(map[string]bool)
+	}
+	l.running[server][appKey] = true
+	l.servers[appKey] = server
+	return true
+}
+
+// release frees the sync slot held by the operation of the given application, if any
+func (l *clusterSyncLimiter) release(appKey string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	server, ok := l.servers[appKey]
+	if !ok {
+		return
+	}
+	delete(l.servers, appKey)
+	delete(l.running[server], appKey)
+	if len(l.running[server]) == 0 {
+		delete(l.running, server)
+	}
+}
+
+// getClusterSyncLimit returns the destination server of the application and the maximum number of sync operations
+// which might be processed concurrently for it. Zero means no limit.
+func (ctrl *ApplicationController) getClusterSyncLimit(app *appv1.Application) (string, int64, error) {
+	dest := app.Spec.Destination
+	if err := argo.ValidateDestination(context.Background(), &dest, ctrl.db); err != nil {
+		return "", 0, err
+	}
+	cluster, err := ctrl.db.GetCluster(context.Background(), dest.Server)
+	if err != nil {
+		return "", 0, fmt.Errorf("error getting cluster %s: %w", dest.Server, err)
+	}
+	if cluster.MaxConcurrentSyncs > 0 {
+		return dest.Server, cluster.MaxConcurrentSyncs, nil
+	}
+	limit, err := ctrl.settingsMgr.GetClusterMaxConcurrentSyncs()
+	if err != nil {
+		return "", 0, err
+	}
+	return dest.Server, limit, nil
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func TestClusterSyncLimiter(t *testing.T) {
+	limiter := newClusterSyncLimiter()
+
+	assert.True(t, limiter.tryAcquire("argocd/app-1", "https://cluster-1", 2))
+	assert.True(t, limiter.tryAcquire("argocd/app-2", "https://cluster-1", 2))
+	assert.False(t, limiter.tryAcquire("argocd/app-3", "https://cluster-1", 2))
+	assert.True(t, limiter.tryAcquire("argocd/app-3", "https://cluster-2", 2))
+
+	// the next steps of an operation holding a slot are not limited
+	assert.True(t, limiter.holds("argocd/app-1"))
+	assert.True(t, limiter.tryAcquire("argocd/app-1", "https://cluster-1", 2))
+
+	limiter.release("argocd/app-1")
+	assert.False(t, limiter.holds("argocd/app-1"))
+	assert.True(t, limiter.tryAcquire("argocd/app-4", "https://cluster-1", 2))
+	// releasing an application which does not hold a slot is a no-op
+	limiter.release("argocd/app-1")
+	assert.False(t, limiter.tryAcquire("argocd/app-5", "https://cluster-1", 2))
+
+	// no limit
+	for i := 0; i < 10; i++ {
+		assert.True(t, limiter.tryAcquire(fmt.Sprintf("argocd/app-%d", i), "https://cluster-3", 0))
+	}
+}
+
+func TestProcessAppOperationQueueItem_ClusterSyncLimit(t *testing.T) {
+	running := newFakeApp()
+	running.Name = "running"
+	running.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	running.Status.OperationState = &v1alpha1.OperationState{Operation: *running.Operation, Phase: synccommon.OperationRunning}
+	waiting := newFakeApp()
+	waiting.Name = "waiting"
+	waiting.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	waiting.Status.OperationState = nil
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{running, waiting, &defaultProj}, configMapData: map[string]string{
+		"cluster.maxConcurrentSyncs": "1",
+	}})
+	// the operation of the running application holds the slot of the cluster between its sync steps
+	require.True(t, ctrl.clusterSyncLimiter.tryAcquire(test.FakeArgoCDNamespace+"/running", running.Spec.Destination.Server, 1))
+
+	ctrl.appOperationQueue.Add(test.FakeArgoCDNamespace + "/waiting")
+	ctrl.processAppOperationQueueItem()
+	assert.False(t, ctrl.clusterSyncLimiter.holds(test.FakeArgoCDNamespace+"/waiting"))
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "waiting", metav1.GetOptions{})
+	require.NoError(t, err)
+	// the operation is postponed
+	assert.NotNil(t, app.Operation)
+	assert.Nil(t, app.Status.OperationState)
+
+	// the slot is released once the operation is removed
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "running", metav1.GetOptions{})
+	require.NoError(t, err)
+	app.Operation = nil
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Update(context.Background(), app, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	ctrl.appOperationQueue.Add(test.FakeArgoCDNamespace + "/running")
+	ctrl.processAppOperationQueueItem()
+	assert.False(t, ctrl.clusterSyncLimiter.holds(test.FakeArgoCDNamespace+"/running"))
+	assert.True(t, ctrl.clusterSyncLimiter.tryAcquire(test.FakeArgoCDNamespace+"/waiting", waiting.Spec.Destination.Server, 1))
+}
+
+func TestGetClusterSyncLimit(t *testing.T) {
+	app := newFakeApp()
+
+	t.Run("NoLimit", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}})
+		server, limit, err := ctrl.getClusterSyncLimit(app)
+		require.NoError(t, err)
+		assert.Equal(t, "https://localhost:6443", server)
+		assert.Equal(t, int64(0), limit)
+	})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, configMapData: map[string]string{
+			"cluster.maxConcurrentSyncs": "3",
+		}})
+		_, limit, err := ctrl.getClusterSyncLimit(app)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), limit)
+	})
+
+	t.Run("ClusterLimit", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, configMapData: map[string]string{
+			"cluster.maxConcurrentSyncs": "3",
+		}})
+		cluster, err := ctrl.db.GetCluster(context.Background(), "https://localhost:6443")
+		require.NoError(t, err)
+		cluster.MaxConcurrentSyncs = 1
+		_, err = ctrl.db.UpdateCluster(context.Background(), cluster)
+		require.NoError(t, err)
+
+		_, limit, err := ctrl.getClusterSyncLimit(app)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), limit)
+	})
+}
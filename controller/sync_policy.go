@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// evaluatePolicyBundles evaluates the given resources against the policy bundles configured in the project. It
+// returns the violations which must fail the sync operation and the violations which are only reported as warnings.
+func (m *appStateManager) evaluatePolicyBundles(proj *v1alpha1.AppProject, resources ...[]*unstructured.Unstructured) ([]string, []string, error) {
+	if len(proj.Spec.PolicyBundles) == 0 {
+		return nil, nil, nil
+	}
+	var manifests []string
+	for _, objs := range resources {
+		for _, obj := range objs {
+			if obj == nil {
+				continue
+			}
+			data, err := json.Marshal(obj.Object)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to marshal %s: %w", obj.GetName(), err)
+			}
+			manifests = append(manifests, string(data))
+		}
+	}
+	if len(manifests) == 0 {
+		return nil, nil, nil
+	}
+
+	conn, repoClient, err := m.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer io.Close(conn)
+
+	var denied, warnings []string
+	for i := range proj.Spec.PolicyBundles {
+		bundle := proj.Spec.PolicyBundles[i]
+		// like application sources, bundles are only fetched from the repositories permitted by the project
+		if !proj.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: bundle.RepoURL}) {
+			return nil, nil, fmt.Errorf("policy bundle repository %s is not permitted in project '%s'", bundle.RepoURL, proj.Name)
+		}
+		repo, err := m.db.GetRepository(context.Background(), bundle.RepoURL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get repository %s: %w", bundle.RepoURL, err)
+		}
+		if repo.Project != "" && repo.Project != proj.Name {
+			return nil, nil, fmt.Errorf("policy bundle repository %s belongs to project '%s'", bundle.RepoURL, repo.Project)
+		}
+		res, err := repoClient.EvaluatePolicies(context.Background(), &apiclient.PolicyEvaluationRequest{
+			Repo:      repo,
+			Bundle:    &bundle,
+			Manifests: manifests,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to evaluate policy bundle %s: %w", bundle.RepoURL, err)
+		}
+		for _, violation := range res.Violations {
+			message := formatPolicyViolation(violation)
+			if violation.Warning || bundle.IsWarnOnly() {
+				warnings = append(warnings, message)
+			} else {
+				denied = append(denied, message)
+			}
+		}
+	}
+	return denied, warnings, nil
+}
+
+func formatPolicyViolation(violation *apiclient.PolicyViolation) string {
+	policy := violation.Policy
+	if violation.Rule != "" {
+		policy = fmt.Sprintf("%s/%s", violation.Policy, violation.Rule)
+	}
+	resource := fmt.Sprintf("%s/%s", violation.Kind, violation.Name)
+	if violation.Namespace != "" {
+		resource = fmt.Sprintf("%s/%s", violation.Namespace, resource)
+	}
+	return fmt.Sprintf("%s: %s: %s", resource, policy, violation.Message)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,8 +46,11 @@ func TestPersistRevisionHistory(t *testing.T) {
 	ctrl := newFakeController(&data)
 
 	// Sync with source unspecified
+	approvedAt := v1.Now()
 	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{},
+		Sync:        &v1alpha1.SyncOperation{},
+		InitiatedBy: v1alpha1.OperationInitiator{Username: "alice"},
+		Approval:    &v1alpha1.OperationApproval{ApprovedBy: "bob", ApprovedAt: &approvedAt},
 	}}
 	ctrl.appStateManager.SyncAppState(app, opState)
 	// Ensure we record spec.source into sync result
@@ -57,6 +61,8 @@ func TestPersistRevisionHistory(t *testing.T) {
 	assert.Equal(t, 1, len(updatedApp.Status.History))
 	assert.Equal(t, app.Spec.GetSource(), updatedApp.Status.History[0].Source)
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
+	assert.Equal(t, "alice", updatedApp.Status.History[0].InitiatedBy.Username)
+	assert.Equal(t, "bob", updatedApp.Status.History[0].Approval.ApprovedBy)
 }
 
 func TestPersistRevisionHistoryRollback(t *testing.T) {
@@ -216,6 +222,202 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 	})
 }
 
+func TestSyncAppStatePolicyBundles(t *testing.T) {
+	setupWithSourceRepos := func(sourceRepos []string, action string, violations ...*apiclient.PolicyViolation) *ApplicationController {
+		app := newFakeApp()
+		project := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos: sourceRepos,
+				PolicyBundles: []v1alpha1.PolicyBundle{{
+					RepoURL: "https://github.com/argoproj/policies",
+					Engine:  v1alpha1.PolicyEngineKyverno,
+					Action:  action,
+				}},
+			},
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{PodManifest},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs:          make(map[kube.ResourceKey]*unstructured.Unstructured),
+			policyEvaluationResponse: &apiclient.PolicyEvaluationResponse{Violations: violations},
+		}
+		return newFakeController(&data)
+	}
+	setup := func(action string, violations ...*apiclient.PolicyViolation) *ApplicationController {
+		return setupWithSourceRepos([]string{"*"}, action, violations...)
+	}
+	violation := &apiclient.PolicyViolation{
+		Policy:  "require-labels",
+		Rule:    "check-team",
+		Message: "label team is required",
+		Kind:    "Pod",
+		Name:    "my-pod",
+	}
+
+	t.Run("DenyViolation", func(t *testing.T) {
+		ctrl := setup(v1alpha1.PolicyActionDeny, violation)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Equal(t, "Manifests violate project policies: Pod/my-pod: require-labels/check-team: label team is required", opState.Message)
+	})
+
+	t.Run("WarnViolation", func(t *testing.T) {
+		ctrl := setup(v1alpha1.PolicyActionWarn, violation)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.NotContains(t, opState.Message, "Manifests violate project policies")
+		assert.Contains(t, opState.Message, "(policy warnings: Pod/my-pod: require-labels/check-team: label team is required)")
+	})
+
+	t.Run("NoViolation", func(t *testing.T) {
+		ctrl := setup(v1alpha1.PolicyActionDeny)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.NotContains(t, opState.Message, "polic")
+	})
+
+	t.Run("RepositoryNotPermitted", func(t *testing.T) {
+		ctrl := setupWithSourceRepos([]string{"https://github.com/argoproj/argocd-example-apps"}, v1alpha1.PolicyActionDeny)
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.Equal(t, common.OperationError, opState.Phase)
+		assert.Equal(t, "Failed to evaluate project policies: policy bundle repository https://github.com/argoproj/policies is not permitted in project 'default'", opState.Message)
+	})
+}
+
+func TestSyncAppStateImpersonation(t *testing.T) {
+	setup := func(serviceAccounts ...v1alpha1.ApplicationDestinationServiceAccount) *ApplicationController {
+		app := newFakeApp()
+		project := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+			Spec: v1alpha1.AppProjectSpec{DestinationServiceAccounts: serviceAccounts},
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{PodManifest},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			configMapData:   map[string]string{"application.sync.impersonation.enabled": "true"},
+		}
+		return newFakeController(&data)
+	}
+
+	t.Run("NoServiceAccount", func(t *testing.T) {
+		ctrl := setup()
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.Equal(t, common.OperationError, opState.Phase)
+		assert.Contains(t, opState.Message, "Failed to find the service account to impersonate")
+	})
+
+	t.Run("ServiceAccount", func(t *testing.T) {
+		ctrl := setup(v1alpha1.ApplicationDestinationServiceAccount{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"})
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.NotContains(t, opState.Message, "impersonate")
+	})
+}
+
+func TestSyncAppStateSourceRevisions(t *testing.T) {
+	setup := func() *ApplicationController {
+		app := newFakeApp()
+		project := &v1alpha1.AppProject{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: test.FakeArgoCDNamespace,
+				Name:      "default",
+			},
+			Spec: v1alpha1.AppProjectSpec{SourceRevisions: []string{"HEAD", "v*"}},
+		}
+		data := fakeData{
+			apps: []runtime.Object{app, project},
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		return newFakeController(&data)
+	}
+
+	t.Run("NotPermittedRevision", func(t *testing.T) {
+		ctrl := setup()
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "feature/foo"}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "Revision feature/foo is not permitted in project 'default'")
+	})
+
+	t.Run("PermittedRevision", func(t *testing.T) {
+		ctrl := setup()
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "v1.0.0"}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.NotContains(t, opState.Message, "is not permitted")
+	})
+
+	t.Run("NotPermittedSourceRevision", func(t *testing.T) {
+		ctrl := setup()
+		source := newFakeApp().Spec.GetSource()
+		source.TargetRevision = "feature/foo"
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Source: &source, Revision: "abc123"}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "Revision feature/foo is not permitted in project 'default'")
+	})
+
+	t.Run("NotPermittedRevisionWithSource", func(t *testing.T) {
+		ctrl := setup()
+		source := newFakeApp().Spec.GetSource()
+		source.TargetRevision = "v1.0.0"
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Source: &source, Revision: "feature/foo"}}}
+		ctrl.appStateManager.SyncAppState(newFakeApp(), opState)
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "Revision feature/foo is not permitted in project 'default'")
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		ctrl := setup()
+		app := newFakeApp()
+		source := app.Spec.GetSource()
+		source.TargetRevision = "v1.0.0"
+		app.Status.History = v1alpha1.RevisionHistories{{ID: 1, Source: source, Revision: "abc123"}}
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Source: &source, Revision: "abc123"}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.NotContains(t, opState.Message, "is not permitted")
+	})
+
+	t.Run("NotPermittedSources", func(t *testing.T) {
+		ctrl := setup()
+		app := newFakeApp()
+		app.Spec.Sources = v1alpha1.ApplicationSources{app.Spec.GetSource()}
+		app.Spec.Source = nil
+		sources := v1alpha1.ApplicationSources{app.Spec.GetSource()}
+		sources[0].TargetRevision = "feature/foo"
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Sources: sources, Revisions: []string{"abc123"}}}}
+		ctrl.appStateManager.SyncAppState(app, opState)
+		assert.Equal(t, common.OperationFailed, opState.Phase)
+		assert.Contains(t, opState.Message, "Revision feature/foo is not permitted in project 'default'")
+	})
+}
+
 func TestNormalizeTargetResources(t *testing.T) {
 	type fixture struct {
 		comparisonResult *comparisonResult
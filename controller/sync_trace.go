@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/env"
+)
+
+const (
+	// EnvVarMaxResourceOperationTraces is an environment variable which controls the maximum number of resource
+	// operations recorded in the result of a sync. Only the slowest operations are kept, and 0 disables the recording.
+	EnvVarMaxResourceOperationTraces = "ARGOCD_SYNC_MAX_RESOURCE_OPERATION_TRACES"
+
+	// maxResourceOperationMessageLength is the maximum length of the server response recorded for a resource operation
+	maxResourceOperationMessageLength = 512
+)
+
+// resourceOperationTracer wraps the kubectl used by a sync to record the duration and the server response of the
+// operations applied to each resource. Dry runs are not recorded.
+type resourceOperationTracer struct {
+	kubeutil.Kubectl
+	lock   sync.Mutex
+	traces []v1alpha1.ResourceOperationTrace
+}
+
+func newResourceOperationTracer(kubectl kubeutil.Kubectl) *resourceOperationTracer {
+	return &resourceOperationTracer{Kubectl: kubectl}
+}
+
+func (t *resourceOperationTracer) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kubeutil.ResourceOperations, func(), error) {
+	ops, cleanup, err := t.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &tracedResourceOperations{ResourceOperations: ops, tracer: t}, cleanup, nil
+}
+
+func (t *resourceOperationTracer) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions metav1.DeleteOptions) error {
+	startedAt := time.Now()
+	err := t.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
+	t.record("prune", gvk.Group, gvk.Kind, namespace, name, startedAt, "pruned", err)
+	return err
+}
+
+func (t *resourceOperationTracer) record(operation string, group string, kind string, namespace string, name string, startedAt time.Time, message string, err error) {
+	trace := v1alpha1.ResourceOperationTrace{
+		Group:                group,
+		Kind:                 kind,
+		Namespace:            namespace,
+		Name:                 name,
+		Operation:            operation,
+		StartedAt:            metav1.NewTime(startedAt),
+		DurationMilliseconds: time.Since(startedAt).Milliseconds(),
+		Message:              message,
+	}
+	if err != nil {
+		trace.Failed = true
+		trace.Message = err.Error()
+	}
+	if len(trace.Message) > maxResourceOperationMessageLength {
+		trace.Message = trace.Message[:maxResourceOperationMessageLength] + "..."
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.traces = append(t.traces, trace)
+}
+
+// mergeInto adds the recorded traces to the given ones, which were recorded by the previous runs of the sync, and
+// returns the slowest ones, at most max, ordered by start time
+func (t *resourceOperationTracer) mergeInto(traces []v1alpha1.ResourceOperationTrace, max int) []v1alpha1.ResourceOperationTrace {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	merged := append(append([]v1alpha1.ResourceOperationTrace{}, traces...), t.traces...)
+	if len(merged) > max {
+		sort.SliceStable(merged, func(i, j int) bool {
+			return merged[i].DurationMilliseconds > merged[j].DurationMilliseconds
+		})
+		merged = merged[:max]
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].StartedAt.Before(&merged[j].StartedAt)
+	})
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+// tracedResourceOperations records the operations applied to the resources by the wrapped resource operations
+type tracedResourceOperations struct {
+	kubeutil.ResourceOperations
+	tracer *resourceOperationTracer
+}
+
+func (o *tracedResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	startedAt := time.Now()
+	message, err := o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+	o.record("apply", obj, dryRunStrategy, startedAt, message, err)
+	return message, err
+}
+
+func (o *tracedResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, force bool) (string, error) {
+	startedAt := time.Now()
+	message, err := o.ResourceOperations.ReplaceResource(ctx, obj, dryRunStrategy, force)
+	o.record("replace", obj, dryRunStrategy, startedAt, message, err)
+	return message, err
+}
+
+func (o *tracedResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, validate bool) (string, error) {
+	startedAt := time.Now()
+	message, err := o.ResourceOperations.CreateResource(ctx, obj, dryRunStrategy, validate)
+	o.record("create", obj, dryRunStrategy, startedAt, message, err)
+	return message, err
+}
+
+func (o *tracedResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy) (*unstructured.Unstructured, error) {
+	startedAt := time.Now()
+	res, err := o.ResourceOperations.UpdateResource(ctx, obj, dryRunStrategy)
+	o.record("update", obj, dryRunStrategy, startedAt, "updated", err)
+	return res, err
+}
+
+func (o *tracedResourceOperations) record(operation string, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, startedAt time.Time, message string, err error) {
+	if dryRunStrategy != util.DryRunNone {
+		return
+	}
+	gvk := obj.GroupVersionKind()
+	o.tracer.record(operation, gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName(), startedAt, message, err)
+}
+
+func maxResourceOperationTraces() int {
+	return env.ParseNumFromEnv(EnvVarMaxResourceOperationTraces, 50, 0, 1000)
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestResourceOperationTracer(t *testing.T) {
+	kubectl := &kubetest.MockKubectlCmd{Commands: map[string]kubetest.KubectlOutput{
+		"my-service": {Output: "service/my-service configured"},
+		"my-pod":     {Err: fmt.Errorf("admission webhook denied the request: %s", strings.Repeat("x", 1000))},
+	}}
+	tracer := newResourceOperationTracer(kubectl)
+	ops, cleanup, err := tracer.ManageResources(nil, nil)
+	require.NoError(t, err)
+	defer cleanup()
+
+	_, err = ops.ApplyResource(context.Background(), NewService(), util.DryRunClient, false, true, false, "")
+	require.NoError(t, err)
+	_, err = ops.ApplyResource(context.Background(), NewService(), util.DryRunNone, false, true, false, "")
+	require.NoError(t, err)
+	_, err = ops.CreateResource(context.Background(), NewPod(), util.DryRunNone, true)
+	require.Error(t, err)
+	err = tracer.DeleteResource(context.Background(), nil, schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, "guestbook", "default", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	traces := tracer.mergeInto(nil, 10)
+	require.Len(t, traces, 3)
+	assert.Equal(t, "apply", traces[0].Operation)
+	assert.Equal(t, "Service", traces[0].Kind)
+	assert.Equal(t, "my-service", traces[0].Name)
+	assert.Equal(t, "service/my-service configured", traces[0].Message)
+	assert.False(t, traces[0].Failed)
+	assert.Equal(t, "create", traces[1].Operation)
+	assert.True(t, traces[1].Failed)
+	assert.True(t, strings.HasPrefix(traces[1].Message, "admission webhook denied the request"))
+	assert.Len(t, traces[1].Message, maxResourceOperationMessageLength+len("..."))
+	assert.Equal(t, "prune", traces[2].Operation)
+	assert.Equal(t, "apps", traces[2].Group)
+	assert.Equal(t, "Deployment", traces[2].Kind)
+}
+
+func TestResourceOperationTracer_MergeInto(t *testing.T) {
+	now := time.Now()
+	previous := []v1alpha1.ResourceOperationTrace{
+		{Name: "fast", StartedAt: metav1.NewTime(now.Add(-3 * time.Minute)), DurationMilliseconds: 10},
+		{Name: "slow", StartedAt: metav1.NewTime(now.Add(-2 * time.Minute)), DurationMilliseconds: 60000},
+	}
+	tracer := newResourceOperationTracer(&kubetest.MockKubectlCmd{})
+	tracer.traces = []v1alpha1.ResourceOperationTrace{
+		{Name: "slower", StartedAt: metav1.NewTime(now.Add(-time.Minute)), DurationMilliseconds: 120000},
+	}
+
+	traces := tracer.mergeInto(previous, 2)
+	require.Len(t, traces, 2)
+	assert.Equal(t, "slow", traces[0].Name)
+	assert.Equal(t, "slower", traces[1].Name)
+
+	assert.Len(t, tracer.mergeInto(previous, 10), 3)
+	assert.Nil(t, newResourceOperationTracer(&kubetest.MockKubectlCmd{}).mergeInto(nil, 10))
+}
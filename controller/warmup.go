@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+// SetStatusWarmupDuration sets the duration following the controller start during which the comparison of the
+// applications whose comparison results persisted across the restart is deferred, see deferComparison
+func (ctrl *ApplicationController) SetStatusWarmupDuration(duration time.Duration) {
+	ctrl.statusWarmupDuration = duration
+}
+
+func (ctrl *ApplicationController) isWarmingUp() bool {
+	return ctrl.statusWarmupDuration > 0 && !ctrl.startedAt.IsZero() && time.Since(ctrl.startedAt) < ctrl.statusWarmupDuration
+}
+
+// setAppComparisonState records the comparison whose results were just cached for the given application
+func (ctrl *ApplicationController) setAppComparisonState(app *appv1.Application, syncStatus *appv1.SyncStatus) {
+	state := &appstatecache.ComparisonState{Generation: app.Generation, ComparedAt: time.Now().UTC()}
+	if syncStatus != nil {
+		if app.Spec.HasMultipleSources() {
+			state.Revisions = syncStatus.Revisions
+		} else if syncStatus.Revision != "" {
+			state.Revisions = []string{syncStatus.Revision}
+		}
+	}
+	if err := ctrl.cache.SetAppComparisonState(app.InstanceName(ctrl.namespace), state); err != nil {
+		log.WithField("application", app.QualifiedName()).Warnf("Failed to cache comparison state: %v", err)
+	}
+}
+
+// deferComparison returns true if the comparison of the given application, whose status refresh period expired, is
+// deferred because the controller is warming up. Right after a restart, the status of every application which was not
+// reconciled during the last refresh period expires at once. The comparison of the applications whose comparison results
+// are still cached for their current generation is instead spread over the warm-up duration, while the API keeps
+// serving the cached results. The comparison of an application is deferred at most once, and never if the application
+// has a positive reconcile priority.
+func (ctrl *ApplicationController) deferComparison(app *appv1.Application) bool {
+	if !ctrl.isWarmingUp() || ctrl.reconcilePriority(app) > 0 {
+		return false
+	}
+	key := ctrl.toAppKey(app.QualifiedName())
+	if _, deferred := ctrl.deferredComparisons.LoadOrStore(key, true); deferred {
+		return false
+	}
+	var state appstatecache.ComparisonState
+	if err := ctrl.cache.GetAppComparisonState(app.InstanceName(ctrl.namespace), &state); err != nil || state.Generation != app.Generation {
+		return false
+	}
+	delay := time.Duration(rand.Int63n(int64(ctrl.statusWarmupDuration)))
+	log.WithField("application", app.QualifiedName()).Debugf("Deferring comparison by %v while the controller is warming up, last compared at %v", delay, state.ComparedAt)
+	ctrl.appRefreshQueue.AddAfter(key, delay)
+	return true
+}
+
+// parseReconcilePriority returns the reconcile priority held by the given annotations, if any
+func parseReconcilePriority(annotations map[string]string) (int, bool) {
+	value, ok := annotations[common.AnnotationKeyReconcilePriority]
+	if !ok {
+		return 0, false
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return priority, true
+}
+
+// reconcilePriority returns the reconcile priority of the given application, which is set by the reconcile priority
+// annotation of the application, or of its project if the application has none. The default priority is 0.
+func (ctrl *ApplicationController) reconcilePriority(app *appv1.Application) int {
+	if priority, ok := parseReconcilePriority(app.Annotations); ok {
+		return priority
+	}
+	proj, err := applisters.NewAppProjectLister(ctrl.projInformer.GetIndexer()).AppProjects(ctrl.namespace).Get(app.Spec.GetProject())
+	if err != nil {
+		return 0
+	}
+	priority, _ := parseReconcilePriority(proj.Annotations)
+	return priority
+}
+
+// queueInitialApps queues the applications which exist when the controller starts in the order of their reconcile
+// priority, so that the applications with the highest priority regain a fresh status first. Until they are queued,
+// the applications added by the informer are not queued individually, see appsQueued.
+func (ctrl *ApplicationController) queueInitialApps() error {
+	ctrl.appsQueuedLock.Lock()
+	defer ctrl.appsQueuedLock.Unlock()
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var queued []*appv1.Application
+	priorities := map[*appv1.Application]int{}
+	for _, app := range apps {
+		if !ctrl.canProcessApp(app) {
+			continue
+		}
+		queued = append(queued, app)
+		priorities[app] = ctrl.reconcilePriority(app)
+	}
+	sort.SliceStable(queued, func(i, j int) bool {
+		if priorities[queued[i]] != priorities[queued[j]] {
+			return priorities[queued[i]] > priorities[queued[j]]
+		}
+		return queued[i].QualifiedName() < queued[j].QualifiedName()
+	})
+	for _, app := range queued {
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err != nil {
+			continue
+		}
+		ctrl.appRefreshQueue.Add(key)
+		ctrl.appOperationQueue.Add(key)
+	}
+	ctrl.appsQueued = true
+	log.Infof("Queued %d applications in the order of their reconcile priority", len(queued))
+	return nil
+}
+
+// shouldQueueAddedApp returns whether an application added by the informer is queued, which is the case once the
+// applications which existed when the controller started are queued
+func (ctrl *ApplicationController) shouldQueueAddedApp() bool {
+	ctrl.appsQueuedLock.Lock()
+	defer ctrl.appsQueuedLock.Unlock()
+	return ctrl.appsQueued
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+func newExpiredFakeApp() *argoappv1.Application {
+	app := newFakeApp()
+	app.Generation = 2
+	reconciledAt := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	app.Status.ReconciledAt = &reconciledAt
+	app.Status.Sync = argoappv1.SyncStatus{
+		Status: argoappv1.SyncStatusCodeSynced,
+		ComparedTo: argoappv1.ComparedTo{
+			Source:      app.Spec.GetSource(),
+			Destination: app.Spec.Destination,
+		},
+	}
+	return app
+}
+
+func TestSetAppComparisonState(t *testing.T) {
+	app := newExpiredFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	ctrl.setAppComparisonState(app, &argoappv1.SyncStatus{Revision: "abc"})
+
+	var state appstatecache.ComparisonState
+	require.NoError(t, ctrl.cache.GetAppComparisonState(app.InstanceName(ctrl.namespace), &state))
+	assert.Equal(t, int64(2), state.Generation)
+	assert.Equal(t, []string{"abc"}, state.Revisions)
+	assert.False(t, state.ComparedAt.IsZero())
+}
+
+func TestNeedRefreshAppStatus_Warmup(t *testing.T) {
+	t.Run("Deferred", func(t *testing.T) {
+		app := newExpiredFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.SetStatusWarmupDuration(time.Minute)
+		ctrl.startedAt = time.Now()
+		ctrl.setAppComparisonState(app, &argoappv1.SyncStatus{Revision: "abc"})
+
+		needRefresh, _, _ := ctrl.needRefreshAppStatus(app, time.Hour, 0)
+		assert.False(t, needRefresh)
+
+		// the comparison is deferred once
+		needRefresh, _, compareWith := ctrl.needRefreshAppStatus(app, time.Hour, 0)
+		assert.True(t, needRefresh)
+		assert.Equal(t, CompareWithLatest, compareWith)
+	})
+
+	t.Run("NotWarmingUp", func(t *testing.T) {
+		app := newExpiredFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.SetStatusWarmupDuration(time.Minute)
+		ctrl.startedAt = time.Now().Add(-2 * time.Minute)
+		ctrl.setAppComparisonState(app, &argoappv1.SyncStatus{Revision: "abc"})
+
+		needRefresh, _, _ := ctrl.needRefreshAppStatus(app, time.Hour, 0)
+		assert.True(t, needRefresh)
+	})
+
+	t.Run("NoComparisonState", func(t *testing.T) {
+		app := newExpiredFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.SetStatusWarmupDuration(time.Minute)
+		ctrl.startedAt = time.Now()
+
+		needRefresh, _, _ := ctrl.needRefreshAppStatus(app, time.Hour, 0)
+		assert.True(t, needRefresh)
+	})
+
+	t.Run("GenerationChanged", func(t *testing.T) {
+		app := newExpiredFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.SetStatusWarmupDuration(time.Minute)
+		ctrl.startedAt = time.Now()
+		ctrl.setAppComparisonState(app, &argoappv1.SyncStatus{Revision: "abc"})
+		app.Generation = 3
+
+		needRefresh, _, _ := ctrl.needRefreshAppStatus(app, time.Hour, 0)
+		assert.True(t, needRefresh)
+	})
+
+	t.Run("HardExpired", func(t *testing.T) {
+		app := newExpiredFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.SetStatusWarmupDuration(time.Minute)
+		ctrl.startedAt = time.Now()
+		ctrl.setAppComparisonState(app, &argoappv1.SyncStatus{Revision: "abc"})
+
+		needRefresh, refreshType, _ := ctrl.needRefreshAppStatus(app, time.Hour, time.Hour)
+		assert.True(t, needRefresh)
+		assert.Equal(t, argoappv1.RefreshTypeHard, refreshType)
+	})
+
+	t.Run("Prioritized", func(t *testing.T) {
+		app := newExpiredFakeApp()
+		app.Annotations = map[string]string{common.AnnotationKeyReconcilePriority: "10"}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		ctrl.SetStatusWarmupDuration(time.Minute)
+		ctrl.startedAt = time.Now()
+		ctrl.setAppComparisonState(app, &argoappv1.SyncStatus{Revision: "abc"})
+
+		needRefresh, _, _ := ctrl.needRefreshAppStatus(app, time.Hour, 0)
+		assert.True(t, needRefresh)
+	})
+}
+
+func newPrioritizedFakeApp(name string, project string, priority string) *argoappv1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Spec.Project = project
+	if priority != "" {
+		app.Annotations = map[string]string{common.AnnotationKeyReconcilePriority: priority}
+	}
+	return app
+}
+
+func TestReconcilePriority(t *testing.T) {
+	prodProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: test.FakeArgoCDNamespace, Annotations: map[string]string{common.AnnotationKeyReconcilePriority: "10"}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{prodProj}})
+
+	assert.Equal(t, 0, ctrl.reconcilePriority(newPrioritizedFakeApp("app", "default", "")))
+	assert.Equal(t, 0, ctrl.reconcilePriority(newPrioritizedFakeApp("app", "default", "invalid")))
+	assert.Equal(t, -5, ctrl.reconcilePriority(newPrioritizedFakeApp("app", "default", "-5")))
+	assert.Equal(t, 10, ctrl.reconcilePriority(newPrioritizedFakeApp("app", "prod", "")))
+	assert.Equal(t, 20, ctrl.reconcilePriority(newPrioritizedFakeApp("app", "prod", "20")))
+}
+
+func TestQueueInitialApps(t *testing.T) {
+	prodProj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: test.FakeArgoCDNamespace, Annotations: map[string]string{common.AnnotationKeyReconcilePriority: "10"}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+		prodProj,
+		newPrioritizedFakeApp("dev-a", "default", ""),
+		newPrioritizedFakeApp("dev-b", "default", "-1"),
+		newPrioritizedFakeApp("prod-a", "prod", ""),
+		newPrioritizedFakeApp("prod-b", "prod", "20"),
+		newPrioritizedFakeApp("dev-c", "default", ""),
+	}})
+	// the applications existing at startup are not queued by the informer
+	assert.Equal(t, 0, ctrl.appRefreshQueue.Len())
+	assert.False(t, ctrl.shouldQueueAddedApp())
+
+	require.NoError(t, ctrl.queueInitialApps())
+	assert.True(t, ctrl.shouldQueueAddedApp())
+
+	var keys []string
+	for ctrl.appRefreshQueue.Len() > 0 {
+		key, _ := ctrl.appRefreshQueue.Get()
+		keys = append(keys, key.(string))
+		ctrl.appRefreshQueue.Done(key)
+	}
+	ns := test.FakeArgoCDNamespace + "/"
+	assert.Equal(t, []string{ns + "prod-b", ns + "prod-a", ns + "dev-a", ns + "dev-c", ns + "dev-b"}, keys)
+	assert.Equal(t, 5, ctrl.appOperationQueue.Len())
+}
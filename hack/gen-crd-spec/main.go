@@ -17,9 +17,11 @@ import (
 
 var (
 	kindToCRDPath = map[string]string{
-		application.ApplicationFullName:    "manifests/crds/application-crd.yaml",
-		application.AppProjectFullName:     "manifests/crds/appproject-crd.yaml",
-		application.ApplicationSetFullName: "manifests/crds/applicationset-crd.yaml",
+		application.ApplicationFullName:            "manifests/crds/application-crd.yaml",
+		application.AppProjectFullName:             "manifests/crds/appproject-crd.yaml",
+		application.ApplicationSetFullName:         "manifests/crds/applicationset-crd.yaml",
+		application.RepositoryRegistrationFullName: "manifests/crds/repositoryregistration-crd.yaml",
+		application.ClusterRegistrationFullName:    "manifests/crds/clusterregistration-crd.yaml",
 	}
 )
 
@@ -39,6 +41,8 @@ func getCustomResourceDefinitions() map[string]*extensionsobj.CustomResourceDefi
 	deleteFile("config/argoproj.io_applications.yaml")
 	deleteFile("config/argoproj.io_appprojects.yaml")
 	deleteFile("config/argoproj.io_applicationsets.yaml")
+	deleteFile("config/argoproj.io_repositoryregistrations.yaml")
+	deleteFile("config/argoproj.io_clusterregistrations.yaml")
 	deleteFile("config")
 
 	objs, err := kube.SplitYAML(crdYamlBytes)
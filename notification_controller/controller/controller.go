@@ -56,6 +56,7 @@ func NewController(
 	registry *controller.MetricsRegistry,
 	secretName string,
 	configMapName string,
+	deliveryOpts DeliveryOpts,
 ) *notificationController {
 	appClient := client.Resource(applications)
 	appInformer := newInformer(appClient.Namespace(namespace), appLabelSelector)
@@ -63,14 +64,16 @@ func NewController(
 	secretInformer := k8s.NewSecretInformer(k8sClient, namespace, secretName)
 	configMapInformer := k8s.NewConfigMapInformer(k8sClient, namespace, configMapName)
 	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, secretName, configMapName), namespace, secretInformer, configMapInformer)
+	deliveryTracker := newDeliveryTracker(apiFactory, deliveryOpts)
 
 	res := &notificationController{
 		secretInformer:    secretInformer,
 		configMapInformer: configMapInformer,
 		appInformer:       appInformer,
 		appProjInformer:   appProjInformer,
-		apiFactory:        apiFactory}
-	res.ctrl = controller.NewController(appClient, appInformer, apiFactory,
+		apiFactory:        apiFactory,
+		deliveryTracker:   deliveryTracker}
+	res.ctrl = controller.NewController(appClient, appInformer, &deliveryTrackingFactory{Factory: apiFactory, tracker: deliveryTracker},
 		controller.WithSkipProcessing(func(obj v1.Object) (bool, string) {
 			app, ok := (obj).(*unstructured.Unstructured)
 			if !ok {
@@ -117,6 +120,7 @@ func newInformer(resClient dynamic.ResourceInterface, selector string) cache.Sha
 
 type notificationController struct {
 	apiFactory        api.Factory
+	deliveryTracker   *deliveryTracker
 	ctrl              controller.NotificationController
 	appInformer       cache.SharedIndexInformer
 	appProjInformer   cache.SharedIndexInformer
@@ -137,6 +141,7 @@ func (c *notificationController) Init(ctx context.Context) error {
 }
 
 func (c *notificationController) Run(ctx context.Context, processors int) {
+	go c.deliveryTracker.Run(ctx)
 	c.ctrl.Run(processors, ctx.Done())
 }
 
@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+)
+
+const (
+	maxDeliveryRetryDelay = 5 * time.Minute
+)
+
+// DeliveryOpts configures the tracking and the retries of notification deliveries
+type DeliveryOpts struct {
+	// Store records the outcome of every delivery attempt. Deliveries are not tracked if nil.
+	Store *delivery.Store
+	// MaxRetries is the number of times a failed delivery is retried before giving up
+	MaxRetries int
+	// RetryBaseDelay is the delay before the first retry, doubled after every failed attempt
+	RetryBaseDelay time.Duration
+}
+
+type pendingDelivery struct {
+	obj       map[string]interface{}
+	templates []string
+	dest      services.Destination
+	delivery  delivery.Delivery
+}
+
+// deliveryTracker records the outcome of notification deliveries and retries failed deliveries with an exponential
+// backoff
+type deliveryTracker struct {
+	apiFactory api.Factory
+	store      *delivery.Store
+	maxRetries int
+	queue      workqueue.RateLimitingInterface
+	lock       sync.Mutex
+	pending    map[string]*pendingDelivery
+	now        func() time.Time
+}
+
+func newDeliveryTracker(apiFactory api.Factory, opts DeliveryOpts) *deliveryTracker {
+	baseDelay := opts.RetryBaseDelay
+	if baseDelay <= 0 {
+		baseDelay = time.Second
+	}
+	return &deliveryTracker{
+		apiFactory: apiFactory,
+		store:      opts.Store,
+		maxRetries: opts.MaxRetries,
+		queue:      workqueue.NewRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(baseDelay, maxDeliveryRetryDelay)),
+		pending:    map[string]*pendingDelivery{},
+		now:        time.Now,
+	}
+}
+
+// deliveryTrackingFactory wraps an api.Factory so that the notifications sent through the returned APIs are tracked
+type deliveryTrackingFactory struct {
+	api.Factory
+	tracker *deliveryTracker
+}
+
+func (f *deliveryTrackingFactory) GetAPI() (api.API, error) {
+	notificationAPI, err := f.Factory.GetAPI()
+	if err != nil {
+		return nil, err
+	}
+	return &deliveryTrackingAPI{API: notificationAPI, tracker: f.tracker}, nil
+}
+
+// deliveryTrackingAPI remembers the last evaluated trigger so that the notifications sent afterwards can be attributed
+// to it. The notifications engine evaluates a trigger and sends its notifications before moving to the next one.
+type deliveryTrackingAPI struct {
+	api.API
+	tracker *deliveryTracker
+	trigger string
+}
+
+func (a *deliveryTrackingAPI) RunTrigger(triggerName string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	a.trigger = triggerName
+	return a.API.RunTrigger(triggerName, vars)
+}
+
+func (a *deliveryTrackingAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	return a.tracker.track(obj, a.trigger, templates, dest, a.API.Send(obj, templates, dest))
+}
+
+func newDelivery(obj map[string]interface{}, trigger string, templates []string, dest services.Destination) delivery.Delivery {
+	app := unstructured.Unstructured{Object: obj}
+	project, _, _ := unstructured.NestedString(obj, "spec", "project")
+	return delivery.Delivery{
+		ID:           uuid.New().String(),
+		Application:  app.GetName(),
+		AppNamespace: app.GetNamespace(),
+		Project:      project,
+		Trigger:      trigger,
+		Service:      dest.Service,
+		Recipient:    dest.Recipient,
+		Templates:    templates,
+	}
+}
+
+// track records the outcome of the first attempt of a delivery. A failed delivery is queued for retry if retries are
+// enabled, in which case the error is swallowed so that the notifications engine does not send it again.
+func (t *deliveryTracker) track(obj map[string]interface{}, trigger string, templates []string, dest services.Destination, sendErr error) error {
+	d := newDelivery(obj, trigger, templates, dest)
+	d.Attempts = 1
+	d.Time = t.now()
+	if sendErr == nil {
+		d.Succeeded = true
+		t.record(d)
+		return nil
+	}
+	d.Error = sendErr.Error()
+	if t.maxRetries <= 0 {
+		t.record(d)
+		return sendErr
+	}
+	d.Retrying = true
+	t.record(d)
+
+	t.lock.Lock()
+	t.pending[d.ID] = &pendingDelivery{obj: obj, templates: templates, dest: dest, delivery: d}
+	t.lock.Unlock()
+	t.queue.AddRateLimited(d.ID)
+	return nil
+}
+
+func (t *deliveryTracker) record(d delivery.Delivery) {
+	if t.store == nil {
+		return
+	}
+	if err := t.store.Record(d); err != nil {
+		log.Warnf("Failed to record delivery of trigger %s to %s:%s: %v", d.Trigger, d.Service, d.Recipient, err)
+	}
+}
+
+// retry attempts once more to send the given pending delivery and returns true if it should be retried again
+func (t *deliveryTracker) retry(p *pendingDelivery) bool {
+	p.delivery.Attempts++
+	p.delivery.Time = t.now()
+
+	notificationAPI, err := t.apiFactory.GetAPI()
+	if err == nil {
+		err = notificationAPI.Send(p.obj, p.templates, p.dest)
+	}
+
+	if err == nil {
+		p.delivery.Succeeded = true
+		p.delivery.Retrying = false
+		p.delivery.Error = ""
+	} else {
+		p.delivery.Error = err.Error()
+		p.delivery.Retrying = p.delivery.Attempts <= t.maxRetries
+		if !p.delivery.Retrying {
+			log.Errorf("Giving up delivery of trigger %s to %s:%s after %d attempts: %v",
+				p.delivery.Trigger, p.delivery.Service, p.delivery.Recipient, p.delivery.Attempts, err)
+		}
+	}
+	t.record(p.delivery)
+	return p.delivery.Retrying
+}
+
+func (t *deliveryTracker) processQueueItem() (processNext bool) {
+	key, shutdown := t.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer t.queue.Done(key)
+
+	id := key.(string)
+	t.lock.Lock()
+	p, ok := t.pending[id]
+	t.lock.Unlock()
+	if !ok {
+		t.queue.Forget(key)
+		return true
+	}
+
+	if t.retry(p) {
+		t.queue.AddRateLimited(key)
+		return true
+	}
+	t.queue.Forget(key)
+	t.lock.Lock()
+	delete(t.pending, id)
+	t.lock.Unlock()
+	return true
+}
+
+// Run retries the failed deliveries until the context is done
+func (t *deliveryTracker) Run(ctx context.Context) {
+	defer t.queue.ShutDown()
+	go wait.Until(func() {
+		for t.processQueueItem() {
+		}
+	}, time.Second, ctx.Done())
+	<-ctx.Done()
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+)
+
+type fakeAPI struct {
+	api.API
+	sendErrs []error
+	sent     int
+}
+
+func (a *fakeAPI) RunTrigger(triggerName string, vars map[string]interface{}) ([]triggers.ConditionResult, error) {
+	return []triggers.ConditionResult{{Triggered: true, Templates: []string{"app-deployed"}}}, nil
+}
+
+func (a *fakeAPI) Send(obj map[string]interface{}, templates []string, dest services.Destination) error {
+	a.sent++
+	if len(a.sendErrs) == 0 {
+		return nil
+	}
+	err := a.sendErrs[0]
+	a.sendErrs = a.sendErrs[1:]
+	return err
+}
+
+type fakeFactory struct {
+	api *fakeAPI
+}
+
+func (f *fakeFactory) GetAPI() (api.API, error) {
+	return f.api, nil
+}
+
+var testApp = map[string]interface{}{
+	"metadata": map[string]interface{}{
+		"name":      "guestbook",
+		"namespace": "argocd",
+	},
+	"spec": map[string]interface{}{
+		"project": "prod",
+	},
+}
+
+var testDestination = services.Destination{Service: "slack", Recipient: "prod-deploys"}
+
+func newTestDeliveryTracker(fake *fakeAPI, maxRetries int) (*deliveryTrackingFactory, *delivery.Store) {
+	store := delivery.NewStore(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)))
+	tracker := newDeliveryTracker(&fakeFactory{api: fake}, DeliveryOpts{Store: store, MaxRetries: maxRetries, RetryBaseDelay: time.Millisecond})
+	return &deliveryTrackingFactory{Factory: tracker.apiFactory, tracker: tracker}, store
+}
+
+func sendTestNotification(t *testing.T, factory *deliveryTrackingFactory) error {
+	notificationAPI, err := factory.GetAPI()
+	require.NoError(t, err)
+	_, err = notificationAPI.RunTrigger("on-deployed", testApp)
+	require.NoError(t, err)
+	return notificationAPI.Send(testApp, []string{"app-deployed"}, testDestination)
+}
+
+func TestDeliveryTracking_Succeeded(t *testing.T) {
+	factory, store := newTestDeliveryTracker(&fakeAPI{}, 3)
+
+	require.NoError(t, sendTestNotification(t, factory))
+
+	deliveries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	d := deliveries[0]
+	assert.NotEmpty(t, d.ID)
+	assert.Equal(t, "guestbook", d.Application)
+	assert.Equal(t, "argocd", d.AppNamespace)
+	assert.Equal(t, "prod", d.Project)
+	assert.Equal(t, "on-deployed", d.Trigger)
+	assert.Equal(t, "slack", d.Service)
+	assert.Equal(t, "prod-deploys", d.Recipient)
+	assert.Equal(t, []string{"app-deployed"}, d.Templates)
+	assert.Equal(t, 1, d.Attempts)
+	assert.True(t, d.Succeeded)
+	assert.False(t, d.Retrying)
+	assert.Empty(t, d.Error)
+}
+
+func TestDeliveryTracking_NoRetries(t *testing.T) {
+	factory, store := newTestDeliveryTracker(&fakeAPI{sendErrs: []error{errors.New("timeout")}}, 0)
+
+	assert.EqualError(t, sendTestNotification(t, factory), "timeout")
+
+	deliveries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.False(t, deliveries[0].Succeeded)
+	assert.False(t, deliveries[0].Retrying)
+	assert.Equal(t, "timeout", deliveries[0].Error)
+	assert.Equal(t, 0, factory.tracker.queue.Len())
+}
+
+func TestDeliveryTracking_RetrySucceeded(t *testing.T) {
+	fake := &fakeAPI{sendErrs: []error{errors.New("timeout"), errors.New("timeout")}}
+	factory, store := newTestDeliveryTracker(fake, 3)
+	tracker := factory.tracker
+
+	// the error is swallowed so that the engine does not send the notification again
+	require.NoError(t, sendTestNotification(t, factory))
+
+	deliveries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.True(t, deliveries[0].Retrying)
+	assert.Equal(t, "timeout", deliveries[0].Error)
+
+	assert.True(t, tracker.processQueueItem())
+	deliveries, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 2, deliveries[0].Attempts)
+	assert.True(t, deliveries[0].Retrying)
+
+	assert.True(t, tracker.processQueueItem())
+	deliveries, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 3, deliveries[0].Attempts)
+	assert.True(t, deliveries[0].Succeeded)
+	assert.False(t, deliveries[0].Retrying)
+	assert.Empty(t, deliveries[0].Error)
+
+	assert.Equal(t, 3, fake.sent)
+	assert.Empty(t, tracker.pending)
+}
+
+func TestDeliveryTracking_RetryGivesUp(t *testing.T) {
+	fake := &fakeAPI{sendErrs: []error{errors.New("timeout"), errors.New("timeout"), errors.New("channel not found")}}
+	factory, store := newTestDeliveryTracker(fake, 2)
+	tracker := factory.tracker
+
+	require.NoError(t, sendTestNotification(t, factory))
+	assert.True(t, tracker.processQueueItem())
+	assert.True(t, tracker.processQueueItem())
+
+	deliveries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, 3, deliveries[0].Attempts)
+	assert.False(t, deliveries[0].Succeeded)
+	assert.False(t, deliveries[0].Retrying)
+	assert.Equal(t, "channel not found", deliveries[0].Error)
+
+	assert.Equal(t, 3, fake.sent)
+	assert.Empty(t, tracker.pending)
+	assert.Equal(t, 0, tracker.queue.Len())
+}
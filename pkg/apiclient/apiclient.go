@@ -33,6 +33,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	accountpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
+	agentpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
 	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	applicationsetpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
 	certificatepkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/certificate"
@@ -44,6 +45,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
+	summarypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/summary"
 	versionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -94,6 +96,10 @@ type Client interface {
 	NewApplicationSetClientOrDie() (io.Closer, applicationsetpkg.ApplicationSetServiceClient)
 	NewNotificationClient() (io.Closer, notificationpkg.NotificationServiceClient, error)
 	NewNotificationClientOrDie() (io.Closer, notificationpkg.NotificationServiceClient)
+	NewSummaryClient() (io.Closer, summarypkg.SummaryServiceClient, error)
+	NewSummaryClientOrDie() (io.Closer, summarypkg.SummaryServiceClient)
+	NewAgentClient() (io.Closer, agentpkg.AgentServiceClient, error)
+	NewAgentClientOrDie() (io.Closer, agentpkg.AgentServiceClient)
 	NewSessionClient() (io.Closer, sessionpkg.SessionServiceClient, error)
 	NewSessionClientOrDie() (io.Closer, sessionpkg.SessionServiceClient)
 	NewSettingsClient() (io.Closer, settingspkg.SettingsServiceClient, error)
@@ -210,7 +216,7 @@ func NewClient(opts *ClientOptions) (Client, error) {
 		if opts.KubeOverrides == nil {
 			opts.KubeOverrides = &clientcmd.ConfigOverrides{}
 		}
-		port, err := kube.PortForward(8080, opts.PortForwardNamespace, opts.KubeOverrides, "app.kubernetes.io/name=argocd-server")
+		port, err := kube.PortForward(8080, opts.PortForwardNamespace, opts.KubeOverrides, common.InstanceLabelSelector("app.kubernetes.io/name=argocd-server"))
 		if err != nil {
 			return nil, err
 		}
@@ -711,6 +717,40 @@ func (c *client) NewNotificationClientOrDie() (io.Closer, notificationpkg.Notifi
 	return conn, notifIf
 }
 
+func (c *client) NewSummaryClient() (io.Closer, summarypkg.SummaryServiceClient, error) {
+	conn, closer, err := c.newConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	summaryIf := summarypkg.NewSummaryServiceClient(conn)
+	return closer, summaryIf, nil
+}
+
+func (c *client) NewSummaryClientOrDie() (io.Closer, summarypkg.SummaryServiceClient) {
+	conn, summaryIf, err := c.NewSummaryClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, summaryIf
+}
+
+func (c *client) NewAgentClient() (io.Closer, agentpkg.AgentServiceClient, error) {
+	conn, closer, err := c.newConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	agentIf := agentpkg.NewAgentServiceClient(conn)
+	return closer, agentIf, nil
+}
+
+func (c *client) NewAgentClientOrDie() (io.Closer, agentpkg.AgentServiceClient) {
+	conn, agentIf, err := c.NewAgentClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, agentIf
+}
+
 func (c *client) NewApplicationSetClientOrDie() (io.Closer, applicationsetpkg.ApplicationSetServiceClient) {
 	conn, repoIf, err := c.NewApplicationSetClient()
 	if err != nil {
@@ -295,12 +295,77 @@ func (m *ApplicationSetDeleteRequest) GetName() string {
 	return ""
 }
 
+// ApplicationSetStatusResponse contains the status of the generators of an applicationset and the aggregated status of its applications
+type ApplicationSetStatusResponse struct {
+	Generators           []*v1alpha1.ApplicationSetGeneratorStatus `protobuf:"bytes,1,rep,name=generators,proto3" json:"generators,omitempty"`
+	Summary              *v1alpha1.ApplicationSetSummary           `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Conditions           []*v1alpha1.ApplicationSetCondition       `protobuf:"bytes,3,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ApplicationSetStatusResponse) Reset()         { *m = ApplicationSetStatusResponse{} }
+func (m *ApplicationSetStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetStatusResponse) ProtoMessage()    {}
+func (*ApplicationSetStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{5}
+}
+func (m *ApplicationSetStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetStatusResponse.Merge(m, src)
+}
+func (m *ApplicationSetStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetStatusResponse proto.InternalMessageInfo
+
+func (m *ApplicationSetStatusResponse) GetGenerators() []*v1alpha1.ApplicationSetGeneratorStatus {
+	if m != nil {
+		return m.Generators
+	}
+	return nil
+}
+
+func (m *ApplicationSetStatusResponse) GetSummary() *v1alpha1.ApplicationSetSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *ApplicationSetStatusResponse) GetConditions() []*v1alpha1.ApplicationSetCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
 	proto.RegisterType((*ApplicationSetResponse)(nil), "applicationset.ApplicationSetResponse")
 	proto.RegisterType((*ApplicationSetCreateRequest)(nil), "applicationset.ApplicationSetCreateRequest")
 	proto.RegisterType((*ApplicationSetDeleteRequest)(nil), "applicationset.ApplicationSetDeleteRequest")
+	proto.RegisterType((*ApplicationSetStatusResponse)(nil), "applicationset.ApplicationSetStatusResponse")
 }
 
 func init() {
@@ -308,39 +373,45 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xe5, 0xb6, 0x84, 0xd6, 0x48, 0x1c, 0x2c, 0xd1, 0x86, 0x05, 0x85, 0x68, 0x0f, 0xa5,
-	0x14, 0xb0, 0x95, 0x70, 0x83, 0x13, 0x7f, 0xa4, 0x0a, 0x29, 0x07, 0xba, 0xbd, 0x71, 0x41, 0xae,
-	0x33, 0xda, 0x2e, 0xdd, 0xae, 0x8d, 0xed, 0xac, 0x84, 0x10, 0x17, 0x24, 0x9e, 0x80, 0x27, 0x00,
-	0x2e, 0x48, 0x5c, 0x79, 0x08, 0x8e, 0x48, 0xbc, 0x00, 0x8a, 0x78, 0x10, 0x64, 0x6f, 0x36, 0xe9,
-	0x5a, 0x69, 0xc3, 0x21, 0xbd, 0x79, 0xd6, 0xe3, 0xf1, 0x6f, 0x3f, 0x7f, 0x33, 0x78, 0xd7, 0x80,
-	0x2e, 0x41, 0x33, 0xae, 0x54, 0x9e, 0x09, 0x6e, 0x33, 0x59, 0x18, 0xb0, 0x41, 0x48, 0x95, 0x96,
-	0x56, 0x92, 0xab, 0xcd, 0xaf, 0xd1, 0xcd, 0x54, 0xca, 0x34, 0x07, 0xc6, 0x55, 0xc6, 0x78, 0x51,
-	0x48, 0x5b, 0xed, 0x54, 0xd9, 0xd1, 0x20, 0xcd, 0xec, 0xd1, 0xe8, 0x90, 0x0a, 0x79, 0xc2, 0xb8,
-	0x4e, 0xa5, 0xd2, 0xf2, 0xb5, 0x5f, 0xdc, 0x17, 0x43, 0x56, 0xf6, 0x99, 0x3a, 0x4e, 0xdd, 0x49,
-	0x73, 0xfa, 0x2e, 0x56, 0xf6, 0x78, 0xae, 0x8e, 0x78, 0x8f, 0xa5, 0x50, 0x80, 0xe6, 0x16, 0x86,
-	0x55, 0xb5, 0xf8, 0x1e, 0xde, 0x7c, 0x3c, 0xcb, 0x3b, 0x00, 0xbb, 0x07, 0x76, 0x7f, 0x04, 0xfa,
-	0x2d, 0x21, 0x78, 0xad, 0xe0, 0x27, 0xd0, 0x46, 0x5d, 0xb4, 0xb3, 0x91, 0xf8, 0x75, 0xbc, 0x8f,
-	0xb7, 0x9a, 0xd9, 0x83, 0xcc, 0x4c, 0xd2, 0x23, 0xbc, 0xee, 0x48, 0x40, 0x58, 0xd3, 0x46, 0xdd,
-	0xd5, 0x9d, 0x8d, 0x64, 0x1a, 0xbb, 0x3d, 0x03, 0x39, 0x08, 0x2b, 0x75, 0x7b, 0xc5, 0x97, 0x9b,
-	0xc6, 0xf1, 0x37, 0x14, 0x12, 0x24, 0x60, 0x94, 0x13, 0x82, 0xb4, 0xf1, 0xe5, 0x49, 0x89, 0x09,
-	0x44, 0x1d, 0x12, 0x8b, 0x03, 0xcd, 0x7c, 0xd9, 0x2b, 0xfd, 0x01, 0x9d, 0x89, 0x43, 0x6b, 0x71,
-	0xfc, 0xe2, 0x95, 0x18, 0xd2, 0xb2, 0x4f, 0xd5, 0x71, 0x4a, 0x9d, 0x38, 0xf4, 0xd4, 0x71, 0x5a,
-	0x8b, 0x43, 0x03, 0x8e, 0xe0, 0x8e, 0xf8, 0x3b, 0xc2, 0x37, 0x9a, 0x29, 0x4f, 0x35, 0x70, 0x0b,
-	0x09, 0xbc, 0x19, 0x81, 0x99, 0x47, 0x85, 0x2e, 0x9e, 0x8a, 0x6c, 0xe2, 0xd6, 0x48, 0x19, 0xd0,
-	0x95, 0x06, 0xeb, 0xc9, 0x24, 0x8a, 0x7b, 0x21, 0xec, 0x33, 0xc8, 0x61, 0x06, 0x3b, 0xe7, 0x79,
-	0xfb, 0x9f, 0x2f, 0xe1, 0x6b, 0xcd, 0x33, 0x07, 0xa0, 0xcb, 0x4c, 0x00, 0xf9, 0x8a, 0xf0, 0xea,
-	0x1e, 0x58, 0xb2, 0x4d, 0x03, 0x07, 0xcf, 0x37, 0x4f, 0xb4, 0xd4, 0x5f, 0x8e, 0xb7, 0x3f, 0xfc,
-	0xfe, 0xfb, 0x69, 0xa5, 0x4b, 0x3a, 0xbe, 0x25, 0xca, 0x5e, 0xd0, 0x46, 0x86, 0xbd, 0x73, 0xf8,
-	0xef, 0xc9, 0x17, 0x84, 0xd7, 0x9c, 0x23, 0xc9, 0xed, 0xf3, 0x31, 0xa7, 0xae, 0x8d, 0x5e, 0x2c,
-	0x93, 0xd3, 0x95, 0x8d, 0x6f, 0x79, 0xd6, 0xeb, 0x64, 0xeb, 0x0c, 0x56, 0xf2, 0x03, 0xe1, 0x56,
-	0xe5, 0x1b, 0x72, 0xf7, 0x7c, 0xcc, 0x86, 0xbb, 0x96, 0x2c, 0x29, 0xf3, 0x98, 0x77, 0xe2, 0xb3,
-	0x30, 0x1f, 0x86, 0x36, 0xfb, 0x88, 0x70, 0xab, 0x72, 0xd0, 0x22, 0xec, 0x86, 0xcf, 0xa2, 0x05,
-	0x8e, 0xa9, 0x9b, 0xbd, 0x7e, 0xe3, 0xdd, 0x05, 0x6f, 0xfc, 0xe4, 0xf9, 0xcf, 0x71, 0x07, 0xfd,
-	0x1a, 0x77, 0xd0, 0x9f, 0x71, 0x07, 0xbd, 0x7c, 0xf4, 0x7f, 0xc3, 0x50, 0xe4, 0x19, 0x14, 0xe1,
-	0xf4, 0x3d, 0x6c, 0xf9, 0x11, 0xf8, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x22, 0xb1,
-	0x96, 0xac, 0x05, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x99, 0xa6, 0xa4, 0xcd, 0x14, 0x3c, 0x0c, 0xd8, 0xc6, 0xb5, 0xc4, 0xb0, 0x60, 0x5b,
+	0x6b, 0xbb, 0x4b, 0xe2, 0x4d, 0x4f, 0x5a, 0x21, 0x08, 0x39, 0xd8, 0x0d, 0x5e, 0xf4, 0x20, 0xd3,
+	0xcd, 0x63, 0xbb, 0x76, 0xb3, 0xb3, 0xce, 0xcc, 0x2e, 0x94, 0xe2, 0x41, 0xc1, 0xbb, 0xe0, 0x7f,
+	0xa0, 0x17, 0xc1, 0x6b, 0xff, 0x08, 0x8f, 0x82, 0xff, 0x80, 0x04, 0xff, 0x10, 0xd9, 0xd9, 0xdd,
+	0x24, 0x3b, 0xa4, 0x49, 0x0f, 0xdb, 0xdb, 0xbc, 0xe4, 0xcd, 0x7b, 0x9f, 0xfd, 0xbe, 0x1f, 0x83,
+	0xf7, 0x05, 0xf0, 0x04, 0xb8, 0x4d, 0xa3, 0x28, 0xf0, 0x5d, 0x2a, 0x7d, 0x16, 0x0a, 0x90, 0x9a,
+	0x69, 0x45, 0x9c, 0x49, 0x46, 0x6e, 0x95, 0x7f, 0x35, 0xb6, 0x3d, 0xc6, 0xbc, 0x00, 0x6c, 0x1a,
+	0xf9, 0x36, 0x0d, 0x43, 0x26, 0xb3, 0x7f, 0x32, 0x6f, 0xa3, 0xef, 0xf9, 0xf2, 0x34, 0x3e, 0xb1,
+	0x5c, 0x36, 0xb2, 0x29, 0xf7, 0x58, 0xc4, 0xd9, 0x3b, 0x75, 0x38, 0x74, 0x87, 0x76, 0xd2, 0xb5,
+	0xa3, 0x33, 0x2f, 0xbd, 0x29, 0x66, 0x73, 0xd9, 0x49, 0x87, 0x06, 0xd1, 0x29, 0xed, 0xd8, 0x1e,
+	0x84, 0xc0, 0xa9, 0x84, 0x61, 0x16, 0xcd, 0x3c, 0xc0, 0x9b, 0x4f, 0xa7, 0x7e, 0x03, 0x90, 0x3d,
+	0x90, 0xc7, 0x31, 0xf0, 0x73, 0x42, 0xf0, 0x6a, 0x48, 0x47, 0xd0, 0x44, 0x6d, 0xb4, 0xd7, 0x70,
+	0xd4, 0xd9, 0x3c, 0xc6, 0x5b, 0x65, 0xef, 0xbe, 0x2f, 0x72, 0x77, 0x03, 0xaf, 0xa7, 0x24, 0xe0,
+	0x4a, 0xd1, 0x44, 0xed, 0xda, 0x5e, 0xc3, 0x99, 0xd8, 0xe9, 0x7f, 0x02, 0x02, 0x70, 0x25, 0xe3,
+	0xcd, 0x15, 0x15, 0x6e, 0x62, 0x9b, 0x3f, 0x90, 0x4e, 0xe0, 0x80, 0x88, 0x52, 0x21, 0x48, 0x13,
+	0xaf, 0xe5, 0x21, 0x72, 0x88, 0xc2, 0x24, 0x12, 0x6b, 0x9a, 0xa9, 0xb0, 0x1b, 0xdd, 0xbe, 0x35,
+	0x15, 0xc7, 0x2a, 0xc4, 0x51, 0x87, 0xb7, 0xee, 0xd0, 0x4a, 0xba, 0x56, 0x74, 0xe6, 0x59, 0xa9,
+	0x38, 0xd6, 0xcc, 0x75, 0xab, 0x10, 0xc7, 0xd2, 0x38, 0xb4, 0x1c, 0xe6, 0x4f, 0x84, 0xef, 0x96,
+	0x5d, 0x8e, 0x38, 0x50, 0x09, 0x0e, 0xbc, 0x8f, 0x41, 0xcc, 0xa3, 0x42, 0x37, 0x4f, 0x45, 0x36,
+	0x71, 0x3d, 0x8e, 0x04, 0xf0, 0x4c, 0x83, 0x75, 0x27, 0xb7, 0xcc, 0x8e, 0x0e, 0xfb, 0x1c, 0x02,
+	0x98, 0xc2, 0xce, 0x2b, 0xef, 0xc7, 0x1a, 0xde, 0x2e, 0xdf, 0x19, 0x48, 0x2a, 0x63, 0x31, 0xa9,
+	0xc8, 0x05, 0xc6, 0x79, 0x03, 0x31, 0x9e, 0x95, 0x79, 0xa3, 0xfb, 0xa6, 0xca, 0xaf, 0xeb, 0x15,
+	0xd1, 0xf3, 0xc4, 0x33, 0xe9, 0xc8, 0x08, 0xaf, 0x89, 0x78, 0x34, 0xa2, 0xfc, 0x3c, 0xaf, 0xf6,
+	0xa0, 0xca, 0xcc, 0x83, 0x2c, 0xb4, 0x53, 0xe4, 0x20, 0x31, 0xc6, 0x2e, 0x0b, 0x87, 0xbe, 0xd2,
+	0xb9, 0x59, 0x53, 0xdf, 0xfa, 0xaa, 0xca, 0x8c, 0x47, 0x45, 0x74, 0x67, 0x26, 0x51, 0xf7, 0xb2,
+	0x8e, 0x6f, 0x6b, 0x64, 0xc0, 0x13, 0xdf, 0x05, 0xf2, 0x1d, 0xe1, 0x5a, 0x0f, 0x24, 0xd9, 0xb1,
+	0xb4, 0x2d, 0x32, 0x7f, 0x80, 0x8d, 0x4a, 0xdb, 0xce, 0xdc, 0xf9, 0xf4, 0xe7, 0xdf, 0xd7, 0x95,
+	0x36, 0x69, 0xa9, 0xb5, 0x94, 0x74, 0xb4, 0x55, 0x26, 0xec, 0x8b, 0xb4, 0x85, 0x3e, 0x90, 0x6f,
+	0x08, 0xaf, 0xa6, 0x5b, 0x81, 0xec, 0x2e, 0xc6, 0x9c, 0x6c, 0x0e, 0xe3, 0x65, 0x95, 0x9c, 0x69,
+	0x58, 0xf3, 0x9e, 0x62, 0xbd, 0x43, 0xb6, 0xae, 0x60, 0x25, 0x97, 0x08, 0xd7, 0xb3, 0xd9, 0x25,
+	0x0f, 0x17, 0x63, 0x96, 0x26, 0xbc, 0x62, 0x49, 0x6d, 0x85, 0xf9, 0xc0, 0xbc, 0x0a, 0xf3, 0xb1,
+	0x3e, 0xea, 0x9f, 0x11, 0xae, 0x67, 0x53, 0xbc, 0x0c, 0xbb, 0x34, 0xeb, 0xc6, 0x92, 0x8e, 0x29,
+	0xc6, 0xbb, 0xa8, 0xf1, 0xfe, 0xb2, 0x1a, 0x7f, 0x41, 0xb8, 0xd1, 0x2b, 0x96, 0xc3, 0xb5, 0xfb,
+	0xf1, 0x60, 0xb1, 0x5f, 0x79, 0xd5, 0x98, 0x87, 0x8a, 0x65, 0x97, 0xdc, 0x5f, 0xcc, 0x62, 0x0b,
+	0x75, 0xed, 0xd9, 0x8b, 0x5f, 0xe3, 0x16, 0xfa, 0x3d, 0x6e, 0xa1, 0xbf, 0xe3, 0x16, 0x7a, 0xfd,
+	0xe4, 0x7a, 0x6f, 0xa4, 0x1b, 0xf8, 0x10, 0xea, 0x8f, 0xf2, 0x49, 0x5d, 0xbd, 0x8c, 0x8f, 0xfe,
+	0x07, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x36, 0x8e, 0x5f, 0xc3, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +434,8 @@ type ApplicationSetServiceClient interface {
 	Create(ctx context.Context, in *ApplicationSetCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(ctx context.Context, in *ApplicationSetDeleteRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error)
+	// GetStatus returns the status of the generators of an applicationset and the aggregated health and sync status of its applications
+	GetStatus(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*ApplicationSetStatusResponse, error)
 }
 
 type applicationSetServiceClient struct {
@@ -409,6 +482,15 @@ func (c *applicationSetServiceClient) Delete(ctx context.Context, in *Applicatio
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) GetStatus(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*ApplicationSetStatusResponse, error) {
+	out := new(ApplicationSetStatusResponse)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationSetServiceServer is the server API for ApplicationSetService service.
 type ApplicationSetServiceServer interface {
 	// Get returns an applicationset by name
@@ -419,6 +501,8 @@ type ApplicationSetServiceServer interface {
 	Create(context.Context, *ApplicationSetCreateRequest) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(context.Context, *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error)
+	// GetStatus returns the status of the generators of an applicationset and the aggregated health and sync status of its applications
+	GetStatus(context.Context, *ApplicationSetGetQuery) (*ApplicationSetStatusResponse, error)
 }
 
 // UnimplementedApplicationSetServiceServer can be embedded to have forward compatible implementations.
@@ -437,6 +521,9 @@ func (*UnimplementedApplicationSetServiceServer) Create(ctx context.Context, req
 func (*UnimplementedApplicationSetServiceServer) Delete(ctx context.Context, req *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) GetStatus(ctx context.Context, req *ApplicationSetGetQuery) (*ApplicationSetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
 
 func RegisterApplicationSetServiceServer(s *grpc.Server, srv ApplicationSetServiceServer) {
 	s.RegisterService(&_ApplicationSetService_serviceDesc, srv)
@@ -514,6 +601,24 @@ func _ApplicationSetService_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetGetQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).GetStatus(ctx, req.(*ApplicationSetGetQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "applicationset.ApplicationSetService",
 	HandlerType: (*ApplicationSetServiceServer)(nil),
@@ -534,6 +639,10 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationSetService_Delete_Handler,
 		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _ApplicationSetService_GetStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/applicationset/applicationset.proto",
@@ -745,6 +854,73 @@ func (m *ApplicationSetDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Generators) > 0 {
+		for iNdEx := len(m.Generators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Generators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplicationset(v)
 	base := offset
@@ -849,6 +1025,34 @@ func (m *ApplicationSetDeleteRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Generators) > 0 {
+		for _, e := range m.Generators {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.Summary != nil {
+		l = m.Summary.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplicationset(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1362,6 +1566,161 @@ func (m *ApplicationSetDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Generators = append(m.Generators, &v1alpha1.ApplicationSetGeneratorStatus{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Summary == nil {
+				m.Summary = &v1alpha1.ApplicationSetSummary{}
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, &v1alpha1.ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplicationset(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
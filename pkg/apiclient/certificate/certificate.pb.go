@@ -197,10 +197,223 @@ func (m *RepositoryCertificateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepositoryCertificateResponse proto.InternalMessageInfo
 
+// Request to scan the public host keys of an SSH server
+type SSHHostKeyScanRequest struct {
+	// Host name or IP address of the SSH server
+	HostName string `protobuf:"bytes,1,opt,name=hostName,proto3" json:"hostName,omitempty"`
+	// Port of the SSH server, defaults to 22
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// SHA256 fingerprints of the keys confirmed to be stored, or removed for keys no longer offered by the server.
+	// If empty, the keys are only scanned and compared with the stored ones.
+	Fingerprints         []string `protobuf:"bytes,3,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHHostKeyScanRequest) Reset()         { *m = SSHHostKeyScanRequest{} }
+func (m *SSHHostKeyScanRequest) String() string { return proto.CompactTextString(m) }
+func (*SSHHostKeyScanRequest) ProtoMessage()    {}
+func (*SSHHostKeyScanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387c41efc0710f00, []int{3}
+}
+func (m *SSHHostKeyScanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHHostKeyScanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHHostKeyScanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHHostKeyScanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHHostKeyScanRequest.Merge(m, src)
+}
+func (m *SSHHostKeyScanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHHostKeyScanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHHostKeyScanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHHostKeyScanRequest proto.InternalMessageInfo
+
+func (m *SSHHostKeyScanRequest) GetHostName() string {
+	if m != nil {
+		return m.HostName
+	}
+	return ""
+}
+
+func (m *SSHHostKeyScanRequest) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *SSHHostKeyScanRequest) GetFingerprints() []string {
+	if m != nil {
+		return m.Fingerprints
+	}
+	return nil
+}
+
+// A public host key of an SSH server
+type SSHHostKey struct {
+	// Name of the server as in the known hosts, i.e. [hostName]:port for non-default ports
+	ServerName string `protobuf:"bytes,1,opt,name=serverName,proto3" json:"serverName,omitempty"`
+	// Type of the key, e.g. ssh-ed25519
+	KeyType string `protobuf:"bytes,2,opt,name=keyType,proto3" json:"keyType,omitempty"`
+	// Base64 encoded key data
+	KeyData string `protobuf:"bytes,3,opt,name=keyData,proto3" json:"keyData,omitempty"`
+	// SHA256 fingerprint of the key
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Comparison with the stored known hosts: new, unchanged, changed (the server key was rotated) or removed (the stored key is no longer offered by the server)
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Whether the key has been stored, or removed for a removed key
+	Applied              bool     `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHHostKey) Reset()         { *m = SSHHostKey{} }
+func (m *SSHHostKey) String() string { return proto.CompactTextString(m) }
+func (*SSHHostKey) ProtoMessage()    {}
+func (*SSHHostKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387c41efc0710f00, []int{4}
+}
+func (m *SSHHostKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHHostKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHHostKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHHostKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHHostKey.Merge(m, src)
+}
+func (m *SSHHostKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHHostKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHHostKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHHostKey proto.InternalMessageInfo
+
+func (m *SSHHostKey) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *SSHHostKey) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
+func (m *SSHHostKey) GetKeyData() string {
+	if m != nil {
+		return m.KeyData
+	}
+	return ""
+}
+
+func (m *SSHHostKey) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *SSHHostKey) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SSHHostKey) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+// Response of an SSH host key scan
+type SSHHostKeyScanResponse struct {
+	Items                []*SSHHostKey `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SSHHostKeyScanResponse) Reset()         { *m = SSHHostKeyScanResponse{} }
+func (m *SSHHostKeyScanResponse) String() string { return proto.CompactTextString(m) }
+func (*SSHHostKeyScanResponse) ProtoMessage()    {}
+func (*SSHHostKeyScanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387c41efc0710f00, []int{5}
+}
+func (m *SSHHostKeyScanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHHostKeyScanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHHostKeyScanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHHostKeyScanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHHostKeyScanResponse.Merge(m, src)
+}
+func (m *SSHHostKeyScanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHHostKeyScanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHHostKeyScanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHHostKeyScanResponse proto.InternalMessageInfo
+
+func (m *SSHHostKeyScanResponse) GetItems() []*SSHHostKey {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RepositoryCertificateQuery)(nil), "certificate.RepositoryCertificateQuery")
 	proto.RegisterType((*RepositoryCertificateCreateRequest)(nil), "certificate.RepositoryCertificateCreateRequest")
 	proto.RegisterType((*RepositoryCertificateResponse)(nil), "certificate.RepositoryCertificateResponse")
+	proto.RegisterType((*SSHHostKeyScanRequest)(nil), "certificate.SSHHostKeyScanRequest")
+	proto.RegisterType((*SSHHostKey)(nil), "certificate.SSHHostKey")
+	proto.RegisterType((*SSHHostKeyScanResponse)(nil), "certificate.SSHHostKeyScanResponse")
 }
 
 func init() {
@@ -208,35 +421,47 @@ func init() {
 }
 
 var fileDescriptor_387c41efc0710f00 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6a, 0x14, 0x31,
-	0x18, 0x27, 0x55, 0x4a, 0x4d, 0x05, 0x6d, 0x90, 0x52, 0x86, 0xba, 0x96, 0xa1, 0x60, 0x29, 0x98,
-	0xb0, 0xe3, 0x45, 0x3c, 0xba, 0xe2, 0xa9, 0x88, 0x4e, 0x05, 0xc1, 0x8b, 0x64, 0x67, 0x3f, 0x67,
-	0x63, 0xa7, 0x49, 0x4c, 0xbe, 0x19, 0x58, 0xbc, 0x89, 0x6f, 0xe0, 0x9b, 0xe8, 0xc9, 0x37, 0x50,
-	0xbc, 0x08, 0xbe, 0x80, 0x2c, 0x3e, 0x88, 0x4c, 0xd6, 0xba, 0x19, 0x19, 0xd1, 0xcb, 0x42, 0x6f,
-	0x5f, 0xbe, 0x24, 0xdf, 0xef, 0x5f, 0x08, 0xdd, 0xf7, 0xe0, 0x1a, 0x70, 0xa2, 0x00, 0x87, 0xea,
-	0x85, 0x2a, 0x24, 0x42, 0x5c, 0x73, 0xeb, 0x0c, 0x1a, 0xb6, 0x19, 0xb5, 0x92, 0xdd, 0xd2, 0x98,
-	0xb2, 0x02, 0x21, 0xad, 0x12, 0x52, 0x6b, 0x83, 0x12, 0x95, 0xd1, 0x7e, 0x71, 0x34, 0x39, 0x2a,
-	0x15, 0x4e, 0xeb, 0x31, 0x2f, 0xcc, 0xa9, 0x90, 0xae, 0x34, 0xd6, 0x99, 0x97, 0xa1, 0xb8, 0x55,
-	0x4c, 0x44, 0x93, 0x09, 0x7b, 0x52, 0xb6, 0x37, 0xbd, 0x90, 0xd6, 0x56, 0xed, 0x40, 0x65, 0xb4,
-	0x68, 0x86, 0xb2, 0xb2, 0x53, 0x39, 0x14, 0x25, 0x68, 0x70, 0x12, 0x61, 0xb2, 0x98, 0x96, 0xbe,
-	0x25, 0x34, 0xc9, 0xc1, 0x1a, 0xaf, 0xd0, 0xb8, 0xd9, 0x68, 0xc9, 0xe2, 0x71, 0x0d, 0x6e, 0xc6,
-	0x0e, 0xe8, 0x95, 0xa9, 0xf1, 0xf8, 0x50, 0x9e, 0xc2, 0x23, 0x89, 0x08, 0x4e, 0xef, 0x90, 0x3d,
-	0x72, 0x70, 0x29, 0xff, 0xb3, 0xcd, 0x12, 0xba, 0xd1, 0x6a, 0x78, 0x32, 0xb3, 0xb0, 0xb3, 0x16,
-	0x8e, 0xfc, 0x5e, 0xb3, 0x3d, 0x1a, 0xf4, 0x1d, 0xd7, 0xe3, 0xb0, 0x7d, 0x21, 0x6c, 0xc7, 0xad,
-	0xf4, 0x23, 0xa1, 0x69, 0x2f, 0x8d, 0x91, 0x03, 0x89, 0x90, 0xc3, 0xab, 0x1a, 0x3c, 0xb2, 0xd7,
-	0xf4, 0x72, 0x64, 0x94, 0x0f, 0x5c, 0x36, 0xb3, 0xa7, 0x7c, 0x69, 0x09, 0x3f, 0xb3, 0x24, 0x14,
-	0xcf, 0x8b, 0x09, 0x6f, 0x32, 0x6e, 0x4f, 0x4a, 0xde, 0x5a, 0xc2, 0x23, 0x4b, 0xf8, 0x99, 0x25,
-	0xbc, 0x17, 0xf7, 0x48, 0x79, 0xcc, 0x3b, 0x60, 0x6c, 0x9b, 0xae, 0xd7, 0xd6, 0x83, 0xc3, 0xa0,
-	0x6f, 0x23, 0xff, 0xb5, 0x4a, 0x6f, 0xd0, 0xeb, 0xbd, 0x23, 0x72, 0xf0, 0xd6, 0x68, 0x0f, 0xd9,
-	0x97, 0x8b, 0x94, 0x45, 0xfd, 0x63, 0x70, 0x8d, 0x2a, 0x80, 0xbd, 0x27, 0xf4, 0x6a, 0x0b, 0x33,
-	0x8a, 0x41, 0x6e, 0xf2, 0xf8, 0x71, 0xfc, 0x3d, 0x99, 0x64, 0x55, 0xa2, 0xd3, 0xdd, 0x37, 0xdf,
-	0x7e, 0xbc, 0x5b, 0xdb, 0x66, 0xd7, 0xc2, 0xfb, 0x6b, 0x86, 0xa2, 0x63, 0xc2, 0x67, 0x42, 0xb7,
-	0x16, 0x99, 0x44, 0xf7, 0x98, 0xf8, 0x37, 0xeb, 0x4e, 0x90, 0xab, 0x63, 0x7f, 0x18, 0xd8, 0xef,
-	0xa7, 0xbd, 0xec, 0xef, 0x76, 0x03, 0xfd, 0x40, 0xe8, 0xd6, 0x7d, 0xa8, 0xa0, 0xab, 0xe5, 0xdc,
-	0x24, 0x70, 0xd8, 0xab, 0xe1, 0xde, 0x83, 0x4f, 0xf3, 0x01, 0xf9, 0x3a, 0x1f, 0x90, 0xef, 0xf3,
-	0x01, 0x79, 0x76, 0xe7, 0xff, 0x7e, 0x83, 0xa2, 0x52, 0xa0, 0x31, 0x1e, 0x34, 0x5e, 0x0f, 0x1f,
-	0xc0, 0xed, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x19, 0x1a, 0x3e, 0x95, 0xa1, 0x04, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xd1, 0x6a, 0x14, 0x3d,
+	0x14, 0x26, 0xdd, 0x6e, 0xff, 0x36, 0x2d, 0xfc, 0x36, 0x68, 0x1d, 0x86, 0x76, 0x2d, 0xb1, 0xe0,
+	0x52, 0xe8, 0x0c, 0x5d, 0x6f, 0xa4, 0x97, 0xb6, 0x68, 0xc1, 0x22, 0x3a, 0x2b, 0x08, 0xde, 0x48,
+	0x3a, 0x7b, 0x3a, 0x1b, 0x77, 0x3b, 0x89, 0x49, 0x76, 0x61, 0xf1, 0x4a, 0xf1, 0x0d, 0x7c, 0x13,
+	0xf5, 0xc6, 0x37, 0xd0, 0x2b, 0x05, 0x5f, 0x40, 0x16, 0x1f, 0x44, 0x26, 0x33, 0xd3, 0xcd, 0x94,
+	0xa9, 0x7a, 0x53, 0xf0, 0x2e, 0xe7, 0x24, 0x39, 0xe7, 0xcb, 0xf7, 0x9d, 0x2f, 0x78, 0x4b, 0x83,
+	0x1a, 0x83, 0x0a, 0x63, 0x50, 0x86, 0x9f, 0xf0, 0x98, 0x19, 0x70, 0xd7, 0x81, 0x54, 0xc2, 0x08,
+	0xb2, 0xec, 0xa4, 0xfc, 0xf5, 0x44, 0x88, 0x64, 0x08, 0x21, 0x93, 0x3c, 0x64, 0x69, 0x2a, 0x0c,
+	0x33, 0x5c, 0xa4, 0x3a, 0x3f, 0xea, 0x1f, 0x25, 0xdc, 0xf4, 0x47, 0xc7, 0x41, 0x2c, 0x4e, 0x43,
+	0xa6, 0x12, 0x21, 0x95, 0x78, 0x61, 0x17, 0x3b, 0x71, 0x2f, 0x1c, 0x77, 0x42, 0x39, 0x48, 0xb2,
+	0x9b, 0x3a, 0x64, 0x52, 0x0e, 0xb3, 0x82, 0x5c, 0xa4, 0xe1, 0x78, 0x97, 0x0d, 0x65, 0x9f, 0xed,
+	0x86, 0x09, 0xa4, 0xa0, 0x98, 0x81, 0x5e, 0x5e, 0x8d, 0xbe, 0x45, 0xd8, 0x8f, 0x40, 0x0a, 0xcd,
+	0x8d, 0x50, 0x93, 0xfd, 0x19, 0x8a, 0xc7, 0x23, 0x50, 0x13, 0xd2, 0xc6, 0xff, 0xf7, 0x85, 0x36,
+	0x0f, 0xd9, 0x29, 0x3c, 0x62, 0xc6, 0x80, 0x4a, 0x3d, 0xb4, 0x89, 0xda, 0x4b, 0xd1, 0xf9, 0x34,
+	0xf1, 0xf1, 0x62, 0xf6, 0x86, 0x27, 0x13, 0x09, 0xde, 0x9c, 0x3d, 0x72, 0x16, 0x93, 0x4d, 0x6c,
+	0xdf, 0xd7, 0x1d, 0x1d, 0xdb, 0xed, 0x86, 0xdd, 0x76, 0x53, 0xf4, 0x13, 0xc2, 0xb4, 0x16, 0xc6,
+	0xbe, 0x02, 0x66, 0x20, 0x82, 0x97, 0x23, 0xd0, 0x86, 0xbc, 0xc2, 0x2b, 0x0e, 0x51, 0xda, 0x62,
+	0x59, 0xee, 0x3c, 0x0d, 0x66, 0x94, 0x04, 0x25, 0x25, 0x76, 0xf1, 0x3c, 0xee, 0x05, 0xe3, 0x4e,
+	0x20, 0x07, 0x49, 0x90, 0x51, 0x12, 0x38, 0x94, 0x04, 0x25, 0x25, 0x41, 0x6d, 0xdf, 0x23, 0xae,
+	0x4d, 0x54, 0x69, 0x46, 0xd6, 0xf0, 0xc2, 0x48, 0x6a, 0x50, 0xc6, 0xbe, 0x6f, 0x31, 0x2a, 0x22,
+	0x7a, 0x03, 0x6f, 0xd4, 0x96, 0x88, 0x40, 0x4b, 0x91, 0x6a, 0xa0, 0x03, 0x7c, 0xad, 0xdb, 0x3d,
+	0x3c, 0x14, 0xda, 0x3c, 0x80, 0x49, 0x37, 0x66, 0x69, 0xf9, 0x1c, 0x1f, 0x2f, 0x96, 0x34, 0x16,
+	0xb4, 0x9e, 0xc5, 0x84, 0xe0, 0x79, 0x29, 0x8a, 0x5e, 0xcd, 0xc8, 0xae, 0x09, 0xc5, 0x2b, 0x27,
+	0x3c, 0x4d, 0x40, 0x49, 0xc5, 0x53, 0xa3, 0xbd, 0xc6, 0x66, 0xa3, 0xbd, 0x14, 0x55, 0x72, 0xf4,
+	0x23, 0xc2, 0x78, 0xd6, 0x8d, 0xb4, 0x30, 0xce, 0x07, 0xd0, 0x69, 0xe2, 0x64, 0x88, 0x87, 0xff,
+	0x1b, 0xc0, 0xc4, 0x51, 0xad, 0x0c, 0x8b, 0x9d, 0x03, 0x66, 0x58, 0x21, 0x58, 0x19, 0x66, 0x72,
+	0x3a, 0x2d, 0xbd, 0xf9, 0x5c, 0x4e, 0x27, 0x95, 0x51, 0xa5, 0x0d, 0x33, 0x23, 0xed, 0x35, 0xed,
+	0x66, 0x11, 0x65, 0x35, 0xad, 0x04, 0xd0, 0xf3, 0x16, 0x2c, 0x87, 0x65, 0x48, 0xef, 0xe3, 0xb5,
+	0xf3, 0x1c, 0xe5, 0xec, 0x91, 0x1d, 0xdc, 0xe4, 0x06, 0x4e, 0x33, 0xb1, 0x1b, 0xed, 0xe5, 0xce,
+	0xf5, 0xc0, 0x75, 0xcf, 0xec, 0x4e, 0x94, 0x9f, 0xea, 0x7c, 0x6d, 0x62, 0xe2, 0x88, 0xd0, 0x05,
+	0x35, 0xe6, 0x31, 0x90, 0xf7, 0x08, 0x5f, 0xc9, 0x34, 0xdd, 0x77, 0x15, 0xbd, 0x55, 0xa9, 0x75,
+	0xb1, 0x0d, 0xfc, 0xcb, 0x9a, 0x30, 0xba, 0xfe, 0xe6, 0xfb, 0xcf, 0x77, 0x73, 0x6b, 0xe4, 0xaa,
+	0x35, 0xfb, 0x78, 0x37, 0xac, 0x4c, 0xdc, 0x17, 0x84, 0x57, 0x73, 0x03, 0x38, 0xf7, 0x48, 0xf8,
+	0x67, 0xd4, 0x15, 0xd7, 0x5c, 0x1e, 0xfa, 0x6d, 0x8b, 0x7e, 0x8b, 0xd6, 0xa2, 0xdf, 0xab, 0xba,
+	0xe7, 0x35, 0xc2, 0x2b, 0x99, 0xae, 0x85, 0x5c, 0x9a, 0xd0, 0x0b, 0x84, 0x74, 0x0c, 0xe2, 0xdf,
+	0xfc, 0xed, 0x99, 0xc2, 0x5e, 0x6d, 0x8b, 0x82, 0xd2, 0x8d, 0x3a, 0x14, 0xa1, 0xd6, 0xfd, 0x1d,
+	0x1d, 0xb3, 0x74, 0x0f, 0x6d, 0x93, 0x0f, 0x08, 0xaf, 0x1e, 0xc0, 0x10, 0xaa, 0x7c, 0xfe, 0x33,
+	0x53, 0xb0, 0x5d, 0xcb, 0xe3, 0xdd, 0x7b, 0x9f, 0xa7, 0x2d, 0xf4, 0x6d, 0xda, 0x42, 0x3f, 0xa6,
+	0x2d, 0xf4, 0xec, 0xce, 0xdf, 0x7d, 0xff, 0xf1, 0x90, 0x43, 0x6a, 0xdc, 0x42, 0xc7, 0x0b, 0xf6,
+	0xc7, 0xbf, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xbf, 0xb5, 0x58, 0x92, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -255,6 +480,8 @@ type CertificateServiceClient interface {
 	ListCertificates(ctx context.Context, in *RepositoryCertificateQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
 	// Creates repository certificates on the server
 	CreateCertificate(ctx context.Context, in *RepositoryCertificateCreateRequest, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
+	// Scans the public host keys of an SSH server, and stores the confirmed ones as known hosts
+	ScanHostKeys(ctx context.Context, in *SSHHostKeyScanRequest, opts ...grpc.CallOption) (*SSHHostKeyScanResponse, error)
 	// Delete the certificates that match the RepositoryCertificateQuery
 	DeleteCertificate(ctx context.Context, in *RepositoryCertificateQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error)
 }
@@ -285,6 +512,15 @@ func (c *certificateServiceClient) CreateCertificate(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *certificateServiceClient) ScanHostKeys(ctx context.Context, in *SSHHostKeyScanRequest, opts ...grpc.CallOption) (*SSHHostKeyScanResponse, error) {
+	out := new(SSHHostKeyScanResponse)
+	err := c.cc.Invoke(ctx, "/certificate.CertificateService/ScanHostKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certificateServiceClient) DeleteCertificate(ctx context.Context, in *RepositoryCertificateQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryCertificateList, error) {
 	out := new(v1alpha1.RepositoryCertificateList)
 	err := c.cc.Invoke(ctx, "/certificate.CertificateService/DeleteCertificate", in, out, opts...)
@@ -300,6 +536,8 @@ type CertificateServiceServer interface {
 	ListCertificates(context.Context, *RepositoryCertificateQuery) (*v1alpha1.RepositoryCertificateList, error)
 	// Creates repository certificates on the server
 	CreateCertificate(context.Context, *RepositoryCertificateCreateRequest) (*v1alpha1.RepositoryCertificateList, error)
+	// Scans the public host keys of an SSH server, and stores the confirmed ones as known hosts
+	ScanHostKeys(context.Context, *SSHHostKeyScanRequest) (*SSHHostKeyScanResponse, error)
 	// Delete the certificates that match the RepositoryCertificateQuery
 	DeleteCertificate(context.Context, *RepositoryCertificateQuery) (*v1alpha1.RepositoryCertificateList, error)
 }
@@ -314,6 +552,9 @@ func (*UnimplementedCertificateServiceServer) ListCertificates(ctx context.Conte
 func (*UnimplementedCertificateServiceServer) CreateCertificate(ctx context.Context, req *RepositoryCertificateCreateRequest) (*v1alpha1.RepositoryCertificateList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCertificate not implemented")
 }
+func (*UnimplementedCertificateServiceServer) ScanHostKeys(ctx context.Context, req *SSHHostKeyScanRequest) (*SSHHostKeyScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanHostKeys not implemented")
+}
 func (*UnimplementedCertificateServiceServer) DeleteCertificate(ctx context.Context, req *RepositoryCertificateQuery) (*v1alpha1.RepositoryCertificateList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCertificate not implemented")
 }
@@ -358,6 +599,24 @@ func _CertificateService_CreateCertificate_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CertificateService_ScanHostKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHHostKeyScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).ScanHostKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/certificate.CertificateService/ScanHostKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).ScanHostKeys(ctx, req.(*SSHHostKeyScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CertificateService_DeleteCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepositoryCertificateQuery)
 	if err := dec(in); err != nil {
@@ -388,6 +647,10 @@ var _CertificateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCertificate",
 			Handler:    _CertificateService_CreateCertificate_Handler,
 		},
+		{
+			MethodName: "ScanHostKeys",
+			Handler:    _CertificateService_ScanHostKeys_Handler,
+		},
 		{
 			MethodName: "DeleteCertificate",
 			Handler:    _CertificateService_DeleteCertificate_Handler,
@@ -521,6 +784,167 @@ func (m *RepositoryCertificateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *SSHHostKeyScanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHHostKeyScanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHHostKeyScanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Fingerprints) > 0 {
+		for iNdEx := len(m.Fingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fingerprints[iNdEx])
+			copy(dAtA[i:], m.Fingerprints[iNdEx])
+			i = encodeVarintCertificate(dAtA, i, uint64(len(m.Fingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Port != 0 {
+		i = encodeVarintCertificate(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HostName) > 0 {
+		i -= len(m.HostName)
+		copy(dAtA[i:], m.HostName)
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.HostName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHHostKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHHostKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHHostKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.KeyData) > 0 {
+		i -= len(m.KeyData)
+		copy(dAtA[i:], m.KeyData)
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.KeyData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServerName) > 0 {
+		i -= len(m.ServerName)
+		copy(dAtA[i:], m.ServerName)
+		i = encodeVarintCertificate(dAtA, i, uint64(len(m.ServerName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHHostKeyScanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHHostKeyScanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHHostKeyScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCertificate(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCertificate(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCertificate(v)
 	base := offset
@@ -587,18 +1011,96 @@ func (m *RepositoryCertificateResponse) Size() (n int) {
 	return n
 }
 
-func sovCertificate(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCertificate(x uint64) (n int) {
-	return sovCertificate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *RepositoryCertificateQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *SSHHostKeyScanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostName)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovCertificate(uint64(m.Port))
+	}
+	if len(m.Fingerprints) > 0 {
+		for _, s := range m.Fingerprints {
+			l = len(s)
+			n += 1 + l + sovCertificate(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHHostKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerName)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.KeyData)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovCertificate(uint64(l))
+	}
+	if m.Applied {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHHostKeyScanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCertificate(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCertificate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCertificate(x uint64) (n int) {
+	return sovCertificate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RepositoryCertificateQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowCertificate
@@ -898,6 +1400,456 @@ func (m *RepositoryCertificateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SSHHostKeyScanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHHostKeyScanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHHostKeyScanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprints = append(m.Fingerprints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHHostKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHHostKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHHostKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHHostKeyScanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCertificate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHHostKeyScanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHHostKeyScanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCertificate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &SSHHostKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCertificate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCertificate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCertificate(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
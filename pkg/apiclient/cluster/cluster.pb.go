@@ -92,12 +92,14 @@ func (m *ClusterID) GetValue() string {
 
 // ClusterQuery is a query for cluster resources
 type ClusterQuery struct {
-	Server               string     `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
-	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Id                   *ClusterID `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Server string     `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Name   string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id     *ClusterID `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// the external IDs to restrict returned list clusters
+	ExternalIDs          []string `protobuf:"bytes,4,rep,name=externalIDs,proto3" json:"externalIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ClusterQuery) Reset()         { *m = ClusterQuery{} }
@@ -154,6 +156,61 @@ func (m *ClusterQuery) GetId() *ClusterID {
 	return nil
 }
 
+func (m *ClusterQuery) GetExternalIDs() []string {
+	if m != nil {
+		return m.ExternalIDs
+	}
+	return nil
+}
+
+// ClusterByIDQuery is a query for a cluster by its external ID
+type ClusterByIDQuery struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterByIDQuery) Reset()         { *m = ClusterByIDQuery{} }
+func (m *ClusterByIDQuery) String() string { return proto.CompactTextString(m) }
+func (*ClusterByIDQuery) ProtoMessage()    {}
+func (*ClusterByIDQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{2}
+}
+func (m *ClusterByIDQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterByIDQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterByIDQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterByIDQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterByIDQuery.Merge(m, src)
+}
+func (m *ClusterByIDQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterByIDQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterByIDQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterByIDQuery proto.InternalMessageInfo
+
+func (m *ClusterByIDQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type ClusterResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -164,7 +221,7 @@ func (m *ClusterResponse) Reset()         { *m = ClusterResponse{} }
 func (m *ClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*ClusterResponse) ProtoMessage()    {}
 func (*ClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b5ba0b5aa57b32, []int{2}
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{3}
 }
 func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,7 +262,7 @@ func (m *ClusterCreateRequest) Reset()         { *m = ClusterCreateRequest{} }
 func (m *ClusterCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterCreateRequest) ProtoMessage()    {}
 func (*ClusterCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b5ba0b5aa57b32, []int{3}
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{4}
 }
 func (m *ClusterCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +318,7 @@ func (m *ClusterUpdateRequest) Reset()         { *m = ClusterUpdateRequest{} }
 func (m *ClusterUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ClusterUpdateRequest) ProtoMessage()    {}
 func (*ClusterUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a6b5ba0b5aa57b32, []int{4}
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{5}
 }
 func (m *ClusterUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,6 +371,7 @@ func (m *ClusterUpdateRequest) GetId() *ClusterID {
 func init() {
 	proto.RegisterType((*ClusterID)(nil), "cluster.ClusterID")
 	proto.RegisterType((*ClusterQuery)(nil), "cluster.ClusterQuery")
+	proto.RegisterType((*ClusterByIDQuery)(nil), "cluster.ClusterByIDQuery")
 	proto.RegisterType((*ClusterResponse)(nil), "cluster.ClusterResponse")
 	proto.RegisterType((*ClusterCreateRequest)(nil), "cluster.ClusterCreateRequest")
 	proto.RegisterType((*ClusterUpdateRequest)(nil), "cluster.ClusterUpdateRequest")
@@ -322,45 +380,51 @@ func init() {
 func init() { proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_a6b5ba0b5aa57b32) }
 
 var fileDescriptor_a6b5ba0b5aa57b32 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x14, 0xc7, 0xe5, 0x6e, 0xbf, 0xee, 0x57, 0x0f, 0x18, 0x58, 0x03, 0x45, 0xdd, 0x56, 0x95, 0x80,
-	0xa0, 0xa0, 0xd5, 0x56, 0xcb, 0xb8, 0x70, 0x63, 0x1d, 0xa0, 0x4a, 0xbb, 0x10, 0xc4, 0x85, 0xc3,
-	0x26, 0x2f, 0x79, 0x4a, 0xcd, 0xb2, 0xc4, 0xc4, 0x4e, 0xa4, 0x09, 0x71, 0xd9, 0x89, 0x1b, 0x42,
-	0x5c, 0xb9, 0xf2, 0x87, 0x70, 0x43, 0xe2, 0x82, 0xc4, 0x3f, 0x80, 0x2a, 0xfe, 0x10, 0x14, 0x27,
-	0x69, 0x69, 0xa7, 0x55, 0x43, 0x2a, 0x9c, 0xea, 0xf7, 0xd4, 0xe7, 0xef, 0xe7, 0x7d, 0xfd, 0x1c,
-	0xe3, 0x75, 0x05, 0x71, 0x0a, 0x31, 0x73, 0x83, 0x44, 0xe9, 0xf1, 0x2f, 0x95, 0x71, 0xa4, 0x23,
-	0xb2, 0x54, 0x84, 0xf5, 0x75, 0x3f, 0x8a, 0xfc, 0x00, 0x18, 0x97, 0x82, 0xf1, 0x30, 0x8c, 0x34,
-	0xd7, 0x22, 0x0a, 0x55, 0xfe, 0xb7, 0xfa, 0xae, 0x2f, 0xf4, 0x20, 0x39, 0xa0, 0x6e, 0x74, 0xc4,
-	0x78, 0xec, 0x47, 0x32, 0x8e, 0x5e, 0x9a, 0x45, 0xdb, 0xf5, 0x58, 0xda, 0x65, 0xf2, 0xd0, 0xcf,
-	0x2a, 0x15, 0xe3, 0x52, 0x06, 0xc2, 0x35, 0xb5, 0x2c, 0xed, 0xf0, 0x40, 0x0e, 0x78, 0x87, 0xf9,
-	0x10, 0x42, 0xcc, 0x35, 0x78, 0xf9, 0x6e, 0xf6, 0x7d, 0x5c, 0xeb, 0xe5, 0xb2, 0xfd, 0x1d, 0x42,
-	0xf0, 0xa2, 0x3e, 0x96, 0x60, 0xa1, 0x26, 0x6a, 0xd5, 0x1c, 0xb3, 0x26, 0xab, 0xf8, 0xbf, 0x94,
-	0x07, 0x09, 0x58, 0x15, 0x93, 0xcc, 0x03, 0x7b, 0x0f, 0x5f, 0x28, 0xca, 0x9e, 0x26, 0x10, 0x1f,
-	0x93, 0x6b, 0xb8, 0x9a, 0xf7, 0x56, 0xd4, 0x16, 0x51, 0xb6, 0x63, 0xc8, 0x8f, 0xca, 0x62, 0xb3,
-	0x26, 0x36, 0xae, 0x08, 0xcf, 0x5a, 0x68, 0xa2, 0xd6, 0x72, 0x97, 0xd0, 0xd2, 0x83, 0x11, 0x85,
-	0x53, 0x11, 0x9e, 0x7d, 0x05, 0xaf, 0x14, 0x09, 0x07, 0x94, 0x8c, 0x42, 0x05, 0xf6, 0x3b, 0x84,
-	0x57, 0x8b, 0x5c, 0x2f, 0x06, 0xae, 0xc1, 0x81, 0x57, 0x09, 0x28, 0x4d, 0xf6, 0x71, 0xe9, 0x9c,
-	0x11, 0x5f, 0xee, 0x3e, 0xa2, 0x63, 0x8b, 0x68, 0x69, 0x91, 0x59, 0xec, 0xbb, 0x1e, 0x4d, 0xbb,
-	0x54, 0x1e, 0xfa, 0x34, 0xb3, 0x88, 0xfe, 0x66, 0x11, 0x2d, 0x2d, 0x2a, 0x49, 0x9c, 0x72, 0xd7,
-	0xac, 0xb9, 0x44, 0x2a, 0x88, 0xb5, 0x69, 0xe3, 0x7f, 0xa7, 0x88, 0xec, 0xcf, 0x63, 0xa2, 0xe7,
-	0xd2, 0xfb, 0x97, 0x44, 0x37, 0xf1, 0xc5, 0xc4, 0x28, 0x7a, 0x8f, 0x05, 0x04, 0x9e, 0xb2, 0x2a,
-	0xcd, 0x85, 0x56, 0xcd, 0x99, 0x4c, 0x9e, 0xc7, 0xe8, 0xee, 0xd7, 0x25, 0x7c, 0xa9, 0xc8, 0x3c,
-	0x83, 0x38, 0x15, 0x2e, 0x90, 0x13, 0x84, 0x17, 0x77, 0x85, 0xd2, 0xe4, 0xea, 0x74, 0x8d, 0x39,
-	0xeb, 0x7a, 0x7f, 0x2e, 0xcd, 0x64, 0x0a, 0xb6, 0x75, 0xf2, 0xfd, 0xe7, 0x87, 0x0a, 0x21, 0x97,
-	0xcd, 0xac, 0xa7, 0x9d, 0xf2, 0x46, 0x28, 0xf2, 0x1e, 0xe1, 0x6a, 0x7e, 0xcc, 0x64, 0x63, 0x1a,
-	0x63, 0xe2, 0xf8, 0xeb, 0xf3, 0xf1, 0xd6, 0xbe, 0x6e, 0x50, 0xd6, 0xec, 0x53, 0x28, 0x0f, 0x46,
-	0xae, 0xbf, 0x45, 0x78, 0xe1, 0x09, 0x9c, 0xe9, 0xcb, 0x9c, 0x40, 0x6e, 0x18, 0x90, 0x0d, 0xb2,
-	0x36, 0x0d, 0xc2, 0x5e, 0x0b, 0x8f, 0x9a, 0xeb, 0xf7, 0x86, 0x7c, 0x44, 0xb8, 0x9a, 0xcf, 0xdc,
-	0x69, 0x7b, 0x26, 0x66, 0x71, 0x5e, 0x54, 0x9b, 0x86, 0xea, 0x56, 0x7d, 0x16, 0xd5, 0xd8, 0xa9,
-	0x3d, 0x5c, 0xdd, 0x81, 0x00, 0x34, 0x9c, 0xe5, 0x95, 0x35, 0x9d, 0x1e, 0x5d, 0xf3, 0xa2, 0xfd,
-	0xbb, 0x33, 0xdb, 0x0f, 0x31, 0x76, 0xb2, 0xcf, 0x22, 0x3c, 0x4c, 0xf4, 0xe0, 0xcf, 0x35, 0x98,
-	0xd1, 0xb8, 0x63, 0xdf, 0x9e, 0xa1, 0xc1, 0x62, 0x23, 0xd0, 0xe6, 0x99, 0xc2, 0x27, 0x84, 0x57,
-	0xfa, 0x61, 0xca, 0x03, 0x91, 0x59, 0xdb, 0xe3, 0xee, 0x00, 0xfe, 0xf2, 0x14, 0x6c, 0x19, 0x44,
-	0x6a, 0x6f, 0xce, 0x42, 0x14, 0x23, 0xa4, 0xb6, 0x9b, 0x31, 0x6d, 0x6f, 0x7f, 0x19, 0x36, 0xd0,
-	0xb7, 0x61, 0x03, 0xfd, 0x18, 0x36, 0xd0, 0x8b, 0xad, 0xf3, 0xbd, 0x14, 0x6e, 0x20, 0x20, 0xd4,
-	0xa5, 0xc0, 0x41, 0xd5, 0x3c, 0x0c, 0xf7, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x77, 0xdb,
-	0xd9, 0xad, 0x06, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb5, 0x69, 0x49, 0xe9, 0x16, 0xda, 0xb2, 0x2a, 0xc8, 0xa4, 0x1f, 0x0a, 0x06, 0x41,
+	0xa8, 0x1a, 0x9b, 0x86, 0x72, 0xe1, 0xd6, 0x36, 0x50, 0x45, 0xea, 0x01, 0x0c, 0x5c, 0x38, 0x50,
+	0x6d, 0xed, 0x91, 0xb3, 0xd4, 0xb5, 0x97, 0xdd, 0xb5, 0x45, 0x84, 0x2a, 0xa1, 0x9e, 0xb8, 0xf1,
+	0x75, 0xe5, 0xca, 0x03, 0xf0, 0x08, 0xdc, 0x38, 0x22, 0xf1, 0x02, 0xa8, 0xe2, 0x41, 0x90, 0xd7,
+	0x76, 0xd2, 0xa6, 0x6a, 0x54, 0xa4, 0x94, 0x53, 0x76, 0x47, 0x9e, 0x99, 0xdf, 0xfe, 0xff, 0x99,
+	0x5d, 0x3c, 0x27, 0x41, 0x24, 0x20, 0x6c, 0x37, 0x88, 0xa5, 0xea, 0xfd, 0x5a, 0x5c, 0x44, 0x2a,
+	0x22, 0x63, 0xf9, 0xb6, 0x32, 0xe7, 0x47, 0x91, 0x1f, 0x80, 0x4d, 0x39, 0xb3, 0x69, 0x18, 0x46,
+	0x8a, 0x2a, 0x16, 0x85, 0x32, 0xfb, 0xac, 0xb2, 0xe9, 0x33, 0xd5, 0x8e, 0xb7, 0x2d, 0x37, 0xda,
+	0xb5, 0xa9, 0xf0, 0x23, 0x2e, 0xa2, 0x97, 0x7a, 0x51, 0x77, 0x3d, 0x3b, 0x69, 0xd8, 0x7c, 0xc7,
+	0x4f, 0x33, 0xa5, 0x4d, 0x39, 0x0f, 0x98, 0xab, 0x73, 0xed, 0x64, 0x99, 0x06, 0xbc, 0x4d, 0x97,
+	0x6d, 0x1f, 0x42, 0x10, 0x54, 0x81, 0x97, 0x55, 0x33, 0xef, 0xe1, 0xf1, 0xf5, 0xac, 0x6d, 0xab,
+	0x49, 0x08, 0x1e, 0x55, 0x1d, 0x0e, 0x06, 0xaa, 0xa2, 0xda, 0xb8, 0xa3, 0xd7, 0x64, 0x06, 0x9f,
+	0x4b, 0x68, 0x10, 0x83, 0x51, 0xd2, 0xc1, 0x6c, 0x63, 0xbe, 0x45, 0xf8, 0x42, 0x9e, 0xf7, 0x38,
+	0x06, 0xd1, 0x21, 0x57, 0x70, 0x39, 0x3b, 0x5c, 0x9e, 0x9c, 0xef, 0xd2, 0x92, 0x21, 0xdd, 0x2d,
+	0xb2, 0xf5, 0x9a, 0x98, 0xb8, 0xc4, 0x3c, 0x63, 0xa4, 0x8a, 0x6a, 0x13, 0x0d, 0x62, 0x15, 0x22,
+	0x74, 0x31, 0x9c, 0x12, 0xf3, 0x48, 0x15, 0x4f, 0xc0, 0x6b, 0x05, 0x22, 0xa4, 0x41, 0xab, 0x29,
+	0x8d, 0xd1, 0xea, 0x48, 0x6d, 0xdc, 0x39, 0x1c, 0x32, 0x4d, 0x3c, 0x9d, 0xa7, 0xac, 0x75, 0x5a,
+	0xcd, 0x8c, 0x62, 0x52, 0x57, 0xce, 0x08, 0x4a, 0xcc, 0x33, 0x2f, 0xe1, 0xa9, 0xfc, 0x1b, 0x07,
+	0x24, 0x8f, 0x42, 0x09, 0xe6, 0x7b, 0x84, 0x67, 0xf2, 0xd8, 0xba, 0x00, 0xaa, 0xc0, 0x81, 0x57,
+	0x31, 0x48, 0x45, 0xb6, 0x70, 0x61, 0x80, 0x2e, 0x30, 0xd1, 0x78, 0x60, 0xf5, 0x94, 0xb6, 0x0a,
+	0xa5, 0xf5, 0x62, 0xcb, 0xf5, 0xac, 0xa4, 0x61, 0xf1, 0x1d, 0xdf, 0x4a, 0x95, 0xb6, 0x0e, 0x29,
+	0x6d, 0x15, 0x4a, 0x17, 0xe7, 0x71, 0x8a, 0xaa, 0xa9, 0x44, 0x31, 0x97, 0x20, 0x94, 0x16, 0xe3,
+	0xbc, 0x93, 0xef, 0xcc, 0xef, 0x3d, 0xa2, 0x67, 0xdc, 0xfb, 0x9f, 0x44, 0x37, 0xf0, 0xc5, 0x58,
+	0x77, 0xf4, 0x1e, 0x32, 0x08, 0x3c, 0x69, 0x94, 0xb4, 0xcc, 0x47, 0x83, 0xa7, 0xb1, 0xab, 0xf1,
+	0x09, 0xe3, 0xc9, 0x3c, 0xf2, 0x04, 0x44, 0xc2, 0x5c, 0x20, 0xfb, 0x08, 0x8f, 0x6e, 0x32, 0xa9,
+	0xc8, 0xe5, 0xfe, 0x1c, 0xed, 0x55, 0xa5, 0x35, 0x94, 0xc3, 0xa4, 0x1d, 0x4c, 0x63, 0xff, 0xd7,
+	0x9f, 0xcf, 0x25, 0x42, 0xa6, 0xf5, 0xc8, 0x24, 0xcb, 0xc5, 0x60, 0x49, 0xf2, 0x11, 0xe1, 0x72,
+	0x66, 0x33, 0x99, 0xef, 0xc7, 0x38, 0x62, 0x7f, 0x65, 0x38, 0xda, 0x9a, 0xd7, 0x34, 0xca, 0xac,
+	0x79, 0x0c, 0xe5, 0x7e, 0x57, 0xf5, 0x77, 0x08, 0x8f, 0x6c, 0xc0, 0x89, 0xba, 0x0c, 0x09, 0xe4,
+	0xba, 0x06, 0x99, 0x27, 0xb3, 0xfd, 0x20, 0xf6, 0x1b, 0xe6, 0x59, 0x7a, 0x8a, 0xf7, 0xc8, 0x07,
+	0x84, 0xc7, 0x36, 0x40, 0xa5, 0x03, 0x44, 0xae, 0xf6, 0xe3, 0x74, 0xc7, 0xea, 0xec, 0x91, 0xb6,
+	0x3b, 0x75, 0xe6, 0xa5, 0x60, 0x7b, 0xe4, 0x0b, 0xc2, 0xe5, 0x6c, 0x0c, 0x8e, 0x3b, 0x76, 0x64,
+	0x3c, 0x86, 0x45, 0xb5, 0xa4, 0xa9, 0x6e, 0x56, 0x06, 0x09, 0xd5, 0x33, 0xef, 0x05, 0x2e, 0x37,
+	0x21, 0x00, 0x05, 0x27, 0xd9, 0x67, 0xf4, 0x87, 0xbb, 0x37, 0x4f, 0x7e, 0xfc, 0xc5, 0x81, 0x8e,
+	0x84, 0x18, 0x3b, 0xe9, 0x85, 0x0f, 0xab, 0xb1, 0x6a, 0xff, 0x7b, 0x0f, 0x5b, 0xf7, 0xb8, 0x6d,
+	0xde, 0x1a, 0xd0, 0xc3, 0x16, 0xba, 0x41, 0x9d, 0xa6, 0x1d, 0xbe, 0x22, 0x3c, 0xd5, 0x0a, 0x13,
+	0x1a, 0xb0, 0x54, 0xda, 0x75, 0xea, 0xb6, 0xe1, 0x8c, 0xff, 0x98, 0x2b, 0x1a, 0xd1, 0x32, 0x97,
+	0x06, 0x21, 0xb2, 0x2e, 0x52, 0xdd, 0xd5, 0x4c, 0xdf, 0x10, 0x9e, 0x4e, 0x67, 0x7d, 0xf5, 0x51,
+	0xcb, 0x01, 0x19, 0xc5, 0xc2, 0x05, 0x79, 0x12, 0xe8, 0xd3, 0xa1, 0x80, 0x1e, 0xea, 0xa4, 0x2f,
+	0x99, 0x3b, 0x9a, 0x7b, 0x91, 0xd4, 0x06, 0x71, 0x53, 0xce, 0x44, 0x81, 0xb7, 0xb6, 0xf6, 0xe3,
+	0x60, 0x01, 0xfd, 0x3c, 0x58, 0x40, 0xbf, 0x0f, 0x16, 0xd0, 0xf3, 0x95, 0xd3, 0xbd, 0xdb, 0x6e,
+	0xc0, 0x20, 0x54, 0x45, 0xf1, 0xed, 0xb2, 0x7e, 0xa6, 0xef, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0x8d, 0x3a, 0x34, 0x91, 0x3b, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -381,6 +445,8 @@ type ClusterServiceClient interface {
 	Create(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Get returns a cluster by server address
 	Get(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// GetByID returns a cluster by its external ID
+	GetByID(ctx context.Context, in *ClusterByIDQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Update updates a cluster
 	Update(ctx context.Context, in *ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
@@ -389,6 +455,8 @@ type ClusterServiceClient interface {
 	RotateAuth(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
+	// ListAPIResources returns the API resources discovered on the cluster by the application controller
+	ListAPIResources(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.ClusterAPIResourceList, error)
 }
 
 type clusterServiceClient struct {
@@ -426,6 +494,15 @@ func (c *clusterServiceClient) Get(ctx context.Context, in *ClusterQuery, opts .
 	return out, nil
 }
 
+func (c *clusterServiceClient) GetByID(ctx context.Context, in *ClusterByIDQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
+	out := new(v1alpha1.Cluster)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) Update(ctx context.Context, in *ClusterUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	out := new(v1alpha1.Cluster)
 	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Update", in, out, opts...)
@@ -462,6 +539,15 @@ func (c *clusterServiceClient) InvalidateCache(ctx context.Context, in *ClusterQ
 	return out, nil
 }
 
+func (c *clusterServiceClient) ListAPIResources(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.ClusterAPIResourceList, error) {
+	out := new(v1alpha1.ClusterAPIResourceList)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/ListAPIResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 type ClusterServiceServer interface {
 	// List returns list of clusters
@@ -470,6 +556,8 @@ type ClusterServiceServer interface {
 	Create(context.Context, *ClusterCreateRequest) (*v1alpha1.Cluster, error)
 	// Get returns a cluster by server address
 	Get(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
+	// GetByID returns a cluster by its external ID
+	GetByID(context.Context, *ClusterByIDQuery) (*v1alpha1.Cluster, error)
 	// Update updates a cluster
 	Update(context.Context, *ClusterUpdateRequest) (*v1alpha1.Cluster, error)
 	// Delete deletes a cluster
@@ -478,6 +566,8 @@ type ClusterServiceServer interface {
 	RotateAuth(context.Context, *ClusterQuery) (*ClusterResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
+	// ListAPIResources returns the API resources discovered on the cluster by the application controller
+	ListAPIResources(context.Context, *ClusterQuery) (*v1alpha1.ClusterAPIResourceList, error)
 }
 
 // UnimplementedClusterServiceServer can be embedded to have forward compatible implementations.
@@ -493,6 +583,9 @@ func (*UnimplementedClusterServiceServer) Create(ctx context.Context, req *Clust
 func (*UnimplementedClusterServiceServer) Get(ctx context.Context, req *ClusterQuery) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (*UnimplementedClusterServiceServer) GetByID(ctx context.Context, req *ClusterByIDQuery) (*v1alpha1.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
 func (*UnimplementedClusterServiceServer) Update(ctx context.Context, req *ClusterUpdateRequest) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -505,6 +598,9 @@ func (*UnimplementedClusterServiceServer) RotateAuth(ctx context.Context, req *C
 func (*UnimplementedClusterServiceServer) InvalidateCache(ctx context.Context, req *ClusterQuery) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
 }
+func (*UnimplementedClusterServiceServer) ListAPIResources(ctx context.Context, req *ClusterQuery) (*v1alpha1.ClusterAPIResourceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIResources not implemented")
+}
 
 func RegisterClusterServiceServer(s *grpc.Server, srv ClusterServiceServer) {
 	s.RegisterService(&_ClusterService_serviceDesc, srv)
@@ -564,6 +660,24 @@ func _ClusterService_Get_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterByIDQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetByID(ctx, req.(*ClusterByIDQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ClusterUpdateRequest)
 	if err := dec(in); err != nil {
@@ -636,6 +750,24 @@ func _ClusterService_InvalidateCache_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_ListAPIResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListAPIResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/ListAPIResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListAPIResources(ctx, req.(*ClusterQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.ClusterService",
 	HandlerType: (*ClusterServiceServer)(nil),
@@ -652,6 +784,10 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _ClusterService_Get_Handler,
 		},
+		{
+			MethodName: "GetByID",
+			Handler:    _ClusterService_GetByID_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _ClusterService_Update_Handler,
@@ -668,6 +804,10 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateCache",
 			Handler:    _ClusterService_InvalidateCache_Handler,
 		},
+		{
+			MethodName: "ListAPIResources",
+			Handler:    _ClusterService_ListAPIResources_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/cluster/cluster.proto",
@@ -738,6 +878,15 @@ func (m *ClusterQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExternalIDs) > 0 {
+		for iNdEx := len(m.ExternalIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExternalIDs[iNdEx])
+			copy(dAtA[i:], m.ExternalIDs[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.ExternalIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Id != nil {
 		{
 			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
@@ -767,6 +916,40 @@ func (m *ClusterQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterByIDQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterByIDQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterByIDQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -952,6 +1135,28 @@ func (m *ClusterQuery) Size() (n int) {
 		l = m.Id.Size()
 		n += 1 + l + sovCluster(uint64(l))
 	}
+	if len(m.ExternalIDs) > 0 {
+		for _, s := range m.ExternalIDs {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterByIDQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1265,6 +1470,121 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalIDs = append(m.ExternalIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterByIDQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterByIDQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterByIDQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
@@ -17,6 +17,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -431,6 +432,278 @@ func (m *TemplatesListRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TemplatesListRequest proto.InternalMessageInfo
 
+// Delivery holds the outcome of sending the notifications of a trigger to a single destination
+type Delivery struct {
+	Id                   *string  `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	Application          *string  `protobuf:"bytes,2,opt,name=application" json:"application,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	Trigger              *string  `protobuf:"bytes,5,opt,name=trigger" json:"trigger,omitempty"`
+	Service              *string  `protobuf:"bytes,6,opt,name=service" json:"service,omitempty"`
+	Recipient            *string  `protobuf:"bytes,7,opt,name=recipient" json:"recipient,omitempty"`
+	Templates            []string `protobuf:"bytes,8,rep,name=templates" json:"templates,omitempty"`
+	Attempts             *int64   `protobuf:"varint,9,opt,name=attempts" json:"attempts,omitempty"`
+	Succeeded            *bool    `protobuf:"varint,10,opt,name=succeeded" json:"succeeded,omitempty"`
+	Retrying             *bool    `protobuf:"varint,11,opt,name=retrying" json:"retrying,omitempty"`
+	Error                *string  `protobuf:"bytes,12,opt,name=error" json:"error,omitempty"`
+	Time                 *v1.Time `protobuf:"bytes,13,opt,name=time" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Delivery) Reset()         { *m = Delivery{} }
+func (m *Delivery) String() string { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()    {}
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{9}
+}
+func (m *Delivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delivery.Merge(m, src)
+}
+func (m *Delivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delivery proto.InternalMessageInfo
+
+func (m *Delivery) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return ""
+}
+
+func (m *Delivery) GetApplication() string {
+	if m != nil && m.Application != nil {
+		return *m.Application
+	}
+	return ""
+}
+
+func (m *Delivery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *Delivery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *Delivery) GetTrigger() string {
+	if m != nil && m.Trigger != nil {
+		return *m.Trigger
+	}
+	return ""
+}
+
+func (m *Delivery) GetService() string {
+	if m != nil && m.Service != nil {
+		return *m.Service
+	}
+	return ""
+}
+
+func (m *Delivery) GetRecipient() string {
+	if m != nil && m.Recipient != nil {
+		return *m.Recipient
+	}
+	return ""
+}
+
+func (m *Delivery) GetTemplates() []string {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *Delivery) GetAttempts() int64 {
+	if m != nil && m.Attempts != nil {
+		return *m.Attempts
+	}
+	return 0
+}
+
+func (m *Delivery) GetSucceeded() bool {
+	if m != nil && m.Succeeded != nil {
+		return *m.Succeeded
+	}
+	return false
+}
+
+func (m *Delivery) GetRetrying() bool {
+	if m != nil && m.Retrying != nil {
+		return *m.Retrying
+	}
+	return false
+}
+
+func (m *Delivery) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+func (m *Delivery) GetTime() *v1.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type DeliveryList struct {
+	Items                []*Delivery `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DeliveryList) Reset()         { *m = DeliveryList{} }
+func (m *DeliveryList) String() string { return proto.CompactTextString(m) }
+func (*DeliveryList) ProtoMessage()    {}
+func (*DeliveryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{10}
+}
+func (m *DeliveryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryList.Merge(m, src)
+}
+func (m *DeliveryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryList proto.InternalMessageInfo
+
+func (m *DeliveryList) GetItems() []*Delivery {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// DeliveriesListRequest filters the listed deliveries
+type DeliveriesListRequest struct {
+	AppName      *string `protobuf:"bytes,1,opt,name=appName" json:"appName,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	Trigger      *string `protobuf:"bytes,4,opt,name=trigger" json:"trigger,omitempty"`
+	// FailedOnly lists only the deliveries which have not succeeded, including the ones queued for retry
+	FailedOnly           *bool    `protobuf:"varint,5,opt,name=failedOnly" json:"failedOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliveriesListRequest) Reset()         { *m = DeliveriesListRequest{} }
+func (m *DeliveriesListRequest) String() string { return proto.CompactTextString(m) }
+func (*DeliveriesListRequest) ProtoMessage()    {}
+func (*DeliveriesListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{11}
+}
+func (m *DeliveriesListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveriesListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveriesListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveriesListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveriesListRequest.Merge(m, src)
+}
+func (m *DeliveriesListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveriesListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveriesListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveriesListRequest proto.InternalMessageInfo
+
+func (m *DeliveriesListRequest) GetAppName() string {
+	if m != nil && m.AppName != nil {
+		return *m.AppName
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetTrigger() string {
+	if m != nil && m.Trigger != nil {
+		return *m.Trigger
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetFailedOnly() bool {
+	if m != nil && m.FailedOnly != nil {
+		return *m.FailedOnly
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Trigger)(nil), "notification.Trigger")
 	proto.RegisterType((*TriggerList)(nil), "notification.TriggerList")
@@ -441,6 +714,9 @@ func init() {
 	proto.RegisterType((*Template)(nil), "notification.Template")
 	proto.RegisterType((*TemplateList)(nil), "notification.TemplateList")
 	proto.RegisterType((*TemplatesListRequest)(nil), "notification.TemplatesListRequest")
+	proto.RegisterType((*Delivery)(nil), "notification.Delivery")
+	proto.RegisterType((*DeliveryList)(nil), "notification.DeliveryList")
+	proto.RegisterType((*DeliveriesListRequest)(nil), "notification.DeliveriesListRequest")
 }
 
 func init() {
@@ -448,31 +724,51 @@ func init() {
 }
 
 var fileDescriptor_e1dead44d55a8ff4 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x4e, 0xf3, 0x30,
-	0x10, 0xc7, 0x95, 0x7e, 0x1f, 0x02, 0xdc, 0xb2, 0xb8, 0xb4, 0x2a, 0x15, 0x84, 0x36, 0x43, 0xa9,
-	0x04, 0xd4, 0xa2, 0x1b, 0x88, 0x89, 0x85, 0x05, 0x31, 0x94, 0x4e, 0x6c, 0x21, 0x18, 0x63, 0x68,
-	0xe2, 0x60, 0x5f, 0x23, 0x66, 0x5e, 0x81, 0x97, 0x62, 0x44, 0xe2, 0x05, 0x50, 0xc5, 0x5b, 0xb0,
-	0xa0, 0xb8, 0xb6, 0x14, 0x57, 0xa9, 0x60, 0x73, 0xee, 0x7e, 0xbe, 0x9f, 0xee, 0xef, 0xa0, 0x9e,
-	0xa2, 0x32, 0xa3, 0x92, 0x24, 0x02, 0xf8, 0x1d, 0x8f, 0x42, 0xe0, 0x22, 0x71, 0x3e, 0x06, 0xa9,
-	0x14, 0x20, 0x70, 0xad, 0x58, 0x6b, 0x6f, 0x33, 0x21, 0xd8, 0x84, 0x92, 0x30, 0xe5, 0x24, 0x4c,
-	0x12, 0x01, 0xba, 0xac, 0xe6, 0x6c, 0xb0, 0x83, 0x56, 0xc7, 0x92, 0x33, 0x46, 0x25, 0xc6, 0xe8,
-	0x7f, 0x12, 0xc6, 0xb4, 0xe5, 0x75, 0x2a, 0xfd, 0xf5, 0x91, 0x3e, 0x07, 0x27, 0xa8, 0x6a, 0xda,
-	0x17, 0x5c, 0x01, 0xde, 0x47, 0x2b, 0x1c, 0x68, 0xac, 0x5a, 0x5e, 0xe7, 0x5f, 0xbf, 0x3a, 0x6c,
-	0x0c, 0x1c, 0xbb, 0x21, 0x47, 0x73, 0x26, 0x68, 0xa0, 0xba, 0xa9, 0xa8, 0xfc, 0xf2, 0x88, 0x3e,
-	0x4d, 0xa9, 0x82, 0xdc, 0x78, 0x45, 0x65, 0xc6, 0x23, 0xba, 0xcc, 0x68, 0xda, 0x7f, 0x30, 0x1a,
-	0xb2, 0x60, 0x34, 0x15, 0xc7, 0xe8, 0xa3, 0xb5, 0x31, 0x8d, 0xd3, 0x49, 0x08, 0xe5, 0xca, 0x53,
-	0x54, 0xb3, 0x7d, 0xed, 0x3c, 0x70, 0x9d, 0xcd, 0x85, 0x2d, 0x0d, 0x6a, 0xa5, 0x4d, 0xb4, 0x69,
-	0x4b, 0x45, 0xeb, 0xf0, 0xbb, 0x82, 0xea, 0x97, 0x85, 0x8b, 0x76, 0x69, 0x40, 0xb5, 0x1c, 0xb3,
-	0xd1, 0xe0, 0x6e, 0x69, 0x88, 0xc5, 0x51, 0xed, 0xad, 0x52, 0x24, 0x27, 0x82, 0xde, 0xcb, 0xc7,
-	0xd7, 0x6b, 0xa5, 0x83, 0x7d, 0xfd, 0xbe, 0xd9, 0x91, 0xf3, 0x3f, 0x28, 0x02, 0xd6, 0x62, 0xac,
-	0x36, 0x9e, 0x45, 0x6b, 0x49, 0x6c, 0x8b, 0xd6, 0xc2, 0xab, 0xfc, 0x66, 0x55, 0xd6, 0xf2, 0x8c,
-	0x36, 0xf4, 0xae, 0x36, 0x1f, 0x1c, 0x94, 0x67, 0xe9, 0x78, 0xdb, 0xe5, 0x8c, 0x16, 0xef, 0x69,
-	0x71, 0x17, 0xef, 0x2e, 0x59, 0xd7, 0xce, 0x3b, 0x3b, 0x7f, 0x9b, 0xf9, 0xde, 0xfb, 0xcc, 0xf7,
-	0x3e, 0x67, 0xbe, 0x77, 0x7d, 0xcc, 0x38, 0xdc, 0x4f, 0x6f, 0x06, 0x91, 0x88, 0x49, 0x28, 0x99,
-	0x48, 0xa5, 0x78, 0xd0, 0x87, 0xc3, 0xe8, 0x96, 0x64, 0x43, 0x92, 0x3e, 0xb2, 0x7c, 0x60, 0x34,
-	0xe1, 0x34, 0x01, 0x67, 0xe6, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad, 0x19, 0x88, 0x22, 0x75,
-	0x03, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe5, 0x24, 0xfd, 0x9a, 0x4c, 0xd2, 0x2e, 0xa6, 0x17, 0xcd, 0x17, 0x95, 0xe0, 0x1a,
+	0xa9, 0x44, 0x5c, 0x6c, 0x35, 0x62, 0x01, 0x08, 0xb1, 0x40, 0x48, 0x6c, 0x50, 0x91, 0x4c, 0x57,
+	0xec, 0x06, 0xfb, 0xd4, 0x1d, 0x1a, 0x5f, 0x98, 0x99, 0x5a, 0x44, 0xec, 0x78, 0x05, 0x24, 0xde,
+	0x81, 0x37, 0x61, 0x89, 0xc4, 0x0b, 0xa0, 0x8a, 0x87, 0x60, 0x89, 0x66, 0x3c, 0x93, 0xda, 0xc5,
+	0x2d, 0xdd, 0xe5, 0xfc, 0xff, 0x67, 0xfc, 0xf3, 0x99, 0x73, 0x8e, 0x83, 0xf6, 0x04, 0xf0, 0x12,
+	0x78, 0x90, 0xe5, 0x92, 0x1d, 0xb1, 0x88, 0x4a, 0x96, 0x67, 0x8d, 0xc0, 0x2f, 0x78, 0x2e, 0x73,
+	0x3c, 0xaa, 0x6b, 0xe3, 0x9d, 0x24, 0xcf, 0x93, 0x39, 0x04, 0xb4, 0x60, 0x01, 0xcd, 0xb2, 0x5c,
+	0x6a, 0x59, 0x54, 0xb9, 0xe3, 0x07, 0x27, 0x0f, 0x85, 0xcf, 0x72, 0xe5, 0xa6, 0x34, 0x3a, 0x66,
+	0x19, 0xf0, 0x45, 0x50, 0x9c, 0x24, 0x4a, 0x10, 0x41, 0x0a, 0x92, 0x06, 0xe5, 0x7e, 0x90, 0x40,
+	0x06, 0x9c, 0x4a, 0x88, 0xab, 0x53, 0xde, 0x0d, 0xb4, 0x7a, 0xc8, 0x59, 0x92, 0x00, 0xc7, 0x18,
+	0xf5, 0x32, 0x9a, 0x02, 0x71, 0xdc, 0xce, 0x74, 0x10, 0xea, 0xdf, 0xde, 0x63, 0x34, 0x34, 0xf6,
+	0x4b, 0x26, 0x24, 0xbe, 0x8b, 0x56, 0x98, 0x84, 0x54, 0x10, 0xc7, 0xed, 0x4e, 0x87, 0xb3, 0x2d,
+	0xbf, 0xf1, 0xce, 0x26, 0x33, 0xac, 0x72, 0xbc, 0x2d, 0xb4, 0x61, 0x14, 0xa1, 0x0e, 0x87, 0xf0,
+	0xfe, 0x14, 0x84, 0x54, 0xc4, 0xd7, 0xc0, 0x4b, 0x16, 0xc1, 0x65, 0x44, 0x63, 0x5f, 0x83, 0x68,
+	0x32, 0x6b, 0x44, 0xa3, 0x34, 0x88, 0x13, 0xd4, 0x3f, 0x84, 0xb4, 0x98, 0x53, 0xd9, 0x8e, 0x7c,
+	0x82, 0x46, 0xd6, 0xd7, 0xcc, 0x7b, 0x4d, 0xe6, 0xf6, 0x85, 0x2a, 0x4d, 0xaa, 0x85, 0x6e, 0xa3,
+	0x4d, 0x2b, 0x35, 0xa8, 0x5f, 0xba, 0xa8, 0xff, 0x1c, 0xe6, 0xac, 0x04, 0xbe, 0xc0, 0xeb, 0xa8,
+	0xc3, 0x62, 0x03, 0xed, 0xb0, 0x18, 0xbb, 0x68, 0x48, 0x8b, 0x62, 0x6e, 0x9e, 0x49, 0x3a, 0xae,
+	0x33, 0x1d, 0x84, 0x75, 0x09, 0x7b, 0x68, 0x44, 0x8b, 0xe2, 0x80, 0xa6, 0x20, 0x0a, 0x1a, 0x01,
+	0xe9, 0xea, 0x94, 0x86, 0x86, 0x09, 0x5a, 0x2d, 0x78, 0xfe, 0x0e, 0x22, 0x49, 0x7a, 0xda, 0xb6,
+	0xa1, 0x72, 0x64, 0x75, 0xf7, 0x64, 0xa5, 0x72, 0x4c, 0xa8, 0x1c, 0x51, 0xdd, 0x11, 0xf9, 0xaf,
+	0x72, 0x4c, 0x88, 0x77, 0xd0, 0x80, 0x43, 0xc4, 0x0a, 0x06, 0x99, 0x24, 0xab, 0xda, 0x3b, 0x17,
+	0x94, 0x2b, 0x6d, 0x99, 0xa4, 0xef, 0x76, 0x95, 0xbb, 0x14, 0xf0, 0x18, 0xf5, 0xa9, 0x54, 0xa1,
+	0x14, 0x64, 0xe0, 0x3a, 0xd3, 0x6e, 0xb8, 0x8c, 0xd5, 0x49, 0x71, 0x1a, 0x45, 0x00, 0x31, 0xc4,
+	0x04, 0xb9, 0xce, 0xb4, 0x1f, 0x9e, 0x0b, 0xea, 0x24, 0x07, 0xc9, 0x17, 0x2c, 0x4b, 0xc8, 0x50,
+	0x9b, 0xcb, 0x18, 0x6f, 0xa2, 0x15, 0xe0, 0x3c, 0xe7, 0x64, 0xa4, 0xdf, 0xa6, 0x0a, 0xf0, 0x53,
+	0xd4, 0x93, 0x2c, 0x05, 0xb2, 0xe6, 0x3a, 0xd3, 0xe1, 0xec, 0x8e, 0x5f, 0xcd, 0xbd, 0x5f, 0x9f,
+	0x7b, 0xbf, 0x38, 0x49, 0x94, 0x20, 0x7c, 0x35, 0xf7, 0x7e, 0xb9, 0xef, 0x1f, 0xb2, 0x14, 0x42,
+	0x7d, 0x4e, 0xb5, 0xdb, 0xf6, 0xe5, 0x1a, 0xed, 0xb6, 0xa9, 0xb6, 0xdd, 0x5f, 0x1d, 0xb4, 0x65,
+	0x34, 0xd6, 0x68, 0xb8, 0xba, 0x59, 0xd3, 0x1d, 0xe2, 0x54, 0x37, 0x6b, 0xc2, 0xbf, 0x7a, 0xd9,
+	0xb9, 0xba, 0x97, 0xdd, 0x4b, 0x7b, 0xd9, 0x6b, 0xf6, 0x72, 0x82, 0xd0, 0x11, 0x65, 0x73, 0x88,
+	0x5f, 0x65, 0xf3, 0x85, 0x6e, 0x74, 0x3f, 0xac, 0x29, 0xb3, 0xdf, 0x5d, 0xb4, 0x71, 0x50, 0x2b,
+	0xc6, 0xee, 0x9d, 0x44, 0x23, 0xf5, 0xe2, 0x76, 0x3b, 0xf1, 0x6e, 0xeb, 0x1e, 0xd7, 0x8b, 0x1b,
+	0xff, 0xdf, 0x9a, 0xa2, 0x32, 0xbc, 0xbd, 0x4f, 0x3f, 0x7e, 0x7d, 0xee, 0xb8, 0x78, 0xa2, 0x3f,
+	0x4c, 0xe5, 0x7e, 0xe3, 0x43, 0x26, 0x02, 0x69, 0x29, 0x86, 0x6a, 0x37, 0xf4, 0x22, 0xb5, 0x65,
+	0x73, 0x2f, 0x52, 0x6b, 0x1f, 0x86, 0x7f, 0x51, 0x85, 0xa5, 0x7c, 0x40, 0x6b, 0xba, 0xd6, 0xe5,
+	0xa8, 0x7a, 0xed, 0xeb, 0xdc, 0xe0, 0x8e, 0xdb, 0x73, 0x34, 0xf8, 0xb6, 0x06, 0xef, 0xe2, 0x9b,
+	0x97, 0x94, 0xbb, 0x04, 0x7d, 0x44, 0xeb, 0xea, 0xc0, 0xf9, 0xb0, 0xe0, 0x5b, 0xad, 0xa3, 0xc5,
+	0xae, 0x64, 0xd7, 0x47, 0xd5, 0x9b, 0x6a, 0xb6, 0x87, 0xdd, 0x76, 0x76, 0xbc, 0x7c, 0xe0, 0xb3,
+	0x17, 0xdf, 0xce, 0x26, 0xce, 0xf7, 0xb3, 0x89, 0xf3, 0xf3, 0x6c, 0xe2, 0xbc, 0x79, 0x94, 0x30,
+	0x79, 0x7c, 0xfa, 0xd6, 0x8f, 0xf2, 0x34, 0xa0, 0x3c, 0xc9, 0xd5, 0x68, 0xe9, 0x1f, 0xf7, 0xa3,
+	0x38, 0x28, 0x67, 0xf6, 0x6f, 0x22, 0x9a, 0xab, 0x55, 0x6f, 0x3c, 0xf4, 0x4f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x28, 0x72, 0x50, 0x8b, 0xab, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -493,6 +789,8 @@ type NotificationServiceClient interface {
 	ListServices(ctx context.Context, in *ServicesListRequest, opts ...grpc.CallOption) (*ServiceList, error)
 	// List returns list of templates
 	ListTemplates(ctx context.Context, in *TemplatesListRequest, opts ...grpc.CallOption) (*TemplateList, error)
+	// ListDeliveries returns list of recent notification deliveries, most recently attempted first
+	ListDeliveries(ctx context.Context, in *DeliveriesListRequest, opts ...grpc.CallOption) (*DeliveryList, error)
 }
 
 type notificationServiceClient struct {
@@ -530,6 +828,15 @@ func (c *notificationServiceClient) ListTemplates(ctx context.Context, in *Templ
 	return out, nil
 }
 
+func (c *notificationServiceClient) ListDeliveries(ctx context.Context, in *DeliveriesListRequest, opts ...grpc.CallOption) (*DeliveryList, error) {
+	out := new(DeliveryList)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/ListDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 type NotificationServiceServer interface {
 	// List returns list of triggers
@@ -538,6 +845,8 @@ type NotificationServiceServer interface {
 	ListServices(context.Context, *ServicesListRequest) (*ServiceList, error)
 	// List returns list of templates
 	ListTemplates(context.Context, *TemplatesListRequest) (*TemplateList, error)
+	// ListDeliveries returns list of recent notification deliveries, most recently attempted first
+	ListDeliveries(context.Context, *DeliveriesListRequest) (*DeliveryList, error)
 }
 
 // UnimplementedNotificationServiceServer can be embedded to have forward compatible implementations.
@@ -553,6 +862,9 @@ func (*UnimplementedNotificationServiceServer) ListServices(ctx context.Context,
 func (*UnimplementedNotificationServiceServer) ListTemplates(ctx context.Context, req *TemplatesListRequest) (*TemplateList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
+func (*UnimplementedNotificationServiceServer) ListDeliveries(ctx context.Context, req *DeliveriesListRequest) (*DeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
 
 func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
 	s.RegisterService(&_NotificationService_serviceDesc, srv)
@@ -612,6 +924,24 @@ func _NotificationService_ListTemplates_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveriesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/ListDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListDeliveries(ctx, req.(*DeliveriesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "notification.NotificationService",
 	HandlerType: (*NotificationServiceServer)(nil),
@@ -628,6 +958,10 @@ var _NotificationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTemplates",
 			Handler:    _NotificationService_ListTemplates_Handler,
 		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _NotificationService_ListDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/notification/notification.proto",
@@ -945,75 +1279,312 @@ func (m *TemplatesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNotification(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNotification(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Delivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Trigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovNotification(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *Delivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TriggerList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Delivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovNotification(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNotification(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *TriggersListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Retrying != nil {
+		i--
+		if *m.Retrying {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Succeeded != nil {
+		i--
+		if *m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	return n
-}
-
-func (m *Service) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Attempts != nil {
+		i = encodeVarintNotification(dAtA, i, uint64(*m.Attempts))
+		i--
+		dAtA[i] = 0x48
 	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovNotification(uint64(l))
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Templates[iNdEx])
+			copy(dAtA[i:], m.Templates[iNdEx])
+			i = encodeVarintNotification(dAtA, i, uint64(len(m.Templates[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Recipient != nil {
+		i -= len(*m.Recipient)
+		copy(dAtA[i:], *m.Recipient)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Recipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Service != nil {
+		i -= len(*m.Service)
+		copy(dAtA[i:], *m.Service)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Service)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Trigger != nil {
+		i -= len(*m.Trigger)
+		copy(dAtA[i:], *m.Trigger)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Trigger)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Application != nil {
+		i -= len(*m.Application)
+		copy(dAtA[i:], *m.Application)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Application)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNotification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveriesListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveriesListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveriesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FailedOnly != nil {
+		i--
+		if *m.FailedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Trigger != nil {
+		i -= len(*m.Trigger)
+		copy(dAtA[i:], *m.Trigger)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Trigger)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppName != nil {
+		i -= len(*m.AppName)
+		copy(dAtA[i:], *m.AppName)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNotification(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNotification(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovNotification(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggersListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Service) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
@@ -1094,6 +1665,118 @@ func (m *TemplatesListRequest) Size() (n int) {
 	return n
 }
 
+func (m *Delivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Application != nil {
+		l = len(*m.Application)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Trigger != nil {
+		l = len(*m.Trigger)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Service != nil {
+		l = len(*m.Service)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Recipient != nil {
+		l = len(*m.Recipient)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if len(m.Templates) > 0 {
+		for _, s := range m.Templates {
+			l = len(s)
+			n += 1 + l + sovNotification(uint64(l))
+		}
+	}
+	if m.Attempts != nil {
+		n += 1 + sovNotification(uint64(*m.Attempts))
+	}
+	if m.Succeeded != nil {
+		n += 2
+	}
+	if m.Retrying != nil {
+		n += 2
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeliveryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovNotification(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeliveriesListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppName != nil {
+		l = len(*m.AppName)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Trigger != nil {
+		l = len(*m.Trigger)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.FailedOnly != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovNotification(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1775,6 +2458,745 @@ func (m *TemplatesListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Delivery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Id = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Application = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Trigger = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Service = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Recipient = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Attempts = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Succeeded = &b
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrying", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Retrying = &b
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Error = &s
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &v1.Time{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Delivery{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeliveriesListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliveriesListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliveriesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppName = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Trigger = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailedOnly = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNotification(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -278,7 +278,9 @@ type RepoQuery struct {
 	// Repo URL for query
 	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	// Whether to force a cache refresh on repo's connection state
-	ForceRefresh         bool     `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`
+	ForceRefresh bool `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`
+	// The external IDs to restrict returned list repositories
+	ExternalIDs          []string `protobuf:"bytes,3,rep,name=externalIDs,proto3" json:"externalIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -331,6 +333,71 @@ func (m *RepoQuery) GetForceRefresh() bool {
 	return false
 }
 
+func (m *RepoQuery) GetExternalIDs() []string {
+	if m != nil {
+		return m.ExternalIDs
+	}
+	return nil
+}
+
+// RepoByIDQuery is a query for a repository by its external ID
+type RepoByIDQuery struct {
+	// The external ID of the repository
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether to force a cache refresh on repo's connection state
+	ForceRefresh         bool     `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoByIDQuery) Reset()         { *m = RepoByIDQuery{} }
+func (m *RepoByIDQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoByIDQuery) ProtoMessage()    {}
+func (*RepoByIDQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{5}
+}
+func (m *RepoByIDQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoByIDQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoByIDQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoByIDQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoByIDQuery.Merge(m, src)
+}
+func (m *RepoByIDQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoByIDQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoByIDQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoByIDQuery proto.InternalMessageInfo
+
+func (m *RepoByIDQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RepoByIDQuery) GetForceRefresh() bool {
+	if m != nil {
+		return m.ForceRefresh
+	}
+	return false
+}
+
 // RepoAccessQuery is a query for checking access to a repo
 type RepoAccessQuery struct {
 	// The URL to the repo
@@ -368,7 +435,11 @@ type RepoAccessQuery struct {
 	// Google Cloud Platform service account key
 	GcpServiceAccountKey string `protobuf:"bytes,18,opt,name=gcpServiceAccountKey,proto3" json:"gcpServiceAccountKey,omitempty"`
 	// Whether to force HTTP basic auth
-	ForceHttpBasicAuth   bool     `protobuf:"varint,19,opt,name=forceHttpBasicAuth,proto3" json:"forceHttpBasicAuth,omitempty"`
+	ForceHttpBasicAuth bool `protobuf:"varint,19,opt,name=forceHttpBasicAuth,proto3" json:"forceHttpBasicAuth,omitempty"`
+	// CA certificates used to verify the certificate of the HTTPS repository
+	TlsCACertData string `protobuf:"bytes,20,opt,name=tlsCACertData,proto3" json:"tlsCACertData,omitempty"`
+	// Server name sent with SNI and used to verify the certificate of the HTTPS repository
+	TlsServerName        string   `protobuf:"bytes,21,opt,name=tlsServerName,proto3" json:"tlsServerName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -378,7 +449,7 @@ func (m *RepoAccessQuery) Reset()         { *m = RepoAccessQuery{} }
 func (m *RepoAccessQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAccessQuery) ProtoMessage()    {}
 func (*RepoAccessQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{5}
+	return fileDescriptor_8d38260443475705, []int{6}
 }
 func (m *RepoAccessQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,6 +604,20 @@ func (m *RepoAccessQuery) GetForceHttpBasicAuth() bool {
 	return false
 }
 
+func (m *RepoAccessQuery) GetTlsCACertData() string {
+	if m != nil {
+		return m.TlsCACertData
+	}
+	return ""
+}
+
+func (m *RepoAccessQuery) GetTlsServerName() string {
+	if m != nil {
+		return m.TlsServerName
+	}
+	return ""
+}
+
 type RepoResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -543,7 +628,7 @@ func (m *RepoResponse) Reset()         { *m = RepoResponse{} }
 func (m *RepoResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()    {}
 func (*RepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{6}
+	return fileDescriptor_8d38260443475705, []int{7}
 }
 func (m *RepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +674,7 @@ func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{7}
+	return fileDescriptor_8d38260443475705, []int{8}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,6 +724,176 @@ func (m *RepoCreateRequest) GetCredsOnly() bool {
 	return false
 }
 
+// RepoCredsResolveQuery is a query for the credential template used for a repository URL
+type RepoCredsResolveQuery struct {
+	// Repo URL to resolve credentials for
+	Repo                 string   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoCredsResolveQuery) Reset()         { *m = RepoCredsResolveQuery{} }
+func (m *RepoCredsResolveQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoCredsResolveQuery) ProtoMessage()    {}
+func (*RepoCredsResolveQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{9}
+}
+func (m *RepoCredsResolveQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoCredsResolveQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoCredsResolveQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoCredsResolveQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoCredsResolveQuery.Merge(m, src)
+}
+func (m *RepoCredsResolveQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoCredsResolveQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoCredsResolveQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoCredsResolveQuery proto.InternalMessageInfo
+
+func (m *RepoCredsResolveQuery) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+// RepoCredsResolveResponse describes the credential template matching a repository URL, without any secret data
+type RepoCredsResolveResponse struct {
+	// Whether a credential template matches the repository URL
+	Matched bool `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	// URL or URL regular expression of the matching credential template
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Whether the template URL is a regular expression
+	UrlRegex bool `protobuf:"varint,3,opt,name=urlRegex,proto3" json:"urlRegex,omitempty"`
+	// Priority of the matching credential template
+	Priority int64 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Type of the credentials
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Whether the template forces Git LFS on
+	EnableLfs bool `protobuf:"varint,6,opt,name=enableLfs,proto3" json:"enableLfs,omitempty"`
+	// Whether the template proxy overrides the repository's proxy
+	ForceProxy bool `protobuf:"varint,7,opt,name=forceProxy,proto3" json:"forceProxy,omitempty"`
+	// HTTP/HTTPS proxy configured in the template
+	Proxy string `protobuf:"bytes,8,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// Whether the template provides a CA certificate
+	HasTlsCACertData     bool     `protobuf:"varint,9,opt,name=hasTlsCACertData,proto3" json:"hasTlsCACertData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoCredsResolveResponse) Reset()         { *m = RepoCredsResolveResponse{} }
+func (m *RepoCredsResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*RepoCredsResolveResponse) ProtoMessage()    {}
+func (*RepoCredsResolveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{10}
+}
+func (m *RepoCredsResolveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoCredsResolveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoCredsResolveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoCredsResolveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoCredsResolveResponse.Merge(m, src)
+}
+func (m *RepoCredsResolveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoCredsResolveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoCredsResolveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoCredsResolveResponse proto.InternalMessageInfo
+
+func (m *RepoCredsResolveResponse) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+func (m *RepoCredsResolveResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *RepoCredsResolveResponse) GetUrlRegex() bool {
+	if m != nil {
+		return m.UrlRegex
+	}
+	return false
+}
+
+func (m *RepoCredsResolveResponse) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *RepoCredsResolveResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RepoCredsResolveResponse) GetEnableLfs() bool {
+	if m != nil {
+		return m.EnableLfs
+	}
+	return false
+}
+
+func (m *RepoCredsResolveResponse) GetForceProxy() bool {
+	if m != nil {
+		return m.ForceProxy
+	}
+	return false
+}
+
+func (m *RepoCredsResolveResponse) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
+func (m *RepoCredsResolveResponse) GetHasTlsCACertData() bool {
+	if m != nil {
+		return m.HasTlsCACertData
+	}
+	return false
+}
+
 type RepoUpdateRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -650,7 +905,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{8}
+	return fileDescriptor_8d38260443475705, []int{11}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,9 +947,12 @@ func init() {
 	proto.RegisterType((*RepoAppDetailsQuery)(nil), "repository.RepoAppDetailsQuery")
 	proto.RegisterType((*RepoAppsResponse)(nil), "repository.RepoAppsResponse")
 	proto.RegisterType((*RepoQuery)(nil), "repository.RepoQuery")
+	proto.RegisterType((*RepoByIDQuery)(nil), "repository.RepoByIDQuery")
 	proto.RegisterType((*RepoAccessQuery)(nil), "repository.RepoAccessQuery")
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
+	proto.RegisterType((*RepoCredsResolveQuery)(nil), "repository.RepoCredsResolveQuery")
+	proto.RegisterType((*RepoCredsResolveResponse)(nil), "repository.RepoCredsResolveResponse")
 	proto.RegisterType((*RepoUpdateRequest)(nil), "repository.RepoUpdateRequest")
 }
 
@@ -703,79 +961,97 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1146 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xd7, 0x25, 0x8d, 0x9b, 0x4c, 0x9a, 0xd4, 0xd9, 0x84, 0x72, 0xb8, 0x69, 0x1a, 0x5d, 0x4b,
-	0x15, 0xa2, 0x72, 0xd7, 0x18, 0x21, 0x50, 0x11, 0x48, 0xce, 0x1f, 0x35, 0x11, 0x11, 0x29, 0x57,
-	0x85, 0x07, 0x04, 0x42, 0x9b, 0xf3, 0xc4, 0xbe, 0xf6, 0x7c, 0xb7, 0xdd, 0x5d, 0x1b, 0xac, 0xaa,
-	0x2f, 0x3c, 0x21, 0xc1, 0x0b, 0x42, 0x48, 0xbc, 0x21, 0x24, 0x24, 0x1e, 0xf8, 0x02, 0x7c, 0x04,
-	0x1e, 0x91, 0xf8, 0x02, 0x28, 0xe2, 0x73, 0x20, 0xb4, 0xbb, 0xe7, 0xbb, 0x73, 0x62, 0x3b, 0xa9,
-	0x08, 0x79, 0xdb, 0xf9, 0xcd, 0xdc, 0xcc, 0x6f, 0x7f, 0x3b, 0x3b, 0x6b, 0x83, 0x23, 0x90, 0x77,
-	0x90, 0x7b, 0x1c, 0x59, 0x22, 0x42, 0x99, 0xf0, 0x6e, 0x61, 0xe9, 0x32, 0x9e, 0xc8, 0x84, 0x40,
-	0x8e, 0x54, 0x16, 0x1b, 0x49, 0xd2, 0x88, 0xd0, 0xa3, 0x2c, 0xf4, 0x68, 0x1c, 0x27, 0x92, 0xca,
-	0x30, 0x89, 0x85, 0x89, 0xac, 0xec, 0x36, 0x42, 0xd9, 0x6c, 0x1f, 0xb8, 0x41, 0xd2, 0xf2, 0x28,
-	0x6f, 0x24, 0x8c, 0x27, 0x8f, 0xf5, 0xe2, 0xf5, 0xa0, 0xee, 0x75, 0xaa, 0x1e, 0x7b, 0xd2, 0x50,
-	0x5f, 0x0a, 0x8f, 0x32, 0x16, 0x85, 0x81, 0xfe, 0xd6, 0xeb, 0xac, 0xd1, 0x88, 0x35, 0xe9, 0x9a,
-	0xd7, 0xc0, 0x18, 0x39, 0x95, 0x58, 0x4f, 0xb3, 0x6d, 0x9d, 0x92, 0x4d, 0xd3, 0x3a, 0x95, 0xbe,
-	0xd3, 0x85, 0x19, 0x1f, 0x59, 0x52, 0x63, 0x4c, 0x7c, 0xd8, 0x46, 0xde, 0x25, 0x04, 0x2e, 0xa9,
-	0x20, 0xdb, 0x5a, 0xb6, 0x56, 0xa6, 0x7c, 0xbd, 0x26, 0x15, 0x98, 0xe4, 0xd8, 0x09, 0x45, 0x98,
-	0xc4, 0xf6, 0x98, 0xc6, 0x33, 0x9b, 0xd8, 0x70, 0x99, 0x32, 0xf6, 0x01, 0x6d, 0xa1, 0x3d, 0xae,
-	0x5d, 0x3d, 0x93, 0x2c, 0x01, 0x50, 0xc6, 0x1e, 0xf2, 0xe4, 0x31, 0x06, 0xd2, 0xbe, 0xa4, 0x9d,
-	0x05, 0xc4, 0x59, 0x83, 0xcb, 0x35, 0xc6, 0x76, 0xe2, 0xc3, 0x44, 0x15, 0x95, 0x5d, 0x86, 0xbd,
-	0xa2, 0x6a, 0xad, 0x30, 0x46, 0x65, 0x33, 0x2d, 0xa8, 0xd7, 0xce, 0x6f, 0x16, 0xcc, 0xa7, 0x74,
-	0x37, 0x51, 0xd2, 0x30, 0x4a, 0x49, 0x37, 0xa0, 0x24, 0x92, 0x36, 0x0f, 0x4c, 0x86, 0xe9, 0xea,
-	0x9e, 0x9b, 0xab, 0xe3, 0xf6, 0xd4, 0xd1, 0x8b, 0xcf, 0x82, 0xba, 0xdb, 0xa9, 0xba, 0xec, 0x49,
-	0xc3, 0x55, 0x5a, 0xbb, 0x05, 0xad, 0xdd, 0x9e, 0xd6, 0x6e, 0x2d, 0x07, 0x1f, 0xe9, 0xb4, 0x7e,
-	0x9a, 0xbe, 0xb8, 0xdb, 0xb1, 0x51, 0xbb, 0x1d, 0x3f, 0xb1, 0xdb, 0x77, 0xa1, 0xdc, 0x13, 0xda,
-	0x47, 0xc1, 0x92, 0x58, 0x20, 0x79, 0x0d, 0x26, 0x42, 0x89, 0x2d, 0x61, 0x5b, 0xcb, 0xe3, 0x2b,
-	0xd3, 0xd5, 0x79, 0xb7, 0x70, 0x3c, 0xa9, 0x34, 0xbe, 0x89, 0x70, 0x36, 0x60, 0x4a, 0x7d, 0x3e,
-	0xfc, 0x8c, 0x1c, 0xb8, 0x72, 0x98, 0x28, 0xaa, 0x78, 0xc8, 0x51, 0x18, 0xd9, 0x26, 0xfd, 0x3e,
-	0xcc, 0xf9, 0x69, 0x02, 0xae, 0x6a, 0x12, 0x41, 0x80, 0x62, 0xf4, 0x79, 0xb7, 0x05, 0xf2, 0x38,
-	0xdf, 0x66, 0x66, 0x2b, 0x1f, 0xa3, 0x42, 0x7c, 0x9e, 0xf0, 0x7a, 0xba, 0xcb, 0xcc, 0x26, 0xb7,
-	0x61, 0x46, 0x88, 0xe6, 0x43, 0x1e, 0x76, 0xa8, 0xc4, 0xf7, 0xb1, 0x9b, 0x1e, 0x7a, 0x3f, 0xa8,
-	0x32, 0x84, 0xb1, 0xc0, 0xa0, 0xcd, 0xd1, 0x9e, 0xd0, 0x2c, 0x33, 0x9b, 0xdc, 0x85, 0x39, 0x19,
-	0x89, 0x8d, 0x28, 0xc4, 0x58, 0x6e, 0x20, 0x97, 0x9b, 0x54, 0x52, 0xbb, 0xa4, 0xb3, 0x9c, 0x74,
-	0x90, 0x55, 0x28, 0xf7, 0x81, 0xaa, 0xe4, 0x65, 0x1d, 0x7c, 0x02, 0xcf, 0x5a, 0x6c, 0xaa, 0xbf,
-	0xc5, 0xf4, 0x1e, 0xc1, 0x60, 0x7a, 0x7f, 0x8b, 0x30, 0x85, 0x31, 0x3d, 0x88, 0x70, 0x2f, 0x08,
-	0xed, 0x69, 0x4d, 0x2f, 0x07, 0xc8, 0x3d, 0x98, 0x37, 0x9d, 0x55, 0x53, 0x27, 0x9b, 0xed, 0xf3,
-	0x8a, 0x4e, 0x30, 0xc8, 0x45, 0x96, 0x61, 0x3a, 0x83, 0x77, 0x36, 0xed, 0x99, 0x65, 0x6b, 0x65,
-	0xdc, 0x2f, 0x42, 0xe4, 0x6d, 0x78, 0x39, 0x37, 0x63, 0x21, 0x69, 0x14, 0xe9, 0xd6, 0xdb, 0xd9,
-	0xb4, 0x67, 0x75, 0xf4, 0x30, 0x37, 0x79, 0x0f, 0x2a, 0x99, 0x6b, 0x2b, 0x96, 0xc8, 0x19, 0x0f,
-	0x05, 0xae, 0x53, 0x81, 0xfb, 0x3c, 0xb2, 0xaf, 0x6a, 0x52, 0x23, 0x22, 0xc8, 0x02, 0x4c, 0x30,
-	0x9e, 0x7c, 0xd1, 0xb5, 0xcb, 0x3a, 0xd4, 0x18, 0xaa, 0xc7, 0x59, 0xda, 0xc6, 0x73, 0xa6, 0xc7,
-	0x53, 0x93, 0x54, 0x61, 0xa1, 0x11, 0xb0, 0x47, 0xc8, 0x3b, 0x61, 0x80, 0xb5, 0x20, 0x48, 0xda,
-	0xb1, 0xd6, 0x9c, 0xe8, 0xb0, 0x81, 0x3e, 0xe2, 0x02, 0xd1, 0x3d, 0xb8, 0x2d, 0x25, 0x5b, 0xa7,
-	0x22, 0x0c, 0x6a, 0x6d, 0xd9, 0xb4, 0xe7, 0xb5, 0xb0, 0x03, 0x3c, 0xce, 0x2c, 0x5c, 0x51, 0x2d,
-	0xda, 0xbb, 0x23, 0xce, 0x2f, 0x16, 0xcc, 0x29, 0x60, 0x83, 0x23, 0x95, 0xe8, 0xe3, 0xd3, 0x36,
-	0x0a, 0x49, 0x3e, 0x29, 0x74, 0xed, 0x74, 0x75, 0xfb, 0xbf, 0x5d, 0x77, 0x3f, 0xbb, 0x75, 0x69,
-	0xff, 0x5f, 0x83, 0x52, 0x9b, 0x09, 0xe4, 0x32, 0xbd, 0x45, 0xa9, 0xa5, 0x7a, 0x23, 0xe0, 0x58,
-	0x17, 0x7b, 0x71, 0xd4, 0xd5, 0xcd, 0x3f, 0xe9, 0xe7, 0x80, 0xf3, 0xd4, 0x10, 0xdd, 0x67, 0xf5,
-	0x8b, 0x22, 0x5a, 0xfd, 0x67, 0xd6, 0xd4, 0x34, 0x60, 0x2a, 0x3e, 0xf9, 0xc6, 0x82, 0x4b, 0xbb,
-	0xa1, 0x90, 0xe4, 0xa5, 0xe2, 0x40, 0xc9, 0xc6, 0x47, 0x65, 0xf7, 0xbc, 0x58, 0xa8, 0x22, 0xce,
-	0xcd, 0x2f, 0xff, 0xfc, 0xfb, 0xbb, 0xb1, 0x6b, 0x64, 0x41, 0x3f, 0x7b, 0x9d, 0xb5, 0xfc, 0x8d,
-	0x09, 0x51, 0x7c, 0x35, 0x66, 0x91, 0xaf, 0x2d, 0x18, 0x7f, 0x80, 0x43, 0xd9, 0x9c, 0x9b, 0x26,
-	0xce, 0x2d, 0xcd, 0xe4, 0x06, 0xb9, 0x3e, 0x88, 0x89, 0xf7, 0x4c, 0x59, 0xcf, 0xc9, 0xf7, 0x16,
-	0x94, 0x15, 0x6f, 0xbf, 0xe0, 0xbb, 0x18, 0xa1, 0x16, 0x47, 0x09, 0x45, 0x3e, 0x85, 0x49, 0x43,
-	0xeb, 0x70, 0x28, 0x9d, 0x72, 0x3f, 0x7c, 0x28, 0x9c, 0x15, 0x9d, 0xd2, 0x21, 0xcb, 0x23, 0x76,
-	0xec, 0x71, 0x95, 0xb2, 0x65, 0xd2, 0xab, 0xe7, 0x87, 0xbc, 0x72, 0x3c, 0x7d, 0xf6, 0xfa, 0x57,
-	0x16, 0x07, 0xb9, 0xb2, 0xbb, 0x78, 0xa6, 0x72, 0x54, 0x95, 0xf8, 0xd6, 0x82, 0x99, 0x07, 0x28,
-	0xf3, 0x77, 0x9a, 0xdc, 0x1c, 0x90, 0xb9, 0xf8, 0x86, 0x57, 0x9c, 0xe1, 0x01, 0x19, 0x81, 0x77,
-	0x34, 0x81, 0x37, 0x9d, 0x7b, 0x83, 0x09, 0x98, 0x47, 0x5a, 0xe7, 0xd9, 0xf7, 0x77, 0x35, 0x95,
-	0xba, 0xc9, 0x70, 0xdf, 0x5a, 0x25, 0x1d, 0x4d, 0x69, 0x1b, 0xa3, 0xd6, 0x46, 0x93, 0x72, 0x39,
-	0x54, 0xe6, 0xa5, 0x22, 0x9c, 0x87, 0x67, 0x24, 0x5c, 0x4d, 0x62, 0x85, 0xdc, 0x19, 0xa5, 0x42,
-	0x13, 0xa3, 0x56, 0x60, 0xca, 0xfc, 0x60, 0x41, 0xc9, 0x4c, 0x2f, 0x72, 0xe3, 0x78, 0xc5, 0xbe,
-	0xa9, 0x76, 0x8e, 0x57, 0xe1, 0x55, 0xcd, 0x71, 0xd1, 0x19, 0xd8, 0x6b, 0xf7, 0xf5, 0xf0, 0x50,
-	0x57, 0xf3, 0x47, 0x0b, 0xca, 0x3d, 0x0a, 0xbd, 0x6f, 0x2f, 0x8e, 0xa4, 0x73, 0x3a, 0x49, 0xf2,
-	0xb3, 0x05, 0x25, 0x33, 0x51, 0x4f, 0xf2, 0xea, 0x9b, 0xb4, 0xe7, 0xc8, 0x6b, 0xcd, 0x1c, 0x70,
-	0x65, 0x44, 0x9b, 0x6b, 0x2a, 0xcf, 0x73, 0x21, 0x7f, 0xb5, 0xa0, 0xdc, 0xa3, 0x33, 0x5c, 0xc8,
-	0xff, 0x8b, 0xb0, 0xfb, 0x62, 0x84, 0x09, 0x85, 0xd2, 0x26, 0x46, 0x28, 0x71, 0xd8, 0x15, 0xb0,
-	0x8f, 0xc3, 0x59, 0xf3, 0xdf, 0x31, 0x33, 0x76, 0x75, 0xd4, 0x8c, 0x55, 0x82, 0x34, 0xa1, 0x6c,
-	0x4a, 0x14, 0xf4, 0x78, 0xe1, 0x62, 0xb7, 0xce, 0x50, 0x8c, 0x3c, 0x83, 0xd9, 0x8f, 0x68, 0x14,
-	0x2a, 0x65, 0xcd, 0xef, 0x5a, 0x72, 0xfd, 0xc4, 0x24, 0xc9, 0x7f, 0xef, 0x8e, 0xa8, 0x56, 0xd5,
-	0xd5, 0xee, 0x3a, 0xb7, 0x47, 0xdd, 0xeb, 0x4e, 0x5a, 0xca, 0x28, 0xb9, 0xbe, 0xf5, 0xfb, 0xd1,
-	0x92, 0xf5, 0xc7, 0xd1, 0x92, 0xf5, 0xd7, 0xd1, 0x92, 0xf5, 0xf1, 0x5b, 0x67, 0xfb, 0x87, 0x17,
-	0xe8, 0x1f, 0xa6, 0x85, 0xff, 0x62, 0x07, 0x25, 0xfd, 0x67, 0xec, 0x8d, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x52, 0xa9, 0xe9, 0x17, 0x71, 0x0e, 0x00, 0x00,
+	// 1436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xd7, 0xc6, 0x8d, 0x93, 0x4c, 0x9a, 0xd4, 0x9d, 0xa4, 0x65, 0xeb, 0xa6, 0x69, 0xba, 0x2d,
+	0x6d, 0x1a, 0x9a, 0x75, 0xe3, 0x0a, 0x81, 0x8a, 0x40, 0x72, 0xe2, 0x2a, 0x89, 0x88, 0x68, 0xd9,
+	0x52, 0x0e, 0x08, 0x84, 0x26, 0xeb, 0x67, 0x7b, 0xda, 0xf5, 0xee, 0x74, 0x66, 0x6c, 0x6a, 0x55,
+	0xbd, 0x70, 0x42, 0x02, 0x21, 0xf1, 0x4f, 0x70, 0xe3, 0x82, 0xc4, 0x81, 0x2f, 0xc0, 0x91, 0x23,
+	0x47, 0x24, 0xbe, 0x00, 0xaa, 0xb8, 0xf2, 0x1d, 0xd0, 0xcc, 0xac, 0xd7, 0xeb, 0xd8, 0xde, 0xa6,
+	0x22, 0xf4, 0x36, 0xef, 0x37, 0x6f, 0xdf, 0xfb, 0xbd, 0x7f, 0x33, 0x63, 0x23, 0x47, 0x00, 0xef,
+	0x00, 0x2f, 0x71, 0x60, 0x91, 0xa0, 0x32, 0xe2, 0xdd, 0xd4, 0xd2, 0x65, 0x3c, 0x92, 0x11, 0x46,
+	0x7d, 0xa4, 0xb8, 0xd4, 0x88, 0xa2, 0x46, 0x00, 0x25, 0xc2, 0x68, 0x89, 0x84, 0x61, 0x24, 0x89,
+	0xa4, 0x51, 0x28, 0x8c, 0x66, 0x71, 0xaf, 0x41, 0x65, 0xb3, 0xbd, 0xef, 0xfa, 0x51, 0xab, 0x44,
+	0x78, 0x23, 0x62, 0x3c, 0xba, 0xaf, 0x17, 0xeb, 0x7e, 0xad, 0xd4, 0x29, 0x97, 0xd8, 0x83, 0x86,
+	0xfa, 0x52, 0x94, 0x08, 0x63, 0x01, 0xf5, 0xf5, 0xb7, 0xa5, 0xce, 0x06, 0x09, 0x58, 0x93, 0x6c,
+	0x94, 0x1a, 0x10, 0x02, 0x27, 0x12, 0x6a, 0xb1, 0xb5, 0x5b, 0xcf, 0xb0, 0xa6, 0x69, 0x3d, 0x93,
+	0xbe, 0xd3, 0x45, 0x73, 0x1e, 0xb0, 0xa8, 0xc2, 0x98, 0x78, 0xb7, 0x0d, 0xbc, 0x8b, 0x31, 0x3a,
+	0xa6, 0x94, 0x6c, 0x6b, 0xc5, 0x5a, 0x9d, 0xf1, 0xf4, 0x1a, 0x17, 0xd1, 0x34, 0x87, 0x0e, 0x15,
+	0x34, 0x0a, 0xed, 0x09, 0x8d, 0x27, 0x32, 0xb6, 0xd1, 0x14, 0x61, 0xec, 0x1d, 0xd2, 0x02, 0x3b,
+	0xa7, 0xb7, 0x7a, 0x22, 0x5e, 0x46, 0x88, 0x30, 0x76, 0x87, 0x47, 0xf7, 0xc1, 0x97, 0xf6, 0x31,
+	0xbd, 0x99, 0x42, 0x9c, 0x0d, 0x34, 0x55, 0x61, 0x6c, 0x37, 0xac, 0x47, 0xca, 0xa9, 0xec, 0x32,
+	0xe8, 0x39, 0x55, 0x6b, 0x85, 0x31, 0x22, 0x9b, 0xb1, 0x43, 0xbd, 0x76, 0x7e, 0xb5, 0xd0, 0x42,
+	0x4c, 0xb7, 0x0a, 0x92, 0xd0, 0x20, 0x26, 0xdd, 0x40, 0x79, 0x11, 0xb5, 0xb9, 0x6f, 0x2c, 0xcc,
+	0x96, 0x6f, 0xbb, 0xfd, 0xec, 0xb8, 0xbd, 0xec, 0xe8, 0xc5, 0xc7, 0x7e, 0xcd, 0xed, 0x94, 0x5d,
+	0xf6, 0xa0, 0xe1, 0xaa, 0x5c, 0xbb, 0xa9, 0x5c, 0xbb, 0xbd, 0x5c, 0xbb, 0x95, 0x3e, 0x78, 0x57,
+	0x9b, 0xf5, 0x62, 0xf3, 0xe9, 0x68, 0x27, 0xb2, 0xa2, 0xcd, 0x0d, 0x45, 0xfb, 0x26, 0x2a, 0xf4,
+	0x12, 0xed, 0x81, 0x60, 0x51, 0x28, 0x00, 0x5f, 0x45, 0x93, 0x54, 0x42, 0x4b, 0xd8, 0xd6, 0x4a,
+	0x6e, 0x75, 0xb6, 0xbc, 0xe0, 0xa6, 0xca, 0x13, 0xa7, 0xc6, 0x33, 0x1a, 0x0e, 0xa0, 0x19, 0xf5,
+	0xf9, 0xf8, 0x1a, 0x39, 0xe8, 0x78, 0x3d, 0x52, 0x54, 0xa1, 0xce, 0x41, 0x98, 0xb4, 0x4d, 0x7b,
+	0x03, 0x18, 0x5e, 0x41, 0xb3, 0xf0, 0x48, 0x02, 0x0f, 0x49, 0xb0, 0x5b, 0x15, 0x76, 0x6e, 0x25,
+	0xb7, 0x3a, 0xe3, 0xa5, 0x21, 0x67, 0xcb, 0xb4, 0xc3, 0x66, 0x77, 0xb7, 0x6a, 0x5c, 0xcd, 0xa3,
+	0x09, 0x5a, 0x8b, 0x1d, 0x4d, 0xd0, 0xda, 0x61, 0xdc, 0x38, 0xff, 0x4c, 0xa2, 0x13, 0x3a, 0x56,
+	0xdf, 0x07, 0x91, 0xdd, 0x56, 0x6d, 0xa1, 0x5c, 0x27, 0xd9, 0x4c, 0x64, 0xb5, 0xc7, 0x88, 0x10,
+	0x9f, 0x44, 0xbc, 0x16, 0x27, 0x33, 0x91, 0xf1, 0x25, 0x34, 0x27, 0x44, 0xf3, 0x0e, 0xa7, 0x1d,
+	0x22, 0xe1, 0x6d, 0xe8, 0xc6, 0xbd, 0x35, 0x08, 0x2a, 0x0b, 0x34, 0x14, 0xe0, 0xb7, 0x39, 0xd8,
+	0x93, 0x9a, 0x65, 0x22, 0xe3, 0x6b, 0xe8, 0xa4, 0x0c, 0xc4, 0x56, 0x40, 0x21, 0x94, 0x5b, 0xc0,
+	0x65, 0x95, 0x48, 0x62, 0xe7, 0xb5, 0x95, 0xe1, 0x0d, 0xbc, 0x86, 0x0a, 0x03, 0xa0, 0x72, 0x39,
+	0xa5, 0x95, 0x87, 0xf0, 0xa4, 0x93, 0x67, 0x06, 0x3b, 0x59, 0xc7, 0x88, 0x0c, 0xa6, 0xe3, 0x5b,
+	0x42, 0x33, 0x10, 0x92, 0xfd, 0x00, 0x6e, 0xfb, 0xd4, 0x9e, 0xd5, 0xf4, 0xfa, 0x00, 0xbe, 0x8e,
+	0x16, 0x4c, 0x03, 0x57, 0x54, 0x03, 0x25, 0x71, 0x1e, 0xd7, 0x06, 0x46, 0x6d, 0xa9, 0xd2, 0x26,
+	0xf0, 0x6e, 0xd5, 0x9e, 0x5b, 0xb1, 0x56, 0x73, 0x5e, 0x1a, 0xc2, 0xaf, 0xa3, 0x97, 0xfa, 0x62,
+	0x28, 0x24, 0x09, 0x02, 0xdd, 0xe1, 0xbb, 0x55, 0x7b, 0x5e, 0x6b, 0x8f, 0xdb, 0xc6, 0x6f, 0xa1,
+	0x62, 0xb2, 0x75, 0x2b, 0x94, 0xc0, 0x19, 0xa7, 0x02, 0x36, 0x89, 0x80, 0x7b, 0x3c, 0xb0, 0x4f,
+	0x68, 0x52, 0x19, 0x1a, 0x78, 0x11, 0x4d, 0x32, 0x1e, 0x3d, 0xea, 0xda, 0x05, 0xad, 0x6a, 0x04,
+	0x35, 0x4a, 0x2c, 0x9e, 0x96, 0x93, 0x66, 0x94, 0x62, 0x11, 0x97, 0xd1, 0x62, 0xc3, 0x67, 0x77,
+	0x81, 0x77, 0xa8, 0x0f, 0x15, 0xdf, 0x8f, 0xda, 0xa1, 0xce, 0x39, 0xd6, 0x6a, 0x23, 0xf7, 0xb0,
+	0x8b, 0xb0, 0xee, 0xc1, 0x1d, 0x29, 0xd9, 0x26, 0x11, 0xd4, 0xaf, 0xb4, 0x65, 0xd3, 0x5e, 0xd0,
+	0x89, 0x1d, 0xb1, 0xa3, 0x7a, 0x48, 0xd5, 0xae, 0x92, 0x54, 0x7f, 0xd1, 0xf4, 0xd0, 0x00, 0x18,
+	0x6b, 0xdd, 0xd5, 0xc7, 0xa8, 0x1e, 0xfa, 0x53, 0x89, 0x56, 0x1f, 0x74, 0xe6, 0xd1, 0x71, 0xd5,
+	0xee, 0xbd, 0xb1, 0x76, 0x7e, 0xb6, 0xd0, 0x49, 0x05, 0x6c, 0x71, 0x20, 0x12, 0x3c, 0x78, 0xd8,
+	0x06, 0x21, 0xf1, 0x87, 0xa9, 0x09, 0x98, 0x2d, 0xef, 0xfc, 0xb7, 0x13, 0xca, 0x4b, 0x0e, 0x8a,
+	0x78, 0x96, 0x4e, 0xa3, 0x7c, 0x9b, 0x09, 0xe0, 0x32, 0x9e, 0xc8, 0x58, 0x52, 0x7d, 0xe6, 0x73,
+	0xa8, 0x89, 0xdb, 0x61, 0xd0, 0xd5, 0x83, 0x34, 0xed, 0xf5, 0x01, 0xe7, 0x15, 0x74, 0x2a, 0x26,
+	0x5a, 0x53, 0xa7, 0x52, 0x14, 0x74, 0x60, 0xec, 0xb8, 0x3a, 0x5f, 0x4f, 0x20, 0xfb, 0xa0, 0x76,
+	0x72, 0x94, 0xd9, 0x68, 0xaa, 0x45, 0xa4, 0xdf, 0x04, 0x73, 0x58, 0x4c, 0x7b, 0x3d, 0x11, 0x17,
+	0x50, 0xae, 0xcd, 0x83, 0x78, 0xc0, 0xd5, 0x52, 0xcf, 0x3d, 0x0f, 0x3c, 0x68, 0xc0, 0xa3, 0x98,
+	0x52, 0x22, 0xeb, 0xb9, 0xe7, 0x34, 0xe2, 0x54, 0x9a, 0xb1, 0xce, 0x79, 0x89, 0x9c, 0xcc, 0xd6,
+	0x64, 0x6a, 0xb6, 0x92, 0x39, 0xda, 0xab, 0x0b, 0x3d, 0xc1, 0xc9, 0x1c, 0xed, 0xd5, 0x85, 0x3a,
+	0x94, 0x75, 0xed, 0xef, 0xe8, 0xf6, 0x9b, 0xd2, 0xdb, 0x29, 0xa4, 0xdf, 0x99, 0xd3, 0xe9, 0xce,
+	0x5c, 0x43, 0x85, 0x26, 0x11, 0xef, 0x0d, 0xb4, 0xc7, 0x8c, 0xfe, 0x76, 0x08, 0x77, 0x1e, 0x9a,
+	0x52, 0xdf, 0x63, 0xb5, 0x17, 0x55, 0xea, 0xf2, 0x6f, 0xd8, 0xf8, 0x34, 0x60, 0x3c, 0x0a, 0xf8,
+	0x0b, 0x0b, 0x1d, 0xdb, 0xa3, 0x42, 0xe2, 0x53, 0xe9, 0x5b, 0x24, 0xb9, 0x33, 0x8a, 0x7b, 0x47,
+	0xc5, 0x42, 0x39, 0x71, 0xce, 0x7f, 0xfa, 0xe7, 0xdf, 0xdf, 0x4c, 0x9c, 0xc6, 0x8b, 0xfa, 0xad,
+	0xd3, 0xd9, 0xe8, 0x3f, 0x2c, 0x28, 0x88, 0xcf, 0x26, 0x2c, 0xfc, 0xb9, 0x85, 0x72, 0xdb, 0x30,
+	0x96, 0xcd, 0x91, 0xe5, 0xc4, 0xb9, 0xa8, 0x99, 0x9c, 0xc3, 0x67, 0x47, 0x31, 0x29, 0x3d, 0x56,
+	0xd2, 0x13, 0xfc, 0xbd, 0x85, 0xa6, 0xb6, 0x41, 0xaa, 0x6b, 0x0d, 0x9f, 0x39, 0xc8, 0x28, 0xb9,
+	0xec, 0x8e, 0x90, 0xd5, 0x15, 0xcd, 0xea, 0x02, 0x3e, 0x3f, 0x92, 0xd5, 0x7e, 0x77, 0x9d, 0xd6,
+	0x4a, 0x8f, 0x69, 0xed, 0x09, 0xfe, 0xce, 0x42, 0x05, 0x95, 0x51, 0x2f, 0xb5, 0xff, 0x62, 0x4a,
+	0xb8, 0x94, 0x55, 0x42, 0xfc, 0x11, 0x9a, 0x36, 0xb4, 0xea, 0x63, 0xe9, 0x14, 0x06, 0xe1, 0xba,
+	0x70, 0x56, 0xb5, 0x49, 0x07, 0xaf, 0x64, 0xd4, 0xa2, 0xc4, 0x95, 0xc9, 0x6f, 0x2d, 0x74, 0x46,
+	0xd9, 0xdf, 0xa6, 0x72, 0x47, 0xdf, 0x1a, 0x87, 0x89, 0xff, 0x62, 0x1a, 0x1e, 0xfe, 0xd2, 0x84,
+	0xf5, 0x86, 0xe6, 0xf0, 0x2a, 0xbe, 0x91, 0xc5, 0xc1, 0xe4, 0x71, 0x9d, 0x30, 0xb6, 0x3e, 0x10,
+	0x75, 0xcb, 0x44, 0xad, 0x1e, 0x69, 0xc3, 0x7d, 0x92, 0xbc, 0x91, 0x8b, 0x4b, 0xa3, 0xb6, 0x92,
+	0xe3, 0xff, 0x50, 0x59, 0x20, 0xca, 0xc5, 0x57, 0x16, 0x9a, 0xdb, 0x06, 0xd9, 0x7f, 0xcd, 0xe2,
+	0xf3, 0x23, 0x2c, 0xa7, 0x5f, 0xba, 0x45, 0x67, 0xbc, 0x42, 0x42, 0x20, 0x4e, 0x81, 0x73, 0x7d,
+	0x34, 0x01, 0xf3, 0x94, 0xd5, 0x76, 0xee, 0x79, 0x7b, 0x9a, 0x4a, 0xcd, 0x58, 0xb8, 0x69, 0xad,
+	0xe1, 0x8e, 0xa6, 0xb4, 0x03, 0x41, 0x6b, 0xab, 0x49, 0xb8, 0x1c, 0x5b, 0x8c, 0xe5, 0x34, 0xdc,
+	0x57, 0x4f, 0x48, 0xb8, 0x9a, 0xc4, 0x2a, 0xbe, 0x9c, 0x95, 0x85, 0x26, 0x04, 0x2d, 0xdf, 0xb8,
+	0xf9, 0xc1, 0x42, 0x79, 0x73, 0x61, 0xe2, 0x73, 0x07, 0x3d, 0x0e, 0x5c, 0xa4, 0x47, 0x38, 0xa5,
+	0x2f, 0x6b, 0x8e, 0x4b, 0xce, 0xc8, 0x11, 0xb8, 0xa9, 0x4f, 0x5b, 0x75, 0x96, 0xfd, 0x68, 0xa1,
+	0x42, 0x8f, 0x42, 0xef, 0xdb, 0x17, 0x47, 0xd2, 0x79, 0x36, 0x49, 0xfc, 0x93, 0x85, 0xf2, 0xe6,
+	0x0a, 0x1a, 0xe6, 0x35, 0x70, 0x35, 0x1d, 0x21, 0xaf, 0x0d, 0x53, 0xe0, 0x62, 0x46, 0x9b, 0x6b,
+	0x2a, 0x4f, 0xfa, 0x89, 0xfc, 0xc5, 0x42, 0x85, 0x1e, 0x9d, 0xf1, 0x89, 0xfc, 0xbf, 0x08, 0xbb,
+	0xcf, 0x47, 0x18, 0x13, 0x94, 0xaf, 0x42, 0x00, 0x12, 0xc6, 0x8d, 0x80, 0x7d, 0x10, 0x4e, 0x9a,
+	0xff, 0xb2, 0xb9, 0x94, 0xd6, 0xb2, 0x2e, 0x25, 0x95, 0x90, 0x26, 0x2a, 0x18, 0x17, 0xa9, 0x7c,
+	0x3c, 0xb7, 0xb3, 0x8b, 0x87, 0x70, 0x86, 0xbf, 0xb4, 0x10, 0x8e, 0xdf, 0x6c, 0xea, 0xfd, 0x06,
+	0xa1, 0xa4, 0x24, 0x10, 0xf8, 0xc2, 0x88, 0x2e, 0x1e, 0x7c, 0x0a, 0x16, 0x2f, 0x65, 0xa9, 0x24,
+	0x24, 0x4a, 0x9a, 0xc4, 0x55, 0x7c, 0x25, 0x6b, 0xdc, 0xfd, 0x94, 0xe7, 0xc7, 0x68, 0xfe, 0x7d,
+	0x12, 0x50, 0x55, 0x6a, 0xf3, 0x3b, 0x11, 0x9f, 0x1d, 0x3a, 0xda, 0xfa, 0xbf, 0x1f, 0x33, 0xc2,
+	0x2f, 0x6b, 0xcf, 0xd7, 0x9c, 0x4b, 0x59, 0x9e, 0x3b, 0xb1, 0x2b, 0x53, 0xda, 0xcd, 0x5b, 0xbf,
+	0x3f, 0x5d, 0xb6, 0xfe, 0x78, 0xba, 0x6c, 0xfd, 0xf5, 0x74, 0xd9, 0xfa, 0xe0, 0xb5, 0xc3, 0xfd,
+	0x31, 0xe3, 0xeb, 0x1f, 0x7a, 0xa9, 0xbf, 0x50, 0xf6, 0xf3, 0xfa, 0x3f, 0x94, 0x1b, 0xff, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0xe7, 0x78, 0x8a, 0x45, 0x28, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -794,9 +1070,13 @@ type RepositoryServiceClient interface {
 	List(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
 	// Get returns a repository or its credentials
 	Get(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
+	// GetByID returns a repository by its external ID
+	GetByID(ctx context.Context, in *RepoByIDQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// ListRepositories gets a list of all configured repositories
 	ListRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
 	ListRefs(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.Refs, error)
+	// ListGitHubAppRepositories returns the repositories accessible to the GitHub App installation used by the repo or credential template
+	ListGitHubAppRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.GitHubAppRepositoryList, error)
 	// ListApps returns list of apps in the repo
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
@@ -815,6 +1095,8 @@ type RepositoryServiceClient interface {
 	Delete(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 	// DeleteRepository deletes a repository from the configuration
 	DeleteRepository(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error)
+	// ResolveCredentials returns which credential template is used for a repository URL
+	ResolveCredentials(ctx context.Context, in *RepoCredsResolveQuery, opts ...grpc.CallOption) (*RepoCredsResolveResponse, error)
 	// ValidateAccess validates access to a repository with given parameters
 	ValidateAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 }
@@ -846,6 +1128,15 @@ func (c *repositoryServiceClient) Get(ctx context.Context, in *RepoQuery, opts .
 	return out, nil
 }
 
+func (c *repositoryServiceClient) GetByID(ctx context.Context, in *RepoByIDQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error) {
+	out := new(v1alpha1.Repository)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/GetByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ListRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error) {
 	out := new(v1alpha1.RepositoryList)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListRepositories", in, out, opts...)
@@ -864,6 +1155,15 @@ func (c *repositoryServiceClient) ListRefs(ctx context.Context, in *RepoQuery, o
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ListGitHubAppRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.GitHubAppRepositoryList, error) {
+	out := new(apiclient.GitHubAppRepositoryList)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListGitHubAppRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error) {
 	out := new(RepoAppsResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListApps", in, out, opts...)
@@ -948,6 +1248,15 @@ func (c *repositoryServiceClient) DeleteRepository(ctx context.Context, in *Repo
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ResolveCredentials(ctx context.Context, in *RepoCredsResolveQuery, opts ...grpc.CallOption) (*RepoCredsResolveResponse, error) {
+	out := new(RepoCredsResolveResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ResolveCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ValidateAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error) {
 	out := new(RepoResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ValidateAccess", in, out, opts...)
@@ -963,9 +1272,13 @@ type RepositoryServiceServer interface {
 	List(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
 	// Get returns a repository or its credentials
 	Get(context.Context, *RepoQuery) (*v1alpha1.Repository, error)
+	// GetByID returns a repository by its external ID
+	GetByID(context.Context, *RepoByIDQuery) (*v1alpha1.Repository, error)
 	// ListRepositories gets a list of all configured repositories
 	ListRepositories(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
 	ListRefs(context.Context, *RepoQuery) (*apiclient.Refs, error)
+	// ListGitHubAppRepositories returns the repositories accessible to the GitHub App installation used by the repo or credential template
+	ListGitHubAppRepositories(context.Context, *RepoQuery) (*apiclient.GitHubAppRepositoryList, error)
 	// ListApps returns list of apps in the repo
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
@@ -984,6 +1297,8 @@ type RepositoryServiceServer interface {
 	Delete(context.Context, *RepoQuery) (*RepoResponse, error)
 	// DeleteRepository deletes a repository from the configuration
 	DeleteRepository(context.Context, *RepoQuery) (*RepoResponse, error)
+	// ResolveCredentials returns which credential template is used for a repository URL
+	ResolveCredentials(context.Context, *RepoCredsResolveQuery) (*RepoCredsResolveResponse, error)
 	// ValidateAccess validates access to a repository with given parameters
 	ValidateAccess(context.Context, *RepoAccessQuery) (*RepoResponse, error)
 }
@@ -998,12 +1313,18 @@ func (*UnimplementedRepositoryServiceServer) List(ctx context.Context, req *Repo
 func (*UnimplementedRepositoryServiceServer) Get(ctx context.Context, req *RepoQuery) (*v1alpha1.Repository, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (*UnimplementedRepositoryServiceServer) GetByID(ctx context.Context, req *RepoByIDQuery) (*v1alpha1.Repository, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
+}
 func (*UnimplementedRepositoryServiceServer) ListRepositories(ctx context.Context, req *RepoQuery) (*v1alpha1.RepositoryList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepositories not implemented")
 }
 func (*UnimplementedRepositoryServiceServer) ListRefs(ctx context.Context, req *RepoQuery) (*apiclient.Refs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRefs not implemented")
 }
+func (*UnimplementedRepositoryServiceServer) ListGitHubAppRepositories(ctx context.Context, req *RepoQuery) (*apiclient.GitHubAppRepositoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGitHubAppRepositories not implemented")
+}
 func (*UnimplementedRepositoryServiceServer) ListApps(ctx context.Context, req *RepoAppsQuery) (*RepoAppsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApps not implemented")
 }
@@ -1031,6 +1352,9 @@ func (*UnimplementedRepositoryServiceServer) Delete(ctx context.Context, req *Re
 func (*UnimplementedRepositoryServiceServer) DeleteRepository(ctx context.Context, req *RepoQuery) (*RepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepository not implemented")
 }
+func (*UnimplementedRepositoryServiceServer) ResolveCredentials(ctx context.Context, req *RepoCredsResolveQuery) (*RepoCredsResolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCredentials not implemented")
+}
 func (*UnimplementedRepositoryServiceServer) ValidateAccess(ctx context.Context, req *RepoAccessQuery) (*RepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateAccess not implemented")
 }
@@ -1075,6 +1399,24 @@ func _RepositoryService_Get_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_GetByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoByIDQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/GetByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetByID(ctx, req.(*RepoByIDQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ListRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoQuery)
 	if err := dec(in); err != nil {
@@ -1111,6 +1453,24 @@ func _RepositoryService_ListRefs_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ListGitHubAppRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListGitHubAppRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ListGitHubAppRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListGitHubAppRepositories(ctx, req.(*RepoQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoAppsQuery)
 	if err := dec(in); err != nil {
@@ -1273,6 +1633,24 @@ func _RepositoryService_DeleteRepository_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ResolveCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoCredsResolveQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ResolveCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/ResolveCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ResolveCredentials(ctx, req.(*RepoCredsResolveQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ValidateAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoAccessQuery)
 	if err := dec(in); err != nil {
@@ -1303,6 +1681,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _RepositoryService_Get_Handler,
 		},
+		{
+			MethodName: "GetByID",
+			Handler:    _RepositoryService_GetByID_Handler,
+		},
 		{
 			MethodName: "ListRepositories",
 			Handler:    _RepositoryService_ListRepositories_Handler,
@@ -1311,6 +1693,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListRefs",
 			Handler:    _RepositoryService_ListRefs_Handler,
 		},
+		{
+			MethodName: "ListGitHubAppRepositories",
+			Handler:    _RepositoryService_ListGitHubAppRepositories_Handler,
+		},
 		{
 			MethodName: "ListApps",
 			Handler:    _RepositoryService_ListApps_Handler,
@@ -1347,6 +1733,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepository",
 			Handler:    _RepositoryService_DeleteRepository_Handler,
 		},
+		{
+			MethodName: "ResolveCredentials",
+			Handler:    _RepositoryService_ResolveCredentials_Handler,
+		},
 		{
 			MethodName: "ValidateAccess",
 			Handler:    _RepositoryService_ValidateAccess_Handler,
@@ -1570,6 +1960,15 @@ func (m *RepoQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExternalIDs) > 0 {
+		for iNdEx := len(m.ExternalIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExternalIDs[iNdEx])
+			copy(dAtA[i:], m.ExternalIDs[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ExternalIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ForceRefresh {
 		i--
 		if m.ForceRefresh {
@@ -1590,7 +1989,7 @@ func (m *RepoQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoAccessQuery) Marshal() (dAtA []byte, err error) {
+func (m *RepoByIDQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1600,12 +1999,12 @@ func (m *RepoAccessQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoAccessQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoByIDQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoAccessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoByIDQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1614,30 +2013,92 @@ func (m *RepoAccessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ForceHttpBasicAuth {
+	if m.ForceRefresh {
 		i--
-		if m.ForceHttpBasicAuth {
+		if m.ForceRefresh {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+		dAtA[i] = 0x10
 	}
-	if len(m.GcpServiceAccountKey) > 0 {
-		i -= len(m.GcpServiceAccountKey)
-		copy(dAtA[i:], m.GcpServiceAccountKey)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.GcpServiceAccountKey)))
-		i--
-		dAtA[i] = 0x1
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0xa
 	}
-	if len(m.Project) > 0 {
-		i -= len(m.Project)
-		copy(dAtA[i:], m.Project)
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoAccessQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoAccessQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoAccessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TlsServerName) > 0 {
+		i -= len(m.TlsServerName)
+		copy(dAtA[i:], m.TlsServerName)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TlsServerName)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.TlsCACertData) > 0 {
+		i -= len(m.TlsCACertData)
+		copy(dAtA[i:], m.TlsCACertData)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TlsCACertData)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.ForceHttpBasicAuth {
+		i--
+		if m.ForceHttpBasicAuth {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.GcpServiceAccountKey) > 0 {
+		i -= len(m.GcpServiceAccountKey)
+		copy(dAtA[i:], m.GcpServiceAccountKey)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.GcpServiceAccountKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.Project)))
 		i--
 		dAtA[i] = 0x1
@@ -1842,6 +2303,143 @@ func (m *RepoCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RepoCredsResolveQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoCredsResolveQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoCredsResolveQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoCredsResolveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoCredsResolveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoCredsResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HasTlsCACertData {
+		i--
+		if m.HasTlsCACertData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Proxy) > 0 {
+		i -= len(m.Proxy)
+		copy(dAtA[i:], m.Proxy)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Proxy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ForceProxy {
+		i--
+		if m.ForceProxy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EnableLfs {
+		i--
+		if m.EnableLfs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UrlRegex {
+		i--
+		if m.UrlRegex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RepoUpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1995,6 +2593,31 @@ func (m *RepoQuery) Size() (n int) {
 	if m.ForceRefresh {
 		n += 2
 	}
+	if len(m.ExternalIDs) > 0 {
+		for _, s := range m.ExternalIDs {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoByIDQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.ForceRefresh {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2074,6 +2697,14 @@ func (m *RepoAccessQuery) Size() (n int) {
 	if m.ForceHttpBasicAuth {
 		n += 3
 	}
+	l = len(m.TlsCACertData)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TlsServerName)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2114,6 +2745,64 @@ func (m *RepoCreateRequest) Size() (n int) {
 	return n
 }
 
+func (m *RepoCredsResolveQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoCredsResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Matched {
+		n += 2
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.UrlRegex {
+		n += 2
+	}
+	if m.Priority != 0 {
+		n += 1 + sovRepository(uint64(m.Priority))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.EnableLfs {
+		n += 2
+	}
+	if m.ForceProxy {
+		n += 2
+	}
+	l = len(m.Proxy)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.HasTlsCACertData {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RepoUpdateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2747,20 +3436,155 @@ func (m *RepoQuery) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ForceRefresh = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIDs", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalIDs = append(m.ExternalIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoByIDQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoByIDQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoByIDQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRefresh", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceRefresh = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
 
@@ -3312,6 +4136,70 @@ func (m *RepoAccessQuery) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ForceHttpBasicAuth = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsCACertData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsCACertData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsServerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TlsServerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -3512,6 +4400,355 @@ func (m *RepoCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoCredsResolveQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoCredsResolveQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoCredsResolveQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoCredsResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoCredsResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoCredsResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UrlRegex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UrlRegex = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLfs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableLfs = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceProxy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceProxy = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proxy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTlsCACertData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasTlsCACertData = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepoUpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -33,9 +33,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SessionCreateRequest is for logging in.
 type SessionCreateRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token    string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// expiresIn is the number of seconds the token is valid for, which cannot exceed the session duration
+	ExpiresIn            int64    `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -95,6 +97,13 @@ func (m *SessionCreateRequest) GetToken() string {
 	return ""
 }
 
+func (m *SessionCreateRequest) GetExpiresIn() int64 {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return 0
+}
+
 // SessionDeleteRequest is for logging out.
 type SessionDeleteRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -295,44 +304,221 @@ func (m *GetUserInfoResponse) GetGroups() []string {
 	return nil
 }
 
+// Get the current user's preferences
+type GetPreferencesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPreferencesRequest) Reset()         { *m = GetPreferencesRequest{} }
+func (m *GetPreferencesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPreferencesRequest) ProtoMessage()    {}
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{5}
+}
+func (m *GetPreferencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPreferencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPreferencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPreferencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPreferencesRequest.Merge(m, src)
+}
+func (m *GetPreferencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPreferencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPreferencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPreferencesRequest proto.InternalMessageInfo
+
+// SavedFilter is a named set of filters of the applications list
+type SavedFilter struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// query is the query string of the filters of the applications list, e.g. proj=default&health=Degraded
+	Query                string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SavedFilter) Reset()         { *m = SavedFilter{} }
+func (m *SavedFilter) String() string { return proto.CompactTextString(m) }
+func (*SavedFilter) ProtoMessage()    {}
+func (*SavedFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{6}
+}
+func (m *SavedFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SavedFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SavedFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SavedFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SavedFilter.Merge(m, src)
+}
+func (m *SavedFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SavedFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SavedFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SavedFilter proto.InternalMessageInfo
+
+func (m *SavedFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SavedFilter) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+// The current user's preferences
+type UserPreferences struct {
+	// starredApps are the qualified names of the applications starred by the user, i.e. <namespace>/<name>
+	StarredApps []string `protobuf:"bytes,1,rep,name=starredApps,proto3" json:"starredApps,omitempty"`
+	// recentApps are the qualified names of the applications recently viewed by the user, the most recent first
+	RecentApps           []string       `protobuf:"bytes,2,rep,name=recentApps,proto3" json:"recentApps,omitempty"`
+	SavedFilters         []*SavedFilter `protobuf:"bytes,3,rep,name=savedFilters,proto3" json:"savedFilters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UserPreferences) Reset()         { *m = UserPreferences{} }
+func (m *UserPreferences) String() string { return proto.CompactTextString(m) }
+func (*UserPreferences) ProtoMessage()    {}
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{7}
+}
+func (m *UserPreferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserPreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserPreferences.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserPreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserPreferences.Merge(m, src)
+}
+func (m *UserPreferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserPreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserPreferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserPreferences proto.InternalMessageInfo
+
+func (m *UserPreferences) GetStarredApps() []string {
+	if m != nil {
+		return m.StarredApps
+	}
+	return nil
+}
+
+func (m *UserPreferences) GetRecentApps() []string {
+	if m != nil {
+		return m.RecentApps
+	}
+	return nil
+}
+
+func (m *UserPreferences) GetSavedFilters() []*SavedFilter {
+	if m != nil {
+		return m.SavedFilters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SessionCreateRequest)(nil), "session.SessionCreateRequest")
 	proto.RegisterType((*SessionDeleteRequest)(nil), "session.SessionDeleteRequest")
 	proto.RegisterType((*SessionResponse)(nil), "session.SessionResponse")
 	proto.RegisterType((*GetUserInfoRequest)(nil), "session.GetUserInfoRequest")
 	proto.RegisterType((*GetUserInfoResponse)(nil), "session.GetUserInfoResponse")
+	proto.RegisterType((*GetPreferencesRequest)(nil), "session.GetPreferencesRequest")
+	proto.RegisterType((*SavedFilter)(nil), "session.SavedFilter")
+	proto.RegisterType((*UserPreferences)(nil), "session.UserPreferences")
 }
 
 func init() { proto.RegisterFile("server/session/session.proto", fileDescriptor_87870a51a62685ed) }
 
 var fileDescriptor_87870a51a62685ed = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x95, 0x14, 0x42, 0xc7, 0x48, 0x0c, 0x98, 0x68, 0x88, 0x42, 0xa9, 0xaa, 0x6c, 0x18,
-	0x8d, 0x44, 0x23, 0x06, 0x56, 0x2c, 0x0b, 0x12, 0xea, 0x36, 0x15, 0x9b, 0x4a, 0x2c, 0xd2, 0xe4,
-	0xc7, 0xb8, 0x4d, 0xfd, 0x07, 0xdb, 0x49, 0xf7, 0x5c, 0x81, 0xc3, 0x70, 0x05, 0x96, 0x48, 0x5c,
-	0x00, 0x55, 0x1c, 0x04, 0x25, 0x4e, 0x42, 0x9b, 0x56, 0xac, 0xe2, 0xe7, 0xdf, 0xf9, 0xde, 0xb3,
-	0x9e, 0xc9, 0x48, 0x81, 0x2c, 0x41, 0x86, 0x0a, 0x94, 0xe2, 0x28, 0xda, 0xef, 0x34, 0x97, 0xa8,
-	0x91, 0xde, 0x6b, 0xa4, 0x3f, 0x62, 0x88, 0x2c, 0x83, 0x30, 0xce, 0x79, 0x18, 0x0b, 0x81, 0x3a,
-	0xd6, 0x1c, 0x85, 0x32, 0xc7, 0x82, 0x94, 0xb8, 0x0b, 0x73, 0xf0, 0xad, 0x84, 0x58, 0x43, 0x04,
-	0x5f, 0x0a, 0x50, 0x9a, 0xfa, 0x64, 0x58, 0x28, 0x90, 0x22, 0xde, 0x82, 0x67, 0x4d, 0xac, 0xeb,
-	0x8b, 0xa8, 0xd3, 0xd5, 0x2c, 0x8f, 0x95, 0xda, 0xa1, 0x4c, 0x3d, 0xdb, 0xcc, 0x5a, 0x4d, 0x5d,
-	0x72, 0x57, 0xe3, 0x06, 0x84, 0x37, 0xa8, 0x07, 0x46, 0x04, 0x57, 0x9d, 0xcb, 0x3b, 0xc8, 0xa0,
-	0x73, 0x09, 0x9e, 0x93, 0xcb, 0x66, 0x3f, 0x02, 0x95, 0xa3, 0x50, 0xf0, 0x0f, 0x60, 0x1d, 0x02,
-	0x5c, 0x42, 0xdf, 0x83, 0xfe, 0xa0, 0x40, 0xce, 0xc5, 0x27, 0x6c, 0x7f, 0xdf, 0x91, 0xc7, 0x47,
-	0xbb, 0x0d, 0xc2, 0x27, 0xc3, 0x0c, 0x19, 0x83, 0x74, 0x6e, 0x28, 0xc3, 0xa8, 0xd3, 0x47, 0xf7,
-	0xb2, 0x7b, 0xf7, 0x7a, 0x48, 0x06, 0x5c, 0xa9, 0x26, 0x79, 0xb5, 0xa4, 0x57, 0xc4, 0x61, 0x12,
-	0x8b, 0x5c, 0x79, 0x77, 0x26, 0x83, 0xeb, 0x8b, 0xa8, 0x51, 0xb7, 0xdf, 0x6d, 0xf2, 0xa0, 0x09,
-	0xbe, 0x00, 0x59, 0xf2, 0x04, 0xe8, 0x9a, 0xdc, 0x3f, 0xc8, 0x42, 0x9f, 0x4e, 0xdb, 0x3a, 0x4e,
-	0x73, 0xfb, 0xa3, 0xf3, 0x43, 0x13, 0x3f, 0x98, 0x7c, 0xfd, 0xf5, 0xe7, 0x9b, 0xed, 0x53, 0xaf,
-	0xae, 0xac, 0x7c, 0xd9, 0x15, 0x5c, 0x05, 0xe5, 0x15, 0xfc, 0x23, 0x71, 0x4c, 0x5b, 0xf4, 0x59,
-	0x47, 0x3a, 0xd7, 0xa2, 0xef, 0xf5, 0xc7, 0x9d, 0x89, 0x5f, 0x9b, 0xb8, 0xc1, 0x65, 0xcf, 0xe4,
-	0x8d, 0x75, 0x43, 0x97, 0xc4, 0x31, 0x35, 0x9d, 0xe2, 0x8f, 0xea, 0xfb, 0x0f, 0xfe, 0x49, 0x8d,
-	0x7f, 0x74, 0xd3, 0xc7, 0xcf, 0x66, 0x3f, 0xf6, 0x63, 0xeb, 0xe7, 0x7e, 0x6c, 0xfd, 0xde, 0x8f,
-	0xad, 0xe5, 0x6b, 0xc6, 0xf5, 0xe7, 0x62, 0x35, 0x4d, 0x70, 0x1b, 0xc6, 0x92, 0x61, 0x2e, 0x71,
-	0x5d, 0x2f, 0x5e, 0x24, 0x69, 0x58, 0xde, 0x86, 0xf9, 0x86, 0x55, 0x80, 0x24, 0xe3, 0x20, 0x74,
-	0xcb, 0x58, 0x39, 0xf5, 0xd3, 0x7d, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0xda, 0x7e, 0xce, 0xb3,
-	0x01, 0x03, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe5, 0x24, 0x4f, 0x9e, 0x66, 0x82, 0x1a, 0xba, 0x84, 0xd6, 0x72, 0xd3, 0xc8, 0x32,
+	0x12, 0x44, 0x95, 0x88, 0x45, 0x40, 0x02, 0x71, 0xa3, 0x20, 0xaa, 0xdc, 0x90, 0xa3, 0x5e, 0x2a,
+	0x71, 0x70, 0xed, 0xa9, 0x71, 0x9b, 0xee, 0x6e, 0x77, 0x37, 0x29, 0x5c, 0x7b, 0xe7, 0xc4, 0x89,
+	0x6f, 0xc4, 0x11, 0x89, 0x2f, 0x80, 0x22, 0x3e, 0x08, 0xf2, 0xfa, 0x25, 0x8e, 0x53, 0x72, 0xca,
+	0xce, 0xce, 0xe4, 0xf7, 0x9f, 0x97, 0x1d, 0x43, 0x4f, 0xa2, 0x98, 0xa3, 0x70, 0x25, 0x4a, 0x19,
+	0x33, 0x9a, 0xff, 0x0e, 0xb9, 0x60, 0x8a, 0x91, 0xff, 0x33, 0xd3, 0xea, 0x45, 0x8c, 0x45, 0x53,
+	0x74, 0x7d, 0x1e, 0xbb, 0x3e, 0xa5, 0x4c, 0xf9, 0x2a, 0x66, 0x54, 0xa6, 0x61, 0xce, 0xad, 0x01,
+	0xdd, 0x49, 0x1a, 0xf9, 0x56, 0xa0, 0xaf, 0xd0, 0xc3, 0xeb, 0x19, 0x4a, 0x45, 0x2c, 0xd8, 0x9a,
+	0x49, 0x14, 0xd4, 0xbf, 0x42, 0xd3, 0xb0, 0x8d, 0x41, 0xcb, 0x2b, 0xec, 0xc4, 0xc7, 0x7d, 0x29,
+	0x6f, 0x98, 0x08, 0xcd, 0x5a, 0xea, 0xcb, 0x6d, 0xd2, 0x85, 0xff, 0x14, 0xbb, 0x44, 0x6a, 0xd6,
+	0xb5, 0x23, 0x35, 0x48, 0x0f, 0x5a, 0xf8, 0x99, 0xc7, 0x02, 0xe5, 0x98, 0x9a, 0x0d, 0xdb, 0x18,
+	0xd4, 0xbd, 0xe5, 0x85, 0xb3, 0x5b, 0xe4, 0xf0, 0x0e, 0xa7, 0x58, 0xe4, 0xe0, 0x3c, 0x81, 0x4e,
+	0x76, 0xef, 0xa1, 0xe4, 0x8c, 0x4a, 0x5c, 0xe2, 0x8d, 0x12, 0xde, 0xe9, 0x02, 0x39, 0x46, 0x75,
+	0x22, 0x51, 0x8c, 0xe9, 0x39, 0xcb, 0xff, 0x7e, 0x03, 0x0f, 0x56, 0x6e, 0x33, 0x84, 0x05, 0x5b,
+	0x53, 0x16, 0x45, 0x18, 0x8e, 0x53, 0xca, 0x96, 0x57, 0xd8, 0x2b, 0x55, 0xd7, 0x2a, 0x55, 0xdf,
+	0x87, 0x7a, 0x2c, 0x65, 0x56, 0x57, 0x72, 0x24, 0xbb, 0xd0, 0x8c, 0x04, 0x9b, 0x71, 0x69, 0x36,
+	0xec, 0xfa, 0xa0, 0xe5, 0x65, 0x96, 0xb3, 0x07, 0x0f, 0x8f, 0x51, 0x7d, 0x10, 0x78, 0x8e, 0x02,
+	0x69, 0x80, 0x32, 0xcf, 0xe8, 0x25, 0xb4, 0x27, 0xfe, 0x1c, 0xc3, 0xf7, 0xf1, 0x54, 0xa1, 0x20,
+	0x04, 0x1a, 0xa5, 0xfe, 0xea, 0x73, 0x52, 0xe0, 0xf5, 0x0c, 0xc5, 0x97, 0x4c, 0x3e, 0x35, 0x9c,
+	0xaf, 0x06, 0x74, 0x92, 0x42, 0x4a, 0x4c, 0x62, 0x43, 0x5b, 0x2a, 0x5f, 0x08, 0x0c, 0xdf, 0x70,
+	0x2e, 0x4d, 0x43, 0xa7, 0x50, 0xbe, 0x22, 0x7d, 0x00, 0x81, 0x01, 0x52, 0xa5, 0x03, 0x6a, 0x3a,
+	0xa0, 0x74, 0x43, 0x5e, 0xc1, 0x3d, 0xb9, 0x4c, 0x27, 0x29, 0xad, 0x3e, 0x68, 0x8f, 0xba, 0xc3,
+	0xfc, 0x25, 0x95, 0x72, 0xf5, 0x56, 0x22, 0x47, 0xdf, 0x1b, 0xb0, 0x9d, 0x8d, 0x66, 0x82, 0x62,
+	0x1e, 0x07, 0x48, 0x2e, 0xa0, 0x5d, 0xea, 0x36, 0xd9, 0x2f, 0x28, 0xeb, 0x93, 0xb1, 0x7a, 0x77,
+	0x3b, 0xd3, 0x01, 0x39, 0xf6, 0xed, 0xaf, 0x3f, 0xdf, 0x6a, 0x16, 0x31, 0xf5, 0x9b, 0x9d, 0x3f,
+	0x2b, 0x5e, 0x78, 0x32, 0x8a, 0x38, 0x81, 0x33, 0xd8, 0x5e, 0x6d, 0x30, 0xe9, 0x97, 0x89, 0xeb,
+	0x9d, 0xb7, 0xcc, 0xc2, 0x5f, 0x69, 0xa3, 0xf3, 0x48, 0xab, 0x1d, 0x90, 0xfd, 0xaa, 0x1a, 0x2f,
+	0xe1, 0x19, 0xec, 0x9c, 0xf0, 0xd0, 0x57, 0x58, 0xd6, 0xfc, 0x27, 0x73, 0x83, 0xda, 0x63, 0xad,
+	0x66, 0x5b, 0x9b, 0xd4, 0x5e, 0x1b, 0x87, 0xe4, 0x23, 0x34, 0xd3, 0x7d, 0x24, 0x07, 0xcb, 0x71,
+	0xdc, 0xb1, 0xa7, 0x25, 0xa9, 0xca, 0xaa, 0x38, 0x96, 0x96, 0xea, 0x3a, 0x9d, 0x8a, 0x54, 0x82,
+	0x3f, 0x85, 0x66, 0xba, 0x6a, 0xeb, 0xf8, 0x95, 0x15, 0xdc, 0x80, 0xdf, 0xd3, 0xf8, 0x9d, 0xc3,
+	0x2a, 0xfe, 0xe8, 0xe8, 0xc7, 0xa2, 0x6f, 0xfc, 0x5c, 0xf4, 0x8d, 0xdf, 0x8b, 0xbe, 0x71, 0xfa,
+	0x22, 0x8a, 0xd5, 0xa7, 0xd9, 0xd9, 0x30, 0x60, 0x57, 0xae, 0x2f, 0x22, 0xc6, 0x05, 0xbb, 0xd0,
+	0x87, 0xa7, 0x41, 0xe8, 0xce, 0x47, 0x2e, 0xbf, 0x8c, 0x12, 0x40, 0x30, 0x8d, 0x91, 0xaa, 0x9c,
+	0x71, 0xd6, 0xd4, 0x5f, 0xa7, 0xe7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x4b, 0x42, 0x80,
+	0xe4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +535,10 @@ const _ = grpc.SupportPackageIsVersion4
 type SessionServiceClient interface {
 	// Get the current user's info
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
+	// Get the current user's preferences
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*UserPreferences, error)
+	// Update the current user's preferences
+	UpdatePreferences(ctx context.Context, in *UserPreferences, opts ...grpc.CallOption) (*UserPreferences, error)
 	// Create a new JWT for authentication and set a cookie if using HTTP
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
@@ -372,6 +562,24 @@ func (c *sessionServiceClient) GetUserInfo(ctx context.Context, in *GetUserInfoR
 	return out, nil
 }
 
+func (c *sessionServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*UserPreferences, error) {
+	out := new(UserPreferences)
+	err := c.cc.Invoke(ctx, "/session.SessionService/GetPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) UpdatePreferences(ctx context.Context, in *UserPreferences, opts ...grpc.CallOption) (*UserPreferences, error) {
+	out := new(UserPreferences)
+	err := c.cc.Invoke(ctx, "/session.SessionService/UpdatePreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionServiceClient) Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error) {
 	out := new(SessionResponse)
 	err := c.cc.Invoke(ctx, "/session.SessionService/Create", in, out, opts...)
@@ -394,6 +602,10 @@ func (c *sessionServiceClient) Delete(ctx context.Context, in *SessionDeleteRequ
 type SessionServiceServer interface {
 	// Get the current user's info
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
+	// Get the current user's preferences
+	GetPreferences(context.Context, *GetPreferencesRequest) (*UserPreferences, error)
+	// Update the current user's preferences
+	UpdatePreferences(context.Context, *UserPreferences) (*UserPreferences, error)
 	// Create a new JWT for authentication and set a cookie if using HTTP
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
@@ -407,6 +619,12 @@ type UnimplementedSessionServiceServer struct {
 func (*UnimplementedSessionServiceServer) GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (*GetUserInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
+func (*UnimplementedSessionServiceServer) GetPreferences(ctx context.Context, req *GetPreferencesRequest) (*UserPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (*UnimplementedSessionServiceServer) UpdatePreferences(ctx context.Context, req *UserPreferences) (*UserPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
 func (*UnimplementedSessionServiceServer) Create(ctx context.Context, req *SessionCreateRequest) (*SessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -436,6 +654,42 @@ func _SessionService_GetUserInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/GetPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPreferences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/UpdatePreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).UpdatePreferences(ctx, req.(*UserPreferences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SessionCreateRequest)
 	if err := dec(in); err != nil {
@@ -480,6 +734,14 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserInfo",
 			Handler:    _SessionService_GetUserInfo_Handler,
 		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _SessionService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _SessionService_UpdatePreferences_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _SessionService_Create_Handler,
@@ -517,6 +779,11 @@ func (m *SessionCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExpiresIn != 0 {
+		i = encodeVarintSession(dAtA, i, uint64(m.ExpiresIn))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Token) > 0 {
 		i -= len(m.Token)
 		copy(dAtA[i:], m.Token)
@@ -689,6 +956,133 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GetPreferencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPreferencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPreferencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SavedFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SavedFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SavedFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserPreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserPreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SavedFilters) > 0 {
+		for iNdEx := len(m.SavedFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavedFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecentApps) > 0 {
+		for iNdEx := len(m.RecentApps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecentApps[iNdEx])
+			copy(dAtA[i:], m.RecentApps[iNdEx])
+			i = encodeVarintSession(dAtA, i, uint64(len(m.RecentApps[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StarredApps) > 0 {
+		for iNdEx := len(m.StarredApps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StarredApps[iNdEx])
+			copy(dAtA[i:], m.StarredApps[iNdEx])
+			i = encodeVarintSession(dAtA, i, uint64(len(m.StarredApps[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSession(v)
 	base := offset
@@ -718,6 +1112,9 @@ func (m *SessionCreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSession(uint64(l))
 	}
+	if m.ExpiresIn != 0 {
+		n += 1 + sovSession(uint64(m.ExpiresIn))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -793,20 +1190,82 @@ func (m *GetUserInfoResponse) Size() (n int) {
 	return n
 }
 
-func sovSession(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSession(x uint64) (n int) {
-	return sovSession(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GetPreferencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *SessionCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *SavedFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserPreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StarredApps) > 0 {
+		for _, s := range m.StarredApps {
+			l = len(s)
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if len(m.RecentApps) > 0 {
+		for _, s := range m.RecentApps {
+			l = len(s)
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if len(m.SavedFilters) > 0 {
+		for _, e := range m.SavedFilters {
+			l = e.Size()
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSession(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSession(x uint64) (n int) {
+	return sovSession(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SessionCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowSession
 			}
 			if iNdEx >= l {
@@ -924,6 +1383,25 @@ func (m *SessionCreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			m.ExpiresIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(dAtA[iNdEx:])
@@ -1298,6 +1776,321 @@ func (m *GetUserInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetPreferencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPreferencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPreferencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SavedFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SavedFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SavedFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPreferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPreferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StarredApps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StarredApps = append(m.StarredApps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentApps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentApps = append(m.RecentApps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavedFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SavedFilters = append(m.SavedFilters, &SavedFilter{})
+			if err := m.SavedFilters[len(m.SavedFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSession(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
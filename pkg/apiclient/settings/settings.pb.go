@@ -19,6 +19,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -100,6 +101,8 @@ type Settings struct {
 	ExecEnabled               bool                               `protobuf:"varint,22,opt,name=execEnabled,proto3" json:"execEnabled,omitempty"`
 	ControllerNamespace       string                             `protobuf:"bytes,23,opt,name=controllerNamespace,proto3" json:"controllerNamespace,omitempty"`
 	AppsInAnyNamespaceEnabled bool                               `protobuf:"varint,24,opt,name=appsInAnyNamespaceEnabled,proto3" json:"appsInAnyNamespaceEnabled,omitempty"`
+	AutomationPaused          bool                               `protobuf:"varint,25,opt,name=automationPaused,proto3" json:"automationPaused,omitempty"`
+	AutomationPausedReason    string                             `protobuf:"bytes,26,opt,name=automationPausedReason,proto3" json:"automationPausedReason,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                           `json:"-"`
 	XXX_unrecognized          []byte                             `json:"-"`
 	XXX_sizecache             int32                              `json:"-"`
@@ -306,6 +309,20 @@ func (m *Settings) GetAppsInAnyNamespaceEnabled() bool {
 	return false
 }
 
+func (m *Settings) GetAutomationPaused() bool {
+	if m != nil {
+		return m.AutomationPaused
+	}
+	return false
+}
+
+func (m *Settings) GetAutomationPausedReason() string {
+	if m != nil {
+		return m.AutomationPausedReason
+	}
+	return ""
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -574,6 +591,7 @@ func (m *DexConfig) GetConnectors() []*Connector {
 type Connector struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -626,6 +644,13 @@ func (m *Connector) GetType() string {
 	return ""
 }
 
+func (m *Connector) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type OIDCConfig struct {
 	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Issuer               string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
@@ -713,758 +738,783 @@ func (m *OIDCConfig) GetIDTokenClaims() map[string]*oidc.Claim {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
-	proto.RegisterType((*Settings)(nil), "cluster.Settings")
-	proto.RegisterMapType((map[string]*v1alpha1.ResourceOverride)(nil), "cluster.Settings.ResourceOverridesEntry")
-	proto.RegisterType((*GoogleAnalyticsConfig)(nil), "cluster.GoogleAnalyticsConfig")
-	proto.RegisterType((*SettingsPluginsResponse)(nil), "cluster.SettingsPluginsResponse")
-	proto.RegisterType((*Help)(nil), "cluster.Help")
-	proto.RegisterMapType((map[string]string)(nil), "cluster.Help.BinaryUrlsEntry")
-	proto.RegisterType((*Plugin)(nil), "cluster.Plugin")
-	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
-	proto.RegisterType((*Connector)(nil), "cluster.Connector")
-	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
-	proto.RegisterMapType((map[string]*oidc.Claim)(nil), "cluster.OIDCConfig.IdTokenClaimsEntry")
+// SettingsValidateRequest holds the contents of an Argo CD config map to validate
+type SettingsValidateRequest struct {
+	// Name of the config map, either argocd-cm or argocd-rbac-cm
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data                 map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
-
-var fileDescriptor_a480d494da040caa = []byte{
-	// 1194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xd7, 0xd6, 0x69, 0x62, 0x3f, 0x37, 0x75, 0x32, 0x6d, 0xd3, 0xad, 0xd5, 0x6f, 0xe2, 0xaf,
-	0x0f, 0x95, 0x41, 0xb0, 0x6e, 0x52, 0x21, 0x10, 0xa2, 0x82, 0xda, 0xae, 0x5a, 0xd3, 0xb4, 0x0d,
-	0xdb, 0xa6, 0x07, 0x2e, 0xd5, 0x64, 0xf7, 0xb1, 0x59, 0xb2, 0x9e, 0x59, 0xcd, 0xcc, 0x9a, 0xba,
-	0x47, 0x6e, 0x5c, 0xb8, 0xc0, 0xdf, 0xc2, 0x81, 0x7f, 0x00, 0x8e, 0x48, 0xdc, 0x23, 0x64, 0xf1,
-	0x87, 0xa0, 0x99, 0xfd, 0x91, 0xcd, 0xda, 0x2d, 0x48, 0xbd, 0xcd, 0x7c, 0x3e, 0xef, 0xd7, 0xbc,
-	0x79, 0xf3, 0xe6, 0xc1, 0xb6, 0x44, 0x31, 0x45, 0xd1, 0x97, 0xa8, 0x54, 0xc8, 0x02, 0x59, 0x2c,
-	0x9c, 0x58, 0x70, 0xc5, 0xc9, 0x9a, 0x17, 0x25, 0x52, 0xa1, 0x68, 0x5f, 0x0d, 0x78, 0xc0, 0x0d,
-	0xd6, 0xd7, 0xab, 0x94, 0x6e, 0xdf, 0x0c, 0x38, 0x0f, 0x22, 0xec, 0xd3, 0x38, 0xec, 0x53, 0xc6,
-	0xb8, 0xa2, 0x2a, 0xe4, 0x2c, 0x53, 0x6e, 0xef, 0x07, 0xa1, 0x3a, 0x4e, 0x8e, 0x1c, 0x8f, 0x4f,
-	0xfa, 0x54, 0x18, 0xf5, 0x6f, 0xcd, 0xe2, 0x43, 0xcf, 0xef, 0x4f, 0xf7, 0xfa, 0xf1, 0x49, 0xa0,
-	0x35, 0x65, 0x9f, 0xc6, 0x71, 0x14, 0x7a, 0x46, 0xb7, 0x3f, 0xdd, 0xa5, 0x51, 0x7c, 0x4c, 0x77,
-	0xfb, 0x01, 0x32, 0x14, 0x54, 0xa1, 0x9f, 0x59, 0xfb, 0xe2, 0x5f, 0xac, 0x55, 0x4f, 0xc2, 0x43,
-	0xdf, 0xeb, 0x7b, 0x11, 0x0d, 0x27, 0x59, 0x3c, 0xdd, 0x16, 0xac, 0x3f, 0xcb, 0xd8, 0xaf, 0x12,
-	0x14, 0xb3, 0xee, 0x2f, 0x4d, 0xa8, 0xe7, 0x08, 0xb9, 0x01, 0xb5, 0x44, 0x44, 0xb6, 0xd5, 0xb1,
-	0x7a, 0x8d, 0xc1, 0xda, 0xfc, 0x74, 0xa7, 0x76, 0xe8, 0xee, 0xbb, 0x1a, 0x23, 0xb7, 0xa1, 0xe1,
-	0xe3, 0xab, 0x21, 0x67, 0xdf, 0x84, 0x81, 0x7d, 0xa1, 0x63, 0xf5, 0x9a, 0x7b, 0xc4, 0xc9, 0x32,
-	0xe3, 0x8c, 0x72, 0xc6, 0x3d, 0x13, 0x22, 0x43, 0x00, 0xed, 0x3f, 0x53, 0xa9, 0x19, 0x95, 0x2b,
-	0x85, 0xca, 0xd3, 0xf1, 0x68, 0x98, 0x52, 0x83, 0xcb, 0xf3, 0xd3, 0x1d, 0x38, 0xdb, 0xbb, 0x25,
-	0x35, 0xd2, 0x81, 0x26, 0x8d, 0xe3, 0x7d, 0x7a, 0x84, 0xd1, 0x23, 0x9c, 0xd9, 0x2b, 0x3a, 0x32,
-	0xb7, 0x0c, 0x91, 0x17, 0xb0, 0x29, 0x50, 0xf2, 0x44, 0x78, 0xf8, 0x74, 0x8a, 0x42, 0x84, 0x3e,
-	0x4a, 0xfb, 0x62, 0xa7, 0xd6, 0x6b, 0xee, 0xf5, 0x0a, 0x6f, 0xf9, 0x09, 0x1d, 0xb7, 0x2a, 0x7a,
-	0x9f, 0x29, 0x31, 0x73, 0x17, 0x4d, 0x10, 0x07, 0x88, 0x54, 0x54, 0x25, 0x72, 0x40, 0xfd, 0x00,
-	0xef, 0x33, 0x7a, 0x14, 0xa1, 0x6f, 0xaf, 0x76, 0xac, 0x5e, 0xdd, 0x5d, 0xc2, 0x90, 0x87, 0xd0,
-	0x4a, 0x2b, 0xe1, 0x1e, 0xa3, 0xd1, 0x4c, 0x85, 0x9e, 0xb4, 0xd7, 0xcc, 0x99, 0xb7, 0x8b, 0x28,
-	0x1e, 0x9c, 0xe7, 0xb3, 0xe3, 0x56, 0xd5, 0xc8, 0x6b, 0xd8, 0x38, 0x49, 0xa4, 0xe2, 0x93, 0xf0,
-	0x35, 0x3e, 0x8d, 0x4d, 0x35, 0xd9, 0x75, 0x63, 0xea, 0x89, 0x73, 0x56, 0x00, 0x4e, 0x5e, 0x00,
-	0x66, 0xf1, 0xd2, 0xf3, 0x9d, 0xe9, 0x9e, 0x13, 0x9f, 0x04, 0x8e, 0x2e, 0x27, 0xa7, 0x54, 0x4e,
-	0x4e, 0x5e, 0x4e, 0xce, 0xa3, 0x8a, 0x55, 0x77, 0xc1, 0x0f, 0xf9, 0x3f, 0xac, 0x1c, 0x63, 0x14,
-	0xdb, 0x0d, 0xe3, 0x6f, 0xbd, 0x08, 0xfd, 0x21, 0x46, 0xb1, 0x6b, 0x28, 0xf2, 0x1e, 0xac, 0xc5,
-	0x51, 0x12, 0x84, 0x4c, 0xda, 0x60, 0xd2, 0xdc, 0x2a, 0xa4, 0x0e, 0x0c, 0xee, 0xe6, 0xbc, 0xce,
-	0x61, 0x22, 0x51, 0xec, 0x73, 0xbd, 0x1b, 0x85, 0x32, 0xcd, 0x61, 0x33, 0xcd, 0xe1, 0x22, 0x43,
-	0x7e, 0xb4, 0xe0, 0xba, 0x67, 0xb2, 0xf2, 0x98, 0x32, 0x1a, 0xe0, 0x04, 0x99, 0x3a, 0xc8, 0x7c,
-	0x5d, 0x32, 0xbe, 0x9e, 0xbf, 0x5b, 0x06, 0x86, 0x4b, 0x8d, 0xbb, 0x6f, 0x72, 0x4a, 0x3e, 0x80,
-	0xcd, 0x22, 0x45, 0x2f, 0x50, 0x48, 0x73, 0x17, 0xeb, 0x9d, 0x5a, 0xaf, 0xe1, 0x2e, 0x12, 0xa4,
-	0x0d, 0xf5, 0x24, 0x1c, 0x4a, 0x79, 0xe8, 0xee, 0xdb, 0x97, 0x4d, 0xa5, 0x16, 0x7b, 0xd2, 0x83,
-	0x56, 0x12, 0x0e, 0x28, 0x63, 0x28, 0x86, 0x9c, 0x29, 0x64, 0xca, 0x6e, 0x19, 0x91, 0x2a, 0xac,
-	0x4b, 0x3e, 0x87, 0xb4, 0xa1, 0x8d, 0xb4, 0xe4, 0x4b, 0x90, 0xb6, 0x15, 0x53, 0x29, 0xbf, 0xe3,
-	0xc2, 0x3f, 0xa0, 0x4a, 0xa1, 0x60, 0xf6, 0x66, 0x6a, 0xab, 0x02, 0x93, 0x5b, 0x70, 0x59, 0x09,
-	0xea, 0x9d, 0x84, 0x2c, 0x78, 0x8c, 0xea, 0x98, 0xfb, 0x36, 0x31, 0x82, 0x15, 0x54, 0x9f, 0x33,
-	0x77, 0x70, 0x80, 0x62, 0x42, 0x99, 0x8e, 0xef, 0x8a, 0xb9, 0xa7, 0x45, 0x82, 0xbc, 0x0f, 0x1b,
-	0x05, 0xc8, 0x65, 0xa8, 0x53, 0x6c, 0x5f, 0x35, 0x76, 0x17, 0xf0, 0xca, 0x33, 0x72, 0x39, 0x57,
-	0x87, 0x22, 0xb2, 0xaf, 0x19, 0xe9, 0x25, 0x8c, 0x3e, 0x3d, 0xbe, 0x42, 0x2f, 0x7f, 0x6f, 0x5b,
-	0x26, 0x86, 0x32, 0x44, 0x6e, 0xc3, 0x15, 0x8f, 0x33, 0x25, 0x78, 0x14, 0xa1, 0x78, 0x42, 0x27,
-	0x28, 0x63, 0xea, 0xa1, 0x7d, 0xdd, 0x98, 0x5c, 0x46, 0x91, 0xcf, 0xe0, 0x06, 0x8d, 0x63, 0x39,
-	0x66, 0xf7, 0xd8, 0xac, 0x40, 0x73, 0x0f, 0xb6, 0xf1, 0xf0, 0x66, 0x81, 0xf6, 0xcf, 0x16, 0x6c,
-	0x2d, 0x6f, 0x1b, 0x64, 0x03, 0x6a, 0x27, 0x38, 0x4b, 0xfb, 0xa5, 0xab, 0x97, 0xc4, 0x87, 0x8b,
-	0x53, 0x1a, 0x25, 0x98, 0xb5, 0xc8, 0x77, 0x7c, 0xb0, 0x55, 0xb7, 0x6e, 0x6a, 0xfc, 0xd3, 0x0b,
-	0x9f, 0x58, 0xdd, 0x97, 0x70, 0x6d, 0x69, 0x3f, 0x21, 0xdb, 0x00, 0xf9, 0xed, 0x8e, 0x47, 0x59,
-	0x6c, 0x25, 0x44, 0xd7, 0x04, 0x65, 0x9c, 0xcd, 0x74, 0xe9, 0x1e, 0x4a, 0x14, 0xd2, 0xc4, 0x5a,
-	0x77, 0x2b, 0x68, 0x77, 0x04, 0xd7, 0xf3, 0xb6, 0x99, 0x3d, 0x07, 0x17, 0x65, 0xcc, 0x99, 0xc4,
-	0x72, 0x0b, 0xb0, 0xde, 0xde, 0x02, 0xba, 0xbf, 0x5a, 0xb0, 0xa2, 0x9b, 0x07, 0xb1, 0x61, 0xcd,
-	0x3b, 0xa6, 0xe6, 0xf6, 0xd3, 0x98, 0xf2, 0xad, 0x7e, 0x36, 0x7a, 0xf9, 0x1c, 0x5f, 0x29, 0x13,
-	0x4a, 0xc3, 0x2d, 0xf6, 0xe4, 0x2e, 0xc0, 0x51, 0xc8, 0xa8, 0x98, 0x1d, 0x8a, 0x48, 0xda, 0x35,
-	0xe3, 0xec, 0x7f, 0xe7, 0xba, 0x92, 0x33, 0x28, 0xf8, 0xb4, 0x97, 0x97, 0x14, 0xda, 0x77, 0xa1,
-	0x55, 0xa1, 0x97, 0xdc, 0xd9, 0xd5, 0xf2, 0x9d, 0x35, 0xca, 0x39, 0xbe, 0x09, 0xab, 0xe9, 0x79,
-	0x08, 0x81, 0x15, 0x46, 0x27, 0x98, 0xa9, 0x99, 0x75, 0xf7, 0x73, 0x68, 0x14, 0x1f, 0x1f, 0xd9,
-	0x03, 0xf0, 0x38, 0x63, 0xe8, 0x29, 0x2e, 0xf2, 0xac, 0x9c, 0x7d, 0x90, 0xc3, 0x9c, 0x72, 0x4b,
-	0x52, 0xdd, 0x3b, 0xd0, 0x28, 0x88, 0x65, 0x1e, 0x34, 0xa6, 0x66, 0x71, 0x1e, 0x98, 0x59, 0x77,
-	0x7f, 0xa8, 0x41, 0xe9, 0xb3, 0x5c, 0xaa, 0xb6, 0x05, 0xab, 0xa1, 0x94, 0x09, 0x8a, 0x4c, 0x31,
-	0xdb, 0x91, 0x1e, 0xd4, 0xbd, 0x28, 0x44, 0xa6, 0xc6, 0x23, 0xf3, 0x1f, 0x37, 0x06, 0x97, 0xe6,
-	0xa7, 0x3b, 0xf5, 0x61, 0x86, 0xb9, 0x05, 0x4b, 0x76, 0xa1, 0xe9, 0x45, 0x61, 0x4e, 0xa4, 0xdf,
-	0xee, 0xa0, 0x35, 0x3f, 0xdd, 0x69, 0x0e, 0xf7, 0xc7, 0x85, 0x7c, 0x59, 0x46, 0x3b, 0x95, 0x1e,
-	0x8f, 0xb3, 0xcf, 0xb7, 0xe1, 0x66, 0x3b, 0xf2, 0x12, 0xd6, 0x43, 0xff, 0x39, 0x3f, 0x41, 0x36,
-	0x34, 0x83, 0x88, 0xbd, 0x6a, 0x72, 0x73, 0x6b, 0xc9, 0x24, 0xe0, 0x8c, 0xcb, 0x82, 0xe6, 0xba,
-	0x06, 0x9b, 0xf3, 0xd3, 0x9d, 0xf5, 0xf1, 0xa8, 0x84, 0xbb, 0xe7, 0xed, 0xb5, 0x67, 0x40, 0x16,
-	0xf5, 0x96, 0x5c, 0xf3, 0xe3, 0xf3, 0x4f, 0xf3, 0xe3, 0xb7, 0x3e, 0xcd, 0x74, 0x92, 0x72, 0x8a,
-	0x51, 0x50, 0x8f, 0x24, 0x8e, 0xb1, 0x5f, 0xaa, 0x8f, 0xbd, 0xdf, 0x2c, 0x68, 0xe5, 0x6f, 0xe4,
-	0x19, 0x8a, 0x69, 0xe8, 0x21, 0xf9, 0x12, 0x6a, 0x0f, 0x50, 0x91, 0xad, 0x85, 0xd9, 0xc3, 0xcc,
-	0x5b, 0xed, 0xcd, 0x05, 0xbc, 0x6b, 0x7f, 0xff, 0xe7, 0xdf, 0x3f, 0x5d, 0x20, 0x64, 0xc3, 0xcc,
-	0x90, 0xd3, 0xdd, 0x62, 0x7e, 0x23, 0xc7, 0x00, 0x0f, 0xb0, 0xf8, 0x8c, 0xde, 0x64, 0xb2, 0xb3,
-	0x80, 0x57, 0xde, 0x6b, 0xb7, 0x63, 0x3c, 0xb4, 0x89, 0x5d, 0xf5, 0xd0, 0xcf, 0x9e, 0xe9, 0x60,
-	0xf8, 0xfb, 0x7c, 0xdb, 0xfa, 0x63, 0xbe, 0x6d, 0xfd, 0x35, 0xdf, 0xb6, 0xbe, 0xfe, 0xe8, 0xbf,
-	0x4d, 0xad, 0x69, 0xb9, 0x14, 0xc6, 0x8e, 0x56, 0xcd, 0x8c, 0x79, 0xe7, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xc5, 0x72, 0xeb, 0x5e, 0x52, 0x0b, 0x00, 0x00,
+func (m *SettingsValidateRequest) Reset()         { *m = SettingsValidateRequest{} }
+func (m *SettingsValidateRequest) String() string { return proto.CompactTextString(m) }
+func (*SettingsValidateRequest) ProtoMessage()    {}
+func (*SettingsValidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{9}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// SettingsServiceClient is the client API for SettingsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SettingsServiceClient interface {
-	// Get returns Argo CD settings
-	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
-	// Get returns Argo CD plugins
-	GetPlugins(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*SettingsPluginsResponse, error)
+func (m *SettingsValidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type settingsServiceClient struct {
-	cc *grpc.ClientConn
+func (m *SettingsValidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettingsValidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewSettingsServiceClient(cc *grpc.ClientConn) SettingsServiceClient {
-	return &settingsServiceClient{cc}
+func (m *SettingsValidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettingsValidateRequest.Merge(m, src)
+}
+func (m *SettingsValidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettingsValidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettingsValidateRequest.DiscardUnknown(m)
 }
 
-func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error) {
-	out := new(Settings)
-	err := c.cc.Invoke(ctx, "/cluster.SettingsService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SettingsValidateRequest proto.InternalMessageInfo
+
+func (m *SettingsValidateRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *settingsServiceClient) GetPlugins(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*SettingsPluginsResponse, error) {
-	out := new(SettingsPluginsResponse)
-	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetPlugins", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SettingsValidateRequest) GetData() map[string]string {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-// SettingsServiceServer is the server API for SettingsService service.
-type SettingsServiceServer interface {
-	// Get returns Argo CD settings
-	Get(context.Context, *SettingsQuery) (*Settings, error)
-	// Get returns Argo CD plugins
-	GetPlugins(context.Context, *SettingsQuery) (*SettingsPluginsResponse, error)
+type SettingsValidateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedSettingsServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedSettingsServiceServer struct {
+func (m *SettingsValidateResponse) Reset()         { *m = SettingsValidateResponse{} }
+func (m *SettingsValidateResponse) String() string { return proto.CompactTextString(m) }
+func (*SettingsValidateResponse) ProtoMessage()    {}
+func (*SettingsValidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{10}
 }
-
-func (*UnimplementedSettingsServiceServer) Get(ctx context.Context, req *SettingsQuery) (*Settings, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *SettingsValidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedSettingsServiceServer) GetPlugins(ctx context.Context, req *SettingsQuery) (*SettingsPluginsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPlugins not implemented")
+func (m *SettingsValidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettingsValidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterSettingsServiceServer(s *grpc.Server, srv SettingsServiceServer) {
-	s.RegisterService(&_SettingsService_serviceDesc, srv)
+func (m *SettingsValidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettingsValidateResponse.Merge(m, src)
+}
+func (m *SettingsValidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettingsValidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettingsValidateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettingsValidateResponse proto.InternalMessageInfo
+
+// DexStatus is the status of the Dex server managed by Argo CD, as observed by the API server
+type DexStatus struct {
+	// configured is true if Dex is configured in argocd-cm
+	Configured bool `protobuf:"varint,1,opt,name=configured,proto3" json:"configured,omitempty"`
+	// configError is the error in the Dex configuration, if any. Dex keeps running with its previous configuration
+	// until the error is fixed.
+	ConfigError string `protobuf:"bytes,2,opt,name=configError,proto3" json:"configError,omitempty"`
+	// reachable is true if the API server can reach Dex
+	Reachable bool `protobuf:"varint,3,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// error is the error reaching Dex, if any
+	Error      string                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Connectors []*DexConnectorStatus `protobuf:"bytes,5,rep,name=connectors,proto3" json:"connectors,omitempty"`
+	// lastLoginError is the error of the last failed login through Dex, if any
+	LastLoginError       string   `protobuf:"bytes,6,opt,name=lastLoginError,proto3" json:"lastLoginError,omitempty"`
+	LastLoginErrorAt     *v1.Time `protobuf:"bytes,7,opt,name=lastLoginErrorAt,proto3" json:"lastLoginErrorAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _SettingsService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SettingsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DexStatus) Reset()         { *m = DexStatus{} }
+func (m *DexStatus) String() string { return proto.CompactTextString(m) }
+func (*DexStatus) ProtoMessage()    {}
+func (*DexStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{11}
+}
+func (m *DexStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(SettingsServiceServer).Get(ctx, in)
+}
+func (m *DexStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexStatus.Merge(m, src)
+}
+func (m *DexStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexStatus proto.InternalMessageInfo
+
+func (m *DexStatus) GetConfigured() bool {
+	if m != nil {
+		return m.Configured
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cluster.SettingsService/Get",
+	return false
+}
+
+func (m *DexStatus) GetConfigError() string {
+	if m != nil {
+		return m.ConfigError
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SettingsServiceServer).Get(ctx, req.(*SettingsQuery))
+	return ""
+}
+
+func (m *DexStatus) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _SettingsService_GetPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SettingsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DexStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if interceptor == nil {
-		return srv.(SettingsServiceServer).GetPlugins(ctx, in)
+	return ""
+}
+
+func (m *DexStatus) GetConnectors() []*DexConnectorStatus {
+	if m != nil {
+		return m.Connectors
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cluster.SettingsService/GetPlugins",
+	return nil
+}
+
+func (m *DexStatus) GetLastLoginError() string {
+	if m != nil {
+		return m.LastLoginError
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SettingsServiceServer).GetPlugins(ctx, req.(*SettingsQuery))
+	return ""
+}
+
+func (m *DexStatus) GetLastLoginErrorAt() *v1.Time {
+	if m != nil {
+		return m.LastLoginErrorAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _SettingsService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cluster.SettingsService",
-	HandlerType: (*SettingsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _SettingsService_Get_Handler,
-		},
-		{
-			MethodName: "GetPlugins",
-			Handler:    _SettingsService_GetPlugins_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "server/settings/settings.proto",
+// DexConnectorStatus is the status of a Dex connector
+type DexConnectorStatus struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// lastLoginAt is the time of the last successful login through the connector
+	LastLoginAt          *v1.Time `protobuf:"bytes,4,opt,name=lastLoginAt,proto3" json:"lastLoginAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SettingsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DexConnectorStatus) Reset()         { *m = DexConnectorStatus{} }
+func (m *DexConnectorStatus) String() string { return proto.CompactTextString(m) }
+func (*DexConnectorStatus) ProtoMessage()    {}
+func (*DexConnectorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{12}
+}
+func (m *DexConnectorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexConnectorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexConnectorStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SettingsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DexConnectorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexConnectorStatus.Merge(m, src)
+}
+func (m *DexConnectorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexConnectorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexConnectorStatus.DiscardUnknown(m)
 }
 
-func (m *SettingsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_DexConnectorStatus proto.InternalMessageInfo
+
+func (m *DexConnectorStatus) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Settings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DexConnectorStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DexConnectorStatus) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DexConnectorStatus) GetLastLoginAt() *v1.Time {
+	if m != nil {
+		return m.LastLoginAt
 	}
-	if m.AppsInAnyNamespaceEnabled {
-		i--
-		if m.AppsInAnyNamespaceEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+// FeatureFlag is a feature flag of the Argo CD instance
+type FeatureFlag struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// enabled is true if the flag is enabled, either in the featureFlags key of argocd-cm or by default
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// default is true if the flag is enabled when it is not set in argocd-cm
+	Default bool `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
+	// configured is true if the flag is set in the featureFlags key of argocd-cm
+	Configured bool `protobuf:"varint,5,opt,name=configured,proto3" json:"configured,omitempty"`
+	// known is false if the flag is set in argocd-cm but unknown to this version of Argo CD, which ignores it
+	Known                bool     `protobuf:"varint,6,opt,name=known,proto3" json:"known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeatureFlag) Reset()         { *m = FeatureFlag{} }
+func (m *FeatureFlag) String() string { return proto.CompactTextString(m) }
+func (*FeatureFlag) ProtoMessage()    {}
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{13}
+}
+func (m *FeatureFlag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureFlag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+		return b[:n], nil
 	}
-	if len(m.ControllerNamespace) > 0 {
-		i -= len(m.ControllerNamespace)
-		copy(dAtA[i:], m.ControllerNamespace)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.ControllerNamespace)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+}
+func (m *FeatureFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureFlag.Merge(m, src)
+}
+func (m *FeatureFlag) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureFlag proto.InternalMessageInfo
+
+func (m *FeatureFlag) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.ExecEnabled {
-		i--
-		if m.ExecEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	return ""
+}
+
+func (m *FeatureFlag) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.StatusBadgeRootUrl) > 0 {
-		i -= len(m.StatusBadgeRootUrl)
-		copy(dAtA[i:], m.StatusBadgeRootUrl)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.StatusBadgeRootUrl)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	return ""
+}
+
+func (m *FeatureFlag) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if len(m.UiBannerPosition) > 0 {
-		i -= len(m.UiBannerPosition)
-		copy(dAtA[i:], m.UiBannerPosition)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiBannerPosition)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+	return false
+}
+
+func (m *FeatureFlag) GetDefault() bool {
+	if m != nil {
+		return m.Default
 	}
-	if m.UiBannerPermanent {
-		i--
-		if m.UiBannerPermanent {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return false
+}
+
+func (m *FeatureFlag) GetConfigured() bool {
+	if m != nil {
+		return m.Configured
 	}
-	if len(m.TrackingMethod) > 0 {
-		i -= len(m.TrackingMethod)
-		copy(dAtA[i:], m.TrackingMethod)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingMethod)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	return false
+}
+
+func (m *FeatureFlag) GetKnown() bool {
+	if m != nil {
+		return m.Known
 	}
-	if len(m.PasswordPattern) > 0 {
-		i -= len(m.PasswordPattern)
-		copy(dAtA[i:], m.PasswordPattern)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.PasswordPattern)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return false
+}
+
+type FeatureFlagsResponse struct {
+	Flags                []*FeatureFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FeatureFlagsResponse) Reset()         { *m = FeatureFlagsResponse{} }
+func (m *FeatureFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*FeatureFlagsResponse) ProtoMessage()    {}
+func (*FeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{14}
+}
+func (m *FeatureFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeatureFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeatureFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UiBannerURL) > 0 {
-		i -= len(m.UiBannerURL)
-		copy(dAtA[i:], m.UiBannerURL)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiBannerURL)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+}
+func (m *FeatureFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureFlagsResponse.Merge(m, src)
+}
+func (m *FeatureFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeatureFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureFlagsResponse proto.InternalMessageInfo
+
+func (m *FeatureFlagsResponse) GetFlags() []*FeatureFlag {
+	if m != nil {
+		return m.Flags
 	}
-	if len(m.UiBannerContent) > 0 {
-		i -= len(m.UiBannerContent)
-		copy(dAtA[i:], m.UiBannerContent)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiBannerContent)))
-		i--
-		dAtA[i] = 0x7a
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
+	proto.RegisterType((*Settings)(nil), "cluster.Settings")
+	proto.RegisterMapType((map[string]*v1alpha1.ResourceOverride)(nil), "cluster.Settings.ResourceOverridesEntry")
+	proto.RegisterType((*GoogleAnalyticsConfig)(nil), "cluster.GoogleAnalyticsConfig")
+	proto.RegisterType((*SettingsPluginsResponse)(nil), "cluster.SettingsPluginsResponse")
+	proto.RegisterType((*Help)(nil), "cluster.Help")
+	proto.RegisterMapType((map[string]string)(nil), "cluster.Help.BinaryUrlsEntry")
+	proto.RegisterType((*Plugin)(nil), "cluster.Plugin")
+	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
+	proto.RegisterType((*Connector)(nil), "cluster.Connector")
+	proto.RegisterType((*OIDCConfig)(nil), "cluster.OIDCConfig")
+	proto.RegisterMapType((map[string]*oidc.Claim)(nil), "cluster.OIDCConfig.IdTokenClaimsEntry")
+	proto.RegisterType((*SettingsValidateRequest)(nil), "cluster.SettingsValidateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "cluster.SettingsValidateRequest.DataEntry")
+	proto.RegisterType((*SettingsValidateResponse)(nil), "cluster.SettingsValidateResponse")
+	proto.RegisterType((*DexStatus)(nil), "cluster.DexStatus")
+	proto.RegisterType((*DexConnectorStatus)(nil), "cluster.DexConnectorStatus")
+	proto.RegisterType((*FeatureFlag)(nil), "cluster.FeatureFlag")
+	proto.RegisterType((*FeatureFlagsResponse)(nil), "cluster.FeatureFlagsResponse")
+}
+
+func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
+
+var fileDescriptor_a480d494da040caa = []byte{
+	// 1653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x1f, 0x8a, 0xfa, 0x20, 0x1f, 0x2d, 0x53, 0x5a, 0x2b, 0x32, 0xcc, 0xd8, 0x12, 0xc3, 0x76,
+	0x3c, 0xaa, 0xa6, 0x01, 0x23, 0xf5, 0x23, 0x99, 0xb4, 0x69, 0x6b, 0x52, 0x8e, 0xa2, 0x46, 0x8e,
+	0x55, 0xd8, 0xf2, 0xa1, 0x17, 0xcf, 0x0a, 0x78, 0xa6, 0x50, 0x81, 0xbb, 0xe8, 0xee, 0x82, 0x31,
+	0x73, 0xec, 0xad, 0x97, 0xce, 0x74, 0xda, 0x53, 0xfe, 0x80, 0xde, 0xfa, 0x0f, 0xf4, 0xd0, 0x73,
+	0x8f, 0x9d, 0xe9, 0x5d, 0xd3, 0xd1, 0xf4, 0x0f, 0xe9, 0xec, 0x2e, 0x00, 0x42, 0x00, 0xe5, 0x24,
+	0xe3, 0x1b, 0xf6, 0xf7, 0x3e, 0xf7, 0xed, 0xfb, 0x22, 0x61, 0x4b, 0xa2, 0x98, 0xa0, 0xe8, 0x4b,
+	0x54, 0x2a, 0x64, 0x23, 0x99, 0x7f, 0xb8, 0xb1, 0xe0, 0x8a, 0x93, 0x15, 0x3f, 0x4a, 0xa4, 0x42,
+	0xd1, 0xd9, 0x18, 0xf1, 0x11, 0x37, 0x58, 0x5f, 0x7f, 0x59, 0x72, 0xe7, 0xfe, 0x88, 0xf3, 0x51,
+	0x84, 0x7d, 0x1a, 0x87, 0x7d, 0xca, 0x18, 0x57, 0x54, 0x85, 0x9c, 0xa5, 0xc2, 0x9d, 0xe3, 0x51,
+	0xa8, 0xce, 0x93, 0x33, 0xd7, 0xe7, 0xe3, 0x3e, 0x15, 0x46, 0xfc, 0x77, 0xe6, 0xe3, 0x7d, 0x3f,
+	0xe8, 0x4f, 0xf6, 0xfb, 0xf1, 0xc5, 0x48, 0x4b, 0xca, 0x3e, 0x8d, 0xe3, 0x28, 0xf4, 0x8d, 0x6c,
+	0x7f, 0xb2, 0x47, 0xa3, 0xf8, 0x9c, 0xee, 0xf5, 0x47, 0xc8, 0x50, 0x50, 0x85, 0x41, 0xaa, 0xed,
+	0x57, 0xdf, 0xa0, 0xad, 0x7c, 0x13, 0x1e, 0x06, 0x7e, 0xdf, 0x8f, 0x68, 0x38, 0xce, 0xfc, 0xf9,
+	0xf1, 0xc5, 0x47, 0xd2, 0x0d, 0xb9, 0xb6, 0x39, 0xa6, 0xfe, 0x79, 0xc8, 0x50, 0x4c, 0x67, 0x4e,
+	0x8c, 0x51, 0xd1, 0xfe, 0xa4, 0x62, 0xb7, 0xd7, 0x86, 0xd5, 0x67, 0xa9, 0xce, 0xdf, 0x24, 0x28,
+	0xa6, 0xbd, 0xaf, 0x6f, 0x41, 0x23, 0x43, 0xc8, 0x3d, 0xa8, 0x27, 0x22, 0x72, 0x6a, 0xdd, 0xda,
+	0x4e, 0x73, 0xb0, 0x72, 0x75, 0xb9, 0x5d, 0x3f, 0xf5, 0x8e, 0x3d, 0x8d, 0x91, 0x0f, 0xa0, 0x19,
+	0xe0, 0xeb, 0x21, 0x67, 0xaf, 0xc2, 0x91, 0xb3, 0xd0, 0xad, 0xed, 0xb4, 0xf6, 0x89, 0x9b, 0xc6,
+	0xd3, 0x3d, 0xc8, 0x28, 0xde, 0x8c, 0x89, 0x0c, 0x01, 0xb4, 0xd7, 0xa9, 0x48, 0xdd, 0x88, 0xdc,
+	0xc9, 0x45, 0x9e, 0x1e, 0x1d, 0x0c, 0x2d, 0x69, 0x70, 0xfb, 0xea, 0x72, 0x1b, 0x66, 0x67, 0xaf,
+	0x20, 0x46, 0xba, 0xd0, 0xa2, 0x71, 0x7c, 0x4c, 0xcf, 0x30, 0xfa, 0x1c, 0xa7, 0xce, 0xa2, 0xf6,
+	0xcc, 0x2b, 0x42, 0xe4, 0x05, 0xac, 0x0b, 0x94, 0x3c, 0x11, 0x3e, 0x3e, 0x9d, 0xa0, 0x10, 0x61,
+	0x80, 0xd2, 0x59, 0xea, 0xd6, 0x77, 0x5a, 0xfb, 0x3b, 0xb9, 0xb5, 0xec, 0x86, 0xae, 0x57, 0x66,
+	0x7d, 0xcc, 0x94, 0x98, 0x7a, 0x55, 0x15, 0xc4, 0x05, 0x22, 0x15, 0x55, 0x89, 0x1c, 0xd0, 0x60,
+	0x84, 0x8f, 0x19, 0x3d, 0x8b, 0x30, 0x70, 0x96, 0xbb, 0xb5, 0x9d, 0x86, 0x37, 0x87, 0x42, 0x3e,
+	0x83, 0xb6, 0xcd, 0x9f, 0x47, 0x8c, 0x46, 0x53, 0x15, 0xfa, 0xd2, 0x59, 0x31, 0x77, 0xde, 0xca,
+	0xbd, 0x38, 0xbc, 0x4e, 0x4f, 0xaf, 0x5b, 0x16, 0x23, 0x5f, 0xc1, 0xda, 0x45, 0x22, 0x15, 0x1f,
+	0x87, 0x5f, 0xe1, 0xd3, 0xd8, 0xe4, 0xa0, 0xd3, 0x30, 0xaa, 0xbe, 0x70, 0x67, 0x69, 0xe3, 0x66,
+	0x69, 0x63, 0x3e, 0x5e, 0xfa, 0x81, 0x3b, 0xd9, 0x77, 0xe3, 0x8b, 0x91, 0xab, 0xdf, 0xdf, 0x2d,
+	0x24, 0xa1, 0x9b, 0x25, 0xa1, 0xfb, 0x79, 0x49, 0xab, 0x57, 0xb1, 0x43, 0xde, 0x83, 0xc5, 0x73,
+	0x8c, 0x62, 0xa7, 0x69, 0xec, 0xad, 0xe6, 0xae, 0x7f, 0x86, 0x51, 0xec, 0x19, 0x12, 0xf9, 0x01,
+	0xac, 0xc4, 0x51, 0x32, 0x0a, 0x99, 0x74, 0xc0, 0x84, 0xb9, 0x9d, 0x73, 0x9d, 0x18, 0xdc, 0xcb,
+	0xe8, 0x3a, 0x86, 0x89, 0x44, 0x71, 0xcc, 0xf5, 0xe9, 0x20, 0x94, 0x36, 0x86, 0x2d, 0x1b, 0xc3,
+	0x2a, 0x85, 0xfc, 0xa9, 0x06, 0x77, 0x7d, 0x13, 0x95, 0x27, 0x94, 0xd1, 0x11, 0x8e, 0x91, 0xa9,
+	0x93, 0xd4, 0xd6, 0x2d, 0x63, 0xeb, 0xf9, 0xdb, 0x45, 0x60, 0x38, 0x57, 0xb9, 0x77, 0x93, 0x51,
+	0xf2, 0x43, 0x58, 0xcf, 0x43, 0xf4, 0x02, 0x85, 0x34, 0x6f, 0xb1, 0xda, 0xad, 0xef, 0x34, 0xbd,
+	0x2a, 0x81, 0x74, 0xa0, 0x91, 0x84, 0x43, 0x29, 0x4f, 0xbd, 0x63, 0xe7, 0xb6, 0xc9, 0xd4, 0xfc,
+	0x4c, 0x76, 0xa0, 0x9d, 0x84, 0x03, 0xca, 0x18, 0x8a, 0x21, 0x67, 0x0a, 0x99, 0x72, 0xda, 0x86,
+	0xa5, 0x0c, 0xeb, 0x94, 0xcf, 0x20, 0xad, 0x68, 0xcd, 0xa6, 0x7c, 0x01, 0xd2, 0xba, 0x62, 0x2a,
+	0xe5, 0x97, 0x5c, 0x04, 0x27, 0x54, 0x29, 0x14, 0xcc, 0x59, 0xb7, 0xba, 0x4a, 0x30, 0x79, 0x08,
+	0xb7, 0x95, 0xa0, 0xfe, 0x45, 0xc8, 0x46, 0x4f, 0x50, 0x9d, 0xf3, 0xc0, 0x21, 0x86, 0xb1, 0x84,
+	0xea, 0x7b, 0x66, 0x06, 0x4e, 0x50, 0x8c, 0x29, 0xd3, 0xfe, 0xdd, 0x31, 0xef, 0x54, 0x25, 0x90,
+	0x5d, 0x58, 0xcb, 0x41, 0x2e, 0x43, 0x1d, 0x62, 0x67, 0xc3, 0xe8, 0xad, 0xe0, 0xa5, 0x32, 0xf2,
+	0x38, 0x57, 0xa7, 0x22, 0x72, 0xde, 0x31, 0xdc, 0x73, 0x28, 0xfa, 0xf6, 0xf8, 0x1a, 0xfd, 0xac,
+	0xde, 0x36, 0x8d, 0x0f, 0x45, 0x88, 0x7c, 0x00, 0x77, 0x7c, 0xce, 0x94, 0xe0, 0x51, 0x84, 0xe2,
+	0x0b, 0x3a, 0x46, 0x19, 0x53, 0x1f, 0x9d, 0xbb, 0x46, 0xe5, 0x3c, 0x12, 0xf9, 0x39, 0xdc, 0xa3,
+	0x71, 0x2c, 0x8f, 0xd8, 0x23, 0x36, 0xcd, 0xd1, 0xcc, 0x82, 0x63, 0x2c, 0xdc, 0xcc, 0xa0, 0x6f,
+	0x4b, 0x13, 0xc5, 0xc7, 0x26, 0x95, 0x4e, 0x68, 0x22, 0x31, 0x70, 0xee, 0x19, 0xa1, 0x0a, 0x4e,
+	0x7e, 0x0a, 0x9b, 0x65, 0xcc, 0x43, 0x2a, 0x39, 0x73, 0x3a, 0xc6, 0xbd, 0x1b, 0xa8, 0x9d, 0xbf,
+	0xd6, 0x60, 0x73, 0x7e, 0x6b, 0x22, 0x6b, 0x50, 0xbf, 0xc0, 0xa9, 0xed, 0xc9, 0x9e, 0xfe, 0x24,
+	0x01, 0x2c, 0x4d, 0x68, 0x94, 0x60, 0xda, 0x86, 0xdf, 0xb2, 0x29, 0x94, 0xcd, 0x7a, 0x56, 0xf9,
+	0xc7, 0x0b, 0x1f, 0xd5, 0x7a, 0x2f, 0xe1, 0x9d, 0xb9, 0x3d, 0x8b, 0x6c, 0x01, 0x64, 0x19, 0x74,
+	0x74, 0x90, 0xfa, 0x56, 0x40, 0x74, 0xde, 0x51, 0xc6, 0xd9, 0x54, 0x97, 0xc7, 0xa9, 0x44, 0x21,
+	0x8d, 0xaf, 0x0d, 0xaf, 0x84, 0xf6, 0x0e, 0xe0, 0x6e, 0xd6, 0x9a, 0xd3, 0x92, 0xf3, 0x50, 0xc6,
+	0x9c, 0x49, 0x2c, 0xb6, 0x99, 0xda, 0x9b, 0xdb, 0x4c, 0xef, 0x1f, 0x35, 0x58, 0xd4, 0x0d, 0x8a,
+	0x38, 0xb0, 0xe2, 0x9f, 0x53, 0x93, 0x61, 0xd6, 0xa7, 0xec, 0xa8, 0x4b, 0x53, 0x7f, 0x3e, 0xc7,
+	0xd7, 0xca, 0xb8, 0xd2, 0xf4, 0xf2, 0x33, 0xf9, 0x04, 0xe0, 0x2c, 0x64, 0x54, 0x4c, 0x4f, 0x45,
+	0x24, 0x9d, 0xba, 0x31, 0xf6, 0xe0, 0x5a, 0xe7, 0x73, 0x07, 0x39, 0xdd, 0xce, 0x8b, 0x82, 0x40,
+	0xe7, 0x13, 0x68, 0x97, 0xc8, 0x73, 0xde, 0x6c, 0xa3, 0xf8, 0x66, 0xcd, 0x62, 0x8c, 0xef, 0xc3,
+	0xb2, 0xbd, 0x0f, 0x21, 0xb0, 0xc8, 0xe8, 0x18, 0x53, 0x31, 0xf3, 0xdd, 0xfb, 0x25, 0x34, 0xf3,
+	0xe1, 0x4a, 0xf6, 0x01, 0x7c, 0xce, 0x18, 0xfa, 0x8a, 0x8b, 0x2c, 0x2a, 0xb3, 0x21, 0x3c, 0xcc,
+	0x48, 0x5e, 0x81, 0xab, 0x37, 0x84, 0x66, 0x4e, 0x98, 0x67, 0x41, 0x63, 0x6a, 0x1a, 0x67, 0x8e,
+	0x99, 0x6f, 0x72, 0x1b, 0x16, 0xc2, 0xc0, 0x8c, 0xec, 0xa6, 0xb7, 0x10, 0x06, 0xbd, 0x3f, 0xd6,
+	0xa1, 0x30, 0xa0, 0xe7, 0xaa, 0xd9, 0x84, 0xe5, 0x50, 0xca, 0x04, 0x45, 0xaa, 0x28, 0x3d, 0x91,
+	0x1d, 0x68, 0xf8, 0x51, 0x88, 0x4c, 0x1d, 0x1d, 0x58, 0x85, 0x83, 0x5b, 0x57, 0x97, 0xdb, 0x8d,
+	0x61, 0x8a, 0x79, 0x39, 0x95, 0xec, 0x41, 0xcb, 0x8f, 0xc2, 0x8c, 0x60, 0x47, 0xfd, 0xa0, 0x7d,
+	0x75, 0xb9, 0xdd, 0x1a, 0x1e, 0x1f, 0xe5, 0xfc, 0x45, 0x1e, 0x6d, 0x54, 0xfa, 0x3c, 0x4e, 0x07,
+	0x7e, 0xd3, 0x4b, 0x4f, 0xe4, 0x25, 0xac, 0x86, 0xc1, 0x73, 0x7e, 0x81, 0x6c, 0x68, 0x56, 0x26,
+	0x67, 0xd9, 0xc4, 0xea, 0xe1, 0x9c, 0xed, 0xc3, 0x3d, 0x2a, 0x32, 0x9a, 0xe7, 0x1b, 0xac, 0x5f,
+	0x5d, 0x6e, 0xaf, 0x1e, 0x1d, 0x14, 0x70, 0xef, 0xba, 0xbe, 0xce, 0x14, 0x48, 0x55, 0x6e, 0xce,
+	0xb3, 0x3f, 0xb9, 0x5e, 0xaa, 0x1f, 0xbe, 0xb1, 0x54, 0xed, 0xce, 0xe7, 0xe6, 0x4b, 0xab, 0x5e,
+	0x83, 0x5c, 0xa3, 0xbf, 0x98, 0x2f, 0x7f, 0xab, 0xcd, 0x6a, 0xe6, 0x05, 0x8d, 0xc2, 0x80, 0x2a,
+	0xf4, 0xf0, 0xf7, 0x09, 0x4a, 0x35, 0xf7, 0x61, 0x7e, 0x01, 0x8b, 0x01, 0x55, 0xd4, 0x59, 0x30,
+	0x21, 0xd8, 0xad, 0xac, 0x44, 0x25, 0x1d, 0xee, 0x01, 0x55, 0xd4, 0x26, 0xb9, 0x91, 0xeb, 0x7c,
+	0x08, 0xcd, 0x1c, 0xfa, 0x4e, 0x89, 0xdd, 0x01, 0xa7, 0x6a, 0xc3, 0x16, 0x77, 0xef, 0x9f, 0x0b,
+	0x26, 0xaf, 0x9f, 0x99, 0xfe, 0xaf, 0xbb, 0x89, 0x1d, 0xc0, 0x89, 0xc0, 0xc0, 0x28, 0x6f, 0x78,
+	0x05, 0x44, 0xcf, 0x04, 0x7b, 0x7a, 0x2c, 0x04, 0xcf, 0x12, 0xac, 0x08, 0x91, 0xfb, 0xd0, 0x14,
+	0x48, 0xfd, 0x73, 0xdd, 0xb1, 0x4d, 0x9a, 0x35, 0xbc, 0x19, 0xa0, 0x7d, 0x44, 0x23, 0x69, 0xd7,
+	0x47, 0x7b, 0x20, 0x3f, 0xbb, 0x56, 0x4d, 0x76, 0x63, 0x7c, 0xb7, 0xb4, 0xd2, 0x5a, 0xaa, 0x75,
+	0xb3, 0x58, 0x56, 0xba, 0xc1, 0x45, 0x54, 0x2a, 0xb3, 0xbf, 0x58, 0xaf, 0x96, 0xed, 0x60, 0xbd,
+	0x8e, 0x92, 0x17, 0xb0, 0x76, 0x1d, 0x79, 0xa4, 0xd2, 0xb5, 0x70, 0xd7, 0xb5, 0x0b, 0xbc, 0x5b,
+	0x5c, 0xe0, 0x67, 0xbd, 0x5a, 0x2f, 0xf0, 0xee, 0x64, 0xcf, 0x7d, 0x1e, 0x8e, 0xd1, 0xab, 0xe8,
+	0xe8, 0x7d, 0x5d, 0x03, 0x52, 0x75, 0x31, 0x2d, 0xdc, 0x5a, 0x56, 0xb8, 0x79, 0x42, 0x2c, 0xcc,
+	0x29, 0xf8, 0x7a, 0xa1, 0xe0, 0x8f, 0xa1, 0x95, 0x9b, 0x78, 0xa4, 0x4c, 0x9c, 0xbe, 0x9b, 0x87,
+	0x45, 0xf1, 0xde, 0xdf, 0x6b, 0xd0, 0xfa, 0x14, 0xa9, 0x4a, 0x04, 0x7e, 0x1a, 0xd1, 0xf9, 0xfd,
+	0xa2, 0x0b, 0xad, 0x00, 0xa5, 0x2f, 0x42, 0xb3, 0x78, 0x66, 0x6f, 0x5a, 0x80, 0x74, 0x33, 0xc7,
+	0x74, 0x46, 0xdb, 0x17, 0xcd, 0x8e, 0x9a, 0x12, 0xe0, 0x2b, 0x9a, 0x44, 0xd6, 0xd3, 0x86, 0x97,
+	0x1d, 0x4b, 0x99, 0xb4, 0x54, 0xc9, 0xa4, 0x0d, 0x58, 0xba, 0x60, 0xfc, 0x4b, 0x96, 0xee, 0xf1,
+	0xf6, 0xd0, 0x1b, 0xc0, 0x46, 0xc1, 0xdd, 0xd9, 0x08, 0xda, 0x85, 0xa5, 0x57, 0x1a, 0x48, 0x5b,
+	0xed, 0x46, 0x9e, 0x1c, 0x05, 0x6e, 0xcf, 0xb2, 0xec, 0xff, 0x79, 0x11, 0xda, 0x59, 0xba, 0x3f,
+	0x43, 0x31, 0x09, 0x7d, 0x24, 0xbf, 0x86, 0xfa, 0x21, 0x2a, 0xb2, 0x59, 0xa9, 0x39, 0xf3, 0xd3,
+	0xab, 0xb3, 0x5e, 0xc1, 0x7b, 0xce, 0x1f, 0xfe, 0xf3, 0xbf, 0xbf, 0x2c, 0x10, 0xb2, 0x66, 0x7e,
+	0x84, 0x4e, 0xf6, 0xf2, 0x1f, 0x80, 0xe4, 0x1c, 0xe0, 0x10, 0xf3, 0xbd, 0xf4, 0x26, 0x95, 0xdd,
+	0x0a, 0x5e, 0x1a, 0xab, 0xbd, 0xae, 0xb1, 0xd0, 0x21, 0x4e, 0xd9, 0x42, 0x3f, 0x5b, 0xda, 0x13,
+	0x68, 0x64, 0xf5, 0x4a, 0xba, 0xdf, 0xd4, 0x2e, 0x3a, 0xef, 0xbd, 0x81, 0x23, 0x35, 0xf9, 0x7d,
+	0x63, 0x72, 0xab, 0x77, 0xaf, 0x62, 0x72, 0x92, 0xb2, 0x7e, 0x5c, 0xdb, 0x25, 0x2f, 0xe1, 0xd6,
+	0x21, 0xaa, 0x59, 0x53, 0xb8, 0xe9, 0x8a, 0xd7, 0x7e, 0x75, 0x5a, 0xde, 0xde, 0xf7, 0x8c, 0x85,
+	0x07, 0xe4, 0xdd, 0x8a, 0x85, 0x00, 0x5f, 0xf7, 0xed, 0x96, 0x49, 0x62, 0x68, 0x1f, 0xa2, 0x2a,
+	0x3e, 0xf4, 0x8d, 0x36, 0x1e, 0xcc, 0x7b, 0xe9, 0x59, 0x0c, 0x1f, 0x1a, 0x73, 0x5d, 0xb2, 0x55,
+	0x31, 0xf7, 0xca, 0xb2, 0xbf, 0x6f, 0x72, 0x62, 0x30, 0xfc, 0xd7, 0xd5, 0x56, 0xed, 0xdf, 0x57,
+	0x5b, 0xb5, 0xff, 0x5e, 0x6d, 0xd5, 0x7e, 0xfb, 0x93, 0x6f, 0xf7, 0x07, 0x82, 0x9d, 0x87, 0xb9,
+	0xca, 0xb3, 0x65, 0xf3, 0xc3, 0xfd, 0x47, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xfc, 0x34,
+	0x98, 0xdd, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SettingsServiceClient is the client API for SettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SettingsServiceClient interface {
+	// Get returns Argo CD settings
+	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
+	// Get returns Argo CD plugins
+	GetPlugins(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*SettingsPluginsResponse, error)
+	// Validate validates the contents of an Argo CD config map before it is saved
+	Validate(ctx context.Context, in *SettingsValidateRequest, opts ...grpc.CallOption) (*SettingsValidateResponse, error)
+	// GetDexStatus returns the status of the Dex server and its connectors
+	GetDexStatus(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*DexStatus, error)
+	// GetFeatureFlags returns the feature flags of the Argo CD instance and whether they are enabled
+	GetFeatureFlags(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*FeatureFlagsResponse, error)
+}
+
+type settingsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSettingsServiceClient(cc *grpc.ClientConn) SettingsServiceClient {
+	return &settingsServiceClient{cc}
+}
+
+func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error) {
+	out := new(Settings)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.UiCssURL) > 0 {
-		i -= len(m.UiCssURL)
-		copy(dAtA[i:], m.UiCssURL)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiCssURL)))
-		i--
-		dAtA[i] = 0x72
+	return out, nil
+}
+
+func (c *settingsServiceClient) GetPlugins(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*SettingsPluginsResponse, error) {
+	out := new(SettingsPluginsResponse)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetPlugins", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.KustomizeVersions) > 0 {
-		for iNdEx := len(m.KustomizeVersions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KustomizeVersions[iNdEx])
-			copy(dAtA[i:], m.KustomizeVersions[iNdEx])
-			i = encodeVarintSettings(dAtA, i, uint64(len(m.KustomizeVersions[iNdEx])))
-			i--
-			dAtA[i] = 0x6a
-		}
+	return out, nil
+}
+
+func (c *settingsServiceClient) Validate(ctx context.Context, in *SettingsValidateRequest, opts ...grpc.CallOption) (*SettingsValidateResponse, error) {
+	out := new(SettingsValidateResponse)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ConfigManagementPlugins) > 0 {
-		for iNdEx := len(m.ConfigManagementPlugins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConfigManagementPlugins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettings(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if m.UserLoginsDisabled {
-		i--
-		if m.UserLoginsDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.Plugins) > 0 {
-		for iNdEx := len(m.Plugins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plugins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettings(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.Help != nil {
-		{
-			size, err := m.Help.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSettings(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.KustomizeOptions != nil {
-		{
-			size, err := m.KustomizeOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSettings(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.GoogleAnalytics != nil {
-		{
-			size, err := m.GoogleAnalytics.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSettings(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.StatusBadgeEnabled {
-		i--
-		if m.StatusBadgeEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ResourceOverrides) > 0 {
-		for k := range m.ResourceOverrides {
-			v := m.ResourceOverrides[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintSettings(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.AppLabelKey) > 0 {
-		i -= len(m.AppLabelKey)
-		copy(dAtA[i:], m.AppLabelKey)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.AppLabelKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.OIDCConfig != nil {
-		{
-			size, err := m.OIDCConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSettings(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DexConfig != nil {
-		{
-			size, err := m.DexConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSettings(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.URL) > 0 {
-		i -= len(m.URL)
-		copy(dAtA[i:], m.URL)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.URL)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *settingsServiceClient) GetDexStatus(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*DexStatus, error) {
+	out := new(DexStatus)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetDexStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GoogleAnalyticsConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *settingsServiceClient) GetFeatureFlags(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*FeatureFlagsResponse, error) {
+	out := new(FeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, "/cluster.SettingsService/GetFeatureFlags", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GoogleAnalyticsConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SettingsServiceServer is the server API for SettingsService service.
+type SettingsServiceServer interface {
+	// Get returns Argo CD settings
+	Get(context.Context, *SettingsQuery) (*Settings, error)
+	// Get returns Argo CD plugins
+	GetPlugins(context.Context, *SettingsQuery) (*SettingsPluginsResponse, error)
+	// Validate validates the contents of an Argo CD config map before it is saved
+	Validate(context.Context, *SettingsValidateRequest) (*SettingsValidateResponse, error)
+	// GetDexStatus returns the status of the Dex server and its connectors
+	GetDexStatus(context.Context, *SettingsQuery) (*DexStatus, error)
+	// GetFeatureFlags returns the feature flags of the Argo CD instance and whether they are enabled
+	GetFeatureFlags(context.Context, *SettingsQuery) (*FeatureFlagsResponse, error)
 }
 
-func (m *GoogleAnalyticsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedSettingsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSettingsServiceServer struct {
+}
+
+func (*UnimplementedSettingsServiceServer) Get(ctx context.Context, req *SettingsQuery) (*Settings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedSettingsServiceServer) GetPlugins(ctx context.Context, req *SettingsQuery) (*SettingsPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlugins not implemented")
+}
+func (*UnimplementedSettingsServiceServer) Validate(ctx context.Context, req *SettingsValidateRequest) (*SettingsValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (*UnimplementedSettingsServiceServer) GetDexStatus(ctx context.Context, req *SettingsQuery) (*DexStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDexStatus not implemented")
+}
+func (*UnimplementedSettingsServiceServer) GetFeatureFlags(ctx context.Context, req *SettingsQuery) (*FeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeatureFlags not implemented")
+}
+
+func RegisterSettingsServiceServer(s *grpc.Server, srv SettingsServiceServer) {
+	s.RegisterService(&_SettingsService_serviceDesc, srv)
+}
+
+func _SettingsService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AnonymizeUsers {
-		i--
-		if m.AnonymizeUsers {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).Get(ctx, in)
 	}
-	if len(m.TrackingID) > 0 {
-		i -= len(m.TrackingID)
-		copy(dAtA[i:], m.TrackingID)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingID)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/Get",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).Get(ctx, req.(*SettingsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SettingsPluginsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _SettingsService_GetPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/GetPlugins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetPlugins(ctx, req.(*SettingsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SettingsPluginsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _SettingsService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).Validate(ctx, req.(*SettingsValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SettingsPluginsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Plugins) > 0 {
-		for iNdEx := len(m.Plugins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plugins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettings(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Help) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _SettingsService_GetDexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Help) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Help) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.BinaryUrls) > 0 {
-		for k := range m.BinaryUrls {
-			v := m.BinaryUrls[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSettings(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetDexStatus(ctx, in)
 	}
-	if len(m.ChatText) > 0 {
-		i -= len(m.ChatText)
-		copy(dAtA[i:], m.ChatText)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.ChatText)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/GetDexStatus",
 	}
-	if len(m.ChatUrl) > 0 {
-		i -= len(m.ChatUrl)
-		copy(dAtA[i:], m.ChatUrl)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.ChatUrl)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetDexStatus(ctx, req.(*SettingsQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Plugin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _SettingsService_GetFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Plugin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Plugin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).GetFeatureFlags(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/GetFeatureFlags",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DexConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).GetFeatureFlags(ctx, req.(*SettingsQuery))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DexConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DexConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Connectors) > 0 {
-		for iNdEx := len(m.Connectors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Connectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSettings(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var _SettingsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.SettingsService",
+	HandlerType: (*SettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _SettingsService_Get_Handler,
+		},
+		{
+			MethodName: "GetPlugins",
+			Handler:    _SettingsService_GetPlugins_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _SettingsService_Validate_Handler,
+		},
+		{
+			MethodName: "GetDexStatus",
+			Handler:    _SettingsService_GetDexStatus_Handler,
+		},
+		{
+			MethodName: "GetFeatureFlags",
+			Handler:    _SettingsService_GetFeatureFlags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/settings/settings.proto",
 }
 
-func (m *Connector) Marshal() (dAtA []byte, err error) {
+func (m *SettingsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1474,12 +1524,12 @@ func (m *Connector) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Connector) MarshalTo(dAtA []byte) (int, error) {
+func (m *SettingsQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Connector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SettingsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1488,24 +1538,10 @@ func (m *Connector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
+func (m *Settings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1515,12 +1551,12 @@ func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OIDCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1529,468 +1565,2047 @@ func (m *OIDCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.IDTokenClaims) > 0 {
-		for k := range m.IDTokenClaims {
-			v := m.IDTokenClaims[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintSettings(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Scopes) > 0 {
-		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Scopes[iNdEx])
-			copy(dAtA[i:], m.Scopes[iNdEx])
-			i = encodeVarintSettings(dAtA, i, uint64(len(m.Scopes[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.CLIClientID) > 0 {
-		i -= len(m.CLIClientID)
-		copy(dAtA[i:], m.CLIClientID)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.CLIClientID)))
+	if len(m.AutomationPausedReason) > 0 {
+		i -= len(m.AutomationPausedReason)
+		copy(dAtA[i:], m.AutomationPausedReason)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.AutomationPausedReason)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.ClientID)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xd2
 	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Issuer)))
+	if m.AutomationPaused {
 		i--
-		dAtA[i] = 0x12
+		if m.AutomationPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+	if m.AppsInAnyNamespaceEnabled {
 		i--
-		dAtA[i] = 0xa
+		if m.AppsInAnyNamespaceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintSettings(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSettings(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.ControllerNamespace) > 0 {
+		i -= len(m.ControllerNamespace)
+		copy(dAtA[i:], m.ControllerNamespace)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ControllerNamespace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *SettingsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ExecEnabled {
+		i--
+		if m.ExecEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.StatusBadgeRootUrl) > 0 {
+		i -= len(m.StatusBadgeRootUrl)
+		copy(dAtA[i:], m.StatusBadgeRootUrl)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.StatusBadgeRootUrl)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return n
-}
-
-func (m *Settings) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UiBannerPosition) > 0 {
+		i -= len(m.UiBannerPosition)
+		copy(dAtA[i:], m.UiBannerPosition)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiBannerPosition)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	if m.UiBannerPermanent {
+		i--
+		if m.UiBannerPermanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.DexConfig != nil {
-		l = m.DexConfig.Size()
-		n += 1 + l + sovSettings(uint64(l))
+	if len(m.TrackingMethod) > 0 {
+		i -= len(m.TrackingMethod)
+		copy(dAtA[i:], m.TrackingMethod)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingMethod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	if m.OIDCConfig != nil {
-		l = m.OIDCConfig.Size()
-		n += 1 + l + sovSettings(uint64(l))
+	if len(m.PasswordPattern) > 0 {
+		i -= len(m.PasswordPattern)
+		copy(dAtA[i:], m.PasswordPattern)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.PasswordPattern)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = len(m.AppLabelKey)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	if len(m.UiBannerURL) > 0 {
+		i -= len(m.UiBannerURL)
+		copy(dAtA[i:], m.UiBannerURL)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiBannerURL)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if len(m.ResourceOverrides) > 0 {
-		for k, v := range m.ResourceOverrides {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSettings(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
-		}
+	if len(m.UiBannerContent) > 0 {
+		i -= len(m.UiBannerContent)
+		copy(dAtA[i:], m.UiBannerContent)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiBannerContent)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.StatusBadgeEnabled {
-		n += 2
+	if len(m.UiCssURL) > 0 {
+		i -= len(m.UiCssURL)
+		copy(dAtA[i:], m.UiCssURL)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.UiCssURL)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.GoogleAnalytics != nil {
-		l = m.GoogleAnalytics.Size()
-		n += 1 + l + sovSettings(uint64(l))
+	if len(m.KustomizeVersions) > 0 {
+		for iNdEx := len(m.KustomizeVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KustomizeVersions[iNdEx])
+			copy(dAtA[i:], m.KustomizeVersions[iNdEx])
+			i = encodeVarintSettings(dAtA, i, uint64(len(m.KustomizeVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	if m.KustomizeOptions != nil {
-		l = m.KustomizeOptions.Size()
-		n += 1 + l + sovSettings(uint64(l))
+	if len(m.ConfigManagementPlugins) > 0 {
+		for iNdEx := len(m.ConfigManagementPlugins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigManagementPlugins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	if m.Help != nil {
-		l = m.Help.Size()
-		n += 1 + l + sovSettings(uint64(l))
+	if m.UserLoginsDisabled {
+		i--
+		if m.UserLoginsDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
 	if len(m.Plugins) > 0 {
-		for _, e := range m.Plugins {
-			l = e.Size()
-			n += 1 + l + sovSettings(uint64(l))
+		for iNdEx := len(m.Plugins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plugins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	if m.UserLoginsDisabled {
-		n += 2
+	if m.Help != nil {
+		{
+			size, err := m.Help.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if len(m.ConfigManagementPlugins) > 0 {
-		for _, e := range m.ConfigManagementPlugins {
-			l = e.Size()
-			n += 1 + l + sovSettings(uint64(l))
+	if m.KustomizeOptions != nil {
+		{
+			size, err := m.KustomizeOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.KustomizeVersions) > 0 {
-		for _, s := range m.KustomizeVersions {
-			l = len(s)
-			n += 1 + l + sovSettings(uint64(l))
+	if m.GoogleAnalytics != nil {
+		{
+			size, err := m.GoogleAnalytics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.UiCssURL)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
-	}
-	l = len(m.UiBannerContent)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
-	}
-	l = len(m.UiBannerURL)
-	if l > 0 {
-		n += 2 + l + sovSettings(uint64(l))
-	}
-	l = len(m.PasswordPattern)
-	if l > 0 {
-		n += 2 + l + sovSettings(uint64(l))
-	}
-	l = len(m.TrackingMethod)
-	if l > 0 {
-		n += 2 + l + sovSettings(uint64(l))
-	}
-	if m.UiBannerPermanent {
-		n += 3
-	}
-	l = len(m.UiBannerPosition)
-	if l > 0 {
-		n += 2 + l + sovSettings(uint64(l))
+	if m.StatusBadgeEnabled {
+		i--
+		if m.StatusBadgeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.StatusBadgeRootUrl)
-	if l > 0 {
-		n += 2 + l + sovSettings(uint64(l))
+	if len(m.ResourceOverrides) > 0 {
+		for k := range m.ResourceOverrides {
+			v := m.ResourceOverrides[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSettings(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.ExecEnabled {
-		n += 3
+	if len(m.AppLabelKey) > 0 {
+		i -= len(m.AppLabelKey)
+		copy(dAtA[i:], m.AppLabelKey)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.AppLabelKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ControllerNamespace)
-	if l > 0 {
-		n += 2 + l + sovSettings(uint64(l))
+	if m.OIDCConfig != nil {
+		{
+			size, err := m.OIDCConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AppsInAnyNamespaceEnabled {
-		n += 3
+	if m.DexConfig != nil {
+		{
+			size, err := m.DexConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GoogleAnalyticsConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GoogleAnalyticsConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GoogleAnalyticsConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GoogleAnalyticsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TrackingID)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.AnonymizeUsers {
-		n += 2
+		i--
+		if m.AnonymizeUsers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TrackingID) > 0 {
+		i -= len(m.TrackingID)
+		copy(dAtA[i:], m.TrackingID)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SettingsPluginsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Plugins) > 0 {
-		for _, e := range m.Plugins {
-			l = e.Size()
-			n += 1 + l + sovSettings(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SettingsPluginsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Help) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SettingsPluginsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettingsPluginsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChatUrl)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
-	}
-	l = len(m.ChatText)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.BinaryUrls) > 0 {
-		for k, v := range m.BinaryUrls {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + 1 + len(v) + sovSettings(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+	if len(m.Plugins) > 0 {
+		for iNdEx := len(m.Plugins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plugins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Plugin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Help) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DexConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Help) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Help) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Connectors) > 0 {
-		for _, e := range m.Connectors {
-			l = e.Size()
-			n += 1 + l + sovSettings(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.BinaryUrls) > 0 {
+		for k := range m.BinaryUrls {
+			v := m.BinaryUrls[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSettings(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChatText) > 0 {
+		i -= len(m.ChatText)
+		copy(dAtA[i:], m.ChatText)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ChatText)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChatUrl) > 0 {
+		i -= len(m.ChatUrl)
+		copy(dAtA[i:], m.ChatUrl)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ChatUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Connector) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Plugin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Plugin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Plugin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OIDCConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DexConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DexConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	if len(m.Connectors) > 0 {
+		for iNdEx := len(m.Connectors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Connector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CLIClientID)
-	if l > 0 {
-		n += 1 + l + sovSettings(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Connector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Connector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Scopes) > 0 {
-		for _, s := range m.Scopes {
-			l = len(s)
-			n += 1 + l + sovSettings(uint64(l))
-		}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.IDTokenClaims) > 0 {
-		for k, v := range m.IDTokenClaims {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSettings(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
-		}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovSettings(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozSettings(x uint64) (n int) {
-	return sovSettings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OIDCConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettings
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *OIDCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IDTokenClaims) > 0 {
+		for k := range m.IDTokenClaims {
+			v := m.IDTokenClaims[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSettings(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SettingsQuery: wiretype end group for non-group")
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintSettings(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SettingsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.CLIClientID) > 0 {
+		i -= len(m.CLIClientID)
+		copy(dAtA[i:], m.CLIClientID)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.CLIClientID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettingsValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettingsValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettingsValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSettings(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSettings(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSettings(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettings(dAtA[iNdEx:])
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettingsValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettingsValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettingsValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastLoginErrorAt != nil {
+		{
+			size, err := m.LastLoginErrorAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LastLoginError) > 0 {
+		i -= len(m.LastLoginError)
+		copy(dAtA[i:], m.LastLoginError)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.LastLoginError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Connectors) > 0 {
+		for iNdEx := len(m.Connectors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Connectors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reachable {
+		i--
+		if m.Reachable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConfigError) > 0 {
+		i -= len(m.ConfigError)
+		copy(dAtA[i:], m.ConfigError)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ConfigError)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Configured {
+		i--
+		if m.Configured {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexConnectorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexConnectorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexConnectorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastLoginAt != nil {
+		{
+			size, err := m.LastLoginAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSettings(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureFlag) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureFlag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureFlag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Known {
+		i--
+		if m.Known {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Configured {
+		i--
+		if m.Configured {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Default {
+		i--
+		if m.Default {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeatureFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeatureFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeatureFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Flags) > 0 {
+		for iNdEx := len(m.Flags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSettings(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSettings(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SettingsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Settings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.DexConfig != nil {
+		l = m.DexConfig.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.OIDCConfig != nil {
+		l = m.OIDCConfig.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.AppLabelKey)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.ResourceOverrides) > 0 {
+		for k, v := range m.ResourceOverrides {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSettings(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+		}
+	}
+	if m.StatusBadgeEnabled {
+		n += 2
+	}
+	if m.GoogleAnalytics != nil {
+		l = m.GoogleAnalytics.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.KustomizeOptions != nil {
+		l = m.KustomizeOptions.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.Help != nil {
+		l = m.Help.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Plugins) > 0 {
+		for _, e := range m.Plugins {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.UserLoginsDisabled {
+		n += 2
+	}
+	if len(m.ConfigManagementPlugins) > 0 {
+		for _, e := range m.ConfigManagementPlugins {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if len(m.KustomizeVersions) > 0 {
+		for _, s := range m.KustomizeVersions {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	l = len(m.UiCssURL)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.UiBannerContent)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.UiBannerURL)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	l = len(m.PasswordPattern)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	if m.UiBannerPermanent {
+		n += 3
+	}
+	l = len(m.UiBannerPosition)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	l = len(m.StatusBadgeRootUrl)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	if m.ExecEnabled {
+		n += 3
+	}
+	l = len(m.ControllerNamespace)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	if m.AppsInAnyNamespaceEnabled {
+		n += 3
+	}
+	if m.AutomationPaused {
+		n += 3
+	}
+	l = len(m.AutomationPausedReason)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GoogleAnalyticsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrackingID)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.AnonymizeUsers {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SettingsPluginsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plugins) > 0 {
+		for _, e := range m.Plugins {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Help) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChatUrl)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.ChatText)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.BinaryUrls) > 0 {
+		for k, v := range m.BinaryUrls {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + 1 + len(v) + sovSettings(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Plugin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DexConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Connectors) > 0 {
+		for _, e := range m.Connectors {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Connector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OIDCConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.CLIClientID)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if len(m.IDTokenClaims) > 0 {
+		for k, v := range m.IDTokenClaims {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSettings(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SettingsValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSettings(uint64(len(k))) + 1 + len(v) + sovSettings(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSettings(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SettingsValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DexStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configured {
+		n += 2
+	}
+	l = len(m.ConfigError)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.Reachable {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Connectors) > 0 {
+		for _, e := range m.Connectors {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	l = len(m.LastLoginError)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.LastLoginErrorAt != nil {
+		l = m.LastLoginErrorAt.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DexConnectorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.LastLoginAt != nil {
+		l = m.LastLoginAt.Size()
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FeatureFlag) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Default {
+		n += 2
+	}
+	if m.Configured {
+		n += 2
+	}
+	if m.Known {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FeatureFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flags) > 0 {
+		for _, e := range m.Flags {
+			l = e.Size()
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSettings(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSettings(x uint64) (n int) {
+	return sovSettings(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SettingsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettingsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettingsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Settings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Settings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Settings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DexConfig == nil {
+				m.DexConfig = &DexConfig{}
+			}
+			if err := m.DexConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OIDCConfig == nil {
+				m.OIDCConfig = &OIDCConfig{}
+			}
+			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceOverrides == nil {
+				m.ResourceOverrides = make(map[string]*v1alpha1.ResourceOverride)
+			}
+			var mapkey string
+			var mapvalue *v1alpha1.ResourceOverride
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettings
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1alpha1.ResourceOverride{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSettings(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourceOverrides[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusBadgeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StatusBadgeEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleAnalytics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoogleAnalytics == nil {
+				m.GoogleAnalytics = &GoogleAnalyticsConfig{}
+			}
+			if err := m.GoogleAnalytics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Help == nil {
+				m.Help = &Help{}
+			}
+			if err := m.Help.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plugins = append(m.Plugins, &Plugin{})
+			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserLoginsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserLoginsDisabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigManagementPlugins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Settings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettings
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ConfigManagementPlugins = append(m.ConfigManagementPlugins, &v1alpha1.ConfigManagementPlugin{})
+			if err := m.ConfigManagementPlugins[len(m.ConfigManagementPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Settings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Settings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2018,13 +3633,13 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.KustomizeVersions = append(m.KustomizeVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DexConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UiCssURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2034,33 +3649,29 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DexConfig == nil {
-				m.DexConfig = &DexConfig{}
-			}
-			if err := m.DexConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UiCssURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OIDCConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerContent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2070,31 +3681,59 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OIDCConfig == nil {
-				m.OIDCConfig = &OIDCConfig{}
+			m.UiBannerContent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerURL", wireType)
 			}
-			if err := m.OIDCConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.UiBannerURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppLabelKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordPattern", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2122,13 +3761,13 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppLabelKey = string(dAtA[iNdEx:postIndex])
+			m.PasswordPattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceOverrides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2138,126 +3777,49 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceOverrides == nil {
-				m.ResourceOverrides = make(map[string]*v1alpha1.ResourceOverride)
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerPermanent", wireType)
 			}
-			var mapkey string
-			var mapvalue *v1alpha1.ResourceOverride
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSettings
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettings
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSettings
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettings
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSettings
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1alpha1.ResourceOverride{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSettings(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ResourceOverrides[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusBadgeEnabled", wireType)
+			m.UiBannerPermanent = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerPosition", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2267,17 +3829,29 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StatusBadgeEnabled = bool(v != 0)
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UiBannerPosition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleAnalytics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusBadgeRootUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2287,33 +3861,49 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GoogleAnalytics == nil {
-				m.GoogleAnalytics = &GoogleAnalyticsConfig{}
+			m.StatusBadgeRootUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecEnabled", wireType)
 			}
-			if err := m.GoogleAnalytics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 8:
+			m.ExecEnabled = bool(v != 0)
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2323,33 +3913,69 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KustomizeOptions == nil {
-				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsInAnyNamespaceEnabled", wireType)
 			}
-			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+			m.AppsInAnyNamespaceEnabled = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomationPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutomationPaused = bool(v != 0)
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomationPausedReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2359,33 +3985,80 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Help == nil {
-				m.Help = &Help{}
-			}
-			if err := m.Help.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AutomationPausedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GoogleAnalyticsConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GoogleAnalyticsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2395,29 +4068,27 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plugins = append(m.Plugins, &Plugin{})
-			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TrackingID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserLoginsDisabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnonymizeUsers", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2434,10 +4105,61 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.UserLoginsDisabled = bool(v != 0)
-		case 12:
+			m.AnonymizeUsers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettingsPluginsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettingsPluginsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettingsPluginsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigManagementPlugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2464,14 +4186,65 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigManagementPlugins = append(m.ConfigManagementPlugins, &v1alpha1.ConfigManagementPlugin{})
-			if err := m.ConfigManagementPlugins[len(m.ConfigManagementPlugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Plugins = append(m.Plugins, &Plugin{})
+			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Help) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Help: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Help: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2499,11 +4272,11 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KustomizeVersions = append(m.KustomizeVersions, string(dAtA[iNdEx:postIndex]))
+			m.ChatUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UiCssURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChatText", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2531,13 +4304,13 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UiCssURL = string(dAtA[iNdEx:postIndex])
+			m.ChatText = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerContent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryUrls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2547,59 +4320,173 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UiBannerContent = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerURL", wireType)
+			if m.BinaryUrls == nil {
+				m.BinaryUrls = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettings
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSettings(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
+			m.BinaryUrls[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSettings
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UiBannerURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordPattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2627,13 +4514,64 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PasswordPattern = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2643,47 +4581,80 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSettings
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			m.Connectors = append(m.Connectors, &Connector{})
+			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerPermanent", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
 			}
-			m.UiBannerPermanent = bool(v != 0)
-		case 20:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Connector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Connector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Connector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UiBannerPosition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2711,11 +4682,11 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UiBannerPosition = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusBadgeRootUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2743,31 +4714,11 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusBadgeRootUrl = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ExecEnabled = bool(v != 0)
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2795,28 +4746,8 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppsInAnyNamespaceEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AppsInAnyNamespaceEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -2839,7 +4770,7 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
+func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,15 +4793,79 @@ func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GoogleAnalyticsConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GoogleAnalyticsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2898,13 +4893,13 @@ func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrackingID = string(dAtA[iNdEx:postIndex])
+			m.ClientID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnonymizeUsers", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CLIClientID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -2914,66 +4909,59 @@ func (m *GoogleAnalyticsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AnonymizeUsers = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSettings(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthSettings
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SettingsPluginsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSettings
+			m.CLIClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SettingsPluginsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SettingsPluginsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IDTokenClaims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3000,10 +4988,105 @@ func (m *SettingsPluginsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plugins = append(m.Plugins, &Plugin{})
-			if err := m.Plugins[len(m.Plugins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.IDTokenClaims == nil {
+				m.IDTokenClaims = make(map[string]*oidc.Claim)
+			}
+			var mapkey string
+			var mapvalue *oidc.Claim
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSettings
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSettings
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSettings
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &oidc.Claim{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSettings(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSettings
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.IDTokenClaims[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3027,7 +5110,7 @@ func (m *SettingsPluginsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Help) Unmarshal(dAtA []byte) error {
+func (m *SettingsValidateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3040,57 +5123,25 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Help: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Help: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChatUrl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ChatUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettingsValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettingsValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChatText", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3118,11 +5169,11 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChatText = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryUrls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3149,8 +5200,8 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BinaryUrls == nil {
-				m.BinaryUrls = make(map[string]string)
+			if m.Data == nil {
+				m.Data = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -3245,7 +5296,7 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.BinaryUrls[mapkey] = mapvalue
+			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3269,7 +5320,7 @@ func (m *Help) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *SettingsValidateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3292,44 +5343,12 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettingsValidateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettingsValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSettings
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -3352,7 +5371,7 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DexConfig) Unmarshal(dAtA []byte) error {
+func (m *DexStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3375,13 +5394,117 @@ func (m *DexConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DexConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DexStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DexConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configured", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Configured = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reachable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reachable = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Connectors", wireType)
 			}
@@ -3410,11 +5533,79 @@ func (m *DexConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connectors = append(m.Connectors, &Connector{})
+			m.Connectors = append(m.Connectors, &DexConnectorStatus{})
 			if err := m.Connectors[len(m.Connectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLoginError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastLoginError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLoginErrorAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastLoginErrorAt == nil {
+				m.LastLoginErrorAt = &v1.Time{}
+			}
+			if err := m.LastLoginErrorAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -3437,7 +5628,7 @@ func (m *DexConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Connector) Unmarshal(dAtA []byte) error {
+func (m *DexConnectorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3460,13 +5651,45 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Connector: wiretype end group for non-group")
+			return fmt.Errorf("proto: DexConnectorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Connector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DexConnectorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -3498,7 +5721,7 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
@@ -3530,6 +5753,42 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 			}
 			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLoginAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastLoginAt == nil {
+				m.LastLoginAt = &v1.Time{}
+			}
+			if err := m.LastLoginAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -3552,7 +5811,7 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
+func (m *FeatureFlag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3575,10 +5834,10 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeatureFlag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeatureFlag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3615,7 +5874,7 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3643,13 +5902,13 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -3659,29 +5918,17 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettings
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CLIClientID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -3691,29 +5938,37 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSettings
+			m.Default = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configured", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.CLIClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			m.Configured = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Known", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSettings
@@ -3723,27 +5978,66 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSettings
+			m.Known = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSettings
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeatureFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeatureFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeatureFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDTokenClaims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3770,105 +6064,10 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IDTokenClaims == nil {
-				m.IDTokenClaims = make(map[string]*oidc.Claim)
-			}
-			var mapkey string
-			var mapvalue *oidc.Claim
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSettings
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettings
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSettings
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSettings
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSettings
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &oidc.Claim{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSettings(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSettings
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Flags = append(m.Flags, &FeatureFlag{})
+			if err := m.Flags[len(m.Flags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IDTokenClaims[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,2371 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/summary/summary.proto
+
+// Summary Service
+//
+// Summary Service API returns fleet-level aggregates of the applications
+
+package summary
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SummaryQuery is a query for the summary of the applications
+type SummaryQuery struct {
+	// Projects restricts the summary to the applications of the given projects
+	Projects []string `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// Selector restricts the summary to the applications matching the label selector
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// AppNamespace restricts the summary to the applications of the given namespace
+	AppNamespace string `protobuf:"bytes,3,opt,name=appNamespace,proto3" json:"appNamespace,omitempty"`
+	// TopDegradedLimit is the maximum number of degraded applications returned. Defaults to 10
+	TopDegradedLimit int32 `protobuf:"varint,4,opt,name=topDegradedLimit,proto3" json:"topDegradedLimit,omitempty"`
+	// RecentOperationsLimit is the maximum number of recent operations returned. Defaults to 10
+	RecentOperationsLimit int32    `protobuf:"varint,5,opt,name=recentOperationsLimit,proto3" json:"recentOperationsLimit,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *SummaryQuery) Reset()         { *m = SummaryQuery{} }
+func (m *SummaryQuery) String() string { return proto.CompactTextString(m) }
+func (*SummaryQuery) ProtoMessage()    {}
+func (*SummaryQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1367d3fe110fb6f2, []int{0}
+}
+func (m *SummaryQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SummaryQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SummaryQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SummaryQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SummaryQuery.Merge(m, src)
+}
+func (m *SummaryQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SummaryQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SummaryQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SummaryQuery proto.InternalMessageInfo
+
+func (m *SummaryQuery) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *SummaryQuery) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+func (m *SummaryQuery) GetAppNamespace() string {
+	if m != nil {
+		return m.AppNamespace
+	}
+	return ""
+}
+
+func (m *SummaryQuery) GetTopDegradedLimit() int32 {
+	if m != nil {
+		return m.TopDegradedLimit
+	}
+	return 0
+}
+
+func (m *SummaryQuery) GetRecentOperationsLimit() int32 {
+	if m != nil {
+		return m.RecentOperationsLimit
+	}
+	return 0
+}
+
+// Count is the number of applications sharing the same key, e.g. a health status or a project
+type Count struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Count) Reset()         { *m = Count{} }
+func (m *Count) String() string { return proto.CompactTextString(m) }
+func (*Count) ProtoMessage()    {}
+func (*Count) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1367d3fe110fb6f2, []int{1}
+}
+func (m *Count) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Count) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Count.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Count) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Count.Merge(m, src)
+}
+func (m *Count) XXX_Size() int {
+	return m.Size()
+}
+func (m *Count) XXX_DiscardUnknown() {
+	xxx_messageInfo_Count.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Count proto.InternalMessageInfo
+
+func (m *Count) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Count) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// DegradedApplication is an application whose health status is degraded
+type DegradedApplication struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Project       string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	HealthMessage string `protobuf:"bytes,4,opt,name=healthMessage,proto3" json:"healthMessage,omitempty"`
+	// DegradedResources is the number of degraded resources of the application
+	DegradedResources    int64    `protobuf:"varint,5,opt,name=degradedResources,proto3" json:"degradedResources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DegradedApplication) Reset()         { *m = DegradedApplication{} }
+func (m *DegradedApplication) String() string { return proto.CompactTextString(m) }
+func (*DegradedApplication) ProtoMessage()    {}
+func (*DegradedApplication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1367d3fe110fb6f2, []int{2}
+}
+func (m *DegradedApplication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DegradedApplication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DegradedApplication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DegradedApplication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DegradedApplication.Merge(m, src)
+}
+func (m *DegradedApplication) XXX_Size() int {
+	return m.Size()
+}
+func (m *DegradedApplication) XXX_DiscardUnknown() {
+	xxx_messageInfo_DegradedApplication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DegradedApplication proto.InternalMessageInfo
+
+func (m *DegradedApplication) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DegradedApplication) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DegradedApplication) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *DegradedApplication) GetHealthMessage() string {
+	if m != nil {
+		return m.HealthMessage
+	}
+	return ""
+}
+
+func (m *DegradedApplication) GetDegradedResources() int64 {
+	if m != nil {
+		return m.DegradedResources
+	}
+	return 0
+}
+
+// RecentOperation is the last operation of an application
+type RecentOperation struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Project              string   `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	Phase                string   `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Revision             string   `protobuf:"bytes,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	InitiatedBy          string   `protobuf:"bytes,7,opt,name=initiatedBy,proto3" json:"initiatedBy,omitempty"`
+	StartedAt            *v1.Time `protobuf:"bytes,8,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt           *v1.Time `protobuf:"bytes,9,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecentOperation) Reset()         { *m = RecentOperation{} }
+func (m *RecentOperation) String() string { return proto.CompactTextString(m) }
+func (*RecentOperation) ProtoMessage()    {}
+func (*RecentOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1367d3fe110fb6f2, []int{3}
+}
+func (m *RecentOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecentOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecentOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecentOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentOperation.Merge(m, src)
+}
+func (m *RecentOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecentOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentOperation proto.InternalMessageInfo
+
+func (m *RecentOperation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetInitiatedBy() string {
+	if m != nil {
+		return m.InitiatedBy
+	}
+	return ""
+}
+
+func (m *RecentOperation) GetStartedAt() *v1.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return nil
+}
+
+func (m *RecentOperation) GetFinishedAt() *v1.Time {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return nil
+}
+
+// ApplicationsSummary holds the aggregated statuses of the applications
+type ApplicationsSummary struct {
+	// Total is the number of applications
+	Total          int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	ByHealthStatus []*Count `protobuf:"bytes,2,rep,name=byHealthStatus,proto3" json:"byHealthStatus,omitempty"`
+	BySyncStatus   []*Count `protobuf:"bytes,3,rep,name=bySyncStatus,proto3" json:"bySyncStatus,omitempty"`
+	ByProject      []*Count `protobuf:"bytes,4,rep,name=byProject,proto3" json:"byProject,omitempty"`
+	// ByDestination counts the applications per destination cluster, identified by its server URL or its name
+	ByDestination []*Count `protobuf:"bytes,5,rep,name=byDestination,proto3" json:"byDestination,omitempty"`
+	// TopDegraded are the degraded applications with the most degraded resources first
+	TopDegraded []*DegradedApplication `protobuf:"bytes,6,rep,name=topDegraded,proto3" json:"topDegraded,omitempty"`
+	// RecentOperations are the last operations of the applications, most recently started first
+	RecentOperations     []*RecentOperation `protobuf:"bytes,7,rep,name=recentOperations,proto3" json:"recentOperations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ApplicationsSummary) Reset()         { *m = ApplicationsSummary{} }
+func (m *ApplicationsSummary) String() string { return proto.CompactTextString(m) }
+func (*ApplicationsSummary) ProtoMessage()    {}
+func (*ApplicationsSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1367d3fe110fb6f2, []int{4}
+}
+func (m *ApplicationsSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationsSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationsSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationsSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationsSummary.Merge(m, src)
+}
+func (m *ApplicationsSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationsSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationsSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationsSummary proto.InternalMessageInfo
+
+func (m *ApplicationsSummary) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ApplicationsSummary) GetByHealthStatus() []*Count {
+	if m != nil {
+		return m.ByHealthStatus
+	}
+	return nil
+}
+
+func (m *ApplicationsSummary) GetBySyncStatus() []*Count {
+	if m != nil {
+		return m.BySyncStatus
+	}
+	return nil
+}
+
+func (m *ApplicationsSummary) GetByProject() []*Count {
+	if m != nil {
+		return m.ByProject
+	}
+	return nil
+}
+
+func (m *ApplicationsSummary) GetByDestination() []*Count {
+	if m != nil {
+		return m.ByDestination
+	}
+	return nil
+}
+
+func (m *ApplicationsSummary) GetTopDegraded() []*DegradedApplication {
+	if m != nil {
+		return m.TopDegraded
+	}
+	return nil
+}
+
+func (m *ApplicationsSummary) GetRecentOperations() []*RecentOperation {
+	if m != nil {
+		return m.RecentOperations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SummaryQuery)(nil), "summary.SummaryQuery")
+	proto.RegisterType((*Count)(nil), "summary.Count")
+	proto.RegisterType((*DegradedApplication)(nil), "summary.DegradedApplication")
+	proto.RegisterType((*RecentOperation)(nil), "summary.RecentOperation")
+	proto.RegisterType((*ApplicationsSummary)(nil), "summary.ApplicationsSummary")
+}
+
+func init() { proto.RegisterFile("server/summary/summary.proto", fileDescriptor_1367d3fe110fb6f2) }
+
+var fileDescriptor_1367d3fe110fb6f2 = []byte{
+	// 697 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6a, 0x1b, 0x49,
+	0x10, 0x66, 0x34, 0x96, 0x65, 0x95, 0xfc, 0xdb, 0xb6, 0xd9, 0x41, 0x08, 0x23, 0xc4, 0x1e, 0x84,
+	0xf1, 0xce, 0x60, 0xad, 0x58, 0xf6, 0xb4, 0x60, 0xaf, 0x21, 0x26, 0xe4, 0x77, 0x94, 0x53, 0x6e,
+	0xad, 0x51, 0x65, 0xd4, 0x91, 0xa6, 0x7b, 0xe8, 0x6e, 0x09, 0xe6, 0x98, 0xbc, 0x42, 0x9e, 0x25,
+	0xef, 0x90, 0x43, 0x0e, 0x81, 0xbc, 0x40, 0x62, 0xf2, 0x20, 0xa1, 0x7b, 0x66, 0xf4, 0x63, 0xeb,
+	0x12, 0xc8, 0x49, 0x53, 0x3f, 0xdf, 0xa7, 0xaa, 0xea, 0xaf, 0x0a, 0x5a, 0x0a, 0xe5, 0x1c, 0x65,
+	0xa0, 0x66, 0x49, 0x42, 0x65, 0x56, 0xfe, 0xfa, 0xa9, 0x14, 0x5a, 0x90, 0x5a, 0x61, 0x36, 0x5b,
+	0xb1, 0x10, 0xf1, 0x14, 0x03, 0x9a, 0xb2, 0x80, 0x72, 0x2e, 0x34, 0xd5, 0x4c, 0x70, 0x95, 0xa7,
+	0x35, 0xfb, 0x93, 0x7f, 0x95, 0xcf, 0x84, 0x89, 0x26, 0x34, 0x1a, 0x33, 0x8e, 0x32, 0x0b, 0xd2,
+	0x49, 0x6c, 0x1c, 0x2a, 0x48, 0x50, 0xd3, 0x60, 0x7e, 0x19, 0xc4, 0xc8, 0x51, 0x52, 0x8d, 0xa3,
+	0x1c, 0xd5, 0xf9, 0xec, 0xc0, 0xee, 0x20, 0xe7, 0x7f, 0x39, 0x43, 0x99, 0x91, 0x26, 0xec, 0xa4,
+	0x52, 0xbc, 0xc5, 0x48, 0x2b, 0xcf, 0x69, 0xbb, 0xdd, 0x7a, 0xb8, 0xb0, 0x4d, 0x4c, 0xe1, 0x14,
+	0x23, 0x2d, 0xa4, 0x57, 0x69, 0x3b, 0x26, 0x56, 0xda, 0xa4, 0x03, 0xbb, 0x34, 0x4d, 0x9f, 0xd1,
+	0x04, 0x55, 0x4a, 0x23, 0xf4, 0x5c, 0x1b, 0x5f, 0xf3, 0x91, 0x73, 0x38, 0xd4, 0x22, 0xbd, 0xc1,
+	0x58, 0xd2, 0x11, 0x8e, 0x9e, 0xb0, 0x84, 0x69, 0x6f, 0xab, 0xed, 0x74, 0xab, 0xe1, 0x03, 0x3f,
+	0xe9, 0xc3, 0xa9, 0xc4, 0x08, 0xb9, 0x7e, 0x9e, 0x9a, 0x82, 0x4d, 0xa3, 0x39, 0xa0, 0x6a, 0x01,
+	0x9b, 0x83, 0x9d, 0x00, 0xaa, 0xff, 0x8b, 0x19, 0xd7, 0xe4, 0x10, 0xdc, 0x09, 0x66, 0x9e, 0x63,
+	0xab, 0x30, 0x9f, 0xe4, 0x04, 0xaa, 0x91, 0x09, 0xd9, 0xca, 0xdd, 0x30, 0x37, 0x3a, 0x1f, 0x1d,
+	0x38, 0x2e, 0xff, 0xf8, 0x2a, 0x4d, 0xa7, 0x2c, 0xb2, 0x74, 0x84, 0xc0, 0x16, 0xa7, 0x09, 0x16,
+	0x04, 0xf6, 0x9b, 0xb4, 0xa0, 0xce, 0x17, 0xfd, 0xe5, 0xfd, 0x2f, 0x1d, 0xc4, 0x83, 0x5a, 0x31,
+	0xa8, 0xa2, 0xf7, 0xd2, 0x24, 0x7f, 0xc2, 0xde, 0x18, 0xe9, 0x54, 0x8f, 0x9f, 0xa2, 0x52, 0x34,
+	0x46, 0xdb, 0x73, 0x3d, 0x5c, 0x77, 0x92, 0x0b, 0x38, 0x1a, 0x15, 0x85, 0x84, 0xa8, 0xc4, 0x4c,
+	0x46, 0xa8, 0x6c, 0xb3, 0x6e, 0xf8, 0x30, 0xd0, 0xf9, 0x5e, 0x81, 0x83, 0x70, 0x7d, 0x04, 0xbf,
+	0xb5, 0xe6, 0x13, 0xa8, 0xa6, 0x63, 0xaa, 0xca, 0x5a, 0x73, 0xc3, 0xe4, 0x27, 0x45, 0x0f, 0xd5,
+	0x3c, 0xbf, 0x30, 0x8d, 0x34, 0x24, 0xce, 0x99, 0x62, 0x82, 0x7b, 0xdb, 0xb9, 0x34, 0x4a, 0x9b,
+	0xb4, 0xa1, 0xc1, 0x38, 0xd3, 0xcc, 0xc8, 0xee, 0x3a, 0xf3, 0x6a, 0x36, 0xbc, 0xea, 0x22, 0xb7,
+	0x50, 0x57, 0x9a, 0x4a, 0x8d, 0xa3, 0x2b, 0xed, 0xed, 0xb4, 0x9d, 0x6e, 0xa3, 0x77, 0xee, 0xe7,
+	0x7a, 0xf6, 0x57, 0xf5, 0xec, 0xa7, 0x93, 0xd8, 0x38, 0x94, 0x6f, 0xf4, 0xec, 0xcf, 0x2f, 0xfd,
+	0x57, 0x2c, 0xc1, 0x70, 0x09, 0x26, 0x8f, 0x01, 0xde, 0x30, 0xce, 0xd4, 0xd8, 0x52, 0xd5, 0x7f,
+	0x99, 0x6a, 0x05, 0xdd, 0x79, 0xe7, 0xc2, 0xf1, 0x8a, 0x26, 0x54, 0xb1, 0x27, 0x66, 0x36, 0x5a,
+	0x68, 0x3a, 0xb5, 0x83, 0x76, 0xc3, 0xdc, 0x20, 0xff, 0xc0, 0xfe, 0x30, 0xbb, 0xb5, 0x4f, 0x3a,
+	0xd0, 0x54, 0xcf, 0x94, 0x57, 0x69, 0xbb, 0xdd, 0x46, 0x6f, 0xdf, 0x2f, 0xd7, 0xd9, 0x2a, 0x33,
+	0xbc, 0x97, 0x45, 0x7a, 0xb0, 0x3b, 0xcc, 0x06, 0x19, 0x8f, 0x0a, 0x94, 0xbb, 0x11, 0xb5, 0x96,
+	0x43, 0x2e, 0xa0, 0x3e, 0xcc, 0x5e, 0x14, 0x2f, 0xb7, 0xb5, 0x11, 0xb0, 0x4c, 0x20, 0x7d, 0xd8,
+	0x1b, 0x66, 0x37, 0xa8, 0x34, 0xe3, 0xb6, 0x11, 0xaf, 0xba, 0x11, 0xb1, 0x9e, 0x44, 0xfe, 0x83,
+	0xc6, 0xca, 0x52, 0x7a, 0xdb, 0x16, 0xd3, 0x5a, 0x60, 0x36, 0x2c, 0x4d, 0xb8, 0x0a, 0x20, 0x37,
+	0x70, 0x78, 0x7f, 0x47, 0xbd, 0x9a, 0x25, 0xf1, 0x16, 0x24, 0xf7, 0x14, 0x1c, 0x3e, 0x40, 0xf4,
+	0x10, 0xf6, 0x8b, 0xb1, 0x0f, 0x50, 0xce, 0x59, 0x84, 0x64, 0x00, 0xee, 0x23, 0xd4, 0xe4, 0x74,
+	0x41, 0xb2, 0x7a, 0xbe, 0x9a, 0xcb, 0x02, 0x37, 0xbc, 0x5c, 0xe7, 0x8f, 0xf7, 0x5f, 0x7f, 0x7c,
+	0xa8, 0x1c, 0x91, 0x03, 0x7b, 0x43, 0xe7, 0x97, 0xe5, 0xa5, 0xbd, 0xbe, 0xfe, 0x74, 0x77, 0xe6,
+	0x7c, 0xb9, 0x3b, 0x73, 0xbe, 0xdd, 0x9d, 0x39, 0xaf, 0xfb, 0x31, 0xd3, 0xe3, 0xd9, 0xd0, 0x8f,
+	0x44, 0x12, 0x50, 0x19, 0x0b, 0xb3, 0x14, 0xf6, 0xe3, 0xaf, 0x68, 0x14, 0xcc, 0x7b, 0xe5, 0x55,
+	0x8d, 0xa6, 0x0c, 0xb9, 0x2e, 0x39, 0x86, 0xdb, 0xf6, 0xa2, 0xfe, 0xfd, 0x33, 0x00, 0x00, 0xff,
+	0xff, 0xbc, 0x98, 0x27, 0x20, 0xce, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SummaryServiceClient is the client API for SummaryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SummaryServiceClient interface {
+	// Get returns the summary of the applications the user is allowed to get
+	Get(ctx context.Context, in *SummaryQuery, opts ...grpc.CallOption) (*ApplicationsSummary, error)
+}
+
+type summaryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSummaryServiceClient(cc *grpc.ClientConn) SummaryServiceClient {
+	return &summaryServiceClient{cc}
+}
+
+func (c *summaryServiceClient) Get(ctx context.Context, in *SummaryQuery, opts ...grpc.CallOption) (*ApplicationsSummary, error) {
+	out := new(ApplicationsSummary)
+	err := c.cc.Invoke(ctx, "/summary.SummaryService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SummaryServiceServer is the server API for SummaryService service.
+type SummaryServiceServer interface {
+	// Get returns the summary of the applications the user is allowed to get
+	Get(context.Context, *SummaryQuery) (*ApplicationsSummary, error)
+}
+
+// UnimplementedSummaryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSummaryServiceServer struct {
+}
+
+func (*UnimplementedSummaryServiceServer) Get(ctx context.Context, req *SummaryQuery) (*ApplicationsSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+
+func RegisterSummaryServiceServer(s *grpc.Server, srv SummaryServiceServer) {
+	s.RegisterService(&_SummaryService_serviceDesc, srv)
+}
+
+func _SummaryService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummaryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SummaryServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/summary.SummaryService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SummaryServiceServer).Get(ctx, req.(*SummaryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SummaryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "summary.SummaryService",
+	HandlerType: (*SummaryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _SummaryService_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/summary/summary.proto",
+}
+
+func (m *SummaryQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SummaryQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SummaryQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RecentOperationsLimit != 0 {
+		i = encodeVarintSummary(dAtA, i, uint64(m.RecentOperationsLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TopDegradedLimit != 0 {
+		i = encodeVarintSummary(dAtA, i, uint64(m.TopDegradedLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AppNamespace) > 0 {
+		i -= len(m.AppNamespace)
+		copy(dAtA[i:], m.AppNamespace)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.AppNamespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Selector) > 0 {
+		i -= len(m.Selector)
+		copy(dAtA[i:], m.Selector)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Selector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Projects[iNdEx])
+			copy(dAtA[i:], m.Projects[iNdEx])
+			i = encodeVarintSummary(dAtA, i, uint64(len(m.Projects[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Count) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Count) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Count) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintSummary(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DegradedApplication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DegradedApplication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DegradedApplication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DegradedResources != 0 {
+		i = encodeVarintSummary(dAtA, i, uint64(m.DegradedResources))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HealthMessage) > 0 {
+		i -= len(m.HealthMessage)
+		copy(dAtA[i:], m.HealthMessage)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.HealthMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecentOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecentOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecentOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSummary(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.InitiatedBy) > 0 {
+		i -= len(m.InitiatedBy)
+		copy(dAtA[i:], m.InitiatedBy)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.InitiatedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSummary(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationsSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationsSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RecentOperations) > 0 {
+		for iNdEx := len(m.RecentOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TopDegraded) > 0 {
+		for iNdEx := len(m.TopDegraded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopDegraded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ByDestination) > 0 {
+		for iNdEx := len(m.ByDestination) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByDestination[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ByProject) > 0 {
+		for iNdEx := len(m.ByProject) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByProject[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BySyncStatus) > 0 {
+		for iNdEx := len(m.BySyncStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BySyncStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ByHealthStatus) > 0 {
+		for iNdEx := len(m.ByHealthStatus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByHealthStatus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSummary(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintSummary(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSummary(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSummary(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SummaryQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.AppNamespace)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	if m.TopDegradedLimit != 0 {
+		n += 1 + sovSummary(uint64(m.TopDegradedLimit))
+	}
+	if m.RecentOperationsLimit != 0 {
+		n += 1 + sovSummary(uint64(m.RecentOperationsLimit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Count) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSummary(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DegradedApplication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.HealthMessage)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	if m.DegradedResources != 0 {
+		n += 1 + sovSummary(uint64(m.DegradedResources))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecentOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	l = len(m.InitiatedBy)
+	if l > 0 {
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovSummary(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationsSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovSummary(uint64(m.Total))
+	}
+	if len(m.ByHealthStatus) > 0 {
+		for _, e := range m.ByHealthStatus {
+			l = e.Size()
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	if len(m.BySyncStatus) > 0 {
+		for _, e := range m.BySyncStatus {
+			l = e.Size()
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	if len(m.ByProject) > 0 {
+		for _, e := range m.ByProject {
+			l = e.Size()
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	if len(m.ByDestination) > 0 {
+		for _, e := range m.ByDestination {
+			l = e.Size()
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	if len(m.TopDegraded) > 0 {
+		for _, e := range m.TopDegraded {
+			l = e.Size()
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	if len(m.RecentOperations) > 0 {
+		for _, e := range m.RecentOperations {
+			l = e.Size()
+			n += 1 + l + sovSummary(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSummary(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSummary(x uint64) (n int) {
+	return sovSummary(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SummaryQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SummaryQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SummaryQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopDegradedLimit", wireType)
+			}
+			m.TopDegradedLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopDegradedLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentOperationsLimit", wireType)
+			}
+			m.RecentOperationsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecentOperationsLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Count) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Count: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Count: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DegradedApplication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DegradedApplication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DegradedApplication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DegradedResources", wireType)
+			}
+			m.DegradedResources = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DegradedResources |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecentOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecentOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecentOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitiatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationsSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSummary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationsSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByHealthStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByHealthStatus = append(m.ByHealthStatus, &Count{})
+			if err := m.ByHealthStatus[len(m.ByHealthStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BySyncStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BySyncStatus = append(m.BySyncStatus, &Count{})
+			if err := m.BySyncStatus[len(m.BySyncStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByProject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByProject = append(m.ByProject, &Count{})
+			if err := m.ByProject[len(m.ByProject)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByDestination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByDestination = append(m.ByDestination, &Count{})
+			if err := m.ByDestination[len(m.ByDestination)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopDegraded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopDegraded = append(m.TopDegraded, &DegradedApplication{})
+			if err := m.TopDegraded[len(m.TopDegraded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSummary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentOperations = append(m.RecentOperations, &RecentOperation{})
+			if err := m.RecentOperations[len(m.RecentOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSummary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSummary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSummary(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSummary
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSummary
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSummary
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSummary
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSummary
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSummary        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSummary          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSummary = fmt.Errorf("proto: unexpected end of group")
+)
@@ -34,18 +34,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // VersionMessage represents version of the Argo CD API server
 type VersionMessage struct {
-	Version              string   `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
-	BuildDate            string   `protobuf:"bytes,2,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
-	GitCommit            string   `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
-	GitTag               string   `protobuf:"bytes,4,opt,name=GitTag,proto3" json:"GitTag,omitempty"`
-	GitTreeState         string   `protobuf:"bytes,5,opt,name=GitTreeState,proto3" json:"GitTreeState,omitempty"`
-	GoVersion            string   `protobuf:"bytes,6,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
-	Compiler             string   `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
-	Platform             string   `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
-	KustomizeVersion     string   `protobuf:"bytes,10,opt,name=KustomizeVersion,proto3" json:"KustomizeVersion,omitempty"`
-	HelmVersion          string   `protobuf:"bytes,11,opt,name=HelmVersion,proto3" json:"HelmVersion,omitempty"`
-	KubectlVersion       string   `protobuf:"bytes,12,opt,name=KubectlVersion,proto3" json:"KubectlVersion,omitempty"`
-	JsonnetVersion       string   `protobuf:"bytes,13,opt,name=JsonnetVersion,proto3" json:"JsonnetVersion,omitempty"`
+	Version          string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	BuildDate        string `protobuf:"bytes,2,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
+	GitCommit        string `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
+	GitTag           string `protobuf:"bytes,4,opt,name=GitTag,proto3" json:"GitTag,omitempty"`
+	GitTreeState     string `protobuf:"bytes,5,opt,name=GitTreeState,proto3" json:"GitTreeState,omitempty"`
+	GoVersion        string `protobuf:"bytes,6,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
+	Compiler         string `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
+	Platform         string `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
+	KustomizeVersion string `protobuf:"bytes,10,opt,name=KustomizeVersion,proto3" json:"KustomizeVersion,omitempty"`
+	HelmVersion      string `protobuf:"bytes,11,opt,name=HelmVersion,proto3" json:"HelmVersion,omitempty"`
+	KubectlVersion   string `protobuf:"bytes,12,opt,name=KubectlVersion,proto3" json:"KubectlVersion,omitempty"`
+	JsonnetVersion   string `protobuf:"bytes,13,opt,name=JsonnetVersion,proto3" json:"JsonnetVersion,omitempty"`
+	// MinCLIVersion is the oldest minor version of the CLI supported by the API server, e.g. v2.6
+	MinCLIVersion string `protobuf:"bytes,14,opt,name=MinCLIVersion,proto3" json:"MinCLIVersion,omitempty"`
+	// MaxCLIVersion is the newest minor version of the CLI supported by the API server, e.g. v2.8
+	MaxCLIVersion string `protobuf:"bytes,15,opt,name=MaxCLIVersion,proto3" json:"MaxCLIVersion,omitempty"`
+	// Features are the features of the API server which the CLI checks before relying on them
+	Features             []string `protobuf:"bytes,16,rep,name=Features,proto3" json:"Features,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -168,6 +174,27 @@ func (m *VersionMessage) GetJsonnetVersion() string {
 	return ""
 }
 
+func (m *VersionMessage) GetMinCLIVersion() string {
+	if m != nil {
+		return m.MinCLIVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetMaxCLIVersion() string {
+	if m != nil {
+		return m.MaxCLIVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*VersionMessage)(nil), "version.VersionMessage")
 }
@@ -175,32 +202,35 @@ func init() {
 func init() { proto.RegisterFile("server/version/version.proto", fileDescriptor_8be80977d07a4107) }
 
 var fileDescriptor_8be80977d07a4107 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xdf, 0x6a, 0xdb, 0x30,
-	0x18, 0xc5, 0x71, 0xb2, 0xe5, 0x8f, 0x92, 0x85, 0x21, 0x46, 0x66, 0xbc, 0x10, 0x42, 0x2e, 0xc6,
-	0x18, 0xcc, 0x86, 0x6c, 0x4f, 0x90, 0x6c, 0x64, 0x2c, 0x0c, 0xc2, 0x32, 0x7a, 0xd1, 0x3b, 0xd9,
-	0xf9, 0xe2, 0xaa, 0xb5, 0xfc, 0x19, 0x59, 0x36, 0xb4, 0x97, 0x7d, 0x85, 0x42, 0x9f, 0xa9, 0x97,
-	0x85, 0xbe, 0x40, 0x09, 0x7d, 0x90, 0x62, 0xd9, 0x72, 0x9b, 0xf6, 0xca, 0x3a, 0xe7, 0xfc, 0x7c,
-	0x10, 0x1c, 0x91, 0x51, 0x0a, 0x32, 0x07, 0xe9, 0xe5, 0x20, 0x53, 0x8e, 0xb1, 0xf9, 0xba, 0x89,
-	0x44, 0x85, 0xb4, 0x5d, 0x49, 0x67, 0x14, 0x22, 0x86, 0x11, 0x78, 0x2c, 0xe1, 0x1e, 0x8b, 0x63,
-	0x54, 0x4c, 0x71, 0x8c, 0xd3, 0x12, 0x73, 0x3e, 0x55, 0xa9, 0x56, 0x7e, 0xb6, 0xf3, 0x40, 0x24,
-	0xea, 0xbc, 0x0c, 0xa7, 0xd7, 0x4d, 0x32, 0x38, 0x2a, 0x6b, 0xfe, 0x42, 0x9a, 0xb2, 0x10, 0xa8,
-	0x4d, 0xda, 0x95, 0x63, 0x5b, 0x13, 0xeb, 0x4b, 0xf7, 0x9f, 0x91, 0x74, 0x44, 0xba, 0xf3, 0x8c,
-	0x47, 0xdb, 0x9f, 0x4c, 0x81, 0xdd, 0xd0, 0xd9, 0x93, 0x51, 0xa4, 0x4b, 0xae, 0x16, 0x28, 0x04,
-	0x57, 0x76, 0xb3, 0x4c, 0x6b, 0x83, 0x0e, 0x49, 0x6b, 0xc9, 0xd5, 0x7f, 0x16, 0xda, 0x6f, 0x74,
-	0x54, 0x29, 0x3a, 0x25, 0xfd, 0xe2, 0x24, 0x01, 0x36, 0xaa, 0xa8, 0x7d, 0xab, 0xd3, 0x03, 0x4f,
-	0x37, 0xa3, 0xb9, 0x53, 0xab, 0x6a, 0x36, 0x06, 0x75, 0x48, 0x67, 0x81, 0x22, 0xe1, 0x11, 0x48,
-	0xbb, 0xad, 0xc3, 0x5a, 0x17, 0xd9, 0x3a, 0x62, 0x6a, 0x87, 0x52, 0xd8, 0x9d, 0x32, 0x33, 0x9a,
-	0x7e, 0x25, 0xef, 0x57, 0x59, 0xaa, 0x50, 0xf0, 0x0b, 0x30, 0xe5, 0x44, 0x33, 0xaf, 0x7c, 0x3a,
-	0x21, 0xbd, 0xdf, 0x10, 0x09, 0x83, 0xf5, 0x34, 0xf6, 0xdc, 0xa2, 0x9f, 0xc9, 0x60, 0x95, 0xf9,
-	0x10, 0xa8, 0xc8, 0x40, 0x7d, 0x0d, 0xbd, 0x70, 0x0b, 0xee, 0x4f, 0x8a, 0x71, 0x0c, 0xca, 0x70,
-	0xef, 0x4a, 0xee, 0xd0, 0x9d, 0xf9, 0xf5, 0x2e, 0x1b, 0x90, 0x39, 0x0f, 0x80, 0xae, 0xeb, 0x5d,
-	0xe8, 0xd0, 0x2d, 0x37, 0x75, 0xcd, 0xa6, 0xee, 0xaf, 0x62, 0x53, 0xe7, 0xa3, 0x6b, 0x5e, 0xc8,
-	0xe1, 0xa6, 0xd3, 0x0f, 0x97, 0x77, 0x0f, 0x57, 0x8d, 0x01, 0xed, 0xeb, 0x37, 0x52, 0x41, 0xf3,
-	0xf9, 0xcd, 0x7e, 0x6c, 0xdd, 0xee, 0xc7, 0xd6, 0xfd, 0x7e, 0x6c, 0x1d, 0xff, 0x08, 0xb9, 0x3a,
-	0xc9, 0x7c, 0x37, 0x40, 0xe1, 0x31, 0x19, 0x62, 0x22, 0xf1, 0x54, 0x1f, 0xbe, 0x05, 0x5b, 0x2f,
-	0x9f, 0x79, 0xc9, 0x59, 0x58, 0xfc, 0x1d, 0x44, 0x1c, 0x62, 0x65, 0x3a, 0xfc, 0x96, 0xbe, 0xc2,
-	0xf7, 0xc7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x97, 0xec, 0x06, 0xab, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xd1, 0x6a, 0x13, 0x41,
+	0x18, 0x85, 0xd9, 0x46, 0x93, 0x76, 0x9a, 0xc6, 0x32, 0x48, 0x5d, 0x62, 0x08, 0x21, 0x88, 0x88,
+	0xe0, 0x2e, 0x54, 0x9f, 0x20, 0x51, 0xab, 0xd6, 0x42, 0xb1, 0xe2, 0x85, 0x77, 0xb3, 0xdb, 0xbf,
+	0xeb, 0xe8, 0xce, 0xfc, 0xcb, 0xcc, 0xbf, 0x41, 0xbd, 0xf4, 0x15, 0x7c, 0x29, 0x2f, 0x05, 0x5f,
+	0x40, 0x82, 0xcf, 0x21, 0x32, 0xb3, 0x3b, 0x31, 0xab, 0x57, 0xd9, 0x73, 0xce, 0x97, 0xc3, 0x30,
+	0x73, 0xd8, 0xc4, 0x82, 0x59, 0x81, 0x49, 0x57, 0x60, 0xac, 0x44, 0x1d, 0x7e, 0x93, 0xca, 0x20,
+	0x21, 0x1f, 0xb4, 0x72, 0x3c, 0x29, 0x10, 0x8b, 0x12, 0x52, 0x51, 0xc9, 0x54, 0x68, 0x8d, 0x24,
+	0x48, 0xa2, 0xb6, 0x0d, 0x36, 0xbe, 0xdd, 0xa6, 0x5e, 0x65, 0xf5, 0x55, 0x0a, 0xaa, 0xa2, 0x4f,
+	0x4d, 0x38, 0xff, 0xdd, 0x63, 0xa3, 0x37, 0x4d, 0xcd, 0x19, 0x58, 0x2b, 0x0a, 0xe0, 0x31, 0x1b,
+	0xb4, 0x4e, 0x1c, 0xcd, 0xa2, 0x7b, 0x7b, 0xaf, 0x82, 0xe4, 0x13, 0xb6, 0xb7, 0xa8, 0x65, 0x79,
+	0xf9, 0x58, 0x10, 0xc4, 0x3b, 0x3e, 0xfb, 0x6b, 0xb8, 0xf4, 0x44, 0xd2, 0x12, 0x95, 0x92, 0x14,
+	0xf7, 0x9a, 0x74, 0x63, 0xf0, 0x23, 0xd6, 0x3f, 0x91, 0xf4, 0x5a, 0x14, 0xf1, 0x35, 0x1f, 0xb5,
+	0x8a, 0xcf, 0xd9, 0xd0, 0x7d, 0x19, 0x80, 0x0b, 0x72, 0xb5, 0xd7, 0x7d, 0xda, 0xf1, 0x7c, 0x33,
+	0x86, 0x33, 0xf5, 0xdb, 0xe6, 0x60, 0xf0, 0x31, 0xdb, 0x5d, 0xa2, 0xaa, 0x64, 0x09, 0x26, 0x1e,
+	0xf8, 0x70, 0xa3, 0x5d, 0x76, 0x5e, 0x0a, 0xba, 0x42, 0xa3, 0xe2, 0xdd, 0x26, 0x0b, 0x9a, 0xdf,
+	0x67, 0x87, 0xa7, 0xb5, 0x25, 0x54, 0xf2, 0x33, 0x84, 0x72, 0xe6, 0x99, 0xff, 0x7c, 0x3e, 0x63,
+	0xfb, 0xcf, 0xa0, 0x54, 0x01, 0xdb, 0xf7, 0xd8, 0xb6, 0xc5, 0xef, 0xb2, 0xd1, 0x69, 0x9d, 0x41,
+	0x4e, 0x65, 0x80, 0x86, 0x1e, 0xfa, 0xc7, 0x75, 0xdc, 0x0b, 0x8b, 0x5a, 0x03, 0x05, 0xee, 0xa0,
+	0xe1, 0xba, 0x2e, 0xbf, 0xc3, 0x0e, 0xce, 0xa4, 0x5e, 0xbe, 0x7c, 0x1e, 0xb0, 0x91, 0xc7, 0xba,
+	0xa6, 0xa7, 0xc4, 0xc7, 0x2d, 0xea, 0x46, 0x4b, 0x6d, 0x9b, 0xee, 0x16, 0x9e, 0x82, 0xa0, 0xda,
+	0x80, 0x8d, 0x0f, 0x67, 0x3d, 0x77, 0x0b, 0x41, 0x1f, 0x67, 0x9b, 0xf7, 0xbf, 0x00, 0xb3, 0x92,
+	0x39, 0xf0, 0xf3, 0xcd, 0xfb, 0xf3, 0xa3, 0xa4, 0xd9, 0x4e, 0x12, 0xb6, 0x93, 0x3c, 0x71, 0xdb,
+	0x19, 0xdf, 0x4a, 0xc2, 0x12, 0xbb, 0xdb, 0x99, 0xdf, 0xfc, 0xf2, 0xe3, 0xd7, 0xd7, 0x9d, 0x11,
+	0x1f, 0xfa, 0x2d, 0xb6, 0xd0, 0x62, 0xf1, 0x6d, 0x3d, 0x8d, 0xbe, 0xaf, 0xa7, 0xd1, 0xcf, 0xf5,
+	0x34, 0x7a, 0xfb, 0xa8, 0x90, 0xf4, 0xae, 0xce, 0x92, 0x1c, 0x55, 0x2a, 0x4c, 0x81, 0x95, 0xc1,
+	0xf7, 0xfe, 0xe3, 0x41, 0x7e, 0x99, 0xae, 0x8e, 0xd3, 0xea, 0x43, 0xe1, 0xfe, 0x9d, 0x97, 0x12,
+	0x34, 0x85, 0x8e, 0xac, 0xef, 0x8f, 0xf0, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x5d,
+	0x03, 0x40, 0x13, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -309,6 +339,31 @@ func (m *VersionMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintVersion(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MaxCLIVersion) > 0 {
+		i -= len(m.MaxCLIVersion)
+		copy(dAtA[i:], m.MaxCLIVersion)
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.MaxCLIVersion)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.MinCLIVersion) > 0 {
+		i -= len(m.MinCLIVersion)
+		copy(dAtA[i:], m.MinCLIVersion)
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.MinCLIVersion)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.JsonnetVersion) > 0 {
 		i -= len(m.JsonnetVersion)
 		copy(dAtA[i:], m.JsonnetVersion)
@@ -461,6 +516,20 @@ func (m *VersionMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVersion(uint64(l))
 	}
+	l = len(m.MinCLIVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.MaxCLIVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 2 + l + sovVersion(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -886,6 +955,102 @@ func (m *VersionMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.JsonnetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCLIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinCLIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCLIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCLIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersion(dAtA[iNdEx:])
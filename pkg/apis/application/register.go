@@ -24,4 +24,16 @@ const (
 	ApplicationSetShortName string = "appset"
 	ApplicationSetPlural    string = "applicationsets"
 	ApplicationSetFullName  string = ApplicationSetPlural + "." + Group
+
+	// RepositoryRegistration constants
+	RepositoryRegistrationKind     string = "RepositoryRegistration"
+	RepositoryRegistrationSingular string = "repositoryregistration"
+	RepositoryRegistrationPlural   string = "repositoryregistrations"
+	RepositoryRegistrationFullName string = RepositoryRegistrationPlural + "." + Group
+
+	// ClusterRegistration constants
+	ClusterRegistrationKind     string = "ClusterRegistration"
+	ClusterRegistrationSingular string = "clusterregistration"
+	ClusterRegistrationPlural   string = "clusterregistrations"
+	ClusterRegistrationFullName string = ClusterRegistrationPlural + "." + Group
 )
@@ -2,10 +2,13 @@ package v1alpha1
 
 import (
 	"fmt"
+	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 
@@ -15,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
 )
 
 // AppProjectList is list of AppProject resources
@@ -248,6 +252,35 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	for _, bundle := range p.Spec.PolicyBundles {
+		if err := bundle.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid policy bundle '%s': %v", bundle.RepoURL, err)
+		}
+	}
+
+	for _, rule := range p.Spec.NotificationSubscriptions {
+		if rule.Service == "" {
+			return status.Errorf(codes.InvalidArgument, "notification subscription service cannot be empty")
+		}
+	}
+
+	resourceLists := []struct {
+		name  string
+		items []metav1.GroupKind
+	}{
+		{"clusterResourceWhitelist", p.Spec.ClusterResourceWhitelist},
+		{"clusterResourceBlacklist", p.Spec.ClusterResourceBlacklist},
+		{"namespaceResourceWhitelist", p.Spec.NamespaceResourceWhitelist},
+		{"namespaceResourceBlacklist", p.Spec.NamespaceResourceBlacklist},
+	}
+	for _, list := range resourceLists {
+		for _, item := range list.items {
+			if _, err := parseResourceRule(item); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid rule of %s: %v", list.name, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -321,35 +354,61 @@ func (proj *AppProject) ProjectPoliciesString() string {
 	return strings.Join(policies, "\n")
 }
 
-// IsGroupKindPermitted validates if the given resource group/kind is permitted to be deployed in the project
+// IsGroupKindPermitted validates if the given resource group/kind is permitted to be deployed in the project. The
+// version constraints of the resource lists are ignored.
 func (proj AppProject) IsGroupKindPermitted(gk schema.GroupKind, namespaced bool) bool {
-	var isWhiteListed, isBlackListed bool
-	res := metav1.GroupKind{Group: gk.Group, Kind: gk.Kind}
+	return proj.IsGroupVersionKindPermitted(gk.WithVersion(""), namespaced)
+}
 
-	if namespaced {
-		namespaceWhitelist := proj.Spec.NamespaceResourceWhitelist
-		namespaceBlacklist := proj.Spec.NamespaceResourceBlacklist
+// IsGroupVersionKindPermitted validates if the given resource group/version/kind is permitted to be deployed in the
+// project. The version constraints of the resource lists are ignored if the version is empty.
+func (proj AppProject) IsGroupVersionKindPermitted(gvk schema.GroupVersionKind, namespaced bool) bool {
+	return proj.CheckResourcePermission(gvk, namespaced).Permitted
+}
 
-		isWhiteListed = namespaceWhitelist == nil || len(namespaceWhitelist) != 0 && isResourceInList(res, namespaceWhitelist)
-		isBlackListed = len(namespaceBlacklist) != 0 && isResourceInList(res, namespaceBlacklist)
-		return isWhiteListed && !isBlackListed
-	}
+// ResourcePermission explains whether a resource is permitted to be deployed in a project
+// +protobuf=false
+type ResourcePermission struct {
+	// Permitted is true if the resource is permitted
+	Permitted bool `protobuf:"varint,1,opt,name=permitted"`
+	// List is the name of the resource list of the project which decided, e.g. namespaceResourceBlacklist
+	List string `protobuf:"bytes,2,opt,name=list"`
+	// Rule is the rule of the list which matched the resource, nil if no rule matched
+	Rule *metav1.GroupKind `protobuf:"bytes,3,opt,name=rule"`
+	// Message explains the decision
+	Message string `protobuf:"bytes,4,opt,name=message"`
+}
 
-	clusterWhitelist := proj.Spec.ClusterResourceWhitelist
-	clusterBlacklist := proj.Spec.ClusterResourceBlacklist
+// CheckResourcePermission returns whether the given resource group/version/kind is permitted to be deployed in the
+// project, along with the list and the rule which decided
+func (proj AppProject) CheckResourcePermission(gvk schema.GroupVersionKind, namespaced bool) ResourcePermission {
+	whitelistName, whitelist := "clusterResourceWhitelist", proj.Spec.ClusterResourceWhitelist
+	blacklistName, blacklist := "clusterResourceBlacklist", proj.Spec.ClusterResourceBlacklist
+	if namespaced {
+		whitelistName, whitelist = "namespaceResourceWhitelist", proj.Spec.NamespaceResourceWhitelist
+		blacklistName, blacklist = "namespaceResourceBlacklist", proj.Spec.NamespaceResourceBlacklist
+	}
 
-	isWhiteListed = len(clusterWhitelist) != 0 && isResourceInList(res, clusterWhitelist)
-	isBlackListed = len(clusterBlacklist) != 0 && isResourceInList(res, clusterBlacklist)
-	return isWhiteListed && !isBlackListed
+	if rule := findResourceRule(gvk, blacklist); rule != nil {
+		return ResourcePermission{List: blacklistName, Rule: rule, Message: fmt.Sprintf("denied by rule %s of %s", formatResourceRule(*rule), blacklistName)}
+	}
+	// all the namespaced resources are permitted if the namespace whitelist is not set
+	if namespaced && whitelist == nil {
+		return ResourcePermission{Permitted: true, List: whitelistName, Message: fmt.Sprintf("permitted since %s is not set", whitelistName)}
+	}
+	if rule := findResourceRule(gvk, whitelist); rule != nil {
+		return ResourcePermission{Permitted: true, List: whitelistName, Rule: rule, Message: fmt.Sprintf("permitted by rule %s of %s", formatResourceRule(*rule), whitelistName)}
+	}
+	return ResourcePermission{List: whitelistName, Message: fmt.Sprintf("denied since no rule of %s matches", whitelistName)}
 }
 
 // IsLiveResourcePermitted returns whether a live resource found in the cluster is permitted by an AppProject
 func (proj AppProject) IsLiveResourcePermitted(un *unstructured.Unstructured, server string, name string, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
-	return proj.IsResourcePermitted(un.GroupVersionKind().GroupKind(), un.GetNamespace(), ApplicationDestination{Server: server, Name: name}, projectClusters)
+	return proj.IsResourcePermitted(un.GroupVersionKind(), un.GetNamespace(), ApplicationDestination{Server: server, Name: name}, projectClusters)
 }
 
-func (proj AppProject) IsResourcePermitted(groupKind schema.GroupKind, namespace string, dest ApplicationDestination, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
-	if !proj.IsGroupKindPermitted(groupKind, namespace != "") {
+func (proj AppProject) IsResourcePermitted(gvk schema.GroupVersionKind, namespace string, dest ApplicationDestination, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
+	if !proj.IsGroupVersionKindPermitted(gvk, namespace != "") {
 		return false, nil
 	}
 	if namespace != "" {
@@ -358,6 +417,102 @@ func (proj AppProject) IsResourcePermitted(groupKind schema.GroupKind, namespace
 	return true, nil
 }
 
+// resourceRule is a parsed rule of the resource lists of a project. The group of a rule is a glob pattern, optionally
+// followed by a version constraint after a slash, e.g. apps/v1 or *.example.com/>=v1beta1. The version constraint is
+// either a glob pattern or a comparison (<, <=, =, >=, >) with a Kubernetes version, following the Kubernetes version
+// priority. The kind of a rule is a glob pattern or a regular expression between slashes, e.g. /^(Config|Secret)Map$/.
+type resourceRule struct {
+	group           string
+	versionOperator string
+	version         string
+	kind            string
+	kindRegexp      *regexp.Regexp
+}
+
+var (
+	versionConstraintRegexp = regexp.MustCompile(`^(<=|>=|<|>|=)(.*)$`)
+	kubeVersionRegexp       = regexp.MustCompile(`^v[1-9][0-9]*((alpha|beta)[1-9][0-9]*)?$`)
+)
+
+func parseResourceRule(item metav1.GroupKind) (*resourceRule, error) {
+	rule := &resourceRule{group: item.Group, kind: item.Kind}
+	if i := strings.Index(item.Group, "/"); i >= 0 {
+		rule.group, rule.version = item.Group[:i], item.Group[i+1:]
+		if rule.version == "" {
+			return nil, fmt.Errorf("the version constraint of group '%s' is empty", item.Group)
+		}
+		if match := versionConstraintRegexp.FindStringSubmatch(rule.version); match != nil {
+			rule.versionOperator, rule.version = match[1], match[2]
+			if !kubeVersionRegexp.MatchString(rule.version) {
+				return nil, fmt.Errorf("the version constraint of group '%s' does not compare with a Kubernetes version, e.g. v1beta1", item.Group)
+			}
+		} else if _, err := filepath.Match(rule.version, ""); err != nil {
+			return nil, fmt.Errorf("invalid version pattern of group '%s': %w", item.Group, err)
+		}
+	}
+	if _, err := filepath.Match(rule.group, ""); err != nil {
+		return nil, fmt.Errorf("invalid group pattern '%s': %w", rule.group, err)
+	}
+	if len(item.Kind) > 1 && strings.HasPrefix(item.Kind, "/") && strings.HasSuffix(item.Kind, "/") {
+		kindRegexp, err := regexp.Compile(item.Kind[1 : len(item.Kind)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid kind regular expression '%s': %w", item.Kind, err)
+		}
+		rule.kindRegexp = kindRegexp
+	} else if _, err := filepath.Match(rule.kind, ""); err != nil {
+		return nil, fmt.Errorf("invalid kind pattern '%s': %w", item.Kind, err)
+	}
+	return rule, nil
+}
+
+func (r *resourceRule) matches(gvk schema.GroupVersionKind) bool {
+	if r.kindRegexp != nil {
+		if !r.kindRegexp.MatchString(gvk.Kind) {
+			return false
+		}
+	} else if ok, err := filepath.Match(r.kind, gvk.Kind); !ok || err != nil {
+		return false
+	}
+	if ok, err := filepath.Match(r.group, gvk.Group); !ok || err != nil {
+		return false
+	}
+	if r.version == "" || gvk.Version == "" {
+		return true
+	}
+	if r.versionOperator == "" {
+		ok, err := filepath.Match(r.version, gvk.Version)
+		return ok && err == nil
+	}
+	order := version.CompareKubeAwareVersionStrings(gvk.Version, r.version)
+	switch r.versionOperator {
+	case "<":
+		return order < 0
+	case "<=":
+		return order <= 0
+	case ">":
+		return order > 0
+	case ">=":
+		return order >= 0
+	default:
+		return order == 0
+	}
+}
+
+// findResourceRule returns the first rule of the given list matching the given resource. Invalid rules never match.
+func findResourceRule(gvk schema.GroupVersionKind, list []metav1.GroupKind) *metav1.GroupKind {
+	for i := range list {
+		rule, err := parseResourceRule(list[i])
+		if err == nil && rule.matches(gvk) {
+			return &list[i]
+		}
+	}
+	return nil
+}
+
+func formatResourceRule(rule metav1.GroupKind) string {
+	return fmt.Sprintf("{group: '%s', kind: '%s'}", rule.Group, rule.Kind)
+}
+
 // HasFinalizer returns true if a resource finalizer is set on an AppProject
 func (proj AppProject) HasFinalizer() bool {
 	return getFinalizerIndex(proj.ObjectMeta, ResourcesFinalizerName) > -1
@@ -368,6 +523,38 @@ func (proj *AppProject) RemoveFinalizer() {
 	setFinalizer(&proj.ObjectMeta, ResourcesFinalizerName, false)
 }
 
+// IsAutomationPaused returns true if the automated syncs of the applications of the project are paused, along with
+// the reason why
+func (proj AppProject) IsAutomationPaused() (bool, string) {
+	if proj.Annotations[common.AnnotationKeyAutomationPaused] != "true" {
+		return false, ""
+	}
+	return true, proj.Annotations[common.AnnotationKeyAutomationPausedReason]
+}
+
+// GetImpersonatedServiceAccount returns the user name of the service account impersonated to sync an application to the
+// given destination. The first destination service account of the project matching the destination is used.
+func (proj AppProject) GetImpersonatedServiceAccount(dest ApplicationDestination) (string, error) {
+	for _, item := range proj.Spec.DestinationServiceAccounts {
+		namespacePattern := item.Namespace
+		if namespacePattern == "" {
+			namespacePattern = "*"
+		}
+		if !globMatch(item.Server, dest.Server, false) || !globMatch(namespacePattern, dest.Namespace, false) {
+			continue
+		}
+		namespace, name := dest.Namespace, item.DefaultServiceAccount
+		if parts := strings.SplitN(name, ":", 2); len(parts) == 2 {
+			namespace, name = parts[0], parts[1]
+		}
+		if namespace == "" || name == "" {
+			return "", fmt.Errorf("invalid service account '%s' for destination server %s and namespace %s", item.DefaultServiceAccount, dest.Server, dest.Namespace)
+		}
+		return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name), nil
+	}
+	return "", fmt.Errorf("no service account configured in project '%s' for destination server %s and namespace %s", proj.Name, dest.Server, dest.Namespace)
+}
+
 func globMatch(pattern string, val string, allowNegation bool, separators ...rune) bool {
 	if allowNegation && isDenyPattern(pattern) {
 		return !glob.Match(pattern[1:], val, separators...)
@@ -404,6 +591,97 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return anySourceMatched
 }
 
+// IsSourceRevisionPermitted validates if the provided target revision, e.g. a branch or a tag, can be deployed by the
+// applications of the project. An empty revision stands for HEAD.
+func (proj AppProject) IsSourceRevisionPermitted(revision string) bool {
+	if len(proj.Spec.SourceRevisions) == 0 {
+		return true
+	}
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	return isPermittedByPatterns(proj.Spec.SourceRevisions, revision)
+}
+
+// isPermittedByPatterns returns whether any allow pattern matches the value and no deny pattern, i.e. a pattern
+// prefixed with '!', rejects it
+func isPermittedByPatterns(patterns []string, val string) bool {
+	anyAllowPatternMatched := false
+	for _, pattern := range patterns {
+		if isDenyPattern(pattern) {
+			if !globMatch(pattern, val, true) {
+				return false
+			}
+		} else if globMatch(pattern, val, false) {
+			anyAllowPatternMatched = true
+		}
+	}
+	return anyAllowPatternMatched
+}
+
+// GetApplicationConstraintViolations returns the violations of the application constraints of the project by the
+// provided application, or nil if the application follows them
+func (proj AppProject) GetApplicationConstraintViolations(app *Application) []string {
+	constraints := proj.Spec.ApplicationConstraints
+	if constraints == nil {
+		return nil
+	}
+	var violations []string
+	for _, key := range constraints.RequiredLabels {
+		if app.Labels[key] == "" {
+			violations = append(violations, fmt.Sprintf("label '%s' is required", key))
+		}
+	}
+	if app.Spec.SyncPolicy != nil {
+		for _, option := range app.Spec.SyncPolicy.SyncOptions {
+			for _, pattern := range constraints.ForbiddenSyncOptions {
+				if globMatch(pattern, option, false) {
+					violations = append(violations, fmt.Sprintf("sync option '%s' is forbidden", option))
+					break
+				}
+			}
+		}
+	}
+	namespace := app.Spec.Destination.Namespace
+	if len(constraints.DestinationNamespaces) > 0 && !isPermittedByPatterns(constraints.DestinationNamespaces, namespace) {
+		violations = append(violations, fmt.Sprintf("destination namespace '%s' is not permitted", namespace))
+	}
+	return violations
+}
+
+// IsSyncApprovalRequired returns whether the sync operations to the provided destination must be approved before they start
+func (proj AppProject) IsSyncApprovalRequired(dst ApplicationDestination) bool {
+	for _, item := range proj.Spec.SyncApprovalDestinations {
+		serverMatched := (dst.Server != "" && globMatch(item.Server, dst.Server, false)) || (dst.Name != "" && globMatch(item.Name, dst.Name, false))
+		if serverMatched && globMatch(item.Namespace, dst.Namespace, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotificationSubscriptionPermitted validates if applications of the project can subscribe to the provided recipient of the notification service
+func (proj AppProject) IsNotificationSubscriptionPermitted(service string, recipient string) bool {
+	if len(proj.Spec.NotificationSubscriptions) == 0 {
+		return true
+	}
+	for _, rule := range proj.Spec.NotificationSubscriptions {
+		if !globMatch(rule.Service, service, false) {
+			continue
+		}
+		if len(rule.Recipients) == 0 {
+			return true
+		}
+		for _, r := range rule.Recipients {
+			if globMatch(r, recipient, false) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination, projectClusters func(project string) ([]*Cluster, error)) (bool, error) {
 	destinationMatched := proj.isDestinationMatched(dst)
@@ -5,9 +5,30 @@ const (
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
 
+	// AnnotationKeyRefreshDeadline is an annotation that contains the time, in RFC3339 format, after which the refresh
+	// requested with AnnotationKeyRefresh is dropped by the application controller if it has not started yet, e.g.
+	// because of the rate limits of the refreshes. Removed along with AnnotationKeyRefresh.
+	AnnotationKeyRefreshDeadline = "argocd.argoproj.io/refresh-deadline"
+
+	// AnnotationKeyRefreshDropped is an annotation that contains the reason why the application controller dropped the
+	// last requested refresh of an application. Removed once the application is refreshed or another refresh is
+	// requested.
+	AnnotationKeyRefreshDropped = "argocd.argoproj.io/refresh-dropped"
+
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
 	// source path within the repository.
 	AnnotationKeyManifestGeneratePaths = "argocd.argoproj.io/manifest-generate-paths"
+
+	// AnnotationKeyManifestWatchPaths is an annotation that contains a list of semicolon-separated glob patterns of
+	// additional paths in the manifests repository whose changes affect the application, such as Kustomize bases or
+	// Helm library charts located outside the application source path. Patterns follow the same rules as the paths in
+	// AnnotationKeyManifestGeneratePaths.
+	AnnotationKeyManifestWatchPaths = "argocd.argoproj.io/manifest-watch-paths"
+
+	// AnnotationKeyExpiresAt is an annotation that contains the time, in RFC3339 format, at which an application with a
+	// TTL expires once the TTL has been extended. It is set by the API server and preserved on the applications generated
+	// by an ApplicationSet, so that the extensions survive the updates of the generated applications.
+	AnnotationKeyExpiresAt = "argocd.argoproj.io/expires-at"
 )
@@ -0,0 +1,272 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
+)
+
+// ApplicationBuilder builds Applications programmatically. The built applications are defaulted and validated like
+// the API server does, so that tools generating Applications do not diverge from the applications created with the
+// API. The validation which requires looking up the project, the clusters and the repositories referenced by an
+// application is left to the API server.
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+// +protobuf=false
+type ApplicationBuilder struct {
+	app Application
+}
+
+// NewApplicationBuilder returns a builder of an application with the given name, in the given namespace
+func NewApplicationBuilder(name, namespace string) *ApplicationBuilder {
+	return &ApplicationBuilder{app: Application{
+		TypeMeta:   metav1.TypeMeta{Kind: application.ApplicationKind, APIVersion: SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}}
+}
+
+// WithProject sets the project of the application. The default project is used if none is set.
+func (b *ApplicationBuilder) WithProject(project string) *ApplicationBuilder {
+	b.app.Spec.Project = project
+	return b
+}
+
+// WithLabel sets a label of the application
+func (b *ApplicationBuilder) WithLabel(key, value string) *ApplicationBuilder {
+	if b.app.Labels == nil {
+		b.app.Labels = map[string]string{}
+	}
+	b.app.Labels[key] = value
+	return b
+}
+
+// WithAnnotation sets an annotation of the application
+func (b *ApplicationBuilder) WithAnnotation(key, value string) *ApplicationBuilder {
+	if b.app.Annotations == nil {
+		b.app.Annotations = map[string]string{}
+	}
+	b.app.Annotations[key] = value
+	return b
+}
+
+// WithResourcesFinalizer makes the deletion of the application cascade to its resources
+func (b *ApplicationBuilder) WithResourcesFinalizer() *ApplicationBuilder {
+	b.app.SetCascadedDeletion(ResourcesFinalizerName)
+	return b
+}
+
+// WithSource sets the single source of the application
+func (b *ApplicationBuilder) WithSource(source ApplicationSource) *ApplicationBuilder {
+	b.app.Spec.Source = &source
+	b.app.Spec.Sources = nil
+	return b
+}
+
+// WithSources sets the multiple sources of the application
+func (b *ApplicationBuilder) WithSources(sources ...ApplicationSource) *ApplicationBuilder {
+	b.app.Spec.Source = nil
+	b.app.Spec.Sources = append(ApplicationSources{}, sources...)
+	return b
+}
+
+// WithDestination sets the destination of the application to the namespace of the cluster of the given server URL
+func (b *ApplicationBuilder) WithDestination(server, namespace string) *ApplicationBuilder {
+	b.app.Spec.Destination = ApplicationDestination{Server: server, Namespace: namespace}
+	return b
+}
+
+// WithDestinationName sets the destination of the application to the namespace of the cluster of the given name
+func (b *ApplicationBuilder) WithDestinationName(name, namespace string) *ApplicationBuilder {
+	b.app.Spec.Destination = ApplicationDestination{Name: name, Namespace: namespace}
+	return b
+}
+
+// WithDestinationNamespaceTemplate sets the template rendered into the destination namespace of the application
+func (b *ApplicationBuilder) WithDestinationNamespaceTemplate(template string) *ApplicationBuilder {
+	b.app.Spec.Destination.NamespaceTemplate = template
+	return b
+}
+
+func (b *ApplicationBuilder) syncPolicy() *SyncPolicy {
+	if b.app.Spec.SyncPolicy == nil {
+		b.app.Spec.SyncPolicy = &SyncPolicy{}
+	}
+	return b.app.Spec.SyncPolicy
+}
+
+// WithAutomatedSync enables the automated sync of the application
+func (b *ApplicationBuilder) WithAutomatedSync(prune, selfHeal bool) *ApplicationBuilder {
+	b.syncPolicy().Automated = &SyncPolicyAutomated{Prune: prune, SelfHeal: selfHeal}
+	return b
+}
+
+// WithSyncOptions adds sync options, e.g. CreateNamespace=true, to the application
+func (b *ApplicationBuilder) WithSyncOptions(options ...string) *ApplicationBuilder {
+	policy := b.syncPolicy()
+	for _, option := range options {
+		policy.SyncOptions = policy.SyncOptions.AddOption(option)
+	}
+	return b
+}
+
+// WithRetry sets the strategy retrying the failed syncs of the application
+func (b *ApplicationBuilder) WithRetry(retry RetryStrategy) *ApplicationBuilder {
+	b.syncPolicy().Retry = &retry
+	return b
+}
+
+// WithSyncSchedule sets the schedule of the syncs of the application
+func (b *ApplicationBuilder) WithSyncSchedule(schedule SyncSchedule) *ApplicationBuilder {
+	b.syncPolicy().Schedule = &schedule
+	return b
+}
+
+// WithIgnoreDifferences adds differences of the resources of the application which are ignored
+func (b *ApplicationBuilder) WithIgnoreDifferences(ignoreDifferences ...ResourceIgnoreDifferences) *ApplicationBuilder {
+	b.app.Spec.IgnoreDifferences = append(b.app.Spec.IgnoreDifferences, ignoreDifferences...)
+	return b
+}
+
+// WithRevisionHistoryLimit sets the number of syncs kept in the history of the application
+func (b *ApplicationBuilder) WithRevisionHistoryLimit(limit int64) *ApplicationBuilder {
+	b.app.Spec.RevisionHistoryLimit = &limit
+	return b
+}
+
+// WithTTL sets the duration after which the application is deleted, e.g. 72h
+func (b *ApplicationBuilder) WithTTL(duration string) *ApplicationBuilder {
+	b.app.Spec.TTL = &ApplicationTTL{Duration: duration}
+	return b
+}
+
+// WithDeletionPropagationPolicy sets the propagation policy of the deletion of the resources of the application
+func (b *ApplicationBuilder) WithDeletionPropagationPolicy(policy string) *ApplicationBuilder {
+	b.app.Spec.Deletion = &ApplicationDeletion{PropagationPolicy: policy}
+	return b
+}
+
+// Build returns the application, with the defaults applied by the API server, or an error if the API server would
+// reject it as invalid
+func (b *ApplicationBuilder) Build() (*Application, error) {
+	app := b.app.DeepCopy()
+	if app.Name == "" {
+		return nil, fmt.Errorf("resource name may not be empty")
+	}
+	if conditions := app.Spec.Validate(); len(conditions) > 0 {
+		formatted := make([]string, 0, len(conditions))
+		for _, condition := range conditions {
+			formatted = append(formatted, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+		}
+		return nil, fmt.Errorf("application spec for %s is invalid: %s", app.Name, strings.Join(formatted, ";"))
+	}
+	app.Spec = *app.Spec.Normalize()
+	return app, nil
+}
+
+// ApplicationSourceBuilder builds the sources of Applications programmatically
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+// +protobuf=false
+type ApplicationSourceBuilder struct {
+	source ApplicationSource
+}
+
+// NewGitSourceBuilder returns a builder of a source of the manifests at the given path of a Git repository
+func NewGitSourceBuilder(repoURL, path, targetRevision string) *ApplicationSourceBuilder {
+	return &ApplicationSourceBuilder{source: ApplicationSource{RepoURL: repoURL, Path: path, TargetRevision: targetRevision}}
+}
+
+// NewHelmSourceBuilder returns a builder of a source of the given version of a chart of a Helm repository
+func NewHelmSourceBuilder(repoURL, chart, version string) *ApplicationSourceBuilder {
+	return &ApplicationSourceBuilder{source: ApplicationSource{RepoURL: repoURL, Chart: chart, TargetRevision: version}}
+}
+
+// WithRef sets the name under which the files of the source are referenced by the other sources of the application
+func (b *ApplicationSourceBuilder) WithRef(ref string) *ApplicationSourceBuilder {
+	b.source.Ref = ref
+	return b
+}
+
+func (b *ApplicationSourceBuilder) helm() *ApplicationSourceHelm {
+	if b.source.Helm == nil {
+		b.source.Helm = &ApplicationSourceHelm{}
+	}
+	return b.source.Helm
+}
+
+// WithHelmReleaseName sets the name of the Helm release
+func (b *ApplicationSourceBuilder) WithHelmReleaseName(releaseName string) *ApplicationSourceBuilder {
+	b.helm().ReleaseName = releaseName
+	return b
+}
+
+// WithHelmValueFiles adds Helm value files
+func (b *ApplicationSourceBuilder) WithHelmValueFiles(valueFiles ...string) *ApplicationSourceBuilder {
+	b.helm().ValueFiles = append(b.helm().ValueFiles, valueFiles...)
+	return b
+}
+
+// WithHelmValues sets the inline Helm values, as YAML
+func (b *ApplicationSourceBuilder) WithHelmValues(values string) *ApplicationSourceBuilder {
+	b.helm().Values = values
+	return b
+}
+
+// WithHelmParameter sets a Helm parameter
+func (b *ApplicationSourceBuilder) WithHelmParameter(name, value string, forceString bool) *ApplicationSourceBuilder {
+	b.helm().AddParameter(HelmParameter{Name: name, Value: value, ForceString: forceString})
+	return b
+}
+
+func (b *ApplicationSourceBuilder) kustomize() *ApplicationSourceKustomize {
+	if b.source.Kustomize == nil {
+		b.source.Kustomize = &ApplicationSourceKustomize{}
+	}
+	return b.source.Kustomize
+}
+
+// WithKustomizeNamePrefix sets the prefix of the names of the resources generated by Kustomize
+func (b *ApplicationSourceBuilder) WithKustomizeNamePrefix(prefix string) *ApplicationSourceBuilder {
+	b.kustomize().NamePrefix = prefix
+	return b
+}
+
+// WithKustomizeNameSuffix sets the suffix of the names of the resources generated by Kustomize
+func (b *ApplicationSourceBuilder) WithKustomizeNameSuffix(suffix string) *ApplicationSourceBuilder {
+	b.kustomize().NameSuffix = suffix
+	return b
+}
+
+// WithKustomizeImages overrides images with Kustomize, e.g. nginx:1.23
+func (b *ApplicationSourceBuilder) WithKustomizeImages(images ...KustomizeImage) *ApplicationSourceBuilder {
+	for _, image := range images {
+		b.kustomize().MergeImage(image)
+	}
+	return b
+}
+
+// WithDirectoryRecurse makes the manifests of the subdirectories of the path of a directory source be included
+func (b *ApplicationSourceBuilder) WithDirectoryRecurse() *ApplicationSourceBuilder {
+	if b.source.Directory == nil {
+		b.source.Directory = &ApplicationSourceDirectory{}
+	}
+	b.source.Directory.Recurse = true
+	return b
+}
+
+// WithPlugin sets the config management plugin generating the manifests of the source
+func (b *ApplicationSourceBuilder) WithPlugin(name string) *ApplicationSourceBuilder {
+	if b.source.Plugin == nil {
+		b.source.Plugin = &ApplicationSourcePlugin{}
+	}
+	b.source.Plugin.Name = name
+	return b
+}
+
+// Build returns the source
+func (b *ApplicationSourceBuilder) Build() ApplicationSource {
+	return *b.source.DeepCopy()
+}
@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationBuilder(t *testing.T) {
+	app, err := NewApplicationBuilder("guestbook", "argocd").
+		WithLabel("team", "payments").
+		WithResourcesFinalizer().
+		WithSource(NewGitSourceBuilder("https://github.com/argoproj/argocd-example-apps", "guestbook", "HEAD").
+			WithKustomizeNamePrefix("prod-").
+			Build()).
+		WithDestination(KubernetesInternalAPIServerAddr, "guestbook").
+		WithAutomatedSync(true, false).
+		WithSyncOptions("CreateNamespace=true", "CreateNamespace=true").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "Application", app.Kind)
+	assert.Equal(t, "argoproj.io/v1alpha1", app.APIVersion)
+	assert.Equal(t, map[string]string{"team": "payments"}, app.Labels)
+	assert.Equal(t, []string{ResourcesFinalizerName}, app.Finalizers)
+	// the default project is set like the API server does
+	assert.Equal(t, DefaultAppProjectName, app.Spec.Project)
+	assert.Equal(t, "prod-", app.Spec.Source.Kustomize.NamePrefix)
+	assert.Equal(t, SyncOptions{"CreateNamespace=true"}, app.Spec.SyncPolicy.SyncOptions)
+	assert.True(t, app.Spec.SyncPolicy.Automated.Prune)
+}
+
+func TestApplicationBuilder_Normalizes(t *testing.T) {
+	helm := NewHelmSourceBuilder("https://charts.example.com", "redis", "17.0.0").Build()
+	helm.Helm = &ApplicationSourceHelm{}
+	helm.Kustomize = &ApplicationSourceKustomize{}
+	app, err := NewApplicationBuilder("redis", "argocd").
+		WithProject("cache").
+		WithSources(helm, NewGitSourceBuilder("https://github.com/example/values", "", "main").WithRef("values").Build()).
+		WithDestinationName("in-cluster", "redis").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "cache", app.Spec.Project)
+	require.Len(t, app.Spec.Sources, 2)
+	assert.Nil(t, app.Spec.Sources[0].Helm)
+	assert.Nil(t, app.Spec.Sources[0].Kustomize)
+	assert.Equal(t, "values", app.Spec.Sources[1].Ref)
+}
+
+func TestApplicationBuilder_Invalid(t *testing.T) {
+	source := NewGitSourceBuilder("https://github.com/argoproj/argocd-example-apps", "guestbook", "HEAD").Build()
+
+	_, err := NewApplicationBuilder("", "argocd").WithSource(source).WithDestination(KubernetesInternalAPIServerAddr, "default").Build()
+	assert.EqualError(t, err, "resource name may not be empty")
+
+	_, err = NewApplicationBuilder("guestbook", "argocd").WithDestination(KubernetesInternalAPIServerAddr, "default").Build()
+	assert.EqualError(t, err, "application spec for guestbook is invalid: InvalidSpecError: spec.source.repoURL and either spec.source.path or spec.source.chart are required")
+
+	_, err = NewApplicationBuilder("redis", "argocd").
+		WithSource(NewHelmSourceBuilder("https://charts.example.com", "redis", "").Build()).
+		WithDestination(KubernetesInternalAPIServerAddr, "default").
+		Build()
+	assert.EqualError(t, err, "application spec for redis is invalid: InvalidSpecError: spec.source.targetRevision is required if the manifest source is a helm chart")
+
+	_, err = NewApplicationBuilder("guestbook", "argocd").WithSource(source).Build()
+	assert.EqualError(t, err, "application spec for guestbook is invalid: InvalidSpecError: Destination server missing from app spec")
+
+	_, err = NewApplicationBuilder("guestbook", "argocd").
+		WithSource(source).
+		WithDestination(KubernetesInternalAPIServerAddr, "default").
+		WithTTL("soon").
+		WithDeletionPropagationPolicy("orphan").
+		Build()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid TTL")
+	assert.Contains(t, err.Error(), "invalid deletion options")
+}
+
+func TestApplicationSourceBuilder(t *testing.T) {
+	source := NewHelmSourceBuilder("https://charts.example.com", "redis", "17.0.0").
+		WithHelmReleaseName("cache").
+		WithHelmValueFiles("values.yaml", "values-prod.yaml").
+		WithHelmParameter("replicas", "3", false).
+		WithHelmParameter("replicas", "5", true).
+		Build()
+	assert.Equal(t, "redis", source.Chart)
+	assert.Equal(t, "17.0.0", source.TargetRevision)
+	assert.Equal(t, "cache", source.Helm.ReleaseName)
+	assert.Equal(t, []string{"values.yaml", "values-prod.yaml"}, source.Helm.ValueFiles)
+	assert.Equal(t, []HelmParameter{{Name: "replicas", Value: "5", ForceString: true}}, source.Helm.Parameters)
+
+	builder := NewGitSourceBuilder("https://github.com/argoproj/argocd-example-apps", "kustomize-guestbook", "HEAD").
+		WithKustomizeImages("gcr.io/heptio-images/ks-guestbook-demo:0.2")
+	source = builder.Build()
+	assert.Equal(t, KustomizeImages{"gcr.io/heptio-images/ks-guestbook-demo:0.2"}, source.Kustomize.Images)
+	// the built sources do not share state with the builder
+	builder.WithKustomizeNamePrefix("dev-")
+	assert.Empty(t, source.Kustomize.NamePrefix)
+}
@@ -24,3 +24,43 @@ const (
 	// KubernetesInternalAPIServerAddr is address of the k8s API server when accessing internal to the cluster
 	KubernetesInternalAPIServerAddr = "https://kubernetes.default.svc"
 )
+
+// Normalize returns a copy of the spec with the defaults applied by the API server and the application controller: the
+// default project is set if none is, and the source-specific options whose values are all zero are removed
+func (spec *ApplicationSpec) Normalize() *ApplicationSpec {
+	spec = spec.DeepCopy()
+	if spec.Project == "" {
+		spec.Project = DefaultAppProjectName
+	}
+
+	if spec.Sources != nil && len(spec.Sources) > 0 {
+		for i := range spec.Sources {
+			spec.Sources[i].Normalize()
+		}
+	} else if spec.Source != nil {
+		spec.Source.Normalize()
+	}
+	return spec
+}
+
+// Normalize removes the source-specific options of the source whose values are all zero. This makes it easier for
+// users to switch between source types if they are not using any of the source-specific parameters.
+func (source *ApplicationSource) Normalize() {
+	if source.Kustomize != nil && source.Kustomize.IsZero() {
+		source.Kustomize = nil
+	}
+	if source.Helm != nil && source.Helm.IsZero() {
+		source.Helm = nil
+	}
+	if source.Directory != nil && source.Directory.IsZero() {
+		if source.Directory.Exclude != "" && source.Directory.Include != "" {
+			source.Directory = &ApplicationSourceDirectory{Exclude: source.Directory.Exclude, Include: source.Directory.Include}
+		} else if source.Directory.Exclude != "" {
+			source.Directory = &ApplicationSourceDirectory{Exclude: source.Directory.Exclude}
+		} else if source.Directory.Include != "" {
+			source.Directory = &ApplicationSourceDirectory{Include: source.Directory.Include}
+		} else {
+			source.Directory = nil
+		}
+	}
+}
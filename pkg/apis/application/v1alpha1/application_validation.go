@@ -0,0 +1,102 @@
+package v1alpha1
+
+import "fmt"
+
+// errDestinationMissing is the message of the condition reported for an application without destination
+const errDestinationMissing = "Destination server missing from app spec"
+
+// ValidateRequiredFields returns the condition reported by the API server for a source of an application which lacks
+// the fields required to generate manifests, or nil if it has them
+func (source *ApplicationSource) ValidateRequiredFields(hasMultipleSources bool) *ApplicationCondition {
+	if hasMultipleSources {
+		if source.RepoURL == "" || (source.Path == "" && source.Chart == "" && source.Ref == "") {
+			return &ApplicationCondition{
+				Type:    ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.source.repoURL and either source.path, source.chart, or source.ref are required for source %s", *source),
+			}
+		}
+	} else {
+		if source.RepoURL == "" || (source.Path == "" && source.Chart == "") {
+			return &ApplicationCondition{
+				Type:    ApplicationConditionInvalidSpecError,
+				Message: "spec.source.repoURL and either spec.source.path or spec.source.chart are required",
+			}
+		}
+	}
+	if source.Chart != "" && source.TargetRevision == "" {
+		return &ApplicationCondition{
+			Type:    ApplicationConditionInvalidSpecError,
+			Message: "spec.source.targetRevision is required if the manifest source is a helm chart",
+		}
+	}
+	return nil
+}
+
+// ValidateOptions returns the conditions reported by the API server for the invalid sync schedule, TTL and deletion
+// options of an application
+func (spec *ApplicationSpec) ValidateOptions() []ApplicationCondition {
+	var conditions []ApplicationCondition
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Schedule != nil {
+		if err := spec.SyncPolicy.Schedule.Validate(); err != nil {
+			conditions = append(conditions, ApplicationCondition{
+				Type:    ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync schedule: %v", err),
+			})
+		}
+	}
+
+	if spec.TTL != nil {
+		if err := spec.TTL.Validate(); err != nil {
+			conditions = append(conditions, ApplicationCondition{
+				Type:    ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid TTL: %v", err),
+			})
+		}
+	}
+
+	if spec.Deletion != nil {
+		if err := spec.Deletion.Validate(); err != nil {
+			conditions = append(conditions, ApplicationCondition{
+				Type:    ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid deletion options: %v", err),
+			})
+		}
+	}
+	return conditions
+}
+
+// ValidateNameAndServer returns an error if the destination references a cluster both by name and by server, unless
+// the server was inferred from the name
+func (dest *ApplicationDestination) ValidateNameAndServer() error {
+	if dest.Name != "" && dest.Server != "" && !dest.IsServerInferred() {
+		return fmt.Errorf("application destination can't have both name and server defined: %s %s", dest.Name, dest.Server)
+	}
+	return nil
+}
+
+// Validate returns the conditions reported by the API server for an invalid spec which can be detected without looking
+// up the project, the clusters and the repositories it references
+func (spec *ApplicationSpec) Validate() []ApplicationCondition {
+	var conditions []ApplicationCondition
+	if spec.HasMultipleSources() {
+		for i := range spec.Sources {
+			if condition := spec.Sources[i].ValidateRequiredFields(true); condition != nil {
+				return append(conditions, *condition)
+			}
+		}
+	} else {
+		source := spec.GetSource()
+		if condition := source.ValidateRequiredFields(false); condition != nil {
+			return append(conditions, *condition)
+		}
+	}
+
+	conditions = append(conditions, spec.ValidateOptions()...)
+
+	if err := spec.Destination.ValidateNameAndServer(); err != nil {
+		conditions = append(conditions, ApplicationCondition{Type: ApplicationConditionInvalidSpecError, Message: err.Error()})
+	} else if spec.Destination.Server == "" && spec.Destination.Name == "" {
+		conditions = append(conditions, ApplicationCondition{Type: ApplicationConditionInvalidSpecError, Message: errDestinationMissing})
+	}
+	return conditions
+}
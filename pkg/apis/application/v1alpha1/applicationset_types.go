@@ -22,6 +22,7 @@ import (
 	"sort"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/gitops-engine/pkg/health"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -87,6 +88,40 @@ type ApplicationMatchExpression struct {
 type ApplicationSetSyncPolicy struct {
 	// PreserveResourcesOnDeletion will preserve resources on deletion. If PreserveResourcesOnDeletion is set to true, these Applications will not be deleted.
 	PreserveResourcesOnDeletion bool `json:"preserveResourcesOnDeletion,omitempty" protobuf:"bytes,1,name=syncPolicy"`
+	// ApplicationsRemoval controls what happens to the generated Applications which are no longer produced by the generators.
+	// They are deleted (default), orphaned from the ApplicationSet or suspended by disabling their automated sync.
+	ApplicationsRemoval ApplicationsRemovalPolicy `json:"applicationsRemoval,omitempty" protobuf:"bytes,2,opt,name=applicationsRemoval,casttype=ApplicationsRemovalPolicy"`
+}
+
+// ApplicationsRemovalPolicy controls what happens to the generated Applications which are no longer produced by the
+// generators of their ApplicationSet
+type ApplicationsRemovalPolicy string
+
+const (
+	// ApplicationsRemovalPolicyDelete deletes the Applications
+	ApplicationsRemovalPolicyDelete ApplicationsRemovalPolicy = "delete"
+	// ApplicationsRemovalPolicyOrphan releases the Applications from the ApplicationSet, which stops managing them
+	ApplicationsRemovalPolicyOrphan ApplicationsRemovalPolicy = "orphan"
+	// ApplicationsRemovalPolicySuspend keeps the Applications managed by the ApplicationSet but disables their automated
+	// sync, until they are produced again by the generators
+	ApplicationsRemovalPolicySuspend ApplicationsRemovalPolicy = "suspend"
+)
+
+const (
+	// AnnotationKeyApplicationSetPreserveResourcesOnDeletion is the annotation which, set to "true" on a generated
+	// Application, preserves the resources of the Application when the ApplicationSet deletes it
+	AnnotationKeyApplicationSetPreserveResourcesOnDeletion = "applicationset.argoproj.io/preserve-resources-on-deletion"
+	// AnnotationKeyApplicationSetSuspended is the annotation set on the generated Applications suspended by the
+	// ApplicationSet because they are no longer produced by its generators
+	AnnotationKeyApplicationSetSuspended = "applicationset.argoproj.io/suspended"
+)
+
+// GetApplicationsRemovalPolicy returns the policy applied to the Applications which are no longer generated
+func (p *ApplicationSetSyncPolicy) GetApplicationsRemovalPolicy() ApplicationsRemovalPolicy {
+	if p == nil || p.ApplicationsRemoval == "" {
+		return ApplicationsRemovalPolicyDelete
+	}
+	return p.ApplicationsRemoval
 }
 
 // ApplicationSetTemplate represents argocd ApplicationSpec
@@ -143,8 +178,8 @@ type ApplicationSetNestedGenerator struct {
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
 
 // ApplicationSetTerminalGenerator represents a generator nested within a nested generator (for example, a list within
-// a merge within a matrix). A generator at this level may not be a combination-type generator (MatrixGenerator or
-// MergeGenerator). ApplicationSet enforces this nesting depth limit because CRDs do not support recursive types.
+// a merge within a matrix). A generator at this level may not be a MergeGenerator. ApplicationSet enforces this nesting
+// depth limit because CRDs do not support recursive types.
 // https://github.com/kubernetes-sigs/controller-tools/issues/477
 type ApplicationSetTerminalGenerator struct {
 	List                    *ListGenerator        `json:"list,omitempty" protobuf:"bytes,1,name=list"`
@@ -153,6 +188,10 @@ type ApplicationSetTerminalGenerator struct {
 	SCMProvider             *SCMProviderGenerator `json:"scmProvider,omitempty" protobuf:"bytes,4,name=scmProvider"`
 	ClusterDecisionResource *DuckTypeGenerator    `json:"clusterDecisionResource,omitempty" protobuf:"bytes,5,name=clusterDecisionResource"`
 	PullRequest             *PullRequestGenerator `json:"pullRequest,omitempty" protobuf:"bytes,6,name=pullRequest"`
+
+	// Matrix should have the form of NestedMatrixGenerator. Since it is not validated by the CRD, matrix generators may
+	// be nested at any depth.
+	Matrix *apiextensionsv1.JSON `json:"matrix,omitempty" protobuf:"bytes,7,name=matrix"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -170,6 +209,7 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			SCMProvider:             terminalGenerator.SCMProvider,
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
+			Matrix:                  terminalGenerator.Matrix,
 		}
 	}
 	return nestedGenerators
@@ -181,8 +221,8 @@ type ListGenerator struct {
 	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,2,name=template"`
 }
 
-// MatrixGenerator generates the cartesian product of two sets of parameters. The parameters are defined by two nested
-// generators.
+// MatrixGenerator generates the cartesian product of two or more sets of parameters. The parameters are defined by two
+// or more nested generators.
 type MatrixGenerator struct {
 	Generators []ApplicationSetNestedGenerator `json:"generators" protobuf:"bytes,1,name=generators"`
 	Template   ApplicationSetTemplate          `json:"template,omitempty" protobuf:"bytes,2,name=template"`
@@ -314,6 +354,9 @@ type GitGenerator struct {
 	RequeueAfterSeconds *int64                      `json:"requeueAfterSeconds,omitempty" protobuf:"bytes,5,name=requeueAfterSeconds"`
 	Template            ApplicationSetTemplate      `json:"template,omitempty" protobuf:"bytes,6,name=template"`
 	PathParamPrefix     string                      `json:"pathParamPrefix,omitempty" protobuf:"bytes,7,name=pathParamPrefix"`
+	// FlattenDelimiter is the delimiter joining the keys of the nested values of the files into parameter names, when
+	// goTemplate is false. Defaults to '.'.
+	FlattenDelimiter string `json:"flattenDelimiter,omitempty" protobuf:"bytes,8,name=flattenDelimiter"`
 }
 
 type GitDirectoryGeneratorItem struct {
@@ -322,7 +365,8 @@ type GitDirectoryGeneratorItem struct {
 }
 
 type GitFileGeneratorItem struct {
-	Path string `json:"path" protobuf:"bytes,1,name=path"`
+	Path    string `json:"path" protobuf:"bytes,1,name=path"`
+	Exclude bool   `json:"exclude,omitempty" protobuf:"bytes,2,name=exclude"`
 }
 
 // SCMProviderGenerator defines a generator that scrapes a SCMaaS API to find candidate repos.
@@ -438,6 +482,12 @@ type SCMProviderGeneratorFilter struct {
 	LabelMatch *string `json:"labelMatch,omitempty" protobuf:"bytes,4,opt,name=labelMatch"`
 	// A regex which must match the branch name.
 	BranchMatch *string `json:"branchMatch,omitempty" protobuf:"bytes,5,opt,name=branchMatch"`
+	// An array of topics, all of which the repo must have.
+	Topics []string `json:"topics,omitempty" protobuf:"bytes,6,rep,name=topics"`
+	// An array of languages, one of which must be the primary language of the repo.
+	Languages []string `json:"languages,omitempty" protobuf:"bytes,7,rep,name=languages"`
+	// Whether the repo must be archived or not.
+	Archived *bool `json:"archived,omitempty" protobuf:"varint,8,opt,name=archived"`
 }
 
 // PullRequestGenerator defines a generator that scrapes a PullRequest API to find candidate pull requests.
@@ -531,6 +581,69 @@ type ApplicationSetStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	Conditions        []ApplicationSetCondition         `json:"conditions,omitempty" protobuf:"bytes,1,name=conditions"`
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
+	// Generators contains the status of each generator of the ApplicationSet, in the order of the spec
+	Generators []ApplicationSetGeneratorStatus `json:"generators,omitempty" protobuf:"bytes,3,rep,name=generators"`
+	// Summary contains the aggregated health and sync status of the generated Applications
+	Summary *ApplicationSetSummary `json:"summary,omitempty" protobuf:"bytes,4,opt,name=summary"`
+}
+
+// ApplicationSetGeneratorStatus contains details about the last refresh of a generator of the ApplicationSet
+type ApplicationSetGeneratorStatus struct {
+	// Index is the index of the generator in the generators of the ApplicationSet
+	Index int64 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// Type is the type of the generator, e.g. List, Clusters or Matrix
+	Type string `json:"type" protobuf:"bytes,2,opt,name=type"`
+	// ParameterCount is the number of parameter sets produced by the generator during its last refresh
+	ParameterCount int64 `json:"parameterCount" protobuf:"varint,3,opt,name=parameterCount"`
+	// LastError is the error returned by the generator during its last refresh, if any
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,4,opt,name=lastError"`
+	// LastRefreshTime is the time of the last refresh of the generator
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty" protobuf:"bytes,5,opt,name=lastRefreshTime"`
+}
+
+// ApplicationSetSummary contains the aggregated health and sync status of the Applications generated by an ApplicationSet
+type ApplicationSetSummary struct {
+	// Total is the number of generated Applications
+	Total int64 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Health is the least healthy status of the generated Applications
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,2,opt,name=health"`
+	// Sync is OutOfSync if any of the generated Applications is out of sync, Unknown if the sync status of any of them
+	// is unknown and Synced otherwise
+	Sync SyncStatusCode `json:"sync,omitempty" protobuf:"bytes,3,opt,name=sync,casttype=SyncStatusCode"`
+	// HealthCounts contains the number of generated Applications per health status
+	HealthCounts map[string]int64 `json:"healthCounts,omitempty" protobuf:"bytes,4,rep,name=healthCounts"`
+	// SyncCounts contains the number of generated Applications per sync status
+	SyncCounts map[string]int64 `json:"syncCounts,omitempty" protobuf:"bytes,5,rep,name=syncCounts"`
+}
+
+// SummarizeApplications returns the aggregated health and sync status of the given Applications
+func SummarizeApplications(apps []Application) *ApplicationSetSummary {
+	summary := &ApplicationSetSummary{
+		Total:        int64(len(apps)),
+		Health:       health.HealthStatusHealthy,
+		Sync:         SyncStatusCodeSynced,
+		HealthCounts: map[string]int64{},
+		SyncCounts:   map[string]int64{},
+	}
+	for _, app := range apps {
+		healthStatus := app.Status.Health.Status
+		if healthStatus == "" {
+			healthStatus = health.HealthStatusUnknown
+		}
+		syncStatus := app.Status.Sync.Status
+		if syncStatus == "" {
+			syncStatus = SyncStatusCodeUnknown
+		}
+		summary.HealthCounts[string(healthStatus)]++
+		summary.SyncCounts[string(syncStatus)]++
+		if health.IsWorse(summary.Health, healthStatus) {
+			summary.Health = healthStatus
+		}
+		if syncStatus == SyncStatusCodeOutOfSync || (syncStatus == SyncStatusCodeUnknown && summary.Sync == SyncStatusCodeSynced) {
+			summary.Sync = syncStatus
+		}
+	}
+	return summary
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usally an error or warning
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -131,3 +132,40 @@ func assertAppSetConditions(t *testing.T, expected []ApplicationSetCondition, ac
 		assert.Equal(t, expected[i].Message, actual[i].Message)
 	}
 }
+
+func TestSummarizeApplications(t *testing.T) {
+	newApp := func(healthStatus health.HealthStatusCode, syncStatus SyncStatusCode) Application {
+		return Application{Status: ApplicationStatus{Health: HealthStatus{Status: healthStatus}, Sync: SyncStatus{Status: syncStatus}}}
+	}
+
+	t.Run("NoApplications", func(t *testing.T) {
+		summary := SummarizeApplications(nil)
+		assert.Equal(t, int64(0), summary.Total)
+		assert.Equal(t, health.HealthStatusHealthy, summary.Health)
+		assert.Equal(t, SyncStatusCodeSynced, summary.Sync)
+	})
+
+	t.Run("WorstStatuses", func(t *testing.T) {
+		summary := SummarizeApplications([]Application{
+			newApp(health.HealthStatusHealthy, SyncStatusCodeSynced),
+			newApp(health.HealthStatusDegraded, SyncStatusCodeOutOfSync),
+			newApp(health.HealthStatusProgressing, SyncStatusCodeSynced),
+		})
+		assert.Equal(t, int64(3), summary.Total)
+		assert.Equal(t, health.HealthStatusDegraded, summary.Health)
+		assert.Equal(t, SyncStatusCodeOutOfSync, summary.Sync)
+		assert.Equal(t, map[string]int64{"Healthy": 1, "Degraded": 1, "Progressing": 1}, summary.HealthCounts)
+		assert.Equal(t, map[string]int64{"Synced": 2, "OutOfSync": 1}, summary.SyncCounts)
+	})
+
+	t.Run("UnknownStatuses", func(t *testing.T) {
+		summary := SummarizeApplications([]Application{
+			newApp(health.HealthStatusHealthy, SyncStatusCodeSynced),
+			newApp("", ""),
+		})
+		assert.Equal(t, health.HealthStatusUnknown, summary.Health)
+		assert.Equal(t, SyncStatusCodeUnknown, summary.Sync)
+		assert.Equal(t, map[string]int64{"Healthy": 1, "Unknown": 1}, summary.HealthCounts)
+		assert.Equal(t, map[string]int64{"Synced": 1, "Unknown": 1}, summary.SyncCounts)
+	})
+}
@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Prefixes of the external IDs of the resources of Argo CD, which identify the kind of the resource an ID refers to
+const (
+	ExternalIDPrefixApplication = "app"
+	ExternalIDPrefixProject     = "proj"
+	ExternalIDPrefixRepository  = "repo"
+	ExternalIDPrefixCluster     = "cluster"
+)
+
+// externalID returns the ID made of the given prefix and of the hash of the given key fields. The key fields must not
+// change during the lifetime of the resource, so that its ID is stable.
+func externalID(prefix string, key ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(key, "\x00")))
+	return prefix + "-" + hex.EncodeToString(hash[:16])
+}
+
+// IsExternalIDOf returns whether the given ID is the ID of a resource of the kind of the given prefix
+func IsExternalIDOf(prefix string, id string) bool {
+	return strings.HasPrefix(id, prefix+"-")
+}
+
+// ExternalID returns the stable ID of the application, derived from its namespace and name, which cannot change
+func (app *Application) ExternalID() string {
+	return externalID(ExternalIDPrefixApplication, app.Namespace, app.Name)
+}
+
+// ExternalID returns the stable ID of the project, derived from its name, which cannot change
+func (proj *AppProject) ExternalID() string {
+	return externalID(ExternalIDPrefixProject, proj.Name)
+}
+
+// ExternalID returns the stable ID of the repository, derived from its project and URL, so that it does not change
+// when the repository is renamed or its credentials are updated
+func (repo *Repository) ExternalID() string {
+	return externalID(ExternalIDPrefixRepository, repo.Project, repo.Repo)
+}
+
+// ExternalID returns the stable ID of the cluster, derived from its server URL, so that it does not change when the
+// cluster is renamed or moved to another project
+func (c *Cluster) ExternalID() string {
+	return externalID(ExternalIDPrefixCluster, c.Server)
+}
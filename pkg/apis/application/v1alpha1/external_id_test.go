@@ -0,0 +1,34 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExternalID(t *testing.T) {
+	app := &Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}
+	assert.Equal(t, "app-", app.ExternalID()[:4])
+	assert.Len(t, app.ExternalID(), len("app-")+32)
+	assert.True(t, IsExternalIDOf(ExternalIDPrefixApplication, app.ExternalID()))
+	assert.False(t, IsExternalIDOf(ExternalIDPrefixProject, app.ExternalID()))
+
+	// the IDs are deterministic
+	assert.Equal(t, app.ExternalID(), (&Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}).ExternalID())
+	assert.NotEqual(t, app.ExternalID(), (&Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "team-a"}}).ExternalID())
+	// the key fields are separated, so that different keys do not collide
+	assert.NotEqual(t, app.ExternalID(), (&Application{ObjectMeta: metav1.ObjectMeta{Name: "book", Namespace: "argocdguest"}}).ExternalID())
+
+	// the IDs do not change when the resources are renamed
+	cluster := &Cluster{Name: "production", Server: "https://kubernetes.example.com"}
+	renamed := &Cluster{Name: "prod", Server: "https://kubernetes.example.com", Project: "payments"}
+	assert.Equal(t, cluster.ExternalID(), renamed.ExternalID())
+
+	repo := &Repository{Name: "apps", Repo: "https://github.com/argoproj/argocd-example-apps"}
+	assert.Equal(t, repo.ExternalID(), (&Repository{Name: "examples", Repo: repo.Repo, Username: "admin"}).ExternalID())
+	assert.NotEqual(t, repo.ExternalID(), (&Repository{Repo: repo.Repo, Project: "payments"}).ExternalID())
+
+	proj := &AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	assert.True(t, IsExternalIDOf(ExternalIDPrefixProject, proj.ExternalID()))
+}
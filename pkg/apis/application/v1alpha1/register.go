@@ -14,6 +14,10 @@ var (
 	ApplicationSchemaGroupVersionKind    = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind}
 	AppProjectSchemaGroupVersionKind     = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.AppProjectKind}
 	ApplicationSetSchemaGroupVersionKind = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationSetKind}
+	// RepositoryRegistrationSchemaGroupVersionKind is the group version kind of declared repositories
+	RepositoryRegistrationSchemaGroupVersionKind = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.RepositoryRegistrationKind}
+	// ClusterRegistrationSchemaGroupVersionKind is the group version kind of declared clusters
+	ClusterRegistrationSchemaGroupVersionKind = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ClusterRegistrationKind}
 )
 
 // Resource takes an unqualified resource and returns a Group-qualified GroupResource.
@@ -35,6 +39,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AppProjectList{},
 		&ApplicationSet{},
 		&ApplicationSetList{},
+		&RepositoryRegistration{},
+		&RepositoryRegistrationList{},
+		&ClusterRegistration{},
+		&ClusterRegistrationList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RepositoryRegistration declares a repository, which is reconciled into a repository secret. The credentials of the
+// repository are read from a secret, so that the registration itself holds no sensitive data.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=repositoryregistrations
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.spec.url`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.connectionState.status`
+type RepositoryRegistration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              RepositoryRegistrationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status            RegistrationStatus         `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// RepositoryRegistrationList is a list of RepositoryRegistration resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RepositoryRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RepositoryRegistration `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RepositoryRegistrationSpec is the specification of a declared repository
+type RepositoryRegistrationSpec struct {
+	// URL is the URL of the repository
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Type is the type of the repository, either "git" (default) or "helm"
+	Type string `json:"type,omitempty" protobuf:"bytes,2,opt,name=type"`
+	// Name is the name of the repository, required for Helm repositories
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// Project is the project the repository is scoped to, if any
+	Project string `json:"project,omitempty" protobuf:"bytes,4,opt,name=project"`
+	// Insecure specifies whether the connection to the repository ignores any errors when verifying TLS certificates or SSH host keys
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,5,opt,name=insecure"`
+	// EnableLFS specifies whether git-lfs support should be enabled for this repository
+	EnableLFS bool `json:"enableLfs,omitempty" protobuf:"varint,6,opt,name=enableLfs"`
+	// EnableOCI specifies whether helm-oci support should be enabled for this repository
+	EnableOCI bool `json:"enableOCI,omitempty" protobuf:"varint,7,opt,name=enableOCI"`
+	// Proxy is the HTTP/HTTPS proxy used to access the repository
+	Proxy string `json:"proxy,omitempty" protobuf:"bytes,8,opt,name=proxy"`
+	// GithubAppId specifies the ID of the GitHub app used to access the repository
+	GithubAppId int64 `json:"githubAppID,omitempty" protobuf:"varint,9,opt,name=githubAppID"`
+	// GithubAppInstallationId specifies the installation ID of the GitHub App used to access the repository
+	GithubAppInstallationId int64 `json:"githubAppInstallationID,omitempty" protobuf:"varint,10,opt,name=githubAppInstallationID"`
+	// GitHubAppEnterpriseBaseURL specifies the base URL of GitHub Enterprise installation. If empty will default to https://api.github.com
+	GitHubAppEnterpriseBaseURL string `json:"githubAppEnterpriseBaseUrl,omitempty" protobuf:"bytes,11,opt,name=githubAppEnterpriseBaseUrl"`
+	// ForceHttpBasicAuth specifies whether Argo CD should attempt to force basic auth for HTTP connections
+	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"varint,12,opt,name=forceHttpBasicAuth"`
+	// CredentialsSecretName is the name of a secret of the namespace of the registration holding the credentials of
+	// the repository, with the keys of repository secrets: username, password, sshPrivateKey, tlsClientCertData,
+	// tlsClientCertKey, githubAppPrivateKey and gcpServiceAccountKey
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty" protobuf:"bytes,13,opt,name=credentialsSecretName"`
+}
+
+// ClusterRegistration declares a cluster, which is reconciled into a cluster secret. The connection configuration of
+// the cluster is read from a secret, so that the registration itself holds no sensitive data.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusterregistrations
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Server",type=string,JSONPath=`.spec.server`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.connectionState.status`
+type ClusterRegistration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              ClusterRegistrationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status            RegistrationStatus      `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ClusterRegistrationList is a list of ClusterRegistration resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClusterRegistration `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterRegistrationSpec is the specification of a declared cluster
+type ClusterRegistrationSpec struct {
+	// Server is the API server URL of the Kubernetes cluster
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Name of the cluster. If omitted, will use the server address
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// Namespaces holds the list of namespaces which are accessible in that cluster. Cluster level resources will be ignored if namespace list is not empty.
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,3,opt,name=namespaces"`
+	// ClusterResources indicates if cluster level resources should be managed. This setting is used only if cluster is connected in a namespaced mode.
+	ClusterResources bool `json:"clusterResources,omitempty" protobuf:"varint,4,opt,name=clusterResources"`
+	// Project is the project the cluster is scoped to, if any
+	Project string `json:"project,omitempty" protobuf:"bytes,5,opt,name=project"`
+	// Shard contains optional shard number. Calculated on the fly by the application controller if not specified.
+	Shard *int64 `json:"shard,omitempty" protobuf:"varint,6,opt,name=shard"`
+	// Labels are the labels of the cluster secret, which can be used by ApplicationSet generators
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,7,opt,name=labels"`
+	// ConfigSecretName is the name of a secret of the namespace of the registration holding the connection
+	// configuration of the cluster as JSON in its "config" key, in the format of the config of cluster secrets. The
+	// secret is optional for the in-cluster server.
+	ConfigSecretName string `json:"configSecretName,omitempty" protobuf:"bytes,8,opt,name=configSecretName"`
+}
+
+// RegistrationStatus is the status of the reconciliation of a declared repository or cluster
+type RegistrationStatus struct {
+	// ConnectionState is the state of the connection to the repository or the cluster
+	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,1,opt,name=connectionState"`
+	// SecretName is the name of the secret the registration is reconciled into
+	SecretName string `json:"secretName,omitempty" protobuf:"bytes,2,opt,name=secretName"`
+	// ObservedGeneration is the generation of the registration which has been reconciled
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,3,opt,name=observedGeneration"`
+}
@@ -30,7 +30,7 @@ type RepoCreds struct {
 	GithubAppPrivateKey string `json:"githubAppPrivateKey,omitempty" protobuf:"bytes,7,opt,name=githubAppPrivateKey"`
 	// GithubAppId specifies the Github App ID of the app used to access the repo for GitHub app authentication
 	GithubAppId int64 `json:"githubAppID,omitempty" protobuf:"bytes,8,opt,name=githubAppID"`
-	// GithubAppInstallationId specifies the ID of the installed GitHub App for GitHub app authentication
+	// GithubAppInstallationId specifies the ID of the installed GitHub App for GitHub app authentication. If empty, the installation is discovered from the repository URL
 	GithubAppInstallationId int64 `json:"githubAppInstallationID,omitempty" protobuf:"bytes,9,opt,name=githubAppInstallationID"`
 	// GithubAppEnterpriseBaseURL specifies the GitHub API URL for GitHub app authentication. If empty will default to https://api.github.com
 	GitHubAppEnterpriseBaseURL string `json:"githubAppEnterpriseBaseUrl,omitempty" protobuf:"bytes,10,opt,name=githubAppEnterpriseBaseUrl"`
@@ -44,6 +44,21 @@ type RepoCreds struct {
 	Proxy string `json:"proxy,omitempty" protobuf:"bytes,19,opt,name=proxy"`
 	// ForceHttpBasicAuth specifies whether Argo CD should attempt to force basic auth for HTTP connections
 	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"bytes,20,opt,name=forceHttpBasicAuth"`
+	// URLRegex specifies whether URL is a regular expression matched against repository URLs instead of a URL prefix
+	URLRegex bool `json:"urlRegex,omitempty" protobuf:"bytes,21,opt,name=urlRegex"`
+	// Priority specifies the priority of the credentials when several credential templates match a repository URL.
+	// Templates with a higher priority are preferred, then templates with the longest URL.
+	Priority int64 `json:"priority,omitempty" protobuf:"bytes,22,opt,name=priority"`
+	// EnableLFS specifies whether git-lfs support is forcibly enabled for the repositories matching the credentials
+	EnableLFS bool `json:"enableLfs,omitempty" protobuf:"bytes,23,opt,name=enableLfs"`
+	// ForceProxy specifies whether Proxy overrides the proxy configured for the repositories matching the credentials
+	ForceProxy bool `json:"forceProxy,omitempty" protobuf:"bytes,24,opt,name=forceProxy"`
+	// TLSCACertData specifies the CA certificates in PEM format used to verify the TLS certificates of the repositories
+	// matching the credentials
+	TLSCACertData string `json:"tlsCACertData,omitempty" protobuf:"bytes,25,opt,name=tlsCACertData"`
+	// TLSServerName specifies the server name sent with SNI and used to verify the TLS certificates of the repositories
+	// matching the credentials, instead of the host of their URL
+	TLSServerName string `json:"tlsServerName,omitempty" protobuf:"bytes,26,opt,name=tlsServerName"`
 }
 
 // Repository is a repository holding application configurations
@@ -81,7 +96,7 @@ type Repository struct {
 	GithubAppPrivateKey string `json:"githubAppPrivateKey,omitempty" protobuf:"bytes,15,opt,name=githubAppPrivateKey"`
 	// GithubAppId specifies the ID of the GitHub app used to access the repo
 	GithubAppId int64 `json:"githubAppID,omitempty" protobuf:"bytes,16,opt,name=githubAppID"`
-	// GithubAppInstallationId specifies the installation ID of the GitHub App used to access the repo
+	// GithubAppInstallationId specifies the installation ID of the GitHub App used to access the repo. If empty, the installation is discovered from the repository URL
 	GithubAppInstallationId int64 `json:"githubAppInstallationID,omitempty" protobuf:"bytes,17,opt,name=githubAppInstallationID"`
 	// GithubAppEnterpriseBaseURL specifies the base URL of GitHub Enterprise installation. If empty will default to https://api.github.com
 	GitHubAppEnterpriseBaseURL string `json:"githubAppEnterpriseBaseUrl,omitempty" protobuf:"bytes,18,opt,name=githubAppEnterpriseBaseUrl"`
@@ -93,6 +108,11 @@ type Repository struct {
 	GCPServiceAccountKey string `json:"gcpServiceAccountKey,omitempty" protobuf:"bytes,21,opt,name=gcpServiceAccountKey"`
 	// ForceHttpBasicAuth specifies whether Argo CD should attempt to force basic auth for HTTP connections
 	ForceHttpBasicAuth bool `json:"forceHttpBasicAuth,omitempty" protobuf:"bytes,22,opt,name=forceHttpBasicAuth"`
+	// TLSCACertData contains the CA certificates in PEM format used to verify the TLS certificate of the repo server
+	TLSCACertData string `json:"tlsCACertData,omitempty" protobuf:"bytes,23,opt,name=tlsCACertData"`
+	// TLSServerName contains the server name sent with SNI and used to verify the TLS certificate of the repo server,
+	// instead of the host of the repository URL
+	TLSServerName string `json:"tlsServerName,omitempty" protobuf:"bytes,24,opt,name=tlsServerName"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification
@@ -143,6 +163,12 @@ func (repo *Repository) CopyCredentialsFromRepo(source *Repository) {
 		if repo.GCPServiceAccountKey == "" {
 			repo.GCPServiceAccountKey = source.GCPServiceAccountKey
 		}
+		if repo.TLSCACertData == "" {
+			repo.TLSCACertData = source.TLSCACertData
+		}
+		if repo.TLSServerName == "" {
+			repo.TLSServerName = source.TLSServerName
+		}
 		repo.ForceHttpBasicAuth = source.ForceHttpBasicAuth
 	}
 }
@@ -180,9 +206,18 @@ func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 		if repo.GCPServiceAccountKey == "" {
 			repo.GCPServiceAccountKey = source.GCPServiceAccountKey
 		}
-		if repo.Proxy == "" {
+		if repo.Proxy == "" || source.ForceProxy {
 			repo.Proxy = source.Proxy
 		}
+		if source.EnableLFS {
+			repo.EnableLFS = true
+		}
+		if source.TLSCACertData != "" {
+			repo.TLSCACertData = source.TLSCACertData
+		}
+		if repo.TLSServerName == "" {
+			repo.TLSServerName = source.TLSServerName
+		}
 		repo.ForceHttpBasicAuth = source.ForceHttpBasicAuth
 	}
 }
@@ -193,17 +228,22 @@ func (repo *Repository) GetGitCreds(store git.CredsStore) git.Creds {
 		return git.NopCreds{}
 	}
 	if repo.Password != "" {
-		return git.NewHTTPSCreds(repo.Username, repo.Password, repo.TLSClientCertData, repo.TLSClientCertKey, repo.IsInsecure(), repo.Proxy, store, repo.ForceHttpBasicAuth)
+		return git.NewHTTPSCreds(repo.Username, repo.Password, repo.TLSClientCertData, repo.TLSClientCertKey, repo.TLSCACertData, repo.TLSServerName, repo.IsInsecure(), repo.Proxy, store, repo.ForceHttpBasicAuth)
 	}
 	if repo.SSHPrivateKey != "" {
 		return git.NewSSHCreds(repo.SSHPrivateKey, getCAPath(repo.Repo), repo.IsInsecure(), store)
 	}
-	if repo.GithubAppPrivateKey != "" && repo.GithubAppId != 0 && repo.GithubAppInstallationId != 0 {
-		return git.NewGitHubAppCreds(repo.GithubAppId, repo.GithubAppInstallationId, repo.GithubAppPrivateKey, repo.GitHubAppEnterpriseBaseURL, repo.Repo, repo.TLSClientCertData, repo.TLSClientCertKey, repo.IsInsecure(), repo.Proxy, store)
+	// The installation is discovered from the repository URL if no installation ID is configured
+	if repo.GithubAppPrivateKey != "" && repo.GithubAppId != 0 {
+		return git.NewGitHubAppCreds(repo.GithubAppId, repo.GithubAppInstallationId, repo.GithubAppPrivateKey, repo.GitHubAppEnterpriseBaseURL, repo.Repo, repo.TLSClientCertData, repo.TLSClientCertKey, repo.TLSCACertData, repo.TLSServerName, repo.IsInsecure(), repo.Proxy, store)
 	}
 	if repo.GCPServiceAccountKey != "" {
 		return git.NewGoogleCloudCreds(repo.GCPServiceAccountKey)
 	}
+	// The repositories behind mutual TLS gateways may only have TLS settings
+	if repo.TLSClientCertData != "" || repo.TLSCACertData != "" || repo.TLSServerName != "" {
+		return git.NewHTTPSCreds("", "", repo.TLSClientCertData, repo.TLSClientCertKey, repo.TLSCACertData, repo.TLSServerName, repo.IsInsecure(), repo.Proxy, store, false)
+	}
 	return git.NopCreds{}
 }
 
@@ -213,8 +253,10 @@ func (repo *Repository) GetHelmCreds() helm.Creds {
 		Username:           repo.Username,
 		Password:           repo.Password,
 		CAPath:             getCAPath(repo.Repo),
+		CAData:             []byte(repo.TLSCACertData),
 		CertData:           []byte(repo.TLSClientCertData),
 		KeyData:            []byte(repo.TLSClientCertKey),
+		ServerName:         repo.TLSServerName,
 		InsecureSkipVerify: repo.Insecure,
 	}
 }
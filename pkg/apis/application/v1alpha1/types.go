@@ -3,9 +3,11 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -27,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
@@ -34,6 +37,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/calendar"
 	"github.com/argoproj/argo-cd/v2/util/collections"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	"github.com/argoproj/argo-cd/v2/util/security"
@@ -79,6 +83,79 @@ type ApplicationSpec struct {
 
 	// Sources is a reference to the location of the application's manifests or chart
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
+
+	// TTL is the lifetime of the application, after which it is deleted along with its resources, e.g. for ephemeral preview environments
+	TTL *ApplicationTTL `json:"ttl,omitempty" protobuf:"bytes,9,opt,name=ttl"`
+	// Deletion controls how the resources of the application are deleted when the application is deleted with cascade
+	Deletion *ApplicationDeletion `json:"deletion,omitempty" protobuf:"bytes,10,opt,name=deletion"`
+}
+
+const (
+	// DeletionPropagationPolicyForeground deletes the resources of the application in the foreground, waiting for their dependents to be deleted
+	DeletionPropagationPolicyForeground = "foreground"
+	// DeletionPropagationPolicyBackground deletes the resources of the application in the background, letting the garbage collector delete their dependents
+	DeletionPropagationPolicyBackground = "background"
+)
+
+// ApplicationDeletion controls the cascaded deletion of an application
+type ApplicationDeletion struct {
+	// PropagationPolicy is the propagation policy used to delete the resources of the application, "foreground" or "background".
+	// It is overridden by the policy requested when deleting the application. Defaults to "foreground".
+	PropagationPolicy string `json:"propagationPolicy,omitempty" protobuf:"bytes,1,opt,name=propagationPolicy"`
+}
+
+// Validate checks that the propagation policy is supported
+func (d *ApplicationDeletion) Validate() error {
+	switch d.PropagationPolicy {
+	case "", DeletionPropagationPolicyForeground, DeletionPropagationPolicyBackground:
+		return nil
+	default:
+		return fmt.Errorf("unsupported propagation policy '%s', must be '%s' or '%s'", d.PropagationPolicy, DeletionPropagationPolicyForeground, DeletionPropagationPolicyBackground)
+	}
+}
+
+// DefaultTTLWarningPeriod is the default period before the expiry of an application during which it has an ExpiringWarning condition
+const DefaultTTLWarningPeriod = time.Hour
+
+// ApplicationTTL controls the expiry of an application
+type ApplicationTTL struct {
+	// Duration is the lifetime of the application from its creation, e.g. "72h"
+	Duration string `json:"duration" protobuf:"bytes,1,opt,name=duration"`
+	// WarningPeriod is the period before the expiry during which the application has an ExpiringWarning condition, e.g. "24h". Defaults to 1h.
+	WarningPeriod string `json:"warningPeriod,omitempty" protobuf:"bytes,2,opt,name=warningPeriod"`
+}
+
+// Validate checks that the duration and the warning period of the TTL are valid
+func (t *ApplicationTTL) Validate() error {
+	duration, err := time.ParseDuration(t.Duration)
+	if err != nil {
+		return fmt.Errorf("cannot parse duration '%s': %w", t.Duration, err)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("duration '%s' must be positive", t.Duration)
+	}
+	if t.WarningPeriod != "" {
+		warningPeriod, err := time.ParseDuration(t.WarningPeriod)
+		if err != nil {
+			return fmt.Errorf("cannot parse warning period '%s': %w", t.WarningPeriod, err)
+		}
+		if warningPeriod < 0 {
+			return fmt.Errorf("warning period '%s' must not be negative", t.WarningPeriod)
+		}
+	}
+	return nil
+}
+
+// GetWarningPeriod returns the period before the expiry during which the application has an ExpiringWarning condition
+func (t *ApplicationTTL) GetWarningPeriod() time.Duration {
+	if t.WarningPeriod == "" {
+		return DefaultTTLWarningPeriod
+	}
+	warningPeriod, err := time.ParseDuration(t.WarningPeriod)
+	if err != nil || warningPeriod < 0 {
+		return DefaultTTLWarningPeriod
+	}
+	return warningPeriod
 }
 
 type TrackingMethod string
@@ -267,8 +344,35 @@ type RefreshType string
 const (
 	RefreshTypeNormal RefreshType = "normal"
 	RefreshTypeHard   RefreshType = "hard"
+	// RefreshTypeComparison compares the live state against the target state of the most recently compared revision,
+	// without resolving the latest revision of the sources
+	RefreshTypeComparison RefreshType = "comparison"
 )
 
+// Priority returns the precedence of the refresh type over the other ones when several refreshes of an application are
+// requested before the first one is processed: hard refreshes take precedence over normal refreshes, which take
+// precedence over comparisons
+func (t RefreshType) Priority() int {
+	switch t {
+	case RefreshTypeHard:
+		return 2
+	case RefreshTypeComparison:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// ParseRefreshType returns the refresh type of the given name, normal refreshes being the default
+func ParseRefreshType(name string) RefreshType {
+	switch RefreshType(name) {
+	case RefreshTypeHard, RefreshTypeComparison:
+		return RefreshType(name)
+	default:
+		return RefreshTypeNormal
+	}
+}
+
 type RefTarget struct {
 	Repo           Repository `protobuf:"bytes,1,opt,name=repo"`
 	TargetRevision string     `protobuf:"bytes,2,opt,name=targetRevision"`
@@ -289,7 +393,7 @@ type ApplicationSourceHelm struct {
 	Values string `json:"values,omitempty" protobuf:"bytes,4,opt,name=values"`
 	// FileParameters are file parameters to the helm template
 	FileParameters []HelmFileParameter `json:"fileParameters,omitempty" protobuf:"bytes,5,opt,name=fileParameters"`
-	// Version is the Helm version to use for templating ("3")
+	// Version is the Helm version to use for templating, either "v3" or the name of an additional Helm version registered in the argocd-cm config map
 	Version string `json:"version,omitempty" protobuf:"bytes,6,opt,name=version"`
 	// PassCredentials pass credentials to all domains (Helm's --pass-credentials)
 	PassCredentials bool `json:"passCredentials,omitempty" protobuf:"bytes,7,opt,name=passCredentials"`
@@ -614,6 +718,9 @@ type ApplicationDestination struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// Name is an alternate way of specifying the target cluster by its symbolic name
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// NamespaceTemplate is a Go template of the target namespace, e.g. "team-{{.app.name}}", which is rendered into
+	// Namespace. The template has access to the name, namespace, project, labels and annotations of the application.
+	NamespaceTemplate string `json:"namespaceTemplate,omitempty" protobuf:"bytes,4,opt,name=namespaceTemplate"`
 
 	// nolint:govet
 	isServerInferred bool `json:"-"`
@@ -626,6 +733,13 @@ var (
 	ResourceHealthLocationAppTree ResourceHealthLocation = "appTree"
 )
 
+type ResourceStatusLocation string
+
+var (
+	ResourceStatusLocationInline ResourceStatusLocation = ""
+	ResourceStatusLocationCache  ResourceStatusLocation = "cache"
+)
+
 // ApplicationStatus contains status information for the application
 type ApplicationStatus struct {
 	// Resources is a list of Kubernetes resources managed by this application
@@ -653,6 +767,9 @@ type ApplicationStatus struct {
 	ResourceHealthSource ResourceHealthLocation `json:"resourceHealthSource,omitempty" protobuf:"bytes,11,opt,name=resourceHealthSource"`
 	// SourceTypes specifies the type of the sources included in the application
 	SourceTypes []ApplicationSourceType `json:"sourceTypes,omitempty" protobuf:"bytes,12,opt,name=sourceTypes"`
+	// ResourcesSource indicates where the list of resources is stored: inline if not set or cache, if the status
+	// exceeded the maximum status size of the controller
+	ResourcesSource ResourceStatusLocation `json:"resourcesSource,omitempty" protobuf:"bytes,13,opt,name=resourcesSource"`
 }
 
 // JWTTokens represents a list of JWT tokens
@@ -666,8 +783,23 @@ type OperationInitiator struct {
 	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
 	// Automated is set to true if operation was initiated automatically by the application controller.
 	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
+	// Subject contains the subject claim of the token of the user who started the operation
+	Subject string `json:"subject,omitempty" protobuf:"bytes,3,opt,name=subject"`
+	// Client contains the kind of client through which the operation was started: UI, CLI or API
+	Client string `json:"client,omitempty" protobuf:"bytes,4,opt,name=client"`
+	// UserAgent contains the user agent of the client through which the operation was started
+	UserAgent string `json:"userAgent,omitempty" protobuf:"bytes,5,opt,name=userAgent"`
 }
 
+const (
+	// OperationInitiatorClientUI is the client of the operations started from the web UI
+	OperationInitiatorClientUI = "UI"
+	// OperationInitiatorClientCLI is the client of the operations started from the argocd CLI
+	OperationInitiatorClientCLI = "CLI"
+	// OperationInitiatorClientAPI is the client of the operations started from any other client of the API
+	OperationInitiatorClientAPI = "API"
+)
+
 // Operation contains information about a requested or running operation
 type Operation struct {
 	// Sync contains parameters for the operation
@@ -678,6 +810,16 @@ type Operation struct {
 	Info []*Info `json:"info,omitempty" protobuf:"bytes,3,name=info"`
 	// Retry controls the strategy to apply if a sync fails
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// Approval holds the approval of the operation, if the project requires the syncs to the destination of the application to be approved
+	Approval *OperationApproval `json:"approval,omitempty" protobuf:"bytes,5,opt,name=approval"`
+}
+
+// OperationApproval contains the approval of an operation by a different subject than the one who initiated it
+type OperationApproval struct {
+	// ApprovedBy is the name of the subject who approved the operation. The operation is pending approval if empty
+	ApprovedBy string `json:"approvedBy,omitempty" protobuf:"bytes,1,opt,name=approvedBy"`
+	// ApprovedAt holds the time the operation was approved
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,2,opt,name=approvedAt"`
 }
 
 // DryRun returns true if an operation was requested to be performed in dry run mode
@@ -688,6 +830,16 @@ func (o *Operation) DryRun() bool {
 	return false
 }
 
+// IsPendingApproval returns true if the operation requires an approval which was not given yet
+func (o *Operation) IsPendingApproval() bool {
+	return o.Approval != nil && o.Approval.ApprovedBy == ""
+}
+
+// IsApproved returns true if the operation was approved by a different subject than the one who initiated it
+func (o *Operation) IsApproved() bool {
+	return o.Approval != nil && o.Approval.ApprovedBy != "" && o.Approval.ApprovedAt != nil && o.Approval.ApprovedBy != o.InitiatedBy.Username
+}
+
 // SyncOperationResource contains resources to sync.
 type SyncOperationResource struct {
 	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
@@ -839,11 +991,58 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Schedule controls the syncs triggered at given times regardless of the detected changes
+	Schedule *SyncSchedule `json:"schedule,omitempty" protobuf:"bytes,5,opt,name=schedule"`
 }
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.Schedule == nil)
+}
+
+// SyncSchedule controls the syncs triggered at given times regardless of the detected changes, e.g. to resolve again
+// the dependencies of a Helm chart on the latest versions of other charts
+type SyncSchedule struct {
+	// Cron is the cron expression of the times at which the application is synced, e.g. "0 2 * * *"
+	Cron string `json:"cron" protobuf:"bytes,1,opt,name=cron"`
+	// Jitter is the maximum delay added to the scheduled times, spreading the syncs of the applications sharing a schedule, e.g. "10m"
+	Jitter string `json:"jitter,omitempty" protobuf:"bytes,2,opt,name=jitter"`
+}
+
+// Validate checks that the cron expression and the jitter of the schedule are valid
+func (s *SyncSchedule) Validate() error {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	if _, err := specParser.Parse(s.Cron); err != nil {
+		return fmt.Errorf("cannot parse cron '%s': %w", s.Cron, err)
+	}
+	if s.Jitter != "" {
+		jitter, err := time.ParseDuration(s.Jitter)
+		if err != nil {
+			return fmt.Errorf("cannot parse jitter '%s': %w", s.Jitter, err)
+		}
+		if jitter < 0 {
+			return fmt.Errorf("jitter '%s' must not be negative", s.Jitter)
+		}
+	}
+	return nil
+}
+
+// Next returns the first scheduled sync time after the given time. The jitter delay added to the scheduled time is
+// derived from the given seed, typically the qualified name of the application, so that it is stable across calls.
+func (s *SyncSchedule) Next(after time.Time, seed string) (time.Time, error) {
+	if err := s.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, _ := specParser.Parse(s.Cron)
+	var delay time.Duration
+	if jitter, _ := time.ParseDuration(s.Jitter); jitter > 0 {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(seed))
+		delay = time.Duration(h.Sum64() % uint64(jitter))
+	}
+	// the jitter delay is removed first so that the schedule is not skipped when the time is within the delay
+	return schedule.Next(after.UTC().Add(-delay)).Add(delay), nil
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -985,6 +1184,27 @@ type SyncOperationResult struct {
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,4,opt,name=sources"`
 	// Revisions holds the revision this sync operation was performed for respective indexed source in sources field
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
+	// ResourceOperations holds the duration and the server response of the slowest operations applied to the resources
+	// during the sync operation
+	ResourceOperations []ResourceOperationTrace `json:"resourceOperations,omitempty" protobuf:"bytes,6,rep,name=resourceOperations"`
+}
+
+// ResourceOperationTrace holds the duration and the server response of an operation applied to a resource during a sync
+type ResourceOperationTrace struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Operation is the operation applied to the resource, one of apply, create, replace, update or prune
+	Operation string `json:"operation" protobuf:"bytes,5,opt,name=operation"`
+	// StartedAt is the time the operation started at
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
+	// DurationMilliseconds is the duration of the operation, in milliseconds
+	DurationMilliseconds int64 `json:"durationMilliseconds" protobuf:"varint,7,opt,name=durationMilliseconds"`
+	// Message is the response of the server, or the error returned by the operation
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
+	// Failed is whether the operation failed
+	Failed bool `json:"failed,omitempty" protobuf:"varint,9,opt,name=failed"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1060,6 +1280,36 @@ type RevisionHistory struct {
 	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,8,opt,name=sources"`
 	// Revisions holds the revision of each source in sources field the sync was performed against
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,9,opt,name=revisions"`
+	// InitiatedBy contains information about who initiated the sync operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,10,opt,name=initiatedBy"`
+	// Approval holds the approval of the sync operation, if the project required one
+	Approval *OperationApproval `json:"approval,omitempty" protobuf:"bytes,11,opt,name=approval"`
+}
+
+// DriftRecord contains information about a drift of the live state of an application from its target state,
+// detected outside of a sync operation
+type DriftRecord struct {
+	// DetectedAt holds the time the drift was detected
+	DetectedAt metav1.Time `json:"detectedAt" protobuf:"bytes,1,opt,name=detectedAt"`
+	// Revision holds the revision the application was compared against when the drift was detected
+	Revision string `json:"revision,omitempty" protobuf:"bytes,2,opt,name=revision"`
+	// Revisions holds the revision of each source the application was compared against when the drift was detected
+	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,3,opt,name=revisions"`
+	// Resources holds the resources whose live state drifted from their target state
+	Resources []DriftedResource `json:"resources,omitempty" protobuf:"bytes,4,opt,name=resources"`
+}
+
+// DriftedResource identifies a resource whose live state drifted from its target state
+type DriftedResource struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Version   string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	Kind      string `json:"kind,omitempty" protobuf:"bytes,3,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	Name      string `json:"name,omitempty" protobuf:"bytes,5,opt,name=name"`
+	// Manager holds the name of the field manager which most recently modified the live resource, if known
+	Manager string `json:"manager,omitempty" protobuf:"bytes,6,opt,name=manager"`
+	// ModifiedAt holds the time the live resource was most recently modified by the manager, if known
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty" protobuf:"bytes,7,opt,name=modifiedAt"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -1127,6 +1377,16 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionCacheLimitWarning indicates that application has resource of a kind which exceeded the cluster cache limit
+	ApplicationConditionCacheLimitWarning = "CacheLimitWarning"
+	// ApplicationConditionAutomationPausedWarning indicates that the automated syncs of the application are paused
+	ApplicationConditionAutomationPausedWarning = "AutomationPausedWarning"
+	// ApplicationConditionExpiringWarning indicates that the TTL of the application is about to expire
+	ApplicationConditionExpiringWarning = "ExpiringWarning"
+	// ApplicationConditionDeprecatedAPIWarning indicates that resources use APIs deprecated or removed in the Kubernetes version of the destination cluster or in the next one
+	ApplicationConditionDeprecatedAPIWarning = "DeprecatedAPIWarning"
+	// ApplicationConditionCredentialExpiringWarning indicates that credentials of the repositories or of the destination cluster of the application are about to expire
+	ApplicationConditionCredentialExpiringWarning = "CredentialExpiringWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usally an error or warning
@@ -1392,6 +1652,8 @@ type ConnectionState struct {
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
 	// ModifiedAt contains the timestamp when this connection status has been determined
 	ModifiedAt *metav1.Time `json:"attemptedAt" protobuf:"bytes,3,opt,name=attemptedAt"`
+	// LastSuccessfulAt contains the timestamp of the last successful connection, if known
+	LastSuccessfulAt *metav1.Time `json:"lastSuccessfulAt,omitempty" protobuf:"bytes,4,opt,name=lastSuccessfulAt"`
 }
 
 // Cluster is the definition of a cluster resource
@@ -1426,6 +1688,9 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// MaxConcurrentSyncs is the maximum number of sync operations processed concurrently for applications deployed to the
+	// cluster. Overrides the default configured in argocd-cm if greater than zero.
+	MaxConcurrentSyncs int64 `json:"maxConcurrentSyncs,omitempty" protobuf:"bytes,14,opt,name=maxConcurrentSyncs"`
 }
 
 // Equals returns true if two cluster objects are considered to be equal
@@ -1455,6 +1720,10 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if c.MaxConcurrentSyncs != other.MaxConcurrentSyncs {
+		return false
+	}
+
 	if !collections.StringMapsEqual(c.Annotations, other.Annotations) {
 		return false
 	}
@@ -1498,6 +1767,31 @@ type ClusterCacheInfo struct {
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
 }
 
+// ClusterAPIResource contains information about an API resource discovered on a cluster
+type ClusterAPIResource struct {
+	// Group is the API group of the resource
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Version is the API version of the resource
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	// Kind is the kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,3,opt,name=kind"`
+	// Name is the plural name of the resource, as used in the URLs of the API
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Namespaced indicates whether the resource is namespaced
+	Namespaced bool `json:"namespaced" protobuf:"bytes,5,opt,name=namespaced"`
+	// Excluded indicates whether the resource is excluded from Argo CD's watch by the resource exclusions and
+	// inclusions of the settings
+	Excluded bool `json:"excluded,omitempty" protobuf:"bytes,6,opt,name=excluded"`
+}
+
+// ClusterAPIResourceList is a collection of API resources discovered on a cluster
+type ClusterAPIResourceList struct {
+	// Items holds the API resources
+	Items []ClusterAPIResource `json:"items" protobuf:"bytes,1,rep,name=items"`
+	// LastDiscoveryTime holds the time at which the API resources were last discovered by the application controller
+	LastDiscoveryTime *metav1.Time `json:"lastDiscoveryTime,omitempty" protobuf:"bytes,2,opt,name=lastDiscoveryTime"`
+}
+
 // ClusterList is a collection of Clusters.
 type ClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
@@ -1594,11 +1888,12 @@ type OverrideIgnoreDiff struct {
 }
 
 type rawResourceOverride struct {
-	HealthLua         string           `json:"health.lua,omitempty"`
-	UseOpenLibs       bool             `json:"health.lua.useOpenLibs,omitempty"`
-	Actions           string           `json:"actions,omitempty"`
-	IgnoreDifferences string           `json:"ignoreDifferences,omitempty"`
-	KnownTypeFields   []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthLua         string             `json:"health.lua,omitempty"`
+	UseOpenLibs       bool               `json:"health.lua.useOpenLibs,omitempty"`
+	Actions           string             `json:"actions,omitempty"`
+	IgnoreDifferences string             `json:"ignoreDifferences,omitempty"`
+	KnownTypeFields   []KnownTypeField   `json:"knownTypeFields,omitempty"`
+	HealthAggregation *HealthAggregation `json:"health.aggregation,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
@@ -1609,6 +1904,54 @@ type ResourceOverride struct {
 	Actions           string             `protobuf:"bytes,3,opt,name=actions"`
 	IgnoreDifferences OverrideIgnoreDiff `protobuf:"bytes,2,opt,name=ignoreDifferences"`
 	KnownTypeFields   []KnownTypeField   `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	HealthAggregation *HealthAggregation `protobuf:"bytes,6,opt,name=healthAggregation"`
+}
+
+const (
+	// HealthAggregationStrategyWorst makes a resource as healthy as the least healthy of its children
+	HealthAggregationStrategyWorst = "worst"
+	// HealthAggregationStrategyQuorum makes a resource healthy if a quorum of its children is healthy
+	HealthAggregationStrategyQuorum = "quorum"
+)
+
+// HealthAggregation defines how the health of a resource is aggregated from the health of the child resources it owns,
+// instead of being assessed from the resource itself
+type HealthAggregation struct {
+	// Strategy is the aggregation strategy, either worst or quorum
+	Strategy string `json:"strategy" protobuf:"bytes,1,opt,name=strategy"`
+	// Quorum is the number, or the percentage, of healthy children required for the resource to be healthy with the
+	// quorum strategy, e.g. 2 or 50%
+	Quorum string `json:"quorum,omitempty" protobuf:"bytes,2,opt,name=quorum"`
+}
+
+// Validate checks that the health aggregation has a supported strategy and, for the quorum strategy, a valid quorum
+func (a *HealthAggregation) Validate() error {
+	switch a.Strategy {
+	case HealthAggregationStrategyWorst:
+		return nil
+	case HealthAggregationStrategyQuorum:
+		_, err := a.GetQuorum(1)
+		return err
+	default:
+		return fmt.Errorf("unsupported health aggregation strategy '%s'", a.Strategy)
+	}
+}
+
+// GetQuorum returns the number of healthy children required for the resource to be healthy, out of the given total
+// number of children. Percentages are rounded up.
+func (a *HealthAggregation) GetQuorum(total int) (int, error) {
+	if a.Quorum == "" {
+		return 0, fmt.Errorf("the quorum strategy requires a quorum")
+	}
+	quorum := intstr.Parse(a.Quorum)
+	value, err := intstr.GetScaledValueFromIntOrPercent(&quorum, total, true)
+	if err != nil {
+		return 0, fmt.Errorf("invalid health aggregation quorum '%s': %w", a.Quorum, err)
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("invalid health aggregation quorum '%s': must not be negative", a.Quorum)
+	}
+	return value, nil
 }
 
 // TODO: describe this method
@@ -1621,6 +1964,7 @@ func (s *ResourceOverride) UnmarshalJSON(data []byte) error {
 	s.HealthLua = raw.HealthLua
 	s.UseOpenLibs = raw.UseOpenLibs
 	s.Actions = raw.Actions
+	s.HealthAggregation = raw.HealthAggregation
 	return yaml.Unmarshal([]byte(raw.IgnoreDifferences), &s.IgnoreDifferences)
 }
 
@@ -1630,7 +1974,7 @@ func (s ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), s.KnownTypeFields}
+	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), s.KnownTypeFields, s.HealthAggregation}
 	return json.Marshal(raw)
 }
 
@@ -1839,6 +2183,106 @@ type AppProjectSpec struct {
 	SourceNamespaces []string `json:"sourceNamespaces,omitempty" protobuf:"bytes,12,opt,name=sourceNamespaces"`
 	// PermitOnlyProjectScopedClusters determines whether destinations can only reference clusters which are project-scoped
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
+	// PolicyBundles contains a list of policy bundles the rendered manifests of applications in this project are evaluated against before sync
+	PolicyBundles []PolicyBundle `json:"policyBundles,omitempty" protobuf:"bytes,14,opt,name=policyBundles"`
+	// NotificationSubscriptions contains list of notification services and recipients applications in this project can subscribe to using annotations. Subscriptions are not restricted if empty
+	NotificationSubscriptions []NotificationSubscriptionRule `json:"notificationSubscriptions,omitempty" protobuf:"bytes,15,opt,name=notificationSubscriptions"`
+	// DisableScheduledSyncs disables the scheduled syncs of the applications in this project
+	DisableScheduledSyncs bool `json:"disableScheduledSyncs,omitempty" protobuf:"bytes,16,opt,name=disableScheduledSyncs"`
+	// DestinationServiceAccounts holds the service accounts impersonated to sync the applications of this project, per destination
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,17,rep,name=destinationServiceAccounts"`
+	// SourceRevisions contains list of target revisions (branches, tags, commits or Helm chart versions) which can be deployed. Supports glob patterns and negation with '!'. All the revisions are permitted if empty
+	SourceRevisions []string `json:"sourceRevisions,omitempty" protobuf:"bytes,18,rep,name=sourceRevisions"`
+	// ApplicationConstraints holds the conventions the applications of this project must follow, enforced when they are created or updated
+	ApplicationConstraints *ApplicationConstraints `json:"applicationConstraints,omitempty" protobuf:"bytes,19,opt,name=applicationConstraints"`
+	// SyncApprovalDestinations contains list of destinations the sync operations to which must be approved by a different subject than the one who initiated them
+	SyncApprovalDestinations []ApplicationDestination `json:"syncApprovalDestinations,omitempty" protobuf:"bytes,20,rep,name=syncApprovalDestinations"`
+}
+
+// ApplicationConstraints contains the conventions the specs of the applications of a project must follow
+type ApplicationConstraints struct {
+	// RequiredLabels contains list of label keys the applications must have
+	RequiredLabels []string `json:"requiredLabels,omitempty" protobuf:"bytes,1,rep,name=requiredLabels"`
+	// ForbiddenSyncOptions contains list of sync options (e.g. Replace=true) the applications cannot set. Supports glob patterns
+	ForbiddenSyncOptions []string `json:"forbiddenSyncOptions,omitempty" protobuf:"bytes,2,rep,name=forbiddenSyncOptions"`
+	// DestinationNamespaces contains list of namespaces the applications can be deployed to. Supports glob patterns and negation with '!'. All the namespaces are permitted if empty
+	DestinationNamespaces []string `json:"destinationNamespaces,omitempty" protobuf:"bytes,3,rep,name=destinationNamespaces"`
+}
+
+// ApplicationDestinationServiceAccount is the service account impersonated to sync the applications of a project to a destination
+type ApplicationDestinationServiceAccount struct {
+	// Server specifies the URL of the target cluster. Supports glob patterns
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace specifies the target namespace of the application. Supports glob patterns
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// DefaultServiceAccount is the service account impersonated to sync the applications, either in the "namespace:name"
+	// format or as a name of a service account in the destination namespace of the application
+	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
+}
+
+// NotificationSubscriptionRule permits applications to subscribe to recipients of a notification service
+type NotificationSubscriptionRule struct {
+	// Service is the name of the notification service (e.g. slack). Supports glob patterns
+	Service string `json:"service" protobuf:"bytes,1,opt,name=service"`
+	// Recipients contains list of recipients (e.g. Slack channels) of the service which can be subscribed to. Supports glob patterns. All the recipients of the service are permitted if empty
+	Recipients []string `json:"recipients,omitempty" protobuf:"bytes,2,rep,name=recipients"`
+}
+
+const (
+	// PolicyEngineRego evaluates Rego policies following the conftest conventions (deny/warn rules in package main)
+	PolicyEngineRego = "rego"
+	// PolicyEngineKyverno evaluates the validate rules of Kyverno policies
+	PolicyEngineKyverno = "kyverno"
+
+	// PolicyActionDeny fails the sync operation when a policy is violated
+	PolicyActionDeny = "deny"
+	// PolicyActionWarn only reports policy violations without failing the sync operation
+	PolicyActionWarn = "warn"
+)
+
+// PolicyBundle is a set of policies, stored in a Git repository, that manifests are evaluated against before sync
+type PolicyBundle struct {
+	// RepoURL is the URL of the Git repository containing the policies
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Path is the directory within the repository containing the policies
+	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
+	// TargetRevision is the revision of the repository to fetch the policies from. Defaults to HEAD
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,3,opt,name=targetRevision"`
+	// Engine is the policy engine used to evaluate the policies. Either "rego" or "kyverno"
+	Engine string `json:"engine" protobuf:"bytes,4,opt,name=engine"`
+	// Action is the action taken when a policy is violated. Either "deny" (default) or "warn"
+	Action string `json:"action,omitempty" protobuf:"bytes,5,opt,name=action"`
+}
+
+// IsWarnOnly returns true if violations of the bundle should not fail the sync operation
+func (b *PolicyBundle) IsWarnOnly() bool {
+	return b.Action == PolicyActionWarn
+}
+
+// GetTargetRevision returns the revision the policies should be fetched from
+func (b *PolicyBundle) GetTargetRevision() string {
+	if b.TargetRevision == "" {
+		return "HEAD"
+	}
+	return b.TargetRevision
+}
+
+// Validate checks that the policy bundle references a repository and a supported engine and action
+func (b *PolicyBundle) Validate() error {
+	if b.RepoURL == "" {
+		return fmt.Errorf("repoURL is required")
+	}
+	switch b.Engine {
+	case PolicyEngineRego, PolicyEngineKyverno:
+	default:
+		return fmt.Errorf("unsupported policy engine '%s', must be one of '%s' or '%s'", b.Engine, PolicyEngineRego, PolicyEngineKyverno)
+	}
+	switch b.Action {
+	case "", PolicyActionDeny, PolicyActionWarn:
+	default:
+		return fmt.Errorf("unsupported policy action '%s', must be one of '%s' or '%s'", b.Action, PolicyActionDeny, PolicyActionWarn)
+	}
+	return nil
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -1862,6 +2306,40 @@ type SyncWindow struct {
 	ManualSync bool `json:"manualSync,omitempty" protobuf:"bytes,7,opt,name=manualSync"`
 	//TimeZone of the sync that will be applied to the schedule
 	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
+	// Calendar is an external calendar defining when the window is active, instead of the schedule and duration
+	Calendar *SyncWindowCalendar `json:"calendar,omitempty" protobuf:"bytes,9,opt,name=calendar"`
+}
+
+// SyncWindowCalendar is an external calendar, e.g. a change freeze calendar maintained by release management, defining when a sync window is active
+type SyncWindowCalendar struct {
+	// Type is the format of the calendar: "ical" for an iCalendar feed, active during its events, or "http" for an endpoint returning a JSON object with an "active" boolean field
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// URL is the address of the calendar
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// RefreshInterval is how long the calendar is cached before it is fetched again, e.g. 10m. Defaults to 5m
+	RefreshInterval string `json:"refreshInterval,omitempty" protobuf:"bytes,3,opt,name=refreshInterval"`
+}
+
+// Validate checks whether the calendar has a valid configuration
+func (c *SyncWindowCalendar) Validate() error {
+	if c.Type != calendar.TypeICal && c.Type != calendar.TypeHTTP {
+		return fmt.Errorf("calendar type '%s' mismatch: can only be %s or %s", c.Type, calendar.TypeICal, calendar.TypeHTTP)
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid calendar URL '%s': must be an http or https URL", c.URL)
+	}
+	if _, err := c.getRefreshInterval(); err != nil {
+		return fmt.Errorf("cannot parse calendar refresh interval '%s': %s", c.RefreshInterval, err)
+	}
+	return nil
+}
+
+func (c *SyncWindowCalendar) getRefreshInterval() (time.Duration, error) {
+	if c.RefreshInterval == "" {
+		return calendar.DefaultRefreshInterval, nil
+	}
+	return time.ParseDuration(c.RefreshInterval)
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -1884,6 +2362,12 @@ func (s *SyncWindows) active(currentTime time.Time) *SyncWindows {
 		var active SyncWindows
 		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *s {
+			if w.Calendar != nil {
+				if w.calendarActive(currentTime) {
+					active = append(active, w)
+				}
+				continue
+			}
 			schedule, _ := specParser.Parse(w.Schedule)
 			duration, _ := time.ParseDuration(w.Duration)
 
@@ -1919,6 +2403,12 @@ func (s *SyncWindows) inactiveAllows(currentTime time.Time) *SyncWindows {
 		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *s {
 			if w.Kind == "allow" {
+				if w.Calendar != nil {
+					if !w.calendarActive(currentTime) {
+						inactive = append(inactive, w)
+					}
+					continue
+				}
 				schedule, sErr := specParser.Parse(w.Schedule)
 				duration, dErr := time.ParseDuration(w.Duration)
 				// Offset the nextWindow time to consider the timeZone of the sync window
@@ -2132,6 +2622,10 @@ func (w SyncWindow) active(currentTime time.Time) bool {
 	// first converted to UTC before search
 	currentTime = currentTime.UTC()
 
+	if w.Calendar != nil {
+		return w.calendarActive(currentTime)
+	}
+
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	schedule, _ := specParser.Parse(w.Schedule)
 	duration, _ := time.ParseDuration(w.Duration)
@@ -2143,6 +2637,21 @@ func (w SyncWindow) active(currentTime time.Time) bool {
 	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration))
 }
 
+// calendarActive returns true if the external calendar of the sync window is active. If the calendar cannot be fetched,
+// deny windows are considered active and allow windows inactive, so that syncs are blocked.
+func (w SyncWindow) calendarActive(currentTime time.Time) bool {
+	refreshInterval, err := w.Calendar.getRefreshInterval()
+	if err != nil {
+		refreshInterval = calendar.DefaultRefreshInterval
+	}
+	active, err := calendar.IsActive(w.Calendar.Type, w.Calendar.URL, refreshInterval, currentTime)
+	if err != nil {
+		log.Warnf("Failed to evaluate calendar %s of %s sync window: %v", w.Calendar.URL, w.Kind, err)
+		return w.Kind == "deny"
+	}
+	return active
+}
+
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string) error {
 
@@ -2189,6 +2698,9 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
+	if w.Calendar != nil {
+		return w.Calendar.Validate()
+	}
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	_, err := specParser.Parse(w.Schedule)
 	if err != nil {
@@ -2250,6 +2762,17 @@ type ConfigManagementPlugin struct {
 // HelmOptions holds helm options
 type HelmOptions struct {
 	ValuesFileSchemes []string `protobuf:"bytes,1,opt,name=valuesFileSchemes"`
+	// Versions maps the names of the additional Helm versions applications can be pinned to to the paths of their binary
+	Versions map[string]string `protobuf:"bytes,2,opt,name=versions"`
+}
+
+// GetBinaryPath returns the path of the binary of the given Helm version if it is an additional version, or an empty
+// string if the built-in binary is used
+func (o *HelmOptions) GetBinaryPath(version string) string {
+	if o == nil {
+		return ""
+	}
+	return o.Versions[version]
 }
 
 // KustomizeOptions are options for kustomize to use when building manifests
@@ -2281,11 +2804,21 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	if !ok {
 		return refreshType, false
 	}
+	return ParseRefreshType(typeStr), true
+}
 
-	if typeStr == string(RefreshTypeHard) {
-		refreshType = RefreshTypeHard
+// GetRefreshDeadline returns the time after which the requested refresh of an application is dropped if it has not
+// started yet, if any
+func (app *Application) GetRefreshDeadline() (time.Time, bool) {
+	value, ok := app.GetAnnotations()[AnnotationKeyRefreshDeadline]
+	if !ok {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
 	}
-	return refreshType, true
+	return deadline, true
 }
 
 // SetCascadedDeletion will enable cascaded deletion by setting the propagation policy finalizer
@@ -2330,6 +2863,44 @@ func (app *Application) GetPropagationPolicy() string {
 	return ""
 }
 
+// GetDeletionPropagationPolicy returns the propagation policy used to delete the resources of the application: the
+// one of the propagation policy finalizer if it has any, otherwise the one of its deletion options
+func (app *Application) GetDeletionPropagationPolicy() metav1.DeletionPropagation {
+	switch app.GetPropagationPolicy() {
+	case BackgroundPropagationPolicyFinalizer:
+		return metav1.DeletePropagationBackground
+	case ForegroundPropagationPolicyFinalizer:
+		return metav1.DeletePropagationForeground
+	}
+	if app.Spec.Deletion != nil && app.Spec.Deletion.PropagationPolicy == DeletionPropagationPolicyBackground {
+		return metav1.DeletePropagationBackground
+	}
+	return metav1.DeletePropagationForeground
+}
+
+// ExpiresAt returns the time at which the application expires, or nil if the application has no TTL. The expiry is
+// computed from the creation of the application, unless the TTL has been extended to a later time.
+func (app *Application) ExpiresAt() (*time.Time, error) {
+	if app.Spec.TTL == nil {
+		return nil, nil
+	}
+	if err := app.Spec.TTL.Validate(); err != nil {
+		return nil, err
+	}
+	duration, _ := time.ParseDuration(app.Spec.TTL.Duration)
+	expiresAt := app.CreationTimestamp.Add(duration)
+	if extendedTo, ok := app.Annotations[AnnotationKeyExpiresAt]; ok {
+		extendedAt, err := time.Parse(time.RFC3339, extendedTo)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse annotation %s '%s': %w", AnnotationKeyExpiresAt, extendedTo, err)
+		}
+		if extendedAt.After(expiresAt) {
+			expiresAt = extendedAt
+		}
+	}
+	return &expiresAt, nil
+}
+
 // IsFinalizerPresent checks if the app has a given finalizer
 func (app *Application) IsFinalizerPresent(finalizer string) bool {
 	return getFinalizerIndex(app.ObjectMeta, finalizer) > -1
@@ -2466,19 +3037,6 @@ func (spec ApplicationSpec) GetRevisionHistoryLimit() int {
 	return RevisionHistoryLimit
 }
 
-func isResourceInList(res metav1.GroupKind, list []metav1.GroupKind) bool {
-	for _, item := range list {
-		ok, err := filepath.Match(item.Kind, res.Kind)
-		if ok && err == nil {
-			ok, err = filepath.Match(item.Group, res.Group)
-			if ok && err == nil {
-				return true
-			}
-		}
-	}
-	return false
-}
-
 // getFinalizerIndex returns finalizer index in the list of object finalizers or -1 if finalizer does not exist
 func getFinalizerIndex(meta metav1.ObjectMeta, name string) int {
 	for i, finalizer := range meta.Finalizers {
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	fmt "fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"reflect"
@@ -19,6 +21,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v2/util/git"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -430,6 +434,104 @@ func TestAppProject_IsDestinationPermitted_PermitOnlyProjectScopedClusters(t *te
 	assert.True(t, strings.Contains(err.Error(), "could not retrieve project clusters"))
 }
 
+func TestAppProject_GetApplicationConstraintViolations(t *testing.T) {
+	app := &Application{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "a"}},
+		Spec: ApplicationSpec{
+			Destination: ApplicationDestination{Namespace: "team-a"},
+			SyncPolicy:  &SyncPolicy{SyncOptions: SyncOptions{"Replace=true", "CreateNamespace=true"}},
+		},
+	}
+
+	proj := AppProject{}
+	assert.Nil(t, proj.GetApplicationConstraintViolations(app))
+
+	proj.Spec.ApplicationConstraints = &ApplicationConstraints{
+		RequiredLabels:        []string{"team"},
+		DestinationNamespaces: []string{"team-*", "!team-admin"},
+	}
+	assert.Nil(t, proj.GetApplicationConstraintViolations(app))
+
+	proj.Spec.ApplicationConstraints.RequiredLabels = append(proj.Spec.ApplicationConstraints.RequiredLabels, "owner")
+	proj.Spec.ApplicationConstraints.ForbiddenSyncOptions = []string{"Replace=*"}
+	assert.Equal(t, []string{"label 'owner' is required", "sync option 'Replace=true' is forbidden"}, proj.GetApplicationConstraintViolations(app))
+
+	proj.Spec.ApplicationConstraints = &ApplicationConstraints{DestinationNamespaces: []string{"team-*", "!team-admin"}}
+	app.Spec.Destination.Namespace = "team-admin"
+	assert.Equal(t, []string{"destination namespace 'team-admin' is not permitted"}, proj.GetApplicationConstraintViolations(app))
+	app.Spec.Destination.Namespace = "default"
+	assert.Equal(t, []string{"destination namespace 'default' is not permitted"}, proj.GetApplicationConstraintViolations(app))
+}
+
+func TestAppProject_IsSourceRevisionPermitted(t *testing.T) {
+	testData := []struct {
+		projRevisions []string
+		revision      string
+		isPermitted   bool
+	}{{
+		projRevisions: nil, revision: "feature/foo", isPermitted: true,
+	}, {
+		projRevisions: []string{"release/*"}, revision: "release/1.0", isPermitted: true,
+	}, {
+		projRevisions: []string{"release/*"}, revision: "feature/foo", isPermitted: false,
+	}, {
+		projRevisions: []string{"release/*"}, revision: "", isPermitted: false,
+	}, {
+		projRevisions: []string{"HEAD", "main"}, revision: "", isPermitted: true,
+	}, {
+		projRevisions: []string{"v*", "!v*-rc*"}, revision: "v1.2.0", isPermitted: true,
+	}, {
+		projRevisions: []string{"v*", "!v*-rc*"}, revision: "v1.2.0-rc1", isPermitted: false,
+	}, {
+		projRevisions: []string{"*", "!feature/*"}, revision: "main", isPermitted: true,
+	}, {
+		projRevisions: []string{"*", "!feature/*"}, revision: "feature/foo", isPermitted: false,
+	}, {
+		projRevisions: []string{"!feature/*"}, revision: "main", isPermitted: false,
+	}}
+
+	for _, data := range testData {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				SourceRevisions: data.projRevisions,
+			},
+		}
+		assert.Equal(t, data.isPermitted, proj.IsSourceRevisionPermitted(data.revision), "%v %s", data.projRevisions, data.revision)
+	}
+}
+
+func TestAppProject_GetImpersonatedServiceAccount(t *testing.T) {
+	proj := AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: AppProjectSpec{
+			DestinationServiceAccounts: []ApplicationDestinationServiceAccount{
+				{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "deployer"},
+				{Server: "https://kubernetes.default.svc", Namespace: "shared", DefaultServiceAccount: "argocd:shared-deployer"},
+				{Server: "https://*.example.com", DefaultServiceAccount: "remote-deployer"},
+			},
+		},
+	}
+
+	user, err := proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:team-a:deployer", user)
+
+	user, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "shared"})
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:shared-deployer", user)
+
+	user, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://prod.example.com", Namespace: "guestbook"})
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:guestbook:remote-deployer", user)
+
+	_, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "kube-system"})
+	assert.Error(t, err)
+
+	// the service account namespace cannot be inferred from a destination without namespace
+	_, err = proj.GetImpersonatedServiceAccount(ApplicationDestination{Server: "https://prod.example.com"})
+	assert.Error(t, err)
+}
+
 func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	proj := AppProject{
 		Spec: AppProjectSpec{
@@ -481,6 +583,85 @@ func TestAppProject_IsGroupKindPermitted(t *testing.T) {
 	assert.True(t, proj6.IsGroupKindPermitted(schema.GroupKind{Group: "apps", Kind: "Action"}, true))
 }
 
+func TestAppProject_IsGroupVersionKindPermitted(t *testing.T) {
+	proj := AppProject{
+		Spec: AppProjectSpec{
+			NamespaceResourceWhitelist: []metav1.GroupKind{
+				{Group: "*.example.com/>=v1beta1", Kind: "*"},
+				{Group: "apps/v1", Kind: "/^(Deployment|StatefulSet)$/"},
+				{Group: "", Kind: "/Map$/"},
+			},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "batch/<v1", Kind: "*"}},
+			ClusterResourceWhitelist:   []metav1.GroupKind{{Group: "batch", Kind: "*"}},
+		},
+	}
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "stable.example.com", Version: "v1", Kind: "CronTab"}, true))
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "stable.example.com", Version: "v1beta1", Kind: "CronTab"}, true))
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "stable.example.com", Version: "v2", Kind: "CronTab"}, true))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "stable.example.com", Version: "v1alpha1", Kind: "CronTab"}, true))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "CronTab"}, true))
+	// the version constraints are ignored if the version is unknown
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "stable.example.com", Kind: "CronTab"}, true))
+
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, true))
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, true))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}, true))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "apps", Version: "v1beta2", Kind: "Deployment"}, true))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DeploymentConfig"}, true))
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, true))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, true))
+
+	assert.True(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}, false))
+	assert.False(t, proj.IsGroupVersionKindPermitted(schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}, true))
+}
+
+func TestAppProject_CheckResourcePermission(t *testing.T) {
+	proj := AppProject{
+		Spec: AppProjectSpec{
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+		},
+	}
+	assert.Equal(t, ResourcePermission{
+		List:    "namespaceResourceBlacklist",
+		Rule:    &metav1.GroupKind{Group: "apps", Kind: "Deployment"},
+		Message: "denied by rule {group: 'apps', kind: 'Deployment'} of namespaceResourceBlacklist",
+	}, proj.CheckResourcePermission(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, true))
+	assert.Equal(t, ResourcePermission{
+		Permitted: true,
+		List:      "namespaceResourceWhitelist",
+		Message:   "permitted since namespaceResourceWhitelist is not set",
+	}, proj.CheckResourcePermission(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, true))
+	assert.Equal(t, ResourcePermission{
+		List:    "clusterResourceWhitelist",
+		Message: "denied since no rule of clusterResourceWhitelist matches",
+	}, proj.CheckResourcePermission(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, false))
+}
+
+func TestAppProject_ValidateResourceRules(t *testing.T) {
+	for _, rule := range []metav1.GroupKind{
+		{Group: "apps", Kind: "Deployment"},
+		{Group: "*", Kind: "*"},
+		{Group: "apps/v1", Kind: "*"},
+		{Group: "*.example.com/v1*", Kind: "*"},
+		{Group: "/>=v1beta1", Kind: "/^Config(Map)?$/"},
+	} {
+		p := newTestProject()
+		p.Spec.NamespaceResourceWhitelist = []metav1.GroupKind{rule}
+		assert.NoError(t, p.ValidateProject(), rule)
+	}
+	for _, rule := range []metav1.GroupKind{
+		{Group: "apps/", Kind: "*"},
+		{Group: "apps/>=1", Kind: "*"},
+		{Group: "apps/v[", Kind: "*"},
+		{Group: "[", Kind: "*"},
+		{Group: "apps", Kind: "/(/"},
+	} {
+		p := newTestProject()
+		p.Spec.ClusterResourceBlacklist = []metav1.GroupKind{rule}
+		assert.ErrorContains(t, p.ValidateProject(), "invalid rule of clusterResourceBlacklist", rule)
+	}
+}
+
 func TestAppProject_GetRoleByName(t *testing.T) {
 	t.Run("NotExists", func(t *testing.T) {
 		p := &AppProject{}
@@ -669,6 +850,54 @@ func TestAppProject_ValidateDestinations(t *testing.T) {
 	})
 }
 
+func TestAppProject_ValidatePolicyBundles(t *testing.T) {
+	p := newTestProject()
+	p.Spec.PolicyBundles = []PolicyBundle{{RepoURL: "https://github.com/argoproj/policies", Engine: PolicyEngineKyverno}}
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.PolicyBundles[0].Action = PolicyActionWarn
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.PolicyBundles[0].Action = "ignore"
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.PolicyBundles[0].Action = PolicyActionDeny
+	p.Spec.PolicyBundles[0].Engine = "gatekeeper"
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.PolicyBundles[0].Engine = PolicyEngineRego
+	p.Spec.PolicyBundles[0].RepoURL = ""
+	assert.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ValidateNotificationSubscriptions(t *testing.T) {
+	p := newTestProject()
+	p.Spec.NotificationSubscriptions = []NotificationSubscriptionRule{{Service: "slack", Recipients: []string{"team-*"}}}
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.NotificationSubscriptions[0].Service = ""
+	assert.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_IsNotificationSubscriptionPermitted(t *testing.T) {
+	p := newTestProject()
+	assert.True(t, p.IsNotificationSubscriptionPermitted("slack", "org-wide"))
+
+	p.Spec.NotificationSubscriptions = []NotificationSubscriptionRule{
+		{Service: "slack", Recipients: []string{"team-*", "alerts"}},
+		{Service: "webhook"},
+		{Service: "teams-*", Recipients: []string{"prod"}},
+	}
+	assert.True(t, p.IsNotificationSubscriptionPermitted("slack", "team-a"))
+	assert.True(t, p.IsNotificationSubscriptionPermitted("slack", "alerts"))
+	assert.False(t, p.IsNotificationSubscriptionPermitted("slack", "org-wide"))
+	assert.True(t, p.IsNotificationSubscriptionPermitted("webhook", "github"))
+	assert.True(t, p.IsNotificationSubscriptionPermitted("webhook", ""))
+	assert.True(t, p.IsNotificationSubscriptionPermitted("teams-eu", "prod"))
+	assert.False(t, p.IsNotificationSubscriptionPermitted("teams-eu", "dev"))
+	assert.False(t, p.IsNotificationSubscriptionPermitted("email", "admin@example.com"))
+}
+
 // TestValidateRoleName tests for an invalid role name
 func TestAppProject_ValidateRoleName(t *testing.T) {
 	p := newTestProject()
@@ -1075,6 +1304,7 @@ func TestRepository_CopyCredentialsFromRepo(t *testing.T) {
 		{"SourceEnableLFS", &Repository{}, &Repository{EnableLFS: true}, Repository{EnableLFS: false}},
 		{"SourceTLSClientCertData", &Repository{}, &Repository{TLSClientCertData: "foo"}, Repository{TLSClientCertData: "foo"}},
 		{"SourceTLSClientCertKey", &Repository{}, &Repository{TLSClientCertKey: "foo"}, Repository{TLSClientCertKey: "foo"}},
+		{"SourceTLSServerName", &Repository{}, &Repository{TLSServerName: "foo"}, Repository{TLSServerName: "foo"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1107,6 +1337,13 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 		{"SourceSSHPrivateKey", &Repository{}, &RepoCreds{SSHPrivateKey: "foo"}, Repository{SSHPrivateKey: "foo"}},
 		{"SourceTLSClientCertData", &Repository{}, &RepoCreds{TLSClientCertData: "foo"}, Repository{TLSClientCertData: "foo"}},
 		{"SourceTLSClientCertKey", &Repository{}, &RepoCreds{TLSClientCertKey: "foo"}, Repository{TLSClientCertKey: "foo"}},
+		{"SourceProxy", &Repository{}, &RepoCreds{Proxy: "foo"}, Repository{Proxy: "foo"}},
+		{"SourceProxyNotForced", &Repository{Proxy: "bar"}, &RepoCreds{Proxy: "foo"}, Repository{Proxy: "bar"}},
+		{"SourceProxyForced", &Repository{Proxy: "bar"}, &RepoCreds{Proxy: "foo", ForceProxy: true}, Repository{Proxy: "foo"}},
+		{"SourceEnableLFS", &Repository{}, &RepoCreds{EnableLFS: true}, Repository{EnableLFS: true}},
+		{"SourceTLSCACertData", &Repository{TLSCACertData: "bar"}, &RepoCreds{TLSCACertData: "foo"}, Repository{TLSCACertData: "foo"}},
+		{"SourceTLSServerName", &Repository{}, &RepoCreds{TLSServerName: "foo"}, Repository{TLSServerName: "foo"}},
+		{"SourceTLSServerNameNotForced", &Repository{TLSServerName: "bar"}, &RepoCreds{TLSServerName: "foo"}, Repository{TLSServerName: "bar"}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1117,6 +1354,23 @@ func TestRepository_CopyCredentialsFrom(t *testing.T) {
 	}
 }
 
+func TestRepository_GetGitCreds(t *testing.T) {
+	assert.Equal(t, git.NopCreds{}, (&Repository{Repo: "https://github.com/argoproj/argo-cd"}).GetGitCreds(nil))
+
+	creds := (&Repository{Repo: "https://10.0.0.10/repo.git", TLSCACertData: "ca", TLSServerName: "git.example.com"}).GetGitCreds(nil)
+	httpsCreds, ok := creds.(git.GenericHTTPSCreds)
+	require.True(t, ok)
+	assert.Equal(t, "ca", httpsCreds.GetCAData())
+	assert.Equal(t, "git.example.com", httpsCreds.GetServerName())
+}
+
+func TestRepository_GetHelmCreds(t *testing.T) {
+	creds := (&Repository{Repo: "https://10.0.0.10/charts", Username: "user", TLSCACertData: "ca", TLSServerName: "helm.example.com"}).GetHelmCreds()
+	assert.Equal(t, "user", creds.Username)
+	assert.Equal(t, []byte("ca"), creds.CAData)
+	assert.Equal(t, "helm.example.com", creds.ServerName)
+}
+
 func TestRepository_CopySettingsFrom(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -1511,6 +1765,83 @@ func TestApplicationSourceKustomize_MergeImage(t *testing.T) {
 	})
 }
 
+func TestSyncSchedule_Validate(t *testing.T) {
+	assert.NoError(t, (&SyncSchedule{Cron: "0 2 * * *"}).Validate())
+	assert.NoError(t, (&SyncSchedule{Cron: "0 2 * * *", Jitter: "10m"}).Validate())
+	assert.Error(t, (&SyncSchedule{Cron: "0 2 * *"}).Validate())
+	assert.Error(t, (&SyncSchedule{Cron: "0 2 * * *", Jitter: "ten minutes"}).Validate())
+	assert.Error(t, (&SyncSchedule{Cron: "0 2 * * *", Jitter: "-1m"}).Validate())
+}
+
+func TestSyncSchedule_Next(t *testing.T) {
+	after := time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC)
+
+	next, err := (&SyncSchedule{Cron: "0 2 * * *"}).Next(after, "argocd/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC), next)
+
+	schedule := &SyncSchedule{Cron: "0 2 * * *", Jitter: "10m"}
+	next, err = schedule.Next(after, "argocd/guestbook")
+	require.NoError(t, err)
+	assert.False(t, next.Before(time.Date(2023, 1, 1, 2, 0, 0, 0, time.UTC)))
+	assert.True(t, next.Before(time.Date(2023, 1, 1, 2, 10, 0, 0, time.UTC)))
+
+	// the jitter delay is stable
+	again, err := schedule.Next(after, "argocd/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, next, again)
+
+	// a sync within the jitter delay of the scheduled time postpones the next sync to the next day
+	next, err = schedule.Next(next, "argocd/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.Day())
+
+	_, err = (&SyncSchedule{Cron: "invalid"}).Next(after, "argocd/guestbook")
+	assert.Error(t, err)
+}
+
+func TestApplicationTTL_Validate(t *testing.T) {
+	assert.NoError(t, (&ApplicationTTL{Duration: "72h"}).Validate())
+	assert.NoError(t, (&ApplicationTTL{Duration: "72h", WarningPeriod: "24h"}).Validate())
+	assert.Error(t, (&ApplicationTTL{}).Validate())
+	assert.Error(t, (&ApplicationTTL{Duration: "0s"}).Validate())
+	assert.Error(t, (&ApplicationTTL{Duration: "72h", WarningPeriod: "one day"}).Validate())
+	assert.Error(t, (&ApplicationTTL{Duration: "72h", WarningPeriod: "-1h"}).Validate())
+
+	assert.Equal(t, DefaultTTLWarningPeriod, (&ApplicationTTL{Duration: "72h"}).GetWarningPeriod())
+	assert.Equal(t, 24*time.Hour, (&ApplicationTTL{Duration: "72h", WarningPeriod: "24h"}).GetWarningPeriod())
+}
+
+func TestApplication_ExpiresAt(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	app := &Application{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(createdAt)}}
+
+	expiresAt, err := app.ExpiresAt()
+	require.NoError(t, err)
+	assert.Nil(t, expiresAt)
+
+	app.Spec.TTL = &ApplicationTTL{Duration: "72h"}
+	expiresAt, err = app.ExpiresAt()
+	require.NoError(t, err)
+	assert.Equal(t, createdAt.Add(72*time.Hour), *expiresAt)
+
+	// the TTL is extended to a later time
+	app.Annotations = map[string]string{AnnotationKeyExpiresAt: "2023-01-05T00:00:00Z"}
+	expiresAt, err = app.ExpiresAt()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 1, 5, 0, 0, 0, 0, time.UTC), *expiresAt)
+
+	// the TTL is not shortened
+	app.Annotations = map[string]string{AnnotationKeyExpiresAt: "2023-01-02T00:00:00Z"}
+	expiresAt, err = app.ExpiresAt()
+	require.NoError(t, err)
+	assert.Equal(t, createdAt.Add(72*time.Hour), *expiresAt)
+
+	app.Annotations = map[string]string{AnnotationKeyExpiresAt: "tomorrow"}
+	_, err = app.ExpiresAt()
+	assert.Error(t, err)
+}
+
 func TestSyncWindows_HasWindows(t *testing.T) {
 	t.Run("True", func(t *testing.T) {
 		proj := newTestProjectWithSyncWindows()
@@ -2449,6 +2780,57 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		assert.Error(t, window.Validate())
 	})
+	t.Run("Calendar", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{Type: "ical", URL: "https://calendar.example.com/freeze.ics"}}
+		assert.NoError(t, window.Validate())
+		window.Calendar.Type = "outlook"
+		assert.ErrorContains(t, window.Validate(), "calendar type 'outlook' mismatch")
+		window.Calendar.Type = "http"
+		window.Calendar.URL = "file:///etc/freeze"
+		assert.ErrorContains(t, window.Validate(), "must be an http or https URL")
+		window.Calendar.URL = "https://freeze.example.com"
+		window.Calendar.RefreshInterval = "often"
+		assert.ErrorContains(t, window.Validate(), "cannot parse calendar refresh interval")
+	})
+}
+
+func TestSyncWindows_Calendar(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/frozen":
+			_, _ = w.Write([]byte(`{"active": true}`))
+		case "/open":
+			_, _ = w.Write([]byte(`{"active": false}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer ts.Close()
+	calendarWindow := func(kind string, path string) *SyncWindow {
+		return &SyncWindow{Kind: kind, Applications: []string{"*"}, Calendar: &SyncWindowCalendar{Type: "http", URL: ts.URL + path}}
+	}
+
+	t.Run("ActiveDeny", func(t *testing.T) {
+		windows := SyncWindows{calendarWindow("deny", "/frozen")}
+		assert.True(t, windows[0].Active())
+		assert.False(t, windows.CanSync(false))
+	})
+	t.Run("InactiveDeny", func(t *testing.T) {
+		windows := SyncWindows{calendarWindow("deny", "/open")}
+		assert.Nil(t, windows.Active())
+		assert.True(t, windows.CanSync(false))
+	})
+	t.Run("InactiveAllow", func(t *testing.T) {
+		windows := SyncWindows{calendarWindow("allow", "/open")}
+		assert.Len(t, *windows.InactiveAllows(), 1)
+		assert.False(t, windows.CanSync(false))
+	})
+	t.Run("UnavailableCalendar", func(t *testing.T) {
+		deny := SyncWindows{calendarWindow("deny", "/unavailable")}
+		assert.False(t, deny.CanSync(false))
+		allow := SyncWindows{calendarWindow("allow", "/unavailable")}
+		assert.False(t, allow.CanSync(false))
+	})
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -2904,6 +3286,28 @@ func TestUnSetCascadedDeletion(t *testing.T) {
 	assert.ElementsMatch(t, []string{"alpha", "beta", "gamma"}, a.GetFinalizers())
 }
 
+func TestGetDeletionPropagationPolicy(t *testing.T) {
+	app := &Application{}
+	assert.Equal(t, metav1.DeletePropagationForeground, app.GetDeletionPropagationPolicy())
+
+	app.Spec.Deletion = &ApplicationDeletion{PropagationPolicy: DeletionPropagationPolicyBackground}
+	assert.Equal(t, metav1.DeletePropagationBackground, app.GetDeletionPropagationPolicy())
+
+	app.Finalizers = []string{ForegroundPropagationPolicyFinalizer}
+	assert.Equal(t, metav1.DeletePropagationForeground, app.GetDeletionPropagationPolicy())
+
+	app.Spec.Deletion.PropagationPolicy = DeletionPropagationPolicyForeground
+	app.Finalizers = []string{BackgroundPropagationPolicyFinalizer}
+	assert.Equal(t, metav1.DeletePropagationBackground, app.GetDeletionPropagationPolicy())
+}
+
+func TestApplicationDeletion_Validate(t *testing.T) {
+	assert.NoError(t, (&ApplicationDeletion{}).Validate())
+	assert.NoError(t, (&ApplicationDeletion{PropagationPolicy: DeletionPropagationPolicyForeground}).Validate())
+	assert.NoError(t, (&ApplicationDeletion{PropagationPolicy: DeletionPropagationPolicyBackground}).Validate())
+	assert.EqualError(t, (&ApplicationDeletion{PropagationPolicy: "orphan"}).Validate(), "unsupported propagation policy 'orphan', must be 'foreground' or 'background'")
+}
+
 func TestRemoveEnvEntry(t *testing.T) {
 	t.Run("Remove element from the list", func(t *testing.T) {
 		plugins := &ApplicationSourcePlugin{
@@ -3401,3 +3805,61 @@ func TestGetSources(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthAggregation_GetQuorum(t *testing.T) {
+	for _, tc := range []struct {
+		quorum   string
+		total    int
+		expected int
+	}{
+		{"2", 3, 2},
+		{"50%", 3, 2},
+		{"100%", 4, 4},
+		{"0", 4, 0},
+	} {
+		quorum, err := (&HealthAggregation{Strategy: HealthAggregationStrategyQuorum, Quorum: tc.quorum}).GetQuorum(tc.total)
+		assert.NoError(t, err, tc.quorum)
+		assert.Equal(t, tc.expected, quorum, tc.quorum)
+	}
+	for _, quorum := range []string{"", "half", "-1"} {
+		_, err := (&HealthAggregation{Strategy: HealthAggregationStrategyQuorum, Quorum: quorum}).GetQuorum(3)
+		assert.Error(t, err, quorum)
+	}
+}
+
+func TestHealthAggregation_Validate(t *testing.T) {
+	assert.NoError(t, (&HealthAggregation{Strategy: HealthAggregationStrategyWorst}).Validate())
+	assert.NoError(t, (&HealthAggregation{Strategy: HealthAggregationStrategyQuorum, Quorum: "50%"}).Validate())
+	assert.EqualError(t, (&HealthAggregation{Strategy: "best"}).Validate(), "unsupported health aggregation strategy 'best'")
+	assert.Error(t, (&HealthAggregation{Strategy: HealthAggregationStrategyQuorum}).Validate())
+}
+
+func TestApplication_IsRefreshRequested(t *testing.T) {
+	app := &Application{}
+	_, ok := app.IsRefreshRequested()
+	assert.False(t, ok)
+
+	for value, expected := range map[string]RefreshType{"hard": RefreshTypeHard, "normal": RefreshTypeNormal, "comparison": RefreshTypeComparison, "true": RefreshTypeNormal} {
+		app.Annotations = map[string]string{AnnotationKeyRefresh: value}
+		refreshType, ok := app.IsRefreshRequested()
+		assert.True(t, ok)
+		assert.Equal(t, expected, refreshType, value)
+	}
+	assert.Greater(t, RefreshTypeHard.Priority(), RefreshTypeNormal.Priority())
+	assert.Greater(t, RefreshTypeNormal.Priority(), RefreshTypeComparison.Priority())
+}
+
+func TestApplication_GetRefreshDeadline(t *testing.T) {
+	app := &Application{}
+	_, ok := app.GetRefreshDeadline()
+	assert.False(t, ok)
+
+	app.Annotations = map[string]string{AnnotationKeyRefreshDeadline: "tomorrow"}
+	_, ok = app.GetRefreshDeadline()
+	assert.False(t, ok)
+
+	app.Annotations[AnnotationKeyRefreshDeadline] = "2022-01-01T00:00:00Z"
+	deadline, ok := app.GetRefreshDeadline()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), deadline)
+}
@@ -156,6 +156,38 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PolicyBundles != nil {
+		in, out := &in.PolicyBundles, &out.PolicyBundles
+		*out = make([]PolicyBundle, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotificationSubscriptions != nil {
+		in, out := &in.NotificationSubscriptions, &out.NotificationSubscriptions
+		*out = make([]NotificationSubscriptionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DestinationServiceAccounts != nil {
+		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
+		*out = make([]ApplicationDestinationServiceAccount, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceRevisions != nil {
+		in, out := &in.SourceRevisions, &out.SourceRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationConstraints != nil {
+		in, out := &in.ApplicationConstraints, &out.ApplicationConstraints
+		*out = new(ApplicationConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncApprovalDestinations != nil {
+		in, out := &in.SyncApprovalDestinations, &out.SyncApprovalDestinations
+		*out = make([]ApplicationDestination, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -245,6 +277,53 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationConstraints) DeepCopyInto(out *ApplicationConstraints) {
+	*out = *in
+	if in.RequiredLabels != nil {
+		in, out := &in.RequiredLabels, &out.RequiredLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForbiddenSyncOptions != nil {
+		in, out := &in.ForbiddenSyncOptions, &out.ForbiddenSyncOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationNamespaces != nil {
+		in, out := &in.DestinationNamespaces, &out.DestinationNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationConstraints.
+func (in *ApplicationConstraints) DeepCopy() *ApplicationConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDeletion) DeepCopyInto(out *ApplicationDeletion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDeletion.
+func (in *ApplicationDeletion) DeepCopy() *ApplicationDeletion {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDeletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -261,6 +340,22 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationServiceAccount.
+func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinationServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -444,6 +539,26 @@ func (in *ApplicationSetGenerator) DeepCopy() *ApplicationSetGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetGeneratorStatus) DeepCopyInto(out *ApplicationSetGeneratorStatus) {
+	*out = *in
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetGeneratorStatus.
+func (in *ApplicationSetGeneratorStatus) DeepCopy() *ApplicationSetGeneratorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetGeneratorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetList) DeepCopyInto(out *ApplicationSetList) {
 	*out = *in
@@ -662,6 +777,18 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Generators != nil {
+		in, out := &in.Generators, &out.Generators
+		*out = make([]ApplicationSetGeneratorStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(ApplicationSetSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -696,6 +823,36 @@ func (in *ApplicationSetStrategy) DeepCopy() *ApplicationSetStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetSummary) DeepCopyInto(out *ApplicationSetSummary) {
+	*out = *in
+	if in.HealthCounts != nil {
+		in, out := &in.HealthCounts, &out.HealthCounts
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SyncCounts != nil {
+		in, out := &in.SyncCounts, &out.SyncCounts
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetSummary.
+func (in *ApplicationSetSummary) DeepCopy() *ApplicationSetSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetSyncPolicy) DeepCopyInto(out *ApplicationSetSyncPolicy) {
 	*out = *in
@@ -798,6 +955,11 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(PullRequestGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1132,6 +1294,16 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(ApplicationTTL)
+		**out = **in
+	}
+	if in.Deletion != nil {
+		in, out := &in.Deletion, &out.Deletion
+		*out = new(ApplicationDeletion)
+		**out = **in
+	}
 	return
 }
 
@@ -1229,6 +1401,22 @@ func (in *ApplicationSummary) DeepCopy() *ApplicationSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationTTL) DeepCopyInto(out *ApplicationTTL) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationTTL.
+func (in *ApplicationTTL) DeepCopy() *ApplicationTTL {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationTTL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 	*out = *in
@@ -1372,6 +1560,47 @@ func (in *Cluster) DeepCopy() *Cluster {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAPIResource) DeepCopyInto(out *ClusterAPIResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAPIResource.
+func (in *ClusterAPIResource) DeepCopy() *ClusterAPIResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAPIResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAPIResourceList) DeepCopyInto(out *ClusterAPIResourceList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAPIResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastDiscoveryTime != nil {
+		in, out := &in.LastDiscoveryTime, &out.LastDiscoveryTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAPIResourceList.
+func (in *ClusterAPIResourceList) DeepCopy() *ClusterAPIResourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAPIResourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCacheInfo) DeepCopyInto(out *ClusterCacheInfo) {
 	*out = *in
@@ -1491,6 +1720,100 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistration) DeepCopyInto(out *ClusterRegistration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistration.
+func (in *ClusterRegistration) DeepCopy() *ClusterRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRegistration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistrationList) DeepCopyInto(out *ClusterRegistrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRegistration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistrationList.
+func (in *ClusterRegistrationList) DeepCopy() *ClusterRegistrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistrationSpec) DeepCopyInto(out *ClusterRegistrationSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Shard != nil {
+		in, out := &in.Shard, &out.Shard
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistrationSpec.
+func (in *ClusterRegistrationSpec) DeepCopy() *ClusterRegistrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Command) DeepCopyInto(out *Command) {
 	*out = *in
@@ -1587,6 +1910,10 @@ func (in *ConnectionState) DeepCopyInto(out *ConnectionState) {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.LastSuccessfulAt != nil {
+		in, out := &in.LastSuccessfulAt, &out.LastSuccessfulAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1600,6 +1927,55 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftRecord) DeepCopyInto(out *DriftRecord) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]DriftedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftRecord.
+func (in *DriftRecord) DeepCopy() *DriftRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResource) DeepCopyInto(out *DriftedResource) {
+	*out = *in
+	if in.ModifiedAt != nil {
+		in, out := &in.ModifiedAt, &out.ModifiedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResource.
+func (in *DriftedResource) DeepCopy() *DriftedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DuckTypeGenerator) DeepCopyInto(out *DuckTypeGenerator) {
 	*out = *in
@@ -1802,6 +2178,22 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthAggregation) DeepCopyInto(out *HealthAggregation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthAggregation.
+func (in *HealthAggregation) DeepCopy() *HealthAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -1842,6 +2234,13 @@ func (in *HelmOptions) DeepCopyInto(out *HelmOptions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2204,6 +2603,27 @@ func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSubscriptionRule) DeepCopyInto(out *NotificationSubscriptionRule) {
+	*out = *in
+	if in.Recipients != nil {
+		in, out := &in.Recipients, &out.Recipients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSubscriptionRule.
+func (in *NotificationSubscriptionRule) DeepCopy() *NotificationSubscriptionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSubscriptionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -2225,6 +2645,11 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		}
 	}
 	in.Retry.DeepCopyInto(&out.Retry)
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(OperationApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2238,6 +2663,26 @@ func (in *Operation) DeepCopy() *Operation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationApproval) DeepCopyInto(out *OperationApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationApproval.
+func (in *OperationApproval) DeepCopy() *OperationApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationInitiator) DeepCopyInto(out *OperationInitiator) {
 	*out = *in
@@ -2354,6 +2799,22 @@ func (in *OverrideIgnoreDiff) DeepCopy() *OverrideIgnoreDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBundle) DeepCopyInto(out *PolicyBundle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBundle.
+func (in *PolicyBundle) DeepCopy() *PolicyBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -2596,6 +3057,23 @@ func (in RefTargetRevisionMapping) DeepCopy() RefTargetRevisionMapping {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrationStatus) DeepCopyInto(out *RegistrationStatus) {
+	*out = *in
+	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationStatus.
+func (in *RegistrationStatus) DeepCopy() *RegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoCreds) DeepCopyInto(out *RepoCreds) {
 	*out = *in
@@ -2750,6 +3228,83 @@ func (in *RepositoryList) DeepCopy() *RepositoryList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRegistration) DeepCopyInto(out *RepositoryRegistration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRegistration.
+func (in *RepositoryRegistration) DeepCopy() *RepositoryRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryRegistration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRegistrationList) DeepCopyInto(out *RepositoryRegistrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryRegistration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRegistrationList.
+func (in *RepositoryRegistrationList) DeepCopy() *RepositoryRegistrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRegistrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryRegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRegistrationSpec) DeepCopyInto(out *RepositoryRegistrationSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRegistrationSpec.
+func (in *RepositoryRegistrationSpec) DeepCopy() *RepositoryRegistrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRegistrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceAction) DeepCopyInto(out *ResourceAction) {
 	*out = *in
@@ -2964,6 +3519,23 @@ func (in *ResourceNode) DeepCopy() *ResourceNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceOperationTrace) DeepCopyInto(out *ResourceOperationTrace) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOperationTrace.
+func (in *ResourceOperationTrace) DeepCopy() *ResourceOperationTrace {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceOperationTrace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 	*out = *in
@@ -2973,6 +3545,11 @@ func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 		*out = make([]KnownTypeField, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthAggregation != nil {
+		in, out := &in.HealthAggregation, &out.HealthAggregation
+		*out = new(HealthAggregation)
+		**out = **in
+	}
 	return
 }
 
@@ -2986,6 +3563,27 @@ func (in *ResourceOverride) DeepCopy() *ResourceOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePermission) DeepCopyInto(out *ResourcePermission) {
+	*out = *in
+	if in.Rule != nil {
+		in, out := &in.Rule, &out.Rule
+		*out = new(v1.GroupKind)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePermission.
+func (in *ResourcePermission) DeepCopy() *ResourcePermission {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
 	*out = *in
@@ -3129,6 +3727,12 @@ func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.InitiatedBy = in.InitiatedBy
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(OperationApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3314,6 +3918,21 @@ func (in *SCMProviderGeneratorFilter) DeepCopyInto(out *SCMProviderGeneratorFilt
 		*out = new(string)
 		**out = **in
 	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Languages != nil {
+		in, out := &in.Languages, &out.Languages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Archived != nil {
+		in, out := &in.Archived, &out.Archived
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3518,6 +4137,13 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceOperations != nil {
+		in, out := &in.ResourceOperations, &out.ResourceOperations
+		*out = make([]ResourceOperationTrace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3574,6 +4200,11 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(SyncSchedule)
+		**out = **in
+	}
 	return
 }
 
@@ -3603,6 +4234,22 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSchedule) DeepCopyInto(out *SyncSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSchedule.
+func (in *SyncSchedule) DeepCopy() *SyncSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 	*out = *in
@@ -3702,6 +4349,11 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(SyncWindowCalendar)
+		**out = **in
+	}
 	return
 }
 
@@ -3715,6 +4367,22 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendar) DeepCopyInto(out *SyncWindowCalendar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendar.
+func (in *SyncWindowCalendar) DeepCopy() *SyncWindowCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
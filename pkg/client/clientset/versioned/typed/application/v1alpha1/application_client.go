@@ -15,6 +15,8 @@ type ArgoprojV1alpha1Interface interface {
 	AppProjectsGetter
 	ApplicationsGetter
 	ApplicationSetsGetter
+	ClusterRegistrationsGetter
+	RepositoryRegistrationsGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -34,6 +36,14 @@ func (c *ArgoprojV1alpha1Client) ApplicationSets(namespace string) ApplicationSe
 	return newApplicationSets(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) ClusterRegistrations(namespace string) ClusterRegistrationInterface {
+	return newClusterRegistrations(c, namespace)
+}
+
+func (c *ArgoprojV1alpha1Client) RepositoryRegistrations(namespace string) RepositoryRegistrationInterface {
+	return newRepositoryRegistrations(c, namespace)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
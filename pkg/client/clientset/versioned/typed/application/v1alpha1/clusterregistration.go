@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	scheme "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterRegistrationsGetter has a method to return a ClusterRegistrationInterface.
+// A group's client should implement this interface.
+type ClusterRegistrationsGetter interface {
+	ClusterRegistrations(namespace string) ClusterRegistrationInterface
+}
+
+// ClusterRegistrationInterface has methods to work with ClusterRegistration resources.
+type ClusterRegistrationInterface interface {
+	Create(ctx context.Context, clusterRegistration *v1alpha1.ClusterRegistration, opts v1.CreateOptions) (*v1alpha1.ClusterRegistration, error)
+	Update(ctx context.Context, clusterRegistration *v1alpha1.ClusterRegistration, opts v1.UpdateOptions) (*v1alpha1.ClusterRegistration, error)
+	UpdateStatus(ctx context.Context, clusterRegistration *v1alpha1.ClusterRegistration, opts v1.UpdateOptions) (*v1alpha1.ClusterRegistration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ClusterRegistration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ClusterRegistrationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRegistration, err error)
+	ClusterRegistrationExpansion
+}
+
+// clusterRegistrations implements ClusterRegistrationInterface
+type clusterRegistrations struct {
+	client rest.Interface
+	ns     string
+}
+
+// newClusterRegistrations returns a ClusterRegistrations
+func newClusterRegistrations(c *ArgoprojV1alpha1Client, namespace string) *clusterRegistrations {
+	return &clusterRegistrations{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the clusterRegistration, and returns the corresponding clusterRegistration object, and an error if there is any.
+func (c *clusterRegistrations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRegistration, err error) {
+	result = &v1alpha1.ClusterRegistration{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterRegistrations that match those selectors.
+func (c *clusterRegistrations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRegistrationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterRegistrationList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRegistrations.
+func (c *clusterRegistrations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterRegistration and creates it.  Returns the server's representation of the clusterRegistration, and an error, if there is any.
+func (c *clusterRegistrations) Create(ctx context.Context, clusterRegistration *v1alpha1.ClusterRegistration, opts v1.CreateOptions) (result *v1alpha1.ClusterRegistration, err error) {
+	result = &v1alpha1.ClusterRegistration{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterRegistration and updates it. Returns the server's representation of the clusterRegistration, and an error, if there is any.
+func (c *clusterRegistrations) Update(ctx context.Context, clusterRegistration *v1alpha1.ClusterRegistration, opts v1.UpdateOptions) (result *v1alpha1.ClusterRegistration, err error) {
+	result = &v1alpha1.ClusterRegistration{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		Name(clusterRegistration.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *clusterRegistrations) UpdateStatus(ctx context.Context, clusterRegistration *v1alpha1.ClusterRegistration, opts v1.UpdateOptions) (result *v1alpha1.ClusterRegistration, err error) {
+	result = &v1alpha1.ClusterRegistration{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		Name(clusterRegistration.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterRegistration and deletes it. Returns an error if one occurs.
+func (c *clusterRegistrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterRegistrations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterRegistration.
+func (c *clusterRegistrations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRegistration, err error) {
+	result = &v1alpha1.ClusterRegistration{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("clusterregistrations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -24,6 +24,14 @@ func (c *FakeArgoprojV1alpha1) ApplicationSets(namespace string) v1alpha1.Applic
 	return &FakeApplicationSets{c, namespace}
 }
 
+func (c *FakeArgoprojV1alpha1) ClusterRegistrations(namespace string) v1alpha1.ClusterRegistrationInterface {
+	return &FakeClusterRegistrations{c, namespace}
+}
+
+func (c *FakeArgoprojV1alpha1) RepositoryRegistrations(namespace string) v1alpha1.RepositoryRegistrationInterface {
+	return &FakeRepositoryRegistrations{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeArgoprojV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	scheme "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RepositoryRegistrationsGetter has a method to return a RepositoryRegistrationInterface.
+// A group's client should implement this interface.
+type RepositoryRegistrationsGetter interface {
+	RepositoryRegistrations(namespace string) RepositoryRegistrationInterface
+}
+
+// RepositoryRegistrationInterface has methods to work with RepositoryRegistration resources.
+type RepositoryRegistrationInterface interface {
+	Create(ctx context.Context, repositoryRegistration *v1alpha1.RepositoryRegistration, opts v1.CreateOptions) (*v1alpha1.RepositoryRegistration, error)
+	Update(ctx context.Context, repositoryRegistration *v1alpha1.RepositoryRegistration, opts v1.UpdateOptions) (*v1alpha1.RepositoryRegistration, error)
+	UpdateStatus(ctx context.Context, repositoryRegistration *v1alpha1.RepositoryRegistration, opts v1.UpdateOptions) (*v1alpha1.RepositoryRegistration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.RepositoryRegistration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.RepositoryRegistrationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RepositoryRegistration, err error)
+	RepositoryRegistrationExpansion
+}
+
+// repositoryRegistrations implements RepositoryRegistrationInterface
+type repositoryRegistrations struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRepositoryRegistrations returns a RepositoryRegistrations
+func newRepositoryRegistrations(c *ArgoprojV1alpha1Client, namespace string) *repositoryRegistrations {
+	return &repositoryRegistrations{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the repositoryRegistration, and returns the corresponding repositoryRegistration object, and an error if there is any.
+func (c *repositoryRegistrations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RepositoryRegistration, err error) {
+	result = &v1alpha1.RepositoryRegistration{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RepositoryRegistrations that match those selectors.
+func (c *repositoryRegistrations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RepositoryRegistrationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.RepositoryRegistrationList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested repositoryRegistrations.
+func (c *repositoryRegistrations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a repositoryRegistration and creates it.  Returns the server's representation of the repositoryRegistration, and an error, if there is any.
+func (c *repositoryRegistrations) Create(ctx context.Context, repositoryRegistration *v1alpha1.RepositoryRegistration, opts v1.CreateOptions) (result *v1alpha1.RepositoryRegistration, err error) {
+	result = &v1alpha1.RepositoryRegistration{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(repositoryRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a repositoryRegistration and updates it. Returns the server's representation of the repositoryRegistration, and an error, if there is any.
+func (c *repositoryRegistrations) Update(ctx context.Context, repositoryRegistration *v1alpha1.RepositoryRegistration, opts v1.UpdateOptions) (result *v1alpha1.RepositoryRegistration, err error) {
+	result = &v1alpha1.RepositoryRegistration{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		Name(repositoryRegistration.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(repositoryRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *repositoryRegistrations) UpdateStatus(ctx context.Context, repositoryRegistration *v1alpha1.RepositoryRegistration, opts v1.UpdateOptions) (result *v1alpha1.RepositoryRegistration, err error) {
+	result = &v1alpha1.RepositoryRegistration{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		Name(repositoryRegistration.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(repositoryRegistration).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the repositoryRegistration and deletes it. Returns an error if one occurs.
+func (c *repositoryRegistrations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *repositoryRegistrations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched repositoryRegistration.
+func (c *repositoryRegistrations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RepositoryRegistration, err error) {
+	result = &v1alpha1.RepositoryRegistration{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("repositoryregistrations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
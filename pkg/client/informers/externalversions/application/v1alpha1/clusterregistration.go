@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	applicationv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	versioned "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterRegistrationInformer provides access to a shared informer and lister for
+// ClusterRegistrations.
+type ClusterRegistrationInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ClusterRegistrationLister
+}
+
+type clusterRegistrationInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewClusterRegistrationInformer constructs a new informer for ClusterRegistration type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterRegistrationInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterRegistrationInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterRegistrationInformer constructs a new informer for ClusterRegistration type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterRegistrationInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().ClusterRegistrations(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().ClusterRegistrations(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&applicationv1alpha1.ClusterRegistration{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterRegistrationInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterRegistrationInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *clusterRegistrationInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&applicationv1alpha1.ClusterRegistration{}, f.defaultInformer)
+}
+
+func (f *clusterRegistrationInformer) Lister() v1alpha1.ClusterRegistrationLister {
+	return v1alpha1.NewClusterRegistrationLister(f.Informer().GetIndexer())
+}
@@ -14,6 +14,10 @@ type Interface interface {
 	Applications() ApplicationInformer
 	// ApplicationSets returns a ApplicationSetInformer.
 	ApplicationSets() ApplicationSetInformer
+	// ClusterRegistrations returns a ClusterRegistrationInformer.
+	ClusterRegistrations() ClusterRegistrationInformer
+	// RepositoryRegistrations returns a RepositoryRegistrationInformer.
+	RepositoryRegistrations() RepositoryRegistrationInformer
 }
 
 type version struct {
@@ -41,3 +45,13 @@ func (v *version) Applications() ApplicationInformer {
 func (v *version) ApplicationSets() ApplicationSetInformer {
 	return &applicationSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ClusterRegistrations returns a ClusterRegistrationInformer.
+func (v *version) ClusterRegistrations() ClusterRegistrationInformer {
+	return &clusterRegistrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RepositoryRegistrations returns a RepositoryRegistrationInformer.
+func (v *version) RepositoryRegistrations() RepositoryRegistrationInformer {
+	return &repositoryRegistrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	applicationv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	versioned "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RepositoryRegistrationInformer provides access to a shared informer and lister for
+// RepositoryRegistrations.
+type RepositoryRegistrationInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.RepositoryRegistrationLister
+}
+
+type repositoryRegistrationInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewRepositoryRegistrationInformer constructs a new informer for RepositoryRegistration type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewRepositoryRegistrationInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredRepositoryRegistrationInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredRepositoryRegistrationInformer constructs a new informer for RepositoryRegistration type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredRepositoryRegistrationInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().RepositoryRegistrations(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1alpha1().RepositoryRegistrations(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&applicationv1alpha1.RepositoryRegistration{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *repositoryRegistrationInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredRepositoryRegistrationInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *repositoryRegistrationInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&applicationv1alpha1.RepositoryRegistration{}, f.defaultInformer)
+}
+
+func (f *repositoryRegistrationInformer) Lister() v1alpha1.RepositoryRegistrationLister {
+	return v1alpha1.NewRepositoryRegistrationLister(f.Informer().GetIndexer())
+}
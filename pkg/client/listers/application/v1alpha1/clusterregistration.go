@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterRegistrationLister helps list ClusterRegistrations.
+// All objects returned here must be treated as read-only.
+type ClusterRegistrationLister interface {
+	// List lists all ClusterRegistrations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ClusterRegistration, err error)
+	// ClusterRegistrations returns an object that can list and get ClusterRegistrations.
+	ClusterRegistrations(namespace string) ClusterRegistrationNamespaceLister
+	ClusterRegistrationListerExpansion
+}
+
+// clusterRegistrationLister implements the ClusterRegistrationLister interface.
+type clusterRegistrationLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterRegistrationLister returns a new ClusterRegistrationLister.
+func NewClusterRegistrationLister(indexer cache.Indexer) ClusterRegistrationLister {
+	return &clusterRegistrationLister{indexer: indexer}
+}
+
+// List lists all ClusterRegistrations in the indexer.
+func (s *clusterRegistrationLister) List(selector labels.Selector) (ret []*v1alpha1.ClusterRegistration, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ClusterRegistration))
+	})
+	return ret, err
+}
+
+// ClusterRegistrations returns an object that can list and get ClusterRegistrations.
+func (s *clusterRegistrationLister) ClusterRegistrations(namespace string) ClusterRegistrationNamespaceLister {
+	return clusterRegistrationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ClusterRegistrationNamespaceLister helps list and get ClusterRegistrations.
+// All objects returned here must be treated as read-only.
+type ClusterRegistrationNamespaceLister interface {
+	// List lists all ClusterRegistrations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ClusterRegistration, err error)
+	// Get retrieves the ClusterRegistration from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ClusterRegistration, error)
+	ClusterRegistrationNamespaceListerExpansion
+}
+
+// clusterRegistrationNamespaceLister implements the ClusterRegistrationNamespaceLister
+// interface.
+type clusterRegistrationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ClusterRegistrations in the indexer for a given namespace.
+func (s clusterRegistrationNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ClusterRegistration, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ClusterRegistration))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterRegistration from the indexer for a given namespace and name.
+func (s clusterRegistrationNamespaceLister) Get(name string) (*v1alpha1.ClusterRegistration, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("clusterregistration"), name)
+	}
+	return obj.(*v1alpha1.ClusterRegistration), nil
+}
@@ -25,3 +25,19 @@ type ApplicationSetListerExpansion interface{}
 // ApplicationSetNamespaceListerExpansion allows custom methods to be added to
 // ApplicationSetNamespaceLister.
 type ApplicationSetNamespaceListerExpansion interface{}
+
+// ClusterRegistrationListerExpansion allows custom methods to be added to
+// ClusterRegistrationLister.
+type ClusterRegistrationListerExpansion interface{}
+
+// ClusterRegistrationNamespaceListerExpansion allows custom methods to be added to
+// ClusterRegistrationNamespaceLister.
+type ClusterRegistrationNamespaceListerExpansion interface{}
+
+// RepositoryRegistrationListerExpansion allows custom methods to be added to
+// RepositoryRegistrationLister.
+type RepositoryRegistrationListerExpansion interface{}
+
+// RepositoryRegistrationNamespaceListerExpansion allows custom methods to be added to
+// RepositoryRegistrationNamespaceLister.
+type RepositoryRegistrationNamespaceListerExpansion interface{}
@@ -1,6 +1,7 @@
 package apiclient
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	argogrpc "github.com/argoproj/argo-cd/v2/util/grpc"
 	"github.com/argoproj/argo-cd/v2/util/io"
@@ -92,3 +94,14 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration) Clientset {
 	return &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
 }
+
+// CheckConnection checks that the repo server of the given clientset serves requests
+func CheckConnection(ctx context.Context, clientset Clientset) error {
+	closer, client, err := clientset.NewRepoServerClient()
+	if err != nil {
+		return err
+	}
+	defer io.Close(closer)
+	_, err = client.ListPlugins(ctx, &emptypb.Empty{})
+	return err
+}
@@ -21,6 +21,36 @@ type RepoServerServiceClient struct {
 	mock.Mock
 }
 
+// EvaluatePolicies provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) EvaluatePolicies(ctx context.Context, in *apiclient.PolicyEvaluationRequest, opts ...grpc.CallOption) (*apiclient.PolicyEvaluationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.PolicyEvaluationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.PolicyEvaluationRequest, ...grpc.CallOption) *apiclient.PolicyEvaluationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.PolicyEvaluationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.PolicyEvaluationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateManifest provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) GenerateManifest(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -201,6 +231,36 @@ func (_m *RepoServerServiceClient) ListApps(ctx context.Context, in *apiclient.L
 	return r0, r1
 }
 
+// ListGitHubAppRepositories provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) ListGitHubAppRepositories(ctx context.Context, in *apiclient.ListGitHubAppRepositoriesRequest, opts ...grpc.CallOption) (*apiclient.GitHubAppRepositoryList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.GitHubAppRepositoryList
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ListGitHubAppRepositoriesRequest, ...grpc.CallOption) *apiclient.GitHubAppRepositoryList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.GitHubAppRepositoryList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ListGitHubAppRepositoriesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListPlugins provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) ListPlugins(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*apiclient.PluginList, error) {
 	_va := make([]interface{}, len(opts))
@@ -321,6 +381,36 @@ func (_m *RepoServerServiceClient) TestRepository(ctx context.Context, in *apicl
 	return r0, r1
 }
 
+// UpdateRevisionForPaths provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) UpdateRevisionForPaths(ctx context.Context, in *apiclient.UpdateRevisionForPathsRequest, opts ...grpc.CallOption) (*apiclient.UpdateRevisionForPathsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.UpdateRevisionForPathsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.UpdateRevisionForPathsRequest, ...grpc.CallOption) *apiclient.UpdateRevisionForPathsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.UpdateRevisionForPathsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.UpdateRevisionForPathsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRepoServerServiceClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -8,6 +8,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 )
 
 type MetricsServer struct {
@@ -17,6 +20,8 @@ type MetricsServer struct {
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
+	// dropLabels designates the labels dropped from the exported series
+	dropLabels []metricsutil.LabelRule
 }
 
 type GitRequestType string
@@ -79,14 +84,25 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
-	return &MetricsServer{
-		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+	m := &MetricsServer{}
+	*m = MetricsServer{
+		handler: promhttp.HandlerFor(prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+			families, err := registry.Gather()
+			return metricsutil.DropLabels(families, m.dropLabels), err
+		}), promhttp.HandlerOpts{}),
 		gitRequestCounter:        gitRequestCounter,
 		gitRequestHistogram:      gitRequestHistogram,
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
 	}
+	return m
+}
+
+// SetDropLabels drops the labels designated by the given rules from the exported series, aggregating the series left
+// with the same labels
+func (m *MetricsServer) SetDropLabels(rules []metricsutil.LabelRule) {
+	m.dropLabels = rules
 }
 
 func (m *MetricsServer) GetHandler() http.Handler {
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/lru"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	hydratedManifestFile = "manifest.yaml"
+	hydratorAuthorName   = "Argo CD"
+	hydratorAuthorEmail  = "argo-cd@argoproj.io"
+	// hydrateAttempts is the number of attempts to push the hydrated manifests, since concurrent pushes of other
+	// applications to the same branch may be rejected
+	hydrateAttempts = 3
+	// hydrateWorkers is the maximum number of hydrations processed concurrently
+	hydrateWorkers = 2
+	// hydratedDigestsSize is the maximum number of applications whose last hydrated digest is remembered. The repo
+	// server is not notified of the deletion of applications, so the least recently hydrated ones are forgotten
+	// instead, which only costs a hydration without changes if they are hydrated again.
+	hydratedDigestsSize = 10000
+)
+
+// hydrateRequest is a request to write the generated manifests of an application to the hydrated branch
+type hydrateRequest struct {
+	hydration  *apiclient.ManifestHydration
+	sourceRepo string
+	sourcePath string
+	revision   string
+	manifests  []string
+}
+
+// key returns the key of the application of the request
+func (r *hydrateRequest) key() string {
+	return r.hydration.AppNamespace + "/" + r.hydration.AppName
+}
+
+// digest returns the digest of the hydrated repository, revision and manifests of the request
+func (r *hydrateRequest) digest() string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\x00", r.hydration.Repo.Repo, r.sourcePath, r.revision)
+	for _, manifest := range r.manifests {
+		_, _ = fmt.Fprintf(h, "%s\x00", manifest)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// hydrateQueue holds the pending hydrations, at most one per application, which are processed by a bounded number of
+// workers, outside of the manifest generation
+type hydrateQueue struct {
+	queue workqueue.Interface
+	lock  sync.Mutex
+	// pending are the latest requests which are not processed yet, indexed by application
+	pending map[string]*hydrateRequest
+	// hydrated are the digests of the last hydrated requests, indexed by application, for at most the given number of
+	// applications
+	hydrated *lru.Cache
+}
+
+func newHydrateQueue(hydratedSize int) *hydrateQueue {
+	return &hydrateQueue{queue: workqueue.New(), pending: map[string]*hydrateRequest{}, hydrated: lru.New(hydratedSize)}
+}
+
+// add queues the request, unless the same manifests were already hydrated for the same revision. A pending request
+// of the same application is replaced.
+func (q *hydrateQueue) add(req *hydrateRequest) {
+	key := req.key()
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if digest, ok := q.hydrated.Get(key); ok && digest == req.digest() {
+		return
+	}
+	q.pending[key] = req
+	q.queue.Add(key)
+}
+
+// process processes the next request with the given function, and returns false once the queue is shut down
+func (q *hydrateQueue) process(hydrate func(req *hydrateRequest) error) bool {
+	key, shutdown := q.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer q.queue.Done(key)
+	q.lock.Lock()
+	req := q.pending[key.(string)]
+	delete(q.pending, key.(string))
+	q.lock.Unlock()
+	if req == nil {
+		return true
+	}
+	if err := hydrate(req); err != nil {
+		log.WithField("application", key).Warnf("Failed to push hydrated manifests: %v", err)
+		return true
+	}
+	q.hydrated.Add(key.(string), req.digest())
+	return true
+}
+
+// runHydrateWorkers starts the workers processing the hydrations
+func (s *Service) runHydrateWorkers() {
+	for i := 0; i < hydrateWorkers; i++ {
+		go func() {
+			for s.hydrateQueue.process(s.hydrate) {
+			}
+		}()
+	}
+}
+
+// shouldHydrate returns whether the generated manifests of the request are written to the hydrated branch. The
+// manifests are only written for the requests of the application controller, which provides the credentials allowed
+// to push, and for applications with a single Git source, since the manifests of multiple sources are generated
+// separately. The manifest hydration feature flag sent along with the request can turn the hydration off.
+func (s *Service) shouldHydrate(q *apiclient.ManifestRequest) bool {
+	return s.initConstants.HydratedBranch != "" &&
+		settings.FeatureFlags(q.FeatureFlags).Enabled(settings.FeatureFlagManifestHydration) &&
+		q.Hydration != nil &&
+		q.Hydration.Repo != nil &&
+		q.Hydration.AppName != "" &&
+		q.Hydration.AppNamespace != "" &&
+		q.Repo != nil &&
+		git.SameURL(q.Hydration.Repo.Repo, q.Repo.Repo) &&
+		(q.Repo.Type == "" || q.Repo.Type == "git") &&
+		q.ApplicationSource != nil &&
+		!q.ApplicationSource.IsHelm() &&
+		!q.HasMultipleSources &&
+		q.PostRenderer == nil
+}
+
+// queueHydration queues the hydration of the generated manifests of the request
+func (s *Service) queueHydration(q *apiclient.ManifestRequest, res *apiclient.ManifestResponse) {
+	s.hydrateQueue.add(&hydrateRequest{
+		hydration:  q.Hydration,
+		sourceRepo: q.Repo.Repo,
+		sourcePath: q.ApplicationSource.Path,
+		revision:   res.Revision,
+		manifests:  res.Manifests,
+	})
+}
+
+// hydrate writes the generated manifests of an application to the hydrated branch of its repository, so that they
+// can be reviewed as plain YAML. The manifests of each application are written to
+// <application namespace>/<application>/manifest.yaml and committed with trailers which link the commit to the
+// source revision. The branch is pushed with the credentials of the request, rather than with the credentials of the
+// source repository.
+func (s *Service) hydrate(req *hydrateRequest) error {
+	var content strings.Builder
+	for i, manifest := range req.manifests {
+		data, err := yaml.JSONToYAML([]byte(manifest))
+		if err != nil {
+			return fmt.Errorf("failed to convert manifest to YAML: %w", err)
+		}
+		if i > 0 {
+			content.WriteString("---\n")
+		}
+		content.Write(data)
+	}
+	appName := req.hydration.AppNamespace + "/" + req.hydration.AppName
+	message := fmt.Sprintf("Hydrate %s from %s\n\nArgocd-Source-Repo: %s\nArgocd-Source-Path: %s\nArgocd-Source-Revision: %s\n",
+		appName, req.revision, req.sourceRepo, req.sourcePath, req.revision)
+
+	repo := req.hydration.Repo
+	// the branch is checked out into its own directory, so that it does not interfere with the checkouts of revisions
+	repoPath, err := s.gitRepoPaths.GetPath(git.NormalizeGitURL(repo.Repo) + "#" + s.initConstants.HydratedBranch)
+	if err != nil {
+		return err
+	}
+	s.hydrateLock.Lock(repoPath)
+	defer s.hydrateLock.Unlock(repoPath)
+
+	gitClient, err := s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), false, repo.Proxy)
+	if err != nil {
+		return err
+	}
+	if err := gitClient.Init(); err != nil {
+		return err
+	}
+	appDir := filepath.Join(gitClient.Root(), req.hydration.AppNamespace, req.hydration.AppName)
+	for attempt := 1; ; attempt++ {
+		err = func() error {
+			if err := gitClient.CheckoutOrOrphan(s.initConstants.HydratedBranch); err != nil {
+				return err
+			}
+			if err := os.RemoveAll(appDir); err != nil {
+				return err
+			}
+			if err := os.MkdirAll(appDir, 0755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(filepath.Join(appDir, hydratedManifestFile), []byte(content.String()), 0644); err != nil {
+				return err
+			}
+			pushed, err := gitClient.CommitAndPush(s.initConstants.HydratedBranch, hydratorAuthorName, hydratorAuthorEmail, message)
+			if err == nil && pushed {
+				log.WithFields(log.Fields{"application": appName, "revision": req.revision}).Infof("Pushed hydrated manifests to branch %s", s.initConstants.HydratedBranch)
+			}
+			return err
+		}()
+		if err == nil || attempt >= hydrateAttempts {
+			return err
+		}
+		log.WithField("application", appName).Debugf("Retrying to push hydrated manifests: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/reposerver/cache"
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestShouldHydrate(t *testing.T) {
+	service := newService(".")
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}
+	hydration := &apiclient.ManifestHydration{
+		Repo:         &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Password: "write-token"},
+		AppName:      "guestbook",
+		AppNamespace: "argocd",
+	}
+	q := &apiclient.ManifestRequest{
+		AppName:           "guestbook",
+		Repo:              repo,
+		ApplicationSource: &v1alpha1.ApplicationSource{Path: "guestbook"},
+		Hydration:         hydration,
+	}
+	assert.False(t, service.shouldHydrate(q))
+
+	service.initConstants.HydratedBranch = "hydrated"
+	assert.True(t, service.shouldHydrate(q))
+	assert.False(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: repo, ApplicationSource: q.ApplicationSource}))
+	assert.False(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: repo, ApplicationSource: &v1alpha1.ApplicationSource{Chart: "guestbook"}, Hydration: hydration}))
+	assert.False(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: repo, ApplicationSource: q.ApplicationSource, HasMultipleSources: true, Hydration: hydration}))
+	assert.False(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}, ApplicationSource: q.ApplicationSource, Hydration: hydration}))
+	assert.False(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: repo, ApplicationSource: q.ApplicationSource, Hydration: hydration, PostRenderer: &apiclient.ManifestPostRenderer{}}))
+	assert.True(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: repo, ApplicationSource: q.ApplicationSource, Hydration: hydration, FeatureFlags: map[string]bool{settings.FeatureFlagManifestHydration: true}}))
+	assert.False(t, service.shouldHydrate(&apiclient.ManifestRequest{AppName: "guestbook", Repo: repo, ApplicationSource: q.ApplicationSource, Hydration: hydration, FeatureFlags: map[string]bool{settings.FeatureFlagManifestHydration: false}}))
+}
+
+func TestHydrateQueue(t *testing.T) {
+	queue := newHydrateQueue(2)
+	newRequest := func(name string, revision string) *hydrateRequest {
+		return &hydrateRequest{
+			hydration: &apiclient.ManifestHydration{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}, AppName: name, AppNamespace: "argocd"},
+			revision:  revision,
+			manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`},
+		}
+	}
+	var hydrated []string
+	hydrate := func(req *hydrateRequest) error {
+		hydrated = append(hydrated, req.hydration.AppName+"@"+req.revision)
+		return nil
+	}
+
+	// the pending request of an application is replaced by the latest one
+	queue.add(newRequest("guestbook", "abc123"))
+	queue.add(newRequest("guestbook", "def456"))
+	queue.add(newRequest("other", "abc123"))
+	assert.Equal(t, 2, queue.queue.Len())
+	assert.True(t, queue.process(hydrate))
+	assert.True(t, queue.process(hydrate))
+	assert.Equal(t, []string{"guestbook@def456", "other@abc123"}, hydrated)
+
+	// the requests which were already hydrated are not queued again
+	queue.add(newRequest("guestbook", "def456"))
+	assert.Equal(t, 0, queue.queue.Len())
+	queue.add(newRequest("guestbook", "ghi789"))
+	assert.Equal(t, 1, queue.queue.Len())
+	assert.True(t, queue.process(hydrate))
+
+	// the digests of the least recently hydrated applications are forgotten
+	queue.add(newRequest("third", "abc123"))
+	assert.True(t, queue.process(hydrate))
+	assert.Equal(t, 2, queue.hydrated.Len())
+	queue.add(newRequest("other", "abc123"))
+	assert.Equal(t, 1, queue.queue.Len())
+
+	queue.queue.ShutDown()
+	assert.True(t, queue.process(hydrate))
+	assert.False(t, queue.process(hydrate))
+}
+
+func TestHydrate(t *testing.T) {
+	remoteDir := t.TempDir()
+	gitOutput(t, remoteDir, "init", "--bare")
+
+	service := NewService(metrics.NewMetricsServer(), cache.NewCache(
+		cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Minute)),
+		1*time.Minute,
+		1*time.Minute,
+	), RepoServerInitConstants{HydratedBranch: "hydrated"}, argo.NewResourceTracking(), &git.NoopCredsStore{}, t.TempDir())
+	newRequest := func(namespace string, name string, revision string, manifests []string) *hydrateRequest {
+		return &hydrateRequest{
+			hydration:  &apiclient.ManifestHydration{Repo: &v1alpha1.Repository{Repo: "file://" + remoteDir}, AppName: name, AppNamespace: namespace},
+			sourceRepo: "file://" + remoteDir,
+			sourcePath: "guestbook",
+			revision:   revision,
+			manifests:  manifests,
+		}
+	}
+	manifests := []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"b"}}`}
+	require.NoError(t, service.hydrate(newRequest("argocd", "guestbook", "abc123", manifests)))
+
+	assert.Equal(t, `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: b
+`, gitOutput(t, remoteDir, "show", "hydrated:argocd/guestbook/manifest.yaml"))
+	assert.Equal(t, "abc123\n\n", gitOutput(t, remoteDir, "log", "-1", "--format=%(trailers:key=Argocd-Source-Revision,valueonly)", "hydrated"))
+
+	// unchanged manifests are not committed again
+	require.NoError(t, service.hydrate(newRequest("argocd", "guestbook", "def456", manifests)))
+	assert.Equal(t, "1\n", gitOutput(t, remoteDir, "rev-list", "--count", "hydrated"))
+
+	// the manifests of other applications, including the applications with the same name in other namespaces, are kept
+	require.NoError(t, service.hydrate(newRequest("team", "guestbook", "def456", manifests[:1])))
+	assert.Equal(t, "argocd/guestbook/manifest.yaml\nteam/guestbook/manifest.yaml\n", gitOutput(t, remoteDir, "ls-tree", "-r", "--name-only", "hydrated"))
+}
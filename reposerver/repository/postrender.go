@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+	argokube "github.com/argoproj/argo-cd/v2/util/kube"
+	"github.com/argoproj/argo-cd/v2/util/kustomize"
+)
+
+const postRenderedManifestFile = "manifests.yaml"
+
+// forbiddenPostRendererKustomizationKeys are the keys of the kustomizations of the post-renderers which are rejected,
+// since they would load resources other than the generated manifests, e.g. remote bases, or run plugins
+var forbiddenPostRendererKustomizationKeys = []string{"resources", "bases", "components", "helmCharts", "helmGlobals", "generators", "transformers", "validators"}
+
+// postRender applies the post-renderer of a request to the generated manifests and returns the transformed manifests.
+// The kustomization is built with the generated manifests as its only resources, then the patches are applied.
+func postRender(manifests []string, postRenderer *apiclient.ManifestPostRenderer, kustomizeBinaryPath string) ([]string, error) {
+	var err error
+	if postRenderer.Kustomization != "" {
+		manifests, err = postRenderKustomization(manifests, postRenderer.Kustomization, kustomizeBinaryPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, patch := range postRenderer.Patches {
+		manifests, err = postRenderPatch(manifests, patch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply patch %d: %w", i, err)
+		}
+	}
+	return manifests, nil
+}
+
+func postRenderKustomization(manifests []string, kustomization string, kustomizeBinaryPath string) ([]string, error) {
+	var k map[string]interface{}
+	if err := yaml.Unmarshal([]byte(kustomization), &k); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kustomization: %w", err)
+	}
+	if k == nil {
+		k = map[string]interface{}{}
+	}
+	for _, key := range forbiddenPostRendererKustomizationKeys {
+		if _, ok := k[key]; ok {
+			return nil, fmt.Errorf("the '%s' key is not allowed in the kustomization of a post-renderer", key)
+		}
+	}
+	k["resources"] = []string{postRenderedManifestFile}
+	kustomizationData, err := yaml.Marshal(k)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal kustomization: %w", err)
+	}
+
+	dir, err := files.CreateTempDir("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	if err := os.WriteFile(filepath.Join(dir, "kustomization.yaml"), kustomizationData, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write kustomization: %w", err)
+	}
+	// JSON documents are valid YAML documents
+	if err := os.WriteFile(filepath.Join(dir, postRenderedManifestFile), []byte(strings.Join(manifests, "\n---\n")), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	objs, _, err := kustomize.NewKustomizeApp(dir, git.NopCreds{}, "", kustomizeBinaryPath).Build(nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kustomization: %w", err)
+	}
+	res := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+		}
+		res = append(res, string(data))
+	}
+	return res, nil
+}
+
+func postRenderPatch(manifests []string, patch *apiclient.ManifestPatch) ([]string, error) {
+	patchData, err := yaml.YAMLToJSON([]byte(patch.Patch))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert patch to JSON: %w", err)
+	}
+	res := make([]string, len(manifests))
+	for i, manifest := range manifests {
+		res[i] = manifest
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal([]byte(manifest), &obj); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		if !patchTargets(patch, &obj) {
+			continue
+		}
+		var dataStruct interface{}
+		if patch.PatchType == argokube.PatchTypeStrategic {
+			// strategic merge patches require the Go type of the objects to look up the patch strategies of the fields
+			dataStruct, err = scheme.Scheme.New(obj.GroupVersionKind())
+			if err != nil {
+				return nil, fmt.Errorf("strategic merge patches are not supported for %s, use a merge patch instead", obj.GroupVersionKind())
+			}
+		}
+		patched, err := argokube.ApplyPatch([]byte(manifest), string(patchData), patch.PatchType, dataStruct)
+		if err != nil {
+			return nil, fmt.Errorf("failed to patch %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		res[i] = string(patched)
+	}
+	return res, nil
+}
+
+// patchTargets returns whether the given object is a target of the given patch
+func patchTargets(patch *apiclient.ManifestPatch, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return (patch.Group == "" || patch.Group == gvk.Group) &&
+		(patch.Kind == "" || patch.Kind == gvk.Kind) &&
+		(patch.Name == "" || patch.Name == obj.GetName()) &&
+		(patch.Namespace == "" || patch.Namespace == obj.GetNamespace())
+}
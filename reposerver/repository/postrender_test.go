@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+)
+
+func TestPostRender(t *testing.T) {
+	manifests := []string{
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui","namespace":"default"},"spec":{"replicas":1,"template":{"spec":{"containers":[{"name":"guestbook-ui","image":"guestbook:v1"}]}}}}`,
+		`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui","namespace":"default"},"spec":{"ports":[{"port":80}]}}`,
+	}
+
+	t.Run("JSONPatch", func(t *testing.T) {
+		res, err := postRender(manifests, &apiclient.ManifestPostRenderer{Patches: []*apiclient.ManifestPatch{{
+			Patch: `[{"op": "replace", "path": "/spec/replicas", "value": 3}]`,
+			Group: "apps",
+			Kind:  "Deployment",
+		}}}, "")
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Contains(t, res[0], `"replicas":3`)
+		assert.Equal(t, manifests[1], res[1])
+	})
+
+	t.Run("MergePatchInYAML", func(t *testing.T) {
+		res, err := postRender(manifests, &apiclient.ManifestPostRenderer{Patches: []*apiclient.ManifestPatch{{
+			Patch:     "metadata:\n  labels:\n    preview: \"true\"\n",
+			PatchType: "merge",
+			Name:      "guestbook-ui",
+		}}}, "")
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Contains(t, res[0], `"preview":"true"`)
+		assert.Contains(t, res[1], `"preview":"true"`)
+	})
+
+	t.Run("StrategicMergePatch", func(t *testing.T) {
+		res, err := postRender(manifests, &apiclient.ManifestPostRenderer{Patches: []*apiclient.ManifestPatch{{
+			Patch:     `{"spec":{"template":{"spec":{"containers":[{"name":"guestbook-ui","image":"guestbook:v2"}]}}}}`,
+			PatchType: "strategic",
+			Kind:      "Deployment",
+		}}}, "")
+		require.NoError(t, err)
+		assert.Contains(t, res[0], `"image":"guestbook:v2"`)
+		assert.Contains(t, res[0], `"name":"guestbook-ui"`)
+	})
+
+	t.Run("StrategicMergePatchOfUnknownKind", func(t *testing.T) {
+		_, err := postRender([]string{`{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"w"}}`}, &apiclient.ManifestPostRenderer{Patches: []*apiclient.ManifestPatch{{
+			Patch:     `{"spec":{}}`,
+			PatchType: "strategic",
+		}}}, "")
+		assert.ErrorContains(t, err, "strategic merge patches are not supported")
+	})
+
+	t.Run("InvalidPatch", func(t *testing.T) {
+		_, err := postRender(manifests, &apiclient.ManifestPostRenderer{Patches: []*apiclient.ManifestPatch{{
+			Patch: `[{"op": "remove", "path": "/spec/missing"}]`,
+			Kind:  "Service",
+		}}}, "")
+		assert.ErrorContains(t, err, "failed to apply patch 0")
+	})
+
+	t.Run("KustomizationWithResources", func(t *testing.T) {
+		_, err := postRender(manifests, &apiclient.ManifestPostRenderer{Kustomization: "resources:\n- https://github.com/argoproj/argocd-example-apps//guestbook\n"}, "")
+		assert.ErrorContains(t, err, "the 'resources' key is not allowed")
+	})
+}
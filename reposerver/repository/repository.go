@@ -64,7 +64,9 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	pathutil "github.com/argoproj/argo-cd/v2/util/io/path"
+	"github.com/argoproj/argo-cd/v2/util/kubeschema"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
+	"github.com/argoproj/argo-cd/v2/util/policy"
 	"github.com/argoproj/argo-cd/v2/util/text"
 )
 
@@ -94,6 +96,9 @@ type Service struct {
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client
 	initConstants             RepoServerInitConstants
+	schemaValidator           *kubeschema.Validator
+	hydrateLock               sync.KeyLock
+	hydrateQueue              *hydrateQueue
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
 }
@@ -109,6 +114,15 @@ type RepoServerInitConstants struct {
 	AllowOutOfBoundsSymlinks                     bool
 	StreamedManifestMaxExtractedSize             int64
 	StreamedManifestMaxTarSize                   int64
+	// ManifestSchemaLocation is the location template of the JSON schemas the generated manifests are validated
+	// against. The manifests are not validated if it is empty.
+	ManifestSchemaLocation string
+	// HelmIndexProviders maps the URLs of Helm repositories to the index provider whose API lists their chart
+	// versions, for the repositories whose provider cannot be detected from their URL
+	HelmIndexProviders map[string]string
+	// HydratedBranch is the branch of their repository the generated manifests of applications are written to. The
+	// manifests are not written if it is empty.
+	HydratedBranch string
 }
 
 // NewService returns a new instance of the Manifest service
@@ -137,10 +151,23 @@ func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cac
 		chartPaths:         helmRandomizedPaths,
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
+		hydrateLock:        sync.NewKeyLock(),
+		hydrateQueue:       newHydrateQueue(hydratedDigestsSize),
 	}
 }
 
 func (s *Service) Init() error {
+	if s.initConstants.HydratedBranch != "" {
+		s.runHydrateWorkers()
+	}
+	if s.initConstants.ManifestSchemaLocation != "" {
+		validator, err := kubeschema.NewValidator(s.initConstants.ManifestSchemaLocation)
+		if err != nil {
+			return err
+		}
+		s.schemaValidator = validator
+	}
+
 	_, err := os.Stat(s.rootDir)
 	if os.IsNotExist(err) {
 		return os.MkdirAll(s.rootDir, 0300)
@@ -198,6 +225,31 @@ func (s *Service) ListRefs(ctx context.Context, q *apiclient.ListRefsRequest) (*
 	return &res, nil
 }
 
+// ListGitHubAppRepositories lists the repositories accessible to the GitHub App installation used by the repo
+func (s *Service) ListGitHubAppRepositories(ctx context.Context, q *apiclient.ListGitHubAppRepositoriesRequest) (*apiclient.GitHubAppRepositoryList, error) {
+	creds, ok := q.Repo.GetGitCreds(s.gitCredsStore).(git.GitHubAppCreds)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "repository %s is not configured with GitHub App credentials", q.Repo.Repo)
+	}
+
+	repos, err := creds.ListInstallationRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &apiclient.GitHubAppRepositoryList{}
+	for _, repo := range repos {
+		res.Items = append(res.Items, &apiclient.GitHubAppRepository{
+			FullName:      repo.GetFullName(),
+			CloneURL:      repo.GetCloneURL(),
+			SshURL:        repo.GetSSHURL(),
+			DefaultBranch: repo.GetDefaultBranch(),
+			Private:       repo.GetPrivate(),
+		})
+	}
+	return res, nil
+}
+
 // ListApps lists the contents of a GitHub repo
 func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*apiclient.AppList, error) {
 	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
@@ -535,6 +587,23 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	if q.HasMultipleSources && err == nil && res == nil {
 		res = &apiclient.ManifestResponse{}
 	}
+	if err == nil && res != nil && s.shouldHydrate(q) {
+		s.queueHydration(q, res)
+	}
+	if err == nil && res != nil && q.PostRenderer != nil {
+		// the post-rendered manifests are not cached, so the cached response must not be modified
+		kustomizeBinaryPath := ""
+		if q.KustomizeOptions != nil {
+			kustomizeBinaryPath = q.KustomizeOptions.BinaryPath
+		}
+		manifests, err := postRender(res.Manifests, q.PostRenderer, kustomizeBinaryPath)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to post-render manifests: %v", err)
+		}
+		postRendered := *res
+		postRendered.Manifests = manifests
+		res = &postRendered
+	}
 	return res, err
 }
 
@@ -758,6 +827,9 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		}
 
 		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs))
+		if err == nil && s.schemaValidator != nil {
+			manifestGenResult.ValidationErrors, err = s.validateManifests(q.KubeVersion, manifestGenResult.Manifests)
+		}
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -768,12 +840,12 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	if err != nil {
 		// If manifest generation error caching is enabled
 		if s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 {
-			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
 			// rather than a copy of the cache that occurred before (a potentially lengthy) manifest generation.
 			innerRes := &cache.CachedManifestResponse{}
-			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs)
+			cacheErr := s.cache.GetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.RefreshPaths)
 			if cacheErr != nil && cacheErr != reposervercache.ErrCacheMiss {
 				log.Warnf("manifest cache set error %s: %v", appSourceCopy.String(), cacheErr)
 				ch.errCh <- cacheErr
@@ -786,12 +858,12 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 				innerRes.FirstFailureTimestamp = s.now().Unix()
 			}
 
-			cache.LogDebugManifestCacheKeyFields("setting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+			cache.LogDebugManifestCacheKeyFields("setting manifests cache", "GenerateManifests error", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
 			innerRes.MostRecentError = err.Error()
-			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs)
+			cacheErr = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes, refSourceCommitSHAs, q.RefreshPaths)
 			if cacheErr != nil {
 				log.Warnf("manifest cache set error %s: %v", appSourceCopy.String(), cacheErr)
 				ch.errCh <- cacheErr
@@ -803,7 +875,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		return
 	}
 
-	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "fresh GenerateManifests response", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "fresh GenerateManifests response", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 	// Otherwise, no error occurred, so ensure the manifest generation error data in the cache entry is reset before we cache the value
 	manifestGenCacheEntry := cache.CachedManifestResponse{
@@ -815,13 +887,31 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
-	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs)
+	err = s.cache.SetManifests(cacheKey, appSourceCopy, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry, refSourceCommitSHAs, q.RefreshPaths)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
 	}
 	ch.responseCh <- manifestGenCacheEntry.ManifestResponse
 }
 
+// validateManifests validates the given manifests against the schemas of the given Kubernetes version, and returns the
+// validation errors
+func (s *Service) validateManifests(kubeVersion string, manifests []string) ([]string, error) {
+	objs := make([]*unstructured.Unstructured, 0, len(manifests))
+	for _, manifest := range manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+	validationErrors, err := s.schemaValidator.Validate(kubeVersion, objs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate manifests: %w", err)
+	}
+	return validationErrors, nil
+}
+
 // getManifestCacheEntry returns false if the 'generate manifests' operation should be run by runRepoOperation, e.g.:
 // - If the cache result is empty for the requested key
 // - If the cache is not empty, but the cached value is a manifest generation error AND we have not yet met the failure threshold (e.g. res.NumberOfConsecutiveFailures > 0 && res.NumberOfConsecutiveFailures <  s.initConstants.PauseGenerationAfterFailedGenerationAttempts)
@@ -829,10 +919,10 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 // and returns true otherwise.
 // If true is returned, either the second or third parameter (but not both) will contain a value from the cache (a ManifestResponse, or error, respectively)
 func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRequest, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, *apiclient.ManifestResponse, error) {
-	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs)
+	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.RefreshPaths)
 	if err == nil {
 
 		// The cache contains an existing value
@@ -850,10 +940,10 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 
 					// After X minutes, reset the cache and retry the operation (e.g. perhaps the error is ephemeral and has passed)
 					if elapsedTimeInMinutes >= s.initConstants.PauseGenerationOnFailureForMinutes {
-						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest hash did not match or cached response is empty", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 						// We can now try again, so reset the cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -866,10 +956,10 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 				if s.initConstants.PauseGenerationOnFailureForRequests > 0 && res.NumberOfCachedResponsesReturned > 0 {
 
 					if res.NumberOfCachedResponsesReturned >= s.initConstants.PauseGenerationOnFailureForRequests {
-						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "reset after paused generation count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						cache.LogDebugManifestCacheKeyFields("deleting manifests cache", "reset after paused generation count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 						// We can now try again, so reset the error cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -884,12 +974,12 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 				cachedErrorResponse := fmt.Errorf(cachedManifestGenerationPrefix+": %s", res.MostRecentError)
 
 				if firstInvocation {
-					cache.LogDebugManifestCacheKeyFields("setting manifests cache", "update error count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs)
+					cache.LogDebugManifestCacheKeyFields("setting manifests cache", "update error count", cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, refSourceCommitSHAs, q.RefreshPaths)
 
 					// Increment the number of returned cached responses and push that new value to the cache
 					// (if we have not already done so previously in this function)
 					res.NumberOfCachedResponsesReturned++
-					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs)
+					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res, refSourceCommitSHAs, q.RefreshPaths)
 					if err != nil {
 						log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 					}
@@ -1130,7 +1220,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		proxy = q.Repo.Proxy
 	}
 
-	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), isLocal, version, proxy, passCredentials)
+	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), isLocal, version, q.HelmOptions.GetBinaryPath(version), proxy, passCredentials)
 	if err != nil {
 		return nil, err
 	}
@@ -2032,7 +2122,7 @@ func populateHelmAppDetails(res *apiclient.RepoAppDetailsResponse, appPath strin
 		}
 		passCredentials = q.Source.Helm.PassCredentials
 	}
-	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), false, version, q.Repo.Proxy, passCredentials)
+	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), false, version, q.HelmOptions.GetBinaryPath(version), q.Repo.Proxy, passCredentials)
 	if err != nil {
 		return err
 	}
@@ -2293,7 +2383,7 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 
 func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
-	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths))
+	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths), helm.WithIndexProvider(s.initConstants.HelmIndexProviders[strings.TrimSuffix(repo.Repo, "/")]))
 	if helm.IsVersion(revision) {
 		return helmClient, revision, nil
 	}
@@ -2315,11 +2405,7 @@ func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revisi
 		return helmClient, version.String(), nil
 	}
 
-	index, err := helmClient.GetIndex(noRevisionCache)
-	if err != nil {
-		return nil, "", err
-	}
-	entries, err := index.GetEntries(chart)
+	entries, err := helmClient.GetChartVersions(chart, noRevisionCache)
 	if err != nil {
 		return nil, "", err
 	}
@@ -2410,6 +2496,142 @@ func (s *Service) GetHelmCharts(ctx context.Context, q *apiclient.HelmChartsRequ
 	return &res, nil
 }
 
+// EvaluatePolicies evaluates the manifests of the request against the policies of a policy bundle stored in a Git repository
+func (s *Service) EvaluatePolicies(ctx context.Context, q *apiclient.PolicyEvaluationRequest) (*apiclient.PolicyEvaluationResponse, error) {
+	if q.Repo == nil || q.Bundle == nil {
+		return nil, status.Error(codes.InvalidArgument, "repo and policy bundle are required")
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(q.Manifests))
+	for _, manifest := range q.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal manifest: %v", err)
+		}
+		objs = append(objs, obj)
+	}
+
+	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Bundle.GetTargetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+
+	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
+		return s.checkoutRevision(gitClient, commitSHA, s.initConstants.SubmoduleEnabled)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(closer)
+
+	if !s.initConstants.AllowOutOfBoundsSymlinks {
+		if err := argopath.CheckOutOfBoundsSymlinks(gitClient.Root()); err != nil {
+			return nil, fmt.Errorf("repository contains out-of-bounds symlinks: %w", err)
+		}
+	}
+	bundlePath, err := argopath.Path(gitClient.Root(), q.Bundle.Path)
+	if err != nil {
+		return nil, err
+	}
+	evaluator, err := policy.NewEvaluator(q.Bundle.Engine, bundlePath)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to load policy bundle: %v", err)
+	}
+	violations, err := evaluator.Evaluate(objs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &apiclient.PolicyEvaluationResponse{Revision: commitSHA}
+	for _, violation := range violations {
+		res.Violations = append(res.Violations, &apiclient.PolicyViolation{
+			Policy:    violation.Policy,
+			Rule:      violation.Rule,
+			Message:   violation.Message,
+			Group:     violation.Resource.Group,
+			Kind:      violation.Resource.Kind,
+			Namespace: violation.Resource.Namespace,
+			Name:      violation.Resource.Name,
+			Warning:   violation.Warning,
+		})
+	}
+	return res, nil
+}
+
+// UpdateRevisionForPaths compares the synced revision with the new revision and, if none of the requested paths
+// changed in between, carries the cached manifests of the synced revision over to the new revision, so that the
+// subsequent manifest generation is served from the cache.
+func (s *Service) UpdateRevisionForPaths(ctx context.Context, q *apiclient.UpdateRevisionForPathsRequest) (*apiclient.UpdateRevisionForPathsResponse, error) {
+	source := q.ApplicationSource
+	if q.Repo == nil || source == nil {
+		return nil, status.Error(codes.InvalidArgument, "repo and application source are required")
+	}
+	// only single git sources are supported, since the manifests of multiple sources depend on several revisions
+	if source.IsHelm() || q.HasMultipleSources || q.SyncedRevision == "" || len(q.Paths) == 0 {
+		return &apiclient.UpdateRevisionForPathsResponse{Changes: true}, nil
+	}
+
+	logCtx := log.WithFields(log.Fields{"application": q.AppName, "repo": q.Repo.Repo})
+	gitClient, revision, err := s.newClientResolveRevision(q.Repo, textutils.FirstNonEmpty(q.Revision, source.TargetRevision), git.WithCache(s.cache, true))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to resolve git revision %s: %v", q.Revision, err)
+	}
+	if revision == q.SyncedRevision {
+		return &apiclient.UpdateRevisionForPathsResponse{Revision: revision}, nil
+	}
+
+	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
+	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+
+	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() (goio.Closer, error) {
+		return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(closer)
+
+	changedFiles, err := gitClient.ChangedFiles(q.SyncedRevision, revision)
+	if err != nil {
+		// the synced revision might not exist anymore, e.g. after a force push
+		logCtx.Debugf("unable to determine the files changed since %s: %v", q.SyncedRevision, err)
+		return &apiclient.UpdateRevisionForPathsResponse{Changes: true, Revision: revision}, nil
+	}
+	if len(changedFiles) > 0 && argopath.AppFilesHaveChanged(q.Paths, changedFiles) {
+		return &apiclient.UpdateRevisionForPathsResponse{Changes: true, Revision: revision}, nil
+	}
+
+	logCtx.Debugf("no changes to the manifest generate paths between %s and %s", q.SyncedRevision, revision)
+	if err := s.updateCachedRevision(q.SyncedRevision, revision, q); err != nil {
+		logCtx.Warnf("failed to carry cached manifests over to revision %s: %v", revision, err)
+	}
+	return &apiclient.UpdateRevisionForPathsResponse{Revision: revision}, nil
+}
+
+// updateCachedRevision stores the cached manifests of the old revision for the new revision. Nothing is stored if
+// there are no cached manifests for the old revision or if their generation failed.
+func (s *Service) updateCachedRevision(oldRevision string, newRevision string, q *apiclient.UpdateRevisionForPathsRequest) error {
+	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "UpdateRevisionForPaths API call", oldRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, nil, q.Paths)
+
+	var cachedManifests cache.CachedManifestResponse
+	err := s.cache.GetManifests(oldRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cachedManifests, nil, q.Paths)
+	if err == reposervercache.ErrCacheMiss {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if cachedManifests.ManifestResponse == nil || cachedManifests.FirstFailureTimestamp > 0 {
+		return nil
+	}
+
+	cachedManifests.ManifestResponse.Revision = newRevision
+	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "UpdateRevisionForPaths API call", newRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, nil, q.Paths)
+	return s.cache.SetManifests(newRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cachedManifests, nil, q.Paths)
+}
+
 func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
 	repo := q.Repo
 	// per Type doc, "git" should be assumed if empty or absent
@@ -24,6 +24,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +78,8 @@ func newServiceWithMocks(root string, signed bool) (*Service, *gitmocks.Client)
 			chart:    {{Version: "1.0.0"}, {Version: version}},
 			oobChart: {{Version: "1.0.0"}, {Version: version}},
 		}}, nil)
+		helmClient.On("GetChartVersions", chart, mock.Anything).Return(helm.Entries{{Version: "1.0.0"}, {Version: version}}, nil)
+		helmClient.On("GetChartVersions", oobChart, mock.Anything).Return(helm.Entries{{Version: "1.0.0"}, {Version: version}}, nil)
 		helmClient.On("ExtractChart", chart, version).Return("./testdata/my-chart", io.NopCloser, nil)
 		helmClient.On("ExtractChart", oobChart, version).Return("./testdata2/out-of-bounds-chart", io.NopCloser, nil)
 		helmClient.On("CleanChartCache", chart, version).Return(nil)
@@ -168,6 +172,33 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Equal(t, 3, len(res2.Manifests))
 }
 
+func TestGenerateManifest_ValidatesManifests(t *testing.T) {
+	schemaDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(schemaDir, "v1.24.0"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(schemaDir, "v1.24.0", "serviceaccount-v1.json"), []byte(`{"type": "object", "required": ["imagePullSecrets"]}`), 0644))
+
+	service := newService("./testdata/concatenated")
+	service.initConstants.ManifestSchemaLocation = filepath.Join(schemaDir, "{{ .NormalizedKubernetesVersion }}", "{{ .ResourceKind }}{{ .KindSuffix }}.json")
+	require.NoError(t, service.Init())
+
+	src := argoappv1.ApplicationSource{Path: "."}
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, KubeVersion: "1.24"}
+	res, err := service.GenerateManifest(context.Background(), &q)
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	assert.Equal(t, []string{
+		"ServiceAccount/sa1: imagePullSecrets in body is required",
+		"ServiceAccount/sa2: imagePullSecrets in body is required",
+		"ServiceAccount/sa3: imagePullSecrets in body is required",
+	}, res.ValidationErrors)
+
+	// the schemas of other Kubernetes versions do not exist
+	q.KubeVersion = "1.25"
+	res, err = service.GenerateManifest(context.Background(), &q)
+	require.NoError(t, err)
+	assert.Empty(t, res.ValidationErrors)
+}
+
 func Test_GenerateManifests_NoOutOfBoundsAccess(t *testing.T) {
 	testCases := []struct {
 		name                    string
@@ -249,7 +280,7 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 
 	cachedFakeResponse := &apiclient.ManifestResponse{Manifests: []string{"Fake"}}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil)
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse}, nil, nil)
 	assert.NoError(t, err)
 
 	res, err := service.GenerateManifest(context.Background(), &q)
@@ -271,7 +302,7 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 		Repo: &argoappv1.Repository{}, ApplicationSource: &src,
 	}
 
-	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil)
+	err := service.cache.SetManifests(mock.Anything, &src, q.RefSources, &q, "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil}, nil, nil)
 	assert.NoError(t, err)
 
 	res, err := service.GenerateManifest(context.Background(), &q)
@@ -296,6 +327,23 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	}, response)
 }
 
+func TestHelmManifestFromChartRepoWithPinnedHelmVersion(t *testing.T) {
+	service := newService(".")
+	source := &argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: ">= 1.0.0", Helm: &argoappv1.ApplicationSourceHelm{Version: "v3.10.3"}}
+	request := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: source,
+		NoCache:           true,
+		HelmOptions:       &argoappv1.HelmOptions{Versions: map[string]string{"v3.10.3": "/custom-tools/helm-v3.10.3"}},
+	}
+	_, err := service.GenerateManifest(context.Background(), request)
+	assert.ErrorContains(t, err, "/custom-tools/helm-v3.10.3")
+
+	request.HelmOptions = nil
+	_, err = service.GenerateManifest(context.Background(), request)
+	assert.ErrorContains(t, err, "helm chart version 'v3.10.3' is not supported")
+}
+
 func TestHelmChartReferencingExternalValues(t *testing.T) {
 	service := newService(".")
 	spec := argoappv1.ApplicationSpec{
@@ -464,7 +512,7 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 		assert.NotNil(t, manifestRequest)
 
 		cachedManifestResponse := &cache.CachedManifestResponse{}
-		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil)
+		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.RefSources, manifestRequest, manifestRequest.Namespace, "", manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse, nil, nil)
 		assert.Nil(t, err)
 		return cachedManifestResponse
 	}
@@ -1331,6 +1379,112 @@ func TestGetAppDetailsKustomize(t *testing.T) {
 	assert.EqualValues(t, []string{"nginx:1.15.4", "k8s.gcr.io/nginx-slim:0.8"}, res.Kustomize.Images)
 }
 
+func TestEvaluatePolicies(t *testing.T) {
+	service := newService("../..")
+	service.initConstants.AllowOutOfBoundsSymlinks = true
+	manifest := `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"my-pod","namespace":"default"},"spec":{"containers":[{"name":"main","image":"nginx:latest"}]}}`
+
+	res, err := service.EvaluatePolicies(context.Background(), &apiclient.PolicyEvaluationRequest{
+		Repo:      &argoappv1.Repository{},
+		Bundle:    &argoappv1.PolicyBundle{Path: "util/policy/testdata/kyverno", Engine: argoappv1.PolicyEngineKyverno},
+		Manifests: []string{manifest},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Violations, 2)
+	assert.Equal(t, "require-labels", res.Violations[0].Policy)
+	assert.Equal(t, "Pod", res.Violations[0].Kind)
+	assert.Equal(t, "my-pod", res.Violations[0].Name)
+	assert.False(t, res.Violations[0].Warning)
+	assert.Equal(t, "disallow-latest-tag", res.Violations[1].Policy)
+	assert.True(t, res.Violations[1].Warning)
+
+	_, err = service.EvaluatePolicies(context.Background(), &apiclient.PolicyEvaluationRequest{
+		Repo:   &argoappv1.Repository{},
+		Bundle: &argoappv1.PolicyBundle{Path: "../..", Engine: argoappv1.PolicyEngineKyverno},
+	})
+	assert.Error(t, err)
+}
+
+func TestUpdateRevisionForPaths(t *testing.T) {
+	syncedRevision := "1e67a504d03def3a6a1125d934cb511680f72555"
+	newRevision := "632039659e542ed7de0c170a4fcc1c571b288fc0"
+	newServiceWithChangedFiles := func(changedFiles []string) *Service {
+		service, _ := newServiceWithOpt(func(gitClient *gitmocks.Client, helmClient *helmmocks.Client, paths *iomocks.TempPaths) {
+			gitClient.On("Init").Return(nil)
+			gitClient.On("Fetch", mock.Anything).Return(nil)
+			gitClient.On("Checkout", mock.Anything, mock.Anything).Return(nil)
+			gitClient.On("LsRemote", "HEAD").Return(newRevision, nil)
+			gitClient.On("Root").Return("")
+			gitClient.On("ChangedFiles", syncedRevision, newRevision).Return(changedFiles, nil)
+			paths.On("GetPath", mock.Anything).Return("", nil)
+		}, "")
+		return service
+	}
+	request := func() *apiclient.UpdateRevisionForPathsRequest {
+		return &apiclient.UpdateRevisionForPathsRequest{
+			Repo:              &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"},
+			AppName:           "guestbook",
+			Namespace:         "default",
+			ApplicationSource: &argoappv1.ApplicationSource{Path: "guestbook", TargetRevision: "HEAD"},
+			KubeVersion:       "v1.25.0",
+			SyncedRevision:    syncedRevision,
+			Paths:             []string{"guestbook"},
+		}
+	}
+	cacheManifests := func(t *testing.T, service *Service, q *apiclient.UpdateRevisionForPathsRequest) {
+		err := service.cache.SetManifests(syncedRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cache.CachedManifestResponse{
+			ManifestResponse: &apiclient.ManifestResponse{Manifests: []string{"{}"}, Revision: syncedRevision},
+		}, nil, q.Paths)
+		require.NoError(t, err)
+	}
+
+	t.Run("Unrelated changes carry cached manifests over", func(t *testing.T) {
+		service := newServiceWithChangedFiles([]string{"README.md", "helm-guestbook/values.yaml"})
+		q := request()
+		cacheManifests(t, service, q)
+
+		res, err := service.UpdateRevisionForPaths(context.Background(), q)
+		require.NoError(t, err)
+		assert.False(t, res.Changes)
+		assert.Equal(t, newRevision, res.Revision)
+
+		var cachedManifests cache.CachedManifestResponse
+		err = service.cache.GetManifests(newRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cachedManifests, nil, q.Paths)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"{}"}, cachedManifests.ManifestResponse.Manifests)
+		assert.Equal(t, newRevision, cachedManifests.ManifestResponse.Revision)
+
+		// the manifests carried over are not valid for other paths, e.g. once a watch path is added
+		err = service.cache.GetManifests(newRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cachedManifests, nil, []string{"guestbook", "helm-guestbook"})
+		assert.Equal(t, cache.ErrCacheMiss, err)
+	})
+
+	t.Run("Changes to the paths require manifest generation", func(t *testing.T) {
+		service := newServiceWithChangedFiles([]string{"guestbook/guestbook-ui-svc.yaml"})
+		q := request()
+		cacheManifests(t, service, q)
+
+		res, err := service.UpdateRevisionForPaths(context.Background(), q)
+		require.NoError(t, err)
+		assert.True(t, res.Changes)
+		assert.Equal(t, newRevision, res.Revision)
+
+		var cachedManifests cache.CachedManifestResponse
+		err = service.cache.GetManifests(newRevision, q.ApplicationSource, q.RefSources, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &cachedManifests, nil, q.Paths)
+		assert.Equal(t, cache.ErrCacheMiss, err)
+	})
+
+	t.Run("Multiple sources are not supported", func(t *testing.T) {
+		service := newServiceWithChangedFiles(nil)
+		q := request()
+		q.HasMultipleSources = true
+
+		res, err := service.UpdateRevisionForPaths(context.Background(), q)
+		require.NoError(t, err)
+		assert.True(t, res.Changes)
+	})
+}
+
 func TestGetHelmCharts(t *testing.T) {
 	service := newService("../..")
 	res, err := service.GetHelmCharts(context.Background(), &apiclient.HelmChartsRequest{Repo: &argoappv1.Repository{}})
@@ -1743,7 +1897,7 @@ func TestGenerateManifestsWithAppParameterFile(t *testing.T) {
 			// Try to pull from the cache with a `source` that does not include any overrides. Overrides should not be
 			// part of the cache key, because you can't get the overrides without a repo operation. And avoiding repo
 			// operations is the point of the cache.
-			err = service.cache.GetManifests(mock.Anything, source, argoappv1.RefTargetRevisionMapping{}, &argoappv1.ClusterInfo{}, "", "", "", "test", res, nil)
+			err = service.cache.GetManifests(mock.Anything, source, argoappv1.RefTargetRevisionMapping{}, &argoappv1.ClusterInfo{}, "", "", "", "test", res, nil, nil)
 			assert.NoError(t, err)
 		})
 	})
@@ -2772,3 +2926,11 @@ func Test_getResolvedValueFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestListGitHubAppRepositories_NotGitHubApp(t *testing.T) {
+	service := newService(".")
+	_, err := service.ListGitHubAppRepositories(context.Background(), &apiclient.ListGitHubAppRepositoriesRequest{
+		Repo: &argoappv1.Repository{Repo: "https://github.com/argoproj/argo-cd", Username: "user", Password: "pass"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
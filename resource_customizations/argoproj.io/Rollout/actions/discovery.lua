@@ -13,6 +13,9 @@ fullyPromoted = obj.status.currentPodHash == obj.status.stableRS
 actions["abort"] = {["disabled"] = fullyPromoted or obj.status.abort}
 actions["retry"] = {["disabled"] = fullyPromoted or not(obj.status.abort)}
 
+local canaryWithSteps = obj.spec.strategy.canary ~= nil and obj.spec.strategy.canary.steps ~= nil
+actions["promote"] = {["disabled"] = fullyPromoted or obj.status.abort == true or not(paused or canaryWithSteps)}
+
 actions["promote-full"] = {["disabled"] = true}
 if obj.status ~= nil and not(fullyPromoted) then
     generation = tonumber(obj.status.observedGeneration)
@@ -0,0 +1,19 @@
+if obj.spec.paused ~= nil and obj.spec.paused then
+    obj.spec.paused = false
+end
+
+-- either clear the pause conditions, or skip the current step of a canary rollout running an analysis or an
+-- experiment, like `kubectl argo rollouts promote` does
+if obj.status.pauseConditions ~= nil and table.getn(obj.status.pauseConditions) > 0 then
+    obj.status.pauseConditions = nil
+elseif obj.spec.strategy.canary ~= nil and obj.spec.strategy.canary.steps ~= nil then
+    local index = 0
+    if obj.status.currentStepIndex ~= nil then
+        index = obj.status.currentStepIndex
+    end
+    if index < table.getn(obj.spec.strategy.canary.steps) then
+        obj.status.currentStepIndex = index + 1
+    end
+end
+
+return obj
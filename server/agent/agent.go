@@ -0,0 +1,233 @@
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/rand"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+const (
+	// ProxyPath is the path under which the API server proxies the requests made to the clusters of the agents
+	ProxyPath = "/api/agents/"
+
+	tokenLength = 48
+)
+
+// Server provides an Agent service
+type Server struct {
+	db            db.ArgoDB
+	enf           *rbac.Enforcer
+	authenticator Authenticator
+	proxyURL      string
+	proxyCAData   []byte
+	replicas      int
+
+	lock    sync.Mutex
+	tunnels map[string]*tunnel
+}
+
+// Authenticator authenticates the users calling the RPCs other than Connect
+type Authenticator interface {
+	Authenticate(ctx context.Context) (context.Context, error)
+}
+
+// NewServer returns a new instance of the Agent service. The clusters of the agents are registered with the given URL
+// of the API server, through which the application controller reaches them, and the PEM encoded CA verifying the
+// certificate served at this URL, or nil to use the system roots. The tunnels of the agents are held in memory, so
+// the agents are refused when the API server runs more than one replica.
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer, authenticator Authenticator, proxyURL string, proxyCAData []byte, replicas int) *Server {
+	return &Server{
+		db:            db,
+		enf:           enf,
+		authenticator: authenticator,
+		proxyURL:      strings.TrimSuffix(proxyURL, "/"),
+		proxyCAData:   proxyCAData,
+		replicas:      replicas,
+		tunnels:       map[string]*tunnel{},
+	}
+}
+
+// checkSingleReplica returns an error if the API server runs several replicas, since the requests made to the
+// cluster of an agent could reach a replica which does not hold its tunnel
+func (s *Server) checkSingleReplica() error {
+	if s.replicas > 1 {
+		return status.Errorf(codes.FailedPrecondition, "agents are not supported when the API server runs %d replicas", s.replicas)
+	}
+	return nil
+}
+
+// clusterServer returns the URL of the cluster of the agent with the given name
+func (s *Server) clusterServer(name string) string {
+	return s.proxyURL + ProxyPath + name
+}
+
+// Register registers the agent of a cluster and returns the token it authenticates with. The cluster is reached
+// through the API server with another token, so that the token of the agent does not give access to its cluster.
+func (s *Server) Register(ctx context.Context, q *agent.AgentRegisterRequest) (*agent.AgentRegisterResponse, error) {
+	if q.Name == "" || strings.Contains(q.Name, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid agent name %q", q.Name)
+	}
+	server := s.clusterServer(q.Name)
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionCreate, createRBACObject(q.Project, server)); err != nil {
+		return nil, err
+	}
+	if err := s.checkSingleReplica(); err != nil {
+		return nil, err
+	}
+	token, err := rand.String(tokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate agent token: %v", err)
+	}
+	proxyToken, err := rand.String(tokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate agent token: %v", err)
+	}
+	c := &appv1.Cluster{
+		Name:        q.Name,
+		Server:      server,
+		Project:     q.Project,
+		Namespaces:  q.Namespaces,
+		Annotations: map[string]string{common.AnnotationKeyAgentTokenHash: hashToken(token)},
+		Config: appv1.ClusterConfig{
+			BearerToken:     proxyToken,
+			TLSClientConfig: appv1.TLSClientConfig{CAData: s.proxyCAData},
+		},
+	}
+	_, err = s.db.CreateCluster(ctx, c)
+	if status.Convert(err).Code() == codes.AlreadyExists && q.Upsert {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, createRBACObject(q.Project, server)); err != nil {
+			return nil, err
+		}
+		_, err = s.db.UpdateCluster(ctx, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &agent.AgentRegisterResponse{Token: token, Server: server}, nil
+}
+
+// Connect opens the tunnel of an agent, which lasts until the agent disconnects
+func (s *Server) Connect(stream agent.AgentService_ConnectServer) error {
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if msg.Hello == nil {
+		return status.Errorf(codes.Unauthenticated, "the first message of an agent must authenticate it")
+	}
+	name := msg.Hello.Name
+	if err := s.authenticate(stream.Context(), name, msg.Hello.Token); err != nil {
+		return err
+	}
+	if err := s.checkSingleReplica(); err != nil {
+		return err
+	}
+
+	t := newTunnel(name)
+	s.lock.Lock()
+	if previous, ok := s.tunnels[name]; ok {
+		// the agent reconnected before the previous tunnel has been detected as broken
+		previous.close()
+	}
+	s.tunnels[name] = t
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		if s.tunnels[name] == t {
+			delete(s.tunnels, name)
+		}
+		s.lock.Unlock()
+		t.close()
+	}()
+
+	logCtx := log.WithField("agent", name)
+	logCtx.Info("Agent connected")
+	defer logCtx.Info("Agent disconnected")
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- t.sendLoop(stream)
+	}()
+	go func() {
+		errs <- t.recvLoop(stream)
+	}()
+	select {
+	case err = <-errs:
+	case <-t.done:
+	}
+	return err
+}
+
+// authenticate checks the token of the agent with the given name
+func (s *Server) authenticate(ctx context.Context, name string, token string) error {
+	c, err := s.getAgentCluster(ctx, name)
+	if err != nil {
+		log.WithField("agent", name).Warnf("Failed to authenticate agent: %v", err)
+		return status.Errorf(codes.Unauthenticated, "invalid agent name or token")
+	}
+	tokenHash := c.Annotations[common.AnnotationKeyAgentTokenHash]
+	if token == "" || tokenHash == "" || subtle.ConstantTimeCompare([]byte(hashToken(token)), []byte(tokenHash)) != 1 {
+		return status.Errorf(codes.Unauthenticated, "invalid agent name or token")
+	}
+	return nil
+}
+
+// hashToken returns the hex encoded SHA-256 hash of the token of an agent, which is stored instead of the token
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// getAgentCluster returns the cluster of the agent with the given name
+func (s *Server) getAgentCluster(ctx context.Context, name string) (*appv1.Cluster, error) {
+	servers, err := s.db.GetClusterServersByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, server := range servers {
+		if strings.HasSuffix(server, ProxyPath+name) {
+			return s.db.GetCluster(ctx, server)
+		}
+	}
+	return nil, fmt.Errorf("cluster %s is not the cluster of an agent", name)
+}
+
+// getTunnel returns the tunnel of the agent with the given name if it is connected
+func (s *Server) getTunnel(name string) (*tunnel, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	t, ok := s.tunnels[name]
+	return t, ok
+}
+
+// AuthFuncOverride lets the agents authenticate with their own tokens, while the other RPCs require the usual
+// credentials
+func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if fullMethodName == "/agent.AgentService/Connect" {
+		return ctx, nil
+	}
+	return s.authenticator.Authenticate(ctx)
+}
+
+func createRBACObject(project string, server string) string {
+	if project != "" {
+		return project + "/" + server
+	}
+	return server
+}
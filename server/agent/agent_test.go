@@ -0,0 +1,229 @@
+package agent
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+const (
+	testServer     = "https://argocd-server.argocd.svc/api/agents/edge"
+	testToken      = "secret-token"
+	testProxyToken = "proxy-token"
+)
+
+func newNoopEnforcer() *rbac.Enforcer {
+	enf := rbac.NewEnforcer(fake.NewSimpleClientset(test.NewFakeConfigMap()), test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	enf.EnableEnforce(false)
+	return enf
+}
+
+func newTestServer() *Server {
+	db := &dbmocks.ArgoDB{}
+	db.On("GetClusterServersByName", mock.Anything, "edge").Return([]string{testServer}, nil)
+	db.On("GetClusterServersByName", mock.Anything, mock.Anything).Return([]string{}, nil)
+	db.On("GetCluster", mock.Anything, testServer).Return(&v1alpha1.Cluster{
+		Name:        "edge",
+		Server:      testServer,
+		Annotations: map[string]string{common.AnnotationKeyAgentTokenHash: hashToken(testToken)},
+		Config:      v1alpha1.ClusterConfig{BearerToken: testProxyToken},
+	}, nil)
+	return NewServer(db, newNoopEnforcer(), nil, "https://argocd-server.argocd.svc", nil, 1)
+}
+
+// fakeStream is the server side of the tunnel of an agent
+type fakeStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received chan *agent.AgentMessage
+	sent     chan *agent.ServerMessage
+}
+
+func newFakeStream(ctx context.Context) *fakeStream {
+	return &fakeStream{ctx: ctx, received: make(chan *agent.AgentMessage, 10), sent: make(chan *agent.ServerMessage, 10)}
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Send(msg *agent.ServerMessage) error {
+	s.sent <- msg
+	return nil
+}
+
+func (s *fakeStream) Recv() (*agent.AgentMessage, error) {
+	select {
+	case msg := <-s.received:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, io.EOF
+	}
+}
+
+func TestRegister(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+	var created *v1alpha1.Cluster
+	db.On("CreateCluster", mock.Anything, mock.MatchedBy(func(c *v1alpha1.Cluster) bool {
+		created = c
+		return true
+	})).Return(&v1alpha1.Cluster{}, nil)
+	server := NewServer(db, newNoopEnforcer(), nil, "https://argocd.example.com/", []byte("ca"), 1)
+
+	res, err := server.Register(context.Background(), &agent.AgentRegisterRequest{Name: "edge", Project: "default"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://argocd.example.com/api/agents/edge", res.Server)
+	assert.NotEmpty(t, res.Token)
+	require.NotNil(t, created)
+	assert.Equal(t, res.Server, created.Server)
+	assert.Equal(t, "default", created.Project)
+	// the cluster is reached through the API server with another token than the token of the agent
+	assert.NotEmpty(t, created.Config.BearerToken)
+	assert.NotEqual(t, res.Token, created.Config.BearerToken)
+	assert.Equal(t, hashToken(res.Token), created.Annotations[common.AnnotationKeyAgentTokenHash])
+	assert.False(t, created.Config.Insecure)
+	assert.Equal(t, []byte("ca"), created.Config.CAData)
+}
+
+func TestRegister_InvalidName(t *testing.T) {
+	server := NewServer(&dbmocks.ArgoDB{}, newNoopEnforcer(), nil, "https://argocd.example.com", nil, 1)
+
+	_, err := server.Register(context.Background(), &agent.AgentRegisterRequest{Name: "edge/cluster"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRegister_SeveralReplicas(t *testing.T) {
+	server := NewServer(&dbmocks.ArgoDB{}, newNoopEnforcer(), nil, "https://argocd.example.com", nil, 2)
+
+	_, err := server.Register(context.Background(), &agent.AgentRegisterRequest{Name: "edge"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestConnect_InvalidToken(t *testing.T) {
+	server := newTestServer()
+	stream := newFakeStream(context.Background())
+	stream.received <- &agent.AgentMessage{Hello: &agent.AgentHello{Name: "edge", Token: "wrong"}}
+
+	err := server.Connect(stream)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestConnect_ProxyToken(t *testing.T) {
+	server := newTestServer()
+	stream := newFakeStream(context.Background())
+	stream.received <- &agent.AgentMessage{Hello: &agent.AgentHello{Name: "edge", Token: testProxyToken}}
+
+	err := server.Connect(stream)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestConnect_SeveralReplicas(t *testing.T) {
+	server := newTestServer()
+	server.replicas = 2
+	stream := newFakeStream(context.Background())
+	stream.received <- &agent.AgentMessage{Hello: &agent.AgentHello{Name: "edge", Token: testToken}}
+
+	err := server.Connect(stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestConnect_UnknownAgent(t *testing.T) {
+	server := newTestServer()
+	stream := newFakeStream(context.Background())
+	stream.received <- &agent.AgentMessage{Hello: &agent.AgentHello{Name: "unknown", Token: testToken}}
+
+	err := server.Connect(stream)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServeHTTP(t *testing.T) {
+	server := newTestServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newFakeStream(ctx)
+	stream.received <- &agent.AgentMessage{Hello: &agent.AgentHello{Name: "edge", Token: testToken}}
+	go func() {
+		_ = server.Connect(stream)
+	}()
+	require.Eventually(t, func() bool {
+		_, ok := server.getTunnel("edge")
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the agent performs the requests sent through the tunnel
+	go func() {
+		for msg := range stream.sent {
+			if msg.Request == nil {
+				continue
+			}
+			for _, h := range msg.Request.Headers {
+				assert.NotEqual(t, "Authorization", h.Name)
+			}
+			stream.received <- &agent.AgentMessage{Response: &agent.AgentResponse{
+				Id:      msg.Request.Id,
+				Status:  http.StatusOK,
+				Headers: []*agent.Header{{Name: "Content-Type", Values: []string{"application/json"}}},
+				Body:    []byte(`{"path":"` + msg.Request.Path + `"`),
+			}}
+			stream.received <- &agent.AgentMessage{Response: &agent.AgentResponse{Id: msg.Request.Id, Body: []byte("}"), End: true}}
+		}
+	}()
+
+	t.Run("Authorized", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/agents/edge/api/v1/namespaces?limit=1", nil)
+		req.Header.Set("Authorization", "Bearer "+testProxyToken)
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"path":"/api/v1/namespaces?limit=1"}`, w.Body.String())
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/agents/edge/api/v1/namespaces", nil)
+		req.Header.Set("Authorization", "Bearer wrong")
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("AgentToken", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/agents/edge/api/v1/namespaces", nil)
+		req.Header.Set("Authorization", "Bearer "+testToken)
+		w := httptest.NewRecorder()
+		server.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestServeHTTP_NotConnected(t *testing.T) {
+	server := newTestServer()
+	req := httptest.NewRequest(http.MethodGet, "/api/agents/edge/api/v1/namespaces", nil)
+	req.Header.Set("Authorization", "Bearer "+testProxyToken)
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
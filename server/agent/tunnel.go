@@ -0,0 +1,241 @@
+package agent
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
+)
+
+const (
+	// responseBufferSize is the number of parts of a response buffered while the client reads the previous ones
+	responseBufferSize = 100
+	// maxRequestBodySize is the maximum size of the body of a request sent to an agent
+	maxRequestBodySize = 100 * 1024 * 1024
+)
+
+// hopHeaders are the headers which are not forwarded to the agents, either because they only concern the connection
+// to the API server or because the agents authenticate the requests with their own credentials
+var hopHeaders = map[string]bool{
+	"Authorization":       true,
+	"Connection":          true,
+	"Keep-Alive":          true,
+	"Proxy-Authorization": true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+// tunnel is the connection of an agent, through which the API server sends the requests made to its cluster
+type tunnel struct {
+	name     string
+	messages chan *agent.ServerMessage
+	done     chan struct{}
+
+	lock     sync.Mutex
+	closed   bool
+	lastID   int64
+	requests map[int64]*pendingRequest
+}
+
+// pendingRequest is a request waiting for the parts of its response
+type pendingRequest struct {
+	responses chan *agent.AgentResponse
+	// released is closed once the response is not read anymore
+	released chan struct{}
+}
+
+func newTunnel(name string) *tunnel {
+	return &tunnel{
+		name:     name,
+		messages: make(chan *agent.ServerMessage),
+		done:     make(chan struct{}),
+		requests: map[int64]*pendingRequest{},
+	}
+}
+
+func (t *tunnel) close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if !t.closed {
+		t.closed = true
+		close(t.done)
+	}
+}
+
+// sendLoop sends the messages to the agent until the tunnel is closed
+func (t *tunnel) sendLoop(stream agent.AgentService_ConnectServer) error {
+	for {
+		select {
+		case msg := <-t.messages:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case <-t.done:
+			return nil
+		}
+	}
+}
+
+// recvLoop dispatches the responses of the agent to the requests waiting for them until the tunnel is closed
+func (t *tunnel) recvLoop(stream agent.AgentService_ConnectServer) error {
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if msg.Response == nil {
+			continue
+		}
+		t.lock.Lock()
+		req, ok := t.requests[msg.Response.Id]
+		t.lock.Unlock()
+		if !ok {
+			// the request has been canceled
+			continue
+		}
+		select {
+		case req.responses <- msg.Response:
+		case <-req.released:
+		case <-t.done:
+			return nil
+		}
+	}
+}
+
+// send sends a message to the agent
+func (t *tunnel) send(ctx context.Context, msg *agent.ServerMessage) error {
+	select {
+	case t.messages <- msg:
+		return nil
+	case <-t.done:
+		return fmt.Errorf("agent %s disconnected", t.name)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// do sends a request to the agent and returns the channel of the parts of its response, and a function releasing the
+// request once its response has been read or is not needed anymore
+func (t *tunnel) do(ctx context.Context, req *agent.AgentRequest) (<-chan *agent.AgentResponse, func(), error) {
+	pending := &pendingRequest{
+		responses: make(chan *agent.AgentResponse, responseBufferSize),
+		released:  make(chan struct{}),
+	}
+	t.lock.Lock()
+	t.lastID++
+	req.Id = t.lastID
+	t.requests[req.Id] = pending
+	t.lock.Unlock()
+
+	release := func() {
+		t.lock.Lock()
+		delete(t.requests, req.Id)
+		t.lock.Unlock()
+		close(pending.released)
+	}
+	if err := t.send(ctx, &agent.ServerMessage{Request: req}); err != nil {
+		release()
+		return nil, nil, err
+	}
+	return pending.responses, release, nil
+}
+
+// ServeHTTP proxies the requests made to the clusters of the agents through their tunnels. The requests are
+// authenticated with the bearer token of the cluster of the agent, which differs from the token of the agent.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, ProxyPath), "/")
+	c, err := s.getAgentCluster(r.Context(), name)
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if err != nil || token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(c.Config.BearerToken)) != 1 {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	t, ok := s.getTunnel(name)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Agent %s is not connected", name), http.StatusServiceUnavailable)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &agent.AgentRequest{Method: r.Method, Path: "/" + path, Body: body}
+	if r.URL.RawQuery != "" {
+		req.Path += "?" + r.URL.RawQuery
+	}
+	for header, values := range r.Header {
+		if !hopHeaders[http.CanonicalHeaderKey(header)] {
+			req.Headers = append(req.Headers, &agent.Header{Name: header, Values: values})
+		}
+	}
+
+	ctx := r.Context()
+	responses, release, err := t.do(ctx, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer release()
+
+	started := false
+	for {
+		select {
+		case res := <-responses:
+			if res.Error != "" {
+				if !started {
+					http.Error(w, res.Error, http.StatusBadGateway)
+				}
+				return
+			}
+			if !started {
+				for _, h := range res.Headers {
+					if hopHeaders[http.CanonicalHeaderKey(h.Name)] {
+						continue
+					}
+					for _, v := range h.Values {
+						w.Header().Add(h.Name, v)
+					}
+				}
+				w.WriteHeader(int(res.Status))
+				started = true
+			}
+			if len(res.Body) > 0 {
+				if _, err := w.Write(res.Body); err != nil {
+					_ = t.send(context.Background(), &agent.ServerMessage{Cancel: req.Id})
+					return
+				}
+			}
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+			if res.End {
+				return
+			}
+		case <-ctx.Done():
+			// the client went away, e.g. a watch is closed, so the agent can stop performing the request
+			if err := t.send(context.Background(), &agent.ServerMessage{Cancel: req.Id}); err != nil {
+				log.WithField("agent", name).Debugf("Failed to cancel request: %v", err)
+			}
+			return
+		case <-t.done:
+			if !started {
+				http.Error(w, fmt.Sprintf("Agent %s disconnected", name), http.StatusBadGateway)
+			}
+			return
+		}
+	}
+}
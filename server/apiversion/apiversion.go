@@ -0,0 +1,125 @@
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+const (
+	// V1 is the version of the REST API which is deprecated in favour of V2
+	V1 = "v1"
+	// V2 is the latest version of the REST API. Only the endpoints whose contract changed in V2 are registered under
+	// V2, the requests to the other endpoints are served by their V1 endpoints.
+	V2 = "v2"
+	// Latest is the version of the REST API new integrations should use
+	Latest = V2
+
+	apiPrefix = "/api/"
+)
+
+// RequestCounter counts the requests made to the versions of the REST API
+type RequestCounter interface {
+	IncAPIRequest(version string)
+}
+
+type handler struct {
+	handler http.Handler
+	counter RequestCounter
+}
+
+// NewHandler returns a handler counting the requests made to the versions of the REST API served by the given handler.
+// The responses to the requests to deprecated versions are served with deprecation headers.
+func NewHandler(h http.Handler, counter RequestCounter) http.Handler {
+	return &handler{handler: h, counter: counter}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	version, ok := requestVersion(r.URL.Path)
+	if !ok {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+	if h.counter != nil {
+		h.counter.IncAPIRequest(version)
+	}
+	if version == V1 {
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Warning", fmt.Sprintf(`299 - "API %s is deprecated, use %s%s instead"`, V1, apiPrefix, Latest))
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
+// Router routes the requests to the REST API to the grpc-gateway mux of their version, on which the services are
+// registered with the routes of that version. The requests to V2 which have no V2 route are served by the V1 mux, so
+// that only the endpoints whose contract changed in V2 need to be registered on the V2 mux.
+type Router struct {
+	muxes map[string]*runtime.ServeMux
+}
+
+// NewRouter returns a router to grpc-gateway muxes created with the given options
+func NewRouter(opts ...runtime.ServeMuxOption) *Router {
+	v1 := runtime.NewServeMux(opts...)
+	v2 := runtime.NewServeMux(append(opts, runtime.WithProtoErrorHandler(fallbackErrorHandler(v1)))...)
+	return &Router{muxes: map[string]*runtime.ServeMux{V1: v1, V2: v2}}
+}
+
+// Mux returns the grpc-gateway mux of the given version, on which the services are registered with their routes of
+// that version
+func (r *Router) Mux(version string) *runtime.ServeMux {
+	return r.muxes[version]
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	version, ok := requestVersion(req.URL.Path)
+	if !ok {
+		version = V1
+	}
+	r.muxes[version].ServeHTTP(w, req)
+}
+
+// fallbackErrorHandler returns the error handler of the V2 mux, which serves the requests without a V2 route with the
+// given V1 mux, and handles the other errors like the muxes without an error handler
+func fallbackErrorHandler(v1 *runtime.ServeMux) runtime.ProtoErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if err == runtime.ErrUnknownURI {
+			v1.ServeHTTP(w, withVersion(r, V1))
+			return
+		}
+		runtime.GlobalHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+	}
+}
+
+// Path returns the given path of the REST API under the given version, or the path itself if it is not versioned
+func Path(path string, version string) string {
+	if _, ok := requestVersion(path); !ok {
+		return path
+	}
+	parts := strings.SplitN(strings.TrimPrefix(path, apiPrefix), "/", 2)
+	parts[0] = version
+	return apiPrefix + strings.Join(parts, "/")
+}
+
+// requestVersion returns the version of the REST API requested by the given path
+func requestVersion(path string) (string, bool) {
+	if !strings.HasPrefix(path, apiPrefix) {
+		return "", false
+	}
+	version := strings.SplitN(strings.TrimPrefix(path, apiPrefix), "/", 2)[0]
+	switch version {
+	case V1, V2:
+		return version, true
+	}
+	return "", false
+}
+
+// withVersion returns a copy of the request to the given version of the REST API
+func withVersion(r *http.Request, version string) *http.Request {
+	r = r.Clone(r.Context())
+	r.URL.Path = Path(r.URL.Path, version)
+	r.URL.RawPath = Path(r.URL.RawPath, version)
+	return r
+}
@@ -0,0 +1,118 @@
+package apiversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCounter map[string]int
+
+func (c fakeCounter) IncAPIRequest(version string) {
+	c[version]++
+}
+
+func newTestHandler(counter RequestCounter) (http.Handler, *http.Request) {
+	served := &http.Request{}
+	return NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*served = *r
+	}), counter), served
+}
+
+func TestHandler_V1(t *testing.T) {
+	counter := fakeCounter{}
+	h, served := newTestHandler(counter)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/api/v1/applications", nil))
+
+	assert.Equal(t, "/api/v1/applications", served.URL.Path)
+	assert.Equal(t, "true", rr.Header().Get("Deprecation"))
+	assert.Contains(t, rr.Header().Get("Warning"), "/api/v2")
+	assert.Equal(t, fakeCounter{V1: 1}, counter)
+}
+
+func TestHandler_V2(t *testing.T) {
+	counter := fakeCounter{}
+	h, served := newTestHandler(counter)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/api/v2/applications", nil))
+
+	assert.Equal(t, "/api/v2/applications", served.URL.Path)
+	assert.Empty(t, rr.Header().Get("Deprecation"))
+	assert.Equal(t, fakeCounter{V2: 1}, counter)
+}
+
+func TestHandler_Unversioned(t *testing.T) {
+	counter := fakeCounter{}
+	h, served := newTestHandler(counter)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest("GET", "/api/version", nil))
+
+	assert.Equal(t, "/api/version", served.URL.Path)
+	assert.Empty(t, rr.Header().Get("Deprecation"))
+	assert.Empty(t, counter)
+}
+
+// newTestPattern returns the grpc-gateway pattern of the given literal path
+func newTestPattern(path string) runtime.Pattern {
+	components := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	var ops []int
+	for i := range components {
+		ops = append(ops, int(utilities.OpLitPush), i)
+	}
+	return runtime.MustPattern(runtime.NewPattern(1, ops, components, ""))
+}
+
+func TestRouter(t *testing.T) {
+	router := NewRouter()
+	var served []string
+	serve := func(version string) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			served = append(served, version+" "+r.URL.Path)
+		}
+	}
+	router.Mux(V1).Handle("GET", newTestPattern("/api/v1/clusters"), serve(V1))
+	router.Mux(V1).Handle("GET", newTestPattern("/api/v1/applications"), serve(V1))
+	router.Mux(V2).Handle("GET", newTestPattern("/api/v2/applications"), serve(V2))
+
+	t.Run("V2Route", func(t *testing.T) {
+		served = nil
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/v2/applications", nil))
+		assert.Equal(t, []string{"v2 /api/v2/applications"}, served)
+	})
+
+	t.Run("V1Fallback", func(t *testing.T) {
+		served = nil
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/v2/clusters", nil))
+		assert.Equal(t, []string{"v1 /api/v1/clusters"}, served)
+	})
+
+	t.Run("V1", func(t *testing.T) {
+		served = nil
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/v1/applications", nil))
+		assert.Equal(t, []string{"v1 /api/v1/applications"}, served)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		served = nil
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest("GET", "/api/v2/unknown", nil))
+		assert.Empty(t, served)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "/api/v1/clusters/https%3A%2F%2Fkubernetes.default.svc", Path("/api/v2/clusters/https%3A%2F%2Fkubernetes.default.svc", V1))
+	assert.Equal(t, "/api/v2/applications", Path("/api/v1/applications", V2))
+	assert.Equal(t, "/api/version", Path("/api/version", V1))
+	assert.Equal(t, "", Path("", V1))
+}
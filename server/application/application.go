@@ -1,6 +1,7 @@
 package application
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -14,20 +15,25 @@ import (
 
 	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
+	"github.com/argoproj/notifications-engine/pkg/services"
 	"github.com/argoproj/pkg/sync"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
@@ -44,15 +50,20 @@ import (
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/deeplinks"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	argopath "github.com/argoproj/argo-cd/v2/util/app/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+	argokube "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/manifeststream"
+	settings_notif "github.com/argoproj/argo-cd/v2/util/notification/settings"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/security"
 	"github.com/argoproj/argo-cd/v2/util/session"
@@ -65,6 +76,27 @@ const (
 	maxPodLogsToRender                 = 10
 	backgroundPropagationPolicy string = "background"
 	foregroundPropagationPolicy string = "foreground"
+	// maxResourcesChunkSize is the maximum size in bytes of the chunks of streamed resources, well below the default
+	// maximum gRPC message size
+	maxResourcesChunkSize = 1024 * 1024
+	// aggregatedEventsConcurrency is the maximum number of concurrent requests listing the events of the resources of
+	// an application
+	aggregatedEventsConcurrency = 10
+	// maxEventsPerResource is the maximum number of events listed for each resource of an application
+	maxEventsPerResource = 100
+	// maxAggregatedEvents is the maximum number of aggregated events returned for an application
+	maxAggregatedEvents = 500
+
+	rolloutGroup              = "argoproj.io"
+	rolloutKind               = "Rollout"
+	analysisRunKind           = "AnalysisRun"
+	rolloutRevisionAnnotation = "rollout.argoproj.io/revision"
+
+	// phases of the progress of a refresh streamed by the Refresh API
+	refreshPhaseRequested = "Requested"
+	refreshPhasePending   = "Pending"
+	refreshPhaseCompleted = "Completed"
+	refreshPhaseDropped   = "Dropped"
 )
 
 var (
@@ -171,6 +203,9 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*ap
 	// Filter applications by source repo URL
 	newItems = argoutil.FilterByRepo(newItems, q.GetRepo())
 
+	// Filter applications by external ID
+	newItems = argoutil.FilterByExternalIDs(newItems, q.GetExternalIDs())
+
 	// Sort found applications by name
 	sort.Slice(newItems, func(i, j int) bool {
 		return newItems[i].Name < newItems[j].Name
@@ -250,7 +285,8 @@ func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateReq
 	return updated, nil
 }
 
-func (s *Server) queryRepoServer(ctx context.Context, a *appv1.Application, action func(
+// repoServerAction is an action querying the repo server about a source of an application
+type repoServerAction func(
 	client apiclient.RepoServerServiceClient,
 	repo *appv1.Repository,
 	helmRepos []*appv1.Repository,
@@ -258,14 +294,20 @@ func (s *Server) queryRepoServer(ctx context.Context, a *appv1.Application, acti
 	helmOptions *appv1.HelmOptions,
 	kustomizeOptions *appv1.KustomizeOptions,
 	enabledSourceTypes map[string]bool,
-) error) error {
+) error
+
+func (s *Server) queryRepoServer(ctx context.Context, a *appv1.Application, action repoServerAction) error {
+	return s.queryRepoServerForSource(ctx, a, a.Spec.GetSource(), action)
+}
 
+// queryRepoServerForSource runs the given action with the options of the given source of an application
+func (s *Server) queryRepoServerForSource(ctx context.Context, a *appv1.Application, source appv1.ApplicationSource, action repoServerAction) error {
 	closer, client, err := s.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return fmt.Errorf("error creating repo server client: %w", err)
 	}
 	defer ioutil.Close(closer)
-	repo, err := s.db.GetRepository(ctx, a.Spec.GetSource().RepoURL)
+	repo, err := s.db.GetRepository(ctx, source.RepoURL)
 	if err != nil {
 		return fmt.Errorf("error getting repository: %w", err)
 	}
@@ -273,7 +315,7 @@ func (s *Server) queryRepoServer(ctx context.Context, a *appv1.Application, acti
 	if err != nil {
 		return fmt.Errorf("error getting kustomize settings: %w", err)
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(a.Spec.GetSource())
+	kustomizeOptions, err := kustomizeSettings.GetOptions(source)
 	if err != nil {
 		return fmt.Errorf("error getting kustomize settings options: %w", err)
 	}
@@ -334,13 +376,43 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	revision := source.TargetRevision
+	if q.GetRevision() != "" {
+		revision = q.GetRevision()
+	}
+	manifestInfo, err := s.generateManifests(ctx, a, source, revision, q.GetPostRenderer())
+	if err != nil {
+		return nil, err
+	}
+
+	for i, manifest := range manifestInfo.Manifests {
+		obj := &unstructured.Unstructured{}
+		err = json.Unmarshal([]byte(manifest), obj)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			obj, _, err = diff.HideSecretData(obj, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			}
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling manifest: %w", err)
+			}
+			manifestInfo.Manifests[i] = string(data)
+		}
+	}
+
+	return manifestInfo, nil
+}
+
+// generateManifests generates the manifests of the given source of an application at the given revision, transformed
+// by the given post-renderer if any
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, source appv1.ApplicationSource, revision string, postRenderer *apiclient.ManifestPostRenderer) (*apiclient.ManifestResponse, error) {
 	var manifestInfo *apiclient.ManifestResponse
-	err = s.queryRepoServer(ctx, a, func(
+	err := s.queryRepoServerForSource(ctx, a, source, func(
 		client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, kustomizeOptions *appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
-		revision := source.TargetRevision
-		if q.GetRevision() != "" {
-			revision = q.GetRevision()
-		}
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
 			return fmt.Errorf("error getting app instance label key from settings: %w", err)
@@ -350,6 +422,10 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		if err != nil {
 			return fmt.Errorf("error getting plugins: %w", err)
 		}
+		featureFlags, err := s.settingsMgr.GetFeatureFlags()
+		if err != nil {
+			return fmt.Errorf("error getting feature flags from settings: %w", err)
+		}
 		config, err := s.getApplicationClusterConfig(ctx, a)
 		if err != nil {
 			return fmt.Errorf("error getting application cluster config: %w", err)
@@ -381,6 +457,8 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			HelmOptions:        helmOptions,
 			TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
 			EnabledSourceTypes: enableGenerateManifests,
+			FeatureFlags:       featureFlags,
+			PostRenderer:       postRenderer,
 		})
 		if err != nil {
 			return fmt.Errorf("error generating manifests: %w", err)
@@ -391,27 +469,198 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
+	return manifestInfo, nil
+}
 
-	for i, manifest := range manifestInfo.Manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest), obj)
+// WarmManifests generates the manifests of every source of the given application at its target revision, with the
+// options the application controller compares the application with, so that its next comparison hits the manifest
+// cache of the repo server. The target revisions are resolved again, since they are expected to have just changed.
+func (s *Server) WarmManifests(ctx context.Context, a *appv1.Application) error {
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return fmt.Errorf("error getting application's project: %w", err)
+	}
+	refSources, err := argo.GetRefSources(ctx, a.Spec, s.db)
+	if err != nil {
+		return fmt.Errorf("error getting ref sources: %w", err)
+	}
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return fmt.Errorf("error getting app instance label key from settings: %w", err)
+	}
+	plugins, err := s.plugins()
+	if err != nil {
+		return fmt.Errorf("error getting plugins: %w", err)
+	}
+	sourcePathChangeDetection, err := s.settingsMgr.GetSourcePathChangeDetectionEnabled()
+	if err != nil {
+		return fmt.Errorf("error getting source path change detection from settings: %w", err)
+	}
+	// the refresh paths are part of the manifest cache key, like in the requests of the application controller
+	refreshPaths := argopath.GetAppRefreshPaths(a, sourcePathChangeDetection)
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	serverVersion, err := s.kubectl.GetServerVersion(config)
+	if err != nil {
+		return fmt.Errorf("error getting server version: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return fmt.Errorf("error getting API resources: %w", err)
+	}
+	verifySignature := len(proj.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled()
+
+	for _, source := range a.Spec.GetSources() {
+		source := source
+		err := s.queryRepoServerForSource(ctx, a, source, func(
+			client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, kustomizeOptions *appv1.KustomizeOptions, enabledSourceTypes map[string]bool) error {
+			_, err := client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+				Repo:               repo,
+				Repos:              helmRepos,
+				Revision:           source.TargetRevision,
+				NoRevisionCache:    true,
+				AppLabelKey:        appInstanceLabelKey,
+				AppName:            a.InstanceName(s.ns),
+				Namespace:          a.Spec.Destination.Namespace,
+				ApplicationSource:  &source,
+				Plugins:            plugins,
+				KustomizeOptions:   kustomizeOptions,
+				KubeVersion:        serverVersion,
+				ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
+				VerifySignature:    verifySignature,
+				HelmRepoCreds:      helmCreds,
+				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+				EnabledSourceTypes: enabledSourceTypes,
+				HelmOptions:        helmOptions,
+				HasMultipleSources: a.Spec.HasMultipleSources(),
+				RefSources:         refSources,
+				RefreshPaths:       refreshPaths,
+			})
+			return err
+		})
 		if err != nil {
-			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			return fmt.Errorf("error generating manifests of source %s: %w", source.RepoURL, err)
 		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-			obj, _, err = diff.HideSecretData(obj, nil)
+	}
+	return nil
+}
+
+// RevisionsDiff returns the differences between the manifests of an application at two revisions. The manifests are
+// generated by the repo server, which caches them per revision.
+func (s *Server) RevisionsDiff(ctx context.Context, q *application.ApplicationRevisionsDiffQuery) (*application.ApplicationRevisionsDiffResponse, error) {
+	if q.GetBaseRevision() == "" || q.GetHeadRevision() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "base and head revisions are required")
+	}
+	a, err := s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+	return s.revisionsDiff(ctx, a, q.GetBaseRevision(), q.GetHeadRevision())
+}
+
+// PreviewRevisions returns the differences between the manifests of the given application at two revisions, e.g. to
+// preview the changes of a pull request. Unlike RevisionsDiff, the permissions of the caller are not enforced, since it
+// is called on behalf of Argo CD itself.
+func (s *Server) PreviewRevisions(ctx context.Context, a *appv1.Application, baseRevision string, headRevision string) (*application.ApplicationRevisionsDiffResponse, error) {
+	return s.revisionsDiff(ctx, a, baseRevision, headRevision)
+}
+
+func (s *Server) revisionsDiff(ctx context.Context, a *appv1.Application, baseRevision string, headRevision string) (*application.ApplicationRevisionsDiffResponse, error) {
+	source := a.Spec.GetSource()
+	base, err := s.generateManifests(ctx, a, source, baseRevision, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests at base revision: %w", err)
+	}
+	head, err := s.generateManifests(ctx, a, source, headRevision, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests at head revision: %w", err)
+	}
+	baseObjs, err := manifestsByKey(base.Manifests)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifests at base revision: %w", err)
+	}
+	headObjs, err := manifestsByKey(head.Manifests)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifests at head revision: %w", err)
+	}
+
+	var keys []kube.ResourceKey
+	for key := range baseObjs {
+		keys = append(keys, key)
+	}
+	for key := range headObjs {
+		if _, ok := baseObjs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	var items []*application.ResourceRevisionsDiff
+	for _, key := range keys {
+		baseObj, headObj := baseObjs[key], headObjs[key]
+		if key.Kind == kube.SecretKind && key.Group == "" {
+			headObj, baseObj, err = diff.HideSecretData(headObj, baseObj)
 			if err != nil {
 				return nil, fmt.Errorf("error hiding secret data: %w", err)
 			}
-			data, err := json.Marshal(obj)
-			if err != nil {
-				return nil, fmt.Errorf("error marshaling manifest: %w", err)
-			}
-			manifestInfo.Manifests[i] = string(data)
 		}
+		baseState, err := marshalManifest(baseObj)
+		if err != nil {
+			return nil, err
+		}
+		headState, err := marshalManifest(headObj)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &application.ResourceRevisionsDiff{
+			Group:     pointer.String(key.Group),
+			Kind:      pointer.String(key.Kind),
+			Namespace: pointer.String(key.Namespace),
+			Name:      pointer.String(key.Name),
+			BaseState: pointer.String(baseState),
+			HeadState: pointer.String(headState),
+			Modified:  pointer.Bool(baseObj == nil || headObj == nil || !equality.Semantic.DeepEqual(baseObj.Object, headObj.Object)),
+		})
 	}
+	return &application.ApplicationRevisionsDiffResponse{
+		Items:        items,
+		BaseRevision: pointer.String(base.Revision),
+		HeadRevision: pointer.String(head.Revision),
+	}, nil
+}
 
-	return manifestInfo, nil
+// manifestsByKey parses the given manifests and indexes them by the keys of their resources
+func manifestsByKey(manifests []string) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	objs := map[kube.ResourceKey]*unstructured.Unstructured{}
+	for _, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+		}
+		objs[kube.GetResourceKey(obj)] = obj
+	}
+	return objs, nil
+}
+
+// marshalManifest returns the JSON manifest of the given resource, or an empty string if there is no resource
+func marshalManifest(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling manifest: %w", err)
+	}
+	return string(data), nil
 }
 
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
@@ -450,6 +699,10 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 		if err != nil {
 			return fmt.Errorf("error getting plugins: %w", err)
 		}
+		featureFlags, err := s.settingsMgr.GetFeatureFlags()
+		if err != nil {
+			return fmt.Errorf("error getting feature flags from settings: %w", err)
+		}
 		config, err := s.getApplicationClusterConfig(ctx, a)
 		if err != nil {
 			return fmt.Errorf("error getting application cluster config: %w", err)
@@ -482,6 +735,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			HelmOptions:        helmOptions,
 			TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
 			EnabledSourceTypes: enableGenerateManifests,
+			FeatureFlags:       featureFlags,
 		}
 
 		repoStreamClient, err := client.GenerateManifestWithFiles(stream.Context())
@@ -549,16 +803,13 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 		return nil, err
 	}
 
-	s.inferResourcesStatusHealth(a)
+	s.inferResourcesStatus(a)
 
 	if q.Refresh == nil {
 		return a, nil
 	}
 
-	refreshType := appv1.RefreshTypeNormal
-	if *q.Refresh == string(appv1.RefreshTypeHard) {
-		refreshType = appv1.RefreshTypeHard
-	}
+	refreshType := appv1.ParseRefreshType(*q.Refresh)
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
 
 	// subscribe early with buffered channel to ensure we don't miss events
@@ -574,32 +825,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 	}
 
 	if refreshType == appv1.RefreshTypeHard {
-		// force refresh cached application details
-		if err := s.queryRepoServer(ctx, a, func(
-			client apiclient.RepoServerServiceClient,
-			repo *appv1.Repository,
-			helmRepos []*appv1.Repository,
-			_ []*appv1.RepoCreds,
-			helmOptions *appv1.HelmOptions,
-			kustomizeOptions *appv1.KustomizeOptions,
-			enabledSourceTypes map[string]bool,
-		) error {
-			source := app.Spec.GetSource()
-			_, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-				Repo:               repo,
-				Source:             &source,
-				AppName:            appName,
-				KustomizeOptions:   kustomizeOptions,
-				Repos:              helmRepos,
-				NoCache:            true,
-				TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
-				EnabledSourceTypes: enabledSourceTypes,
-				HelmOptions:        helmOptions,
-			})
-			return err
-		}); err != nil {
-			log.Warnf("Failed to force refresh application details: %v", err)
-		}
+		s.refreshAppDetails(ctx, app)
 	}
 
 	minVersion := 0
@@ -625,6 +851,142 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 	}
 }
 
+// GetByID returns an application by its external ID
+func (s *Server) GetByID(ctx context.Context, q *application.ApplicationByIDQuery) (*appv1.Application, error) {
+	if !appv1.IsExternalIDOf(appv1.ExternalIDPrefixApplication, q.GetId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "'%s' is not the ID of an application", q.GetId())
+	}
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps: %w", err)
+	}
+	for _, a := range apps {
+		if a.ExternalID() == q.GetId() && s.isNamespaceEnabled(a.Namespace) {
+			return s.Get(ctx, &application.ApplicationQuery{Name: pointer.String(a.Name), AppNamespace: pointer.String(a.Namespace)})
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "application with ID '%s' not found", q.GetId())
+}
+
+// refreshAppDetails forces the refresh of the cached details of the given application
+func (s *Server) refreshAppDetails(ctx context.Context, app *appv1.Application) {
+	if err := s.queryRepoServer(ctx, app, func(
+		client apiclient.RepoServerServiceClient,
+		repo *appv1.Repository,
+		helmRepos []*appv1.Repository,
+		_ []*appv1.RepoCreds,
+		helmOptions *appv1.HelmOptions,
+		kustomizeOptions *appv1.KustomizeOptions,
+		enabledSourceTypes map[string]bool,
+	) error {
+		source := app.Spec.GetSource()
+		_, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
+			Repo:               repo,
+			Source:             &source,
+			AppName:            app.Name,
+			KustomizeOptions:   kustomizeOptions,
+			Repos:              helmRepos,
+			NoCache:            true,
+			TrackingMethod:     string(argoutil.GetTrackingMethod(s.settingsMgr)),
+			EnabledSourceTypes: enabledSourceTypes,
+			HelmOptions:        helmOptions,
+		})
+		return err
+	}); err != nil {
+		log.Warnf("Failed to force refresh application details: %v", err)
+	}
+}
+
+// Refresh requests a refresh of an application and streams its progress until the controller completes or drops it
+func (s *Server) Refresh(q *application.ApplicationRefreshRequest, ws application.ApplicationService_RefreshServer) error {
+	ctx := ws.Context()
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return err
+	}
+
+	refreshType := appv1.RefreshTypeNormal
+	if q.GetRefreshType() != "" {
+		refreshType = appv1.ParseRefreshType(q.GetRefreshType())
+		if string(refreshType) != q.GetRefreshType() {
+			return status.Errorf(codes.InvalidArgument, "unknown refresh type '%s'", q.GetRefreshType())
+		}
+	}
+	if q.GetDeadlineSeconds() < 0 {
+		return status.Errorf(codes.InvalidArgument, "the deadline of the refresh must not be negative")
+	}
+	var deadline *time.Time
+	if q.GetDeadlineSeconds() > 0 {
+		d := time.Now().Add(time.Duration(q.GetDeadlineSeconds()) * time.Second)
+		deadline = &d
+	}
+
+	// subscribe early with buffered channel to ensure we don't miss events
+	events := make(chan *appv1.ApplicationWatchEvent, watchAPIBufferSize)
+	unsubscribe := s.appBroadcaster.Subscribe(events, func(event *appv1.ApplicationWatchEvent) bool {
+		return event.Application.Name == appName && event.Application.Namespace == appNs
+	})
+	defer unsubscribe()
+
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	app, err := argoutil.RefreshAppWithDeadline(appIf, appName, refreshType, deadline)
+	if err != nil {
+		return fmt.Errorf("error refreshing the app: %w", err)
+	}
+	requestedType, _ := app.IsRefreshRequested()
+	message := fmt.Sprintf("%s refresh requested", requestedType)
+	if deadline != nil {
+		message = fmt.Sprintf("%s with deadline %s", message, deadline.UTC().Format(time.RFC3339))
+	}
+	if err := ws.Send(&application.ApplicationRefreshProgress{Phase: pointer.String(refreshPhaseRequested), Message: &message}); err != nil {
+		return fmt.Errorf("error sending refresh progress: %w", err)
+	}
+
+	if refreshType == appv1.RefreshTypeHard {
+		s.refreshAppDetails(ctx, app)
+	}
+
+	minVersion := 0
+	if minVersion, err = strconv.Atoi(app.ResourceVersion); err != nil {
+		minVersion = 0
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			appVersion, err := strconv.Atoi(event.Application.ResourceVersion)
+			if err != nil || appVersion <= minVersion {
+				continue
+			}
+			progress := &application.ApplicationRefreshProgress{Phase: pointer.String(refreshPhasePending)}
+			annotations := event.Application.GetAnnotations()
+			if reason, ok := annotations[appv1.AnnotationKeyRefreshDropped]; ok {
+				progress.Phase = pointer.String(refreshPhaseDropped)
+				progress.Message = pointer.String(reason)
+			} else if _, ok := annotations[appv1.AnnotationKeyRefresh]; !ok {
+				progress.Phase = pointer.String(refreshPhaseCompleted)
+			}
+			if progress.GetPhase() != refreshPhasePending {
+				s.inferResourcesStatus(&event.Application)
+				progress.Application = &event.Application
+			}
+			if err := ws.Send(progress); err != nil {
+				return fmt.Errorf("error sending refresh progress: %w", err)
+			}
+			if progress.GetPhase() != refreshPhasePending {
+				return nil
+			}
+		}
+	}
+}
+
 // ListResourceEvents returns a list of event resources
 func (s *Server) ListResourceEvents(ctx context.Context, q *application.ApplicationResourceEventsQuery) (*v1.EventList, error) {
 	appName := q.GetName()
@@ -694,6 +1056,151 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 	return list, nil
 }
 
+// ListAggregatedResourceEvents returns the deduplicated events of all the resources of an application. The events
+// are listed by involved resource UID, and at most maxAggregatedEvents of the most recent ones are returned.
+func (s *Server) ListAggregatedResourceEvents(ctx context.Context, q *application.ApplicationAggregatedEventsQuery) (*application.AggregatedEventsResponse, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appLister.Applications(appNs).Get(appName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	eventType := q.GetType()
+	if eventType != "" && eventType != v1.EventTypeNormal && eventType != v1.EventTypeWarning {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event type '%s': must be either %s or %s", eventType, v1.EventTypeNormal, v1.EventTypeWarning)
+	}
+
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	refs := map[string]appv1.ResourceRef{}
+	for _, n := range tree.Nodes {
+		if n.UID != "" {
+			refs[n.UID] = n.ResourceRef
+		}
+	}
+	if len(refs) == 0 {
+		return &application.AggregatedEventsResponse{}, nil
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating kube client: %w", err)
+	}
+	lists := make([][]v1.Event, len(refs))
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(aggregatedEventsConcurrency)
+	i := 0
+	for _, ref := range refs {
+		i, ref := i, ref
+		g.Go(func() error {
+			selector := map[string]string{"involvedObject.uid": ref.UID}
+			if eventType != "" {
+				selector["type"] = eventType
+			}
+			// the events of the cluster scoped resources are recorded in the default namespace
+			namespace := ref.Namespace
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			list, err := kubeClientset.CoreV1().Events(namespace).List(gCtx, metav1.ListOptions{
+				FieldSelector: fields.SelectorFromSet(selector).String(),
+				Limit:         maxEventsPerResource,
+			})
+			if err != nil {
+				return fmt.Errorf("error listing events of %s %s/%s: %w", ref.Kind, namespace, ref.Name, err)
+			}
+			lists[i] = list.Items
+			return nil
+		})
+		i++
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	var events []v1.Event
+	for _, list := range lists {
+		events = append(events, list...)
+	}
+	return &application.AggregatedEventsResponse{Items: aggregateEvents(events, refs, maxAggregatedEvents)}, nil
+}
+
+// aggregateEvents groups the events involving the given resources, indexed by UID, by involved resource, type, reason
+// and message, and returns at most limit groups sorted from the most recent to the oldest
+func aggregateEvents(events []v1.Event, refs map[string]appv1.ResourceRef, limit int) []*application.AggregatedEvent {
+	aggregated := map[string]*application.AggregatedEvent{}
+	for i := range events {
+		event := events[i]
+		ref, ok := refs[string(event.InvolvedObject.UID)]
+		if !ok {
+			continue
+		}
+		first, last := event.FirstTimestamp, event.LastTimestamp
+		if first.IsZero() && !event.EventTime.IsZero() {
+			first = metav1.NewTime(event.EventTime.Time)
+		}
+		if last.IsZero() {
+			last = first
+		}
+		count := event.Count
+		if event.Series != nil {
+			count = event.Series.Count
+			if !event.Series.LastObservedTime.IsZero() {
+				last = metav1.NewTime(event.Series.LastObservedTime.Time)
+			}
+		}
+		if count < 1 {
+			count = 1
+		}
+
+		key := strings.Join([]string{ref.UID, event.Type, event.Reason, event.Message}, "/")
+		existing, ok := aggregated[key]
+		if !ok {
+			ref := ref
+			aggregated[key] = &application.AggregatedEvent{
+				ResourceRef:    &ref,
+				Type:           pointer.String(event.Type),
+				Reason:         pointer.String(event.Reason),
+				Message:        pointer.String(event.Message),
+				Count:          pointer.Int32(count),
+				FirstTimestamp: &first,
+				LastTimestamp:  &last,
+			}
+			continue
+		}
+		existing.Count = pointer.Int32(existing.GetCount() + count)
+		if first.Before(existing.FirstTimestamp) {
+			existing.FirstTimestamp = &first
+		}
+		if existing.LastTimestamp.Before(&last) {
+			existing.LastTimestamp = &last
+		}
+	}
+
+	items := make([]*application.AggregatedEvent, 0, len(aggregated))
+	for _, item := range aggregated {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].LastTimestamp.Equal(items[j].LastTimestamp) {
+			return items[j].LastTimestamp.Before(items[i].LastTimestamp)
+		}
+		return items[i].GetMessage() < items[j].GetMessage()
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
+
 func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *appv1.Application, merge bool, validate bool) (*appv1.Application, error) {
 	s.projectLock.RLock(newApp.Spec.GetProject())
 	defer s.projectLock.RUnlock(newApp.Spec.GetProject())
@@ -788,7 +1295,7 @@ func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ct
 		if err != nil {
 			return nil, fmt.Errorf("error getting application: %w", err)
 		}
-		s.inferResourcesStatusHealth(app)
+		s.inferResourcesStatus(app)
 	}
 	return nil, status.Errorf(codes.Internal, "Failed to update application. Too many conflicts")
 }
@@ -799,8 +1306,15 @@ func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateReq
 		return nil, fmt.Errorf("error creating application: application is nil in request")
 	}
 	a := q.GetApplication()
+	a.Namespace = s.appNamespaceOrDefault(a.Namespace)
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, a.RBACName(s.ns)); err != nil {
-		return nil, err
+		currApp, getErr := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Get(ctx, a.Name, metav1.GetOptions{})
+		if getErr != nil {
+			return nil, err
+		}
+		if err := s.enforceParameterOverrides(ctx, currApp, a, err); err != nil {
+			return nil, err
+		}
 	}
 
 	validate := true
@@ -810,6 +1324,68 @@ func (s *Server) Update(ctx context.Context, q *application.ApplicationUpdateReq
 	return s.validateAndUpdateApp(ctx, q.Application, false, validate)
 }
 
+// enforceParameterOverrides is called when the user is not allowed to update an application, and lets the update
+// through if it only changes the parameter overrides of the application, and the user is allowed to update them.
+// Otherwise, the given error of the update enforcement is returned.
+func (s *Server) enforceParameterOverrides(ctx context.Context, currApp *appv1.Application, newApp *appv1.Application, updateErr error) error {
+	if !isParameterOverridesUpdate(currApp, newApp) {
+		return updateErr
+	}
+	if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdateParameters, currApp.RBACName(s.ns)) {
+		return updateErr
+	}
+	return nil
+}
+
+// isParameterOverridesUpdate returns true if the new application only differs from the current one by the parameter
+// overrides of its sources, i.e. Helm parameters and Kustomize images. The Helm values, Jsonnet variables and plugin
+// environment and parameters can change the generated manifests arbitrarily, so they require the update permission.
+func isParameterOverridesUpdate(currApp *appv1.Application, newApp *appv1.Application) bool {
+	if currApp.Name != newApp.Name || currApp.Namespace != newApp.Namespace ||
+		!equality.Semantic.DeepEqual(currApp.Labels, newApp.Labels) ||
+		!equality.Semantic.DeepEqual(currApp.Annotations, newApp.Annotations) ||
+		!equality.Semantic.DeepEqual(currApp.Finalizers, newApp.Finalizers) {
+		return false
+	}
+	// the specs are compared in JSON, so that empty and unset fields are considered equal
+	currSpec, err := json.Marshal(withoutParameterOverrides(currApp.Spec))
+	if err != nil {
+		return false
+	}
+	newSpec, err := json.Marshal(withoutParameterOverrides(newApp.Spec))
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(currSpec, newSpec)
+}
+
+// withoutParameterOverrides returns a copy of the spec without the parameter overrides of its sources
+func withoutParameterOverrides(spec appv1.ApplicationSpec) *appv1.ApplicationSpec {
+	res := spec.DeepCopy()
+	if res.Source != nil {
+		stripParameterOverrides(res.Source)
+	}
+	for i := range res.Sources {
+		stripParameterOverrides(&res.Sources[i])
+	}
+	return res
+}
+
+func stripParameterOverrides(source *appv1.ApplicationSource) {
+	if source.Helm != nil {
+		source.Helm.Parameters = nil
+		if source.Helm.IsZero() {
+			source.Helm = nil
+		}
+	}
+	if source.Kustomize != nil {
+		source.Kustomize.Images = nil
+		if source.Kustomize.IsZero() {
+			source.Kustomize = nil
+		}
+	}
+}
+
 // UpdateSpec updates an application spec and filters out any invalid parameter overrides
 func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdateSpecRequest) (*appv1.ApplicationSpec, error) {
 	if q.GetSpec() == nil {
@@ -821,11 +1397,15 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
+	newApp := a.DeepCopy()
+	newApp.Spec = *q.GetSpec()
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, a.RBACName(s.ns)); err != nil {
-		return nil, err
+		if err := s.enforceParameterOverrides(ctx, a, newApp, err); err != nil {
+			return nil, err
+		}
 	}
 
-	a.Spec = *q.GetSpec()
+	a = newApp
 	validate := true
 	if q.Validate != nil {
 		validate = *q.Validate
@@ -841,47 +1421,89 @@ func (s *Server) UpdateSpec(ctx context.Context, q *application.ApplicationUpdat
 func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchRequest) (*appv1.Application, error) {
 	appName := q.GetName()
 	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
-	app, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("error getting application: %w", err)
-	}
-
-	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, app.RBACName(s.ns)); err != nil {
-		return nil, err
-	}
+	// the patch is applied again to the latest version of the application if it is modified concurrently, unless the
+	// patch is only to be applied to a given version
+	for i := 0; i < 10; i++ {
+		app, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application: %w", err)
+		}
 
-	jsonApp, err := json.Marshal(app)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling application: %w", err)
-	}
+		updateErr := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, app.RBACName(s.ns))
+		if updateErr != nil && !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdateParameters, app.RBACName(s.ns)) {
+			return nil, updateErr
+		}
 
-	var patchApp []byte
+		if q.GetResourceVersion() != "" && q.GetResourceVersion() != app.ResourceVersion {
+			return nil, status.Errorf(codes.FailedPrecondition, "application '%s' has been modified: its resource version is %s instead of %s", appName, app.ResourceVersion, q.GetResourceVersion())
+		}
 
-	switch q.GetPatchType() {
-	case "json", "":
-		patch, err := jsonpatch.DecodePatch([]byte(q.GetPatch()))
+		jsonApp, err := json.Marshal(app)
 		if err != nil {
-			return nil, fmt.Errorf("error decoding json patch: %w", err)
+			return nil, fmt.Errorf("error marshaling application: %w", err)
 		}
-		patchApp, err = patch.Apply(jsonApp)
+		patchApp, err := argokube.ApplyPatch(jsonApp, q.GetPatch(), q.GetPatchType(), appv1.Application{})
 		if err != nil {
-			return nil, fmt.Errorf("error applying patch: %w", err)
+			var unsupportedErr *argokube.UnsupportedPatchTypeError
+			if errors.As(err, &unsupportedErr) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, err
 		}
-	case "merge":
-		patchApp, err = jsonpatch.MergePatch(jsonApp, []byte(q.GetPatch()))
+
+		newApp := &appv1.Application{}
+		err = json.Unmarshal(patchApp, newApp)
 		if err != nil {
-			return nil, fmt.Errorf("error calculating merge patch: %w", err)
+			return nil, fmt.Errorf("error unmarshaling patched app: %w", err)
+		}
+		// the application is identified by the request, and can't be renamed
+		newApp.Name = app.Name
+		newApp.Namespace = app.Namespace
+		if updateErr != nil {
+			if err := s.enforceParameterOverrides(ctx, app, newApp, updateErr); err != nil {
+				return nil, err
+			}
 		}
-	default:
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Patch type '%s' is not supported", q.GetPatchType()))
+
+		res, err := s.validateAndPatchApp(ctx, app, newApp, q.GetDryRun())
+		if apierr.IsConflict(err) {
+			if q.GetResourceVersion() != "" {
+				return nil, status.Errorf(codes.FailedPrecondition, "application '%s' has been modified: %v", appName, err)
+			}
+			continue
+		}
+		return res, err
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to patch application. Too many conflicts")
+}
+
+// validateAndPatchApp validates the patched version of an application, and updates the application with its spec and
+// metadata. Unlike updateApp, the update fails with a conflict if the application has been modified since it has been
+// read. The application is not updated in dry-run mode, in which case the validated patched application is returned.
+func (s *Server) validateAndPatchApp(ctx context.Context, app *appv1.Application, newApp *appv1.Application, dryRun bool) (*appv1.Application, error) {
+	s.projectLock.RLock(newApp.Spec.GetProject())
+	defer s.projectLock.RUnlock(newApp.Spec.GetProject())
+
+	if err := s.validateAndNormalizeApp(ctx, newApp, true); err != nil {
+		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
+	}
+
+	app = app.DeepCopy()
+	app.Spec = newApp.Spec
+	app.Labels = newApp.Labels
+	app.Annotations = newApp.Annotations
+	app.Finalizers = newApp.Finalizers
+	if dryRun {
+		return app, nil
 	}
 
-	newApp := &appv1.Application{}
-	err = json.Unmarshal(patchApp, newApp)
+	res, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(ctx, app, metav1.UpdateOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("error unmarshaling patched app: %w", err)
+		return nil, fmt.Errorf("error updating application: %w", err)
 	}
-	return s.validateAndUpdateApp(ctx, newApp, false, true)
+	s.logAppEvent(app, ctx, argo.EventReasonResourceUpdated, "updated application spec")
+	s.waitSync(res)
+	return res, nil
 }
 
 // Delete removes an application and all associated resources
@@ -990,7 +1612,7 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			// do not emit apps user does not have accessing
 			return
 		}
-		s.inferResourcesStatusHealth(&a)
+		s.inferResourcesStatus(&a)
 		err := ws.Send(&appv1.ApplicationWatchEvent{
 			Type:        eventType,
 			Application: a,
@@ -1067,6 +1689,10 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		return fmt.Errorf("error getting plugins: %w", err)
 	}
 
+	if err := argo.RenderDestinationNamespace(app); err != nil {
+		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
+	}
+
 	if err := argo.ValidateDestination(ctx, &app.Spec.Destination, s.db); err != nil {
 		return status.Errorf(codes.InvalidArgument, "application destination spec for %s is invalid: %s", app.Name, err.Error())
 	}
@@ -1092,10 +1718,37 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
 	}
 
+	if err := validateNotificationSubscriptions(app, currApp, proj); err != nil {
+		return err
+	}
+
+	if violations := proj.GetApplicationConstraintViolations(app); len(violations) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application %s violates the constraints of project %s: %s", app.Name, proj.Name, strings.Join(violations, "; "))
+	}
+
 	app.Spec = *argo.NormalizeApplicationSpec(&app.Spec)
 	return nil
 }
 
+// validateNotificationSubscriptions ensures that the notification subscriptions added to the annotations of the
+// application are permitted by its project. Subscriptions which already existed in the same project are left alone,
+// so that restricting the subscriptions of a project does not prevent updating its existing applications.
+func validateNotificationSubscriptions(app *appv1.Application, currApp *appv1.Application, proj *appv1.AppProject) error {
+	existing := make(map[services.Destination]bool)
+	if currApp != nil && currApp.Spec.GetProject() == app.Spec.GetProject() {
+		for _, dest := range settings_notif.GetSubscribedDestinations(currApp.Annotations) {
+			existing[dest] = true
+		}
+	}
+	for _, dest := range settings_notif.GetSubscribedDestinations(app.Annotations) {
+		if existing[dest] || proj.IsNotificationSubscriptionPermitted(dest.Service, dest.Recipient) {
+			continue
+		}
+		return status.Errorf(codes.PermissionDenied, "application %s is not permitted to subscribe to recipient '%s' of notification service '%s' in project %s", app.Name, dest.Recipient, dest.Service, proj.Name)
+	}
+	return nil
+}
+
 func (s *Server) getApplicationClusterConfig(ctx context.Context, a *appv1.Application) (*rest.Config, error) {
 	if err := argo.ValidateDestination(ctx, &a.Spec.Destination, s.db); err != nil {
 		return nil, fmt.Errorf("error validating destination: %w", err)
@@ -1301,6 +1954,56 @@ func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery
 	return s.getAppResources(ctx, a)
 }
 
+// StreamResourceTree sends the resource tree of an application in chunks of at most maxResourcesChunkSize bytes
+func (s *Server) StreamResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_StreamResourceTreeServer) error {
+	tree, err := s.ResourceTree(ws.Context(), q)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range splitApplicationTree(tree, maxResourcesChunkSize) {
+		if err := ws.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitApplicationTree splits the given tree into trees of at most maxSize bytes, unless a single node exceeds maxSize.
+// Merging the nodes, orphaned nodes and hosts of the returned trees in order gives back the given tree.
+func splitApplicationTree(tree *appv1.ApplicationTree, maxSize int) []*appv1.ApplicationTree {
+	var chunks []*appv1.ApplicationTree
+	chunk := &appv1.ApplicationTree{}
+	chunkSize := 0
+	add := func(size int, addTo func(chunk *appv1.ApplicationTree)) {
+		if chunkSize > 0 && chunkSize+size > maxSize {
+			chunks = append(chunks, chunk)
+			chunk = &appv1.ApplicationTree{}
+			chunkSize = 0
+		}
+		addTo(chunk)
+		chunkSize += size
+	}
+	for i := range tree.Nodes {
+		node := tree.Nodes[i]
+		add(node.Size(), func(chunk *appv1.ApplicationTree) {
+			chunk.Nodes = append(chunk.Nodes, node)
+		})
+	}
+	for i := range tree.OrphanedNodes {
+		node := tree.OrphanedNodes[i]
+		add(node.Size(), func(chunk *appv1.ApplicationTree) {
+			chunk.OrphanedNodes = append(chunk.OrphanedNodes, node)
+		})
+	}
+	for i := range tree.Hosts {
+		host := tree.Hosts[i]
+		add(host.Size(), func(chunk *appv1.ApplicationTree) {
+			chunk.Hosts = append(chunk.Hosts, host)
+		})
+	}
+	return append(chunks, chunk)
+}
+
 func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
 	appName := q.GetApplicationName()
 	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
@@ -1328,43 +2031,377 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
 	a, err := s.appLister.Applications(appNs).Get(appName)
 	if err != nil {
-		return nil, fmt.Errorf("error getting app by name: %w", err)
-	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, fmt.Errorf("error getting app by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	source := a.Spec.GetSource()
+	repo, err := s.db.GetRepository(ctx, source.RepoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	// We need to get some information with the project associated to the app,
+	// so we'll know whether GPG signatures are enforced.
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app project: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer ioutil.Close(conn)
+	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:           repo,
+		Revision:       q.GetRevision(),
+		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
+	})
+}
+
+func isMatchingResource(q *application.ResourcesQuery, key kube.ResourceKey) bool {
+	return (q.GetName() == "" || q.GetName() == key.Name) &&
+		(q.GetNamespace() == "" || q.GetNamespace() == key.Namespace) &&
+		(q.GetGroup() == "" || q.GetGroup() == key.Group) &&
+		(q.GetKind() == "" || q.GetKind() == key.Kind)
+}
+
+func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQuery) (*application.ManagedResourcesResponse, error) {
+	items, err := s.getManagedResources(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ManagedResourcesResponse{Items: items}, nil
+}
+
+// StreamManagedResources sends the managed resources of an application in chunks of at most maxResourcesChunkSize
+// bytes, unless a single resource exceeds it
+func (s *Server) StreamManagedResources(q *application.ResourcesQuery, ws application.ApplicationService_StreamManagedResourcesServer) error {
+	items, err := s.getManagedResources(ws.Context(), q)
+	if err != nil {
+		return err
+	}
+	chunk := &application.ManagedResourcesResponse{}
+	chunkSize := 0
+	for _, item := range items {
+		size := item.Size()
+		if chunkSize > 0 && chunkSize+size > maxResourcesChunkSize {
+			if err := ws.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &application.ManagedResourcesResponse{}
+			chunkSize = 0
+		}
+		chunk.Items = append(chunk.Items, item)
+		chunkSize += size
+	}
+	return ws.Send(chunk)
+}
+
+func (s *Server) getManagedResources(ctx context.Context, q *application.ResourcesQuery) ([]*appv1.ResourceDiff, error) {
+	appName := q.GetApplicationName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appLister.Applications(appNs).Get(appName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, fmt.Errorf("error verifying rbac: %w", err)
+	}
+
+	items := make([]*appv1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app state: %w", err)
+	}
+	var res []*appv1.ResourceDiff
+	for i := range items {
+		item := items[i]
+		if isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
+			res = append(res, item)
+		}
+	}
+
+	return res, nil
+}
+
+// ListResourceStatuses returns the sync and health status of the resources managed by the applications the user is
+// allowed to get, without the resources live and target states
+func (s *Server) ListResourceStatuses(ctx context.Context, q *application.ResourceStatusQuery) (*application.ResourceStatusSummaryList, error) {
+	appQuery := &application.ApplicationQuery{
+		Projects:     q.Projects,
+		Selector:     q.Selector,
+		AppNamespace: q.AppNamespace,
+	}
+	if q.GetApplicationName() != "" {
+		appQuery.Name = q.ApplicationName
+	}
+	apps, err := s.List(ctx, appQuery)
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	res := &application.ResourceStatusSummaryList{Items: make([]*application.ResourceStatusSummary, 0)}
+	for i := range apps.Items {
+		a := apps.Items[i]
+		for j := range a.Status.Resources {
+			item := a.Status.Resources[j]
+			healthStatus := ""
+			if item.Health != nil {
+				healthStatus = string(item.Health.Status)
+			}
+			if !isMatchingResourceStatus(q, item, healthStatus) {
+				continue
+			}
+			res.Items = append(res.Items, &application.ResourceStatusSummary{
+				Application:     pointer.String(a.Name),
+				AppNamespace:    pointer.String(a.Namespace),
+				Project:         pointer.String(a.Spec.GetProject()),
+				Group:           pointer.String(item.Group),
+				Version:         pointer.String(item.Version),
+				Kind:            pointer.String(item.Kind),
+				Namespace:       pointer.String(item.Namespace),
+				Name:            pointer.String(item.Name),
+				SyncStatus:      pointer.String(string(item.Status)),
+				HealthStatus:    pointer.String(healthStatus),
+				Hook:            pointer.Bool(item.Hook),
+				RequiresPruning: pointer.Bool(item.RequiresPruning),
+			})
+		}
+	}
+	return res, nil
+}
+
+func isMatchingResourceStatus(q *application.ResourceStatusQuery, res appv1.ResourceStatus, healthStatus string) bool {
+	return (q.GetGroup() == "" || q.GetGroup() == res.Group) &&
+		matchesAnyValue(q.GetKinds(), res.Kind) &&
+		matchesAnyValue(q.GetNamespaces(), res.Namespace) &&
+		matchesAnyValue(q.GetHealthStatuses(), healthStatus) &&
+		matchesAnyValue(q.GetSyncStatuses(), string(res.Status))
+}
+
+// ListDeprecatedAPIs returns the resources managed by applications which use APIs deprecated or removed in the
+// Kubernetes version of their destination cluster, or of the requested version, or in the next minor version
+func (s *Server) ListDeprecatedAPIs(ctx context.Context, q *application.DeprecatedAPIsQuery) (*application.DeprecatedAPIUsageList, error) {
+	apps, err := s.List(ctx, &application.ApplicationQuery{
+		Projects:     q.Projects,
+		Selector:     q.Selector,
+		AppNamespace: q.AppNamespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	// the versions of the destination clusters by server, looked up once per server
+	kubeVersions := map[string]string{}
+	res := &application.DeprecatedAPIUsageList{Items: make([]*application.DeprecatedAPIUsage, 0)}
+	for i := range apps.Items {
+		a := apps.Items[i]
+		if err := argo.ValidateDestination(ctx, &a.Spec.Destination, s.db); err != nil {
+			log.Warnf("Unable to resolve the destination of application '%s': %v", a.QualifiedName(), err)
+			continue
+		}
+		server := a.Spec.Destination.Server
+		if q.GetServer() != "" && q.GetServer() != server {
+			continue
+		}
+		kubeVersion := q.GetKubeVersion()
+		if kubeVersion == "" {
+			var ok bool
+			if kubeVersion, ok = kubeVersions[server]; !ok {
+				var info appv1.ClusterInfo
+				_ = s.cache.GetClusterInfo(server, &info)
+				kubeVersion = info.ServerVersion
+				kubeVersions[server] = kubeVersion
+			}
+		}
+		for _, item := range a.Status.Resources {
+			deprecation := argokube.GetAPIDeprecation(schema.GroupVersionKind{Group: item.Group, Version: item.Version, Kind: item.Kind}, kubeVersion)
+			if deprecation == nil {
+				continue
+			}
+			res.Items = append(res.Items, &application.DeprecatedAPIUsage{
+				Application:  pointer.String(a.Name),
+				AppNamespace: pointer.String(a.Namespace),
+				Project:      pointer.String(a.Spec.GetProject()),
+				Server:       pointer.String(server),
+				Group:        pointer.String(item.Group),
+				Version:      pointer.String(item.Version),
+				Kind:         pointer.String(item.Kind),
+				Namespace:    pointer.String(item.Namespace),
+				Name:         pointer.String(item.Name),
+				KubeVersion:  pointer.String(kubeVersion),
+				DeprecatedIn: pointer.String(deprecation.DeprecatedIn),
+				RemovedIn:    pointer.String(deprecation.RemovedIn),
+				Replacement:  pointer.String(deprecation.Replacement),
+				Removed:      pointer.Bool(deprecation.Removed),
+				Message:      pointer.String(deprecation.Message()),
+			})
+		}
+	}
+	return res, nil
+}
+
+// matchesAnyValue returns true if the value is one of the given values, or if no values are given
+func matchesAnyValue(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// listGroupApplications returns the applications the user is allowed to get, along with their value of the given label
+// or annotation. Applications without the label or annotation are returned with the ok flag set to false.
+func (s *Server) listGroupApplications(ctx context.Context, labelKey string, annotationKey string, q *application.ApplicationQuery) ([]appv1.Application, func(a *appv1.Application) (string, bool), error) {
+	if (labelKey == "") == (annotationKey == "") {
+		return nil, nil, status.Error(codes.InvalidArgument, "exactly one of labelKey and annotationKey must be set")
+	}
+	apps, err := s.List(ctx, q)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	groupValue := func(a *appv1.Application) (string, bool) {
+		if labelKey != "" {
+			value, ok := a.Labels[labelKey]
+			return value, ok
+		}
+		value, ok := a.Annotations[annotationKey]
+		return value, ok
+	}
+	return apps.Items, groupValue, nil
+}
+
+// ListGroups returns the rollups of the applications grouped by the value of a label or an annotation
+func (s *Server) ListGroups(ctx context.Context, q *application.ApplicationGroupsQuery) (*application.ApplicationGroupList, error) {
+	apps, groupValue, err := s.listGroupApplications(ctx, q.GetLabelKey(), q.GetAnnotationKey(), &application.ApplicationQuery{
+		Projects:     q.Projects,
+		Selector:     q.Selector,
+		AppNamespace: q.AppNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &application.ApplicationGroupList{Items: make([]*application.ApplicationGroup, 0)}
+	var ungrouped int64
+	groups := make(map[string]*application.ApplicationGroup)
+	for i := range apps {
+		a := apps[i]
+		value, ok := groupValue(&a)
+		if !ok {
+			ungrouped++
+			continue
+		}
+		group, ok := groups[value]
+		if !ok {
+			group = &application.ApplicationGroup{
+				Value:          pointer.String(value),
+				Total:          pointer.Int64(0),
+				HealthStatuses: map[string]int64{},
+				SyncStatuses:   map[string]int64{},
+				HealthStatus:   pointer.String(string(health.HealthStatusHealthy)),
+				SyncStatus:     pointer.String(string(appv1.SyncStatusCodeSynced)),
+			}
+			groups[value] = group
+			res.Items = append(res.Items, group)
+		}
+		*group.Total++
+		healthStatus := a.Status.Health.Status
+		if healthStatus == "" {
+			healthStatus = health.HealthStatusUnknown
+		}
+		group.HealthStatuses[string(healthStatus)]++
+		if health.IsWorse(health.HealthStatusCode(group.GetHealthStatus()), healthStatus) {
+			group.HealthStatus = pointer.String(string(healthStatus))
+		}
+		syncStatus := a.Status.Sync.Status
+		if syncStatus == "" {
+			syncStatus = appv1.SyncStatusCodeUnknown
+		}
+		group.SyncStatuses[string(syncStatus)]++
+		if syncStatus == appv1.SyncStatusCodeOutOfSync || (syncStatus == appv1.SyncStatusCodeUnknown && group.GetSyncStatus() == string(appv1.SyncStatusCodeSynced)) {
+			group.SyncStatus = pointer.String(string(syncStatus))
+		}
+		group.Applications = append(group.Applications, a.QualifiedName())
+	}
+	sort.Slice(res.Items, func(i, j int) bool {
+		return res.Items[i].GetValue() < res.Items[j].GetValue()
+	})
+	res.Ungrouped = pointer.Int64(ungrouped)
+	return res, nil
+}
+
+// SyncGroup syncs all the applications of a group. The applications which cannot be synced are reported in the
+// response rather than failing the whole request.
+func (s *Server) SyncGroup(ctx context.Context, q *application.ApplicationGroupSyncRequest) (*application.ApplicationGroupActionResponse, error) {
+	apps, groupValue, err := s.listGroupApplications(ctx, q.GetLabelKey(), q.GetAnnotationKey(), &application.ApplicationQuery{
+		Projects:     q.Projects,
+		Selector:     q.Selector,
+		AppNamespace: q.AppNamespace,
+	})
+	if err != nil {
 		return nil, err
 	}
+	res := &application.ApplicationGroupActionResponse{Results: make([]*application.ApplicationGroupActionResult, 0)}
+	for i := range apps {
+		a := apps[i]
+		if value, ok := groupValue(&a); !ok || value != q.GetValue() {
+			continue
+		}
+		_, err := s.Sync(ctx, &application.ApplicationSyncRequest{
+			Name:         pointer.String(a.Name),
+			AppNamespace: pointer.String(a.Namespace),
+			DryRun:       q.DryRun,
+			Prune:        q.Prune,
+		})
+		res.Results = append(res.Results, newGroupActionResult(&a, err))
+	}
+	return res, nil
+}
 
-	source := a.Spec.GetSource()
-	repo, err := s.db.GetRepository(ctx, source.RepoURL)
-	if err != nil {
-		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+// RefreshGroup refreshes all the applications of a group without waiting for the refreshes to complete
+func (s *Server) RefreshGroup(ctx context.Context, q *application.ApplicationGroupRefreshRequest) (*application.ApplicationGroupActionResponse, error) {
+	refreshType := appv1.RefreshTypeNormal
+	if q.GetRefresh() == string(appv1.RefreshTypeHard) {
+		refreshType = appv1.RefreshTypeHard
 	}
-	// We need to get some information with the project associated to the app,
-	// so we'll know whether GPG signatures are enforced.
-	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	apps, groupValue, err := s.listGroupApplications(ctx, q.GetLabelKey(), q.GetAnnotationKey(), &application.ApplicationQuery{
+		Projects:     q.Projects,
+		Selector:     q.Selector,
+		AppNamespace: q.AppNamespace,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error getting app project: %w", err)
+		return nil, err
 	}
-	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
-	if err != nil {
-		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	res := &application.ApplicationGroupActionResponse{Results: make([]*application.ApplicationGroupActionResult, 0)}
+	for i := range apps {
+		a := apps[i]
+		if value, ok := groupValue(&a); !ok || value != q.GetValue() {
+			continue
+		}
+		_, err := argoutil.RefreshApp(s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace), a.Name, refreshType)
+		res.Results = append(res.Results, newGroupActionResult(&a, err))
 	}
-	defer ioutil.Close(conn)
-	return repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
-		Repo:           repo,
-		Revision:       q.GetRevision(),
-		CheckSignature: len(proj.Spec.SignatureKeys) > 0,
-	})
+	return res, nil
 }
 
-func isMatchingResource(q *application.ResourcesQuery, key kube.ResourceKey) bool {
-	return (q.GetName() == "" || q.GetName() == key.Name) &&
-		(q.GetNamespace() == "" || q.GetNamespace() == key.Namespace) &&
-		(q.GetGroup() == "" || q.GetGroup() == key.Group) &&
-		(q.GetKind() == "" || q.GetKind() == key.Kind)
+func newGroupActionResult(a *appv1.Application, err error) *application.ApplicationGroupActionResult {
+	result := &application.ApplicationGroupActionResult{
+		Name:         pointer.String(a.Name),
+		AppNamespace: pointer.String(a.Namespace),
+	}
+	if err != nil {
+		result.Error = pointer.String(status.Convert(err).Message())
+	}
+	return result
 }
 
-func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQuery) (*application.ManagedResourcesResponse, error) {
+func (s *Server) DriftHistory(ctx context.Context, q *application.ResourcesQuery) (*application.DriftHistoryResponse, error) {
 	appName := q.GetApplicationName()
 	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
 	a, err := s.appLister.Applications(appNs).Get(appName)
@@ -1375,21 +2412,26 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 		return nil, fmt.Errorf("error verifying rbac: %w", err)
 	}
 
-	items := make([]*appv1.ResourceDiff, 0)
-	err = s.getCachedAppState(ctx, a, func() error {
-		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error getting cached app state: %w", err)
+	history := make([]appv1.DriftRecord, 0)
+	err = s.cache.GetAppDriftHistory(a.InstanceName(s.ns), &history)
+	if err != nil && err != servercache.ErrCacheMiss {
+		return nil, fmt.Errorf("error getting drift history: %w", err)
 	}
-	res := &application.ManagedResourcesResponse{}
-	for i := range items {
-		item := items[i]
-		if isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
-			res.Items = append(res.Items, item)
+	res := &application.DriftHistoryResponse{}
+	for i := range history {
+		record := history[i]
+		resources := make([]appv1.DriftedResource, 0, len(record.Resources))
+		for _, item := range record.Resources {
+			if isMatchingResource(q, kube.ResourceKey{Name: item.Name, Namespace: item.Namespace, Kind: item.Kind, Group: item.Group}) {
+				resources = append(resources, item)
+			}
+		}
+		if len(resources) == 0 && len(record.Resources) > 0 {
+			continue
 		}
+		record.Resources = resources
+		res.Items = append(res.Items, &record)
 	}
-
 	return res, nil
 }
 
@@ -1641,7 +2683,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return a, fmt.Errorf("error getting app project: %w", err)
 	}
 
-	s.inferResourcesStatusHealth(a)
+	s.inferResourcesStatus(a)
 
 	if !proj.Spec.SyncWindows.Matches(a).CanSync(true) {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: blocked by sync window")
@@ -1710,12 +2752,15 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 			Resources:    resources,
 			Manifests:    syncReq.Manifests,
 		},
-		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
+		InitiatedBy: operationInitiator(ctx),
 		Info:        syncReq.Infos,
 	}
 	if retry != nil {
 		op.Retry = *retry
 	}
+	if !op.DryRun() && proj.IsSyncApprovalRequired(a.Spec.Destination) {
+		op.Approval = &appv1.OperationApproval{}
+	}
 
 	a, err = argo.SetAppOperation(appIf, appName, &op)
 	if err != nil {
@@ -1729,6 +2774,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if syncReq.Manifests != nil {
 		reason = fmt.Sprintf("initiated %ssync locally", partial)
 	}
+	if op.IsPendingApproval() {
+		reason += ", pending approval"
+	}
 	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
@@ -1745,7 +2793,7 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		return nil, err
 	}
 
-	s.inferResourcesStatusHealth(a)
+	s.inferResourcesStatus(a)
 
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
@@ -1785,13 +2833,24 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
 			Source:       &deploymentInfo.Source,
 		},
-		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
+		InitiatedBy: operationInitiator(ctx),
+	}
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app project: %w", err)
+	}
+	if !op.DryRun() && proj.IsSyncApprovalRequired(a.Spec.Destination) {
+		op.Approval = &appv1.OperationApproval{}
 	}
 	a, err = argo.SetAppOperation(appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
-	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId()))
+	reason := fmt.Sprintf("initiated rollback to %d", rollbackReq.GetId())
+	if op.IsPendingApproval() {
+		reason += ", pending approval"
+	}
+	s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, reason)
 	return a, nil
 }
 
@@ -1826,7 +2885,9 @@ func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksReq
 		return nil, fmt.Errorf("failed to read application deep links from configmap: %w", err)
 	}
 
-	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(*obj, deepLinks)
+	proj, cluster := s.getDeepLinksProjectAndCluster(ctx, a)
+	linksObj := deeplinks.CreateDeepLinksObject(obj, nil, obj, proj, cluster)
+	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(linksObj, deepLinks)
 	if len(errorList) > 0 {
 		log.Errorf("errorList while evaluating application deep links, %v", strings.Join(errorList, ", "))
 	}
@@ -1835,7 +2896,7 @@ func (s *Server) ListLinks(ctx context.Context, req *application.ListAppLinksReq
 }
 
 func (s *Server) ListResourceLinks(ctx context.Context, req *application.ApplicationResourceRequest) (*application.LinksResponse, error) {
-	obj, _, _, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbacpolicy.ActionGet, req)
+	obj, _, app, _, err := s.getUnstructuredLiveResourceOrApp(ctx, rbacpolicy.ActionGet, req)
 	if err != nil {
 		return nil, err
 	}
@@ -1850,7 +2911,13 @@ func (s *Server) ListResourceLinks(ctx context.Context, req *application.Applica
 		return nil, fmt.Errorf("error replacing secret values: %w", err)
 	}
 
-	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(*obj, deepLinks)
+	appObj, err := kube.ToUnstructured(app)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	proj, cluster := s.getDeepLinksProjectAndCluster(ctx, app)
+	linksObj := deeplinks.CreateDeepLinksObject(obj, obj, appObj, proj, cluster)
+	finalList, errorList := deeplinks.EvaluateDeepLinksResponse(linksObj, deepLinks)
 	if len(errorList) > 0 {
 		log.Errorf("errors while evaluating resource deep links, %v", strings.Join(errorList, ", "))
 	}
@@ -1858,6 +2925,31 @@ func (s *Server) ListResourceLinks(ctx context.Context, req *application.Applica
 	return finalList, nil
 }
 
+// getDeepLinksProjectAndCluster returns the project and the destination cluster of the application for the evaluation
+// of deep links. Either is omitted from the links if it cannot be found.
+func (s *Server) getDeepLinksProjectAndCluster(ctx context.Context, a *appv1.Application) (*unstructured.Unstructured, *appv1.Cluster) {
+	logCtx := log.WithField("application", a.QualifiedName())
+	var projObj *unstructured.Unstructured
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err == nil {
+		projObj, err = kube.ToUnstructured(proj)
+	}
+	if err != nil {
+		logCtx.Warnf("failed to get the project for deep links: %v", err)
+	}
+	destination := a.Spec.Destination
+	if err := argo.ValidateDestination(ctx, &destination, s.db); err != nil {
+		logCtx.Warnf("failed to get the cluster for deep links: %v", err)
+		return projObj, nil
+	}
+	cluster, err := s.db.GetCluster(ctx, destination.Server)
+	if err != nil {
+		logCtx.Warnf("failed to get the cluster for deep links: %v", err)
+		return projObj, nil
+	}
+	return projObj, cluster
+}
+
 // resolveRevision resolves the revision specified either in the sync request, or the
 // application source, into a concrete revision that will be used for a sync operation.
 func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, syncReq *application.ApplicationSyncRequest) (string, string, error) {
@@ -1909,14 +3001,33 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	}
 
 	for i := 0; i < 10; i++ {
-		if a.Operation == nil || a.Status.OperationState == nil {
-			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
+		pendingApproval := false
+		if termOpReq.GetForce() {
+			if a.Operation == nil && (a.Status.OperationState == nil || a.Status.OperationState.Phase.Completed()) {
+				return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
+			}
+			a.Operation = nil
+			a.Status.OperationState = nil
+		} else if a.Operation != nil && a.Operation.IsPendingApproval() {
+			// the operation was not started by the controller yet
+			a.Operation = nil
+			pendingApproval = true
+		} else {
+			if a.Operation == nil || a.Status.OperationState == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
+			}
+			a.Status.OperationState.Phase = common.OperationTerminating
 		}
-		a.Status.OperationState.Phase = common.OperationTerminating
 		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
 		if err == nil {
 			s.waitSync(updated)
-			s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, "terminated running operation")
+			if termOpReq.GetForce() {
+				s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, "forcibly cleared running operation")
+			} else if pendingApproval {
+				s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, "rejected operation pending approval")
+			} else {
+				s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, "terminated running operation")
+			}
 			return &application.OperationTerminateResponse{}, nil
 		}
 		if !apierr.IsConflict(err) {
@@ -1932,6 +3043,261 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *application.
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
 }
 
+// ApproveOperation approves the operation pending approval, which must have been initiated by a different subject
+func (s *Server) ApproveOperation(ctx context.Context, q *application.OperationApproveRequest) (*appv1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	// the permission is checked against the cached application before the application is read from the API server,
+	// so that callers without the permission cannot learn anything from the error
+	cached, err := s.appLister.Applications(appNs).Get(appName)
+	if apierr.IsNotFound(err) {
+		cached = &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: appName, Namespace: appNs}}
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, cached.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
+	a, err := appIf.Get(ctx, appName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+	if a.Spec.Project != cached.Spec.Project {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, a.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+
+	username := session.Username(ctx)
+	for i := 0; i < 10; i++ {
+		if a.Operation == nil || !a.Operation.IsPendingApproval() {
+			return nil, status.Errorf(codes.FailedPrecondition, "Unable to approve operation. No operation is pending approval")
+		}
+		if username == "" || username == a.Operation.InitiatedBy.Username {
+			return nil, status.Errorf(codes.PermissionDenied, "operation must be approved by a different subject than the one who initiated it")
+		}
+		now := metav1.Now()
+		a.Operation.Approval = &appv1.OperationApproval{ApprovedBy: username, ApprovedAt: &now}
+		updated, err := appIf.Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			s.waitSync(updated)
+			s.logAppEvent(updated, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("approved operation initiated by %s", a.Operation.InitiatedBy.Username))
+			return updated, nil
+		}
+		if !apierr.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to approve operation for app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = appIf.Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to approve operation. Too many conflicts")
+}
+
+// ExtendTTL postpones the expiry of an application with a TTL
+func (s *Server) ExtendTTL(ctx context.Context, q *application.ApplicationExtendTTLRequest) (*appv1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	duration, err := time.ParseDuration(q.GetDuration())
+	if err != nil || duration <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration '%s': the duration must be positive, e.g. 24h", q.GetDuration())
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	expiresAt, err := a.ExpiresAt()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid TTL: %v", err)
+	}
+	if expiresAt == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application '%s' has no TTL", appName)
+	}
+	extendFrom := time.Now().UTC()
+	if expiresAt.After(extendFrom) {
+		extendFrom = *expiresAt
+	}
+	extendedTo := extendFrom.Add(duration).UTC().Format(time.RFC3339)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{appv1.AnnotationKeyExpiresAt: extendedTo},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling patch: %w", err)
+	}
+	updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Patch(ctx, appName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching application: %w", err)
+	}
+	s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("extended TTL until %s", extendedTo))
+	return updated, nil
+}
+
+// GetDeletionProgress returns the resources which remain while an application is deleted, and why
+func (s *Server) GetDeletionProgress(ctx context.Context, q *application.ApplicationDeletionProgressQuery) (*application.ApplicationDeletionProgress, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	progress := &application.ApplicationDeletionProgress{
+		Deleting:   pointer.Bool(a.DeletionTimestamp != nil),
+		Cascade:    pointer.Bool(a.CascadedDeletion()),
+		Finalizers: a.Finalizers,
+	}
+	if a.CascadedDeletion() {
+		propagationPolicy := appv1.DeletionPropagationPolicyForeground
+		if a.GetDeletionPropagationPolicy() == metav1.DeletePropagationBackground {
+			propagationPolicy = appv1.DeletionPropagationPolicyBackground
+		}
+		progress.PropagationPolicy = &propagationPolicy
+	}
+	for _, condition := range a.Status.Conditions {
+		if condition.Type == appv1.ApplicationConditionDeletionError {
+			progress.Errors = append(progress.Errors, condition.Message)
+		}
+	}
+	s.inferOffloadedResources(a)
+	if len(a.Status.Resources) == 0 {
+		return progress, nil
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	resources := make([]*application.RemainingResource, len(a.Status.Resources))
+	err = kube.RunAllAsync(len(a.Status.Resources), func(i int) error {
+		res := a.Status.Resources[i]
+		if res.Group == appv1.ApplicationSchemaGroupVersionKind.Group && res.Kind == appv1.ApplicationSchemaGroupVersionKind.Kind && res.Name == a.Name && res.Namespace == a.Namespace {
+			return nil
+		}
+		obj, err := s.kubectl.GetResource(ctx, config, res.GroupVersionKind(), res.Name, res.Namespace)
+		if apierr.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error getting %s %s: %w", res.Kind, res.Name, err)
+		}
+		// the custom resource definitions are never deleted along with the application
+		if kube.IsCRD(obj) {
+			return nil
+		}
+		resources[i] = newRemainingResource(res, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range resources {
+		if res != nil {
+			progress.Resources = append(progress.Resources, res)
+		}
+	}
+	return progress, nil
+}
+
+func newRemainingResource(res appv1.ResourceStatus, obj *unstructured.Unstructured) *application.RemainingResource {
+	remaining := &application.RemainingResource{
+		Group:      pointer.String(res.Group),
+		Version:    pointer.String(res.Version),
+		Kind:       pointer.String(res.Kind),
+		Namespace:  pointer.String(res.Namespace),
+		Name:       pointer.String(res.Name),
+		Deleting:   pointer.Bool(obj.GetDeletionTimestamp() != nil),
+		Finalizers: obj.GetFinalizers(),
+	}
+	var message string
+	switch {
+	case obj.GetDeletionTimestamp() == nil:
+		message = "Deletion not requested yet"
+	case len(obj.GetFinalizers()) > 0:
+		message = fmt.Sprintf("Waiting for finalizers: %s", strings.Join(obj.GetFinalizers(), ", "))
+	default:
+		message = "Being deleted"
+	}
+	remaining.Message = &message
+	return remaining
+}
+
+// ForceDetach removes the Argo CD finalizers of an application being deleted, so that its deletion completes without
+// waiting for its resources, which are left in the cluster
+func (s *Server) ForceDetach(ctx context.Context, q *application.ApplicationForceDetachRequest) (*application.ApplicationResponse, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+
+	s.projectLock.RLock(a.Spec.Project)
+	defer s.projectLock.RUnlock(a.Spec.Project)
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	if a.DeletionTimestamp == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application '%s' is not being deleted", appName)
+	}
+	if !a.CascadedDeletion() {
+		return nil, status.Errorf(codes.FailedPrecondition, "application '%s' has no Argo CD finalizer", appName)
+	}
+
+	a.UnSetCascadedDeletion()
+	// the resource version makes the patch fail if the finalizers were changed in the meantime
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      a.Finalizers,
+			"resourceVersion": a.ResourceVersion,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling finalizers: %w", err)
+	}
+	_, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Patch(ctx, appName, types.MergePatchType, patch, metav1.PatchOptions{})
+	if apierr.IsConflict(err) {
+		return nil, status.Errorf(codes.Aborted, "application '%s' was updated concurrently, retry", appName)
+	} else if err != nil {
+		return nil, fmt.Errorf("error patching application finalizers: %w", err)
+	}
+	s.logAppEvent(a, ctx, argo.EventReasonResourceDeleted, "force detached the resources of the application")
+	return &application.ApplicationResponse{}, nil
+}
+
+// operationInitiator returns the initiator of an operation started by the given request: the user, identified by their
+// username or by the subject of their token if it has no username, and the client they used
+func operationInitiator(ctx context.Context) appv1.OperationInitiator {
+	initiator := appv1.OperationInitiator{
+		Username:  session.Username(ctx),
+		Subject:   session.Sub(ctx),
+		UserAgent: grpc_util.UserAgent(ctx),
+	}
+	if initiator.Username == "" {
+		initiator.Username = initiator.Subject
+	}
+	switch {
+	case strings.HasPrefix(initiator.UserAgent, argocommon.ArgoCDUserAgentName+"/"):
+		initiator.Client = appv1.OperationInitiatorClientCLI
+	case strings.HasPrefix(initiator.UserAgent, "Mozilla/"):
+		initiator.Client = appv1.OperationInitiatorClientUI
+	default:
+		initiator.Client = appv1.OperationInitiatorClientAPI
+	}
+	return initiator
+}
+
 func (s *Server) logAppEvent(a *appv1.Application, ctx context.Context, reason string, action string) {
 	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
 	user := session.Username(ctx)
@@ -1974,6 +3340,116 @@ func (s *Server) ListResourceActions(ctx context.Context, q *application.Applica
 	return &application.ResourceActionsListResponse{Actions: actionsPtr}, nil
 }
 
+// ListRolloutAnalysisRuns returns the analysis runs of an Argo Rollouts Rollout managed by the application, most recent first
+func (s *Server) ListRolloutAnalysisRuns(ctx context.Context, q *application.RolloutAnalysisRunsQuery) (*application.RolloutAnalysisRunsResponse, error) {
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appLister.Applications(appNs).Get(q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting app by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	rolloutNamespace := q.GetRolloutNamespace()
+	if rolloutNamespace == "" {
+		rolloutNamespace = a.Spec.Destination.Namespace
+	}
+	rollout := tree.FindNode(rolloutGroup, rolloutKind, rolloutNamespace, q.GetRolloutName())
+	if rollout == nil || rollout.ResourceRef.UID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s not found as part of application %s", rolloutKind, q.GetRolloutName(), q.GetName())
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	items := []*application.RolloutAnalysisRun{}
+	for i := range tree.Nodes {
+		node := tree.Nodes[i]
+		if node.Group != rolloutGroup || node.Kind != analysisRunKind || !isOwnedBy(node, rollout.ResourceRef) {
+			continue
+		}
+		obj, err := s.kubectl.GetResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("error getting analysis run %s: %w", node.Name, err)
+		}
+		items = append(items, toRolloutAnalysisRun(obj))
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[j].CreatedAt.Before(items[i].CreatedAt)
+	})
+	return &application.RolloutAnalysisRunsResponse{Items: items}, nil
+}
+
+func isOwnedBy(node appv1.ResourceNode, owner appv1.ResourceRef) bool {
+	for _, parent := range node.ParentRefs {
+		if parent.UID == owner.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// toRolloutAnalysisRun extracts the details of an Argo Rollouts AnalysisRun
+func toRolloutAnalysisRun(obj *unstructured.Unstructured) *application.RolloutAnalysisRun {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+	createdAt := obj.GetCreationTimestamp()
+	run := &application.RolloutAnalysisRun{
+		Name:      pointer.String(obj.GetName()),
+		Namespace: pointer.String(obj.GetNamespace()),
+		Phase:     pointer.String(phase),
+		Message:   pointer.String(message),
+		Revision:  pointer.String(obj.GetAnnotations()[rolloutRevisionAnnotation]),
+		CreatedAt: &createdAt,
+	}
+	metricResults, _, _ := unstructured.NestedSlice(obj.Object, "status", "metricResults")
+	for _, item := range metricResults {
+		result, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(result, "name")
+		phase, _, _ := unstructured.NestedString(result, "phase")
+		message, _, _ := unstructured.NestedString(result, "message")
+		metric := &application.RolloutAnalysisRunMetric{
+			Name:         pointer.String(name),
+			Phase:        pointer.String(phase),
+			Message:      pointer.String(message),
+			Count:        pointer.Int32(nestedInt32(result, "count")),
+			Successful:   pointer.Int32(nestedInt32(result, "successful")),
+			Failed:       pointer.Int32(nestedInt32(result, "failed")),
+			Inconclusive: pointer.Int32(nestedInt32(result, "inconclusive")),
+			Error:        pointer.Int32(nestedInt32(result, "error")),
+		}
+		measurements, _, _ := unstructured.NestedSlice(result, "measurements")
+		if len(measurements) > 0 {
+			if last, ok := measurements[len(measurements)-1].(map[string]interface{}); ok {
+				value, _, _ := unstructured.NestedString(last, "value")
+				metric.LastValue = pointer.String(value)
+			}
+		}
+		run.Metrics = append(run.Metrics, metric)
+	}
+	return run
+}
+
+func nestedInt32(obj map[string]interface{}, fields ...string) int32 {
+	val, _, _ := unstructured.NestedFieldNoCopy(obj, fields...)
+	switch v := val.(type) {
+	case int64:
+		return int32(v)
+	case float64:
+		return int32(v)
+	}
+	return 0
+}
+
 func (s *Server) getUnstructuredLiveResourceOrApp(ctx context.Context, rbacRequest string, q *application.ApplicationResourceRequest) (obj *unstructured.Unstructured, res *appv1.ResourceNode, app *appv1.Application, config *rest.Config, err error) {
 	if q.GetKind() == "Application" && q.GetGroup() == "argoproj.io" && q.GetName() == q.GetResourceName() {
 		namespace := s.appNamespaceOrDefault(q.GetAppNamespace())
@@ -2191,7 +3667,10 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	return res, nil
 }
 
-func (s *Server) inferResourcesStatusHealth(app *appv1.Application) {
+// inferResourcesStatus populates the list of resources of the application if it is stored in the cache, and the health
+// of the resources if it is stored in the resources tree
+func (s *Server) inferResourcesStatus(app *appv1.Application) {
+	s.inferOffloadedResources(app)
 	if app.Status.ResourceHealthSource == appv1.ResourceHealthLocationAppTree {
 		tree := &appv1.ApplicationTree{}
 		if err := s.cache.GetAppResourcesTree(app.Name, tree); err == nil {
@@ -2207,6 +3686,20 @@ func (s *Server) inferResourcesStatusHealth(app *appv1.Application) {
 	}
 }
 
+// inferOffloadedResources populates the list of resources of the application if the controller moved it from the
+// status to the cache because the application exceeded the maximum status size
+func (s *Server) inferOffloadedResources(app *appv1.Application) {
+	if app.Status.ResourcesSource != appv1.ResourceStatusLocationCache {
+		return
+	}
+	resources, err := s.cache.GetAppStatusResources(app, s.ns)
+	if err != nil {
+		log.WithField("application", app.QualifiedName()).Warn(err)
+		return
+	}
+	app.Status.Resources = resources
+}
+
 func convertSyncWindows(w *appv1.SyncWindows) []*application.ApplicationSyncWindow {
 	if w != nil {
 		var windows []*application.ApplicationSyncWindow
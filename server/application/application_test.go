@@ -4,6 +4,7 @@ import (
 	"context"
 	coreerrors "errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -18,13 +19,18 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 	k8scache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
@@ -419,6 +425,41 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsByExternalIDs(t *testing.T) {
+	abc := newTestApp(func(app *appsv1.Application) {
+		app.Name = "abc"
+	})
+	def := newTestApp(func(app *appsv1.Application) {
+		app.Name = "def"
+	})
+	appServer := newTestAppServer(abc, def, newTestApp(func(app *appsv1.Application) {
+		app.Name = "ghi"
+	}))
+
+	res, err := appServer.List(context.Background(), &application.ApplicationQuery{ExternalIDs: []string{def.ExternalID(), abc.ExternalID()}})
+	require.NoError(t, err)
+	var names []string
+	for i := range res.Items {
+		names = append(names, res.Items[i].Name)
+	}
+	assert.Equal(t, []string{"abc", "def"}, names)
+}
+
+func TestGetAppByID(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+
+	app, err := appServer.GetByID(context.Background(), &application.ApplicationByIDQuery{Id: pointer.String(testApp.ExternalID())})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Name, app.Name)
+
+	_, err = appServer.GetByID(context.Background(), &application.ApplicationByIDQuery{Id: pointer.String("app-unknown")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = appServer.GetByID(context.Background(), &application.ApplicationByIDQuery{Id: pointer.String(testApp.Name)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := context.Background()
@@ -499,6 +540,114 @@ func TestUpdateApp(t *testing.T) {
 	assert.Equal(t, app.Spec.Project, "default")
 }
 
+func TestNotificationSubscriptions(t *testing.T) {
+	proj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-proj", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			NotificationSubscriptions: []appsv1.NotificationSubscriptionRule{
+				{Service: "slack", Recipients: []string{"team-*"}},
+				{Service: "webhook"},
+			},
+		},
+	}
+	existingApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "existing-app"
+		app.Spec.Project = "team-proj"
+		app.Annotations = map[string]string{"notifications.argoproj.io/subscribe.on-deployed.slack": "org-wide"}
+	})
+	appServer := newTestAppServer(proj, existingApp)
+
+	t.Run("PermittedSubscription", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Name = "permitted-app"
+			app.Spec.Project = "team-proj"
+			app.Annotations = map[string]string{
+				"notifications.argoproj.io/subscribe.on-deployed.slack":   "team-a;team-b",
+				"notifications.argoproj.io/subscribe.on-deployed.webhook": "",
+			}
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeniedSubscription", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Name = "denied-app"
+			app.Spec.Project = "team-proj"
+			app.Annotations = map[string]string{"notifications.argoproj.io/subscribe.on-deployed.slack": "team-a;org-wide"}
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		statusErr := grpc.UnwrapGRPCStatus(err)
+		require.NotNil(t, statusErr)
+		assert.Equal(t, codes.PermissionDenied, statusErr.Code())
+		assert.ErrorContains(t, err, "recipient 'org-wide' of notification service 'slack'")
+	})
+
+	t.Run("UnrestrictedProject", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Name = "unrestricted-app"
+			app.Annotations = map[string]string{"notifications.argoproj.io/subscribe.on-deployed.slack": "org-wide"}
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExistingSubscription", func(t *testing.T) {
+		app := existingApp.DeepCopy()
+		app.Spec.Source.Path = "other-path"
+		_, err := appServer.Update(context.Background(), &application.ApplicationUpdateRequest{Application: app})
+		assert.NoError(t, err)
+
+		app.Annotations["notifications.argoproj.io/subscribe.on-sync-failed.email"] = "admin@example.com"
+		_, err = appServer.Update(context.Background(), &application.ApplicationUpdateRequest{Application: app})
+		assert.ErrorContains(t, err, "recipient 'admin@example.com' of notification service 'email'")
+	})
+}
+
+func TestApplicationConstraints(t *testing.T) {
+	proj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-proj", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			ApplicationConstraints: &appsv1.ApplicationConstraints{
+				RequiredLabels:        []string{"team"},
+				ForbiddenSyncOptions:  []string{"Replace=*"},
+				DestinationNamespaces: []string{"team-*"},
+			},
+		},
+	}
+	appServer := newTestAppServer(proj)
+
+	t.Run("Compliant", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Name = "compliant-app"
+			app.Labels = map[string]string{"team": "a"}
+			app.Spec.Project = "team-proj"
+			app.Spec.Destination.Namespace = "team-a"
+			app.Spec.SyncPolicy = &appsv1.SyncPolicy{SyncOptions: appsv1.SyncOptions{"CreateNamespace=true"}}
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Violations", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Name = "violating-app"
+			app.Spec.Project = "team-proj"
+			app.Spec.Destination.Namespace = "kube-system"
+			app.Spec.SyncPolicy = &appsv1.SyncPolicy{SyncOptions: appsv1.SyncOptions{"Replace=true"}}
+		})
+		_, err := appServer.Create(context.Background(), &application.ApplicationCreateRequest{Application: testApp})
+		statusErr := grpc.UnwrapGRPCStatus(err)
+		require.NotNil(t, statusErr)
+		assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+		assert.ErrorContains(t, err, "label 'team' is required; sync option 'Replace=true' is forbidden; destination namespace 'kube-system' is not permitted")
+	})
+}
+
 func TestUpdateAppSpec(t *testing.T) {
 	testApp := newTestApp()
 	appServer := newTestAppServer(testApp)
@@ -655,6 +804,252 @@ func TestSyncAndTerminate(t *testing.T) {
 	assert.Equal(t, synccommon.OperationTerminating, app.Status.OperationState.Phase)
 }
 
+func TestSyncApproval(t *testing.T) {
+	proj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:              []string{"*"},
+			Destinations:             []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SyncApprovalDestinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Spec.Project = "prod"
+	})
+	appServer := newTestAppServer(proj, testApp)
+	aliceCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "argocd", "sub": "alice"})
+	bobCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "argocd", "sub": "bob"})
+
+	app, err := appServer.Sync(aliceCtx, &application.ApplicationSyncRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.NotNil(t, app.Operation)
+	assert.True(t, app.Operation.IsPendingApproval())
+
+	_, err = appServer.ApproveOperation(aliceCtx, &application.OperationApproveRequest{Name: &testApp.Name})
+	assert.ErrorContains(t, err, "must be approved by a different subject")
+
+	app, err = appServer.ApproveOperation(bobCtx, &application.OperationApproveRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.False(t, app.Operation.IsPendingApproval())
+	assert.Equal(t, "bob", app.Operation.Approval.ApprovedBy)
+
+	_, err = appServer.ApproveOperation(bobCtx, &application.OperationApproveRequest{Name: &testApp.Name})
+	assert.ErrorContains(t, err, "No operation is pending approval")
+}
+
+func TestTerminateOperationPendingApproval(t *testing.T) {
+	ctx := context.Background()
+	testApp := newTestApp()
+	testApp.Operation = &appsv1.Operation{Sync: &appsv1.SyncOperation{Revision: "HEAD"}, Approval: &appsv1.OperationApproval{}}
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+
+	app, err := appServer.Get(ctx, &application.ApplicationQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestForceTerminateOperation(t *testing.T) {
+	ctx := context.Background()
+	testApp := newTestApp()
+	testApp.Operation = &appsv1.Operation{Sync: &appsv1.SyncOperation{Revision: "HEAD"}}
+	testApp.Status.OperationState = &appsv1.OperationState{
+		Operation: *testApp.Operation,
+		Phase:     synccommon.OperationRunning,
+		StartedAt: metav1.NewTime(time.Now()),
+	}
+	appServer := newTestAppServer(testApp)
+
+	resp, err := appServer.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: &testApp.Name, Force: pointer.Bool(true)})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(ctx, testApp.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Nil(t, app.Operation)
+	assert.Nil(t, app.Status.OperationState)
+
+	_, err = appServer.TerminateOperation(ctx, &application.OperationTerminateRequest{Name: &testApp.Name, Force: pointer.Bool(true)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExtendTTL(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.CreationTimestamp = metav1.NewTime(createdAt)
+		app.Spec.TTL = &appsv1.ApplicationTTL{Duration: "2h"}
+	})
+	appServer := newTestAppServer(testApp)
+
+	app, err := appServer.ExtendTTL(ctx, &application.ApplicationExtendTTLRequest{Name: &testApp.Name, Duration: pointer.String("24h")})
+	require.NoError(t, err)
+	expiresAt, err := app.ExpiresAt()
+	require.NoError(t, err)
+	assert.Equal(t, createdAt.Add(26*time.Hour), *expiresAt)
+
+	// the extensions are cumulative
+	app, err = appServer.ExtendTTL(ctx, &application.ApplicationExtendTTLRequest{Name: &testApp.Name, Duration: pointer.String("1h")})
+	require.NoError(t, err)
+	expiresAt, err = app.ExpiresAt()
+	require.NoError(t, err)
+	assert.Equal(t, createdAt.Add(27*time.Hour), *expiresAt)
+
+	_, err = appServer.ExtendTTL(ctx, &application.ApplicationExtendTTLRequest{Name: &testApp.Name, Duration: pointer.String("-1h")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	noTTLApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "no-ttl"
+	})
+	appServer = newTestAppServer(noTTLApp)
+	_, err = appServer.ExtendTTL(ctx, &application.ApplicationExtendTTLRequest{Name: &noTTLApp.Name, Duration: pointer.String("1h")})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGetDeletionProgress(t *testing.T) {
+	now := metav1.Now()
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.DeletionTimestamp = &now
+		app.Finalizers = []string{appsv1.ResourcesFinalizerName}
+		app.Spec.Deletion = &appsv1.ApplicationDeletion{PropagationPolicy: appsv1.DeletionPropagationPolicyBackground}
+		app.Status.Conditions = []appsv1.ApplicationCondition{{Type: appsv1.ApplicationConditionDeletionError, Message: "admission webhook denied the request"}}
+		app.Status.Resources = []appsv1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "guestbook"},
+			{Version: "v1", Kind: "Service", Namespace: test.FakeDestNamespace, Name: "guestbook"},
+			{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "deleted"},
+		}
+	})
+	appServer := newTestAppServer(testApp)
+	appServer.kubectl = (&kubetest.MockKubectlCmd{}).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		switch gvk.Kind {
+		case "Deployment":
+			obj.SetDeletionTimestamp(&now)
+			obj.SetFinalizers([]string{"example.com/protection"})
+		case "ConfigMap":
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, name)
+		}
+		return obj, nil
+	})
+
+	progress, err := appServer.GetDeletionProgress(context.Background(), &application.ApplicationDeletionProgressQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.True(t, progress.GetDeleting())
+	assert.True(t, progress.GetCascade())
+	assert.Equal(t, appsv1.DeletionPropagationPolicyBackground, progress.GetPropagationPolicy())
+	assert.Equal(t, []string{"admission webhook denied the request"}, progress.Errors)
+	require.Len(t, progress.Resources, 2)
+	assert.Equal(t, "Deployment", progress.Resources[0].GetKind())
+	assert.True(t, progress.Resources[0].GetDeleting())
+	assert.Equal(t, "Waiting for finalizers: example.com/protection", progress.Resources[0].GetMessage())
+	assert.Equal(t, "Service", progress.Resources[1].GetKind())
+	assert.False(t, progress.Resources[1].GetDeleting())
+	assert.Equal(t, "Deletion not requested yet", progress.Resources[1].GetMessage())
+
+	t.Run("OffloadedResources", func(t *testing.T) {
+		offloadedApp := testApp.DeepCopy()
+		resources := offloadedApp.Status.Resources
+		offloadedApp.Status.Resources = nil
+		offloadedApp.Status.ResourcesSource = appsv1.ResourceStatusLocationCache
+		offloadedServer := newTestAppServer(offloadedApp)
+		offloadedServer.kubectl = appServer.kubectl
+		appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+		require.NoError(t, appStateCache.SetAppResourcesStatus(offloadedApp.InstanceName(testNamespace), resources))
+		offloadedServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+		progress, err := offloadedServer.GetDeletionProgress(context.Background(), &application.ApplicationDeletionProgressQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Len(t, progress.Resources, 2)
+	})
+}
+
+func TestDoctor(t *testing.T) {
+	findingsByCheck := func(report *application.ApplicationDoctorReport) map[string]string {
+		res := make(map[string]string)
+		for _, finding := range report.Findings {
+			res[finding.GetCheck()] = finding.GetStatus()
+		}
+		return res
+	}
+
+	t.Run("NamespaceMissing", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(testApp)
+		appServer.kubectl = (&kubetest.MockKubectlCmd{Version: "1.27"}).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, name)
+		})
+
+		report, err := appServer.Doctor(context.Background(), &application.ApplicationDoctorQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			doctorCheckProject:     doctorStatusPassed,
+			doctorCheckRepository:  doctorStatusPassed,
+			doctorCheckRevision:    doctorStatusPassed,
+			doctorCheckDestination: doctorStatusPassed,
+			doctorCheckManifests:   doctorStatusPassed,
+			doctorCheckNamespace:   doctorStatusFailed,
+			doctorCheckPermissions: doctorStatusSkipped,
+		}, findingsByCheck(report))
+	})
+
+	t.Run("NamespaceCreated", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Spec.SyncPolicy = &appsv1.SyncPolicy{SyncOptions: appsv1.SyncOptions{"CreateNamespace=true"}}
+		})
+		appServer := newTestAppServer(testApp)
+		appServer.kubectl = (&kubetest.MockKubectlCmd{}).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, name)
+		})
+
+		report, err := appServer.Doctor(context.Background(), &application.ApplicationDoctorQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, doctorStatusWarning, findingsByCheck(report)[doctorCheckNamespace])
+	})
+
+	t.Run("UnknownProject", func(t *testing.T) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Spec.Project = "unknown"
+		})
+		appServer := newTestAppServer(testApp)
+
+		report, err := appServer.Doctor(context.Background(), &application.ApplicationDoctorQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, report.Findings, 1)
+		assert.Equal(t, doctorStatusFailed, report.Findings[0].GetStatus())
+	})
+}
+
+func TestForceDetach(t *testing.T) {
+	now := metav1.Now()
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.DeletionTimestamp = &now
+		app.Finalizers = []string{appsv1.ForegroundPropagationPolicyFinalizer, "example.com/other"}
+	})
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.ForceDetach(context.Background(), &application.ApplicationForceDetachRequest{Name: &testApp.Name})
+	require.NoError(t, err)
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(context.Background(), testApp.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com/other"}, app.Finalizers)
+
+	_, err = appServer.ForceDetach(context.Background(), &application.ApplicationForceDetachRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	notDeletedApp := newTestApp(func(app *appsv1.Application) {
+		app.Name = "not-deleted"
+		app.Finalizers = []string{appsv1.ResourcesFinalizerName}
+	})
+	appServer = newTestAppServer(notDeletedApp)
+	_, err = appServer.ForceDetach(context.Background(), &application.ApplicationForceDetachRequest{Name: &notDeletedApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestSyncHelm(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer()
@@ -729,6 +1124,57 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestRollbackApp_InitiatedBy(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Status.History = []appsv1.RevisionHistory{{
+		ID:       1,
+		Revision: "abc",
+		Source:   *testApp.Spec.Source.DeepCopy(),
+	}}
+	appServer := newTestAppServer(testApp)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "argocd-client/v2.8.0 grpc-go/1.56.2"))
+	// nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.MapClaims{"iss": "argocd", "sub": "alice"})
+
+	updatedApp, err := appServer.Rollback(ctx, &application.ApplicationRollbackRequest{
+		Name: &testApp.Name,
+		Id:   pointer.Int64(1),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, appsv1.OperationInitiator{
+		Username:  "alice",
+		Subject:   "alice",
+		Client:    appsv1.OperationInitiatorClientCLI,
+		UserAgent: "argocd-client/v2.8.0 grpc-go/1.56.2",
+	}, updatedApp.Operation.InitiatedBy)
+}
+
+func TestOperationInitiator(t *testing.T) {
+	t.Run("UI", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"user-agent", "argocd-client/v2.8.0 grpc-go/1.56.2",
+			"grpcgateway-user-agent", "Mozilla/5.0 (X11; Linux x86_64)",
+		))
+		// nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.MapClaims{"iss": "https://dex.example.com", "sub": "CgVhbGljZRIEbGRhcA", "email": "alice@example.com"})
+		initiator := operationInitiator(ctx)
+		assert.Equal(t, "alice@example.com", initiator.Username)
+		assert.Equal(t, "CgVhbGljZRIEbGRhcA", initiator.Subject)
+		assert.Equal(t, appsv1.OperationInitiatorClientUI, initiator.Client)
+		assert.Equal(t, "Mozilla/5.0 (X11; Linux x86_64)", initiator.UserAgent)
+	})
+	t.Run("API without email claim", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("grpcgateway-user-agent", "curl/8.0.1"))
+		// nolint:staticcheck
+		ctx = context.WithValue(ctx, "claims", &jwt.MapClaims{"iss": "https://dex.example.com", "sub": "CgVhbGljZRIEbGRhcA"})
+		initiator := operationInitiator(ctx)
+		assert.Equal(t, "CgVhbGljZRIEbGRhcA", initiator.Username)
+		assert.Equal(t, appsv1.OperationInitiatorClientAPI, initiator.Client)
+		assert.Equal(t, "curl/8.0.1", initiator.UserAgent)
+	})
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -786,6 +1232,114 @@ p, admin, applications, update, my-proj/test-app, allow
 	assert.Equal(t, "my-proj", updatedApp.Spec.Project)
 }
 
+func TestUpdateAppParameterOverrides(t *testing.T) {
+	ctx := context.Background()
+	// nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin"})
+	newServer := func(policy string) (*Server, *appsv1.Application) {
+		testApp := newTestApp(func(app *appsv1.Application) {
+			app.Spec.Source.Helm = &appsv1.ApplicationSourceHelm{ValueFiles: []string{"values.yaml"}}
+		})
+		appServer := newTestAppServer(testApp)
+		appServer.enf.SetDefaultRole("")
+		_ = appServer.enf.SetBuiltinPolicy(policy)
+		return appServer, testApp
+	}
+
+	t.Run("Update", func(t *testing.T) {
+		appServer, testApp := newServer(`p, admin, applications, update/parameters, default/test-app, allow`)
+
+		testApp.Spec.Source.Helm.Parameters = []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}
+		updatedApp, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}, updatedApp.Spec.Source.Helm.Parameters)
+
+		testApp.Spec.Source.TargetRevision = "v2"
+		_, err = appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("UpdateWithoutNamespace", func(t *testing.T) {
+		appServer, testApp := newServer(`p, admin, applications, update/parameters, default/test-app, allow`)
+
+		testApp.Namespace = ""
+		testApp.Spec.Source.Helm.Parameters = []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}
+		updatedApp, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		require.NoError(t, err)
+		assert.Equal(t, []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}, updatedApp.Spec.Source.Helm.Parameters)
+	})
+
+	t.Run("UpdateSpec", func(t *testing.T) {
+		appServer, testApp := newServer(`p, admin, applications, update/parameters, default/test-app, allow`)
+
+		spec := testApp.Spec.DeepCopy()
+		spec.Source.Helm.Parameters = []appsv1.HelmParameter{{Name: "replicas", Value: "2"}}
+		updatedSpec, err := appServer.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+		require.NoError(t, err)
+		assert.Equal(t, []appsv1.HelmParameter{{Name: "replicas", Value: "2"}}, updatedSpec.Source.Helm.Parameters)
+
+		spec.Source.Helm.Values = "replicas: 2"
+		_, err = appServer.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+		_, err = appServer.UpdateSpec(ctx, &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Patch", func(t *testing.T) {
+		appServer, testApp := newServer(`p, admin, applications, update/parameters, default/test-app, allow`)
+
+		app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: pointer.String(`{"spec": {"source": {"helm": {"parameters": [{"name": "image.tag", "value": "v2"}]}}}}`), PatchType: pointer.String("merge")})
+		require.NoError(t, err)
+		assert.Equal(t, "image.tag", app.Spec.Source.Helm.Parameters[0].Name)
+
+		_, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: pointer.String(`{"metadata": {"labels": {"team": "other"}}}`), PatchType: pointer.String("merge")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		appServer, testApp := newServer(`p, admin, applications, get, default/test-app, allow`)
+
+		testApp.Spec.Source.Helm.Parameters = []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}
+		_, err := appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestIsParameterOverridesUpdate(t *testing.T) {
+	currApp := newTestApp()
+	newApp := currApp.DeepCopy()
+	newApp.Spec.Source.Kustomize = &appsv1.ApplicationSourceKustomize{Images: []appsv1.KustomizeImage{"nginx:1.23"}}
+	assert.True(t, isParameterOverridesUpdate(currApp, newApp))
+
+	newApp.Spec.Source.Kustomize.NamePrefix = "prod-"
+	assert.False(t, isParameterOverridesUpdate(currApp, newApp))
+
+	newApp = currApp.DeepCopy()
+	newApp.Spec.Source.Helm = &appsv1.ApplicationSourceHelm{Parameters: []appsv1.HelmParameter{{Name: "image.tag", Value: "v2"}}}
+	assert.True(t, isParameterOverridesUpdate(currApp, newApp))
+
+	newApp.Name = "other-app"
+	assert.False(t, isParameterOverridesUpdate(currApp, newApp))
+
+	// the overrides which can change the manifests arbitrarily require the update permission
+	for _, source := range []appsv1.ApplicationSource{
+		{Helm: &appsv1.ApplicationSourceHelm{Values: "replicas: 2"}},
+		{Helm: &appsv1.ApplicationSourceHelm{FileParameters: []appsv1.HelmFileParameter{{Name: "config", Path: "config.json"}}}},
+		{Plugin: &appsv1.ApplicationSourcePlugin{Env: appsv1.Env{{Name: "FOO", Value: "bar"}}}},
+		{Directory: &appsv1.ApplicationSourceDirectory{Jsonnet: appsv1.ApplicationSourceJsonnet{TLAs: []appsv1.JsonnetVar{{Name: "foo", Value: "bar"}}}}},
+	} {
+		newApp = currApp.DeepCopy()
+		newApp.Spec.Source.Helm = source.Helm
+		newApp.Spec.Source.Plugin = source.Plugin
+		newApp.Spec.Source.Directory = source.Directory
+		assert.False(t, isParameterOverridesUpdate(currApp, newApp))
+	}
+}
+
 func TestAppJsonPatch(t *testing.T) {
 	testApp := newTestAppWithAnnotations()
 	ctx := context.Background()
@@ -825,6 +1379,59 @@ func TestAppMergePatch(t *testing.T) {
 	assert.Equal(t, "foo", app.Spec.Source.Path)
 }
 
+func TestAppStrategicMergePatch(t *testing.T) {
+	testApp := newTestApp()
+	ctx := context.Background()
+	// nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin"})
+	appServer := newTestAppServer(testApp)
+	appServer.enf.SetDefaultRole("")
+
+	app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+		Name: &testApp.Name, Patch: pointer.String(`{"spec": {"source": {"path": "foo"}}}`), PatchType: pointer.String("strategic")})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", app.Spec.Source.Path)
+	assert.Equal(t, testApp.Spec.Source.RepoURL, app.Spec.Source.RepoURL)
+
+	_, err = appServer.Patch(ctx, &application.ApplicationPatchRequest{
+		Name: &testApp.Name, Patch: pointer.String(`{}`), PatchType: pointer.String("yaml")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAppPatchPreconditionAndDryRun(t *testing.T) {
+	testApp := newTestApp()
+	testApp.ResourceVersion = "1"
+	ctx := context.Background()
+	// nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin"})
+	appServer := newTestAppServer(testApp)
+	appServer.enf.SetDefaultRole("")
+
+	t.Run("DryRun", func(t *testing.T) {
+		app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: pointer.String(`{"spec": {"source": {"path": "foo"}}}`), PatchType: pointer.String("merge"), DryRun: pointer.Bool(true)})
+		require.NoError(t, err)
+		assert.Equal(t, "foo", app.Spec.Source.Path)
+
+		app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(ctx, testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, testApp.Spec.Source.Path, app.Spec.Source.Path)
+	})
+
+	t.Run("StaleResourceVersion", func(t *testing.T) {
+		_, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: pointer.String(`{"spec": {"source": {"path": "foo"}}}`), PatchType: pointer.String("merge"), ResourceVersion: pointer.String("0")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("ResourceVersion", func(t *testing.T) {
+		app, err := appServer.Patch(ctx, &application.ApplicationPatchRequest{
+			Name: &testApp.Name, Patch: pointer.String(`{"spec": {"source": {"path": "foo"}}}`), PatchType: pointer.String("merge"), ResourceVersion: pointer.String("1")})
+		require.NoError(t, err)
+		assert.Equal(t, "foo", app.Spec.Source.Path)
+	})
+}
+
 func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	t.Run("Active", func(t *testing.T) {
 		testApp := newTestApp()
@@ -1106,6 +1713,60 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	}
 }
 
+type fakeRefreshStream struct {
+	application.ApplicationService_RefreshServer
+	ctx      context.Context
+	progress []*application.ApplicationRefreshProgress
+}
+
+func (s *fakeRefreshStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeRefreshStream) Send(progress *application.ApplicationRefreshProgress) error {
+	s.progress = append(s.progress, progress)
+	return nil
+}
+
+func TestRefresh(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	testApp := newTestApp()
+	testApp.ObjectMeta.ResourceVersion = "1"
+	appServer := newTestAppServer(testApp)
+
+	var patched int32
+	ch := make(chan string, 1)
+	go refreshAnnotationRemover(t, ctx, &patched, appServer, testApp.Name, ch)
+
+	stream := &fakeRefreshStream{ctx: ctx}
+	err := appServer.Refresh(&application.ApplicationRefreshRequest{
+		Name:            &testApp.Name,
+		RefreshType:     pointer.String(string(appsv1.RefreshTypeComparison)),
+		DeadlineSeconds: pointer.Int64(60),
+	}, stream)
+	require.NoError(t, err)
+	require.NoError(t, ctx.Err())
+
+	require.Len(t, stream.progress, 2)
+	assert.Equal(t, "Requested", stream.progress[0].GetPhase())
+	assert.Contains(t, stream.progress[0].GetMessage(), "comparison refresh requested with deadline")
+	assert.Equal(t, "Completed", stream.progress[1].GetPhase())
+	require.NotNil(t, stream.progress[1].Application)
+	assert.Equal(t, testApp.Name, stream.progress[1].Application.Name)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&patched))
+}
+
+func TestRefresh_InvalidRefreshType(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	err := appServer.Refresh(&application.ApplicationRefreshRequest{
+		Name:        &testApp.Name,
+		RefreshType: pointer.String("soft"),
+	}, &fakeRefreshStream{ctx: context.Background()})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestInferResourcesStatusHealth(t *testing.T) {
 	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
 
@@ -1140,8 +1801,561 @@ func TestInferResourcesStatusHealth(t *testing.T) {
 
 	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
 
-	appServer.inferResourcesStatusHealth(testApp)
+	appServer.inferResourcesStatus(testApp)
 
 	assert.Equal(t, health.HealthStatusDegraded, testApp.Status.Resources[0].Health.Status)
 	assert.Nil(t, testApp.Status.Resources[1].Health)
 }
+
+func TestInferOffloadedResources(t *testing.T) {
+	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
+
+	testApp := newTestApp()
+	testApp.Status.ResourcesSource = appsv1.ResourceStatusLocationCache
+	appServer := newTestAppServer(testApp)
+	appStateCache := appstate.NewCache(cacheClient, time.Minute)
+	resources := []appsv1.ResourceStatus{{Group: "apps", Kind: "Deployment", Name: "guestbook", Namespace: "default"}}
+	require.NoError(t, appStateCache.SetAppResourcesStatus(testApp.InstanceName(testNamespace), resources))
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+	app, err := appServer.Get(context.Background(), &application.ApplicationQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, resources, app.Status.Resources)
+
+	// listing applications does not load their resources from the cache
+	list, err := appServer.List(context.Background(), &application.ApplicationQuery{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Empty(t, list.Items[0].Status.Resources)
+}
+
+func TestDriftHistory(t *testing.T) {
+	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
+
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	appStateCache := appstate.NewCache(cacheClient, time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+	t.Run("NoHistory", func(t *testing.T) {
+		res, err := appServer.DriftHistory(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+		require.NoError(t, err)
+		assert.Empty(t, res.Items)
+	})
+
+	require.NoError(t, appStateCache.AddAppDriftRecord(testApp.Name, appsv1.DriftRecord{Revision: "abc", Resources: []appsv1.DriftedResource{{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", Manager: "kubectl-edit",
+	}}}))
+	require.NoError(t, appStateCache.AddAppDriftRecord(testApp.Name, appsv1.DriftRecord{Revision: "abc", Resources: []appsv1.DriftedResource{{
+		Kind: "Service", Namespace: "default", Name: "guestbook",
+	}}}))
+
+	t.Run("All", func(t *testing.T) {
+		res, err := appServer.DriftHistory(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "Service", res.Items[0].Resources[0].Kind)
+		assert.Equal(t, "kubectl-edit", res.Items[1].Resources[0].Manager)
+	})
+
+	t.Run("FilterByKind", func(t *testing.T) {
+		res, err := appServer.DriftHistory(context.Background(), &application.ResourcesQuery{ApplicationName: &testApp.Name, Kind: pointer.String("Deployment")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "guestbook", res.Items[0].Resources[0].Name)
+	})
+}
+
+type fakeManagedResourcesStream struct {
+	application.ApplicationService_StreamManagedResourcesServer
+	ctx    context.Context
+	chunks []*application.ManagedResourcesResponse
+}
+
+func (s *fakeManagedResourcesStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeManagedResourcesStream) Send(chunk *application.ManagedResourcesResponse) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestStreamManagedResources(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Hour)), time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+	var items []*appsv1.ResourceDiff
+	for i := 0; i < 5; i++ {
+		items = append(items, &appsv1.ResourceDiff{
+			Kind:        "ConfigMap",
+			Namespace:   "default",
+			Name:        fmt.Sprintf("config-%d", i),
+			LiveState:   strings.Repeat("a", maxResourcesChunkSize/2),
+			TargetState: strings.Repeat("b", maxResourcesChunkSize/4),
+		})
+	}
+	require.NoError(t, appStateCache.SetAppManagedResources(testApp.Name, items))
+
+	stream := &fakeManagedResourcesStream{ctx: context.Background()}
+	require.NoError(t, appServer.StreamManagedResources(&application.ResourcesQuery{ApplicationName: &testApp.Name}, stream))
+
+	// every resource exceeds half of the chunk size
+	require.Len(t, stream.chunks, 5)
+	var names []string
+	for _, chunk := range stream.chunks {
+		require.Len(t, chunk.Items, 1)
+		names = append(names, chunk.Items[0].Name)
+	}
+	assert.Equal(t, []string{"config-0", "config-1", "config-2", "config-3", "config-4"}, names)
+
+	stream = &fakeManagedResourcesStream{ctx: context.Background()}
+	require.NoError(t, appServer.StreamManagedResources(&application.ResourcesQuery{ApplicationName: &testApp.Name, Name: pointer.String("config-3")}, stream))
+	require.Len(t, stream.chunks, 1)
+	require.Len(t, stream.chunks[0].Items, 1)
+	assert.Equal(t, "config-3", stream.chunks[0].Items[0].Name)
+}
+
+func TestSplitApplicationTree(t *testing.T) {
+	node := func(name string) appsv1.ResourceNode {
+		return appsv1.ResourceNode{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: name}}
+	}
+	tree := &appsv1.ApplicationTree{
+		Nodes:         []appsv1.ResourceNode{node("pod-1"), node("pod-2"), node("pod-3")},
+		OrphanedNodes: []appsv1.ResourceNode{node("pod-4")},
+		Hosts:         []appsv1.HostInfo{{Name: "node-1"}},
+	}
+
+	t.Run("SingleChunk", func(t *testing.T) {
+		chunks := splitApplicationTree(tree, maxResourcesChunkSize)
+		require.Len(t, chunks, 1)
+		assert.Equal(t, tree, chunks[0])
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		chunks := splitApplicationTree(&appsv1.ApplicationTree{}, maxResourcesChunkSize)
+		require.Len(t, chunks, 1)
+		assert.Equal(t, &appsv1.ApplicationTree{}, chunks[0])
+	})
+
+	t.Run("MultipleChunks", func(t *testing.T) {
+		n := tree.Nodes[0]
+		chunks := splitApplicationTree(tree, 2*n.Size())
+		require.Len(t, chunks, 3)
+		assert.Len(t, chunks[0].Nodes, 2)
+		assert.Len(t, chunks[1].Nodes, 1)
+		assert.Len(t, chunks[1].OrphanedNodes, 1)
+		assert.Len(t, chunks[2].Hosts, 1)
+
+		merged := &appsv1.ApplicationTree{}
+		for _, chunk := range chunks {
+			merged.Nodes = append(merged.Nodes, chunk.Nodes...)
+			merged.OrphanedNodes = append(merged.OrphanedNodes, chunk.OrphanedNodes...)
+			merged.Hosts = append(merged.Hosts, chunk.Hosts...)
+		}
+		assert.Equal(t, tree, merged)
+	})
+}
+
+func TestListRolloutAnalysisRuns(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Hour)), time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+
+	rolloutRef := appsv1.ResourceRef{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout", Namespace: test.FakeDestNamespace, Name: "guestbook", UID: "rollout-uid"}
+	analysisRunNode := func(name string, owner appsv1.ResourceRef) appsv1.ResourceNode {
+		return appsv1.ResourceNode{
+			ResourceRef: appsv1.ResourceRef{Group: "argoproj.io", Version: "v1alpha1", Kind: "AnalysisRun", Namespace: test.FakeDestNamespace, Name: name, UID: name + "-uid"},
+			ParentRefs:  []appsv1.ResourceRef{owner},
+		}
+	}
+	require.NoError(t, appStateCache.SetAppResourcesTree(testApp.Name, &appsv1.ApplicationTree{Nodes: []appsv1.ResourceNode{
+		{ResourceRef: rolloutRef},
+		analysisRunNode("guestbook-1", rolloutRef),
+		analysisRunNode("guestbook-2", rolloutRef),
+		analysisRunNode("other-1", appsv1.ResourceRef{Group: "argoproj.io", Kind: "Rollout", Namespace: test.FakeDestNamespace, Name: "other", UID: "other-uid"}),
+	}}))
+
+	analysisRuns := map[string]*unstructured.Unstructured{
+		"guestbook-1": test.YamlToUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: AnalysisRun
+metadata:
+  name: guestbook-1
+  annotations:
+    rollout.argoproj.io/revision: "1"
+  creationTimestamp: "2023-01-01T10:00:00Z"
+status:
+  phase: Successful
+  metricResults:
+  - name: success-rate
+    phase: Successful
+    count: 2
+    successful: 2
+    measurements:
+    - phase: Successful
+      value: "0.98"
+    - phase: Successful
+      value: "0.99"
+`),
+		"guestbook-2": test.YamlToUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: AnalysisRun
+metadata:
+  name: guestbook-2
+  annotations:
+    rollout.argoproj.io/revision: "2"
+  creationTimestamp: "2023-01-02T10:00:00Z"
+status:
+  phase: Failed
+  message: Metric "success-rate" assessed Failed due to failed (3) > failureLimit (2)
+  metricResults:
+  - name: success-rate
+    phase: Failed
+    count: 3
+    failed: 3
+    measurements:
+    - phase: Failed
+      value: "0.42"
+`),
+	}
+	appServer.kubectl = (&kubetest.MockKubectlCmd{}).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+		if gvk.Kind != "AnalysisRun" || namespace != test.FakeDestNamespace {
+			return nil, fmt.Errorf("unexpected resource %s %s/%s", gvk.Kind, namespace, name)
+		}
+		return analysisRuns[name], nil
+	})
+
+	t.Run("AnalysisRuns", func(t *testing.T) {
+		res, err := appServer.ListRolloutAnalysisRuns(context.Background(), &application.RolloutAnalysisRunsQuery{Name: &testApp.Name, RolloutName: pointer.String("guestbook")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+
+		latest := res.Items[0]
+		assert.Equal(t, "guestbook-2", latest.GetName())
+		assert.Equal(t, "Failed", latest.GetPhase())
+		assert.Equal(t, "2", latest.GetRevision())
+		assert.Contains(t, latest.GetMessage(), "success-rate")
+		require.Len(t, latest.Metrics, 1)
+		assert.Equal(t, "success-rate", latest.Metrics[0].GetName())
+		assert.Equal(t, int32(3), latest.Metrics[0].GetCount())
+		assert.Equal(t, int32(3), latest.Metrics[0].GetFailed())
+		assert.Equal(t, "0.42", latest.Metrics[0].GetLastValue())
+
+		assert.Equal(t, "guestbook-1", res.Items[1].GetName())
+		assert.Equal(t, "Successful", res.Items[1].GetPhase())
+		assert.Equal(t, int32(2), res.Items[1].Metrics[0].GetSuccessful())
+		assert.Equal(t, "0.99", res.Items[1].Metrics[0].GetLastValue())
+	})
+
+	t.Run("RolloutNotFound", func(t *testing.T) {
+		_, err := appServer.ListRolloutAnalysisRuns(context.Background(), &application.RolloutAnalysisRunsQuery{Name: &testApp.Name, RolloutName: pointer.String("missing")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListResourceStatuses(t *testing.T) {
+	guestbook := newTestApp(func(app *appsv1.Application) {
+		app.Status.Resources = []appsv1.ResourceStatus{{
+			Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+			Status: appsv1.SyncStatusCodeSynced, Health: &appsv1.HealthStatus{Status: health.HealthStatusHealthy},
+		}, {
+			Version: "v1", Kind: "Service", Namespace: "default", Name: "guestbook",
+			Status: appsv1.SyncStatusCodeOutOfSync, Health: &appsv1.HealthStatus{Status: health.HealthStatusHealthy},
+		}}
+	})
+	other := newTestApp(func(app *appsv1.Application) {
+		app.Name = "other"
+		app.Status.Resources = []appsv1.ResourceStatus{{
+			Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "other", Name: "other",
+			Status: appsv1.SyncStatusCodeOutOfSync, Health: &appsv1.HealthStatus{Status: health.HealthStatusDegraded},
+		}}
+	})
+	appServer := newTestAppServer(guestbook, other)
+
+	t.Run("All", func(t *testing.T) {
+		res, err := appServer.ListResourceStatuses(context.Background(), &application.ResourceStatusQuery{})
+		require.NoError(t, err)
+		assert.Len(t, res.Items, 3)
+	})
+
+	t.Run("FilterByApplication", func(t *testing.T) {
+		res, err := appServer.ListResourceStatuses(context.Background(), &application.ResourceStatusQuery{ApplicationName: pointer.String("other")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "other", res.Items[0].GetApplication())
+		assert.Equal(t, "Degraded", res.Items[0].GetHealthStatus())
+	})
+
+	t.Run("FilterByKindAndSyncStatus", func(t *testing.T) {
+		res, err := appServer.ListResourceStatuses(context.Background(), &application.ResourceStatusQuery{
+			Kinds:        []string{"Deployment"},
+			SyncStatuses: []string{string(appsv1.SyncStatusCodeOutOfSync)},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "other", res.Items[0].GetName())
+	})
+
+	t.Run("FilterByNamespaceAndHealthStatus", func(t *testing.T) {
+		res, err := appServer.ListResourceStatuses(context.Background(), &application.ResourceStatusQuery{
+			Namespaces:     []string{"default"},
+			HealthStatuses: []string{string(health.HealthStatusHealthy)},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "Deployment", res.Items[0].GetKind())
+		assert.Equal(t, "Service", res.Items[1].GetKind())
+	})
+}
+
+func TestListDeprecatedAPIs(t *testing.T) {
+	guestbook := newTestApp(func(app *appsv1.Application) {
+		app.Status.Resources = []appsv1.ResourceStatus{
+			{Group: "batch", Version: "v1beta1", Kind: "CronJob", Namespace: "default", Name: "guestbook"},
+			{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress", Namespace: "default", Name: "guestbook"},
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+		}
+	})
+	other := newTestApp(func(app *appsv1.Application) {
+		app.Name = "other"
+		app.Spec.Destination.Server = "https://other-cluster-api.com"
+		app.Status.Resources = []appsv1.ResourceStatus{
+			{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget", Namespace: "other", Name: "other"},
+		}
+	})
+	appServer := newTestAppServer(guestbook, other)
+	appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Hour)), time.Minute)
+	appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute, time.Minute)
+	require.NoError(t, appStateCache.SetClusterInfo("https://cluster-api.com", &appsv1.ClusterInfo{ServerVersion: "1.21"}))
+	require.NoError(t, appStateCache.SetClusterInfo("https://other-cluster-api.com", &appsv1.ClusterInfo{ServerVersion: "1.20"}))
+
+	t.Run("ClusterVersions", func(t *testing.T) {
+		res, err := appServer.ListDeprecatedAPIs(context.Background(), &application.DeprecatedAPIsQuery{})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "CronJob", res.Items[0].GetKind())
+		assert.Equal(t, "1.21", res.Items[0].GetKubeVersion())
+		assert.Equal(t, "batch/v1", res.Items[0].GetReplacement())
+		assert.False(t, res.Items[0].GetRemoved())
+		assert.Equal(t, "Ingress", res.Items[1].GetKind())
+		assert.Equal(t, "batch/v1beta1 is deprecated since Kubernetes 1.21 and removed in 1.25, use batch/v1 instead", res.Items[0].GetMessage())
+	})
+
+	t.Run("KubeVersion", func(t *testing.T) {
+		res, err := appServer.ListDeprecatedAPIs(context.Background(), &application.DeprecatedAPIsQuery{KubeVersion: pointer.String("1.25")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 3)
+		for _, item := range res.Items {
+			assert.True(t, item.GetRemoved())
+		}
+	})
+
+	t.Run("FilterByServer", func(t *testing.T) {
+		res, err := appServer.ListDeprecatedAPIs(context.Background(), &application.DeprecatedAPIsQuery{
+			Server:      pointer.String("https://other-cluster-api.com"),
+			KubeVersion: pointer.String("1.24"),
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "other", res.Items[0].GetApplication())
+		assert.Equal(t, "PodDisruptionBudget", res.Items[0].GetKind())
+	})
+}
+
+func TestApplicationGroups(t *testing.T) {
+	newGroupedApp := func(name string, team string, healthStatus health.HealthStatusCode, syncStatus appsv1.SyncStatusCode) *appsv1.Application {
+		return newTestApp(func(app *appsv1.Application) {
+			app.Name = name
+			app.Spec.Source.RepoURL = "https://github.com/argoproj/argo-cd.git"
+			if team != "" {
+				app.Labels = map[string]string{"team": team}
+				app.Annotations = map[string]string{"example.com/team": team}
+			}
+			app.Status.Health.Status = healthStatus
+			app.Status.Sync.Status = syncStatus
+		})
+	}
+	appServer := newTestAppServer(
+		newGroupedApp("billing", "payments", health.HealthStatusHealthy, appsv1.SyncStatusCodeSynced),
+		newGroupedApp("checkout", "payments", health.HealthStatusDegraded, appsv1.SyncStatusCodeOutOfSync),
+		newGroupedApp("guestbook", "web", health.HealthStatusProgressing, appsv1.SyncStatusCodeSynced),
+		newGroupedApp("legacy", "", health.HealthStatusHealthy, appsv1.SyncStatusCodeSynced),
+	)
+	ctx := context.Background()
+
+	t.Run("ListGroupsByLabel", func(t *testing.T) {
+		res, err := appServer.ListGroups(ctx, &application.ApplicationGroupsQuery{LabelKey: pointer.String("team")})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.GetUngrouped())
+		require.Len(t, res.Items, 2)
+
+		payments := res.Items[0]
+		assert.Equal(t, "payments", payments.GetValue())
+		assert.Equal(t, int64(2), payments.GetTotal())
+		assert.Equal(t, map[string]int64{"Healthy": 1, "Degraded": 1}, payments.HealthStatuses)
+		assert.Equal(t, map[string]int64{"Synced": 1, "OutOfSync": 1}, payments.SyncStatuses)
+		assert.Equal(t, "Degraded", payments.GetHealthStatus())
+		assert.Equal(t, "OutOfSync", payments.GetSyncStatus())
+		assert.Equal(t, []string{"default/billing", "default/checkout"}, payments.Applications)
+
+		web := res.Items[1]
+		assert.Equal(t, "web", web.GetValue())
+		assert.Equal(t, "Progressing", web.GetHealthStatus())
+		assert.Equal(t, "Synced", web.GetSyncStatus())
+	})
+
+	t.Run("ListGroupsByAnnotation", func(t *testing.T) {
+		res, err := appServer.ListGroups(ctx, &application.ApplicationGroupsQuery{AnnotationKey: pointer.String("example.com/team")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "payments", res.Items[0].GetValue())
+	})
+
+	t.Run("ListGroupsWithoutKey", func(t *testing.T) {
+		_, err := appServer.ListGroups(ctx, &application.ApplicationGroupsQuery{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = appServer.ListGroups(ctx, &application.ApplicationGroupsQuery{LabelKey: pointer.String("team"), AnnotationKey: pointer.String("team")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("RefreshGroup", func(t *testing.T) {
+		res, err := appServer.RefreshGroup(ctx, &application.ApplicationGroupRefreshRequest{LabelKey: pointer.String("team"), Value: pointer.String("payments"), Refresh: pointer.String("hard")})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 2)
+		for _, result := range res.Results {
+			assert.Empty(t, result.GetError())
+			app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(result.GetAppNamespace()).Get(ctx, result.GetName(), metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, "hard", app.Annotations[appsv1.AnnotationKeyRefresh])
+		}
+	})
+
+	t.Run("SyncGroup", func(t *testing.T) {
+		res, err := appServer.SyncGroup(ctx, &application.ApplicationGroupSyncRequest{LabelKey: pointer.String("team"), Value: pointer.String("web"), Prune: pointer.Bool(true)})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, "guestbook", res.Results[0].GetName())
+		assert.Empty(t, res.Results[0].GetError())
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(appServer.ns).Get(ctx, "guestbook", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, app.Operation)
+		assert.True(t, app.Operation.Sync.Prune)
+
+		// another operation is already in progress
+		res, err = appServer.SyncGroup(ctx, &application.ApplicationGroupSyncRequest{LabelKey: pointer.String("team"), Value: pointer.String("web")})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.NotEmpty(t, res.Results[0].GetError())
+	})
+}
+
+func TestAggregateEvents(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	podRef := appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-123", UID: "pod-uid"}
+	deployRef := appsv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook", UID: "deploy-uid"}
+	refs := map[string]appsv1.ResourceRef{podRef.UID: podRef, deployRef.UID: deployRef}
+	newEvent := func(uid, eventType, message string, count int32, last time.Time) v1.Event {
+		return v1.Event{
+			InvolvedObject: v1.ObjectReference{UID: k8sTypes.UID(uid)},
+			Type:           eventType,
+			Reason:         "Reason",
+			Message:        message,
+			Count:          count,
+			FirstTimestamp: metav1.NewTime(last.Add(-time.Minute)),
+			LastTimestamp:  metav1.NewTime(last),
+		}
+	}
+
+	items := aggregateEvents([]v1.Event{
+		newEvent("pod-uid", v1.EventTypeWarning, "Back-off restarting failed container", 3, now.Add(-time.Hour)),
+		newEvent("pod-uid", v1.EventTypeWarning, "Back-off restarting failed container", 2, now),
+		newEvent("deploy-uid", v1.EventTypeNormal, "Scaled up replica set", 1, now.Add(-2*time.Hour)),
+		newEvent("other-uid", v1.EventTypeWarning, "Not part of the application", 1, now),
+	}, refs, 10)
+
+	require.Len(t, items, 2)
+	assert.Equal(t, podRef, *items[0].ResourceRef)
+	assert.Equal(t, int32(5), items[0].GetCount())
+	assert.Equal(t, now.Add(-time.Hour-time.Minute), items[0].FirstTimestamp.Time)
+	assert.Equal(t, now, items[0].LastTimestamp.Time)
+	assert.Equal(t, deployRef, *items[1].ResourceRef)
+	assert.Equal(t, "Scaled up replica set", items[1].GetMessage())
+
+	t.Run("Limit", func(t *testing.T) {
+		var events []v1.Event
+		for i := 0; i < 5; i++ {
+			events = append(events, newEvent("pod-uid", v1.EventTypeWarning, fmt.Sprintf("message %d", i), 1, now.Add(time.Duration(i)*time.Minute)))
+		}
+		items := aggregateEvents(events, refs, 3)
+		require.Len(t, items, 3)
+		assert.Equal(t, "message 4", items[0].GetMessage())
+		assert.Equal(t, "message 2", items[2].GetMessage())
+	})
+}
+
+func TestRevisionsDiff(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	repoServerClient := mocks.RepoServerServiceClient{}
+	manifests := map[string][]string{
+		"base": {
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged"},"data":{"key":"value"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"modified"},"data":{"key":"value"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"removed"}}`,
+			`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret"},"data":{"password":"b2xk"}}`,
+		},
+		"head": {
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"unchanged"},"data":{"key":"value"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"modified"},"data":{"key":"changed"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"added"}}`,
+			`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret"},"data":{"password":"bmV3"}}`,
+		},
+	}
+	for revision, revisionManifests := range manifests {
+		revision, revisionManifests := revision, revisionManifests
+		repoServerClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+			return req.Revision == revision
+		})).Return(&apiclient.ManifestResponse{Manifests: revisionManifests, Revision: revision + "-sha"}, nil)
+	}
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+	res, err := appServer.RevisionsDiff(context.Background(), &application.ApplicationRevisionsDiffQuery{
+		Name:         pointer.String("test-app"),
+		BaseRevision: pointer.String("base"),
+		HeadRevision: pointer.String("head"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "base-sha", res.GetBaseRevision())
+	assert.Equal(t, "head-sha", res.GetHeadRevision())
+	diffs := map[string]*application.ResourceRevisionsDiff{}
+	for _, item := range res.Items {
+		diffs[item.GetName()] = item
+	}
+	require.Len(t, diffs, 5)
+	assert.False(t, diffs["unchanged"].GetModified())
+	assert.True(t, diffs["modified"].GetModified())
+	assert.Contains(t, diffs["modified"].GetBaseState(), `"value"`)
+	assert.Contains(t, diffs["modified"].GetHeadState(), `"changed"`)
+	assert.True(t, diffs["removed"].GetModified())
+	assert.Empty(t, diffs["removed"].GetHeadState())
+	assert.True(t, diffs["added"].GetModified())
+	assert.Empty(t, diffs["added"].GetBaseState())
+	assert.True(t, diffs["secret"].GetModified())
+	assert.NotContains(t, diffs["secret"].GetBaseState(), "b2xk")
+	assert.NotContains(t, diffs["secret"].GetHeadState(), "bmV3")
+}
+
+func TestRevisionsDiff_MissingRevision(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+
+	_, err := appServer.RevisionsDiff(context.Background(), &application.ApplicationRevisionsDiffQuery{
+		Name:         pointer.String("test-app"),
+		BaseRevision: pointer.String("base"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
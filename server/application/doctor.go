@@ -0,0 +1,295 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+const (
+	doctorCheckProject     = "ProjectExists"
+	doctorCheckRepository  = "RepositoryReachable"
+	doctorCheckRevision    = "RevisionResolvable"
+	doctorCheckDestination = "DestinationReachable"
+	doctorCheckManifests   = "ManifestsRender"
+	doctorCheckNamespace   = "NamespaceExists"
+	doctorCheckPermissions = "Permissions"
+
+	doctorStatusPassed  = "Passed"
+	doctorStatusWarning = "Warning"
+	doctorStatusFailed  = "Failed"
+	doctorStatusSkipped = "Skipped"
+)
+
+// doctorVerbs are the verbs the resources of an application need to be synced and pruned
+var doctorVerbs = []string{"get", "create", "patch", "delete"}
+
+type doctorReport struct {
+	*application.ApplicationDoctorReport
+}
+
+func (r doctorReport) add(check string, status string, subject string, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, &application.ApplicationDoctorFinding{
+		Check:   pointer.String(check),
+		Status:  pointer.String(status),
+		Subject: pointer.String(subject),
+		Message: pointer.String(fmt.Sprintf(format, args...)),
+	})
+}
+
+// Doctor runs diagnostics of the repositories, revisions, manifests and destination of an application. The outcome of
+// each check is reported as a finding rather than as an error, so that every check runs even if some fail.
+func (s *Server) Doctor(ctx context.Context, q *application.ApplicationDoctorQuery) (*application.ApplicationDoctorReport, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, err := s.appLister.Applications(appNs).Get(appName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+	// the destination is resolved in place while it is validated
+	a = a.DeepCopy()
+
+	report := doctorReport{&application.ApplicationDoctorReport{}}
+	proj, err := argo.GetAppProject(a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		report.add(doctorCheckProject, doctorStatusFailed, a.Spec.Project, "Project cannot be found: %v", err)
+		return report.ApplicationDoctorReport, nil
+	}
+	report.add(doctorCheckProject, doctorStatusPassed, proj.Name, "Project exists")
+
+	sources := a.Spec.GetSources()
+	reachable := s.doctorSources(ctx, a, sources, report)
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err == nil {
+		var version string
+		if version, err = s.kubectl.GetServerVersion(config); err == nil {
+			report.add(doctorCheckDestination, doctorStatusPassed, a.Spec.Destination.Server, "Kubernetes %s is reachable", version)
+		}
+	}
+	if err != nil {
+		report.add(doctorCheckDestination, doctorStatusFailed, a.Spec.Destination.Server, "Destination is not reachable: %v", err)
+		for _, source := range sources {
+			report.add(doctorCheckManifests, doctorStatusSkipped, source.RepoURL, "Destination is not reachable")
+		}
+		report.add(doctorCheckNamespace, doctorStatusSkipped, a.Spec.Destination.Namespace, "Destination is not reachable")
+		report.add(doctorCheckPermissions, doctorStatusSkipped, a.Spec.Destination.Server, "Destination is not reachable")
+		return report.ApplicationDoctorReport, nil
+	}
+
+	var targets []kube.ResourceKey
+	for i, source := range sources {
+		if !reachable[i] {
+			report.add(doctorCheckManifests, doctorStatusSkipped, source.RepoURL, "Repository is not reachable")
+			continue
+		}
+		manifestInfo, err := s.generateManifests(ctx, a, source, source.TargetRevision, nil)
+		if err != nil {
+			report.add(doctorCheckManifests, doctorStatusFailed, source.RepoURL, "Manifests cannot be rendered: %v", err)
+			continue
+		}
+		report.add(doctorCheckManifests, doctorStatusPassed, source.RepoURL, "%d manifests rendered at revision %s", len(manifestInfo.Manifests), manifestInfo.Revision)
+		for _, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err == nil {
+				targets = append(targets, kube.GetResourceKey(obj))
+			}
+		}
+	}
+	s.inferOffloadedResources(a)
+	for _, res := range a.Status.Resources {
+		targets = append(targets, kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name))
+	}
+
+	s.doctorNamespace(ctx, a, config, report)
+	s.doctorPermissions(ctx, a, proj, config, targets, report)
+	return report.ApplicationDoctorReport, nil
+}
+
+// doctorSources checks whether the repositories of the given sources are reachable and their target revisions
+// resolvable, and returns which repositories are reachable
+func (s *Server) doctorSources(ctx context.Context, a *appv1.Application, sources appv1.ApplicationSources, report doctorReport) []bool {
+	reachable := make([]bool, len(sources))
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		for _, source := range sources {
+			report.add(doctorCheckRepository, doctorStatusFailed, source.RepoURL, "Repo server is not reachable: %v", err)
+		}
+		return reachable
+	}
+	defer ioutil.Close(conn)
+
+	for i, source := range sources {
+		repo, err := s.db.GetRepository(ctx, source.RepoURL)
+		if err == nil {
+			_, err = repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{Repo: repo})
+		}
+		if err != nil {
+			report.add(doctorCheckRepository, doctorStatusFailed, source.RepoURL, "Repository is not reachable: %v", err)
+			report.add(doctorCheckRevision, doctorStatusSkipped, source.RepoURL, "Repository is not reachable")
+			continue
+		}
+		reachable[i] = true
+		report.add(doctorCheckRepository, doctorStatusPassed, source.RepoURL, "Repository is reachable")
+
+		// the repo server resolves the revision of the source of the application
+		sourceApp := a.DeepCopy()
+		sourceApp.Spec.Source = source.DeepCopy()
+		sourceApp.Spec.Sources = nil
+		res, err := repoClient.ResolveRevision(ctx, &apiclient.ResolveRevisionRequest{Repo: repo, App: sourceApp, AmbiguousRevision: source.TargetRevision})
+		if err != nil {
+			report.add(doctorCheckRevision, doctorStatusFailed, source.RepoURL, "Revision '%s' cannot be resolved: %v", source.TargetRevision, err)
+			continue
+		}
+		report.add(doctorCheckRevision, doctorStatusPassed, source.RepoURL, "Revision '%s' resolves to %s", source.TargetRevision, res.Revision)
+	}
+	return reachable
+}
+
+// doctorNamespace checks whether the destination namespace of the application exists
+func (s *Server) doctorNamespace(ctx context.Context, a *appv1.Application, config *rest.Config, report doctorReport) {
+	namespace := a.Spec.Destination.Namespace
+	if namespace == "" {
+		report.add(doctorCheckNamespace, doctorStatusSkipped, namespace, "Application has no destination namespace")
+		return
+	}
+	_, err := s.kubectl.GetResource(ctx, config, schema.GroupVersionKind{Version: "v1", Kind: kube.NamespaceKind}, namespace, "")
+	switch {
+	case err == nil:
+		report.add(doctorCheckNamespace, doctorStatusPassed, namespace, "Namespace exists")
+	case apierr.IsNotFound(err) && a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.SyncOptions.HasOption("CreateNamespace=true"):
+		report.add(doctorCheckNamespace, doctorStatusWarning, namespace, "Namespace does not exist and will be created by the next sync")
+	case apierr.IsNotFound(err):
+		report.add(doctorCheckNamespace, doctorStatusFailed, namespace, "Namespace does not exist: create it or enable the CreateNamespace=true sync option")
+	default:
+		report.add(doctorCheckNamespace, doctorStatusWarning, namespace, "Namespace cannot be verified: %v", err)
+	}
+}
+
+// doctorPermissions checks whether the identity syncing the application, that is either the service account
+// impersonated for the destination or the credentials of the cluster, is permitted to manage the given resources
+func (s *Server) doctorPermissions(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, config *rest.Config, targets []kube.ResourceKey, report doctorReport) {
+	identity := "Cluster credentials"
+	impersonationEnabled, err := s.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		report.add(doctorCheckPermissions, doctorStatusWarning, a.Spec.Destination.Server, "Impersonation settings cannot be read: %v", err)
+		return
+	}
+	if impersonationEnabled {
+		serviceAccount, err := proj.GetImpersonatedServiceAccount(a.Spec.Destination)
+		if err != nil {
+			report.add(doctorCheckPermissions, doctorStatusFailed, a.Spec.Destination.Server, "No service account to impersonate: %v", err)
+			return
+		}
+		config = rest.CopyConfig(config)
+		config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+		identity = serviceAccount
+	}
+	if len(targets) == 0 {
+		report.add(doctorCheckPermissions, doctorStatusSkipped, identity, "Application has no resources")
+		return
+	}
+
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		report.add(doctorCheckPermissions, doctorStatusWarning, identity, "API resources cannot be listed: %v", err)
+		return
+	}
+	apiResourceByGroupKind := make(map[schema.GroupKind]kube.APIResourceInfo)
+	for _, apiResource := range apiResources {
+		apiResourceByGroupKind[apiResource.GroupKind] = apiResource
+	}
+
+	type permissionTarget struct {
+		groupKind schema.GroupKind
+		namespace string
+	}
+	var permissionTargets []permissionTarget
+	seen := make(map[permissionTarget]bool)
+	for _, target := range targets {
+		pt := permissionTarget{groupKind: target.GroupKind()}
+		apiResource, ok := apiResourceByGroupKind[pt.groupKind]
+		if !ok {
+			report.add(doctorCheckPermissions, doctorStatusWarning, pt.groupKind.String(), "Kind is not served by the destination cluster, unless its definition is synced first")
+			seen[pt] = true
+			continue
+		}
+		if apiResource.Meta.Namespaced {
+			pt.namespace = target.Namespace
+			if pt.namespace == "" {
+				pt.namespace = a.Spec.Destination.Namespace
+			}
+		}
+		if !seen[pt] {
+			seen[pt] = true
+			permissionTargets = append(permissionTargets, pt)
+		}
+	}
+
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		report.add(doctorCheckPermissions, doctorStatusWarning, identity, "Permissions cannot be verified: %v", err)
+		return
+	}
+	denied := make([][]string, len(permissionTargets))
+	err = kube.RunAllAsync(len(permissionTargets), func(i int) error {
+		pt := permissionTargets[i]
+		gvr := apiResourceByGroupKind[pt.groupKind].GroupVersionResource
+		for _, verb := range doctorVerbs {
+			review, err := kubeClientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{Namespace: pt.namespace, Verb: verb, Group: gvr.Group, Resource: gvr.Resource},
+				},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			if !review.Status.Allowed {
+				denied[i] = append(denied[i], verb)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		report.add(doctorCheckPermissions, doctorStatusWarning, identity, "Permissions cannot be verified: %v", err)
+		return
+	}
+	for i, pt := range permissionTargets {
+		subject := pt.groupKind.String()
+		if pt.namespace != "" {
+			subject = fmt.Sprintf("%s in namespace %s", subject, pt.namespace)
+		}
+		if len(denied[i]) > 0 {
+			report.add(doctorCheckPermissions, doctorStatusFailed, subject, "%s cannot %s", identity, strings.Join(denied[i], ", "))
+		} else {
+			report.add(doctorCheckPermissions, doctorStatusPassed, subject, "%s can %s", identity, strings.Join(doctorVerbs, ", "))
+		}
+	}
+}
@@ -269,6 +269,37 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 
 }
 
+// GetStatus returns the status of the generators of an ApplicationSet and the aggregated status of its Applications
+func (s *Server) GetStatus(ctx context.Context, q *applicationset.ApplicationSetGetQuery) (*applicationset.ApplicationSetStatusResponse, error) {
+	appset, err := s.appsetLister.Get(q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+	if err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplicationSets, rbacpolicy.ActionGet, appset.RBACName()); err != nil {
+		return nil, err
+	}
+
+	apps, err := s.appLister.Applications(s.ns).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
+	}
+	var generatedApps []v1alpha1.Application
+	for _, app := range apps {
+		if metav1.IsControlledBy(app, appset) {
+			generatedApps = append(generatedApps, *app)
+		}
+	}
+
+	res := &applicationset.ApplicationSetStatusResponse{Summary: v1alpha1.SummarizeApplications(generatedApps)}
+	for i := range appset.Status.Generators {
+		res.Generators = append(res.Generators, &appset.Status.Generators[i])
+	}
+	for i := range appset.Status.Conditions {
+		res.Conditions = append(res.Conditions, &appset.Status.Conditions[i])
+	}
+	return res, nil
+}
+
 func (s *Server) validateAppSet(ctx context.Context, appset *v1alpha1.ApplicationSet) (string, error) {
 	if appset == nil {
 		return "", fmt.Errorf("ApplicationSet cannot be validated for nil value")
@@ -3,6 +3,7 @@ package badge
 import (
 	"context"
 	"fmt"
+	"html"
 	"net/http"
 	"regexp"
 	"strings"
@@ -39,10 +40,14 @@ var (
 	leftTextPattern          = regexp.MustCompile(`id="leftText" [^>]*>([^<]*)`)
 	rightTextPattern         = regexp.MustCompile(`id="rightText" [^>]*>([^<]*)`)
 	revisionTextPattern      = regexp.MustCompile(`id="revisionText" [^>]*>([^<]*)`)
+	textFillPattern          = regexp.MustCompile(`<g fill="([^"]*)"`)
 )
 
 const (
 	svgWidthWithRevision = 192
+	// maxLeftTextLength and maxRightTextLength are the lengths of the longest texts fitting in the badge
+	maxLeftTextLength  = 11
+	maxRightTextLength = 9
 )
 
 func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) string {
@@ -62,6 +67,15 @@ func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) strin
 	return result + str[lastIndex:]
 }
 
+// truncateText truncates the text to the given number of characters
+func truncateText(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) > length {
+		return string(runes[:length])
+	}
+	return text
+}
+
 //ServeHTTP returns badge with health and sync status for application
 //(or an error badge if wrong query or application name is given)
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -71,12 +85,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	revisionEnabled := false
 	enabled := false
 	notFound := false
+	query := r.URL.Query()
 	if sets, err := h.settingsMgr.GetSettings(); err == nil {
-		enabled = sets.StatusBadgeEnabled
+		//Badges of applications for which a token has been issued are displayed even if the feature is disabled
+		enabled = sets.StatusBadgeEnabled || validToken(sets.ServerSignature, query)
 	}
 
 	//Sample url: http://localhost:8080/api/badge?name=123
-	if name, ok := r.URL.Query()["name"]; ok && enabled {
+	if name, ok := query["name"]; ok && enabled {
 		if app, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).Get(context.Background(), name[0], v1.GetOptions{}); err == nil {
 			health = app.Status.Health.Status
 			status = app.Status.Sync.Status
@@ -88,9 +104,19 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	//Sample url: http://localhost:8080/api/badge?project=default
-	if projects, ok := r.URL.Query()["project"]; ok && enabled {
-		if apps, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).List(context.Background(), v1.ListOptions{}); err == nil {
-			applicationSet := argo.FilterByProjects(apps.Items, projects)
+	//Sample url: http://localhost:8080/api/badge?selector=team%3Dbackend
+	projects, projectOk := query["project"]
+	selector, selectorOk := query["selector"]
+	if (projectOk || selectorOk) && enabled {
+		listOptions := v1.ListOptions{}
+		if selectorOk {
+			listOptions.LabelSelector = selector[0]
+		}
+		if apps, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).List(context.Background(), listOptions); err == nil {
+			applicationSet := apps.Items
+			if projectOk {
+				applicationSet = argo.FilterByProjects(apps.Items, projects)
+			}
 			for _, a := range applicationSet {
 				if a.Status.Sync.Status != appv1.SyncStatusCodeSynced {
 					status = appv1.SyncStatusCodeOutOfSync
@@ -108,37 +134,54 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	//Sample url: http://localhost:8080/api/badge?name=123&revision=true
-	if revisionParam, ok := r.URL.Query()["revision"]; ok && enabled && strings.EqualFold(revisionParam[0], "true") {
+	if revisionParam, ok := query["revision"]; ok && enabled && strings.EqualFold(revisionParam[0], "true") {
 		revisionEnabled = true
 	}
 
+	//Sample url: http://localhost:8080/api/badge?name=123&theme=dark
+	theme := Themes[ThemeLight]
+	if themeParam, ok := query["theme"]; ok && enabled {
+		if t, ok := Themes[strings.ToLower(themeParam[0])]; ok {
+			theme = t
+		}
+	}
+
 	leftColorString := ""
-	if leftColor, ok := HealthStatusColors[health]; ok {
+	if leftColor, ok := theme.HealthStatusColors[health]; ok {
 		leftColorString = toRGBString(leftColor)
 	} else {
-		leftColorString = toRGBString(Grey)
+		leftColorString = toRGBString(theme.DefaultColor)
 	}
 
 	rightColorString := ""
-	if rightColor, ok := SyncStatusColors[status]; ok {
+	if rightColor, ok := theme.SyncStatusColors[status]; ok {
 		rightColorString = toRGBString(rightColor)
 	} else {
-		rightColorString = toRGBString(Grey)
+		rightColorString = toRGBString(theme.DefaultColor)
 	}
 
 	leftText := string(health)
 	rightText := string(status)
 
+	//Sample url: http://localhost:8080/api/badge?name=123&leftText=prod&rightText=live
+	if text, ok := query["leftText"]; ok && enabled {
+		leftText = truncateText(text[0], maxLeftTextLength)
+	}
+	if text, ok := query["rightText"]; ok && enabled {
+		rightText = truncateText(text[0], maxRightTextLength)
+	}
+
 	if notFound {
 		leftText = "Not Found"
 		rightText = ""
 	}
 
 	badge := assets.BadgeSVG
+	badge = textFillPattern.ReplaceAllString(badge, fmt.Sprintf(`<g fill="%s"`, toRGBString(theme.TextColor)))
 	badge = leftRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="leftRect" fill="%s" $2`, leftColorString))
 	badge = rightRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="rightRect" fill="%s" $2`, rightColorString))
-	badge = replaceFirstGroupSubMatch(leftTextPattern, badge, leftText)
-	badge = replaceFirstGroupSubMatch(rightTextPattern, badge, rightText)
+	badge = replaceFirstGroupSubMatch(leftTextPattern, badge, html.EscapeString(leftText))
+	badge = replaceFirstGroupSubMatch(rightTextPattern, badge, html.EscapeString(rightText))
 
 	if !notFound && revisionEnabled && revision != "" {
 		// Increase width of SVG and enable display of revision components
@@ -149,7 +192,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if len(shortRevision) > 7 {
 			shortRevision = shortRevision[:7]
 		}
-		badge = replaceFirstGroupSubMatch(revisionTextPattern, badge, fmt.Sprintf("(%s)", shortRevision))
+		badge = replaceFirstGroupSubMatch(revisionTextPattern, badge, html.EscapeString(fmt.Sprintf("(%s)", shortRevision)))
 	}
 
 	w.Header().Set("Content-Type", "image/svg+xml")
@@ -2,10 +2,14 @@ package badge
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"image/color"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -255,3 +259,104 @@ func TestHandlerFeatureIsDisabled(t *testing.T) {
 	assert.Equal(t, "Unknown", leftTextPattern.FindStringSubmatch(response)[1])
 	assert.Equal(t, "Unknown", rightTextPattern.FindStringSubmatch(response)[1])
 }
+
+func TestHandlerFeatureSelectorIsEnabled(t *testing.T) {
+	apps := createApplications([]string{"Healthy:Synced", "Degraded:OutOfSync"}, []string{"default", "default"}, "default")
+	apps[0].Labels = map[string]string{"team": "backend"}
+	apps[1].Labels = map[string]string{"team": "frontend"}
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(apps[0], apps[1]), settingsMgr, "default")
+
+	for _, tt := range []struct {
+		apiEndPoint string
+		health      string
+		status      string
+	}{
+		{"/api/badge?selector=team%3Dbackend", "Healthy", "Synced"},
+		{"/api/badge?selector=team%3Dfrontend", "Degraded", "OutOfSync"},
+		{"/api/badge?selector=team%3Dbackend&project=other", "Unknown", "Unknown"},
+		{"/api/badge?selector=team%3Dunknown", "Unknown", "Unknown"},
+	} {
+		req, err := http.NewRequest("GET", tt.apiEndPoint, nil)
+		assert.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		response := rr.Body.String()
+		assert.Equal(t, tt.health, leftTextPattern.FindStringSubmatch(response)[1], tt.apiEndPoint)
+		assert.Equal(t, tt.status, rightTextPattern.FindStringSubmatch(response)[1], tt.apiEndPoint)
+	}
+}
+
+func TestHandlerFeatureIsDisabledWithToken(t *testing.T) {
+	argoCDCmDisabled := argoCDCm.DeepCopy()
+	delete(argoCDCmDisabled.Data, "statusbadge.enabled")
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmDisabled, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+
+	for _, tt := range []struct {
+		name   string
+		token  string
+		health string
+	}{
+		{"valid", Token([]byte("test"), url.Values{"name": {"testApp"}}), "Healthy"},
+		{"other application", Token([]byte("test"), url.Values{"name": {"otherApp"}}), "Unknown"},
+		{"other key", Token([]byte("other"), url.Values{"name": {"testApp"}}), "Unknown"},
+		{"invalid", "invalid", "Unknown"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("GET", "/api/badge?name=testApp&token="+tt.token, nil)
+			assert.NoError(t, err)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.health, leftTextPattern.FindStringSubmatch(rr.Body.String())[1])
+		})
+	}
+}
+
+func TestToken(t *testing.T) {
+	key := []byte("test")
+	assert.Equal(t, Token(key, url.Values{"project": {"a", "b"}}), Token(key, url.Values{"project": {"b", "a"}}))
+	assert.Equal(t, Token(key, url.Values{"name": {"app"}}), Token(key, url.Values{"name": {"app"}, "theme": {"dark"}, "revision": {"true"}}))
+	assert.NotEqual(t, Token(key, url.Values{"project": {"a"}}), Token(key, url.Values{"project": {"a", "b"}}))
+	assert.NotEqual(t, Token(key, url.Values{"project": {"a"}}), Token(key, url.Values{"selector": {"a"}}))
+
+	// the token is not signed with the server signature key itself
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(canonicalQuery(url.Values{"name": {"app"}})))
+	assert.NotEqual(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), Token(key, url.Values{"name": {"app"}}))
+}
+
+func TestHandlerDarkTheme(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp&theme=dark", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	dark := Themes[ThemeDark]
+	assert.Equal(t, toRGBString(dark.HealthStatusColors[health.HealthStatusHealthy]), leftRectColorPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, toRGBString(dark.SyncStatusColors[v1alpha1.SyncStatusCodeSynced]), rightRectColorPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, toRGBString(dark.TextColor), textFillPattern.FindStringSubmatch(response)[1])
+}
+
+func TestHandlerCustomTexts(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp&leftText=%3Cb%3Eprod&rightText=deployed-to-production", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.Equal(t, "&lt;b&gt;prod", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "deployed-", rightTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, toRGBString(Green), leftRectColorPattern.FindStringSubmatch(response)[1])
+}
@@ -36,3 +36,49 @@ var (
 func toRGBString(col color.RGBA) string {
 	return fmt.Sprintf("rgb(%d, %d, %d)", col.R, col.G, col.B)
 }
+
+const (
+	// ThemeLight is the default theme of badges, for light backgrounds
+	ThemeLight = "light"
+	// ThemeDark is the theme of badges for dark backgrounds
+	ThemeDark = "dark"
+)
+
+// Theme holds the colors of a badge
+type Theme struct {
+	HealthStatusColors map[health.HealthStatusCode]color.RGBA
+	SyncStatusColors   map[appv1.SyncStatusCode]color.RGBA
+	DefaultColor       color.RGBA
+	TextColor          color.RGBA
+}
+
+var (
+	White = color.RGBA{255, 255, 255, 255} // #ffffff
+	Black = color.RGBA{13, 17, 23, 255}    // #0d1117
+
+	Themes = map[string]Theme{
+		ThemeLight: {
+			HealthStatusColors: HealthStatusColors,
+			SyncStatusColors:   SyncStatusColors,
+			DefaultColor:       Grey,
+			TextColor:          White,
+		},
+		ThemeDark: {
+			HealthStatusColors: map[health.HealthStatusCode]color.RGBA{
+				health.HealthStatusDegraded:    {248, 81, 73, 255},   // #f85149
+				health.HealthStatusHealthy:     {63, 185, 80, 255},   // #3fb950
+				health.HealthStatusMissing:     {188, 140, 255, 255}, // #bc8cff
+				health.HealthStatusProgressing: {88, 166, 255, 255},  // #58a6ff
+				health.HealthStatusSuspended:   {139, 148, 158, 255}, // #8b949e
+				health.HealthStatusUnknown:     {188, 140, 255, 255}, // #bc8cff
+			},
+			SyncStatusColors: map[appv1.SyncStatusCode]color.RGBA{
+				appv1.SyncStatusCodeSynced:    {63, 185, 80, 255},   // #3fb950
+				appv1.SyncStatusCodeOutOfSync: {210, 153, 34, 255},  // #d29922
+				appv1.SyncStatusCodeUnknown:   {188, 140, 255, 255}, // #bc8cff
+			},
+			DefaultColor: color.RGBA{139, 148, 158, 255}, // #8b949e
+			TextColor:    Black,
+		},
+	}
+)
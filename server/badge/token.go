@@ -0,0 +1,54 @@
+package badge
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"sort"
+)
+
+// tokenParams are the query parameters selecting the applications of a badge. A token only allows to display the
+// badge of the applications it has been issued for, while the parameters changing the appearance of the badge are free.
+var tokenParams = []string{"name", "project", "selector"}
+
+// tokenKeyContext distinguishes the key signing the badge tokens from the other keys derived from the server signature
+const tokenKeyContext = "badge"
+
+// Token returns the token which allows to display the badge of the applications selected by the given query, even if
+// the status badge is not enabled for anonymous users. The token is signed with a key derived from the server signature
+// key, so that a badge token cannot be used as a signature of anything else.
+func Token(serverSignature []byte, query url.Values) string {
+	mac := hmac.New(sha256.New, tokenKey(serverSignature))
+	_, _ = mac.Write([]byte(canonicalQuery(query)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// tokenKey returns the key signing the badge tokens, derived from the server signature key
+func tokenKey(serverSignature []byte) []byte {
+	mac := hmac.New(sha256.New, serverSignature)
+	_, _ = mac.Write([]byte(tokenKeyContext))
+	return mac.Sum(nil)
+}
+
+// validToken returns whether the query holds a token which has been issued for the applications it selects
+func validToken(serverSignature []byte, query url.Values) bool {
+	token := query.Get("token")
+	if token == "" || len(serverSignature) == 0 {
+		return false
+	}
+	return hmac.Equal([]byte(token), []byte(Token(serverSignature, query)))
+}
+
+// canonicalQuery returns the encoded parameters of the query selecting applications, independently of their order
+func canonicalQuery(query url.Values) string {
+	canonical := url.Values{}
+	for _, param := range tokenParams {
+		if values, ok := query[param]; ok {
+			sorted := append([]string{}, values...)
+			sort.Strings(sorted)
+			canonical[param] = sorted
+		}
+	}
+	return canonical.Encode()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-redis/redis/v8"
 	"github.com/spf13/cobra"
 
+	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
@@ -17,6 +18,24 @@ import (
 
 var ErrCacheMiss = appstatecache.ErrCacheMiss
 
+// userPreferencesExpiration is how long the preferences of a user are kept after their last update
+const userPreferencesExpiration = 90 * 24 * time.Hour
+
+// dexLoginStatusExpiration is how long the outcome of the logins through Dex is kept after the last login
+const dexLoginStatusExpiration = 30 * 24 * time.Hour
+
+const dexLoginStatusKey = "dex|login-status"
+
+// DexLoginStatus holds the outcome of the logins through Dex observed by the API servers
+type DexLoginStatus struct {
+	// ConnectorLogins holds the time of the last successful login through each Dex connector
+	ConnectorLogins map[string]time.Time `json:"connectorLogins,omitempty"`
+	// LastError is the error of the last failed login
+	LastError string `json:"lastError,omitempty"`
+	// LastErrorAt is the time of the last failed login
+	LastErrorAt time.Time `json:"lastErrorAt,omitempty"`
+}
+
 type Cache struct {
 	cache                           *appstatecache.Cache
 	connectionStatusCacheExpiration time.Duration
@@ -66,6 +85,36 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
+func (c *Cache) GetAppResourcesStatus(appName string, res *[]appv1.ResourceStatus) error {
+	return c.cache.GetAppResourcesStatus(appName, res)
+}
+
+// GetAppStatusResources returns the resources of an application, which are loaded from the cache if the controller
+// moved them from the status to the cache because the application exceeded the maximum status size. The namespace is
+// the namespace of the control plane, which the cache keys of the applications depend on.
+func (c *Cache) GetAppStatusResources(app *appv1.Application, controllerNs string) ([]appv1.ResourceStatus, error) {
+	if app.Status.ResourcesSource != appv1.ResourceStatusLocationCache {
+		return app.Status.Resources, nil
+	}
+	var resources []appv1.ResourceStatus
+	if err := c.GetAppResourcesStatus(app.InstanceName(controllerNs), &resources); err != nil {
+		return nil, fmt.Errorf("error getting the resources of application %s from the cache: %w", app.QualifiedName(), err)
+	}
+	return resources, nil
+}
+
+func (c *Cache) GetAppDriftHistory(appName string, res *[]appv1.DriftRecord) error {
+	return c.cache.GetAppDriftHistory(appName, res)
+}
+
+func (c *Cache) IncrementProjectUsage(project string, metric string, value float64) error {
+	return c.cache.IncrementProjectUsage(project, metric, value)
+}
+
+func (c *Cache) GetProjectUsage(project string, metric string, from time.Time, to time.Time) (float64, error) {
+	return c.cache.GetProjectUsage(project, metric, from, to)
+}
+
 func (c *Cache) SetRepoConnectionState(repo string, state *appv1.ConnectionState) error {
 	return c.cache.SetItem(repoConnectionStateKey(repo), &state, c.connectionStatusCacheExpiration, state == nil)
 }
@@ -88,6 +137,30 @@ func (c *Cache) SetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	return c.cache.SetClusterInfo(server, res)
 }
 
+func (c *Cache) GetClusterAPIResources(server string, res *appv1.ClusterAPIResourceList) error {
+	return c.cache.GetClusterAPIResources(server, res)
+}
+
+func userPreferencesKey(issuer string, subject string) string {
+	return fmt.Sprintf("user|%s|%s|preferences", issuer, subject)
+}
+
+func (c *Cache) GetUserPreferences(issuer string, subject string, res *sessionpkg.UserPreferences) error {
+	return c.cache.GetItem(userPreferencesKey(issuer, subject), res)
+}
+
+func (c *Cache) SetUserPreferences(issuer string, subject string, preferences *sessionpkg.UserPreferences) error {
+	return c.cache.SetItem(userPreferencesKey(issuer, subject), preferences, userPreferencesExpiration, preferences == nil)
+}
+
+func (c *Cache) GetDexLoginStatus(res *DexLoginStatus) error {
+	return c.cache.GetItem(dexLoginStatusKey, res)
+}
+
+func (c *Cache) SetDexLoginStatus(status *DexLoginStatus) error {
+	return c.cache.SetItem(dexLoginStatusKey, status, dexLoginStatusExpiration, status == nil)
+}
+
 func (c *Cache) GetCache() *cacheutil.Cache {
 	return c.cache.Cache
 }
@@ -45,6 +45,29 @@ func TestCache_GetRepoConnectionState(t *testing.T) {
 	assert.Equal(t, ConnectionState{Status: "my-state"}, value)
 }
 
+func TestCache_GetAppStatusResources(t *testing.T) {
+	cache := newFixtures().Cache
+	inline := []ResourceStatus{{Kind: "Deployment", Name: "inline"}}
+	offloaded := []ResourceStatus{{Kind: "Deployment", Name: "offloaded"}}
+	app := &Application{}
+	app.Name = "guestbook"
+	app.Namespace = "team"
+	app.Status.Resources = inline
+	assert.NoError(t, cache.cache.SetAppResourcesStatus("team_guestbook", offloaded))
+
+	resources, err := cache.GetAppStatusResources(app, "argocd")
+	assert.NoError(t, err)
+	assert.Equal(t, inline, resources)
+
+	app.Status.ResourcesSource = ResourceStatusLocationCache
+	resources, err = cache.GetAppStatusResources(app, "argocd")
+	assert.NoError(t, err)
+	assert.Equal(t, offloaded, resources)
+
+	_, err = cache.GetAppStatusResources(app, "team")
+	assert.ErrorContains(t, err, "error getting the resources of application team/guestbook from the cache")
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	assert.NoError(t, err)
@@ -2,15 +2,35 @@ package certificate
 
 import (
 	"context"
+	"encoding/base64"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	certificatepkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/certificate"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	certutil "github.com/argoproj/argo-cd/v2/util/cert"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 )
 
+const (
+	// sshHostKeyScanTimeout is the timeout for each connection to the scanned SSH server
+	sshHostKeyScanTimeout = 10 * time.Second
+
+	sshHostKeyStatusNew       = "new"
+	sshHostKeyStatusUnchanged = "unchanged"
+	sshHostKeyStatusChanged   = "changed"
+	sshHostKeyStatusRemoved   = "removed"
+)
+
 // Server provides a Certificate service
 type Server struct {
 	db            db.ArgoDB
@@ -79,3 +99,151 @@ func (s *Server) DeleteCertificate(ctx context.Context, q *certificatepkg.Reposi
 	}
 	return certs, nil
 }
+
+// Scans the public host keys of an SSH server and compares them with the stored
+// known hosts. Keys whose fingerprints were confirmed in the request are stored,
+// or removed from the known hosts if the server does not offer them anymore.
+func (s *Server) ScanHostKeys(ctx context.Context, q *certificatepkg.SSHHostKeyScanRequest) (*certificatepkg.SSHHostKeyScanResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceCertificates, rbacpolicy.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+	if !certutil.IsValidHostname(q.HostName, false) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hostname: %s", q.HostName)
+	}
+	port := int(q.Port)
+	if port == 0 {
+		port = 22
+	}
+	serverName := certutil.SSHKnownHostsServerName(q.HostName, port)
+
+	stored, err := s.db.ListRepoCertificates(ctx, &db.CertificateListSelector{
+		HostNamePattern: serverName,
+		CertType:        "ssh",
+	})
+	if err != nil {
+		return nil, err
+	}
+	// only the SSH servers of the repositories are scanned, so that the API server cannot be used to probe arbitrary
+	// hosts and ports of its network
+	if len(stored.Items) == 0 {
+		isRepoServer, err := s.isRepositorySSHServer(ctx, q.HostName, port)
+		if err != nil {
+			return nil, err
+		}
+		if !isRepoServer {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is neither a known host nor the SSH server of a configured repository", serverName)
+		}
+	}
+
+	keys, err := certutil.ScanSSHHostKeys(net.JoinHostPort(q.HostName, strconv.Itoa(port)), sshHostKeyScanTimeout)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+	storedFingerprints := make(map[string]string)
+	for _, cert := range stored.Items {
+		storedFingerprints[cert.CertSubType] = cert.CertInfo
+	}
+
+	confirmed := make(map[string]bool)
+	for _, fingerprint := range q.Fingerprints {
+		confirmed[fingerprint] = true
+	}
+
+	res := &certificatepkg.SSHHostKeyScanResponse{}
+	toStore := &appsv1.RepositoryCertificateList{}
+	scannedTypes := make(map[string]bool)
+	for _, key := range keys {
+		hostKey := &certificatepkg.SSHHostKey{
+			ServerName:  serverName,
+			KeyType:     key.Type(),
+			KeyData:     base64.StdEncoding.EncodeToString(key.Marshal()),
+			Fingerprint: "SHA256:" + certutil.SSHFingerprintSHA256(key),
+		}
+		scannedTypes[hostKey.KeyType] = true
+		switch storedFingerprint, ok := storedFingerprints[hostKey.KeyType]; {
+		case !ok:
+			hostKey.Status = sshHostKeyStatusNew
+		case storedFingerprint == hostKey.Fingerprint:
+			hostKey.Status = sshHostKeyStatusUnchanged
+		default:
+			hostKey.Status = sshHostKeyStatusChanged
+		}
+		if hostKey.Status != sshHostKeyStatusUnchanged && confirmed[hostKey.Fingerprint] {
+			toStore.Items = append(toStore.Items, appsv1.RepositoryCertificate{
+				ServerName:  serverName,
+				CertType:    "ssh",
+				CertSubType: hostKey.KeyType,
+				CertData:    []byte(hostKey.KeyData),
+			})
+			hostKey.Applied = true
+		}
+		res.Items = append(res.Items, hostKey)
+	}
+
+	var toRemove []*certificatepkg.SSHHostKey
+	for _, cert := range stored.Items {
+		if scannedTypes[cert.CertSubType] {
+			continue
+		}
+		hostKey := &certificatepkg.SSHHostKey{
+			ServerName:  cert.ServerName,
+			KeyType:     cert.CertSubType,
+			Fingerprint: cert.CertInfo,
+			Status:      sshHostKeyStatusRemoved,
+		}
+		if confirmed[hostKey.Fingerprint] {
+			toRemove = append(toRemove, hostKey)
+		}
+		res.Items = append(res.Items, hostKey)
+	}
+
+	if len(toRemove) > 0 {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceCertificates, rbacpolicy.ActionDelete, ""); err != nil {
+			return nil, err
+		}
+	}
+	if len(toStore.Items) > 0 {
+		if _, err := s.db.CreateRepoCertificate(ctx, toStore, true); err != nil {
+			return nil, err
+		}
+	}
+	if len(toRemove) > 0 {
+		for _, hostKey := range toRemove {
+			_, err := s.db.RemoveRepoCertificates(ctx, &db.CertificateListSelector{
+				HostNamePattern: hostKey.ServerName,
+				CertType:        "ssh",
+				CertSubType:     hostKey.KeyType,
+			})
+			if err != nil {
+				return nil, err
+			}
+			hostKey.Applied = true
+		}
+	}
+
+	return res, nil
+}
+
+// isRepositorySSHServer returns whether the given host and port are the SSH server of a configured repository or
+// repository credential template
+func (s *Server) isRepositorySSHServer(ctx context.Context, hostName string, port int) (bool, error) {
+	repos, err := s.db.ListRepositories(ctx)
+	if err != nil {
+		return false, err
+	}
+	urls := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		urls = append(urls, repo.Repo)
+	}
+	credsURLs, err := s.db.ListRepositoryCredentials(ctx)
+	if err != nil {
+		return false, err
+	}
+	urls = append(urls, credsURLs...)
+	for _, url := range urls {
+		if repoHost, repoPort, ok := git.SSHHostPort(url); ok && repoHost == strings.ToLower(hostName) && repoPort == port {
+			return true, nil
+		}
+	}
+	return false, nil
+}
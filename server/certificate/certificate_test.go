@@ -0,0 +1,136 @@
+package certificate
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	certificatepkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/certificate"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	certutil "github.com/argoproj/argo-cd/v2/util/cert"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+func newEnforcer() *rbac.Enforcer {
+	enforcer := rbac.NewEnforcer(fake.NewSimpleClientset(), "default", common.ArgoCDRBACConfigMapName, nil)
+	_ = enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	enforcer.SetDefaultRole("role:admin")
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return true
+	})
+	return enforcer
+}
+
+// startSSHServer starts an SSH server offering an ed25519 host key and returns its port and host key
+func startSSHServer(t *testing.T) (int, ssh.PublicKey) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromSigner(key)
+	require.NoError(t, err)
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _, _, _ = ssh.NewServerConn(conn, config)
+			}()
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, signer.PublicKey()
+}
+
+func TestScanHostKeys(t *testing.T) {
+	port, hostKey := startSSHServer(t)
+	serverName := fmt.Sprintf("[127.0.0.1]:%d", port)
+	fingerprint := "SHA256:" + certutil.SSHFingerprintSHA256(hostKey)
+	selector := &db.CertificateListSelector{HostNamePattern: serverName, CertType: "ssh"}
+
+	t.Run("New key is only stored once confirmed", func(t *testing.T) {
+		argoDB := &dbmocks.ArgoDB{}
+		argoDB.On("ListRepoCertificates", mock.Anything, selector).Return(&appsv1.RepositoryCertificateList{}, nil)
+		argoDB.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{{Repo: fmt.Sprintf("ssh://git@127.0.0.1:%d/repo.git", port)}}, nil)
+		argoDB.On("ListRepositoryCredentials", mock.Anything).Return([]string{}, nil)
+		s := NewServer(nil, argoDB, newEnforcer())
+
+		res, err := s.ScanHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{HostName: "127.0.0.1", Port: int32(port)})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, serverName, res.Items[0].ServerName)
+		assert.Equal(t, ssh.KeyAlgoED25519, res.Items[0].KeyType)
+		assert.Equal(t, fingerprint, res.Items[0].Fingerprint)
+		assert.Equal(t, sshHostKeyStatusNew, res.Items[0].Status)
+		assert.False(t, res.Items[0].Applied)
+		argoDB.AssertNotCalled(t, "CreateRepoCertificate", mock.Anything, mock.Anything, mock.Anything)
+
+		argoDB.On("CreateRepoCertificate", mock.Anything, mock.MatchedBy(func(certs *appsv1.RepositoryCertificateList) bool {
+			return len(certs.Items) == 1 && certs.Items[0].ServerName == serverName && certs.Items[0].CertSubType == ssh.KeyAlgoED25519
+		}), true).Return(&appsv1.RepositoryCertificateList{}, nil)
+		res, err = s.ScanHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{HostName: "127.0.0.1", Port: int32(port), Fingerprints: []string{fingerprint}})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		assert.True(t, res.Items[0].Applied)
+		argoDB.AssertExpectations(t)
+	})
+
+	t.Run("Rotated and removed keys are detected", func(t *testing.T) {
+		argoDB := &dbmocks.ArgoDB{}
+		argoDB.On("ListRepoCertificates", mock.Anything, selector).Return(&appsv1.RepositoryCertificateList{Items: []appsv1.RepositoryCertificate{
+			{ServerName: serverName, CertType: "ssh", CertSubType: ssh.KeyAlgoED25519, CertInfo: "SHA256:old"},
+			{ServerName: serverName, CertType: "ssh", CertSubType: ssh.KeyAlgoRSA, CertInfo: "SHA256:rsa"},
+		}}, nil)
+		argoDB.On("RemoveRepoCertificates", mock.Anything, &db.CertificateListSelector{HostNamePattern: serverName, CertType: "ssh", CertSubType: ssh.KeyAlgoRSA}).
+			Return(&appsv1.RepositoryCertificateList{}, nil)
+		s := NewServer(nil, argoDB, newEnforcer())
+
+		res, err := s.ScanHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{HostName: "127.0.0.1", Port: int32(port), Fingerprints: []string{"SHA256:rsa"}})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, sshHostKeyStatusChanged, res.Items[0].Status)
+		assert.False(t, res.Items[0].Applied)
+		assert.Equal(t, sshHostKeyStatusRemoved, res.Items[1].Status)
+		assert.True(t, res.Items[1].Applied)
+		argoDB.AssertExpectations(t)
+	})
+
+	t.Run("Hosts of no repository are not scanned", func(t *testing.T) {
+		argoDB := &dbmocks.ArgoDB{}
+		argoDB.On("ListRepoCertificates", mock.Anything, selector).Return(&appsv1.RepositoryCertificateList{}, nil)
+		argoDB.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{{Repo: "git@127.0.0.1:repo.git"}, {Repo: "https://127.0.0.1/repo.git"}}, nil)
+		argoDB.On("ListRepositoryCredentials", mock.Anything).Return([]string{"ssh://git@example.com:" + fmt.Sprint(port)}, nil)
+		s := NewServer(nil, argoDB, newEnforcer())
+
+		_, err := s.ScanHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{HostName: "127.0.0.1", Port: int32(port)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Invalid hostname", func(t *testing.T) {
+		s := NewServer(nil, &dbmocks.ArgoDB{}, newEnforcer())
+		_, err := s.ScanHostKeys(context.Background(), &certificatepkg.SSHHostKeyScanRequest{HostName: "invalid host"})
+		assert.Error(t, err)
+	})
+}
@@ -22,23 +22,26 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/clusterauth"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 // Server provides a Cluster service
 type Server struct {
-	db      db.ArgoDB
-	enf     *rbac.Enforcer
-	cache   *servercache.Cache
-	kubectl kube.Kubectl
+	db          db.ArgoDB
+	enf         *rbac.Enforcer
+	cache       *servercache.Cache
+	kubectl     kube.Kubectl
+	settingsMgr *settings.SettingsManager
 }
 
 // NewServer returns a new instance of the Cluster service
-func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache, kubectl kube.Kubectl) *Server {
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache, kubectl kube.Kubectl, settingsMgr *settings.SettingsManager) *Server {
 	return &Server{
-		db:      db,
-		enf:     enf,
-		cache:   cache,
-		kubectl: kubectl,
+		db:          db,
+		enf:         enf,
+		cache:       cache,
+		kubectl:     kubectl,
+		settingsMgr: settingsMgr,
 	}
 }
 
@@ -56,8 +59,15 @@ func (s *Server) List(ctx context.Context, q *cluster.ClusterQuery) (*appv1.Clus
 		return nil, err
 	}
 
+	ids := make(map[string]bool)
+	for _, id := range q.ExternalIDs {
+		ids[id] = true
+	}
 	items := make([]appv1.Cluster, 0)
 	for _, clust := range clusterList.Items {
+		if len(ids) > 0 && !ids[clust.ExternalID()] {
+			continue
+		}
 		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionGet, createRBACObject(clust.Project, clust.Server)) {
 			items = append(items, clust)
 		}
@@ -132,6 +142,23 @@ func (s *Server) Get(ctx context.Context, q *cluster.ClusterQuery) (*appv1.Clust
 	return s.toAPIResponse(c), nil
 }
 
+// GetByID returns a cluster by its external ID
+func (s *Server) GetByID(ctx context.Context, q *cluster.ClusterByIDQuery) (*appv1.Cluster, error) {
+	if !appv1.IsExternalIDOf(appv1.ExternalIDPrefixCluster, q.Id) {
+		return nil, status.Errorf(codes.InvalidArgument, "'%s' is not the ID of a cluster", q.Id)
+	}
+	clusterList, err := s.db.ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range clusterList.Items {
+		if c.ExternalID() == q.Id {
+			return s.Get(ctx, &cluster.ClusterQuery{Server: c.Server})
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "cluster with ID '%s' not found", q.Id)
+}
+
 func (s *Server) getClusterWith403IfNotExist(ctx context.Context, q *cluster.ClusterQuery) (*appv1.Cluster, error) {
 	repo, err := s.getCluster(ctx, q)
 	if err != nil || repo == nil {
@@ -207,6 +234,9 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"project": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Project = existing.Project
 	},
+	"maxConcurrentSyncs": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.MaxConcurrentSyncs = existing.MaxConcurrentSyncs
+	},
 }
 
 // Update updates a cluster
@@ -415,3 +445,30 @@ func (s *Server) InvalidateCache(ctx context.Context, q *cluster.ClusterQuery) (
 	}
 	return s.toAPIResponse(cls), nil
 }
+
+// ListAPIResources returns the API resources discovered on the cluster by the application controller
+func (s *Server) ListAPIResources(ctx context.Context, q *cluster.ClusterQuery) (*appv1.ClusterAPIResourceList, error) {
+	c, err := s.getClusterWith403IfNotExist(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionGet, createRBACObject(c.Project, c.Server)); err != nil {
+		return nil, err
+	}
+
+	var resources appv1.ClusterAPIResourceList
+	if err := s.cache.GetClusterAPIResources(c.Server, &resources); err != nil {
+		if err == servercache.ErrCacheMiss {
+			return nil, status.Errorf(codes.Unavailable, "the API resources of cluster '%s' have not been discovered yet: the cluster may not be monitored by the application controller", c.Server)
+		}
+		return nil, err
+	}
+	filter, err := s.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, err
+	}
+	for i := range resources.Items {
+		resources.Items[i].Excluded = filter.IsExcludedClusterResource(resources.Items[i].Group, resources.Items[i].Kind, c)
+	}
+	return &resources, nil
+}
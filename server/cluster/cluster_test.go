@@ -15,6 +15,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/pointer"
 
@@ -66,7 +68,7 @@ func TestGetCluster_UrlEncodedName(t *testing.T) {
 
 	db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	cluster, err := server.Get(context.Background(), &clusterapi.ClusterQuery{
 		Id: &clusterapi.ClusterID{
@@ -96,7 +98,7 @@ func TestGetCluster_NameWithUrlEncodingButShouldNotBeUnescaped(t *testing.T) {
 
 	db.On("ListClusters", mock.Anything).Return(&mockClusterList, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	cluster, err := server.Get(context.Background(), &clusterapi.ClusterQuery{
 		Id: &clusterapi.ClusterID{
@@ -109,6 +111,38 @@ func TestGetCluster_NameWithUrlEncodingButShouldNotBeUnescaped(t *testing.T) {
 	assert.Equal(t, cluster.Name, "test%2fing")
 }
 
+func TestGetClusterByID(t *testing.T) {
+	db := &dbmocks.ArgoDB{}
+
+	mockCluster := v1alpha1.Cluster{
+		Name:   "production",
+		Server: "https://127.0.0.1",
+	}
+	other := v1alpha1.Cluster{
+		Name:   "staging",
+		Server: "https://127.0.0.2",
+	}
+	db.On("ListClusters", mock.Anything).Return(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{mockCluster, other}}, nil)
+	db.On("GetCluster", mock.Anything, mockCluster.Server).Return(&mockCluster, nil)
+
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
+
+	cluster, err := server.GetByID(context.Background(), &clusterapi.ClusterByIDQuery{Id: mockCluster.ExternalID()})
+	require.NoError(t, err)
+	assert.Equal(t, "production", cluster.Name)
+
+	_, err = server.GetByID(context.Background(), &clusterapi.ClusterByIDQuery{Id: "cluster-unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.GetByID(context.Background(), &clusterapi.ClusterByIDQuery{Id: "repo-unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	list, err := server.List(context.Background(), &clusterapi.ClusterQuery{ExternalIDs: []string{other.ExternalID()}})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "staging", list.Items[0].Name)
+}
+
 func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 	db := &dbmocks.ArgoDB{}
 	var updated *v1alpha1.Cluster
@@ -132,7 +166,7 @@ func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 		return true
 	})).Return(&v1alpha1.Cluster{}, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	_, err := server.Update(context.Background(), &clusterapi.ClusterUpdateRequest{
 		Cluster: &v1alpha1.Cluster{
@@ -160,7 +194,7 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 		return true
 	})).Return(&v1alpha1.Cluster{}, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	_, err := server.Update(context.Background(), &clusterapi.ClusterUpdateRequest{
 		Cluster: &v1alpha1.Cluster{
@@ -245,7 +279,7 @@ func TestDeleteClusterByName(t *testing.T) {
 		},
 	})
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	t.Run("Delete Fails When Deleting by Unknown Name", func(t *testing.T) {
 		_, err := server.Delete(context.Background(), &clusterapi.ClusterQuery{
@@ -324,7 +358,7 @@ func TestRotateAuth(t *testing.T) {
 		})
 
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, nil)
 
 	t.Run("RotateAuth by Unknown Name", func(t *testing.T) {
 		_, err := server.RotateAuth(context.Background(), &clusterapi.ClusterQuery{
@@ -356,6 +390,51 @@ func TestRotateAuth(t *testing.T) {
 	})
 }
 
+func TestListAPIResources(t *testing.T) {
+	testNamespace := "default"
+	clientset := getClientset(map[string]string{
+		"resource.exclusions": `
+- apiGroups: ["cilium.io"]
+  kinds: ["*"]
+  clusters: ["*"]`,
+	}, testNamespace)
+	settingsMgr := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	db := db.NewDB(testNamespace, settingsMgr, clientset)
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{Server: "https://my-cluster", Name: "my-cluster"})
+	require.NoError(t, err)
+
+	appStateCache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Hour)), 1*time.Minute)
+	server := NewServer(db, newNoopEnforcer(), servercache.NewCache(appStateCache, 1*time.Minute, 1*time.Minute, 1*time.Minute), &kubetest.MockKubectlCmd{}, settingsMgr)
+
+	t.Run("NotDiscovered", func(t *testing.T) {
+		_, err := server.ListAPIResources(context.Background(), &clusterapi.ClusterQuery{Name: "my-cluster"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Discovered", func(t *testing.T) {
+		now := metav1.Now()
+		require.NoError(t, appStateCache.SetClusterAPIResources("https://my-cluster", &v1alpha1.ClusterAPIResourceList{
+			Items: []v1alpha1.ClusterAPIResource{
+				{Group: "apps", Version: "v1", Kind: "Deployment", Name: "deployments", Namespaced: true},
+				{Group: "cilium.io", Version: "v2", Kind: "CiliumIdentity", Name: "ciliumidentities"},
+			},
+			LastDiscoveryTime: &now,
+		}))
+
+		resources, err := server.ListAPIResources(context.Background(), &clusterapi.ClusterQuery{Name: "my-cluster"})
+		require.NoError(t, err)
+		require.Len(t, resources.Items, 2)
+		assert.False(t, resources.Items[0].Excluded)
+		assert.True(t, resources.Items[1].Excluded)
+		assert.NotNil(t, resources.LastDiscoveryTime)
+	})
+
+	t.Run("UnknownCluster", func(t *testing.T) {
+		_, err := server.ListAPIResources(context.Background(), &clusterapi.ClusterQuery{Name: "foo"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
 func getClientset(config map[string]string, ns string, objects ...runtime.Object) *fake.Clientset {
 	secret := corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
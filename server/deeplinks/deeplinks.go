@@ -10,9 +10,58 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
+const (
+	// ResourceDeepLinkKey is the key of the resource in the data the resource links are evaluated with
+	ResourceDeepLinkKey = "resource"
+	// AppDeepLinkKey is the key of the application in the data the application and resource links are evaluated with
+	AppDeepLinkKey = "app"
+	// ProjectDeepLinkKey is the key of the project in the data the application and resource links are evaluated with
+	ProjectDeepLinkKey = "project"
+	// ClusterDeepLinkKey is the key of the destination cluster in the data the application and resource links are
+	// evaluated with
+	ClusterDeepLinkKey = "cluster"
+)
+
+// CreateDeepLinksObject returns the data the templates and conditions of deep links are evaluated with. The fields of the
+// object the links are displayed for are available at the top level, so that the links can refer to them directly, and
+// the resource, application, project and cluster of the links under their key, unless the object has a field with the
+// same name. Only the name, server, project, labels and annotations of the cluster are available.
+func CreateDeepLinksObject(obj *unstructured.Unstructured, resource *unstructured.Unstructured, app *unstructured.Unstructured, project *unstructured.Unstructured, cluster *v1alpha1.Cluster) unstructured.Unstructured {
+	data := map[string]interface{}{}
+	for k, v := range obj.Object {
+		data[k] = v
+	}
+	related := map[string]interface{}{}
+	if resource != nil {
+		related[ResourceDeepLinkKey] = resource.Object
+	}
+	if app != nil {
+		related[AppDeepLinkKey] = app.Object
+	}
+	if project != nil {
+		related[ProjectDeepLinkKey] = project.Object
+	}
+	if cluster != nil {
+		related[ClusterDeepLinkKey] = map[string]interface{}{
+			"name":        cluster.Name,
+			"server":      cluster.Server,
+			"project":     cluster.Project,
+			"labels":      cluster.Labels,
+			"annotations": cluster.Annotations,
+		}
+	}
+	for k, v := range related {
+		if _, ok := data[k]; !ok {
+			data[k] = v
+		}
+	}
+	return unstructured.Unstructured{Object: data}
+}
+
 func EvaluateDeepLinksResponse(obj unstructured.Unstructured, links []settings.DeepLink) (*application.LinksResponse, []string) {
 	finalLinks := []*application.LinkInfo{}
 	errors := []string{}
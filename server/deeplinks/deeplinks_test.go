@@ -99,3 +99,51 @@ func TestDeepLinks(t *testing.T) {
 		assert.Equal(t, reflect.DeepEqual(output.Items, tc.outputLinks), true)
 	}
 }
+
+func TestCreateDeepLinksObject(t *testing.T) {
+	resourceObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "guestbook-ui", "namespace": "guestbook"},
+		// a field of the resource takes precedence over the related objects
+		"project": "resource-field",
+	}}
+	appObj, err := kube.ToUnstructured(&v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+	})
+	assert.NoError(t, err)
+	projObj, err := kube.ToUnstructured(&v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+	assert.NoError(t, err)
+	cluster := &v1alpha1.Cluster{
+		Name:   "production",
+		Server: "https://production.example.com",
+		Labels: map[string]string{"region": "eu"},
+		Config: v1alpha1.ClusterConfig{BearerToken: "secret"},
+	}
+
+	obj := CreateDeepLinksObject(resourceObj, resourceObj, appObj, projObj, cluster)
+	assert.Equal(t, "guestbook-ui", obj.GetName())
+	assert.Equal(t, "resource-field", obj.Object[ProjectDeepLinkKey])
+	assert.NotContains(t, obj.Object[ClusterDeepLinkKey], "config")
+
+	output, errs := EvaluateDeepLinksResponse(obj, []settings.DeepLink{{
+		Title:     "logs",
+		URL:       "https://logs.example.com?app={{ .app.metadata.name }}&cluster={{ .cluster.name }}&region={{ .cluster.labels.region }}&name={{ .metadata.name }}&kind={{ .resource.kind }}",
+		Condition: pointer.String(`kind == "Deployment" && app.spec.project == "default"`),
+	}, {
+		Title:     "hidden",
+		URL:       "https://example.com",
+		Condition: pointer.String(`resource.kind == "Pod"`),
+	}})
+	assert.Empty(t, errs)
+	assert.Equal(t, []*application.LinkInfo{{
+		Title: pointer.String("logs"),
+		Url:   pointer.String("https://logs.example.com?app=guestbook&cluster=production&region=eu&name=guestbook-ui&kind=Deployment"),
+	}}, output.Items)
+
+	obj = CreateDeepLinksObject(appObj, nil, appObj, projObj, nil)
+	assert.Equal(t, projObj.Object, obj.Object[ProjectDeepLinkKey])
+	assert.NotContains(t, obj.Object, ClusterDeepLinkKey)
+	assert.NotContains(t, obj.Object, ResourceDeepLinkKey)
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	"k8s.io/client-go/tools/cache"
+
+	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	dexutil "github.com/argoproj/argo-cd/v2/util/dex"
+	"github.com/argoproj/argo-cd/v2/util/healthz"
+)
+
+// dependencies returns the services the API server depends on, whose states are reported by the health check endpoint
+func (a *ArgoCDServer) dependencies() []healthz.Dependency {
+	redis := healthz.Dependency{Name: healthz.DependencyRedis, Check: func(ctx context.Context) error {
+		if a.Cache == nil {
+			return nil
+		}
+		return a.Cache.GetCache().CheckConnection()
+	}}
+	dex := healthz.Dependency{Name: healthz.DependencyDex, Check: func(ctx context.Context) error {
+		argoCDSettings, err := a.settingsMgr.GetSettings()
+		if err != nil {
+			return err
+		}
+		// Dex is not a dependency if it is not configured
+		if !argoCDSettings.IsDexConfigured() {
+			return nil
+		}
+		return dexutil.CheckDiscovery(ctx, a.DexServerAddr, a.DexTLSConfig, argoCDSettings.IssuerURL())
+	}}
+	informers := healthz.InformersDependency(map[string]cache.InformerSynced{
+		"applications": a.appInformer.HasSynced,
+		"appprojects":  a.projInformer.HasSynced,
+		"configmaps":   a.configMapInformer.HasSynced,
+		"secrets":      a.secretInformer.HasSynced,
+	})
+	repoServer := healthz.Dependency{Name: healthz.DependencyRepoServer, Check: func(ctx context.Context) error {
+		return repoapiclient.CheckConnection(ctx, a.RepoClientset)
+	}}
+	return []healthz.Dependency{redis, dex, healthz.KubernetesDependency(a.KubeClientset.Discovery()), informers, repoServer}
+}
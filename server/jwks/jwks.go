@@ -0,0 +1,49 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// cacheMaxAge is the number of seconds the clients may cache the key set. The clients are expected to refetch it when
+// they see a token signed with an unknown key, e.g. right after a rotation.
+const cacheMaxAge = "300"
+
+// NewHandler creates the handler publishing the public keys verifying the tokens issued by Argo CD, as a JSON Web Key
+// Set, so that other services can verify the tokens without sharing a secret with Argo CD
+func NewHandler(settingsMgr *settings.SettingsManager) *Handler {
+	return &Handler{getKeySet: settingsMgr.GetTokenVerificationKeySet}
+}
+
+// Handler serves the JSON Web Key Set of the keys signing the tokens
+type Handler struct {
+	getKeySet func() (*jose.JSONWebKeySet, error)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	keySet, err := h.getKeySet()
+	if err != nil {
+		log.Errorf("Failed to get token verification keys: %v", err)
+		http.Error(w, "Failed to get token verification keys", http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(keySet)
+	if err != nil {
+		log.Errorf("Failed to marshal token verification keys: %v", err)
+		http.Error(w, "Failed to marshal token verification keys", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age="+cacheMaxAge)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,46 @@
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+)
+
+func TestHandler(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	handler := &Handler{getKeySet: func() (*jose.JSONWebKeySet, error) {
+		return &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: privateKey.Public(), KeyID: "key", Algorithm: "ES256", Use: "sig"}}}, nil
+	}}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	var keySet jose.JSONWebKeySet
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &keySet))
+	keys := keySet.Key("key")
+	require.Len(t, keys, 1)
+	assert.True(t, keys[0].IsPublic())
+	assert.Equal(t, privateKey.Public(), keys[0].Key)
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/.well-known/jwks.json", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+
+	handler.getKeySet = func() (*jose.JSONWebKeySet, error) {
+		return nil, errors.New("unavailable")
+	}
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	httputil "github.com/argoproj/argo-cd/v2/util/http"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
@@ -40,6 +39,14 @@ type Handler struct {
 	verifyToken  func(tokenString string) (jwt.Claims, string, error)
 	revokeToken  func(ctx context.Context, id string, expiringAt time.Duration) error
 	baseHRef     string
+	// cookieOptions are the attributes of the cookies holding the token, which are cleared on logout
+	cookieOptions httputil.CookieOptions
+}
+
+// WithCookieOptions sets the attributes of the cookies holding the token
+func (h *Handler) WithCookieOptions(options httputil.CookieOptions) *Handler {
+	h.cookieOptions = options
+	return h
 }
 
 var (
@@ -76,7 +83,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	logoutRedirectURL := strings.TrimRight(strings.TrimLeft(argoURL, "/"), "/")
 
 	cookies := r.Cookies()
-	tokenString, err = httputil.JoinCookies(common.AuthCookieName, cookies)
+	tokenString, err = httputil.JoinCookies(h.cookieOptions.CookieName(), cookies)
 	if tokenString == "" || err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		http.Error(w, "Failed to retrieve ArgoCD auth token: "+fmt.Sprintf("%s", err), http.StatusBadRequest)
@@ -84,13 +91,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	for _, cookie := range cookies {
-		if !strings.HasPrefix(cookie.Name, common.AuthCookieName) {
+		if !strings.HasPrefix(cookie.Name, h.cookieOptions.CookieName()) {
 			continue
 		}
 
 		argocdCookie := http.Cookie{
-			Name:  cookie.Name,
-			Value: "",
+			Name:   cookie.Name,
+			Value:  "",
+			Domain: h.cookieOptions.Domain,
 		}
 
 		argocdCookie.Path = fmt.Sprintf("/%s", strings.TrimRight(strings.TrimLeft(h.baseHRef, "/"), "/"))
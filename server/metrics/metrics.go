@@ -8,14 +8,24 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
 
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/healthz"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 	"github.com/argoproj/argo-cd/v2/util/profile"
 )
 
 type MetricsServer struct {
 	*http.Server
-	redisRequestCounter   *prometheus.CounterVec
-	redisRequestHistogram *prometheus.HistogramVec
+	redisRequestCounter       *prometheus.CounterVec
+	redisRequestHistogram     *prometheus.HistogramVec
+	repoConnectionStatusGauge *prometheus.GaugeVec
+	repoLastSuccessGauge      *prometheus.GaugeVec
+	apiRequestCounter         *prometheus.CounterVec
+	dependencyReadyGauge      *prometheus.GaugeVec
+	// dropLabels designates the labels dropped from the exported series
+	dropLabels []metricsutil.LabelRule
 }
 
 var (
@@ -34,29 +44,88 @@ var (
 		},
 		[]string{"initiator"},
 	)
+	repoConnectionStatusGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_connection_status",
+			Help: "Result of the last connection check of a repository. 1 if successful, 0 if failed.",
+		},
+		[]string{"repo"},
+	)
+	repoLastSuccessGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_connection_last_success_timestamp_seconds",
+			Help: "Unix timestamp of the last successful connection check of a repository.",
+		},
+		[]string{"repo"},
+	)
+	apiRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_api_request_total",
+			Help: "Number of requests made to the REST API by version.",
+		},
+		[]string{"version"},
+	)
+	dependencyReadyGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_dependency_ready",
+			Help: "Whether a dependency of the API server was ready at its last check (1) or not (0).",
+		},
+		[]string{"dependency", "requirement"},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects api server metrics
 func NewMetricsServer(host string, port int) *MetricsServer {
 	mux := http.NewServeMux()
 	registry := prometheus.NewRegistry()
-	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.Gatherers{
+	m := &MetricsServer{}
+	gatherers := prometheus.Gatherers{
 		registry,
 		prometheus.DefaultGatherer,
-	}, promhttp.HandlerOpts{}))
+	}
+	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := gatherers.Gather()
+		return metricsutil.DropLabels(families, m.dropLabels), err
+	}), promhttp.HandlerOpts{}))
 	profile.RegisterProfiler(mux)
 
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
+	registry.MustRegister(repoConnectionStatusGauge)
+	registry.MustRegister(repoLastSuccessGauge)
+	registry.MustRegister(apiRequestCounter)
+	registry.MustRegister(dependencyReadyGauge)
 
-	return &MetricsServer{
+	*m = MetricsServer{
 		Server: &http.Server{
 			Addr:    fmt.Sprintf("%s:%d", host, port),
 			Handler: mux,
 		},
-		redisRequestCounter:   redisRequestCounter,
-		redisRequestHistogram: redisRequestHistogram,
+		redisRequestCounter:       redisRequestCounter,
+		redisRequestHistogram:     redisRequestHistogram,
+		repoConnectionStatusGauge: repoConnectionStatusGauge,
+		repoLastSuccessGauge:      repoLastSuccessGauge,
+		apiRequestCounter:         apiRequestCounter,
+		dependencyReadyGauge:      dependencyReadyGauge,
+	}
+	return m
+}
+
+// SetAuth requires the requests made to the metrics server to be authorized as configured by the given options
+func (m *MetricsServer) SetAuth(auth *metricsutil.AuthOptions) error {
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		return err
 	}
+	m.TLSConfig = tlsConfig
+	m.Handler = auth.Handler(m.Handler)
+	return nil
+}
+
+// SetDropLabels drops the labels designated by the given rules from the exported series, aggregating the series left
+// with the same labels
+func (m *MetricsServer) SetDropLabels(rules []metricsutil.LabelRule) {
+	m.dropLabels = rules
 }
 
 func (m *MetricsServer) IncRedisRequest(failed bool) {
@@ -67,3 +136,35 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-server").Observe(duration.Seconds())
 }
+
+// SetRepoConnectionState records the result of a repository connection check
+func (m *MetricsServer) SetRepoConnectionState(repo string, state appv1.ConnectionState) {
+	status := 0.0
+	if state.Status == appv1.ConnectionStatusSuccessful {
+		status = 1
+	}
+	m.repoConnectionStatusGauge.WithLabelValues(repo).Set(status)
+	if state.LastSuccessfulAt != nil {
+		m.repoLastSuccessGauge.WithLabelValues(repo).Set(float64(state.LastSuccessfulAt.Unix()))
+	}
+}
+
+// DeleteRepoConnectionState removes the connection check metrics of a repository which is not configured anymore
+func (m *MetricsServer) DeleteRepoConnectionState(repo string) {
+	m.repoConnectionStatusGauge.DeleteLabelValues(repo)
+	m.repoLastSuccessGauge.DeleteLabelValues(repo)
+}
+
+// IncAPIRequest increments the number of requests made to the given version of the REST API
+func (m *MetricsServer) IncAPIRequest(version string) {
+	m.apiRequestCounter.WithLabelValues(version).Inc()
+}
+
+// SetDependencyState records the state of a dependency of the API server
+func (m *MetricsServer) SetDependencyState(state healthz.DependencyState) {
+	ready := 0.0
+	if state.Ready {
+		ready = 1
+	}
+	m.dependencyReadyGauge.WithLabelValues(state.Name, string(state.Requirement)).Set(ready)
+}
@@ -4,19 +4,27 @@ import (
 	"context"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/security"
 	"github.com/argoproj/notifications-engine/pkg/api"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 )
 
 // Server provides an Application service
 type Server struct {
-	apiFactory api.Factory
+	apiFactory    api.Factory
+	deliveryStore *delivery.Store
+	enf           *rbac.Enforcer
+	ns            string
 }
 
 // NewServer returns a new instance of the Application service
-func NewServer(apiFactory api.Factory) notification.NotificationServiceServer {
-	s := &Server{apiFactory: apiFactory}
+func NewServer(apiFactory api.Factory, deliveryStore *delivery.Store, enf *rbac.Enforcer, namespace string) notification.NotificationServiceServer {
+	s := &Server{apiFactory: apiFactory, deliveryStore: deliveryStore, enf: enf, ns: namespace}
 	return s
 }
 
@@ -66,3 +74,44 @@ func (s *Server) ListTemplates(ctx context.Context, q *notification.TemplatesLis
 	}
 	return &notification.TemplateList{Items: templates}, nil
 }
+
+// ListDeliveries returns list of recent notification deliveries of the applications the user is allowed to get
+func (s *Server) ListDeliveries(ctx context.Context, q *notification.DeliveriesListRequest) (*notification.DeliveryList, error) {
+	deliveries, err := s.deliveryStore.List()
+	if err != nil {
+		return nil, err
+	}
+	items := []*notification.Delivery{}
+	for _, d := range deliveries {
+		if q.AppName != nil && *q.AppName != d.Application ||
+			q.AppNamespace != nil && *q.AppNamespace != d.AppNamespace ||
+			q.Project != nil && *q.Project != d.Project ||
+			q.Trigger != nil && *q.Trigger != d.Trigger ||
+			q.GetFailedOnly() && d.Succeeded {
+			continue
+		}
+		if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, security.AppRBACName(s.ns, d.Project, d.AppNamespace, d.Application)) {
+			continue
+		}
+		items = append(items, toDelivery(d))
+	}
+	return &notification.DeliveryList{Items: items}, nil
+}
+
+func toDelivery(d delivery.Delivery) *notification.Delivery {
+	return &notification.Delivery{
+		Id:           pointer.String(d.ID),
+		Application:  pointer.String(d.Application),
+		AppNamespace: pointer.String(d.AppNamespace),
+		Project:      pointer.String(d.Project),
+		Trigger:      pointer.String(d.Trigger),
+		Service:      pointer.String(d.Service),
+		Recipient:    pointer.String(d.Recipient),
+		Templates:    d.Templates,
+		Attempts:     pointer.Int64(int64(d.Attempts)),
+		Succeeded:    pointer.Bool(d.Succeeded),
+		Retrying:     pointer.Bool(d.Retrying),
+		Error:        pointer.String(d.Error),
+		Time:         &v1.Time{Time: d.Time},
+	}
+}
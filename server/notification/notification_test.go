@@ -3,14 +3,21 @@ package notification
 import (
 	"context"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/notification"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
 	"github.com/argoproj/argo-cd/v2/util/notification/settings"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -71,9 +78,15 @@ func TestNotificationServer(t *testing.T) {
 	require.NoError(t, err)
 	defer argocdService.Close()
 	apiFactory := api.NewFactory(settings.GetFactorySettings(argocdService, "argocd-notifications-secret", "argocd-notifications-cm"), testNamespace, secretInformer, configMapInformer)
+	deliveryStore := delivery.NewStore(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)))
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		// the user is only allowed to get the applications of the prod project
+		return strings.HasPrefix(rvals[3].(string), "prod/")
+	})
 
 	t.Run("TestListServices", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, deliveryStore, enforcer, testNamespace)
 		services, err := server.ListServices(ctx, &notification.ServicesListRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, services.Items, 1)
@@ -81,7 +94,7 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, services.Items[0])
 	})
 	t.Run("TestListTriggers", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, deliveryStore, enforcer, testNamespace)
 		triggers, err := server.ListTriggers(ctx, &notification.TriggersListRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, triggers.Items, 1)
@@ -89,11 +102,43 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, triggers.Items[0])
 	})
 	t.Run("TestListTemplates", func(t *testing.T) {
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, deliveryStore, enforcer, testNamespace)
 		templates, err := server.ListTemplates(ctx, &notification.TemplatesListRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, templates.Items, 1)
 		assert.Equal(t, templates.Items[0].Name, pointer.String("app-created"))
 		assert.NotEmpty(t, templates.Items[0])
 	})
+	t.Run("TestListDeliveries", func(t *testing.T) {
+		require.NoError(t, deliveryStore.Record(delivery.Delivery{ID: "1", Application: "guestbook", Project: "prod", Trigger: "on-deployed", Service: "slack", Recipient: "prod-deploys", Attempts: 3, Error: "timeout"}))
+		require.NoError(t, deliveryStore.Record(delivery.Delivery{ID: "2", Application: "guestbook", Project: "prod", Trigger: "on-sync-failed", Service: "slack", Recipient: "prod-deploys", Attempts: 1, Succeeded: true}))
+		require.NoError(t, deliveryStore.Record(delivery.Delivery{ID: "3", Application: "staging-app", Project: "staging", Trigger: "on-deployed", Service: "slack", Recipient: "staging-deploys", Attempts: 1, Succeeded: true}))
+		server := NewServer(apiFactory, deliveryStore, enforcer, testNamespace)
+		ctx := context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+
+		deliveries, err := server.ListDeliveries(ctx, &notification.DeliveriesListRequest{})
+		require.NoError(t, err)
+		require.Len(t, deliveries.Items, 2)
+		assert.Equal(t, "2", deliveries.Items[0].GetId())
+		assert.Equal(t, "1", deliveries.Items[1].GetId())
+		assert.Equal(t, "guestbook", deliveries.Items[1].GetApplication())
+		assert.Equal(t, "on-deployed", deliveries.Items[1].GetTrigger())
+		assert.Equal(t, int64(3), deliveries.Items[1].GetAttempts())
+		assert.False(t, deliveries.Items[1].GetSucceeded())
+		assert.Equal(t, "timeout", deliveries.Items[1].GetError())
+
+		deliveries, err = server.ListDeliveries(ctx, &notification.DeliveriesListRequest{FailedOnly: pointer.Bool(true)})
+		require.NoError(t, err)
+		require.Len(t, deliveries.Items, 1)
+		assert.Equal(t, "1", deliveries.Items[0].GetId())
+
+		deliveries, err = server.ListDeliveries(ctx, &notification.DeliveriesListRequest{Trigger: pointer.String("on-sync-failed")})
+		require.NoError(t, err)
+		require.Len(t, deliveries.Items, 1)
+		assert.Equal(t, "2", deliveries.Items[0].GetId())
+
+		deliveries, err = server.ListDeliveries(ctx, &notification.DeliveriesListRequest{AppName: pointer.String("staging-app")})
+		require.NoError(t, err)
+		assert.Empty(t, deliveries.Items)
+	})
 }
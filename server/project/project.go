@@ -2,9 +2,12 @@ package project
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/sync"
@@ -17,20 +20,25 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	listersv1alpha1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/deeplinks"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	argokube "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
@@ -54,14 +62,15 @@ type Server struct {
 	projInformer  cache.SharedIndexInformer
 	settingsMgr   *settings.SettingsManager
 	db            db.ArgoDB
+	cache         *servercache.Cache
 }
 
 // NewServer returns a new instance of the Project service
 func NewServer(ns string, kubeclientset kubernetes.Interface, appclientset appclientset.Interface, enf *rbac.Enforcer, projectLock sync.KeyLock, sessionMgr *session.SessionManager, policyEnf *rbacpolicy.RBACPolicyEnforcer,
-	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB) *Server {
+	projInformer cache.SharedIndexInformer, settingsMgr *settings.SettingsManager, db db.ArgoDB, cache *servercache.Cache) *Server {
 	auditLogger := argo.NewAuditLogger(ns, kubeclientset, "argocd-server")
 	return &Server{enf: enf, policyEnf: policyEnf, appclientset: appclientset, kubeclientset: kubeclientset, ns: ns, projectLock: projectLock, auditLogger: auditLogger, sessionMgr: sessionMgr,
-		projInformer: projInformer, settingsMgr: settingsMgr, db: db}
+		projInformer: projInformer, settingsMgr: settingsMgr, db: db, cache: cache}
 }
 
 func validateProject(proj *v1alpha1.AppProject) error {
@@ -281,8 +290,15 @@ func (s *Server) List(ctx context.Context, q *project.ProjectQuery) (*v1alpha1.A
 	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(ctx, metav1.ListOptions{})
 	if list != nil {
 		newItems := make([]v1alpha1.AppProject, 0)
+		ids := make(map[string]bool)
+		for _, id := range q.ExternalIDs {
+			ids[id] = true
+		}
 		for i := range list.Items {
 			project := list.Items[i]
+			if len(ids) > 0 && !ids[project.ExternalID()] {
+				continue
+			}
 			if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, project.Name) {
 				newItems = append(newItems, project)
 			}
@@ -325,6 +341,23 @@ func (s *Server) Get(ctx context.Context, q *project.ProjectQuery) (*v1alpha1.Ap
 	return proj, err
 }
 
+// GetByID returns a project by its external ID
+func (s *Server) GetByID(ctx context.Context, q *project.ProjectByIDQuery) (*v1alpha1.AppProject, error) {
+	if !v1alpha1.IsExternalIDOf(v1alpha1.ExternalIDPrefixProject, q.Id) {
+		return nil, status.Errorf(codes.InvalidArgument, "'%s' is not the ID of a project", q.Id)
+	}
+	list, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		if list.Items[i].ExternalID() == q.Id {
+			return s.Get(ctx, &project.ProjectQuery{Name: list.Items[i].Name})
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "project with ID '%s' not found", q.Id)
+}
+
 // GetGlobalProjects returns global projects
 func (s *Server) GetGlobalProjects(ctx context.Context, q *project.ProjectQuery) (*project.GlobalProjectsResponse, error) {
 	projOrig, err := s.Get(ctx, q)
@@ -347,38 +380,93 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Project.Name); err != nil {
 		return nil, err
 	}
-	q.Project.NormalizePolicies()
-	q.Project.NormalizeJWTTokens()
-	err := validateProject(q.Project)
+	return s.validateAndUpdateProject(ctx, q.Project, false)
+}
+
+// Patch patches a project
+func (s *Server) Patch(ctx context.Context, q *project.ProjectPatchRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Name); err != nil {
+		return nil, err
+	}
+	// the patch is applied again to the latest version of the project if it is modified concurrently, unless the patch
+	// is only to be applied to a given version
+	for i := 0; i < 10; i++ {
+		proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if q.ResourceVersion != "" && q.ResourceVersion != proj.ResourceVersion {
+			return nil, status.Errorf(codes.FailedPrecondition, "project '%s' has been modified: its resource version is %s instead of %s", q.Name, proj.ResourceVersion, q.ResourceVersion)
+		}
+		jsonProj, err := json.Marshal(proj)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling project: %w", err)
+		}
+		patchProj, err := argokube.ApplyPatch(jsonProj, q.Patch, q.PatchType, v1alpha1.AppProject{})
+		if err != nil {
+			var unsupportedErr *argokube.UnsupportedPatchTypeError
+			if errors.As(err, &unsupportedErr) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, err
+		}
+		newProj := &v1alpha1.AppProject{}
+		if err := json.Unmarshal(patchProj, newProj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling patched project: %w", err)
+		}
+		// the project is identified by the request, and the patch is applied to the version which has been read
+		newProj.Name = proj.Name
+		newProj.Namespace = proj.Namespace
+		newProj.ResourceVersion = proj.ResourceVersion
+
+		res, err := s.validateAndUpdateProject(ctx, newProj, q.DryRun)
+		if apierr.IsConflict(err) {
+			if q.ResourceVersion != "" {
+				return nil, status.Errorf(codes.FailedPrecondition, "project '%s' has been modified: %v", q.Name, err)
+			}
+			continue
+		}
+		return res, err
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to patch project. Too many conflicts")
+}
+
+// validateAndUpdateProject validates the new version of a project, along with the permissions required by its changes
+// and their impact on the applications of the project, and updates it. The project is not updated in dry-run mode, in
+// which case the validated project is returned.
+func (s *Server) validateAndUpdateProject(ctx context.Context, proj *v1alpha1.AppProject, dryRun bool) (*v1alpha1.AppProject, error) {
+	proj.NormalizePolicies()
+	proj.NormalizeJWTTokens()
+	err := validateProject(proj)
 	if err != nil {
 		return nil, err
 	}
-	s.projectLock.Lock(q.Project.Name)
-	defer s.projectLock.Unlock(q.Project.Name)
+	s.projectLock.Lock(proj.Name)
+	defer s.projectLock.Unlock(proj.Name)
 
-	oldProj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project.Name, metav1.GetOptions{})
+	oldProj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, proj.Name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, cluster := range difference(q.Project.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
+	for _, cluster := range difference(proj.Spec.DestinationClusters(), oldProj.Spec.DestinationClusters()) {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
 			return nil, err
 		}
 	}
 
-	for _, repoUrl := range difference(q.Project.Spec.SourceRepos, oldProj.Spec.SourceRepos) {
+	for _, repoUrl := range difference(proj.Spec.SourceRepos, oldProj.Spec.SourceRepos) {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionUpdate, repoUrl); err != nil {
 			return nil, err
 		}
 	}
 
-	clusterResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceWhitelist, oldProj.Spec.ClusterResourceWhitelist)
-	clusterResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.ClusterResourceBlacklist, oldProj.Spec.ClusterResourceBlacklist)
-	namespacesResourceBlacklistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceBlacklist, oldProj.Spec.NamespaceResourceBlacklist)
-	namespacesResourceWhitelistsEqual := reflect.DeepEqual(q.Project.Spec.NamespaceResourceWhitelist, oldProj.Spec.NamespaceResourceWhitelist)
+	clusterResourceWhitelistsEqual := reflect.DeepEqual(proj.Spec.ClusterResourceWhitelist, oldProj.Spec.ClusterResourceWhitelist)
+	clusterResourceBlacklistsEqual := reflect.DeepEqual(proj.Spec.ClusterResourceBlacklist, oldProj.Spec.ClusterResourceBlacklist)
+	namespacesResourceBlacklistsEqual := reflect.DeepEqual(proj.Spec.NamespaceResourceBlacklist, oldProj.Spec.NamespaceResourceBlacklist)
+	namespacesResourceWhitelistsEqual := reflect.DeepEqual(proj.Spec.NamespaceResourceWhitelist, oldProj.Spec.NamespaceResourceWhitelist)
 	if !clusterResourceWhitelistsEqual || !clusterResourceBlacklistsEqual || !namespacesResourceBlacklistsEqual || !namespacesResourceWhitelistsEqual {
-		for _, cluster := range q.Project.Spec.DestinationClusters() {
+		for _, cluster := range proj.Spec.DestinationClusters() {
 			if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceClusters, rbacpolicy.ActionUpdate, cluster); err != nil {
 				return nil, err
 			}
@@ -396,7 +484,7 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		return s.db.GetProjectClusters(ctx, project)
 	}
 
-	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
+	for _, a := range argo.FilterByProjects(appsList.Items, []string{proj.Name}) {
 		if oldProj.IsSourcePermitted(a.Spec.GetSource()) {
 			srcValidatedApps = append(srcValidatedApps, a)
 		}
@@ -415,12 +503,12 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	invalidDstCount := 0
 
 	for _, a := range srcValidatedApps {
-		if !q.Project.IsSourcePermitted(a.Spec.GetSource()) {
+		if !proj.IsSourcePermitted(a.Spec.GetSource()) {
 			invalidSrcCount++
 		}
 	}
 	for _, a := range dstValidatedApps {
-		dstPermitted, err := q.Project.IsDestinationPermitted(a.Spec.Destination, getProjectClusters)
+		dstPermitted, err := proj.IsDestinationPermitted(a.Spec.Destination, getProjectClusters)
 		if err != nil {
 			return nil, err
 		}
@@ -441,13 +529,151 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "as a result of project update %s", strings.Join(parts, " and "))
 	}
 
-	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, q.Project, metav1.UpdateOptions{})
+	if dryRun {
+		return proj, nil
+	}
+
+	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, proj, metav1.UpdateOptions{})
 	if err == nil {
 		s.logEvent(res, ctx, argo.EventReasonResourceUpdated, "updated project")
 	}
 	return res, err
 }
 
+// SetAutomationPaused pauses or resumes the automated syncs of the applications of a project, or of all the
+// applications if the name is '*'
+func (s *Server) SetAutomationPaused(ctx context.Context, q *project.ProjectAutomationPauseRequest) (*project.ProjectAutomationPauseResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Name); err != nil {
+		return nil, err
+	}
+	if q.Name == "*" {
+		if err := s.settingsMgr.SetAutomationPaused(q.Paused, q.Reason); err != nil {
+			return nil, fmt.Errorf("error updating the global automation pause: %w", err)
+		}
+		paused, reason, err := s.settingsMgr.GetAutomationPaused()
+		if err != nil {
+			return nil, fmt.Errorf("error getting the global automation pause: %w", err)
+		}
+		return &project.ProjectAutomationPauseResponse{Paused: paused, Reason: reason}, nil
+	}
+
+	s.projectLock.Lock(q.Name)
+	defer s.projectLock.Unlock(q.Name)
+
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if proj.Annotations == nil {
+		proj.Annotations = map[string]string{}
+	}
+	delete(proj.Annotations, common.AnnotationKeyAutomationPaused)
+	delete(proj.Annotations, common.AnnotationKeyAutomationPausedReason)
+	if q.Paused {
+		proj.Annotations[common.AnnotationKeyAutomationPaused] = "true"
+		if q.Reason != "" {
+			proj.Annotations[common.AnnotationKeyAutomationPausedReason] = q.Reason
+		}
+	}
+	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, proj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if q.Paused {
+		s.logEvent(res, ctx, argo.EventReasonResourceUpdated, "paused automation")
+	} else {
+		s.logEvent(res, ctx, argo.EventReasonResourceUpdated, "resumed automation")
+	}
+	paused, reason := res.IsAutomationPaused()
+	return &project.ProjectAutomationPauseResponse{Paused: paused, Reason: reason}, nil
+}
+
+// GetUsage returns the usage statistics of a project over a time range
+func (s *Server) GetUsage(ctx context.Context, q *project.ProjectUsageRequest) (*project.ProjectUsageResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	if _, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+
+	to := time.Now()
+	if q.To != "" {
+		t, err := time.Parse(time.RFC3339, q.To)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end of the time range '%s': %v", q.To, err)
+		}
+		to = t
+	}
+	from := to.Add(-30 * 24 * time.Hour)
+	if q.From != "" {
+		t, err := time.Parse(time.RFC3339, q.From)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start of the time range '%s': %v", q.From, err)
+		}
+		from = t
+	}
+	if from.After(to) {
+		return nil, status.Errorf(codes.InvalidArgument, "the start of the time range must be before its end")
+	}
+
+	res := &project.ProjectUsageResponse{From: from.UTC().Format(time.RFC3339), To: to.UTC().Format(time.RFC3339)}
+	if s.cache != nil {
+		usage := make(map[string]float64)
+		for _, metric := range []string{appstatecache.ProjectUsageSyncs, appstatecache.ProjectUsageManifestGenerationSeconds, appstatecache.ProjectUsageAPICalls} {
+			value, err := s.cache.GetProjectUsage(q.Name, metric, from, to)
+			if err != nil {
+				return nil, fmt.Errorf("error getting the %s usage of project %s: %w", metric, q.Name, err)
+			}
+			usage[metric] = value
+		}
+		res.Syncs = int64(usage[appstatecache.ProjectUsageSyncs])
+		res.ManifestGenerationSeconds = usage[appstatecache.ProjectUsageManifestGenerationSeconds]
+		res.ApiCalls = int64(usage[appstatecache.ProjectUsageAPICalls])
+	}
+
+	appsList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Name}) {
+		res.Applications++
+		resources := a.Status.Resources
+		if s.cache != nil {
+			if resources, err = s.cache.GetAppStatusResources(&a, s.ns); err != nil {
+				log.Warn(err)
+			}
+		}
+		res.ManagedResources += int64(len(resources))
+	}
+	return res, nil
+}
+
+// CheckResourcePermission returns whether a resource is permitted to be deployed in a project, along with the rule which
+// decided. The resource lists of the global projects of the project are taken into account.
+func (s *Server) CheckResourcePermission(ctx context.Context, q *project.ProjectResourcePermissionQuery) (*project.ProjectResourcePermissionResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	if q.Kind == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the kind of the resource is required")
+	}
+	proj, err := argo.GetAppProjectByName(q.Name, listersv1alpha1.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "project '%s' not found", q.Name)
+		}
+		return nil, err
+	}
+	permission := proj.CheckResourcePermission(schema.GroupVersionKind{Group: q.Group, Version: q.Version, Kind: q.Kind}, q.Namespaced)
+	return &project.ProjectResourcePermissionResponse{
+		Permitted: permission.Permitted,
+		List:      permission.List,
+		Rule:      permission.Rule,
+		Message:   permission.Message,
+	}, nil
+}
+
 // Delete deletes a project
 func (s *Server) Delete(ctx context.Context, q *project.ProjectQuery) (*project.EmptyResponse, error) {
 	if q.Name == v1alpha1.DefaultAppProjectName {
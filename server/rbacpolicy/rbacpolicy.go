@@ -24,6 +24,7 @@ const (
 	ResourceGPGKeys         = "gpgkeys"
 	ResourceLogs            = "logs"
 	ResourceExec            = "exec"
+	ResourceSettings        = "settings"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -33,6 +34,9 @@ const (
 	ActionSync     = "sync"
 	ActionOverride = "override"
 	ActionAction   = "action"
+	// ActionUpdateParameters allows to update the parameter overrides of applications, e.g. Helm parameters, without
+	// being allowed to update the rest of their spec
+	ActionUpdateParameters = "update/parameters"
 )
 
 var (
@@ -46,6 +50,7 @@ var (
 		ResourceCertificates,
 		ResourceLogs,
 		ResourceExec,
+		ResourceSettings,
 	}
 	Actions = []string{
 		ActionGet,
@@ -54,6 +59,7 @@ var (
 		ActionDelete,
 		ActionSync,
 		ActionOverride,
+		ActionUpdateParameters,
 	}
 )
 
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"net/http"
+
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+)
+
+// readOnlyMethods are the RPCs served by an API server running in read-only mode. Any other RPC, including the ones
+// added in the future, is rejected until it is explicitly listed here.
+var readOnlyMethods = map[string]bool{
+	"/account.AccountService/CanI":                                 true,
+	"/account.AccountService/ListAccounts":                         true,
+	"/account.AccountService/GetAccount":                           true,
+	"/application.ApplicationService/List":                         true,
+	"/application.ApplicationService/ListResourceEvents":           true,
+	"/application.ApplicationService/ListAggregatedResourceEvents": true,
+	"/application.ApplicationService/Watch":                        true,
+	"/application.ApplicationService/Get":                          true,
+	"/application.ApplicationService/GetByID":                      true,
+	"/application.ApplicationService/GetApplicationSyncWindows":    true,
+	"/application.ApplicationService/GetDeletionProgress":          true,
+	"/application.ApplicationService/RevisionMetadata":             true,
+	"/application.ApplicationService/GetManifests":                 true,
+	"/application.ApplicationService/GetManifestsWithFiles":        true,
+	"/application.ApplicationService/RevisionsDiff":                true,
+	"/application.ApplicationService/ManagedResources":             true,
+	"/application.ApplicationService/StreamManagedResources":       true,
+	"/application.ApplicationService/ListResourceStatuses":         true,
+	"/application.ApplicationService/ListDeprecatedAPIs":           true,
+	"/application.ApplicationService/ListGroups":                   true,
+	"/application.ApplicationService/DriftHistory":                 true,
+	"/application.ApplicationService/ResourceTree":                 true,
+	"/application.ApplicationService/StreamResourceTree":           true,
+	"/application.ApplicationService/WatchResourceTree":            true,
+	"/application.ApplicationService/GetResource":                  true,
+	"/application.ApplicationService/ListResourceActions":          true,
+	"/application.ApplicationService/ListRolloutAnalysisRuns":      true,
+	"/application.ApplicationService/PodLogs":                      true,
+	"/application.ApplicationService/ListLinks":                    true,
+	"/application.ApplicationService/ListResourceLinks":            true,
+	"/application.ApplicationService/Doctor":                       true,
+	"/applicationset.ApplicationSetService/Get":                    true,
+	"/applicationset.ApplicationSetService/List":                   true,
+	"/applicationset.ApplicationSetService/GetStatus":              true,
+	"/certificate.CertificateService/ListCertificates":             true,
+	"/cluster.ClusterService/List":                                 true,
+	"/cluster.ClusterService/Get":                                  true,
+	"/cluster.ClusterService/GetByID":                              true,
+	"/cluster.ClusterService/ListAPIResources":                     true,
+	"/cluster.SettingsService/Get":                                 true,
+	"/cluster.SettingsService/GetPlugins":                          true,
+	"/cluster.SettingsService/Validate":                            true,
+	"/cluster.SettingsService/GetFeatureFlags":                     true,
+	"/cluster.SettingsService/GetDexStatus":                        true,
+	"/gpgkey.GPGKeyService/List":                                   true,
+	"/gpgkey.GPGKeyService/Get":                                    true,
+	"/notification.NotificationService/ListTriggers":               true,
+	"/notification.NotificationService/ListServices":               true,
+	"/notification.NotificationService/ListTemplates":              true,
+	"/notification.NotificationService/ListDeliveries":             true,
+	"/project.ProjectService/List":                                 true,
+	"/project.ProjectService/GetDetailedProject":                   true,
+	"/project.ProjectService/Get":                                  true,
+	"/project.ProjectService/GetByID":                              true,
+	"/project.ProjectService/GetGlobalProjects":                    true,
+	"/project.ProjectService/ListEvents":                           true,
+	"/project.ProjectService/GetSyncWindowsState":                  true,
+	"/project.ProjectService/ListLinks":                            true,
+	"/project.ProjectService/GetUsage":                             true,
+	"/project.ProjectService/CheckResourcePermission":              true,
+	"/repocreds.RepoCredsService/ListRepositoryCredentials":        true,
+	"/repository.RepositoryService/List":                           true,
+	"/repository.RepositoryService/Get":                            true,
+	"/repository.RepositoryService/GetByID":                        true,
+	"/repository.RepositoryService/ListRepositories":               true,
+	"/repository.RepositoryService/ListRefs":                       true,
+	"/repository.RepositoryService/ListGitHubAppRepositories":      true,
+	"/repository.RepositoryService/ListApps":                       true,
+	"/repository.RepositoryService/GetAppDetails":                  true,
+	"/repository.RepositoryService/GetHelmCharts":                  true,
+	"/repository.RepositoryService/ResolveCredentials":             true,
+	"/repository.RepositoryService/ValidateAccess":                 true,
+	"/summary.SummaryService/Get":                                  true,
+	"/version.VersionService/Version":                              true,
+	// sessions are required to use the read-only API server and do not mutate the managed state
+	"/session.SessionService/GetUserInfo": true,
+	"/session.SessionService/Create":      true,
+	"/session.SessionService/Delete":      true,
+	// the preferences of users are not part of the managed state either
+	"/session.SessionService/GetPreferences":    true,
+	"/session.SessionService/UpdatePreferences": true,
+}
+
+// isReadOnlyRequest returns true if the given request can be served by an API server running in read-only mode
+func isReadOnlyRequest(fullMethod string, req interface{}) bool {
+	if !readOnlyMethods[fullMethod] {
+		return false
+	}
+	// getting an application with the refresh parameter refreshes it
+	if q, ok := req.(*applicationpkg.ApplicationQuery); ok && q.Refresh != nil {
+		return false
+	}
+	return true
+}
+
+// acceptRequest returns false if the given request must be rejected because the API server runs in read-only mode
+func (a *ArgoCDServer) acceptRequest(fullMethod string, req interface{}) bool {
+	return !a.ReadOnly || isReadOnlyRequest(fullMethod, req)
+}
+
+// readOnlyHandler rejects the requests to the HTTP handlers which mutate state, such as the Git webhooks and the
+// terminal, when the API server runs in read-only mode
+func readOnlyHandler(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "The API server runs in read-only mode", http.StatusForbidden)
+}
@@ -2,6 +2,7 @@ package repocreds
 
 import (
 	"reflect"
+	"regexp"
 
 	"github.com/argoproj/argo-cd/v2/util/argo"
 
@@ -79,6 +80,11 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if r.URL == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "must specify URL")
 	}
+	if r.URLRegex {
+		if _, err := regexp.Compile(r.URL); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid URL regular expression: %v", err)
+		}
+	}
 
 	_, err := s.db.CreateRepositoryCredentials(ctx, r)
 	if status.Convert(err).Code() == codes.AlreadyExists {
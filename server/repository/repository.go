@@ -3,6 +3,7 @@ package repository
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"context"
 
@@ -96,8 +97,9 @@ func (s *Server) getConnectionState(ctx context.Context, url string, forceRefres
 	}
 	now := metav1.Now()
 	connectionState := appsv1.ConnectionState{
-		Status:     appsv1.ConnectionStatusSuccessful,
-		ModifiedAt: &now,
+		Status:           appsv1.ConnectionStatusSuccessful,
+		ModifiedAt:       &now,
+		LastSuccessfulAt: &now,
 	}
 	var err error
 	repo, err := s.db.GetRepository(ctx, url)
@@ -106,6 +108,10 @@ func (s *Server) getConnectionState(ctx context.Context, url string, forceRefres
 	}
 	if err != nil {
 		connectionState.Status = appsv1.ConnectionStatusFailed
+		connectionState.LastSuccessfulAt = nil
+		if previousState, err := s.cache.GetRepoConnectionState(url); err == nil {
+			connectionState.LastSuccessfulAt = previousState.LastSuccessfulAt
+		}
 		if errors.IsCredentialsConfigurationError(err) {
 			connectionState.Message = "Configuration error - please check the server logs"
 			log.Warnf("could not retrieve repo: %s", err.Error())
@@ -120,6 +126,56 @@ func (s *Server) getConnectionState(ctx context.Context, url string, forceRefres
 	return connectionState
 }
 
+// RepoConnectionStateObserver is notified of the connection states determined by ProbeRepositories
+type RepoConnectionStateObserver interface {
+	SetRepoConnectionState(repo string, state appsv1.ConnectionState)
+	DeleteRepoConnectionState(repo string)
+}
+
+// ProbeRepositories periodically checks the connection to each configured
+// repository, so that connectivity and credentials issues are visible before
+// they make an application sync fail. It blocks until the context is done.
+func (s *Server) ProbeRepositories(ctx context.Context, interval time.Duration, observer RepoConnectionStateObserver) {
+	probed := make(map[string]bool)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		probed = s.probeRepositories(ctx, probed, observer)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probeRepositories checks the connection to each configured repository once and returns the probed repositories
+func (s *Server) probeRepositories(ctx context.Context, previouslyProbed map[string]bool, observer RepoConnectionStateObserver) map[string]bool {
+	repos, err := s.db.ListRepositories(ctx)
+	if err != nil {
+		log.Warnf("Failed to list repositories to probe: %v", err)
+		return previouslyProbed
+	}
+	probed := make(map[string]bool)
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			return previouslyProbed
+		}
+		state := s.getConnectionState(ctx, repo.Repo, true)
+		if state.Status == appsv1.ConnectionStatusFailed {
+			log.WithField("repo", repo.Repo).Warnf("Repository connection check failed: %s", state.Message)
+		}
+		observer.SetRepoConnectionState(repo.Repo, state)
+		probed[repo.Repo] = true
+	}
+	for repo := range previouslyProbed {
+		if !probed[repo] {
+			observer.DeleteRepoConnectionState(repo)
+		}
+	}
+	return probed
+}
+
 // List returns list of repositories
 // Deprecated: Use ListRepositories instead
 func (s *Server) List(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.RepositoryList, error) {
@@ -171,14 +227,38 @@ func (s *Server) Get(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.R
 	return &item, nil
 }
 
+// GetByID returns the configured repository of the given external ID and the state of its connections
+func (s *Server) GetByID(ctx context.Context, q *repositorypkg.RepoByIDQuery) (*appsv1.Repository, error) {
+	if !appsv1.IsExternalIDOf(appsv1.ExternalIDPrefixRepository, q.Id) {
+		return nil, status.Errorf(codes.InvalidArgument, "'%s' is not the ID of a repository", q.Id)
+	}
+	repos, err := s.db.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range repos {
+		if repo.ExternalID() == q.Id {
+			return s.Get(ctx, &repositorypkg.RepoQuery{Repo: repo.Repo, ForceRefresh: q.ForceRefresh})
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "repo with ID '%s' not found", q.Id)
+}
+
 // ListRepositories returns a list of all configured repositories and the state of their connections
 func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.RepositoryList, error) {
 	repos, err := s.db.ListRepositories(ctx)
 	if err != nil {
 		return nil, err
 	}
+	ids := make(map[string]bool)
+	for _, id := range q.ExternalIDs {
+		ids[id] = true
+	}
 	items := appsv1.Repositories{}
 	for _, repo := range repos {
+		if len(ids) > 0 && !ids[repo.ExternalID()] {
+			continue
+		}
 		if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, createRBACObject(repo.Project, repo.Repo)) {
 			// For backwards compatibility, if we have no repo type set assume a default
 			rType := repo.Type
@@ -231,6 +311,33 @@ func (s *Server) ListRefs(ctx context.Context, q *repositorypkg.RepoQuery) (*api
 	})
 }
 
+// ListGitHubAppRepositories returns the repositories accessible to the GitHub App installation used by
+// the repository or credential template. Used as a convenience to the UI for repository pickers.
+func (s *Server) ListGitHubAppRepositories(ctx context.Context, q *repositorypkg.RepoQuery) (*apiclient.GitHubAppRepositoryList, error) {
+	repo, err := s.getRepo(ctx, q.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, createRBACObject(repo.Project, repo.Repo)); err != nil {
+		return nil, err
+	}
+
+	if repo.GithubAppPrivateKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "repository %s is not configured with GitHub App credentials", q.Repo)
+	}
+
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(conn)
+
+	return repoClient.ListGitHubAppRepositories(ctx, &apiclient.ListGitHubAppRepositoriesRequest{
+		Repo: repo,
+	})
+}
+
 // ListApps performs discovery of a git repository for potential sources of applications. Used
 // as a convenience to the UI for auto-complete.
 func (s *Server) ListApps(ctx context.Context, q *repositorypkg.RepoAppsQuery) (*repositorypkg.RepoAppsResponse, error) {
@@ -488,6 +595,37 @@ func (s *Server) DeleteRepository(ctx context.Context, q *repositorypkg.RepoQuer
 	return &repositorypkg.RepoResponse{}, err
 }
 
+// ResolveCredentials returns the credential template which would be used for
+// the given repository URL. No secret data is returned.
+func (s *Server) ResolveCredentials(ctx context.Context, q *repositorypkg.RepoCredsResolveQuery) (*repositorypkg.RepoCredsResolveResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, q.Repo); err != nil {
+		return nil, err
+	}
+
+	repoCreds, err := s.db.GetRepositoryCredentials(ctx, q.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if repoCreds == nil {
+		return &repositorypkg.RepoCredsResolveResponse{}, nil
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceRepositories, rbacpolicy.ActionGet, repoCreds.URL); err != nil {
+		return nil, err
+	}
+
+	return &repositorypkg.RepoCredsResolveResponse{
+		Matched:          true,
+		Url:              repoCreds.URL,
+		UrlRegex:         repoCreds.URLRegex,
+		Priority:         repoCreds.Priority,
+		Type:             repoCreds.Type,
+		EnableLfs:        repoCreds.EnableLFS,
+		ForceProxy:       repoCreds.ForceProxy,
+		Proxy:            repoCreds.Proxy,
+		HasTlsCACertData: repoCreds.TLSCACertData != "",
+	}, nil
+}
+
 // ValidateAccess checks whether access to a repository is possible with the
 // given URL and credentials.
 func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccessQuery) (*repositorypkg.RepoResponse, error) {
@@ -505,6 +643,8 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 		Insecure:                   q.Insecure,
 		TLSClientCertData:          q.TlsClientCertData,
 		TLSClientCertKey:           q.TlsClientCertKey,
+		TLSCACertData:              q.TlsCACertData,
+		TLSServerName:              q.TlsServerName,
 		EnableOCI:                  q.EnableOci,
 		GithubAppPrivateKey:        q.GithubAppPrivateKey,
 		GithubAppId:                q.GithubAppID,
@@ -9,6 +9,7 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -196,6 +197,32 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Equal(t, repo.Repo, url)
 	})
 
+	t.Run("Test_GetByID", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		url := "https://test"
+		repos := []*appsv1.Repository{{Repo: "https://other"}, {Repo: url}}
+		db := &dbmocks.ArgoDB{}
+		db.On("ListRepositories", context.TODO()).Return(repos, nil)
+		db.On("GetRepository", context.TODO(), url).Return(&appsv1.Repository{Repo: url}, nil)
+		db.On("RepositoryExists", context.TODO(), url).Return(true, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		repo, err := s.GetByID(context.TODO(), &repository.RepoByIDQuery{Id: repos[1].ExternalID()})
+		assert.Nil(t, err)
+		assert.Equal(t, url, repo.Repo)
+
+		_, err = s.GetByID(context.TODO(), &repository.RepoByIDQuery{Id: "repo-unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		list, err := s.ListRepositories(context.TODO(), &repository.RepoQuery{ExternalIDs: []string{repos[1].ExternalID()}})
+		assert.Nil(t, err)
+		assert.Len(t, list.Items, 1)
+		assert.Equal(t, url, list.Items[0].Repo)
+	})
+
 	t.Run("Test_GetWithErrorShouldReturn403", func(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
@@ -279,6 +306,79 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Equal(t, repo.Repo, "test")
 	})
 
+	t.Run("Test_ListGitHubAppRepositories", func(t *testing.T) {
+		url := "https://github.com/argoproj"
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClient.On("ListGitHubAppRepositories", mock.Anything, &apiclient.ListGitHubAppRepositoriesRequest{
+			Repo: &appsv1.Repository{Repo: url, GithubAppId: 1, GithubAppPrivateKey: "key"},
+		}).Return(&apiclient.GitHubAppRepositoryList{Items: []*apiclient.GitHubAppRepository{{FullName: "argoproj/argo-cd"}}}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepository", context.TODO(), url).Return(&appsv1.Repository{Repo: url, GithubAppId: 1, GithubAppPrivateKey: "key"}, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		list, err := s.ListGitHubAppRepositories(context.TODO(), &repository.RepoQuery{Repo: url})
+		assert.Nil(t, err)
+		if assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "argoproj/argo-cd", list.Items[0].FullName)
+		}
+	})
+
+	t.Run("Test_ListGitHubAppRepositoriesWithoutGitHubApp", func(t *testing.T) {
+		url := "https://github.com/argoproj"
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepository", context.TODO(), url).Return(&appsv1.Repository{Repo: url}, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		_, err := s.ListGitHubAppRepositories(context.TODO(), &repository.RepoQuery{Repo: url})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Test_ResolveCredentials", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		url := "https://github.com/argoproj/argo-cd"
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepositoryCredentials", context.TODO(), url).Return(&appsv1.RepoCreds{
+			URL:           `^https://github\.com/argoproj/.*$`,
+			URLRegex:      true,
+			Priority:      10,
+			Password:      "secret",
+			EnableLFS:     true,
+			TLSCACertData: "ca",
+		}, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		resp, err := s.ResolveCredentials(context.TODO(), &repository.RepoCredsResolveQuery{Repo: url})
+		assert.Nil(t, err)
+		assert.Equal(t, &repository.RepoCredsResolveResponse{
+			Matched:          true,
+			Url:              `^https://github\.com/argoproj/.*$`,
+			UrlRegex:         true,
+			Priority:         10,
+			EnableLfs:        true,
+			HasTlsCACertData: true,
+		}, resp)
+	})
+
+	t.Run("Test_ResolveCredentialsNoMatch", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		url := "https://gitlab.com/argoproj/argo-cd"
+		db := &dbmocks.ArgoDB{}
+		db.On("GetRepositoryCredentials", context.TODO(), url).Return(nil, nil)
+
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+		resp, err := s.ResolveCredentials(context.TODO(), &repository.RepoCredsResolveQuery{Repo: url})
+		assert.Nil(t, err)
+		assert.False(t, resp.Matched)
+	})
 }
 
 func TestRepositoryServerListApps(t *testing.T) {
@@ -582,6 +682,57 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 	})
 }
 
+type fakeRepoConnectionStateObserver struct {
+	states map[string]appsv1.ConnectionState
+}
+
+func (o *fakeRepoConnectionStateObserver) SetRepoConnectionState(repo string, state appsv1.ConnectionState) {
+	o.states[repo] = state
+}
+
+func (o *fakeRepoConnectionStateObserver) DeleteRepoConnectionState(repo string) {
+	delete(o.states, repo)
+}
+
+func TestRepositoryServerProbeRepositories(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(&argocdCM, &argocdSecret)
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	enforcer := newEnforcer(kubeclientset)
+	appLister, projInformer := newAppAndProjLister(defaultProj)
+
+	url := "https://test"
+	otherURL := "https://other"
+	repoServerClient := mocks.RepoServerServiceClient{}
+	repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil).Twice()
+	repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(nil, errors.New("authentication required"))
+	repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+	db := &dbmocks.ArgoDB{}
+	db.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{{Repo: url}, {Repo: otherURL}}, nil).Once()
+	db.On("ListRepositories", mock.Anything).Return([]*appsv1.Repository{{Repo: url}}, nil)
+	db.On("GetRepository", mock.Anything, url).Return(&appsv1.Repository{Repo: url}, nil)
+	db.On("GetRepository", mock.Anything, otherURL).Return(&appsv1.Repository{Repo: otherURL}, nil)
+
+	s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr)
+	observer := &fakeRepoConnectionStateObserver{states: map[string]appsv1.ConnectionState{}}
+
+	probed := s.probeRepositories(context.Background(), nil, observer)
+	assert.Len(t, probed, 2)
+	require.Contains(t, observer.states, url)
+	assert.Equal(t, appsv1.ConnectionStatusSuccessful, observer.states[url].Status)
+	require.NotNil(t, observer.states[url].LastSuccessfulAt)
+	lastSuccessfulAt := observer.states[url].LastSuccessfulAt
+
+	probed = s.probeRepositories(context.Background(), probed, observer)
+	assert.Len(t, probed, 1)
+	assert.NotContains(t, observer.states, otherURL)
+	require.Contains(t, observer.states, url)
+	assert.Equal(t, appsv1.ConnectionStatusFailed, observer.states[url].Status)
+	assert.Contains(t, observer.states[url].Message, "authentication required")
+	require.NotNil(t, observer.states[url].LastSuccessfulAt)
+	assert.True(t, lastSuccessfulAt.Equal(observer.states[url].LastSuccessfulAt))
+}
+
 type fixtures struct {
 	*cache.Cache
 }
@@ -45,6 +45,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	// register the gzip compressor so that gRPC clients can request compressed responses
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
@@ -53,13 +55,17 @@ import (
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
+	agentpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/agent"
 	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 
 	applicationsetpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/applicationset"
@@ -72,6 +78,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
+	summarypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/summary"
 	versionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
@@ -80,6 +87,8 @@ import (
 	repoapiclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	repocache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	"github.com/argoproj/argo-cd/v2/server/account"
+	"github.com/argoproj/argo-cd/v2/server/agent"
+	"github.com/argoproj/argo-cd/v2/server/apiversion"
 	"github.com/argoproj/argo-cd/v2/server/application"
 	"github.com/argoproj/argo-cd/v2/server/applicationset"
 	"github.com/argoproj/argo-cd/v2/server/badge"
@@ -88,6 +97,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/cluster"
 	"github.com/argoproj/argo-cd/v2/server/extension"
 	"github.com/argoproj/argo-cd/v2/server/gpgkey"
+	"github.com/argoproj/argo-cd/v2/server/jwks"
 	"github.com/argoproj/argo-cd/v2/server/logout"
 	"github.com/argoproj/argo-cd/v2/server/metrics"
 	"github.com/argoproj/argo-cd/v2/server/notification"
@@ -97,15 +107,20 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/repository"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/server/settings"
+	"github.com/argoproj/argo-cd/v2/server/summary"
 	"github.com/argoproj/argo-cd/v2/server/version"
 	"github.com/argoproj/argo-cd/v2/ui"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/assets"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/customizations"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/dex"
 	dexutil "github.com/argoproj/argo-cd/v2/util/dex"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	errorsutil "github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
 	httputil "github.com/argoproj/argo-cd/v2/util/http"
@@ -113,7 +128,9 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/io/files"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
+	metricsutil "github.com/argoproj/argo-cd/v2/util/metrics"
 	service "github.com/argoproj/argo-cd/v2/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v2/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v2/util/notification/k8s"
 	settings_notif "github.com/argoproj/argo-cd/v2/util/notification/settings"
 	"github.com/argoproj/argo-cd/v2/util/oidc"
@@ -194,6 +211,8 @@ type ArgoCDServer struct {
 	secretInformer    cache.SharedIndexInformer
 	configMapInformer cache.SharedIndexInformer
 	serviceSet        *ArgoCDServiceSet
+	// dependencyChecker reports the states of the services the API server depends on
+	dependencyChecker *healthz.DependencyChecker
 }
 
 type ArgoCDServerOpts struct {
@@ -219,6 +238,72 @@ type ArgoCDServerOpts struct {
 	ListenHost            string
 	ApplicationNamespaces []string
 	EnableProxyExtension  bool
+	// RepoHealthCheckInterval is the interval at which configured repositories are probed; zero disables probing
+	RepoHealthCheckInterval time.Duration
+	// BundleSyncInterval is the interval at which the resource customizations bundle is synced from Git
+	BundleSyncInterval time.Duration
+	// EnableSettingsAdmission enables the validating admission webhook of the Argo CD config maps, which is served to
+	// the Kubernetes API server on a distinct TLS listener
+	EnableSettingsAdmission bool
+	// SettingsAdmissionPort is the port of the validating admission webhook of the Argo CD config maps
+	SettingsAdmissionPort int
+	// SettingsAdmissionTLSCertFile and SettingsAdmissionTLSKeyFile are the paths of the TLS certificate and key served
+	// by the validating admission webhook of the Argo CD config maps
+	SettingsAdmissionTLSCertFile string
+	SettingsAdmissionTLSKeyFile  string
+	// GZipPaths are the glob patterns of the request paths whose responses are compressed when GZIP is enabled. The
+	// responses to all requests are compressed if empty.
+	GZipPaths []string
+	// ReadOnly disables all the RPCs and HTTP handlers which mutate state, so that additional API server replicas can
+	// serve dashboards and reporting tools without any risk of changing the managed applications
+	ReadOnly bool
+	// AgentProxyURL is the URL of the API server through which the application controller reaches the clusters of the
+	// agents. Defaults to the URL of the argocd-server service.
+	AgentProxyURL string
+	// MetricsAuth configures the authorization of the requests made to the metrics endpoint
+	MetricsAuth *metricsutil.AuthOptions
+	// MetricsDropLabels designates the labels dropped from the series exported by the metrics endpoint
+	MetricsDropLabels []metricsutil.LabelRule
+	// ManifestWarmParallelism is the maximum number of applications whose manifests are generated at once
+	// when a Git webhook affects them, before they are refreshed. The manifests are not generated if 0.
+	ManifestWarmParallelism int
+	// PreviewParallelism is the maximum number of pull requests whose changes are previewed at once when a Git
+	// webhook notifies that they are opened or updated. The pull requests are not previewed if 0.
+	PreviewParallelism int
+	// PreviewForks is whether the pull requests opened from forks are previewed too
+	PreviewForks bool
+	// ApplicationSelectors restricts the applications watched by the API server, and therefore the applications it
+	// serves. All applications are watched if nil.
+	ApplicationSelectors *argo.ApplicationSelectors
+	// CookieOptions are the attributes of the cookies holding the auth token
+	CookieOptions httputil.CookieOptions
+	// CORSOptions configure the cross-origin requests to the REST API, which are not allowed if empty
+	CORSOptions httputil.CORSOptions
+	// HardDependencies are the names of the dependencies which must be ready for the API server to be ready
+	HardDependencies []string
+}
+
+// agentProxyURL returns the URL of the API server through which the application controller reaches the clusters of
+// the agents
+func (a *ArgoCDServer) agentProxyURL() string {
+	if a.AgentProxyURL != "" {
+		return a.AgentProxyURL
+	}
+	if a.Insecure {
+		return fmt.Sprintf("http://%s.%s.svc", common.GetDefaultServerServiceName(), a.Namespace)
+	}
+	return fmt.Sprintf("https://%s.%s.svc", common.GetDefaultServerServiceName(), a.Namespace)
+}
+
+// agentProxyCAData returns the PEM encoded CA verifying the certificate served at the URL of agentProxyURL: the
+// certificate of the API server itself when the agents are reached through the argocd-server service, or nil to use
+// the system roots when a custom URL is configured
+func (a *ArgoCDServer) agentProxyCAData() []byte {
+	if a.AgentProxyURL != "" || !a.useTLS() {
+		return nil
+	}
+	certPEM, _ := tlsutil.EncodeX509KeyPair(*a.settings.Certificate)
+	return certPEM
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -247,15 +332,17 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	settingsMgr := settings_util.NewSettingsManager(ctx, opts.KubeClientset, opts.Namespace)
 	settings, err := settingsMgr.InitializeSettings(opts.Insecure)
 	errorsutil.CheckError(err)
-	err = initializeDefaultProject(opts)
-	errorsutil.CheckError(err)
+	if !opts.ReadOnly {
+		err = initializeDefaultProject(opts)
+		errorsutil.CheckError(err)
+	}
 
 	appInformerNs := opts.Namespace
 	if len(opts.ApplicationNamespaces) > 0 {
 		appInformerNs = ""
 	}
 	projFactory := appinformer.NewSharedInformerFactoryWithOptions(opts.AppClientset, 0, appinformer.WithNamespace(opts.Namespace), appinformer.WithTweakListOptions(func(options *metav1.ListOptions) {}))
-	appFactory := appinformer.NewSharedInformerFactoryWithOptions(opts.AppClientset, 0, appinformer.WithNamespace(appInformerNs), appinformer.WithTweakListOptions(func(options *metav1.ListOptions) {}))
+	appFactory := appinformer.NewSharedInformerFactoryWithOptions(opts.AppClientset, 0, appinformer.WithNamespace(appInformerNs), appinformer.WithTweakListOptions(opts.ApplicationSelectors.TweakListOptions))
 
 	projInformer := projFactory.Argoproj().V1alpha1().AppProjects().Informer()
 	projLister := projFactory.Argoproj().V1alpha1().AppProjects().Lister().AppProjects(opts.Namespace)
@@ -268,7 +355,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 
 	userStateStorage := util_session.NewUserStateStorage(opts.RedisClient)
 	sessionMgr := util_session.NewSessionManager(settingsMgr, projLister, opts.DexServerAddr, opts.DexTLSConfig, userStateStorage)
-	enf := rbac.NewEnforcer(opts.KubeClientset, opts.Namespace, common.ArgoCDRBACConfigMapName, nil)
+	enf := rbac.NewEnforcer(opts.KubeClientset, opts.Namespace, common.GetArgoCDRBACConfigMapName(), nil)
 	enf.EnableEnforce(!opts.DisableAuth)
 	err = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
 	errorsutil.CheckError(err)
@@ -285,10 +372,10 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	argocdService, err := service.NewArgoCDService(opts.KubeClientset, opts.Namespace, opts.RepoClientset)
 	errorsutil.CheckError(err)
 
-	secretInformer := k8s.NewSecretInformer(opts.KubeClientset, opts.Namespace, "argocd-notifications-secret")
-	configMapInformer := k8s.NewConfigMapInformer(opts.KubeClientset, opts.Namespace, "argocd-notifications-cm")
+	secretInformer := k8s.NewSecretInformer(opts.KubeClientset, opts.Namespace, common.GetArgoCDNotificationsSecretName())
+	configMapInformer := k8s.NewConfigMapInformer(opts.KubeClientset, opts.Namespace, common.GetArgoCDNotificationsConfigMapName())
 
-	apiFactory := api.NewFactory(settings_notif.GetFactorySettings(argocdService, "argocd-notifications-secret", "argocd-notifications-cm"), opts.Namespace, secretInformer, configMapInformer)
+	apiFactory := api.NewFactory(settings_notif.GetFactorySettings(argocdService, common.GetArgoCDNotificationsSecretName(), common.GetArgoCDNotificationsConfigMapName()), opts.Namespace, secretInformer, configMapInformer)
 
 	return &ArgoCDServer{
 		ArgoCDServerOpts:  opts,
@@ -316,8 +403,13 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 const (
 	// catches corrupted informer state; see https://github.com/argoproj/argo-cd/issues/4960 for more information
 	notObjectErrMsg = "object does not implement the Object interfaces"
+	// tokenSigningKeysRotationInterval is the interval at which the keys signing the tokens are checked for rotation
+	tokenSigningKeysRotationInterval = 5 * time.Minute
 )
 
+// tokenSigningKeysRotatorLeaseName is the name of the lease held by the API server replica rotating the token signing keys
+const tokenSigningKeysRotatorLeaseName = "argocd-server-token-signing-keys-rotator"
+
 func (a *ArgoCDServer) healthCheck(r *http.Request) error {
 	if val, ok := r.URL.Query()["full"]; ok && len(val) > 0 && val[0] == "true" {
 		argoDB := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
@@ -330,9 +422,10 @@ func (a *ArgoCDServer) healthCheck(r *http.Request) error {
 }
 
 type Listeners struct {
-	Main        net.Listener
-	Metrics     net.Listener
-	GatewayConn *grpc.ClientConn
+	Main              net.Listener
+	Metrics           net.Listener
+	SettingsAdmission net.Listener
+	GatewayConn       *grpc.ClientConn
 }
 
 func (l *Listeners) Close() error {
@@ -348,6 +441,12 @@ func (l *Listeners) Close() error {
 		}
 		l.Metrics = nil
 	}
+	if l.SettingsAdmission != nil {
+		if err := l.SettingsAdmission.Close(); err != nil {
+			return err
+		}
+		l.SettingsAdmission = nil
+	}
 	if l.GatewayConn != nil {
 		if err := l.GatewayConn.Close(); err != nil {
 			return err
@@ -406,7 +505,32 @@ func (a *ArgoCDServer) Listen() (*Listeners, error) {
 		io.Close(metricsLn)
 		return nil, err
 	}
-	return &Listeners{Main: mainLn, Metrics: metricsLn, GatewayConn: conn}, nil
+	listeners := &Listeners{Main: mainLn, Metrics: metricsLn, GatewayConn: conn}
+	if a.EnableSettingsAdmission {
+		listeners.SettingsAdmission, err = a.listenSettingsAdmission()
+		if err != nil {
+			io.Close(listeners)
+			return nil, err
+		}
+	}
+	return listeners, nil
+}
+
+// listenSettingsAdmission starts the TLS listener of the validating admission webhook of the Argo CD config maps. The
+// webhook is only called by the Kubernetes API server, so it is served apart from the API, with its own certificate.
+func (a *ArgoCDServer) listenSettingsAdmission() (net.Listener, error) {
+	if a.SettingsAdmissionTLSCertFile == "" || a.SettingsAdmissionTLSKeyFile == "" {
+		return nil, fmt.Errorf("the certificate and the key of the settings admission webhook must be specified")
+	}
+	tlsConfig, err := tlsutil.CreateServerTLSConfig(a.SettingsAdmissionTLSCertFile, a.SettingsAdmissionTLSKeyFile, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the certificate of the settings admission webhook: %w", err)
+	}
+	ln, err := startListener(a.ListenHost, a.SettingsAdmissionPort)
+	if err != nil {
+		return nil, err
+	}
+	return tls.NewListener(ln, tlsConfig), nil
 }
 
 // Init starts informers used by the API server
@@ -427,13 +551,19 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	a.serviceSet = svcSet
 	grpcS, appResourceTreeFn := a.newGRPCServer()
 	grpcWebS := grpcweb.WrapServer(grpcS)
+	metricsServ := metrics.NewMetricsServer(a.ListenHost, a.MetricsPort)
+	errorsutil.CheckError(metricsServ.SetAuth(a.MetricsAuth))
+	metricsServ.SetDropLabels(a.MetricsDropLabels)
+	a.dependencyChecker = healthz.NewDependencyChecker(a.dependencies()...)
+	errorsutil.CheckError(a.dependencyChecker.SetHardDependencies(a.HardDependencies))
+	a.dependencyChecker.SetStateObserver(metricsServ.SetDependencyState)
 	var httpS *http.Server
 	var httpsS *http.Server
 	if a.useTLS() {
 		httpS = newRedirectServer(a.ListenPort, a.RootPath)
-		httpsS = a.newHTTPServer(ctx, a.ListenPort, grpcWebS, appResourceTreeFn, listeners.GatewayConn)
+		httpsS = a.newHTTPServer(ctx, a.ListenPort, grpcWebS, appResourceTreeFn, listeners.GatewayConn, metricsServ)
 	} else {
-		httpS = a.newHTTPServer(ctx, a.ListenPort, grpcWebS, appResourceTreeFn, listeners.GatewayConn)
+		httpS = a.newHTTPServer(ctx, a.ListenPort, grpcWebS, appResourceTreeFn, listeners.GatewayConn, metricsServ)
 	}
 	if a.RootPath != "" {
 		httpS.Handler = withRootPath(httpS.Handler, a)
@@ -447,10 +577,16 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		httpsS.Handler = &bug21955Workaround{handler: httpsS.Handler}
 	}
 
-	metricsServ := metrics.NewMetricsServer(a.ListenHost, a.MetricsPort)
 	if a.RedisClient != nil {
 		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
 	}
+	go a.dependencyChecker.Run(ctx, healthz.DependencyCheckInterval)
+	if a.RepoHealthCheckInterval > 0 {
+		go a.serviceSet.RepoService.ProbeRepositories(ctx, a.RepoHealthCheckInterval, metricsServ)
+	}
+	if a.BundleSyncInterval > 0 {
+		go customizations.NewBundleSyncer(a.Namespace, a.settingsMgr, a.db, a.RepoClientset).Run(ctx, a.BundleSyncInterval)
+	}
 
 	// CMux is used to support servicing gRPC and HTTP1.1+JSON on the same port
 	tcpm := cmux.New(listeners.Main)
@@ -495,8 +631,21 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	}
 	go a.watchSettings()
 	go a.rbacPolicyLoader(ctx)
+	go a.tokenSigningKeysRotator(ctx)
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
-	go func() { a.checkServeErr("metrics", metricsServ.Serve(listeners.Metrics)) }()
+	if listeners.SettingsAdmission != nil {
+		mux := http.NewServeMux()
+		mux.HandleFunc(settings.AdmissionPath, settings.NewAdmissionHandler(a.Namespace))
+		settingsAdmissionS := &http.Server{Handler: mux}
+		go func() { a.checkServeErr("settingsAdmission", settingsAdmissionS.Serve(listeners.SettingsAdmission)) }()
+	}
+	go func() {
+		if metricsServ.TLSConfig != nil {
+			a.checkServeErr("metrics", metricsServ.ServeTLS(listeners.Metrics, "", ""))
+		} else {
+			a.checkServeErr("metrics", metricsServ.Serve(listeners.Metrics))
+		}
+	}()
 	if !cache.WaitForCacheSync(ctx.Done(), a.projInformer.HasSynced, a.appInformer.HasSynced) {
 		log.Fatal("Timed out waiting for project cache to sync")
 	}
@@ -557,8 +706,9 @@ func (a *ArgoCDServer) watchSettings() {
 
 	prevURL := a.settings.URL
 	prevOIDCConfig := a.settings.OIDCConfig()
-	prevDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings, a.DexTLSConfig == nil || a.DexTLSConfig.DisableTLS)
-	errorsutil.CheckError(err)
+	// the connectors of Dex are reloaded by the Dex server itself, so the API server only restarts when Dex is
+	// enabled or disabled
+	prevDexConfigured := a.settings.IsDexConfigured()
 	prevGitHubSecret := a.settings.WebhookGitHubSecret
 	prevGitLabSecret := a.settings.WebhookGitLabSecret
 	prevBitbucketUUID := a.settings.WebhookBitbucketUUID
@@ -572,10 +722,10 @@ func (a *ArgoCDServer) watchSettings() {
 	for {
 		newSettings := <-updateCh
 		a.settings = newSettings
-		newDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings, a.DexTLSConfig == nil || a.DexTLSConfig.DisableTLS)
-		errorsutil.CheckError(err)
-		if string(newDexCfgBytes) != string(prevDexCfgBytes) {
-			log.Infof("dex config modified. restarting")
+		if err := dex.ValidateDexConfig(a.settings); err != nil {
+			log.Errorf("invalid dex config: %v", err)
+		} else if a.settings.IsDexConfigured() != prevDexConfigured {
+			log.Infof("dex enabled or disabled. restarting")
 			break
 		}
 		if checkOIDCConfigChange(prevOIDCConfig, a.settings) {
@@ -640,6 +790,80 @@ func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 	errorsutil.CheckError(err)
 }
 
+// tokenSigningKeysRotator rotates the asymmetric keys signing the tokens when they are due for rotation, and removes the
+// retired keys once the tokens they signed are no longer accepted. The keys are rotated by the replica holding the
+// rotator lease only, so that the replicas do not race to update the token signing keys secret.
+func (a *ArgoCDServer) tokenSigningKeysRotator(ctx context.Context) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Warnf("Failed to get hostname, token signing keys will not be rotated: %v", err)
+		return
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: common.InstanceResourceName(tokenSigningKeysRotatorLeaseName), Namespace: a.Namespace},
+		Client:     a.KubeClientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: hostname},
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		ReleaseOnCancel: true,
+		Name:            common.InstanceResourceName(tokenSigningKeysRotatorLeaseName),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: a.rotateTokenSigningKeys,
+			OnStoppedLeading: func() {},
+		},
+	})
+	if err != nil {
+		log.Warnf("Failed to create token signing keys rotator leader elector: %v", err)
+		return
+	}
+	// the elector returns when the leadership is lost, in which case this replica stands by again
+	wait.UntilWithContext(ctx, elector.Run, time.Second)
+}
+
+func (a *ArgoCDServer) rotateTokenSigningKeys(ctx context.Context) {
+	ticker := time.NewTicker(tokenSigningKeysRotationInterval)
+	defer ticker.Stop()
+	for {
+		if err := a.rotateTokenSigningKeysOnce(); err != nil {
+			log.Warnf("Failed to rotate token signing keys: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rotateTokenSigningKeysOnce rotates the token signing keys, keeping the retired keys which signed the tokens issued to
+// the accounts or the project roles
+func (a *ArgoCDServer) rotateTokenSigningKeysOnce() error {
+	projects, err := a.projLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	tokens := []settings_util.Token{}
+	add := func(jwtTokens []v1alpha1.JWTToken) {
+		for _, token := range jwtTokens {
+			tokens = append(tokens, settings_util.Token{ID: token.ID, IssuedAt: token.IssuedAt, ExpiresAt: token.ExpiresAt})
+		}
+	}
+	for _, proj := range projects {
+		for _, role := range proj.Spec.Roles {
+			add(role.JWTTokens)
+		}
+		for _, roleTokens := range proj.Status.JWTTokensByRole {
+			add(roleTokens.Items)
+		}
+	}
+	_, err = a.settingsMgr.RotateTokenSigningKeys(time.Now(), tokens)
+	return err
+}
+
 func (a *ArgoCDServer) useTLS() bool {
 	if a.Insecure || a.settings.Certificate == nil {
 		return false
@@ -680,7 +904,10 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		"/repocreds.RepoCredsService/UpdateRepositoryCredentials": true,
 		"/application.ApplicationService/PatchResource":           true,
 		// Remove from logs both because the contents are sensitive and because they may be very large.
-		"/application.ApplicationService/GetManifestsWithFiles":   true,
+		"/application.ApplicationService/GetManifestsWithFiles": true,
+		// The tunnels of the agents carry the manifests and secrets of their clusters
+		"/agent.AgentService/Connect":  true,
+		"/agent.AgentService/Register": true,
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
@@ -690,6 +917,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		grpc_prometheus.StreamServerInterceptor,
 		grpc_auth.StreamServerInterceptor(a.Authenticate),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
+		grpc_util.ReadOnlyStreamServerInterceptor(a.acceptRequest),
 		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
@@ -704,6 +932,8 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		grpc_prometheus.UnaryServerInterceptor,
 		grpc_auth.UnaryServerInterceptor(a.Authenticate),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
+		grpc_util.ReadOnlyUnaryServerInterceptor(a.acceptRequest),
+		a.projectUsageInterceptor,
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
@@ -726,6 +956,8 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 	accountpkg.RegisterAccountServiceServer(grpcS, a.serviceSet.AccountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, a.serviceSet.CertificateService)
 	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, a.serviceSet.GpgkeyService)
+	summarypkg.RegisterSummaryServiceServer(grpcS, a.serviceSet.SummaryService)
+	agentpkg.RegisterAgentServiceServer(grpcS, a.serviceSet.AgentService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	grpc_prometheus.Register(grpcS)
@@ -748,18 +980,20 @@ type ArgoCDServiceSet struct {
 	CertificateService    *certificate.Server
 	GpgkeyService         *gpgkey.Server
 	VersionService        *version.Server
+	SummaryService        *summary.Server
+	AgentService          *agent.Server
 }
 
 func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	kubectl := kubeutil.NewKubectl()
-	clusterService := cluster.NewServer(a.db, a.enf, a.Cache, kubectl)
+	clusterService := cluster.NewServer(a.db, a.enf, a.Cache, kubectl, a.settingsMgr)
 	repoService := repository.NewServer(a.RepoClientset, a.db, a.enf, a.Cache, a.appLister, a.projInformer, a.Namespace, a.settingsMgr)
 	repoCredsService := repocreds.NewServer(a.RepoClientset, a.db, a.enf, a.settingsMgr)
 	var loginRateLimiter func() (io.Closer, error)
 	if maxConcurrentLoginRequestsCount > 0 {
 		loginRateLimiter = session.NewLoginRateLimiter(maxConcurrentLoginRequestsCount)
 	}
-	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter, a.Cache)
 	projectLock := sync.NewKeyLock()
 	applicationService, appResourceTreeFn := application.NewServer(
 		a.Namespace,
@@ -778,14 +1012,15 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.ApplicationNamespaces)
 
 	applicationSetService := applicationset.NewServer(a.db, a.KubeClientset, a.enf, a.Cache, a.AppClientset, a.appLister, a.appsetInformer, a.appsetLister, a.projLister, a.settingsMgr, a.Namespace, projectLock)
-	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db)
+	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db, a.Cache)
 	appsInAnyNamespaceEnabled := len(a.ArgoCDServerOpts.ApplicationNamespaces) > 0
-	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.DisableAuth, appsInAnyNamespaceEnabled)
+	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.enf, a.DisableAuth, appsInAnyNamespaceEnabled, a.Cache, a.DexServerAddr, a.DexTLSConfig)
 	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
 
-	notificationService := notification.NewServer(a.apiFactory)
+	notificationService := notification.NewServer(a.apiFactory, delivery.NewStore(a.Cache.GetCache()), a.enf, a.Namespace)
 	certificateService := certificate.NewServer(a.RepoClientset, a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.RepoClientset, a.db, a.enf)
+	summaryService := summary.NewServer(a.Namespace, a.appLister, a.enf, a.ApplicationNamespaces, a.Cache)
 	versionService := version.NewServer(a, func() (bool, error) {
 		if a.DisableAuth {
 			return true, nil
@@ -812,6 +1047,8 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		CertificateService:    certificateService,
 		GpgkeyService:         gpgkeyService,
 		VersionService:        versionService,
+		SummaryService:        summaryService,
+		AgentService:          agent.NewServer(a.db, a.enf, a, a.agentProxyURL(), a.agentProxyCAData(), replicasCount),
 	}
 }
 
@@ -834,12 +1071,9 @@ func (a *ArgoCDServer) translateGrpcCookieHeader(ctx context.Context, w http.Res
 }
 
 func (a *ArgoCDServer) setTokenCookie(token string, w http.ResponseWriter) error {
-	cookiePath := fmt.Sprintf("path=/%s", strings.TrimRight(strings.TrimLeft(a.ArgoCDServerOpts.BaseHRef, "/"), "/"))
-	flags := []string{cookiePath, "SameSite=lax", "httpOnly"}
-	if !a.Insecure {
-		flags = append(flags, "Secure")
-	}
-	cookies, err := httputil.MakeCookieMetadata(common.AuthCookieName, token, flags...)
+	cookiePath := fmt.Sprintf("/%s", strings.TrimRight(strings.TrimLeft(a.ArgoCDServerOpts.BaseHRef, "/"), "/"))
+	flags := a.CookieOptions.Flags(cookiePath, !a.Insecure)
+	cookies, err := httputil.MakeCookieMetadata(a.CookieOptions.CookieName(), token, flags...)
 	if err != nil {
 		return err
 	}
@@ -856,15 +1090,32 @@ func withRootPath(handler http.Handler, a *ArgoCDServer) http.Handler {
 	mux := http.NewServeMux()
 	mux.Handle("/"+root+"/", http.StripPrefix("/"+root, handler))
 
-	healthz.ServeHealthCheck(mux, a.healthCheck)
+	healthz.ServeDependencyHealthCheck(mux, a.healthCheck, a.dependencyChecker)
 
 	return mux
 }
 
-func compressHandler(handler http.Handler) http.Handler {
+// etagPaths are the read-heavy API endpoints whose responses are served with an ETag
+var etagPaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/api/v[12]/applications$`),
+	regexp.MustCompile(`^/api/v[12]/applications/[^/]+/resource-tree$`),
+	regexp.MustCompile(`^/api/v[12]/settings$`),
+}
+
+func isETagPath(r *http.Request) bool {
+	for _, path := range etagPaths {
+		if path.MatchString(r.URL.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+func compressHandler(handler http.Handler, paths []string) http.Handler {
 	compr := handlers.CompressHandler(handler)
 	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-		if request.Header.Get("Accept") == "text/event-stream" {
+		// the paths are matched against the v1 paths of the endpoints, which also applies to their v2 paths
+		if request.Header.Get("Accept") == "text/event-stream" || (len(paths) > 0 && !glob.MatchStringInList(paths, apiversion.Path(request.URL.Path, apiversion.V1), false)) {
 			handler.ServeHTTP(writer, request)
 		} else {
 			compr.ServeHTTP(writer, request)
@@ -874,7 +1125,7 @@ func compressHandler(handler http.Handler) http.Handler {
 
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
 // using grpc-gateway as a proxy to the gRPC server.
-func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler, appResourceTreeFn application.AppResourceTreeFn, conn *grpc.ClientConn) *http.Server {
+func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler, appResourceTreeFn application.AppResourceTreeFn, conn *grpc.ClientConn, metricsServ *metrics.MetricsServer) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	mux := http.NewServeMux()
 	httpS := http.Server{
@@ -883,7 +1134,7 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 			handler: mux,
 			urlToHandler: map[string]http.Handler{
 				"/api/badge":          badge.NewHandler(a.AppClientset, a.settingsMgr, a.Namespace),
-				common.LogoutEndpoint: logout.NewHandler(a.AppClientset, a.settingsMgr, a.sessionMgr, a.ArgoCDServerOpts.RootPath, a.ArgoCDServerOpts.BaseHRef, a.Namespace),
+				common.LogoutEndpoint: logout.NewHandler(a.AppClientset, a.settingsMgr, a.sessionMgr, a.ArgoCDServerOpts.RootPath, a.ArgoCDServerOpts.BaseHRef, a.Namespace).WithCookieOptions(a.CookieOptions),
 			},
 			contentTypeToHandler: map[string]http.Handler{
 				"application/grpc-web+proto": grpcWebHandler,
@@ -899,18 +1150,27 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(grpc_util.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	// Each version of the REST API has its own gateway mux: the requests to /api/v2 are served by the v1 endpoints
+	// unless their contract changed in v2, in which case their service is registered on the v2 mux too
+	gwRouter := apiversion.NewRouter(gwMuxOpts, gwCookieOpts)
+	gwmux := gwRouter.Mux(apiversion.V1)
 
-	var handler http.Handler = gwmux
+	var handler http.Handler = httputil.WithETag(gwRouter, isETagPath)
 	if a.EnableGZip {
-		handler = compressHandler(handler)
+		handler = compressHandler(handler, a.GZipPaths)
 	}
-	mux.Handle("/api/", handler)
+	mux.Handle("/api/", a.CORSOptions.Handler(apiversion.NewHandler(handler, metricsServ)))
 
 	terminal := application.NewHandler(a.appLister, a.Namespace, a.ApplicationNamespaces, a.db, a.enf, a.Cache, appResourceTreeFn, a.settings.ExecShells).
 		WithFeatureFlagMiddleware(a.settingsMgr.GetSettings)
-	th := util_session.WithAuthMiddleware(a.DisableAuth, a.sessionMgr, terminal)
-	mux.Handle("/terminal", th)
+	th := util_session.WithAuthMiddleware(a.DisableAuth, a.sessionMgr, a.CookieOptions.CookieName(), terminal)
+	if a.ReadOnly {
+		mux.HandleFunc("/terminal", readOnlyHandler)
+		mux.HandleFunc(agent.ProxyPath, readOnlyHandler)
+	} else {
+		mux.Handle("/terminal", th)
+		mux.Handle(agent.ProxyPath, a.serviceSet.AgentService)
+	}
 
 	// Dead code for now
 	// Proxy extension is currently an experimental feature and is disabled
@@ -934,18 +1194,33 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	mustRegisterGWHandler(accountpkg.RegisterAccountServiceHandler, ctx, gwmux, conn)
 	mustRegisterGWHandler(certificatepkg.RegisterCertificateServiceHandler, ctx, gwmux, conn)
 	mustRegisterGWHandler(gpgkeypkg.RegisterGPGKeyServiceHandler, ctx, gwmux, conn)
+	mustRegisterGWHandler(summarypkg.RegisterSummaryServiceHandler, ctx, gwmux, conn)
+	mustRegisterGWHandler(agentpkg.RegisterAgentServiceHandler, ctx, gwmux, conn)
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui", a.RootPath)
-	healthz.ServeHealthCheck(mux, a.healthCheck)
+	healthz.ServeDependencyHealthCheck(mux, a.healthCheck, a.dependencyChecker)
 
 	// Dex reverse proxy and client app and OAuth2 login/callback
 	a.registerDexHandlers(mux)
 
+	// Public keys verifying the tokens signed with asymmetric keys
+	mux.Handle(common.JWKSEndpoint, jwks.NewHandler(a.settingsMgr))
+
 	// Webhook handler for git events (Note: cache timeouts are hardcoded because API server does not write to cache and not really using them)
 	argoDB := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
 	acdWebhookHandler := webhook.NewHandler(a.Namespace, a.AppClientset, a.settings, a.settingsMgr, repocache.NewCache(a.Cache.GetCache(), 24*time.Hour, 3*time.Minute), a.Cache, argoDB)
-	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+	if warmer, ok := a.serviceSet.ApplicationService.(webhook.ManifestWarmer); ok && a.ManifestWarmParallelism > 0 {
+		acdWebhookHandler.SetManifestWarmer(warmer, int64(a.ManifestWarmParallelism))
+	}
+	if previewer, ok := a.serviceSet.ApplicationService.(webhook.PullRequestPreviewer); ok && a.PreviewParallelism > 0 {
+		acdWebhookHandler.SetPullRequestPreviewer(previewer, int64(a.PreviewParallelism), a.PreviewForks)
+	}
+	if a.ReadOnly {
+		mux.HandleFunc("/api/webhook", readOnlyHandler)
+	} else {
+		mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+	}
 
 	// Serve cli binaries directly from API server
 	registerDownloadHandlers(mux, "/download")
@@ -960,7 +1235,7 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	// Serve UI static assets
 	var assetsHandler http.Handler = http.HandlerFunc(a.newStaticAssetsHandler())
 	if a.ArgoCDServerOpts.EnableGZip {
-		assetsHandler = compressHandler(assetsHandler)
+		assetsHandler = compressHandler(assetsHandler, a.GZipPaths)
 	}
 	mux.Handle("/", assetsHandler)
 	return &httpS
@@ -1036,10 +1311,38 @@ func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	mux.HandleFunc(common.DexAPIEndpoint+"/", dexutil.NewDexHTTPReverseProxy(a.DexServerAddr, a.BaseHRef, a.DexTLSConfig))
 	a.ssoClientApp, err = oidc.NewClientApp(a.settings, a.DexServerAddr, a.DexTLSConfig, a.BaseHRef)
 	errorsutil.CheckError(err)
+	if a.settings.IsDexConfigured() && a.Cache != nil {
+		a.ssoClientApp.SetLoginRecorder(a.recordDexLogin)
+	}
+	a.ssoClientApp.SetCookieOptions(a.CookieOptions)
 	mux.HandleFunc(common.LoginEndpoint, a.ssoClientApp.HandleLogin)
 	mux.HandleFunc(common.CallbackEndpoint, a.ssoClientApp.HandleCallback)
 }
 
+// recordDexLogin records the outcome of a login through Dex, which is reported by the status of Dex
+func (a *ArgoCDServer) recordDexLogin(connectorID string, loginErr error) {
+	var status servercache.DexLoginStatus
+	if err := a.Cache.GetDexLoginStatus(&status); err != nil && err != servercache.ErrCacheMiss {
+		log.Warnf("Failed to get the status of the logins through Dex: %v", err)
+		return
+	}
+	now := time.Now().UTC()
+	if loginErr != nil {
+		status.LastError = loginErr.Error()
+		status.LastErrorAt = now
+	} else if connectorID != "" {
+		if status.ConnectorLogins == nil {
+			status.ConnectorLogins = map[string]time.Time{}
+		}
+		status.ConnectorLogins[connectorID] = now
+	} else {
+		return
+	}
+	if err := a.Cache.SetDexLoginStatus(&status); err != nil {
+		log.Warnf("Failed to record the login through Dex: %v", err)
+	}
+}
+
 // newRedirectServer returns an HTTP server which does a 307 redirect to the HTTPS server
 func newRedirectServer(port int, rootPath string) *http.Server {
 	addr := fmt.Sprintf("localhost:%d/%s", port, strings.TrimRight(strings.TrimLeft(rootPath, "/"), "/"))
@@ -1216,7 +1519,7 @@ func (a *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string, error
 	if !ok {
 		return nil, "", ErrNoSession
 	}
-	tokenString := getToken(md)
+	tokenString := getToken(md, a.CookieOptions.CookieName())
 	if tokenString == "" {
 		return nil, "", ErrNoSession
 	}
@@ -1227,8 +1530,8 @@ func (a *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string, error
 	return claims, newToken, nil
 }
 
-// getToken extracts the token from gRPC metadata or cookie headers
-func getToken(md metadata.MD) string {
+// getToken extracts the token from gRPC metadata or from the cookies of the given name in the cookie headers
+func getToken(md metadata.MD, cookieName string) string {
 	// check the "token" metadata
 	{
 		tokens, ok := md[apiclient.MetaDataTokenKey]
@@ -1251,7 +1554,7 @@ func getToken(md metadata.MD) string {
 		header := http.Header{}
 		header.Add("Cookie", t)
 		request := http.Request{Header: header}
-		token, err := httputil.JoinCookies(common.AuthCookieName, request.Cookies())
+		token, err := httputil.JoinCookies(cookieName, request.Cookies())
 		if err == nil && jwtutil.IsValid(token) {
 			return token
 		}
@@ -1282,11 +1585,11 @@ type bug21955Workaround struct {
 }
 
 var pathPatters = []*regexp.Regexp{
-	regexp.MustCompile(`/api/v1/clusters/[^/]+`),
-	regexp.MustCompile(`/api/v1/repositories/[^/]+`),
-	regexp.MustCompile(`/api/v1/repocreds/[^/]+`),
-	regexp.MustCompile(`/api/v1/repositories/[^/]+/apps`),
-	regexp.MustCompile(`/api/v1/repositories/[^/]+/apps/[^/]+`),
+	regexp.MustCompile(`/api/v[12]/clusters/[^/]+`),
+	regexp.MustCompile(`/api/v[12]/repositories/[^/]+`),
+	regexp.MustCompile(`/api/v[12]/repocreds/[^/]+`),
+	regexp.MustCompile(`/api/v[12]/repositories/[^/]+/apps`),
+	regexp.MustCompile(`/api/v[12]/repositories/[^/]+/apps/[^/]+`),
 	regexp.MustCompile(`/settings/clusters/[^/]+`),
 }
 
@@ -1300,6 +1603,34 @@ func (bf *bug21955Workaround) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	bf.handler.ServeHTTP(w, r)
 }
 
+// projectUsageInterceptor counts the calls made to the applications API in the usage statistics of the projects of
+// the applications
+func (a *ArgoCDServer) projectUsageInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if a.Cache == nil || !strings.HasPrefix(info.FullMethod, "/application.ApplicationService/") {
+		return resp, err
+	}
+	appReq, ok := req.(interface {
+		GetName() string
+		GetAppNamespace() string
+	})
+	if !ok || appReq.GetName() == "" {
+		return resp, err
+	}
+	appNs := appReq.GetAppNamespace()
+	if appNs == "" {
+		appNs = a.Namespace
+	}
+	app, getErr := a.appLister.Applications(appNs).Get(appReq.GetName())
+	if getErr != nil {
+		return resp, err
+	}
+	if incrErr := a.Cache.IncrementProjectUsage(app.Spec.GetProject(), appstatecache.ProjectUsageAPICalls, 1); incrErr != nil {
+		log.Warnf("Failed to record the API usage of project %s: %v", app.Spec.GetProject(), incrErr)
+	}
+	return resp, err
+}
+
 func bug21955WorkaroundInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 	if rq, ok := req.(*repositorypkg.RepoQuery); ok {
 		repo, err := url.QueryUnescape(rq.Repo)
@@ -0,0 +1,90 @@
+package session
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+)
+
+const (
+	maxStarredApps  = 100
+	maxRecentApps   = 10
+	maxSavedFilters = 50
+)
+
+// GetPreferences returns the preferences of the current user, which are empty for anonymous users
+func (s *Server) GetPreferences(ctx context.Context, _ *session.GetPreferencesRequest) (*session.UserPreferences, error) {
+	preferences := &session.UserPreferences{}
+	if !sessionmgr.LoggedIn(ctx) {
+		return preferences, nil
+	}
+	err := s.cache.GetUserPreferences(sessionmgr.Iss(ctx), sessionmgr.Sub(ctx), preferences)
+	if err != nil && !errors.Is(err, servercache.ErrCacheMiss) {
+		return nil, status.Errorf(codes.Internal, "failed to get preferences: %v", err)
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences replaces the preferences of the current user. The preferences are stored in Redis, keyed by the
+// issuer and subject of the token of the user, so that they are shared by all the sessions of the user.
+func (s *Server) UpdatePreferences(ctx context.Context, q *session.UserPreferences) (*session.UserPreferences, error) {
+	if !sessionmgr.LoggedIn(ctx) {
+		return nil, status.Errorf(codes.Unauthenticated, "preferences can only be updated by logged in users")
+	}
+	preferences, err := normalizePreferences(q)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid preferences: %v", err)
+	}
+	if err := s.cache.SetUserPreferences(sessionmgr.Iss(ctx), sessionmgr.Sub(ctx), preferences); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update preferences: %v", err)
+	}
+	return preferences, nil
+}
+
+// normalizePreferences removes the duplicate applications, keeps the most recent applications only, and validates the
+// number of starred applications and saved filters
+func normalizePreferences(q *session.UserPreferences) (*session.UserPreferences, error) {
+	preferences := &session.UserPreferences{
+		StarredApps: uniqueStrings(q.StarredApps),
+		RecentApps:  uniqueStrings(q.RecentApps),
+	}
+	if len(preferences.StarredApps) > maxStarredApps {
+		return nil, errors.New("too many starred applications")
+	}
+	if len(preferences.RecentApps) > maxRecentApps {
+		preferences.RecentApps = preferences.RecentApps[:maxRecentApps]
+	}
+	if len(q.SavedFilters) > maxSavedFilters {
+		return nil, errors.New("too many saved filters")
+	}
+	names := map[string]bool{}
+	for _, filter := range q.SavedFilters {
+		if filter == nil || filter.Name == "" {
+			return nil, errors.New("saved filters must have a name")
+		}
+		if names[filter.Name] {
+			return nil, errors.New("duplicate saved filter " + filter.Name)
+		}
+		names[filter.Name] = true
+		preferences.SavedFilters = append(preferences.SavedFilters, &session.SavedFilter{Name: filter.Name, Query: filter.Query})
+	}
+	return preferences, nil
+}
+
+func uniqueStrings(values []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, value := range values {
+		if value != "" && !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,72 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+)
+
+func newTestPreferencesServer() *Server {
+	cache := servercache.NewCache(appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute), time.Minute, time.Minute, time.Minute)
+	return NewServer(nil, nil, nil, nil, nil, cache)
+}
+
+func userContext(issuer, subject string) context.Context {
+	return context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: subject, Issuer: issuer})
+}
+
+func TestPreferences(t *testing.T) {
+	s := newTestPreferencesServer()
+	ctx := userContext(sessionmgr.SessionManagerClaimsIssuer, "admin")
+
+	preferences, err := s.GetPreferences(ctx, &session.GetPreferencesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &session.UserPreferences{}, preferences)
+
+	updated, err := s.UpdatePreferences(ctx, &session.UserPreferences{
+		StarredApps:  []string{"argocd/guestbook", "argocd/guestbook", "argocd/helm-guestbook"},
+		RecentApps:   []string{"argocd/a", "argocd/b", "argocd/c", "argocd/d", "argocd/e", "argocd/f", "argocd/g", "argocd/h", "argocd/i", "argocd/j", "argocd/k"},
+		SavedFilters: []*session.SavedFilter{{Name: "degraded", Query: "health=Degraded"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"argocd/guestbook", "argocd/helm-guestbook"}, updated.StarredApps)
+	assert.Len(t, updated.RecentApps, maxRecentApps)
+	assert.Equal(t, "argocd/a", updated.RecentApps[0])
+
+	preferences, err = s.GetPreferences(ctx, &session.GetPreferencesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, updated, preferences)
+
+	// the preferences of users are kept apart, including the users of other issuers with the same subject
+	preferences, err = s.GetPreferences(userContext("https://dex.example.com", "admin"), &session.GetPreferencesRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, preferences.StarredApps)
+
+	_, err = s.UpdatePreferences(ctx, &session.UserPreferences{SavedFilters: []*session.SavedFilter{{Name: "a"}, {Name: "a"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.UpdatePreferences(ctx, &session.UserPreferences{SavedFilters: []*session.SavedFilter{{Query: "health=Degraded"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPreferences_Anonymous(t *testing.T) {
+	s := newTestPreferencesServer()
+
+	preferences, err := s.GetPreferences(context.Background(), &session.GetPreferencesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &session.UserPreferences{}, preferences)
+
+	_, err = s.UpdatePreferences(context.Background(), &session.UserPreferences{StarredApps: []string{"argocd/guestbook"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	util "github.com/argoproj/argo-cd/v2/util/io"
 	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
@@ -23,6 +24,7 @@ type Server struct {
 	authenticator      Authenticator
 	policyEnf          *rbacpolicy.RBACPolicyEnforcer
 	limitLoginAttempts func() (util.Closer, error)
+	cache              *servercache.Cache
 }
 
 type Authenticator interface {
@@ -30,8 +32,8 @@ type Authenticator interface {
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (util.Closer, error)) *Server {
-	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter}
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (util.Closer, error), cache *servercache.Cache) *Server {
+	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter, cache}
 }
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
@@ -63,9 +65,17 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	if err != nil {
 		return nil, err
 	}
+	if q.ExpiresIn < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token expiry: %d", q.ExpiresIn)
+	}
+	// a token may expire before the end of the session duration, but not after
+	expiresIn := int64(argoCDSettings.UserSessionDuration.Seconds())
+	if q.ExpiresIn > 0 && (expiresIn <= 0 || q.ExpiresIn < expiresIn) {
+		expiresIn = q.ExpiresIn
+	}
 	jwtToken, err := s.mgr.Create(
 		fmt.Sprintf("%s:%s", q.Username, settings.AccountCapabilityLogin),
-		int64(argoCDSettings.UserSessionDuration.Seconds()),
+		expiresIn,
 		uniqueId.String())
 
 	if err != nil {
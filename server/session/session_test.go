@@ -0,0 +1,73 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/util/password"
+	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func newTestSessionServer(t *testing.T) *Server {
+	hash, err := password.HashPassword("password")
+	require.NoError(t, err)
+	kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: "argocd",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"users.session.duration": "24h"},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: "argocd",
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte(hash),
+			"server.secretkey": []byte("Hello, world!"),
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeClient, "argocd")
+	mgr := sessionmgr.NewSessionManager(settingsMgr, nil, "", nil, sessionmgr.NewUserStateStorage(nil))
+	return NewServer(mgr, settingsMgr, nil, nil, nil, nil)
+}
+
+func tokenExpiry(t *testing.T, token string) time.Duration {
+	claims := jwt.RegisteredClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, &claims)
+	require.NoError(t, err)
+	return claims.ExpiresAt.Sub(claims.IssuedAt.Time)
+}
+
+func TestCreate_ExpiresIn(t *testing.T) {
+	s := newTestSessionServer(t)
+
+	res, err := s.Create(context.Background(), &session.SessionCreateRequest{Username: "admin", Password: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, tokenExpiry(t, res.Token))
+
+	res, err = s.Create(context.Background(), &session.SessionCreateRequest{Username: "admin", Password: "password", ExpiresIn: 3600})
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, tokenExpiry(t, res.Token))
+
+	// a token cannot outlive the session duration
+	res, err = s.Create(context.Background(), &session.SessionCreateRequest{Username: "admin", Password: "password", ExpiresIn: 7 * 24 * 3600})
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, tokenExpiry(t, res.Token))
+
+	_, err = s.Create(context.Background(), &session.SessionCreateRequest{Username: "admin", Password: "password", ExpiresIn: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AdmissionPath is the path of the validating admission webhook of the Argo CD config maps
+const AdmissionPath = "/api/admission/settings"
+
+// maxAdmissionReviewSize is the maximum size of the admission reviews sent by the Kubernetes API server
+const maxAdmissionReviewSize = 3 * 1024 * 1024
+
+// NewAdmissionHandler returns a handler of Kubernetes validating admission reviews which rejects the creation or update
+// of invalid Argo CD config maps in the given namespace
+func NewAdmissionHandler(namespace string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxAdmissionReviewSize))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read admission review: %v", err), http.StatusBadRequest)
+			return
+		}
+		var review admissionv1.AdmissionReview
+		if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+			http.Error(w, "invalid admission review", http.StatusBadRequest)
+			return
+		}
+		review.Response = reviewConfigMap(namespace, review.Request)
+		review.Request = nil
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(review); err != nil {
+			log.Warnf("Failed to write admission review response: %v", err)
+		}
+	}
+}
+
+// reviewConfigMap returns the admission response of the given request, denying invalid Argo CD config maps
+func reviewConfigMap(namespace string, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	res := &admissionv1.AdmissionResponse{UID: req.UID, Allowed: true}
+	if req.Namespace != namespace || req.Kind.Kind != "ConfigMap" || (req.Operation != admissionv1.Create && req.Operation != admissionv1.Update) {
+		return res
+	}
+	validate, ok := configMapValidators()[req.Name]
+	if !ok {
+		return res
+	}
+	var cm apiv1.ConfigMap
+	if err := json.Unmarshal(req.Object.Raw, &cm); err != nil {
+		res.Allowed = false
+		res.Result = &metav1.Status{Status: metav1.StatusFailure, Message: fmt.Sprintf("failed to decode config map: %v", err)}
+		return res
+	}
+	if err := validate(&cm); err != nil {
+		log.Infof("Rejected invalid config map '%s': %v", req.Name, err)
+		res.Allowed = false
+		res.Result = &metav1.Status{Status: metav1.StatusFailure, Message: fmt.Sprintf("config map '%s' is invalid: %v", req.Name, err)}
+	}
+	return res
+}
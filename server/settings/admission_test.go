@@ -0,0 +1,80 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func reviewAdmission(t *testing.T, namespace string, operation admissionv1.Operation, cm *apiv1.ConfigMap) *admissionv1.AdmissionResponse {
+	raw, err := json.Marshal(cm)
+	require.NoError(t, err)
+	review := admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("123"),
+			Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			Name:      cm.Name,
+			Namespace: namespace,
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+	body, err := json.Marshal(review)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	NewAdmissionHandler("argocd").ServeHTTP(w, httptest.NewRequest(http.MethodPost, AdmissionPath, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var res admissionv1.AdmissionReview
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.NotNil(t, res.Response)
+	assert.Equal(t, types.UID("123"), res.Response.UID)
+	return res.Response
+}
+
+func TestAdmissionHandler(t *testing.T) {
+	invalidCM := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-rbac-cm"},
+		Data:       map[string]string{"policy.matchMode": "fuzzy"},
+	}
+	t.Run("ValidConfigMap", func(t *testing.T) {
+		res := reviewAdmission(t, "argocd", admissionv1.Update, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-cm"},
+			Data:       map[string]string{"url": "https://argocd.example.com"},
+		})
+		assert.True(t, res.Allowed)
+	})
+	t.Run("InvalidConfigMap", func(t *testing.T) {
+		res := reviewAdmission(t, "argocd", admissionv1.Create, invalidCM)
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "config map 'argocd-rbac-cm' is invalid")
+	})
+	t.Run("OtherNamespace", func(t *testing.T) {
+		res := reviewAdmission(t, "default", admissionv1.Update, invalidCM)
+		assert.True(t, res.Allowed)
+	})
+	t.Run("OtherConfigMap", func(t *testing.T) {
+		res := reviewAdmission(t, "argocd", admissionv1.Update, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-cm"},
+			Data:       map[string]string{"policy.matchMode": "fuzzy"},
+		})
+		assert.True(t, res.Allowed)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		NewAdmissionHandler("argocd").ServeHTTP(w, httptest.NewRequest(http.MethodPost, AdmissionPath, bytes.NewReader([]byte("{}"))))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
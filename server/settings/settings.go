@@ -3,12 +3,23 @@ package settings
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/dex"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 
 	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
 
@@ -17,13 +28,29 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
+// configMapValidators returns the validation of each Argo CD config map which can be validated before it is saved, by
+// name of the config map of the Argo CD instance
+func configMapValidators() map[string]func(cm *apiv1.ConfigMap) error {
+	return map[string]func(cm *apiv1.ConfigMap) error{
+		common.GetArgoCDConfigMapName():     settings.ValidateArgoCDConfigMap,
+		common.GetArgoCDRBACConfigMapName(): rbac.ValidateConfigMap,
+	}
+}
+
+// dexStatusTimeout is the timeout of the requests made to Dex to check whether it is reachable
+const dexStatusTimeout = 5 * time.Second
+
 // Server provides a Settings service
 type Server struct {
 	mgr                       *settings.SettingsManager
 	repoClient                apiclient.Clientset
 	authenticator             Authenticator
+	enf                       *rbac.Enforcer
 	disableAuth               bool
 	appsInAnyNamespaceEnabled bool
+	cache                     *servercache.Cache
+	dexServerAddr             string
+	dexTLSConfig              *dex.DexTLSConfig
 }
 
 type Authenticator interface {
@@ -31,8 +58,18 @@ type Authenticator interface {
 }
 
 // NewServer returns a new instance of the Settings service
-func NewServer(mgr *settings.SettingsManager, repoClient apiclient.Clientset, authenticator Authenticator, disableAuth, appsInAnyNamespaceEnabled bool) *Server {
-	return &Server{mgr: mgr, repoClient: repoClient, authenticator: authenticator, disableAuth: disableAuth, appsInAnyNamespaceEnabled: appsInAnyNamespaceEnabled}
+func NewServer(mgr *settings.SettingsManager, repoClient apiclient.Clientset, authenticator Authenticator, enf *rbac.Enforcer, disableAuth, appsInAnyNamespaceEnabled bool, cache *servercache.Cache, dexServerAddr string, dexTLSConfig *dex.DexTLSConfig) *Server {
+	return &Server{
+		mgr:                       mgr,
+		repoClient:                repoClient,
+		authenticator:             authenticator,
+		enf:                       enf,
+		disableAuth:               disableAuth,
+		appsInAnyNamespaceEnabled: appsInAnyNamespaceEnabled,
+		cache:                     cache,
+		dexServerAddr:             dexServerAddr,
+		dexTLSConfig:              dexTLSConfig,
+	}
 }
 
 // Get returns Argo CD settings
@@ -135,6 +172,10 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 		set.UiBannerPermanent = argoCDSettings.UiBannerPermanent
 		set.UiBannerPosition = argoCDSettings.UiBannerPosition
 		set.ControllerNamespace = s.mgr.GetNamespace()
+		set.AutomationPaused, set.AutomationPausedReason, err = s.mgr.GetAutomationPaused()
+		if err != nil {
+			return nil, err
+		}
 	}
 	if argoCDSettings.DexConfig != "" {
 		var cfg settingspkg.DexConfig
@@ -199,6 +240,107 @@ func (s *Server) plugins(ctx context.Context, includeV2Plugins bool) ([]*setting
 	return out, nil
 }
 
+// Validate validates the contents of an Argo CD config map before it is saved
+func (s *Server) Validate(ctx context.Context, q *settingspkg.SettingsValidateRequest) (*settingspkg.SettingsValidateResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceSettings, rbacpolicy.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	validate, ok := configMapValidators()[q.Name]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "validation of config map '%s' is not supported", q.Name)
+	}
+	if err := validate(&apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: q.Name}, Data: q.Data}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "config map '%s' is invalid: %v", q.Name, err)
+	}
+	return &settingspkg.SettingsValidateResponse{}, nil
+}
+
+// GetFeatureFlags returns the feature flags of the Argo CD instance, including the ones set in argocd-cm which are
+// unknown to this version of Argo CD
+func (s *Server) GetFeatureFlags(ctx context.Context, q *settingspkg.SettingsQuery) (*settingspkg.FeatureFlagsResponse, error) {
+	configured, err := s.mgr.GetConfiguredFeatureFlags()
+	if err != nil {
+		return nil, err
+	}
+	res := &settingspkg.FeatureFlagsResponse{}
+	known := map[string]bool{}
+	for _, flag := range settings.KnownFeatureFlags {
+		_, ok := configured[flag.Name]
+		known[flag.Name] = true
+		res.Flags = append(res.Flags, &settingspkg.FeatureFlag{
+			Name:        flag.Name,
+			Description: flag.Description,
+			Enabled:     configured.Enabled(flag.Name),
+			Default:     flag.Default,
+			Configured:  ok,
+			Known:       true,
+		})
+	}
+	for _, name := range configured.Names() {
+		if !known[name] {
+			res.Flags = append(res.Flags, &settingspkg.FeatureFlag{Name: name, Configured: true})
+		}
+	}
+	return res, nil
+}
+
+// GetDexStatus returns the status of the Dex server and its connectors
+func (s *Server) GetDexStatus(ctx context.Context, q *settingspkg.SettingsQuery) (*settingspkg.DexStatus, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceSettings, rbacpolicy.ActionGet, common.GetArgoCDConfigMapName()); err != nil {
+		return nil, err
+	}
+	argoCDSettings, err := s.mgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	configErr := dex.ValidateDexConfig(argoCDSettings)
+	res := &settingspkg.DexStatus{Configured: configErr != nil || argoCDSettings.IsDexConfigured()}
+	if !res.Configured {
+		return res, nil
+	}
+	if configErr != nil {
+		res.ConfigError = configErr.Error()
+	}
+	if err := s.checkDex(ctx, argoCDSettings); err != nil {
+		res.Error = err.Error()
+	} else {
+		res.Reachable = true
+	}
+
+	var loginStatus servercache.DexLoginStatus
+	if s.cache != nil {
+		if err := s.cache.GetDexLoginStatus(&loginStatus); err != nil && err != servercache.ErrCacheMiss {
+			return nil, fmt.Errorf("error getting the status of the logins through Dex: %w", err)
+		}
+	}
+	if loginStatus.LastError != "" {
+		res.LastLoginError = loginStatus.LastError
+		res.LastLoginErrorAt = &metav1.Time{Time: loginStatus.LastErrorAt}
+	}
+
+	var dexCfg settingspkg.DexConfig
+	// an invalid configuration is already reported by the config error
+	_ = yaml.Unmarshal([]byte(argoCDSettings.DexConfig), &dexCfg)
+	for _, connector := range dexCfg.Connectors {
+		connectorStatus := &settingspkg.DexConnectorStatus{Id: connector.Id, Name: connector.Name, Type: connector.Type}
+		if lastLoginAt, ok := loginStatus.ConnectorLogins[connector.Id]; ok {
+			connectorStatus.LastLoginAt = &metav1.Time{Time: lastLoginAt}
+		}
+		res.Connectors = append(res.Connectors, connectorStatus)
+	}
+	sort.Slice(res.Connectors, func(i, j int) bool {
+		return res.Connectors[i].Id < res.Connectors[j].Id
+	})
+	return res, nil
+}
+
+// checkDex checks that Dex serves the discovery document of its issuer
+func (s *Server) checkDex(ctx context.Context, argoCDSettings *settings.ArgoCDSettings) error {
+	ctx, cancel := context.WithTimeout(ctx, dexStatusTimeout)
+	defer cancel()
+	return dex.CheckDiscovery(ctx, s.dexServerAddr, s.dexTLSConfig, argoCDSettings.IssuerURL())
+}
+
 // AuthFuncOverride disables authentication for settings service
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
 	ctx, err := s.authenticator.Authenticate(ctx)
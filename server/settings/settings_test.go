@@ -0,0 +1,170 @@
+package settings
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testDexConfig = `connectors:
+- type: github
+  id: github
+  name: GitHub
+  config:
+    clientID: aabbccddeeff00112233
+    clientSecret: aabbccddeeff00112233
+- type: ldap
+  id: ldap
+  name: LDAP
+  config:
+    host: ldap.example.com:636`
+
+func newTestDexStatusServer(t *testing.T, dexConfig string, dexServerAddr string) (*Server, *servercache.Cache) {
+	cm := test.NewFakeConfigMap()
+	cm.Data["url"] = "https://argocd.example.com"
+	cm.Data["dex.config"] = dexConfig
+	kubeClient := fake.NewSimpleClientset(cm, test.NewFakeSecret())
+	mgr := settings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace)
+	cache := servercache.NewCache(appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute), time.Minute, time.Minute, time.Minute)
+	enf := rbac.NewEnforcer(kubeClient, test.FakeArgoCDNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	enf.SetDefaultRole("role:readonly")
+	return NewServer(mgr, nil, nil, enf, false, false, cache, dexServerAddr, nil), cache
+}
+
+func TestGetDexStatus(t *testing.T) {
+	dexServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/dex/.well-known/openid-configuration" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"issuer": "https://argocd.example.com/api/dex"}`))
+	}))
+	t.Cleanup(dexServer.Close)
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		s, _ := newTestDexStatusServer(t, testDexConfig, dexServer.URL)
+		s.enf.SetDefaultRole("")
+		ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "anonymous"})
+		_, err := s.GetDexStatus(ctx, &settingspkg.SettingsQuery{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		s, _ := newTestDexStatusServer(t, "", dexServer.URL)
+		res, err := s.GetDexStatus(context.Background(), &settingspkg.SettingsQuery{})
+		require.NoError(t, err)
+		assert.False(t, res.Configured)
+		assert.Empty(t, res.Connectors)
+	})
+
+	t.Run("Reachable", func(t *testing.T) {
+		s, cache := newTestDexStatusServer(t, testDexConfig, dexServer.URL)
+		lastLoginAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+		require.NoError(t, cache.SetDexLoginStatus(&servercache.DexLoginStatus{
+			ConnectorLogins: map[string]time.Time{"github": lastLoginAt},
+			LastError:       "access_denied: user denied access",
+			LastErrorAt:     lastLoginAt,
+		}))
+
+		res, err := s.GetDexStatus(context.Background(), &settingspkg.SettingsQuery{})
+		require.NoError(t, err)
+		assert.True(t, res.Configured)
+		assert.Empty(t, res.ConfigError)
+		assert.True(t, res.Reachable)
+		assert.Empty(t, res.Error)
+		assert.Equal(t, "access_denied: user denied access", res.LastLoginError)
+		require.Len(t, res.Connectors, 2)
+		assert.Equal(t, "github", res.Connectors[0].Id)
+		assert.Equal(t, "GitHub", res.Connectors[0].Name)
+		require.NotNil(t, res.Connectors[0].LastLoginAt)
+		assert.True(t, lastLoginAt.Equal(res.Connectors[0].LastLoginAt.Time))
+		assert.Equal(t, "ldap", res.Connectors[1].Id)
+		assert.Nil(t, res.Connectors[1].LastLoginAt)
+	})
+
+	t.Run("InvalidConfig", func(t *testing.T) {
+		s, _ := newTestDexStatusServer(t, "connectors: [", dexServer.URL)
+		res, err := s.GetDexStatus(context.Background(), &settingspkg.SettingsQuery{})
+		require.NoError(t, err)
+		assert.Contains(t, res.ConfigError, "failed to unmarshal dex.config")
+	})
+
+	t.Run("Unreachable", func(t *testing.T) {
+		s, _ := newTestDexStatusServer(t, testDexConfig, "http://127.0.0.1:1")
+		res, err := s.GetDexStatus(context.Background(), &settingspkg.SettingsQuery{})
+		require.NoError(t, err)
+		assert.False(t, res.Reachable)
+		assert.Contains(t, res.Error, "error reaching Dex")
+	})
+}
+
+func TestGetFeatureFlags(t *testing.T) {
+	cm := test.NewFakeConfigMap()
+	cm.Data["featureFlags"] = "serverSideApply: false\nfutureSubsystem: true\n"
+	kubeClient := fake.NewSimpleClientset(cm, test.NewFakeSecret())
+	mgr := settings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace)
+	s := NewServer(mgr, nil, nil, nil, false, false, nil, "", nil)
+
+	res, err := s.GetFeatureFlags(context.Background(), &settingspkg.SettingsQuery{})
+	require.NoError(t, err)
+	flags := map[string]*settingspkg.FeatureFlag{}
+	for _, flag := range res.Flags {
+		flags[flag.Name] = flag
+	}
+	require.Len(t, flags, 4)
+	assert.Equal(t, &settingspkg.FeatureFlag{
+		Name:        settings.FeatureFlagServerSideApply,
+		Description: "Sync the applications with the ServerSideApply=true sync option with server-side apply",
+		Enabled:     false,
+		Default:     true,
+		Configured:  true,
+		Known:       true,
+	}, flags[settings.FeatureFlagServerSideApply])
+	assert.False(t, flags[settings.FeatureFlagProgressiveSyncs].Enabled)
+	assert.False(t, flags[settings.FeatureFlagProgressiveSyncs].Configured)
+	assert.Equal(t, &settingspkg.FeatureFlag{Name: "futureSubsystem", Configured: true}, flags["futureSubsystem"])
+}
+
+func TestValidate(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(test.NewFakeConfigMap(), test.NewFakeSecret())
+	mgr := settings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace)
+	enf := rbac.NewEnforcer(kubeClient, test.FakeArgoCDNamespace, common.ArgoCDRBACConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	s := NewServer(mgr, nil, nil, enf, false, false, nil, "", nil)
+	req := &settingspkg.SettingsValidateRequest{Name: common.ArgoCDRBACConfigMapName, Data: map[string]string{"policy.csv": "g, my-org:team-alpha, role:admin"}}
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "anonymous"})
+		_, err := s.Validate(ctx, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Authorized", func(t *testing.T) {
+		enf.SetDefaultRole("role:readonly")
+		ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "anonymous"})
+		_, err := s.Validate(ctx, req)
+		assert.NoError(t, err)
+		_, err = s.Validate(ctx, &settingspkg.SettingsValidateRequest{Name: common.ArgoCDRBACConfigMapName, Data: map[string]string{"policy.csv": "invalid"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,201 @@
+package summary
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/summary"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+const (
+	defaultTopDegradedLimit      = 10
+	defaultRecentOperationsLimit = 10
+)
+
+// Server provides a Summary service
+type Server struct {
+	ns                string
+	appLister         applisters.ApplicationLister
+	enf               *rbac.Enforcer
+	enabledNamespaces []string
+	cache             *servercache.Cache
+}
+
+// NewServer returns a new instance of the Summary service
+func NewServer(namespace string, appLister applisters.ApplicationLister, enf *rbac.Enforcer, enabledNamespaces []string, cache *servercache.Cache) *Server {
+	return &Server{
+		ns:                namespace,
+		appLister:         appLister,
+		enf:               enf,
+		enabledNamespaces: enabledNamespaces,
+		cache:             cache,
+	}
+}
+
+// Get returns the summary of the applications the user is allowed to get
+func (s *Server) Get(ctx context.Context, q *summary.SummaryQuery) (*summary.ApplicationsSummary, error) {
+	selector, err := labels.Parse(q.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the selector: %w", err)
+	}
+	var apps []*appv1.Application
+	if q.AppNamespace == "" {
+		apps, err = s.appLister.List(selector)
+	} else {
+		apps, err = s.appLister.Applications(q.AppNamespace).List(selector)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps with selectors: %w", err)
+	}
+
+	projects := make(map[string]bool)
+	for _, p := range q.Projects {
+		projects[p] = true
+	}
+	var permitted []*appv1.Application
+	for _, a := range apps {
+		if !security.IsNamespaceEnabled(a.Namespace, s.ns, s.enabledNamespaces) {
+			continue
+		}
+		if len(projects) > 0 && !projects[a.Spec.GetProject()] {
+			continue
+		}
+		if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, a.RBACName(s.ns)) {
+			continue
+		}
+		// the resources of the degraded applications are counted
+		if a.Status.Health.Status == health.HealthStatusDegraded && a.Status.ResourcesSource == appv1.ResourceStatusLocationCache {
+			resources, err := s.cache.GetAppStatusResources(a, s.ns)
+			if err != nil {
+				log.Warn(err)
+			}
+			a = a.DeepCopy()
+			a.Status.Resources = resources
+		}
+		permitted = append(permitted, a)
+	}
+
+	topDegradedLimit := defaultTopDegradedLimit
+	if q.TopDegradedLimit > 0 {
+		topDegradedLimit = int(q.TopDegradedLimit)
+	}
+	recentOperationsLimit := defaultRecentOperationsLimit
+	if q.RecentOperationsLimit > 0 {
+		recentOperationsLimit = int(q.RecentOperationsLimit)
+	}
+	return summarize(permitted, topDegradedLimit, recentOperationsLimit), nil
+}
+
+// summarize aggregates the statuses of the given applications
+func summarize(apps []*appv1.Application, topDegradedLimit int, recentOperationsLimit int) *summary.ApplicationsSummary {
+	byHealthStatus := make(map[string]int64)
+	bySyncStatus := make(map[string]int64)
+	byProject := make(map[string]int64)
+	byDestination := make(map[string]int64)
+	var degraded []*summary.DegradedApplication
+	var operations []*summary.RecentOperation
+
+	for _, a := range apps {
+		healthStatus := a.Status.Health.Status
+		if healthStatus == "" {
+			healthStatus = health.HealthStatusUnknown
+		}
+		byHealthStatus[string(healthStatus)]++
+		syncStatus := a.Status.Sync.Status
+		if syncStatus == "" {
+			syncStatus = appv1.SyncStatusCodeUnknown
+		}
+		bySyncStatus[string(syncStatus)]++
+		byProject[a.Spec.GetProject()]++
+		destination := a.Spec.Destination.Server
+		if destination == "" {
+			destination = a.Spec.Destination.Name
+		}
+		byDestination[destination]++
+
+		if healthStatus == health.HealthStatusDegraded {
+			var degradedResources int64
+			for _, res := range a.Status.Resources {
+				if res.Health != nil && res.Health.Status == health.HealthStatusDegraded {
+					degradedResources++
+				}
+			}
+			degraded = append(degraded, &summary.DegradedApplication{
+				Name:              a.Name,
+				Namespace:         a.Namespace,
+				Project:           a.Spec.GetProject(),
+				HealthMessage:     a.Status.Health.Message,
+				DegradedResources: degradedResources,
+			})
+		}
+
+		if op := a.Status.OperationState; op != nil {
+			operation := &summary.RecentOperation{
+				Name:        a.Name,
+				Namespace:   a.Namespace,
+				Project:     a.Spec.GetProject(),
+				Phase:       string(op.Phase),
+				Message:     op.Message,
+				InitiatedBy: op.Operation.InitiatedBy.Username,
+				StartedAt:   op.StartedAt.DeepCopy(),
+				FinishedAt:  op.FinishedAt.DeepCopy(),
+			}
+			if op.SyncResult != nil {
+				operation.Revision = op.SyncResult.Revision
+			}
+			if op.Operation.InitiatedBy.Automated {
+				operation.InitiatedBy = "automated sync policy"
+			}
+			operations = append(operations, operation)
+		}
+	}
+
+	sort.SliceStable(degraded, func(i, j int) bool {
+		if degraded[i].DegradedResources != degraded[j].DegradedResources {
+			return degraded[i].DegradedResources > degraded[j].DegradedResources
+		}
+		return degraded[i].Name < degraded[j].Name
+	})
+	if len(degraded) > topDegradedLimit {
+		degraded = degraded[:topDegradedLimit]
+	}
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[j].StartedAt.Before(operations[i].StartedAt)
+	})
+	if len(operations) > recentOperationsLimit {
+		operations = operations[:recentOperationsLimit]
+	}
+
+	return &summary.ApplicationsSummary{
+		Total:            int64(len(apps)),
+		ByHealthStatus:   toCounts(byHealthStatus),
+		BySyncStatus:     toCounts(bySyncStatus),
+		ByProject:        toCounts(byProject),
+		ByDestination:    toCounts(byDestination),
+		TopDegraded:      degraded,
+		RecentOperations: operations,
+	}
+}
+
+// toCounts returns the counts sorted by key
+func toCounts(counts map[string]int64) []*summary.Count {
+	res := make([]*summary.Count, 0, len(counts))
+	for key, count := range counts {
+		res = append(res, &summary.Count{Key: key, Count: count})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res
+}
@@ -0,0 +1,172 @@
+package summary
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/summary"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	fakeapps "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+const testNamespace = "argocd"
+
+func newTestApp(name string, namespace string, project string, healthStatus health.HealthStatusCode, syncStatus appsv1.SyncStatusCode) *appsv1.Application {
+	return &appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: map[string]string{"team": project}},
+		Spec: appsv1.ApplicationSpec{
+			Project:     project,
+			Destination: appsv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		},
+		Status: appsv1.ApplicationStatus{
+			Health: appsv1.HealthStatus{Status: healthStatus},
+			Sync:   appsv1.SyncStatus{Status: syncStatus},
+		},
+	}
+}
+
+func withOperation(app *appsv1.Application, phase synccommon.OperationPhase, startedAt time.Time, automated bool) *appsv1.Application {
+	app.Status.OperationState = &appsv1.OperationState{
+		Operation: appsv1.Operation{InitiatedBy: appsv1.OperationInitiator{Username: "admin", Automated: automated}},
+		Phase:     phase,
+		StartedAt: metav1.NewTime(startedAt),
+		SyncResult: &appsv1.SyncOperationResult{
+			Revision: "abc123",
+		},
+	}
+	return app
+}
+
+func withDegradedResources(app *appsv1.Application, count int) *appsv1.Application {
+	for i := 0; i < count; i++ {
+		app.Status.Resources = append(app.Status.Resources, appsv1.ResourceStatus{Health: &appsv1.HealthStatus{Status: health.HealthStatusDegraded}})
+	}
+	return app
+}
+
+func newTestServer(t *testing.T, enabledNamespaces []string, objects ...runtime.Object) *Server {
+	return newTestServerWithCache(t, enabledNamespaces, appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour), objects...)
+}
+
+func newTestServerWithCache(t *testing.T, enabledNamespaces []string, appStateCache *appstate.Cache, objects ...runtime.Object) *Server {
+	factory := appinformer.NewSharedInformerFactoryWithOptions(fakeapps.NewSimpleClientset(objects...), 0)
+	appsInformer := factory.Argoproj().V1alpha1().Applications()
+	for _, obj := range objects {
+		require.NoError(t, appsInformer.Informer().GetStore().Add(obj))
+	}
+
+	enforcer := rbac.NewEnforcer(fake.NewSimpleClientset(), testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	// the user is not allowed to get the applications of the restricted project
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return !strings.HasPrefix(rvals[3].(string), "restricted/")
+	})
+	return NewServer(testNamespace, appsInformer.Lister(), enforcer, enabledNamespaces, servercache.NewCache(appStateCache, time.Hour, time.Hour, time.Hour))
+}
+
+func testContext() context.Context {
+	return context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "admin"})
+}
+
+func TestGet(t *testing.T) {
+	now := time.Now()
+	objects := []runtime.Object{
+		withOperation(newTestApp("guestbook", testNamespace, "default", health.HealthStatusHealthy, appsv1.SyncStatusCodeSynced), synccommon.OperationSucceeded, now.Add(-time.Hour), false),
+		withOperation(withDegradedResources(newTestApp("billing", testNamespace, "payments", health.HealthStatusDegraded, appsv1.SyncStatusCodeOutOfSync), 1), synccommon.OperationFailed, now.Add(-time.Minute), true),
+		withDegradedResources(newTestApp("checkout", testNamespace, "payments", health.HealthStatusDegraded, appsv1.SyncStatusCodeSynced), 3),
+		newTestApp("unknown", testNamespace, "default", "", ""),
+		newTestApp("secret", testNamespace, "restricted", health.HealthStatusDegraded, appsv1.SyncStatusCodeSynced),
+		newTestApp("other", "other-ns", "default", health.HealthStatusHealthy, appsv1.SyncStatusCodeSynced),
+	}
+
+	t.Run("All", func(t *testing.T) {
+		res, err := newTestServer(t, nil, objects...).Get(testContext(), &summary.SummaryQuery{})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(4), res.Total)
+		assert.Equal(t, []*summary.Count{{Key: "Degraded", Count: 2}, {Key: "Healthy", Count: 1}, {Key: "Unknown", Count: 1}}, res.ByHealthStatus)
+		assert.Equal(t, []*summary.Count{{Key: "OutOfSync", Count: 1}, {Key: "Synced", Count: 2}, {Key: "Unknown", Count: 1}}, res.BySyncStatus)
+		assert.Equal(t, []*summary.Count{{Key: "default", Count: 2}, {Key: "payments", Count: 2}}, res.ByProject)
+		assert.Equal(t, []*summary.Count{{Key: "https://kubernetes.default.svc", Count: 4}}, res.ByDestination)
+
+		require.Len(t, res.TopDegraded, 2)
+		assert.Equal(t, "checkout", res.TopDegraded[0].Name)
+		assert.Equal(t, int64(3), res.TopDegraded[0].DegradedResources)
+		assert.Equal(t, "billing", res.TopDegraded[1].Name)
+
+		require.Len(t, res.RecentOperations, 2)
+		assert.Equal(t, "billing", res.RecentOperations[0].Name)
+		assert.Equal(t, "Failed", res.RecentOperations[0].Phase)
+		assert.Equal(t, "automated sync policy", res.RecentOperations[0].InitiatedBy)
+		assert.Equal(t, "guestbook", res.RecentOperations[1].Name)
+		assert.Equal(t, "admin", res.RecentOperations[1].InitiatedBy)
+		assert.Equal(t, "abc123", res.RecentOperations[1].Revision)
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		res, err := newTestServer(t, nil, objects...).Get(testContext(), &summary.SummaryQuery{TopDegradedLimit: 1, RecentOperationsLimit: 1})
+		require.NoError(t, err)
+		require.Len(t, res.TopDegraded, 1)
+		assert.Equal(t, "checkout", res.TopDegraded[0].Name)
+		require.Len(t, res.RecentOperations, 1)
+		assert.Equal(t, "billing", res.RecentOperations[0].Name)
+	})
+
+	t.Run("Projects", func(t *testing.T) {
+		res, err := newTestServer(t, nil, objects...).Get(testContext(), &summary.SummaryQuery{Projects: []string{"payments"}})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.Total)
+		assert.Equal(t, []*summary.Count{{Key: "payments", Count: 2}}, res.ByProject)
+	})
+
+	t.Run("Selector", func(t *testing.T) {
+		res, err := newTestServer(t, nil, objects...).Get(testContext(), &summary.SummaryQuery{Selector: "team=default"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), res.Total)
+
+		_, err = newTestServer(t, nil, objects...).Get(testContext(), &summary.SummaryQuery{Selector: "team in (default"})
+		assert.Error(t, err)
+	})
+
+	t.Run("OffloadedResources", func(t *testing.T) {
+		offloaded := newTestApp("offloaded", testNamespace, "payments", health.HealthStatusDegraded, appsv1.SyncStatusCodeSynced)
+		offloaded.Status.ResourcesSource = appsv1.ResourceStatusLocationCache
+		appStateCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+		require.NoError(t, appStateCache.SetAppResourcesStatus("offloaded", withDegradedResources(offloaded.DeepCopy(), 5).Status.Resources))
+
+		res, err := newTestServerWithCache(t, nil, appStateCache, append(objects, offloaded)...).Get(testContext(), &summary.SummaryQuery{})
+		require.NoError(t, err)
+		require.Len(t, res.TopDegraded, 3)
+		assert.Equal(t, "offloaded", res.TopDegraded[0].Name)
+		assert.Equal(t, int64(5), res.TopDegraded[0].DegradedResources)
+		// the application in the informer cache is not modified
+		assert.Empty(t, offloaded.Status.Resources)
+	})
+
+	t.Run("EnabledNamespaces", func(t *testing.T) {
+		server := newTestServer(t, []string{"other-ns"}, objects...)
+		res, err := server.Get(testContext(), &summary.SummaryQuery{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), res.Total)
+
+		res, err = server.Get(testContext(), &summary.SummaryQuery{AppNamespace: "other-ns"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.Total)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/go-jsonnet"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/version"
@@ -33,8 +34,20 @@ func (s *Server) Version(ctx context.Context, _ *empty.Empty) (*version.VersionM
 		return nil, err
 	}
 
+	// the supported CLI versions and the features are returned to anonymous users, so that the CLI can check them
+	// before logging in
+	minCLIVersion, maxCLIVersion, err := common.SupportedCLIVersions(vers.Version)
+	if err != nil {
+		log.Warnf("Failed to determine the supported CLI versions: %v", err)
+	}
+
 	if !sessionmgr.LoggedIn(ctx) && !disableAuth {
-		return &version.VersionMessage{Version: vers.Version}, nil
+		return &version.VersionMessage{
+			Version:       vers.Version,
+			MinCLIVersion: minCLIVersion,
+			MaxCLIVersion: maxCLIVersion,
+			Features:      common.ServerFeatures,
+		}, nil
 	}
 
 	if s.kustomizeVersion == "" {
@@ -67,6 +80,9 @@ func (s *Server) Version(ctx context.Context, _ *empty.Empty) (*version.VersionM
 		HelmVersion:      s.helmVersion,
 		JsonnetVersion:   s.jsonnetVersion,
 		KubectlVersion:   vers.KubectlVersion,
+		MinCLIVersion:    minCLIVersion,
+		MaxCLIVersion:    maxCLIVersion,
+		Features:         common.ServerFeatures,
 	}, nil
 }
 
@@ -6,6 +6,8 @@ import (
 
 	"github.com/spf13/cobra/doc"
 
+	argocdadmissionwebhook "github.com/argoproj/argo-cd/v2/cmd/argocd-admission-webhook/commands"
+	argocdagent "github.com/argoproj/argo-cd/v2/cmd/argocd-agent/commands"
 	controller "github.com/argoproj/argo-cd/v2/cmd/argocd-application-controller/commands"
 	argocddex "github.com/argoproj/argo-cd/v2/cmd/argocd-dex/commands"
 	reposerver "github.com/argoproj/argo-cd/v2/cmd/argocd-repo-server/commands"
@@ -43,4 +45,14 @@ func main() {
 		log.Fatal(err)
 	}
 
+	err = doc.GenMarkdownTree(argocdagent.NewCommand(), "./docs/operator-manual/server-commands")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = doc.GenMarkdownTree(argocdadmissionwebhook.NewCommand(), "./docs/operator-manual/server-commands")
+	if err != nil {
+		log.Fatal(err)
+	}
+
 }
@@ -5,6 +5,7 @@ import {Revision, Timestamp} from '../../../shared/components';
 import * as models from '../../../shared/models';
 import {services} from '../../../shared/services';
 import {ApplicationParameters} from '../application-parameters/application-parameters';
+import {formatOperationInitiator} from '../utils';
 import {RevisionMetadataRows} from './revision-metadata-rows';
 import './application-deployment-history.scss';
 
@@ -48,6 +49,12 @@ export const ApplicationDeploymentHistory = ({
                             <br />
                             <Duration durationMs={info.durationMs} />
                         </div>
+                        <div>
+                            <br />
+                            <i className='fa fa-user' /> <span class='show-for-large'>Initiated by:</span>
+                            <br />
+                            {formatOperationInitiator(info.initiatedBy) || 'Unknown'}
+                        </div>
                     </div>
                     <div className='columns small-9'>
                         <div className='row'>
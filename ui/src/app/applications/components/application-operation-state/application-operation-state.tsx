@@ -95,14 +95,7 @@ export const ApplicationOperationState: React.StatelessComponent<Props> = ({appl
     if (operationState.syncResult) {
         operationAttributes.push({title: 'REVISION', value: <Revision repoUrl={utils.getAppDefaultSource(application).repoURL} revision={operationState.syncResult.revision} />});
     }
-    let initiator = '';
-    if (operationState.operation.initiatedBy) {
-        if (operationState.operation.initiatedBy.automated) {
-            initiator = 'automated sync policy';
-        } else {
-            initiator = operationState.operation.initiatedBy.username;
-        }
-    }
+    const initiator = utils.formatOperationInitiator(operationState.operation.initiatedBy);
     operationAttributes.push({title: 'INITIATED BY', value: initiator || 'Unknown'});
 
     const resultAttributes: {title: string; value: string}[] = [];
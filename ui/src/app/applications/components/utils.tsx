@@ -38,6 +38,16 @@ export function isSameNode(first: NodeId, second: NodeId) {
     return nodeKey(first) === nodeKey(second);
 }
 
+export function formatOperationInitiator(initiator: appModels.OperationInitiator) {
+    if (!initiator) {
+        return '';
+    }
+    if (initiator.automated) {
+        return initiator.username ? `${initiator.username} (automated)` : 'automated sync policy';
+    }
+    return initiator.username && initiator.client ? `${initiator.username} (${initiator.client})` : initiator.username;
+}
+
 export function helpTip(text: string) {
     return (
         <Tooltip content={text}>
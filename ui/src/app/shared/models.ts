@@ -55,6 +55,9 @@ export interface RollbackOperation {
 export interface OperationInitiator {
     username: string;
     automated: boolean;
+    subject?: string;
+    client?: string;
+    userAgent?: string;
 }
 
 export interface Operation {
@@ -289,6 +292,7 @@ export interface RevisionHistory {
     sources: ApplicationSource[];
     deployStartedAt: models.Time;
     deployedAt: models.Time;
+    initiatedBy?: OperationInitiator;
 }
 
 export type SyncStatusCode = 'Unknown' | 'Synced' | 'OutOfSync';
@@ -388,6 +392,18 @@ export interface ResourceDiff extends ResourceID {
     hook: boolean;
 }
 
+export interface ResourceRevisionsDiff extends ResourceID {
+    baseState: string;
+    headState: string;
+    modified: boolean;
+}
+
+export interface ApplicationRevisionsDiff {
+    items: ResourceRevisionsDiff[];
+    baseRevision: string;
+    headRevision: string;
+}
+
 export interface SyncStatus {
     comparedTo: ApplicationSource;
     status: SyncStatusCode;
@@ -470,6 +486,8 @@ export interface AuthSettings {
     uiBannerPosition: string;
     execEnabled: boolean;
     appsInAnyNamespaceEnabled: boolean;
+    automationPaused?: boolean;
+    automationPausedReason?: string;
 }
 
 export interface UserInfo {
@@ -105,6 +105,13 @@ export class ApplicationsService {
             .then(res => res.body as models.ManifestResponse);
     }
 
+    public revisionsDiff(name: string, appNamespace: string, baseRevision: string, headRevision: string): Promise<models.ApplicationRevisionsDiff> {
+        return requests
+            .get(`/applications/${name}/revisions/diff`)
+            .query({appNamespace, baseRevision, headRevision})
+            .then(res => res.body as models.ApplicationRevisionsDiff);
+    }
+
     public updateSpec(appName: string, appNamespace: string, spec: models.ApplicationSpec): Promise<models.ApplicationSpec> {
         return requests
             .put(`/applications/${appName}/spec`)
@@ -229,7 +236,7 @@ export class ApplicationsService {
     ): string {
         const search = this.getLogsQuery(namespace, appNamespace, podName, resource, containerName, null, false);
         search.set('download', 'true');
-        return `api/v1/applications/${applicationName}/logs?${search.toString()}`;
+        return `api/v2/applications/${applicationName}/logs?${search.toString()}`;
     }
 
     public getContainerLogs(
@@ -6,7 +6,10 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/io/files"
+	"github.com/argoproj/argo-cd/v2/util/security"
 )
 
 func Path(root, path string) (string, error) {
@@ -88,3 +91,107 @@ func CheckOutOfBoundsSymlinks(basePath string) error {
 		return nil
 	})
 }
+
+// GetAppRefreshPaths returns the paths within the repository which affect the manifest generation of the given
+// application. The paths are taken from the manifest-generate-paths annotation or, if the annotation is missing, from
+// the application sources if sourcePathChangeDetection is enabled or additional paths are watched. The glob patterns
+// of the manifest-watch-paths annotation are appended. An empty result means that any change in the repository might
+// affect the application.
+func GetAppRefreshPaths(app *v1alpha1.Application, sourcePathChangeDetection bool) []string {
+	var paths []string
+	watchPaths := getAnnotationPaths(app, v1alpha1.AnnotationKeyManifestWatchPaths)
+	if generatePaths := getAnnotationPaths(app, v1alpha1.AnnotationKeyManifestGeneratePaths); len(generatePaths) > 0 {
+		return append(generatePaths, watchPaths...)
+	}
+	if !sourcePathChangeDetection && len(watchPaths) == 0 {
+		return nil
+	}
+	for _, source := range app.Spec.GetSources() {
+		sourcePath := filepath.Clean(source.Path)
+		if sourcePath == "." || sourcePath == string(filepath.Separator) {
+			// the source uses the whole repository
+			return nil
+		}
+		paths = append(paths, strings.TrimPrefix(sourcePath, string(filepath.Separator)))
+	}
+	return append(paths, watchPaths...)
+}
+
+// getAnnotationPaths returns the semicolon-separated paths of the given annotation. Absolute paths are made relative
+// to the repository root, while relative paths are resolved against the path of each application source.
+func getAnnotationPaths(app *v1alpha1.Application, annotation string) []string {
+	var paths []string
+	val, ok := app.Annotations[annotation]
+	if !ok || val == "" {
+		return nil
+	}
+	for _, item := range strings.Split(val, ";") {
+		if item == "" {
+			continue
+		}
+		if filepath.IsAbs(item) {
+			paths = append(paths, item[1:])
+		} else {
+			for _, source := range app.Spec.GetSources() {
+				paths = append(paths, filepath.Clean(filepath.Join(source.Path, item)))
+			}
+		}
+	}
+	return paths
+}
+
+// AppFilesHaveChanged returns true if any of the changed files is located under one of the given refresh paths. Refresh
+// paths containing glob patterns match a file if the pattern matches the file or any of its parent directories. An
+// empty list of refresh paths or changed files is considered a change, since the impact is unknown.
+func AppFilesHaveChanged(refreshPaths []string, changedFiles []string) bool {
+	// an empty slice of changed files means that the payload didn't include a list
+	// of changed files and we have to assume that a refresh is required
+	if len(changedFiles) == 0 {
+		return true
+	}
+
+	if len(refreshPaths) == 0 {
+		// Apps without a given refreshed paths always be refreshed, regardless of changed files
+		// this is the "default" behavior
+		return true
+	}
+
+	// At last one changed file must be under refresh path
+	for _, f := range changedFiles {
+		f = ensureAbsPath(f)
+		for _, item := range refreshPaths {
+			item = ensureAbsPath(item)
+			if isGlobPattern(item) {
+				if globMatchesPathOrParent(item, f) {
+					return true
+				}
+			} else if f == item {
+				return true
+			} else if _, err := security.EnforceToCurrentRoot(item, f); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isGlobPattern(path string) bool {
+	return strings.ContainsAny(path, "*?[{")
+}
+
+// globMatchesPathOrParent returns true if the pattern matches the given path or any of its parent directories
+func globMatchesPathOrParent(pattern string, path string) bool {
+	for ; path != string(filepath.Separator) && path != "."; path = filepath.Dir(path) {
+		if glob.Match(pattern, path, filepath.Separator) {
+			return true
+		}
+	}
+	return false
+}
+
+func ensureAbsPath(input string) string {
+	if !filepath.IsAbs(input) {
+		return string(filepath.Separator) + input
+	}
+	return input
+}
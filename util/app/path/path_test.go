@@ -7,7 +7,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	fileutil "github.com/argoproj/argo-cd/v2/test/fixture/path"
 )
 
@@ -90,3 +92,70 @@ func TestAbsSymlink(t *testing.T) {
 	assert.ErrorAs(t, err, &oobError)
 	assert.Equal(t, oobError.File, "abslink")
 }
+
+func withWatchPaths(app *v1alpha1.Application, watchPaths string) *v1alpha1.Application {
+	app.Annotations[v1alpha1.AnnotationKeyManifestWatchPaths] = watchPaths
+	return app
+}
+
+func TestGetAppRefreshPaths(t *testing.T) {
+	newApp := func(annotation string, paths ...string) *v1alpha1.Application {
+		app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+		if annotation != "" {
+			app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths] = annotation
+		}
+		for _, path := range paths {
+			app.Spec.Sources = append(app.Spec.Sources, v1alpha1.ApplicationSource{Path: path})
+		}
+		return app
+	}
+
+	tests := []struct {
+		name                      string
+		app                       *v1alpha1.Application
+		sourcePathChangeDetection bool
+		expected                  []string
+	}{
+		{"no annotation", newApp("", "source/path"), false, nil},
+		{"annotation", newApp(".;/shared", "source/path"), false, []string{"source/path", "shared"}},
+		{"annotation takes precedence over source path", newApp("/shared", "source/path"), true, []string{"shared"}},
+		{"source path", newApp("", "source/path"), true, []string{"source/path"}},
+		{"source paths of multiple sources", newApp("", "source/path", "/other/path/"), true, []string{"source/path", "other/path"}},
+		{"source at repository root", newApp("", "source/path", "."), true, nil},
+		{"source without path", newApp("", ""), true, nil},
+		{"watch paths with annotation", withWatchPaths(newApp(".", "apps/guestbook"), "/libs/**;../base"), false, []string{"apps/guestbook", "libs/**", "apps/base"}},
+		{"watch paths with source path", withWatchPaths(newApp("", "apps/guestbook"), "/libs/*"), false, []string{"apps/guestbook", "libs/*"}},
+		{"watch paths with source at repository root", withWatchPaths(newApp("", "."), "/libs/*"), false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetAppRefreshPaths(tt.app, tt.sourcePathChangeDetection))
+		})
+	}
+}
+
+func TestAppFilesHaveChanged(t *testing.T) {
+	tests := []struct {
+		name           string
+		refreshPaths   []string
+		changedFiles   []string
+		changeExpected bool
+	}{
+		{"no changed files", []string{"source/path"}, nil, true},
+		{"no refresh paths", nil, []string{"README.md"}, true},
+		{"file under refresh path", []string{"source/path"}, []string{"README.md", "source/path/deployment.yaml"}, true},
+		{"refresh path is a file", []string{"source/path/deployment.yaml"}, []string{"source/path/deployment.yaml"}, true},
+		{"path with common prefix", []string{"source/path"}, []string{"source/path2/deployment.yaml"}, false},
+		{"no file under refresh path", []string{"source/path", "shared"}, []string{"README.md", "other/deployment.yaml"}, false},
+		{"glob matching file", []string{"libs/*.yaml"}, []string{"libs/common.yaml"}, true},
+		{"glob matching parent directory", []string{"libs/*"}, []string{"libs/common/templates/deployment.yaml"}, true},
+		{"glob matching nested file", []string{"charts/**/values.yaml"}, []string{"charts/library/sub/values.yaml"}, true},
+		{"glob with alternatives", []string{"{base,components}/*"}, []string{"components/ingress/kustomization.yaml"}, true},
+		{"glob not matching", []string{"libs/*.yaml"}, []string{"libs/common/deployment.yaml", "other/libs/common.yaml"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.changeExpected, AppFilesHaveChanged(tt.refreshPaths, tt.changedFiles))
+		})
+	}
+}
@@ -1,14 +1,17 @@
 package argo
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
+	"github.com/Masterminds/sprig/v3"
 	"github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/r3labs/diff"
@@ -19,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/typed/application/v1alpha1"
@@ -33,6 +37,16 @@ const (
 	errDestinationMissing = "Destination server missing from app spec"
 )
 
+// namespaceTemplateFuncMap contains the sprig functions available to the namespace templates of destinations, except
+// for those reading the environment of Argo CD
+var namespaceTemplateFuncMap = sprig.GenericFuncMap()
+
+func init() {
+	delete(namespaceTemplateFuncMap, "env")
+	delete(namespaceTemplateFuncMap, "expandenv")
+	delete(namespaceTemplateFuncMap, "getHostByName")
+}
+
 // FormatAppConditions returns string representation of give app condition list
 func FormatAppConditions(conditions []argoappv1.ApplicationCondition) string {
 	formattedConditions := make([]string, 0)
@@ -62,6 +76,24 @@ func FilterByProjects(apps []argoappv1.Application, projects []string) []argoapp
 
 }
 
+// FilterByExternalIDs returns the applications with one of the given external IDs
+func FilterByExternalIDs(apps []argoappv1.Application, ids []string) []argoappv1.Application {
+	if len(ids) == 0 {
+		return apps
+	}
+	idsMap := make(map[string]bool)
+	for i := range ids {
+		idsMap[ids[i]] = true
+	}
+	items := make([]argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if idsMap[apps[i].ExternalID()] {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
 // FilterAppSetsByProjects returns applications which belongs to the specified project
 func FilterAppSetsByProjects(appsets []argoappv1.ApplicationSet, projects []string) []argoappv1.ApplicationSet {
 	if len(projects) == 0 {
@@ -126,20 +158,43 @@ func FilterByName(apps []argoappv1.Application, name string) ([]argoappv1.Applic
 
 // RefreshApp updates the refresh annotation of an application to coerce the controller to process it
 func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType) (*argoappv1.Application, error) {
-	metadata := map[string]interface{}{
-		"metadata": map[string]interface{}{
-			"annotations": map[string]string{
-				argoappv1.AnnotationKeyRefresh: string(refreshType),
-			},
-		},
-	}
+	return RefreshAppWithDeadline(appIf, name, refreshType, nil)
+}
+
+// RefreshAppWithDeadline updates the refresh annotation of an application to coerce the controller to process it. The
+// controller drops the refresh if it cannot start it before the given deadline, if any. A pending refresh of a type
+// taking precedence over the given one is kept.
+func RefreshAppWithDeadline(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, deadline *time.Time) (*argoappv1.Application, error) {
 	var err error
-	patch, err := json.Marshal(metadata)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling metadata: %w", err)
-	}
 	for attempt := 0; attempt < 5; attempt++ {
-		app, err := appIf.Patch(context.Background(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+		var app *argoappv1.Application
+		app, err = appIf.Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application %q: %w", name, err)
+		}
+		requestedType := refreshType
+		if pendingType, ok := app.IsRefreshRequested(); ok && pendingType.Priority() > requestedType.Priority() {
+			requestedType = pendingType
+		}
+		annotations := map[string]interface{}{
+			argoappv1.AnnotationKeyRefresh:         string(requestedType),
+			argoappv1.AnnotationKeyRefreshDeadline: nil,
+			argoappv1.AnnotationKeyRefreshDropped:  nil,
+		}
+		if deadline != nil {
+			annotations[argoappv1.AnnotationKeyRefreshDeadline] = deadline.UTC().Format(time.RFC3339)
+		}
+		var patch []byte
+		patch, err = json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"resourceVersion": app.ResourceVersion,
+				"annotations":     annotations,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling metadata: %w", err)
+		}
+		app, err = appIf.Patch(context.Background(), name, types.MergePatchType, patch, metav1.PatchOptions{})
 		if err != nil {
 			if !apierr.IsConflict(err) {
 				return nil, fmt.Errorf("error patching annotations in application %q: %w", name, err)
@@ -399,41 +454,50 @@ func ValidateDestination(ctx context.Context, dest *argoappv1.ApplicationDestina
 			}
 			dest.SetInferredServer(server)
 		} else {
-			if !dest.IsServerInferred() {
-				return fmt.Errorf("application destination can't have both name and server defined: %s %s", dest.Name, dest.Server)
-			}
+			return dest.ValidateNameAndServer()
 		}
 	}
 	return nil
 }
 
-func validateSourcePermissions(ctx context.Context, source argoappv1.ApplicationSource, proj *argoappv1.AppProject, project string, hasMultipleSources bool) []argoappv1.ApplicationCondition {
-	var conditions []argoappv1.ApplicationCondition
-	if hasMultipleSources {
-		if source.RepoURL == "" || (source.Path == "" && source.Chart == "" && source.Ref == "") {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("spec.source.repoURL and either source.path, source.chart, or source.ref are required for source %s", source),
-			})
-			return conditions
-		}
-	} else {
-		if source.RepoURL == "" || (source.Path == "" && source.Chart == "") {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: "spec.source.repoURL and either spec.source.path or spec.source.chart are required",
-			})
-			return conditions
-		}
+// RenderDestinationNamespace renders the namespace template of the destination of the application, if any, into the
+// namespace of its destination.
+// NOTE: this function WILL write to the destination of the given application.
+func RenderDestinationNamespace(app *argoappv1.Application) error {
+	dest := &app.Spec.Destination
+	if dest.NamespaceTemplate == "" {
+		return nil
 	}
-	if source.Chart != "" && source.TargetRevision == "" {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
-			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "spec.source.targetRevision is required if the manifest source is a helm chart",
-		})
-		return conditions
+	tmpl, err := template.New("namespace").Funcs(namespaceTemplateFuncMap).Option("missingkey=error").Parse(dest.NamespaceTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse namespace template '%s': %w", dest.NamespaceTemplate, err)
+	}
+	data := map[string]interface{}{
+		"app": map[string]interface{}{
+			"name":        app.Name,
+			"namespace":   app.Namespace,
+			"project":     app.Spec.GetProject(),
+			"labels":      app.Labels,
+			"annotations": app.Annotations,
+		},
 	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to render namespace template '%s': %w", dest.NamespaceTemplate, err)
+	}
+	namespace := buf.String()
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("namespace '%s' rendered from template '%s' is invalid: %s", namespace, dest.NamespaceTemplate, strings.Join(errs, ", "))
+	}
+	dest.Namespace = namespace
+	return nil
+}
 
+func validateSourcePermissions(ctx context.Context, source argoappv1.ApplicationSource, proj *argoappv1.AppProject, project string, hasMultipleSources bool) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	if condition := source.ValidateRequiredFields(hasMultipleSources); condition != nil {
+		conditions = append(conditions, *condition)
+	}
 	return conditions
 }
 
@@ -455,6 +519,12 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, spec.Project),
 				})
 			}
+			if !proj.IsSourceRevisionPermitted(source.TargetRevision) {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application revision %s of repo %s is not permitted in project '%s'", source.TargetRevision, source.RepoURL, spec.Project),
+				})
+			}
 		}
 
 	} else {
@@ -469,8 +539,16 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, spec.Project),
 			})
 		}
+		if !proj.IsSourceRevisionPermitted(spec.GetSource().TargetRevision) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application revision %s is not permitted in project '%s'", spec.GetSource().TargetRevision, spec.Project),
+			})
+		}
 	}
 
+	conditions = append(conditions, spec.ValidateOptions()...)
+
 	// ValidateDestination will resolve the destination's server address from its name for us, if possible
 	if err := ValidateDestination(ctx, &spec.Destination, db); err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -533,6 +611,31 @@ func APIResourcesToStrings(resources []kube.APIResourceInfo, includeKinds bool)
 	return res
 }
 
+// APIResourcesToClusterAPIResources converts the given API resources to cluster API resources sorted by group, version
+// and kind
+func APIResourcesToClusterAPIResources(resources []kube.APIResourceInfo) []argoappv1.ClusterAPIResource {
+	res := make([]argoappv1.ClusterAPIResource, 0, len(resources))
+	for _, r := range resources {
+		res = append(res, argoappv1.ClusterAPIResource{
+			Group:      r.GroupVersionResource.Group,
+			Version:    r.GroupVersionResource.Version,
+			Kind:       r.GroupKind.Kind,
+			Name:       r.GroupVersionResource.Resource,
+			Namespaced: r.Meta.Namespaced,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Group != res[j].Group {
+			return res[i].Group < res[j].Group
+		}
+		if res[i].Version != res[j].Version {
+			return res[i].Version < res[j].Version
+		}
+		return res[i].Kind < res[j].Kind
+	})
+	return res
+}
+
 // GetAppProjectWithScopedResources returns a project from an application with scoped resources
 func GetAppProjectWithScopedResources(name string, projLister applicationsv1.AppProjectLister, ns string, settingsManager *settings.SettingsManager, db db.ArgoDB, ctx context.Context) (*argoappv1.AppProject, argoappv1.Repositories, []*argoappv1.Cluster, error) {
 	projOrig, err := projLister.AppProjects(ns).Get(name)
@@ -751,42 +854,12 @@ func IncludeResource(resourceName string, resourceNamespace string, gvk schema.G
 // for migrating application objects which are using deprecated legacy fields into the new fields,
 // and defaulting fields in the spec (e.g. spec.project)
 func NormalizeApplicationSpec(spec *argoappv1.ApplicationSpec) *argoappv1.ApplicationSpec {
-	spec = spec.DeepCopy()
-	if spec.Project == "" {
-		spec.Project = argoappv1.DefaultAppProjectName
-	}
-
-	if spec.Sources != nil && len(spec.Sources) > 0 {
-		for _, source := range spec.Sources {
-			NormalizeSource(&source)
-		}
-	} else {
-		NormalizeSource(spec.Source)
-	}
-	return spec
+	return spec.Normalize()
 }
 
+// NormalizeSource removes the source-specific options of the source whose values are all zero
 func NormalizeSource(source *argoappv1.ApplicationSource) *argoappv1.ApplicationSource {
-	// 3. If any app sources are their zero values, then nil out the pointers to the source spec.
-	// This makes it easier for users to switch between app source types if they are not using
-	// any of the source-specific parameters.
-	if source.Kustomize != nil && source.Kustomize.IsZero() {
-		source.Kustomize = nil
-	}
-	if source.Helm != nil && source.Helm.IsZero() {
-		source.Helm = nil
-	}
-	if source.Directory != nil && source.Directory.IsZero() {
-		if source.Directory.Exclude != "" && source.Directory.Include != "" {
-			source.Directory = &argoappv1.ApplicationSourceDirectory{Exclude: source.Directory.Exclude, Include: source.Directory.Include}
-		} else if source.Directory.Exclude != "" {
-			source.Directory = &argoappv1.ApplicationSourceDirectory{Exclude: source.Directory.Exclude}
-		} else if source.Directory.Include != "" {
-			source.Directory = &argoappv1.ApplicationSourceDirectory{Include: source.Directory.Include}
-		} else {
-			source.Directory = nil
-		}
-	}
+	source.Normalize()
 	return source
 }
 
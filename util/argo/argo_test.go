@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -46,6 +48,43 @@ func TestRefreshApp(t *testing.T) {
 	//assert.True(t, ok)
 }
 
+func TestRefreshAppWithDeadline(t *testing.T) {
+	newApp := func(annotations map[string]string) *argoappv1.Application {
+		return &argoappv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default", Annotations: annotations}}
+	}
+	deadline := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("SetsTheDeadline", func(t *testing.T) {
+		appClientset := appclientset.NewSimpleClientset(newApp(map[string]string{argoappv1.AnnotationKeyRefreshDropped: "dropped"}))
+		appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+		app, err := RefreshAppWithDeadline(appIf, "test-app", argoappv1.RefreshTypeComparison, &deadline)
+		require.NoError(t, err)
+		assert.Equal(t, string(argoappv1.RefreshTypeComparison), app.Annotations[argoappv1.AnnotationKeyRefresh])
+		assert.Equal(t, "2022-01-01T00:00:00Z", app.Annotations[argoappv1.AnnotationKeyRefreshDeadline])
+		assert.NotContains(t, app.Annotations, argoappv1.AnnotationKeyRefreshDropped)
+	})
+
+	t.Run("KeepsAPendingRefreshTakingPrecedence", func(t *testing.T) {
+		appClientset := appclientset.NewSimpleClientset(newApp(map[string]string{argoappv1.AnnotationKeyRefresh: string(argoappv1.RefreshTypeHard)}))
+		appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+		app, err := RefreshAppWithDeadline(appIf, "test-app", argoappv1.RefreshTypeNormal, nil)
+		require.NoError(t, err)
+		assert.Equal(t, string(argoappv1.RefreshTypeHard), app.Annotations[argoappv1.AnnotationKeyRefresh])
+	})
+
+	t.Run("OverridesAPendingRefreshOfLowerPriority", func(t *testing.T) {
+		appClientset := appclientset.NewSimpleClientset(newApp(map[string]string{
+			argoappv1.AnnotationKeyRefresh:         string(argoappv1.RefreshTypeComparison),
+			argoappv1.AnnotationKeyRefreshDeadline: "2021-01-01T00:00:00Z",
+		}))
+		appIf := appClientset.ArgoprojV1alpha1().Applications("default")
+		app, err := RefreshAppWithDeadline(appIf, "test-app", argoappv1.RefreshTypeNormal, nil)
+		require.NoError(t, err)
+		assert.Equal(t, string(argoappv1.RefreshTypeNormal), app.Annotations[argoappv1.AnnotationKeyRefresh])
+		assert.NotContains(t, app.Annotations, argoappv1.AnnotationKeyRefreshDeadline)
+	})
+}
+
 func TestGetAppProjectWithNoProjDefined(t *testing.T) {
 	projName := "default"
 	namespace := "default"
@@ -297,6 +336,31 @@ func TestValidateChartWithoutRevision(t *testing.T) {
 	assert.Equal(t, "spec.source.targetRevision is required if the manifest source is a helm chart", conditions[0].Message)
 }
 
+func TestValidateInvalidSyncSchedule(t *testing.T) {
+	appSpec := &argoappv1.ApplicationSpec{
+		Source: &argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd", Path: "."},
+		Destination: argoappv1.ApplicationDestination{
+			Server: "https://kubernetes.default.svc", Namespace: "default",
+		},
+		SyncPolicy: &argoappv1.SyncPolicy{Schedule: &argoappv1.SyncSchedule{Cron: "every day"}},
+	}
+	cluster := &argoappv1.Cluster{Server: "https://kubernetes.default.svc"}
+	db := &dbmocks.ArgoDB{}
+	ctx := context.Background()
+	db.On("GetCluster", ctx, appSpec.Destination.Server).Return(cluster, nil)
+
+	conditions, err := ValidatePermissions(ctx, appSpec, &argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, db)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(conditions))
+	assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "invalid sync schedule")
+}
+
 func TestAPIResourcesToStrings(t *testing.T) {
 	resources := []kube.APIResourceInfo{{
 		GroupVersionResource: schema.GroupVersionResource{Group: "apps", Version: "v1beta1"},
@@ -604,6 +668,44 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
 	})
 
+	t.Run("Application revision is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Path:           "app",
+				TargetRevision: "feature/foo",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos:     []string{"*"},
+				SourceRevisions: []string{"main", "v*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "application revision feature/foo is not permitted")
+
+		spec.Source.TargetRevision = "v1.0.0"
+		conditions, err = ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 0)
+	})
+
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: &argoappv1.ApplicationSource{
@@ -899,6 +1001,53 @@ func TestValidateDestination(t *testing.T) {
 
 }
 
+func TestRenderDestinationNamespace(t *testing.T) {
+	newApp := func(namespaceTemplate string) *argoappv1.Application {
+		return &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", Labels: map[string]string{"team": "Payments"}},
+			Spec: argoappv1.ApplicationSpec{
+				Project:     "apps",
+				Destination: argoappv1.ApplicationDestination{Server: "https://127.0.0.1:6443", Namespace: "default", NamespaceTemplate: namespaceTemplate},
+			},
+		}
+	}
+
+	t.Run("Without template", func(t *testing.T) {
+		app := newApp("")
+		assert.NoError(t, RenderDestinationNamespace(app))
+		assert.Equal(t, "default", app.Spec.Destination.Namespace)
+	})
+
+	t.Run("Renders template", func(t *testing.T) {
+		app := newApp("{{.app.project}}-{{.app.name}}")
+		assert.NoError(t, RenderDestinationNamespace(app))
+		assert.Equal(t, "apps-guestbook", app.Spec.Destination.Namespace)
+	})
+
+	t.Run("Renders template with functions", func(t *testing.T) {
+		app := newApp("team-{{.app.labels.team | lower}}")
+		assert.NoError(t, RenderDestinationNamespace(app))
+		assert.Equal(t, "team-payments", app.Spec.Destination.Namespace)
+	})
+
+	t.Run("Invalid template", func(t *testing.T) {
+		app := newApp("team-{{.app.name")
+		assert.ErrorContains(t, RenderDestinationNamespace(app), "failed to parse namespace template")
+		assert.Equal(t, "default", app.Spec.Destination.Namespace)
+	})
+
+	t.Run("Missing value", func(t *testing.T) {
+		app := newApp("team-{{.app.labels.owner}}")
+		assert.ErrorContains(t, RenderDestinationNamespace(app), "failed to render namespace template")
+	})
+
+	t.Run("Invalid namespace", func(t *testing.T) {
+		app := newApp("team-{{.app.labels.team}}")
+		assert.ErrorContains(t, RenderDestinationNamespace(app), "namespace 'team-Payments' rendered from template 'team-{{.app.labels.team}}' is invalid")
+		assert.Equal(t, "default", app.Spec.Destination.Namespace)
+	})
+}
+
 func TestFilterByName(t *testing.T) {
 	apps := []argoappv1.Application{
 		{
@@ -0,0 +1,55 @@
+package argo
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ApplicationSelectors restricts the applications watched by the informers of the Argo CD components to the ones
+// matching a label selector and a field selector, so that several Argo CD instances sharing a cluster only list,
+// watch and cache their own applications
+type ApplicationSelectors struct {
+	// LabelSelector selects the applications by label, e.g. `argocd.argoproj.io/instance=team-a`
+	LabelSelector string
+	// FieldSelector selects the applications by field. The Kubernetes API only supports the metadata.name and
+	// metadata.namespace fields of custom resources, e.g. `metadata.namespace!=sandbox`.
+	FieldSelector string
+}
+
+// NewApplicationSelectors returns the application selectors of the given label and field selectors, or nil if both are
+// empty, and validates them
+func NewApplicationSelectors(labelSelector, fieldSelector string) (*ApplicationSelectors, error) {
+	if labelSelector == "" && fieldSelector == "" {
+		return nil, nil
+	}
+	if _, err := labels.Parse(labelSelector); err != nil {
+		return nil, fmt.Errorf("invalid application label selector '%s': %w", labelSelector, err)
+	}
+	if _, err := fields.ParseSelector(fieldSelector); err != nil {
+		return nil, fmt.Errorf("invalid application field selector '%s': %w", fieldSelector, err)
+	}
+	return &ApplicationSelectors{LabelSelector: labelSelector, FieldSelector: fieldSelector}, nil
+}
+
+// TweakListOptions adds the selectors to the given options of a list or watch request of applications. Nil selectors
+// select all applications.
+func (s *ApplicationSelectors) TweakListOptions(options *metav1.ListOptions) {
+	if s == nil {
+		return
+	}
+	options.LabelSelector = joinSelectors(options.LabelSelector, s.LabelSelector)
+	options.FieldSelector = joinSelectors(options.FieldSelector, s.FieldSelector)
+}
+
+func joinSelectors(selector, other string) string {
+	if selector == "" {
+		return other
+	}
+	if other == "" {
+		return selector
+	}
+	return selector + "," + other
+}
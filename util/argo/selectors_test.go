@@ -0,0 +1,37 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewApplicationSelectors(t *testing.T) {
+	selectors, err := NewApplicationSelectors("", "")
+	require.NoError(t, err)
+	assert.Nil(t, selectors)
+
+	_, err = NewApplicationSelectors("team in (a", "")
+	assert.ErrorContains(t, err, "invalid application label selector")
+
+	_, err = NewApplicationSelectors("", "metadata.namespace")
+	assert.ErrorContains(t, err, "invalid application field selector")
+
+	selectors, err = NewApplicationSelectors("team=a", "metadata.namespace!=sandbox")
+	require.NoError(t, err)
+	assert.Equal(t, &ApplicationSelectors{LabelSelector: "team=a", FieldSelector: "metadata.namespace!=sandbox"}, selectors)
+}
+
+func TestApplicationSelectors_TweakListOptions(t *testing.T) {
+	options := metav1.ListOptions{LabelSelector: "tier=frontend"}
+	var nilSelectors *ApplicationSelectors
+	nilSelectors.TweakListOptions(&options)
+	assert.Equal(t, metav1.ListOptions{LabelSelector: "tier=frontend"}, options)
+
+	selectors := &ApplicationSelectors{LabelSelector: "team=a", FieldSelector: "metadata.namespace!=sandbox"}
+	selectors.TweakListOptions(&options)
+	assert.Equal(t, "tier=frontend,team=a", options.LabelSelector)
+	assert.Equal(t, "metadata.namespace!=sandbox", options.FieldSelector)
+}
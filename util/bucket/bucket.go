@@ -0,0 +1,352 @@
+// Package bucket registers the fetchers of manifests stored in S3 (s3://) and Google Cloud Storage (gs://) buckets.
+// A repository URL either refers to a manifest bundle, i.e. a tar.gz object, or to a prefix whose objects are laid out
+// like a directory, e.g. s3://my-bucket/guestbook.tar.gz or gs://my-bucket/rendered/guestbook/.
+package bucket
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+	"github.com/argoproj/argo-cd/v2/util/proxy"
+)
+
+const (
+	// SchemeS3 is the URL scheme of S3 buckets and S3 compatible object stores
+	SchemeS3 = "s3"
+	// SchemeGCS is the URL scheme of Google Cloud Storage buckets, which are accessed with its S3 compatible API
+	SchemeGCS = "gs"
+
+	gcsEndpoint = "https://storage.googleapis.com"
+	// defaultRegion is the region used to look up the region of S3 buckets
+	defaultRegion = "us-east-1"
+)
+
+var (
+	// maxExtractedSize is the maximum size of extracted manifest bundles
+	maxExtractedSize = env.ParseInt64FromEnv("ARGOCD_REPO_SERVER_BUCKET_MAX_EXTRACTED_SIZE", 1024*1024*1024, 0, math.MaxInt64)
+	// useDefaultCredentials enables the access to the S3 buckets without repository credentials with the default
+	// credentials of the AWS SDK, i.e. the credentials of the repo server. Otherwise, they are accessed anonymously.
+	useDefaultCredentials = env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_BUCKET_USE_DEFAULT_CREDENTIALS", false)
+	// allowedEndpoints are the endpoints which may be set with the endpoint query parameter of the repository URLs
+	allowedEndpoints = env.StringsFromEnv("ARGOCD_REPO_SERVER_BUCKET_ALLOWED_ENDPOINTS", []string{}, ",")
+)
+
+func init() {
+	git.RegisterFetcher(SchemeS3, &fetcher{newClient: newS3Client})
+	git.RegisterFetcher(SchemeGCS, &fetcher{newClient: newS3Client})
+}
+
+// location is a bundle or a directory in a bucket
+type location struct {
+	scheme string
+	bucket string
+	key    string
+	// region and endpoint are configured with the query parameters of the repository URL
+	region   string
+	endpoint string
+}
+
+// parseLocation parses repository URLs of the form s3://<bucket>/<key>[?region=<region>&endpoint=<endpoint>]
+func parseLocation(repoURL string) (*location, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("repository URL %s has no bucket", repoURL)
+	}
+	loc := &location{
+		scheme:   strings.ToLower(u.Scheme),
+		bucket:   u.Host,
+		key:      strings.TrimPrefix(u.Path, "/"),
+		region:   u.Query().Get("region"),
+		endpoint: u.Query().Get("endpoint"),
+	}
+	if !loc.isBundle() && loc.key != "" && !strings.HasSuffix(loc.key, "/") {
+		loc.key += "/"
+	}
+	return loc, nil
+}
+
+// isBundle returns whether the location refers to a tar.gz object rather than a directory
+func (l *location) isBundle() bool {
+	return strings.HasSuffix(l.key, ".tar.gz") || strings.HasSuffix(l.key, ".tgz")
+}
+
+// checkEndpoint returns an error if the endpoint set in a repository URL is not one of the allowed endpoints, so that
+// the repo server does not send requests to arbitrary hosts
+func checkEndpoint(endpoint string, allowed []string) error {
+	for _, e := range allowed {
+		if e != "" && strings.TrimSuffix(e, "/") == strings.TrimSuffix(endpoint, "/") {
+			return nil
+		}
+	}
+	return fmt.Errorf("endpoint %s is not allowed, the allowed endpoints are configured with ARGOCD_REPO_SERVER_BUCKET_ALLOWED_ENDPOINTS", endpoint)
+}
+
+// s3Credentials returns the credentials to access a bucket, or nil if the default credentials of the AWS SDK are used
+func s3Credentials(source git.FetchSource, loc *location, useDefault bool) *credentials.Credentials {
+	switch {
+	case source.Username != "" || source.Password != "":
+		return credentials.NewStaticCredentials(source.Username, source.Password, "")
+	// the default credentials are AWS credentials, so they are never used for Google Cloud Storage
+	case useDefault && loc.scheme == SchemeS3:
+		return nil
+	default:
+		return credentials.AnonymousCredentials
+	}
+}
+
+func newS3Client(source git.FetchSource, loc *location) (s3iface.S3API, error) {
+	config := aws.NewConfig().WithHTTPClient(&http.Client{
+		Transport: &http.Transport{
+			Proxy:           proxy.GetCallback(source.Proxy),
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: source.Insecure},
+		},
+	})
+	endpoint := loc.endpoint
+	if endpoint != "" {
+		if err := checkEndpoint(endpoint, allowedEndpoints); err != nil {
+			return nil, err
+		}
+	} else if loc.scheme == SchemeGCS {
+		endpoint = gcsEndpoint
+	}
+	if endpoint != "" {
+		config = config.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	sharedConfigState := session.SharedConfigDisable
+	if creds := s3Credentials(source, loc, useDefaultCredentials); creds != nil {
+		config = config.WithCredentials(creds)
+	} else {
+		sharedConfigState = session.SharedConfigEnable
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{Config: *config, SharedConfigState: sharedConfigState})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	if loc.region != "" {
+		sess.Config.Region = aws.String(loc.region)
+	} else if aws.StringValue(sess.Config.Region) == "" {
+		if endpoint != "" {
+			sess.Config.Region = aws.String(defaultRegion)
+		} else {
+			region, err := s3manager.GetBucketRegion(aws.BackgroundContext(), sess, loc.bucket, defaultRegion)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the region of bucket %s: %w", loc.bucket, err)
+			}
+			sess.Config.Region = aws.String(region)
+		}
+	}
+	return s3.New(sess), nil
+}
+
+// fetcher fetches manifests from buckets
+type fetcher struct {
+	newClient func(source git.FetchSource, loc *location) (s3iface.S3API, error)
+}
+
+var _ git.Fetcher = &fetcher{}
+
+func (f *fetcher) open(source git.FetchSource) (*location, s3iface.S3API, error) {
+	loc, err := parseLocation(source.RepoURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := f.newClient(source, loc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return loc, client, nil
+}
+
+func isLatest(revision string) bool {
+	return revision == "" || revision == "HEAD"
+}
+
+// ResolveRevision resolves the revision of a bundle into its version ID, or into its ETag if the bucket is not
+// versioned, and the revision of a directory into the digest of the ETags of its objects. Since directories are not
+// versioned, only their latest revision can be resolved.
+func (f *fetcher) ResolveRevision(source git.FetchSource, revision string) (string, error) {
+	loc, client, err := f.open(source)
+	if err != nil {
+		return "", err
+	}
+	if !loc.isBundle() {
+		objects, err := listObjects(client, loc)
+		if err != nil {
+			return "", err
+		}
+		digest := directoryRevision(objects)
+		if !isLatest(revision) && revision != digest {
+			return "", fmt.Errorf("revision %s is not the latest revision of the directory", revision)
+		}
+		return digest, nil
+	}
+	if isLatest(revision) {
+		head, err := client.HeadObject(&s3.HeadObjectInput{Bucket: aws.String(loc.bucket), Key: aws.String(loc.key)})
+		if err != nil {
+			return "", err
+		}
+		return objectRevision(head.VersionId, head.ETag), nil
+	}
+	input, err := getObjectInput(client, loc, revision)
+	if err != nil {
+		return "", err
+	}
+	if input.VersionId != nil {
+		if _, err := client.HeadObject(&s3.HeadObjectInput{Bucket: input.Bucket, Key: input.Key, VersionId: input.VersionId}); err != nil {
+			return "", err
+		}
+	}
+	return revision, nil
+}
+
+// Fetch downloads and extracts the given revision of a bundle, or downloads the objects of a directory
+func (f *fetcher) Fetch(source git.FetchSource, revision string, dir string) error {
+	loc, client, err := f.open(source)
+	if err != nil {
+		return err
+	}
+	if !loc.isBundle() {
+		objects, err := listObjects(client, loc)
+		if err != nil {
+			return err
+		}
+		if digest := directoryRevision(objects); digest != revision {
+			return fmt.Errorf("the directory changed since revision %s was resolved", revision)
+		}
+		for _, o := range objects {
+			if err := downloadObject(client, loc, o, dir); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	input, err := getObjectInput(client, loc, revision)
+	if err != nil {
+		return err
+	}
+	out, err := client.GetObject(input)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = out.Body.Close() }()
+	if err := files.Untgz(dir, out.Body, maxExtractedSize); err != nil {
+		return fmt.Errorf("failed to extract %s: %w", loc.key, err)
+	}
+	return nil
+}
+
+// objectRevision returns the version ID of an object, or its ETag if the bucket is not versioned
+func objectRevision(versionID *string, etag *string) string {
+	if version := aws.StringValue(versionID); version != "" && version != "null" {
+		return version
+	}
+	return strings.Trim(aws.StringValue(etag), `"`)
+}
+
+// getObjectInput returns the input to get the given revision of a bundle. The revision is either the revision of the
+// latest version, which might be an ETag, or a version ID.
+func getObjectInput(client s3iface.S3API, loc *location, revision string) (*s3.GetObjectInput, error) {
+	input := &s3.GetObjectInput{Bucket: aws.String(loc.bucket), Key: aws.String(loc.key)}
+	head, err := client.HeadObject(&s3.HeadObjectInput{Bucket: input.Bucket, Key: input.Key})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case objectRevision(head.VersionId, head.ETag) != revision:
+		input.VersionId = aws.String(revision)
+	case head.VersionId != nil && aws.StringValue(head.VersionId) != "null":
+		input.VersionId = head.VersionId
+	default:
+		// fail rather than fetching other content if the object is overwritten in the meantime
+		input.IfMatch = head.ETag
+	}
+	return input, nil
+}
+
+type object struct {
+	key  string
+	etag string
+}
+
+// listObjects lists the objects of a directory, sorted by key
+func listObjects(client s3iface.S3API, loc *location) ([]object, error) {
+	var objects []object
+	err := client.ListObjectsV2Pages(&s3.ListObjectsV2Input{Bucket: aws.String(loc.bucket), Prefix: aws.String(loc.key)}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			key := aws.StringValue(o.Key)
+			// skip the placeholder objects of folders
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+			objects = append(objects, object{key: key, etag: strings.Trim(aws.StringValue(o.ETag), `"`)})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("no objects found in %s://%s/%s", loc.scheme, loc.bucket, loc.key)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].key < objects[j].key
+	})
+	return objects, nil
+}
+
+// directoryRevision returns the digest of the keys and ETags of the objects of a directory
+func directoryRevision(objects []object) string {
+	h := sha256.New()
+	for _, o := range objects {
+		_, _ = fmt.Fprintf(h, "%s\x00%s\n", o.key, o.etag)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// downloadObject downloads an object of a directory into the corresponding file of dir
+func downloadObject(client s3iface.S3API, loc *location, o object, dir string) error {
+	path := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(o.key, loc.key)))
+	if !strings.HasPrefix(path, filepath.Clean(dir)+string(os.PathSeparator)) {
+		return fmt.Errorf("illegal object key %s", o.key)
+	}
+	out, err := client.GetObject(&s3.GetObjectInput{Bucket: aws.String(loc.bucket), Key: aws.String(o.key), IfMatch: aws.String(`"` + o.etag + `"`)})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = out.Body.Close() }()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	if _, err := io.Copy(file, out.Body); err != nil {
+		return fmt.Errorf("failed to download %s: %w", o.key, err)
+	}
+	return nil
+}
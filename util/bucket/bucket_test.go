@@ -0,0 +1,222 @@
+package bucket
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io/files"
+)
+
+type fakeObject struct {
+	versionID string
+	etag      string
+	data      []byte
+}
+
+// fakeS3 serves the objects of a single bucket. The first version of an object is the latest one.
+type fakeS3 struct {
+	s3iface.S3API
+	objects map[string][]fakeObject
+}
+
+func (f *fakeS3) findObject(key string, versionID *string) (*fakeObject, error) {
+	for i, o := range f.objects[key] {
+		if versionID == nil || *versionID == o.versionID {
+			return &f.objects[key][i], nil
+		}
+	}
+	return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+}
+
+func (f *fakeS3) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	o, err := f.findObject(*input.Key, input.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	return &s3.HeadObjectOutput{VersionId: aws.String(o.versionID), ETag: aws.String(`"` + o.etag + `"`)}, nil
+}
+
+func (f *fakeS3) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	o, err := f.findObject(*input.Key, input.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	if input.IfMatch != nil && *input.IfMatch != `"`+o.etag+`"` {
+		return nil, awserr.New("PreconditionFailed", "precondition failed", nil)
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(o.data))}, nil
+}
+
+func (f *fakeS3) ListObjectsV2Pages(input *s3.ListObjectsV2Input, fn func(*s3.ListObjectsV2Output, bool) bool) error {
+	page := &s3.ListObjectsV2Output{}
+	for key, versions := range f.objects {
+		if len(key) >= len(*input.Prefix) && key[:len(*input.Prefix)] == *input.Prefix {
+			page.Contents = append(page.Contents, &s3.Object{Key: aws.String(key), ETag: aws.String(`"` + versions[0].etag + `"`)})
+		}
+	}
+	fn(page, true)
+	return nil
+}
+
+func newTestFetcher(client *fakeS3) *fetcher {
+	return &fetcher{newClient: func(_ git.FetchSource, _ *location) (s3iface.S3API, error) {
+		return client, nil
+	}}
+}
+
+func createBundle(t *testing.T, manifest string) []byte {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "deployment.yaml"), []byte(manifest), 0644))
+	var buf bytes.Buffer
+	_, err := files.Tgz(dir, nil, nil, &buf)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func Test_parseLocation(t *testing.T) {
+	loc, err := parseLocation("s3://my-bucket/apps/guestbook.tar.gz?region=eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, &location{scheme: "s3", bucket: "my-bucket", key: "apps/guestbook.tar.gz", region: "eu-west-1"}, loc)
+	assert.True(t, loc.isBundle())
+
+	loc, err = parseLocation("gs://my-bucket/rendered/guestbook?endpoint=https://storage.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, &location{scheme: "gs", bucket: "my-bucket", key: "rendered/guestbook/", endpoint: "https://storage.example.com"}, loc)
+	assert.False(t, loc.isBundle())
+
+	loc, err = parseLocation("s3://my-bucket")
+	require.NoError(t, err)
+	assert.Equal(t, "", loc.key)
+
+	_, err = parseLocation("s3:///guestbook.tar.gz")
+	assert.Error(t, err)
+}
+
+func Test_checkEndpoint(t *testing.T) {
+	allowed := []string{"https://minio.example.com/", "https://storage.example.com"}
+	assert.NoError(t, checkEndpoint("https://minio.example.com", allowed))
+	assert.NoError(t, checkEndpoint("https://storage.example.com/", allowed))
+	assert.EqualError(t, checkEndpoint("http://169.254.169.254", allowed), "endpoint http://169.254.169.254 is not allowed, the allowed endpoints are configured with ARGOCD_REPO_SERVER_BUCKET_ALLOWED_ENDPOINTS")
+	assert.Error(t, checkEndpoint("https://minio.example.com", []string{}))
+}
+
+func Test_s3Credentials(t *testing.T) {
+	s3Loc := &location{scheme: SchemeS3, bucket: "my-bucket"}
+	gcsLoc := &location{scheme: SchemeGCS, bucket: "my-bucket"}
+
+	creds := s3Credentials(git.FetchSource{Username: "key-id", Password: "secret"}, s3Loc, false)
+	value, err := creds.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "key-id", value.AccessKeyID)
+
+	assert.Equal(t, credentials.AnonymousCredentials, s3Credentials(git.FetchSource{}, s3Loc, false))
+	assert.Nil(t, s3Credentials(git.FetchSource{}, s3Loc, true))
+	assert.Equal(t, credentials.AnonymousCredentials, s3Credentials(git.FetchSource{}, gcsLoc, true))
+}
+
+func TestNewS3Client_EndpointNotAllowed(t *testing.T) {
+	loc, err := parseLocation("s3://my-bucket/guestbook.tar.gz?endpoint=http://169.254.169.254")
+	require.NoError(t, err)
+	_, err = newS3Client(git.FetchSource{RepoURL: "s3://my-bucket/guestbook.tar.gz?endpoint=http://169.254.169.254"}, loc)
+	assert.EqualError(t, err, "endpoint http://169.254.169.254 is not allowed, the allowed endpoints are configured with ARGOCD_REPO_SERVER_BUCKET_ALLOWED_ENDPOINTS")
+}
+
+func TestFetcher_Bundle(t *testing.T) {
+	client := &fakeS3{objects: map[string][]fakeObject{
+		"guestbook.tar.gz": {
+			{versionID: "v2", etag: "etag2", data: createBundle(t, "version: 2")},
+			{versionID: "v1", etag: "etag1", data: createBundle(t, "version: 1")},
+		},
+	}}
+	f := newTestFetcher(client)
+	source := git.FetchSource{RepoURL: "s3://my-bucket/guestbook.tar.gz"}
+
+	revision, err := f.ResolveRevision(source, "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", revision)
+	revision, err = f.ResolveRevision(source, "v1")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", revision)
+	_, err = f.ResolveRevision(source, "v3")
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, f.Fetch(source, "v1", dir))
+	data, err := os.ReadFile(filepath.Join(dir, "deployment.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "version: 1", string(data))
+
+	t.Run("Unversioned", func(t *testing.T) {
+		client := &fakeS3{objects: map[string][]fakeObject{
+			"guestbook.tgz": {{versionID: "null", etag: "etag", data: createBundle(t, "version: 1")}},
+		}}
+		f := newTestFetcher(client)
+		source := git.FetchSource{RepoURL: "s3://my-bucket/guestbook.tgz"}
+
+		revision, err := f.ResolveRevision(source, "")
+		require.NoError(t, err)
+		assert.Equal(t, "etag", revision)
+
+		dir := t.TempDir()
+		require.NoError(t, f.Fetch(source, revision, dir))
+		assert.FileExists(t, filepath.Join(dir, "deployment.yaml"))
+
+		// the ETag of an overwritten object cannot be fetched anymore
+		client.objects["guestbook.tgz"][0].etag = "other"
+		assert.Error(t, f.Fetch(source, revision, t.TempDir()))
+	})
+}
+
+func TestFetcher_Directory(t *testing.T) {
+	client := &fakeS3{objects: map[string][]fakeObject{
+		"rendered/guestbook/":                    {{etag: "folder"}},
+		"rendered/guestbook/deployment.yaml":     {{etag: "etag1", data: []byte("kind: Deployment")}},
+		"rendered/guestbook/nested/service.yaml": {{etag: "etag2", data: []byte("kind: Service")}},
+		"rendered/other/deployment.yaml":         {{etag: "etag3", data: []byte("kind: Deployment")}},
+	}}
+	f := newTestFetcher(client)
+	source := git.FetchSource{RepoURL: "gs://my-bucket/rendered/guestbook"}
+
+	revision, err := f.ResolveRevision(source, "HEAD")
+	require.NoError(t, err)
+	assert.Len(t, revision, 64)
+	resolved, err := f.ResolveRevision(source, revision)
+	require.NoError(t, err)
+	assert.Equal(t, revision, resolved)
+
+	dir := t.TempDir()
+	require.NoError(t, f.Fetch(source, revision, dir))
+	data, err := os.ReadFile(filepath.Join(dir, "nested", "service.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: Service", string(data))
+	assert.FileExists(t, filepath.Join(dir, "deployment.yaml"))
+	assert.NoFileExists(t, filepath.Join(dir, "other", "deployment.yaml"))
+
+	client.objects["rendered/guestbook/deployment.yaml"][0].etag = "changed"
+	newRevision, err := f.ResolveRevision(source, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, revision, newRevision)
+	_, err = f.ResolveRevision(source, revision)
+	assert.Error(t, err)
+	assert.Error(t, f.Fetch(source, revision, t.TempDir()))
+
+	_, err = f.ResolveRevision(git.FetchSource{RepoURL: "gs://my-bucket/missing"}, "")
+	assert.EqualError(t, err, "no objects found in gs://my-bucket/missing/")
+}
+
+func TestFetcher_Registered(t *testing.T) {
+	assert.Contains(t, git.FetcherSchemes(), SchemeS3)
+	assert.Contains(t, git.FetcherSchemes(), SchemeGCS)
+}
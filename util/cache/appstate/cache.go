@@ -18,6 +18,22 @@ var ErrCacheMiss = cacheutil.ErrCacheMiss
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// driftHistoryCacheExpiration is the expiration of the drift history of an application, which is refreshed
+	// every time a new drift is recorded
+	driftHistoryCacheExpiration = 7 * 24 * time.Hour
+	// DriftHistoryLimit is the maximum number of drift records kept per application
+	DriftHistoryLimit = 50
+	// appResourcesStatusNoExpiration is a negative expiration, which stores the resources of applications without TTL
+	appResourcesStatusNoExpiration = -1
+	// ProjectUsageRetention is how long the daily usage statistics of the projects are kept
+	ProjectUsageRetention = 90 * 24 * time.Hour
+
+	// ProjectUsageSyncs counts the sync operations of the applications of a project
+	ProjectUsageSyncs = "syncs"
+	// ProjectUsageManifestGenerationSeconds sums the time spent generating the manifests of the applications of a project
+	ProjectUsageManifestGenerationSeconds = "manifest-generation-seconds"
+	// ProjectUsageAPICalls counts the calls of the API targeting the applications of a project
+	ProjectUsageAPICalls = "api-calls"
 )
 
 type Cache struct {
@@ -77,6 +93,10 @@ func clusterInfoKey(server string) string {
 	return fmt.Sprintf("cluster|info|%s", server)
 }
 
+func clusterAPIResourcesKey(server string) string {
+	return fmt.Sprintf("cluster|api-resources|%s", server)
+}
+
 func (c *Cache) GetAppResourcesTree(appName string, res *appv1.ApplicationTree) error {
 	err := c.GetItem(appResourcesTreeKey(appName), &res)
 	return err
@@ -97,6 +117,92 @@ func (c *Cache) SetAppResourcesTree(appName string, resourcesTree *appv1.Applica
 	return c.Cache.NotifyUpdated(appManagedResourcesKey(appName))
 }
 
+func appResourcesStatusKey(appName string) string {
+	return fmt.Sprintf("app|resources-status|%s", appName)
+}
+
+// GetAppResourcesStatus returns the list of resources of an application whose resources are not stored in its status
+func (c *Cache) GetAppResourcesStatus(appName string, res *[]appv1.ResourceStatus) error {
+	return c.GetItem(appResourcesStatusKey(appName), res)
+}
+
+// SetAppResourcesStatus stores the list of resources of an application instead of its status, or deletes it if nil.
+// The list never expires since the status of the application refers to it: it is deleted along with the application.
+func (c *Cache) SetAppResourcesStatus(appName string, resources []appv1.ResourceStatus) error {
+	return c.SetItem(appResourcesStatusKey(appName), resources, appResourcesStatusNoExpiration, resources == nil)
+}
+
+func appComparisonStateKey(appName string) string {
+	return fmt.Sprintf("app|comparison-state|%s", appName)
+}
+
+// ComparisonState describes the comparison whose results are cached as the managed resources and the resources tree
+// of an application
+type ComparisonState struct {
+	// Generation is the generation of the application the comparison was made for
+	Generation int64 `json:"generation"`
+	// Revisions are the revisions the application was compared to
+	Revisions []string `json:"revisions,omitempty"`
+	// ComparedAt is the time of the comparison
+	ComparedAt time.Time `json:"comparedAt"`
+}
+
+// GetAppComparisonState returns the state of the comparison whose results are cached for the application
+func (c *Cache) GetAppComparisonState(appName string, res *ComparisonState) error {
+	return c.GetItem(appComparisonStateKey(appName), res)
+}
+
+// SetAppComparisonState records the state of the comparison whose results are cached for the application. It expires
+// along with the cached managed resources and resources tree.
+func (c *Cache) SetAppComparisonState(appName string, state *ComparisonState) error {
+	return c.SetItem(appComparisonStateKey(appName), state, c.appStateCacheExpiration, state == nil)
+}
+
+func appDriftHistoryKey(appName string) string {
+	return fmt.Sprintf("app|drift-history|%s", appName)
+}
+
+// GetAppDriftHistory returns the drift history of the application, most recent drift first
+func (c *Cache) GetAppDriftHistory(appName string, res *[]appv1.DriftRecord) error {
+	return c.GetItem(appDriftHistoryKey(appName), &res)
+}
+
+// AddAppDriftRecord prepends the drift record to the drift history of the application, dropping the oldest records
+// once the history exceeds DriftHistoryLimit
+func (c *Cache) AddAppDriftRecord(appName string, record appv1.DriftRecord) error {
+	history := make([]appv1.DriftRecord, 0)
+	if err := c.GetAppDriftHistory(appName, &history); err != nil && err != ErrCacheMiss {
+		return err
+	}
+	history = append([]appv1.DriftRecord{record}, history...)
+	if len(history) > DriftHistoryLimit {
+		history = history[:DriftHistoryLimit]
+	}
+	return c.SetItem(appDriftHistoryKey(appName), history, driftHistoryCacheExpiration, false)
+}
+
+func projectUsageKey(project string, metric string, day time.Time) string {
+	return fmt.Sprintf("project|usage|%s|%s|%s", project, metric, day.UTC().Format("2006-01-02"))
+}
+
+// IncrementProjectUsage adds the value to the usage statistic of the project for the current day
+func (c *Cache) IncrementProjectUsage(project string, metric string, value float64) error {
+	return c.Cache.IncrCounter(projectUsageKey(project, metric, time.Now()), value, ProjectUsageRetention)
+}
+
+// GetProjectUsage returns the usage statistic of the project summed over the days from the day of from to the day of to
+func (c *Cache) GetProjectUsage(project string, metric string, from time.Time, to time.Time) (float64, error) {
+	var total float64
+	for day := from.UTC().Truncate(24 * time.Hour); !day.After(to); day = day.Add(24 * time.Hour) {
+		value, err := c.Cache.GetCounter(projectUsageKey(project, metric, day))
+		if err != nil && err != ErrCacheMiss {
+			return 0, err
+		}
+		total += value
+	}
+	return total, nil
+}
+
 func (c *Cache) SetClusterInfo(server string, info *appv1.ClusterInfo) error {
 	return c.SetItem(clusterInfoKey(server), info, clusterInfoCacheExpiration, info == nil)
 }
@@ -105,3 +211,11 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func (c *Cache) SetClusterAPIResources(server string, resources *appv1.ClusterAPIResourceList) error {
+	return c.SetItem(clusterAPIResourcesKey(server), resources, clusterInfoCacheExpiration, resources == nil)
+}
+
+func (c *Cache) GetClusterAPIResources(server string, res *appv1.ClusterAPIResourceList) error {
+	return c.GetItem(clusterAPIResourcesKey(server), &res)
+}
@@ -1,6 +1,7 @@
 package appstate
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -58,8 +59,88 @@ func TestCache_GetAppResourcesTree(t *testing.T) {
 	assert.Equal(t, &ApplicationTree{Nodes: []ResourceNode{{}}}, value)
 }
 
+func TestCache_GetAppResourcesStatus(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]ResourceStatus{}
+	err := cache.GetAppResourcesStatus("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppResourcesStatus("my-appname", []ResourceStatus{{Name: "my-name"}})
+	assert.NoError(t, err)
+	// cache hit
+	err = cache.GetAppResourcesStatus("my-appname", value)
+	assert.NoError(t, err)
+	assert.Equal(t, &[]ResourceStatus{{Name: "my-name"}}, value)
+	// delete
+	err = cache.SetAppResourcesStatus("my-appname", nil)
+	assert.NoError(t, err)
+	err = cache.GetAppResourcesStatus("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	assert.NoError(t, err)
 	assert.Equal(t, 1*time.Hour, cache.appStateCacheExpiration)
 }
+
+func TestCache_AddAppDriftRecord(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]DriftRecord{}
+	err := cache.GetAppDriftHistory("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	for i := 0; i < DriftHistoryLimit+5; i++ {
+		err = cache.AddAppDriftRecord("my-appname", DriftRecord{Revision: fmt.Sprintf("rev-%d", i)})
+		assert.NoError(t, err)
+	}
+	// cache hit, most recent first and capped
+	err = cache.GetAppDriftHistory("my-appname", value)
+	assert.NoError(t, err)
+	assert.Len(t, *value, DriftHistoryLimit)
+	assert.Equal(t, fmt.Sprintf("rev-%d", DriftHistoryLimit+4), (*value)[0].Revision)
+	assert.Equal(t, "rev-5", (*value)[DriftHistoryLimit-1].Revision)
+}
+
+func TestCache_GetAppComparisonState(t *testing.T) {
+	cache := newFixtures().Cache
+	value := &ComparisonState{}
+	// cache miss
+	err := cache.GetAppComparisonState("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	comparedAt := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	err = cache.SetAppComparisonState("my-appname", &ComparisonState{Generation: 3, Revisions: []string{"abc"}, ComparedAt: comparedAt})
+	assert.NoError(t, err)
+	// cache hit
+	err = cache.GetAppComparisonState("my-appname", value)
+	assert.NoError(t, err)
+	assert.Equal(t, &ComparisonState{Generation: 3, Revisions: []string{"abc"}, ComparedAt: comparedAt}, value)
+	// delete
+	err = cache.SetAppComparisonState("my-appname", nil)
+	assert.NoError(t, err)
+	err = cache.GetAppComparisonState("my-appname", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
+func TestCache_GetProjectUsage(t *testing.T) {
+	cache := newFixtures().Cache
+	now := time.Now()
+	// cache miss
+	value, err := cache.GetProjectUsage("my-project", ProjectUsageSyncs, now.Add(-48*time.Hour), now)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(0), value)
+	// cache hit
+	assert.NoError(t, cache.IncrementProjectUsage("my-project", ProjectUsageSyncs, 1))
+	assert.NoError(t, cache.IncrementProjectUsage("my-project", ProjectUsageSyncs, 1))
+	assert.NoError(t, cache.IncrementProjectUsage("other-project", ProjectUsageSyncs, 1))
+	value, err = cache.GetProjectUsage("my-project", ProjectUsageSyncs, now.Add(-48*time.Hour), now)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(2), value)
+	// out of range
+	value, err = cache.GetProjectUsage("my-project", ProjectUsageSyncs, now.Add(-72*time.Hour), now.Add(-48*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, float64(0), value)
+}
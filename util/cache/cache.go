@@ -139,7 +139,7 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client))
 			return NewCache(NewRedisCache(client, defaultCacheExpiration, compression)), nil
 		}
 		if redisAddress == "" {
-			redisAddress = common.DefaultRedisAddr
+			redisAddress = common.GetDefaultRedisAddr()
 		}
 
 		client := buildRedisClient(redisAddress, password, username, redisDB, maxRetries, tlsConfig)
@@ -183,6 +183,23 @@ func (c *Cache) GetItem(key string, item interface{}) error {
 	return c.client.Get(key, item)
 }
 
+// CheckConnection checks that the cache is reachable by getting an item which is never set
+func (c *Cache) CheckConnection() error {
+	var item struct{}
+	if err := c.GetItem("healthz", &item); err != nil && err != ErrCacheMiss {
+		return err
+	}
+	return nil
+}
+
+func (c *Cache) IncrCounter(key string, delta float64, expiration time.Duration) error {
+	return c.client.IncrCounter(fmt.Sprintf("%s|%s", key, common.CacheVersion), delta, expiration)
+}
+
+func (c *Cache) GetCounter(key string) (float64, error) {
+	return c.client.GetCounter(fmt.Sprintf("%s|%s", key, common.CacheVersion))
+}
+
 func (c *Cache) OnUpdated(ctx context.Context, key string, callback func() error) error {
 	return c.client.OnUpdated(ctx, fmt.Sprintf("%s|%s", key, common.CacheVersion), callback)
 }
@@ -21,4 +21,8 @@ type CacheClient interface {
 	Delete(key string) error
 	OnUpdated(ctx context.Context, key string, callback func() error) error
 	NotifyUpdated(key string) error
+	// IncrCounter atomically increments the counter stored at the key by the delta, and resets its expiration
+	IncrCounter(key string, delta float64, expiration time.Duration) error
+	// GetCounter returns the value of the counter stored at the key
+	GetCounter(key string) (float64, error)
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/gob"
 	"fmt"
+	"sync"
 	"time"
 
 	gocache "github.com/patrickmn/go-cache"
@@ -20,7 +21,8 @@ func NewInMemoryCache(expiration time.Duration) *InMemoryCache {
 var _ CacheClient = &InMemoryCache{}
 
 type InMemoryCache struct {
-	memCache *gocache.Cache
+	memCache    *gocache.Cache
+	counterLock sync.Mutex
 }
 
 func (i *InMemoryCache) Set(item *Item) error {
@@ -66,6 +68,29 @@ func (i *InMemoryCache) Delete(key string) error {
 	return nil
 }
 
+func (i *InMemoryCache) IncrCounter(key string, delta float64, expiration time.Duration) error {
+	i.counterLock.Lock()
+	defer i.counterLock.Unlock()
+	var counter float64
+	if val, found := i.memCache.Get(key); found {
+		counter, _ = val.(float64)
+	}
+	i.memCache.Set(key, counter+delta, expiration)
+	return nil
+}
+
+func (i *InMemoryCache) GetCounter(key string) (float64, error) {
+	val, found := i.memCache.Get(key)
+	if !found {
+		return 0, ErrCacheMiss
+	}
+	counter, ok := val.(float64)
+	if !ok {
+		return 0, fmt.Errorf("key '%s' is not a counter", key)
+	}
+	return counter, nil
+}
+
 func (i *InMemoryCache) Flush() {
 	i.memCache.Flush()
 }
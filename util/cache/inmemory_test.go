@@ -25,3 +25,15 @@ func TestInMemoryCache(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, &foo{Bar: "bar"}, obj)
 }
+
+func TestInMemoryCacheCounter(t *testing.T) {
+	cache := NewInMemoryCache(1 * time.Hour)
+	_, err := cache.GetCounter("my-counter")
+	assert.Equal(t, ErrCacheMiss, err)
+
+	assert.NoError(t, cache.IncrCounter("my-counter", 1, time.Hour))
+	assert.NoError(t, cache.IncrCounter("my-counter", 2.5, time.Hour))
+	counter, err := cache.GetCounter("my-counter")
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, counter)
+}
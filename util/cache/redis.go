@@ -129,6 +129,26 @@ func (r *redisCache) Delete(key string) error {
 	return r.cache.Delete(context.TODO(), r.getKey(key))
 }
 
+func (r *redisCache) IncrCounter(key string, delta float64, expiration time.Duration) error {
+	if expiration == 0 {
+		expiration = r.expiration
+	}
+	_, err := r.client.TxPipelined(context.TODO(), func(pipe redis.Pipeliner) error {
+		pipe.IncrByFloat(context.TODO(), key, delta)
+		pipe.Expire(context.TODO(), key, expiration)
+		return nil
+	})
+	return err
+}
+
+func (r *redisCache) GetCounter(key string) (float64, error) {
+	val, err := r.client.Get(context.TODO(), key).Float64()
+	if err == redis.Nil {
+		return 0, ErrCacheMiss
+	}
+	return val, err
+}
+
 func (r *redisCache) OnUpdated(ctx context.Context, key string, callback func() error) error {
 	pubsub := r.client.Subscribe(ctx, key)
 	defer ioutil.Close(pubsub)
@@ -70,3 +70,22 @@ func TestRedisSetCacheCompressed(t *testing.T) {
 
 	assert.Equal(t, testValue, result)
 }
+
+func TestRedisCounter(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer mr.Close()
+
+	client := NewRedisCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), 10*time.Second, RedisCompressionNone)
+	_, err = client.GetCounter("counter")
+	assert.Equal(t, ErrCacheMiss, err)
+
+	assert.NoError(t, client.IncrCounter("counter", 1, time.Hour))
+	assert.NoError(t, client.IncrCounter("counter", 2.5, time.Hour))
+	counter, err := client.GetCounter("counter")
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, counter)
+	assert.Equal(t, time.Hour, mr.TTL("counter"))
+}
@@ -59,6 +59,16 @@ func (c *twoLevelClient) Delete(key string) error {
 	return c.externalCache.Delete(key)
 }
 
+// IncrCounter increments the counter in the external cache only, since it is shared with other processes.
+func (c *twoLevelClient) IncrCounter(key string, delta float64, expiration time.Duration) error {
+	return c.externalCache.IncrCounter(key, delta, expiration)
+}
+
+// GetCounter returns the counter from the external cache only, since it is shared with other processes.
+func (c *twoLevelClient) GetCounter(key string) (float64, error) {
+	return c.externalCache.GetCounter(key)
+}
+
 func (c *twoLevelClient) OnUpdated(ctx context.Context, key string, callback func() error) error {
 	return c.externalCache.OnUpdated(ctx, key, callback)
 }
@@ -0,0 +1,277 @@
+package calendar
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// TypeICal is an iCalendar feed, which is active during its events
+	TypeICal = "ical"
+	// TypeHTTP is an HTTP endpoint returning a JSON object with an "active" boolean field
+	TypeHTTP = "http"
+
+	// DefaultRefreshInterval is how long a calendar is cached if no refresh interval is specified
+	DefaultRefreshInterval = 5 * time.Minute
+
+	icalDateTimeFormat    = "20060102T150405"
+	icalUTCDateTimeFormat = "20060102T150405Z"
+	icalDateFormat        = "20060102"
+)
+
+var (
+	httpClient = &http.Client{Timeout: 10 * time.Second}
+
+	cacheLock sync.Mutex
+	cache     = make(map[string]*cachedCalendar)
+
+	icalDurationRegex = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+)
+
+type event struct {
+	start time.Time
+	end   time.Time
+}
+
+type cachedCalendar struct {
+	fetchedAt time.Time
+	// events of an iCalendar feed
+	events []event
+	// active state returned by an HTTP endpoint
+	active bool
+}
+
+func (c *cachedCalendar) isActive(calendarType string, at time.Time) bool {
+	if calendarType == TypeHTTP {
+		return c.active
+	}
+	for _, e := range c.events {
+		if !at.Before(e.start) && at.Before(e.end) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive returns whether the calendar of the given type, at the given URL, is active at the given time. The calendar
+// is fetched at most once per refresh interval, and the last fetched version is used if it cannot be fetched again.
+func IsActive(calendarType string, url string, refreshInterval time.Duration, at time.Time) (bool, error) {
+	key := calendarType + "|" + url
+
+	cacheLock.Lock()
+	cached := cache[key]
+	cacheLock.Unlock()
+	if cached != nil && time.Since(cached.fetchedAt) < refreshInterval {
+		return cached.isActive(calendarType, at), nil
+	}
+
+	fetched, err := fetch(calendarType, url)
+	if err != nil {
+		if cached == nil {
+			return false, err
+		}
+		log.Warnf("Failed to refresh calendar %s, using the version fetched at %s: %v", url, cached.fetchedAt.Format(time.RFC3339), err)
+		return cached.isActive(calendarType, at), nil
+	}
+	cacheLock.Lock()
+	cache[key] = fetched
+	cacheLock.Unlock()
+	return fetched.isActive(calendarType, at), nil
+}
+
+func fetch(calendarType string, url string) (*cachedCalendar, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching calendar: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching calendar: unexpected status %s", resp.Status)
+	}
+
+	fetched := &cachedCalendar{fetchedAt: time.Now()}
+	switch calendarType {
+	case TypeICal:
+		fetched.events, err = parseICal(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing iCalendar feed: %w", err)
+		}
+	case TypeHTTP:
+		var body struct {
+			Active *bool `json:"active"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, fmt.Errorf("error decoding calendar response: %w", err)
+		}
+		if body.Active == nil {
+			return nil, fmt.Errorf("calendar response has no 'active' field")
+		}
+		fetched.active = *body.Active
+	default:
+		return nil, fmt.Errorf("unsupported calendar type '%s'", calendarType)
+	}
+	return fetched, nil
+}
+
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICal returns the events of an iCalendar feed. Recurrence rules are not supported: only the first occurrence of
+// recurring events is returned.
+func parseICal(r io.Reader) ([]event, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		// long lines are folded on multiple lines starting with a whitespace
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var events []event
+	var props map[string]icalProperty
+	for _, line := range lines {
+		prop, ok := parseICalProperty(line)
+		if !ok {
+			continue
+		}
+		switch {
+		case prop.name == "BEGIN" && prop.value == "VEVENT":
+			props = make(map[string]icalProperty)
+		case prop.name == "END" && prop.value == "VEVENT":
+			if props == nil {
+				continue
+			}
+			e, err := newICalEvent(props)
+			if err != nil {
+				return nil, err
+			}
+			if e != nil {
+				events = append(events, *e)
+			}
+			props = nil
+		case props != nil:
+			if _, ok := props[prop.name]; !ok {
+				props[prop.name] = prop
+			}
+		}
+	}
+	return events, nil
+}
+
+func parseICalProperty(line string) (icalProperty, bool) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return icalProperty{}, false
+	}
+	parts := strings.Split(line[:i], ";")
+	prop := icalProperty{name: strings.ToUpper(parts[0]), params: make(map[string]string), value: line[i+1:]}
+	for _, param := range parts[1:] {
+		if k, v, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return prop, true
+}
+
+func newICalEvent(props map[string]icalProperty) (*event, error) {
+	if status, ok := props["STATUS"]; ok && strings.EqualFold(status.value, "CANCELLED") {
+		return nil, nil
+	}
+	dtstart, ok := props["DTSTART"]
+	if !ok {
+		return nil, fmt.Errorf("event has no DTSTART property")
+	}
+	start, allDay, err := parseICalTime(dtstart)
+	if err != nil {
+		return nil, err
+	}
+	var end time.Time
+	if dtend, ok := props["DTEND"]; ok {
+		end, _, err = parseICalTime(dtend)
+		if err != nil {
+			return nil, err
+		}
+	} else if duration, ok := props["DURATION"]; ok {
+		d, err := parseICalDuration(duration.value)
+		if err != nil {
+			return nil, err
+		}
+		end = start.Add(d)
+	} else if allDay {
+		end = start.AddDate(0, 0, 1)
+	} else {
+		end = start
+	}
+	return &event{start: start, end: end}, nil
+}
+
+// parseICalTime returns the time of a DTSTART or DTEND property, and whether it is a date rather than a date-time
+func parseICalTime(prop icalProperty) (time.Time, bool, error) {
+	loc := time.UTC
+	if tzid, ok := prop.params["TZID"]; ok {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid time zone '%s' of %s: %w", tzid, prop.name, err)
+		}
+		loc = l
+	}
+	var t time.Time
+	var err error
+	allDay := prop.params["VALUE"] == "DATE" || len(prop.value) == len(icalDateFormat)
+	switch {
+	case allDay:
+		t, err = time.ParseInLocation(icalDateFormat, prop.value, loc)
+	case strings.HasSuffix(prop.value, "Z"):
+		t, err = time.Parse(icalUTCDateTimeFormat, prop.value)
+	default:
+		t, err = time.ParseInLocation(icalDateTimeFormat, prop.value, loc)
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s '%s': %w", prop.name, prop.value, err)
+	}
+	return t, allDay, nil
+}
+
+// parseICalDuration parses a duration in the ISO 8601 format used by iCalendar, e.g. PT1H30M or P1D
+func parseICalDuration(s string) (time.Duration, error) {
+	matches := icalDurationRegex.FindStringSubmatch(s)
+	if matches == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid duration '%s'", s)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if matches[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': %w", s, err)
+		}
+		d += time.Duration(n) * unit
+	}
+	if matches[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
@@ -0,0 +1,128 @@
+package calendar
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testICal = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"SUMMARY:Year-end change\r\n" +
+	" freeze\r\n" +
+	"DTSTART:20231220T000000Z\r\n" +
+	"DTEND:20240103T000000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;TZID=Europe/Paris:20240201T090000\r\n" +
+	"DURATION:PT2H30M\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20240301\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20240401T000000Z\r\n" +
+	"DTEND:20240402T000000Z\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICal(t *testing.T) {
+	events, err := parseICal(strings.NewReader(testICal))
+	require.NoError(t, err)
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	assert.Equal(t, []event{{
+		start: time.Date(2023, 12, 20, 0, 0, 0, 0, time.UTC),
+		end:   time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	}, {
+		start: time.Date(2024, 2, 1, 9, 0, 0, 0, paris),
+		end:   time.Date(2024, 2, 1, 11, 30, 0, 0, paris),
+	}, {
+		start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		end:   time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+	}}, events)
+
+	_, err = parseICal(strings.NewReader("BEGIN:VEVENT\nDTSTART:yesterday\nEND:VEVENT\n"))
+	assert.ErrorContains(t, err, "invalid DTSTART 'yesterday'")
+}
+
+func TestParseICalDuration(t *testing.T) {
+	for s, expected := range map[string]time.Duration{
+		"PT1H":      time.Hour,
+		"PT1H30M":   90 * time.Minute,
+		"P1D":       24 * time.Hour,
+		"P1W":       7 * 24 * time.Hour,
+		"P1DT12H":   36 * time.Hour,
+		"-PT15M":    -15 * time.Minute,
+		"PT0S":      0,
+		"P2DT3M10S": 48*time.Hour + 3*time.Minute + 10*time.Second,
+	} {
+		d, err := parseICalDuration(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, d, s)
+	}
+	for _, s := range []string{"", "P", "PT", "1H", "PT1X"} {
+		_, err := parseICalDuration(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestIsActive(t *testing.T) {
+	requests := 0
+	failing := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch r.URL.Path {
+		case "/freeze.ics":
+			_, _ = w.Write([]byte(testICal))
+		case "/freeze":
+			_, _ = w.Write([]byte(`{"active": true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	t.Run("ICal", func(t *testing.T) {
+		active, err := IsActive(TypeICal, ts.URL+"/freeze.ics", time.Hour, time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, active)
+
+		active, err = IsActive(TypeICal, ts.URL+"/freeze.ics", time.Hour, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, active)
+		// the feed is cached for the refresh interval
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		requests = 0
+		active, err := IsActive(TypeHTTP, ts.URL+"/freeze", 0, time.Now())
+		require.NoError(t, err)
+		assert.True(t, active)
+
+		// the last fetched version is used if the endpoint fails
+		failing = true
+		active, err = IsActive(TypeHTTP, ts.URL+"/freeze", 0, time.Now())
+		require.NoError(t, err)
+		assert.True(t, active)
+		assert.Equal(t, 2, requests)
+		failing = false
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		_, err := IsActive(TypeHTTP, ts.URL+"/missing", time.Hour, time.Now())
+		assert.ErrorContains(t, err, "unexpected status 404")
+	})
+}
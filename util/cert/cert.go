@@ -11,10 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
@@ -362,3 +364,85 @@ func GetCertPoolFromPEMData(pemData []string) *x509.CertPool {
 	}
 	return certPool
 }
+
+// The host key algorithms requested from an SSH server when scanning its host
+// keys. The server is asked for each of them in turn, so that all the keys it
+// offers are returned.
+var sshScanHostKeyAlgorithms = []string{
+	ssh.KeyAlgoED25519,
+	ssh.KeyAlgoECDSA256,
+	ssh.KeyAlgoECDSA384,
+	ssh.KeyAlgoECDSA521,
+	ssh.KeyAlgoRSASHA512,
+	ssh.KeyAlgoRSA,
+}
+
+// errSSHHostKeyScanned aborts the SSH handshake once the host key was received
+var errSSHHostKeyScanned = errors.New("host key scanned")
+
+// Returns the name of an SSH server as used in known hosts entries, i.e. with
+// the port only for non-default ports.
+func SSHKnownHostsServerName(hostname string, port int) string {
+	if port == 0 || port == 22 {
+		return hostname
+	}
+	return fmt.Sprintf("[%s]:%d", hostname, port)
+}
+
+// Connects to the SSH server at the given address (host:port) and returns the
+// public host keys it offers, at most one per key type. No authentication is
+// performed, the connection is closed as soon as the host key was received.
+func ScanSSHHostKeys(address string, timeout time.Duration) ([]ssh.PublicKey, error) {
+	keys := make([]ssh.PublicKey, 0)
+	keyTypes := make(map[string]bool)
+	var lastErr error
+	for _, algorithm := range sshScanHostKeyAlgorithms {
+		key, err := scanSSHHostKey(address, algorithm, timeout)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) {
+				return nil, err
+			}
+			// The server does not support the algorithm
+			lastErr = err
+			continue
+		}
+		if !keyTypes[key.Type()] {
+			keyTypes[key.Type()] = true
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 && lastErr != nil {
+		return nil, fmt.Errorf("could not scan host keys of %s: %w", address, lastErr)
+	}
+	return keys, nil
+}
+
+func scanSSHHostKey(address string, algorithm string, timeout time.Duration) (ssh.PublicKey, error) {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	var hostKey ssh.PublicKey
+	config := &ssh.ClientConfig{
+		HostKeyAlgorithms: []string{algorithm},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			hostKey = key
+			return errSSHHostKeyScanned
+		},
+		Timeout: timeout,
+	}
+	_, _, _, err = ssh.NewClientConn(conn, address, config)
+	if hostKey != nil {
+		return hostKey, nil
+	}
+	if err == nil {
+		err = fmt.Errorf("no host key received for algorithm %s", algorithm)
+	}
+	return nil, err
+}
@@ -1,12 +1,20 @@
 package cert
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"net"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/argoproj/argo-cd/v2/common"
 )
@@ -547,3 +555,60 @@ func TestGetCertBundlePathForRepository(t *testing.T) {
 	})
 
 }
+
+func TestSSHKnownHostsServerName(t *testing.T) {
+	assert.Equal(t, "github.com", SSHKnownHostsServerName("github.com", 0))
+	assert.Equal(t, "github.com", SSHKnownHostsServerName("github.com", 22))
+	assert.Equal(t, "[github.com]:2222", SSHKnownHostsServerName("github.com", 2222))
+}
+
+func TestScanSSHHostKeys(t *testing.T) {
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	var expected []ssh.PublicKey
+	for _, newKey := range []func() (crypto.Signer, error){
+		func() (crypto.Signer, error) {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			return key, err
+		},
+		func() (crypto.Signer, error) { return rsa.GenerateKey(rand.Reader, 2048) },
+	} {
+		key, err := newKey()
+		require.NoError(t, err)
+		signer, err := ssh.NewSignerFromSigner(key)
+		require.NoError(t, err)
+		config.AddHostKey(signer)
+		expected = append(expected, signer.PublicKey())
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _, _, _ = ssh.NewServerConn(conn, config)
+			}()
+		}
+	}()
+
+	keys, err := ScanSSHHostKeys(listener.Addr().String(), 5*time.Second)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, expected[0].Marshal(), keys[0].Marshal())
+	assert.Equal(t, expected[1].Marshal(), keys[1].Marshal())
+}
+
+func TestScanSSHHostKeys_ConnectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	_, err = ScanSSHHostKeys(address, time.Second)
+	assert.Error(t, err)
+}
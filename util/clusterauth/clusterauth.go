@@ -26,6 +26,23 @@ const (
 	ArgoCDManagerClusterRoleBinding = "argocd-manager-role-binding"
 )
 
+// GetArgoCDManagerServiceAccount returns the name of the service account for managing a cluster by the Argo CD instance
+func GetArgoCDManagerServiceAccount() string {
+	return common.InstanceResourceName(ArgoCDManagerServiceAccount)
+}
+
+// GetArgoCDManagerClusterRole returns the name of the cluster role of the service account for managing a cluster by the
+// Argo CD instance
+func GetArgoCDManagerClusterRole() string {
+	return common.InstanceResourceName(ArgoCDManagerClusterRole)
+}
+
+// GetArgoCDManagerClusterRoleBinding returns the name of the cluster role binding of the service account for managing a
+// cluster by the Argo CD instance
+func GetArgoCDManagerClusterRoleBinding() string {
+	return common.InstanceResourceName(ArgoCDManagerClusterRoleBinding)
+}
+
 // ArgoCDManagerPolicyRules are the policies to give argocd-manager
 var ArgoCDManagerClusterPolicyRules = []rbacv1.PolicyRule{
 	{
@@ -178,20 +195,20 @@ func upsertRoleBinding(clientset kubernetes.Interface, name string, roleName str
 // InstallClusterManagerRBAC installs RBAC resources for a cluster manager to operate a cluster. Returns a token
 func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, namespaces []string, bearerTokenTimeout time.Duration) (string, error) {
 
-	err := CreateServiceAccount(clientset, ArgoCDManagerServiceAccount, ns)
+	err := CreateServiceAccount(clientset, GetArgoCDManagerServiceAccount(), ns)
 	if err != nil {
 		return "", err
 	}
 
 	if len(namespaces) == 0 {
-		err = upsertClusterRole(clientset, ArgoCDManagerClusterRole, ArgoCDManagerClusterPolicyRules)
+		err = upsertClusterRole(clientset, GetArgoCDManagerClusterRole(), ArgoCDManagerClusterPolicyRules)
 		if err != nil {
 			return "", err
 		}
 
-		err = upsertClusterRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, rbacv1.Subject{
+		err = upsertClusterRoleBinding(clientset, GetArgoCDManagerClusterRoleBinding(), GetArgoCDManagerClusterRole(), rbacv1.Subject{
 			Kind:      rbacv1.ServiceAccountKind,
-			Name:      ArgoCDManagerServiceAccount,
+			Name:      GetArgoCDManagerServiceAccount(),
 			Namespace: ns,
 		})
 		if err != nil {
@@ -199,14 +216,14 @@ func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, namesp
 		}
 	} else {
 		for _, namespace := range namespaces {
-			err = upsertRole(clientset, ArgoCDManagerClusterRole, namespace, ArgoCDManagerNamespacePolicyRules)
+			err = upsertRole(clientset, GetArgoCDManagerClusterRole(), namespace, ArgoCDManagerNamespacePolicyRules)
 			if err != nil {
 				return "", err
 			}
 
-			err = upsertRoleBinding(clientset, ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, namespace, rbacv1.Subject{
+			err = upsertRoleBinding(clientset, GetArgoCDManagerClusterRoleBinding(), GetArgoCDManagerClusterRole(), namespace, rbacv1.Subject{
 				Kind:      rbacv1.ServiceAccountKind,
-				Name:      ArgoCDManagerServiceAccount,
+				Name:      GetArgoCDManagerServiceAccount(),
 				Namespace: ns,
 			})
 			if err != nil {
@@ -215,7 +232,7 @@ func InstallClusterManagerRBAC(clientset kubernetes.Interface, ns string, namesp
 		}
 	}
 
-	return GetServiceAccountBearerToken(clientset, ns, ArgoCDManagerServiceAccount, bearerTokenTimeout)
+	return GetServiceAccountBearerToken(clientset, ns, GetArgoCDManagerServiceAccount(), bearerTokenTimeout)
 }
 
 // GetServiceAccountBearerToken determines if a ServiceAccount has a
@@ -336,7 +353,7 @@ func createServiceAccountToken(clientset kubernetes.Interface, serviceAccount *c
 
 // UninstallClusterManagerRBAC removes RBAC resources for a cluster manager to operate a cluster
 func UninstallClusterManagerRBAC(clientset kubernetes.Interface) error {
-	return UninstallRBAC(clientset, "kube-system", ArgoCDManagerClusterRoleBinding, ArgoCDManagerClusterRole, ArgoCDManagerServiceAccount)
+	return UninstallRBAC(clientset, "kube-system", GetArgoCDManagerClusterRoleBinding(), GetArgoCDManagerClusterRole(), GetArgoCDManagerServiceAccount())
 }
 
 // UninstallRBAC uninstalls RBAC related resources  for a binding, role, and service account
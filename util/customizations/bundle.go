@@ -0,0 +1,169 @@
+package customizations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
+	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const resourceCustomizationsKeyPrefix = "resource.customizations."
+
+// BundleSyncer keeps the resource customizations of the settings manager in sync with the bundle of resource
+// customizations configured in the argocd-cm ConfigMap
+type BundleSyncer struct {
+	namespace     string
+	settingsMgr   *settings.SettingsManager
+	db            db.ArgoDB
+	repoClientset apiclient.Clientset
+}
+
+// NewBundleSyncer creates a syncer of the resource customizations bundle of the given settings manager
+func NewBundleSyncer(namespace string, settingsMgr *settings.SettingsManager, db db.ArgoDB, repoClientset apiclient.Clientset) *BundleSyncer {
+	return &BundleSyncer{
+		namespace:     namespace,
+		settingsMgr:   settingsMgr,
+		db:            db,
+		repoClientset: repoClientset,
+	}
+}
+
+// Run syncs the resource customizations bundle at the given interval until the context is done
+func (s *BundleSyncer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sync(ctx); err != nil {
+			log.Warnf("Failed to sync the resource customizations bundle, keeping revision '%s': %v", s.settingsMgr.GetResourceCustomizationsBundleRevision(), err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync loads the resource customizations from the configured bundle and sets them in the settings manager once
+// verified and validated. The customizations previously loaded are kept if the bundle cannot be loaded, and dropped if
+// the bundle is no longer configured.
+func (s *BundleSyncer) Sync(ctx context.Context) error {
+	bundle, err := s.settingsMgr.GetResourceCustomizationsBundle()
+	if err != nil {
+		return err
+	}
+	if bundle == nil {
+		s.settingsMgr.SetResourceCustomizationsBundle("", nil)
+		return nil
+	}
+	revision, customizations, err := s.getCustomizations(ctx, bundle)
+	if err != nil {
+		return err
+	}
+	if err := settings.ValidateResourceCustomizations(customizations); err != nil {
+		return fmt.Errorf("resource customizations at revision %s are invalid: %w", revision, err)
+	}
+	if revision != s.settingsMgr.GetResourceCustomizationsBundleRevision() {
+		log.Infof("Loaded %d resource customizations from %s at revision %s", len(customizations), bundle.RepoURL, revision)
+	}
+	s.settingsMgr.SetResourceCustomizationsBundle(revision, customizations)
+	return nil
+}
+
+// getCustomizations returns the resolved revision of the bundle and the resource customizations defined in the data of
+// its config maps
+func (s *BundleSyncer) getCustomizations(ctx context.Context, bundle *settings.ResourceCustomizationsBundle) (string, map[string]string, error) {
+	repo, err := s.db.GetRepository(ctx, bundle.RepoURL)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get repository '%s': %w", bundle.RepoURL, err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to connect to the repo server: %w", err)
+	}
+	defer io.Close(conn)
+
+	source := appv1.ApplicationSource{RepoURL: bundle.RepoURL, Path: bundle.Path, TargetRevision: bundle.TargetRevision}
+	res, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
+		Repo:              repo,
+		Revision:          bundle.TargetRevision,
+		Namespace:         s.namespace,
+		ApplicationSource: &source,
+		NoRevisionCache:   true,
+		VerifySignature:   len(bundle.SignatureKeys) > 0,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate manifests: %w", err)
+	}
+	if len(bundle.SignatureKeys) > 0 {
+		if err := verifySignature(res.Revision, res.VerifyResult, bundle.SignatureKeys); err != nil {
+			return "", nil, err
+		}
+	}
+
+	var names []string
+	data := map[string]map[string]interface{}{}
+	for _, manifest := range res.Manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return "", nil, err
+		}
+		if obj.GetAPIVersion() != "v1" || obj.GetKind() != "ConfigMap" {
+			log.Debugf("Ignoring %s/%s which is not a config map", obj.GetKind(), obj.GetName())
+			continue
+		}
+		if _, ok := data[obj.GetName()]; ok {
+			return "", nil, fmt.Errorf("config map '%s' is defined more than once", obj.GetName())
+		}
+		cmData, _ := obj.Object["data"].(map[string]interface{})
+		data[obj.GetName()] = cmData
+		names = append(names, obj.GetName())
+	}
+	sort.Strings(names)
+
+	customizations := map[string]string{}
+	for _, name := range names {
+		for k, v := range data[name] {
+			if !strings.HasPrefix(k, resourceCustomizationsKeyPrefix) || strings.HasPrefix(k, resourceCustomizationsKeyPrefix+"bundle.") {
+				return "", nil, fmt.Errorf("key '%s' of config map '%s' is not a resource customization", k, name)
+			}
+			if _, ok := customizations[k]; ok {
+				return "", nil, fmt.Errorf("resource customization '%s' is defined more than once", k)
+			}
+			value, ok := v.(string)
+			if !ok {
+				return "", nil, fmt.Errorf("resource customization '%s' of config map '%s' is not a string", k, name)
+			}
+			customizations[k] = value
+		}
+	}
+	return res.Revision, customizations, nil
+}
+
+// verifySignature verifies that the given result of the verification of the signature of the revision is good and was
+// made with one of the allowed keys
+func verifySignature(revision string, verifyResult string, signatureKeys []string) error {
+	if verifyResult == "" {
+		return fmt.Errorf("revision %s is not signed, but a signature is required", revision)
+	}
+	result := gpg.ParseGitCommitVerification(verifyResult)
+	if result.Result != gpg.VerifyResultGood {
+		return fmt.Errorf("could not verify the signature of revision %s: %s", revision, result.Message)
+	}
+	for _, key := range signatureKeys {
+		if gpg.KeyID(key) != "" && gpg.KeyID(key) == gpg.KeyID(result.KeyID) {
+			return nil
+		}
+	}
+	return fmt.Errorf("revision %s is signed with %s key %s, which is not allowed", revision, result.Cipher, result.KeyID)
+}
@@ -0,0 +1,121 @@
+package customizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	testNamespace = "argocd"
+	testRepoURL   = "https://github.com/argoproj/argocd-customizations"
+	goodSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
+gpg:                using RSA key 4AEE18F83AFDEB23
+gpg: Good signature from "GitHub (web-flow commit signing) <noreply@github.com>" [ultimate]`
+)
+
+var (
+	widgetManifest = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"widget"},"data":{"resource.customizations.health.example.com_Widget":"hs = {status = 'Healthy'}\nreturn hs"}}`
+	gadgetManifest = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"gadget"},"data":{"resource.customizations.health.example.com_Gadget":"hs = {status = 'Healthy'}\nreturn hs"}}`
+)
+
+func newSyncer(t *testing.T, bundleSettings map[string]string, res *apiclient.ManifestResponse) (*BundleSyncer, *settings.SettingsManager) {
+	repoClient := &repomocks.RepoServerServiceClient{}
+	repoClient.On("GenerateManifest", mock.Anything, mock.MatchedBy(func(req *apiclient.ManifestRequest) bool {
+		return req.ApplicationSource.RepoURL == testRepoURL && req.ApplicationSource.Path == "customizations" && req.Revision == "HEAD"
+	})).Return(res, nil)
+
+	argoDB := &dbmocks.ArgoDB{}
+	argoDB.On("GetRepository", mock.Anything, testRepoURL).Return(&appv1.Repository{Repo: testRepoURL}, nil)
+
+	data := map[string]string{
+		"resource.customizations.bundle.repoURL": testRepoURL,
+		"resource.customizations.bundle.path":    "customizations",
+	}
+	for k, v := range bundleSettings {
+		data[k] = v
+	}
+	kubeClient := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: data,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, testNamespace)
+	return NewBundleSyncer(testNamespace, settingsMgr, argoDB, &repomocks.Clientset{RepoServerServiceClient: repoClient}), settingsMgr
+}
+
+func TestSync(t *testing.T) {
+	t.Run("LoadsCustomizations", func(t *testing.T) {
+		syncer, settingsMgr := newSyncer(t, nil, &apiclient.ManifestResponse{Manifests: []string{widgetManifest, gadgetManifest}, Revision: "abc123"})
+
+		require.NoError(t, syncer.Sync(context.Background()))
+		assert.Equal(t, "abc123", settingsMgr.GetResourceCustomizationsBundleRevision())
+		overrides, err := settingsMgr.GetResourceOverrides()
+		require.NoError(t, err)
+		assert.Contains(t, overrides["example.com/Widget"].HealthLua, "Healthy")
+		assert.Contains(t, overrides["example.com/Gadget"].HealthLua, "Healthy")
+	})
+
+	t.Run("RejectsDuplicatedCustomizations", func(t *testing.T) {
+		otherWidgetManifest := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"other"},"data":{"resource.customizations.health.example.com_Widget":"return {}"}}`
+		syncer, settingsMgr := newSyncer(t, nil, &apiclient.ManifestResponse{Manifests: []string{widgetManifest, otherWidgetManifest}, Revision: "abc123"})
+
+		assert.ErrorContains(t, syncer.Sync(context.Background()), "defined more than once")
+		assert.Empty(t, settingsMgr.GetResourceCustomizationsBundleRevision())
+	})
+
+	t.Run("RejectsOtherSettings", func(t *testing.T) {
+		urlManifest := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"argocd-cm"},"data":{"url":"https://argocd.example.com"}}`
+		syncer, _ := newSyncer(t, nil, &apiclient.ManifestResponse{Manifests: []string{urlManifest}, Revision: "abc123"})
+
+		assert.ErrorContains(t, syncer.Sync(context.Background()), "is not a resource customization")
+	})
+
+	t.Run("RejectsInvalidScripts", func(t *testing.T) {
+		invalidManifest := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"widget"},"data":{"resource.customizations.health.example.com_Widget":"hs = {"}}`
+		syncer, settingsMgr := newSyncer(t, nil, &apiclient.ManifestResponse{Manifests: []string{invalidManifest}, Revision: "abc123"})
+
+		assert.ErrorContains(t, syncer.Sync(context.Background()), "are invalid")
+		assert.Empty(t, settingsMgr.GetResourceCustomizationsBundleRevision())
+	})
+
+	t.Run("VerifiesSignature", func(t *testing.T) {
+		syncer, settingsMgr := newSyncer(t, map[string]string{"resource.customizations.bundle.signatureKeys": "4AEE18F83AFDEB23"},
+			&apiclient.ManifestResponse{Manifests: []string{widgetManifest}, Revision: "abc123", VerifyResult: goodSignature})
+
+		require.NoError(t, syncer.Sync(context.Background()))
+		assert.Equal(t, "abc123", settingsMgr.GetResourceCustomizationsBundleRevision())
+	})
+
+	t.Run("RejectsUnsignedRevision", func(t *testing.T) {
+		syncer, _ := newSyncer(t, map[string]string{"resource.customizations.bundle.signatureKeys": "4AEE18F83AFDEB23"},
+			&apiclient.ManifestResponse{Manifests: []string{widgetManifest}, Revision: "abc123"})
+
+		assert.ErrorContains(t, syncer.Sync(context.Background()), "is not signed")
+	})
+
+	t.Run("RejectsUnknownKey", func(t *testing.T) {
+		syncer, _ := newSyncer(t, map[string]string{"resource.customizations.bundle.signatureKeys": "9B2E7E5E9B2E7E5E"},
+			&apiclient.ManifestResponse{Manifests: []string{widgetManifest}, Revision: "abc123", VerifyResult: goodSignature})
+
+		assert.ErrorContains(t, syncer.Sync(context.Background()), "which is not allowed")
+	})
+}
@@ -110,7 +110,7 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		},
 	}
 
-	if err = clusterToSecret(c, clusterSecret); err != nil {
+	if err = ClusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
 
@@ -287,7 +287,7 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
-	if err := clusterToSecret(c, clusterSecret); err != nil {
+	if err := ClusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
 
@@ -318,8 +318,8 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 	return db.settingsMgr.ResyncInformers()
 }
 
-// clusterToData converts a cluster object to string data for serialization to a secret
-func clusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
+// ClusterToSecret converts a cluster object to string data for serialization to a secret
+func ClusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
 	data := make(map[string][]byte)
 	data["server"] = []byte(strings.TrimRight(c.Server, "/"))
 	if c.Name == "" {
@@ -344,6 +344,9 @@ func clusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
+	if c.MaxConcurrentSyncs > 0 {
+		data["maxConcurrentSyncs"] = []byte(strconv.FormatInt(c.MaxConcurrentSyncs, 10))
+	}
 	secret.Data = data
 
 	secret.Labels = c.Labels
@@ -396,6 +399,15 @@ func secretToCluster(s *apiv1.Secret) (*appv1.Cluster, error) {
 		}
 	}
 
+	var maxConcurrentSyncs int64
+	if maxConcurrentSyncsStr := s.Data["maxConcurrentSyncs"]; maxConcurrentSyncsStr != nil {
+		if val, err := strconv.ParseInt(string(maxConcurrentSyncsStr), 10, 64); err != nil {
+			log.Warnf("Error while parsing maxConcurrentSyncs in cluster secret '%s': %v", s.Name, err)
+		} else {
+			maxConcurrentSyncs = val
+		}
+	}
+
 	// copy labels and annotations excluding system ones
 	labels := map[string]string{}
 	if s.Labels != nil {
@@ -420,6 +432,7 @@ func secretToCluster(s *apiv1.Secret) (*appv1.Cluster, error) {
 		Project:            string(s.Data["project"]),
 		Labels:             labels,
 		Annotations:        annotations,
+		MaxConcurrentSyncs: maxConcurrentSyncs,
 	}
 	return &cluster, nil
 }
@@ -58,22 +58,24 @@ func Test_secretToCluster(t *testing.T) {
 
 func TestClusterToSecret(t *testing.T) {
 	cluster := &appv1.Cluster{
-		Server:      "server",
-		Labels:      map[string]string{"test": "label"},
-		Annotations: map[string]string{"test": "annotation"},
-		Name:        "test",
-		Config:      v1alpha1.ClusterConfig{},
-		Project:     "project",
-		Namespaces:  []string{"default"},
+		Server:             "server",
+		Labels:             map[string]string{"test": "label"},
+		Annotations:        map[string]string{"test": "annotation"},
+		Name:               "test",
+		Config:             v1alpha1.ClusterConfig{},
+		Project:            "project",
+		Namespaces:         []string{"default"},
+		MaxConcurrentSyncs: 3,
 	}
 	s := &v1.Secret{}
-	err := clusterToSecret(cluster, s)
+	err := ClusterToSecret(cluster, s)
 	assert.NoError(t, err)
 
 	assert.Equal(t, []byte(cluster.Server), s.Data["server"])
 	assert.Equal(t, []byte(cluster.Name), s.Data["name"])
 	assert.Equal(t, []byte(cluster.Project), s.Data["project"])
 	assert.Equal(t, []byte("default"), s.Data["namespaces"])
+	assert.Equal(t, []byte("3"), s.Data["maxConcurrentSyncs"])
 	assert.Equal(t, cluster.Annotations, s.Annotations)
 	assert.Equal(t, cluster.Labels, s.Labels)
 }
@@ -99,6 +101,28 @@ func Test_secretToCluster_NoConfig(t *testing.T) {
 	})
 }
 
+func Test_secretToCluster_MaxConcurrentSyncs(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mycluster",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"name":               []byte("test"),
+			"server":             []byte("http://mycluster"),
+			"maxConcurrentSyncs": []byte("5"),
+		},
+	}
+	cluster, err := secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), cluster.MaxConcurrentSyncs)
+
+	secret.Data["maxConcurrentSyncs"] = []byte("invalid")
+	cluster, err = secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), cluster.MaxConcurrentSyncs)
+}
+
 func Test_secretToCluster_InvalidConfig(t *testing.T) {
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
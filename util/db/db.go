@@ -47,6 +47,9 @@ type ArgoDB interface {
 	GetRepository(ctx context.Context, url string) (*appv1.Repository, error)
 	// GetProjectRepositories returns project scoped repositories by given project name
 	GetProjectRepositories(ctx context.Context, project string) ([]*appv1.Repository, error)
+	// GetWriteRepository returns the repository with the credentials allowed to push to the repository with the given
+	// URL, or nil if there is none
+	GetWriteRepository(ctx context.Context, repoURL string) (*appv1.Repository, error)
 	// RepositoryExists returns whether a repository is configured for the given URL
 	RepositoryExists(ctx context.Context, repoURL string) (bool, error)
 	// UpdateRepository updates a repository
@@ -83,6 +86,9 @@ type ArgoDB interface {
 	AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*appv1.GnuPGPublicKey, []string, error)
 	// DeleteGPGPublicKey removes a GPG public key from the configuration
 	DeleteGPGPublicKey(ctx context.Context, keyID string) error
+
+	// ListCredentialExpiries lists the known expiries of the credentials of the repositories and clusters
+	ListCredentialExpiries(ctx context.Context) ([]CredentialExpiry, error)
 }
 
 type db struct {
@@ -0,0 +1,203 @@
+package db
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+// CredentialType is the type of a credential whose expiry is tracked
+type CredentialType string
+
+const (
+	// CredentialTypeTLSClientCertificate is a TLS client certificate, which expires at its notAfter date
+	CredentialTypeTLSClientCertificate CredentialType = "TLSClientCertificate"
+	// CredentialTypeGitHubAppPrivateKey is the private key of a GitHub App, which does not expire but ages from the
+	// last update of its secret
+	CredentialTypeGitHubAppPrivateKey CredentialType = "GitHubAppPrivateKey"
+	// CredentialTypeToken is a token, whose expiry is known when it is a JWT with an expiration claim
+	CredentialTypeToken CredentialType = "Token"
+)
+
+const (
+	// CredentialOwnerRepository indicates a credential of a repository
+	CredentialOwnerRepository = "Repository"
+	// CredentialOwnerRepositoryCredentials indicates a credential of a repository credentials template
+	CredentialOwnerRepositoryCredentials = "RepositoryCredentials"
+	// CredentialOwnerCluster indicates a credential of a cluster
+	CredentialOwnerCluster = "Cluster"
+)
+
+// CredentialExpiry holds what is known of the expiry of a credential of a repository or of a cluster
+type CredentialExpiry struct {
+	// OwnerKind is the kind of the owner of the credential, e.g. Repository
+	OwnerKind string
+	// Owner is the URL of the repository, the URL prefix of the repository credentials template, or the server of
+	// the cluster owning the credential
+	Owner string
+	// Type is the type of the credential
+	Type CredentialType
+	// IssuedAt is the time at which the credential was issued, if known
+	IssuedAt *time.Time
+	// ExpiresAt is the time at which the credential expires, if known
+	ExpiresAt *time.Time
+}
+
+// Deadline returns the time at which the credential expires, or at which it exceeds the given maximum age if it does
+// not expire. Returns nil if neither is known.
+func (e *CredentialExpiry) Deadline(maxAge time.Duration) *time.Time {
+	if e.ExpiresAt != nil {
+		return e.ExpiresAt
+	}
+	if e.IssuedAt != nil && maxAge > 0 {
+		deadline := e.IssuedAt.Add(maxAge)
+		return &deadline
+	}
+	return nil
+}
+
+// ExpiresWithin returns whether the deadline of the credential, see Deadline, is at most the given period after now
+func (e *CredentialExpiry) ExpiresWithin(now time.Time, period time.Duration, maxAge time.Duration) bool {
+	deadline := e.Deadline(maxAge)
+	return deadline != nil && deadline.Sub(now) <= period
+}
+
+// AppliesToRepository returns whether the credential is used to access the given repository, either because it is a
+// credential of the repository or of a repository credentials template whose URL prefixes the URL of the repository
+func (e *CredentialExpiry) AppliesToRepository(repoURL string) bool {
+	switch e.OwnerKind {
+	case CredentialOwnerRepository:
+		return strings.TrimSuffix(e.Owner, "/") == strings.TrimSuffix(repoURL, "/")
+	case CredentialOwnerRepositoryCredentials:
+		return strings.HasPrefix(repoURL, e.Owner)
+	}
+	return false
+}
+
+// Message returns a human readable description of the deadline of the credential given the maximum age of the
+// credentials which do not expire, see Deadline
+func (e *CredentialExpiry) Message(maxAge time.Duration) string {
+	credential := fmt.Sprintf("%s of %s %s", e.Type, strings.ToLower(e.OwnerKind), e.Owner)
+	switch deadline := e.Deadline(maxAge); {
+	case e.ExpiresAt != nil:
+		return fmt.Sprintf("%s expires at %s", credential, e.ExpiresAt.UTC().Format(time.RFC3339))
+	case deadline != nil:
+		return fmt.Sprintf("%s exceeds the maximum age of %s at %s", credential, maxAge, deadline.UTC().Format(time.RFC3339))
+	case e.IssuedAt != nil:
+		return fmt.Sprintf("%s was issued at %s", credential, e.IssuedAt.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%s has no known expiry", credential)
+}
+
+// ListCredentialExpiries returns the expiries of the credentials of the repositories, repository credentials templates
+// and clusters stored in secrets, which are known from the TLS client certificates, the tokens which are JWTs and the
+// last update of the secrets holding GitHub App private keys. The credentials without known expiry are not returned.
+func (db *db) ListCredentialExpiries(_ context.Context) ([]CredentialExpiry, error) {
+	var expiries []CredentialExpiry
+	for _, secretType := range []string{common.LabelValueSecretTypeRepository, common.LabelValueSecretTypeRepoCreds, common.LabelValueSecretTypeCluster} {
+		secrets, err := db.listSecretsByType(secretType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s secrets: %w", secretType, err)
+		}
+		for _, secret := range secrets {
+			switch secretType {
+			case common.LabelValueSecretTypeRepository:
+				expiries = append(expiries, repositorySecretExpiries(CredentialOwnerRepository, secret)...)
+			case common.LabelValueSecretTypeRepoCreds:
+				expiries = append(expiries, repositorySecretExpiries(CredentialOwnerRepositoryCredentials, secret)...)
+			case common.LabelValueSecretTypeCluster:
+				cluster, err := secretToCluster(secret)
+				if err != nil {
+					log.Warnf("Failed to get cluster from secret %s: %v", secret.Name, err)
+					continue
+				}
+				if expiry := certificateExpiry(CredentialOwnerCluster, cluster.Server, cluster.Config.TLSClientConfig.CertData); expiry != nil {
+					expiries = append(expiries, *expiry)
+				}
+				if expiry := tokenExpiry(CredentialOwnerCluster, cluster.Server, cluster.Config.BearerToken); expiry != nil {
+					expiries = append(expiries, *expiry)
+				}
+			}
+		}
+	}
+	sort.Slice(expiries, func(i, j int) bool {
+		if expiries[i].OwnerKind != expiries[j].OwnerKind {
+			return expiries[i].OwnerKind < expiries[j].OwnerKind
+		}
+		if expiries[i].Owner != expiries[j].Owner {
+			return expiries[i].Owner < expiries[j].Owner
+		}
+		return expiries[i].Type < expiries[j].Type
+	})
+	return expiries, nil
+}
+
+// repositorySecretExpiries returns the expiries of the credentials of a repository or repository credentials secret
+func repositorySecretExpiries(ownerKind string, secret *apiv1.Secret) []CredentialExpiry {
+	owner := string(secret.Data["url"])
+	var expiries []CredentialExpiry
+	if expiry := certificateExpiry(ownerKind, owner, secret.Data["tlsClientCertData"]); expiry != nil {
+		expiries = append(expiries, *expiry)
+	}
+	if expiry := tokenExpiry(ownerKind, owner, string(secret.Data["password"])); expiry != nil {
+		expiries = append(expiries, *expiry)
+	}
+	if len(secret.Data["githubAppPrivateKey"]) > 0 {
+		updatedAt := secretUpdatedAt(secret)
+		expiries = append(expiries, CredentialExpiry{OwnerKind: ownerKind, Owner: owner, Type: CredentialTypeGitHubAppPrivateKey, IssuedAt: &updatedAt})
+	}
+	return expiries
+}
+
+// certificateExpiry returns the expiry of the first certificate of the given PEM data, or nil if there is none
+func certificateExpiry(ownerKind string, owner string, data []byte) *CredentialExpiry {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.Warnf("Failed to parse TLS client certificate of %s %s: %v", strings.ToLower(ownerKind), owner, err)
+		return nil
+	}
+	return &CredentialExpiry{OwnerKind: ownerKind, Owner: owner, Type: CredentialTypeTLSClientCertificate, IssuedAt: &cert.NotBefore, ExpiresAt: &cert.NotAfter}
+}
+
+// tokenExpiry returns the expiry of the given token if it is a JWT with an expiration claim, or nil otherwise. The
+// signature of the token is not verified, since it is only inspected.
+func tokenExpiry(ownerKind string, owner string, token string) *CredentialExpiry {
+	if strings.Count(token, ".") != 2 {
+		return nil
+	}
+	var claims jwt.RegisteredClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil || claims.ExpiresAt == nil {
+		return nil
+	}
+	expiry := &CredentialExpiry{OwnerKind: ownerKind, Owner: owner, Type: CredentialTypeToken, ExpiresAt: &claims.ExpiresAt.Time}
+	if claims.IssuedAt != nil {
+		expiry.IssuedAt = &claims.IssuedAt.Time
+	}
+	return expiry
+}
+
+// secretUpdatedAt returns the time of the last update of the given secret recorded by its managed fields, or its
+// creation time if it has none
+func secretUpdatedAt(secret *apiv1.Secret) time.Time {
+	updatedAt := secret.CreationTimestamp.Time
+	for _, entry := range secret.ManagedFields {
+		if entry.Time != nil && entry.Time.After(updatedAt) {
+			updatedAt = entry.Time.Time
+		}
+	}
+	return updatedAt
+}
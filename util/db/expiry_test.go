@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func newTestCertificate(t *testing.T, notBefore, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "argocd"}, NotBefore: notBefore, NotAfter: notAfter}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func newTestToken(t *testing.T, issuedAt, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(issuedAt), ExpiresAt: jwt.NewNumericDate(expiresAt)}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	return token
+}
+
+func TestListCredentialExpiries(t *testing.T) {
+	issuedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clusterConfig, err := json.Marshal(v1alpha1.ClusterConfig{BearerToken: newTestToken(t, issuedAt, expiresAt)})
+	require.NoError(t, err)
+	secrets := []*corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", Namespace: testNamespace, Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository}},
+		Data: map[string][]byte{
+			"url":               []byte("https://git.example.com/org/repo"),
+			"tlsClientCertData": newTestCertificate(t, issuedAt, expiresAt),
+			// not a JWT
+			"password": []byte("ghp_token"),
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "creds", Namespace: testNamespace, Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds},
+			CreationTimestamp: metav1.NewTime(issuedAt),
+			ManagedFields:     []metav1.ManagedFieldsEntry{{Time: &metav1.Time{Time: issuedAt.Add(time.Hour)}}},
+		},
+		Data: map[string][]byte{"url": []byte("https://github.com/org"), "githubAppPrivateKey": []byte("key")},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: testNamespace, Labels: map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster}},
+		Data:       map[string][]byte{"server": []byte("https://kubernetes.example.com"), "name": []byte("cluster"), "config": clusterConfig},
+	}}
+	clientset := getClientset(nil, secrets[0], secrets[1], secrets[2])
+	db := NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset)
+
+	expiries, err := db.ListCredentialExpiries(context.Background())
+	require.NoError(t, err)
+	require.Len(t, expiries, 3)
+
+	assert.Equal(t, CredentialOwnerCluster, expiries[0].OwnerKind)
+	assert.Equal(t, "https://kubernetes.example.com", expiries[0].Owner)
+	assert.Equal(t, CredentialTypeToken, expiries[0].Type)
+	assert.Equal(t, expiresAt, expiries[0].ExpiresAt.UTC())
+
+	assert.Equal(t, CredentialOwnerRepository, expiries[1].OwnerKind)
+	assert.Equal(t, CredentialTypeTLSClientCertificate, expiries[1].Type)
+	assert.Equal(t, issuedAt, expiries[1].IssuedAt.UTC())
+	assert.Equal(t, expiresAt, expiries[1].ExpiresAt.UTC())
+
+	assert.Equal(t, CredentialOwnerRepositoryCredentials, expiries[2].OwnerKind)
+	assert.Equal(t, CredentialTypeGitHubAppPrivateKey, expiries[2].Type)
+	assert.Equal(t, issuedAt.Add(time.Hour), expiries[2].IssuedAt.UTC())
+	assert.Nil(t, expiries[2].ExpiresAt)
+}
+
+func TestCredentialExpiry(t *testing.T) {
+	issuedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := CredentialExpiry{OwnerKind: CredentialOwnerRepositoryCredentials, Owner: "https://github.com/org", Type: CredentialTypeGitHubAppPrivateKey, IssuedAt: &issuedAt}
+
+	assert.Nil(t, expiry.Deadline(0))
+	assert.False(t, expiry.ExpiresWithin(issuedAt.Add(365*24*time.Hour), time.Hour, 0))
+	assert.Equal(t, issuedAt.Add(90*24*time.Hour), *expiry.Deadline(90 * 24 * time.Hour))
+	assert.True(t, expiry.ExpiresWithin(issuedAt.Add(89*24*time.Hour), 48*time.Hour, 90*24*time.Hour))
+	assert.False(t, expiry.ExpiresWithin(issuedAt.Add(80*24*time.Hour), 48*time.Hour, 90*24*time.Hour))
+	assert.Equal(t, "GitHubAppPrivateKey of repositorycredentials https://github.com/org exceeds the maximum age of 2160h0m0s at 2023-04-01T00:00:00Z", expiry.Message(90*24*time.Hour))
+
+	assert.True(t, expiry.AppliesToRepository("https://github.com/org/repo"))
+	assert.False(t, expiry.AppliesToRepository("https://github.com/other/repo"))
+	expiry.OwnerKind = CredentialOwnerRepository
+	assert.False(t, expiry.AppliesToRepository("https://github.com/org/repo"))
+	assert.True(t, expiry.AppliesToRepository("https://github.com/org/"))
+}
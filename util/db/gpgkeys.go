@@ -59,7 +59,7 @@ func validatePGPKey(keyData string) (*appsv1.GnuPGPublicKey, error) {
 func (db *db) ListConfiguredGPGPublicKeys(ctx context.Context) (map[string]*appsv1.GnuPGPublicKey, error) {
 	log.Debugf("Loading PGP public keys from config map")
 	result := make(map[string]*appsv1.GnuPGPublicKey)
-	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.GetArgoCDGPGKeysConfigMapName())
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (db *db) AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*
 		return nil, nil, err
 	}
 
-	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.GetArgoCDGPGKeysConfigMapName())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -126,7 +126,7 @@ func (db *db) AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*
 
 // DeleteGPGPublicKey deletes a GPG public key from the configuration
 func (db *db) DeleteGPGPublicKey(ctx context.Context, keyID string) error {
-	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.GetArgoCDGPGKeysConfigMapName())
 	if err != nil {
 		return err
 	}
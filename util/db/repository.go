@@ -10,8 +10,10 @@ import (
 	"google.golang.org/grpc/status"
 	apiv1 "k8s.io/api/core/v1"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -108,6 +110,19 @@ func (db *db) GetProjectRepositories(ctx context.Context, project string) ([]*ap
 	return res, nil
 }
 
+func (db *db) GetWriteRepository(ctx context.Context, repoURL string) (*appsv1.Repository, error) {
+	secrets, err := db.listSecretsByType(common.LabelValueSecretTypeRepositoryWrite)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if git.SameURL(string(secret.Data["url"]), repoURL) {
+			return secretToRepository(secret)
+		}
+	}
+	return nil, nil
+}
+
 func (db *db) RepositoryExists(ctx context.Context, repoURL string) (bool, error) {
 	secretsBackend := db.repoBackend()
 	exists, err := secretsBackend.RepositoryExists(ctx, repoURL)
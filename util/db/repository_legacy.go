@@ -10,6 +10,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -472,3 +473,66 @@ func getRepositoryCredentialIndex(repoCredentials []settings.RepositoryCredentia
 	}
 	return idx
 }
+
+// MigrateLegacyRepositories moves the repositories and the repository credentials declared in the argocd-cm config map
+// into repository secrets, and returns the number of moved entries. The entries whose URL already has a repository
+// secret are dropped from the config map. The secrets referenced by the legacy entries are left untouched.
+func MigrateLegacyRepositories(ctx context.Context, namespace string, settingsMgr *settings.SettingsManager, kubeclientset kubernetes.Interface) (int, error) {
+	db := &db{settingsMgr: settingsMgr, ns: namespace, kubeclientset: kubeclientset}
+	legacyBackend := &legacyRepositoryBackend{db: db}
+	secretBackend := db.repoBackend()
+	migrated := 0
+
+	repos, err := settingsMgr.GetRepositories()
+	if err != nil {
+		return migrated, err
+	}
+	for _, repoInfo := range repos {
+		exists, err := secretBackend.RepositoryExists(ctx, repoInfo.URL)
+		if err != nil {
+			return migrated, err
+		}
+		if !exists {
+			repo, err := legacyBackend.credentialsToRepository(repoInfo)
+			if err != nil {
+				return migrated, err
+			}
+			if _, err := secretBackend.CreateRepository(ctx, repo); err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+	}
+	if len(repos) > 0 {
+		if err := settingsMgr.SaveRepositories(nil); err != nil {
+			return migrated, err
+		}
+	}
+
+	creds, err := settingsMgr.GetRepositoryCredentials()
+	if err != nil {
+		return migrated, err
+	}
+	for _, credsInfo := range creds {
+		exists, err := secretBackend.RepoCredsExists(ctx, credsInfo.URL)
+		if err != nil {
+			return migrated, err
+		}
+		if !exists {
+			repoCreds, err := legacyBackend.credentialsToRepositoryCredentials(credsInfo)
+			if err != nil {
+				return migrated, err
+			}
+			if _, err := secretBackend.CreateRepoCreds(ctx, repoCreds); err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+	}
+	if len(creds) > 0 {
+		if err := settingsMgr.SaveRepositoryCredentials(nil); err != nil {
+			return migrated, err
+		}
+	}
+	return migrated, nil
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -11,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/lru"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -32,7 +34,7 @@ func (s *secretsRepositoryBackend) CreateRepository(ctx context.Context, reposit
 		},
 	}
 
-	repositoryToSecret(repository, repositorySecret)
+	RepositoryToSecret(repository, repositorySecret)
 
 	_, err := s.db.createSecret(ctx, repositorySecret)
 	if err != nil {
@@ -141,7 +143,7 @@ func (s *secretsRepositoryBackend) UpdateRepository(ctx context.Context, reposit
 		return nil, err
 	}
 
-	repositoryToSecret(repository, repositorySecret)
+	RepositoryToSecret(repository, repositorySecret)
 
 	_, err = s.db.kubeclientset.CoreV1().Secrets(s.db.ns).Update(ctx, repositorySecret, metav1.UpdateOptions{})
 	if err != nil {
@@ -228,7 +230,7 @@ func (s *secretsRepositoryBackend) ListRepoCreds(ctx context.Context) ([]string,
 }
 
 func (s *secretsRepositoryBackend) UpdateRepoCreds(ctx context.Context, repoCreds *appsv1.RepoCreds) (*appsv1.RepoCreds, error) {
-	repoCredsSecret, err := s.getRepoCredsSecret(repoCreds.URL)
+	repoCredsSecret, err := s.getRepoCredsSecretByTemplateURL(repoCreds.URL)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return s.CreateRepoCreds(ctx, repoCreds)
@@ -252,7 +254,7 @@ func (s *secretsRepositoryBackend) UpdateRepoCreds(ctx context.Context, repoCred
 }
 
 func (s *secretsRepositoryBackend) DeleteRepoCreds(ctx context.Context, name string) error {
-	secret, err := s.getRepoCredsSecret(name)
+	secret, err := s.getRepoCredsSecretByTemplateURL(name)
 	if err != nil {
 		return err
 	}
@@ -265,7 +267,7 @@ func (s *secretsRepositoryBackend) DeleteRepoCreds(ctx context.Context, name str
 }
 
 func (s *secretsRepositoryBackend) RepoCredsExists(ctx context.Context, repoURL string) (bool, error) {
-	_, err := s.getRepoCredsSecret(repoURL)
+	_, err := s.getRepoCredsSecretByTemplateURL(repoURL)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return false, nil
@@ -314,6 +316,8 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 		Proxy:                      string(secret.Data["proxy"]),
 		Project:                    string(secret.Data["project"]),
 		GCPServiceAccountKey:       string(secret.Data["gcpServiceAccountKey"]),
+		TLSCACertData:              string(secret.Data["tlsCACertData"]),
+		TLSServerName:              string(secret.Data["tlsServerName"]),
 	}
 
 	insecureIgnoreHostKey, err := boolOrFalse(secret, "insecureIgnoreHostKey")
@@ -361,7 +365,8 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	return repository, nil
 }
 
-func repositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) {
+// RepositoryToSecret writes the repository into the data, labels and annotations of a repository secret
+func RepositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) {
 	if secret.Data == nil {
 		secret.Data = make(map[string][]byte)
 	}
@@ -386,6 +391,8 @@ func repositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) {
 	updateSecretString(secret, "proxy", repository.Proxy)
 	updateSecretString(secret, "gcpServiceAccountKey", repository.GCPServiceAccountKey)
 	updateSecretBool(secret, "forceHttpBasicAuth", repository.ForceHttpBasicAuth)
+	updateSecretString(secret, "tlsCACertData", repository.TLSCACertData)
+	updateSecretString(secret, "tlsServerName", repository.TLSServerName)
 	addSecretMetadata(secret, common.LabelValueSecretTypeRepository)
 }
 
@@ -402,6 +409,8 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		GitHubAppEnterpriseBaseURL: string(secret.Data["githubAppEnterpriseBaseUrl"]),
 		GCPServiceAccountKey:       string(secret.Data["gcpServiceAccountKey"]),
 		Proxy:                      string(secret.Data["proxy"]),
+		TLSCACertData:              string(secret.Data["tlsCACertData"]),
+		TLSServerName:              string(secret.Data["tlsServerName"]),
 	}
 
 	enableOCI, err := boolOrFalse(secret, "enableOCI")
@@ -428,6 +437,30 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 	}
 	repository.ForceHttpBasicAuth = forceBasicAuth
 
+	urlRegex, err := boolOrFalse(secret, "urlRegex")
+	if err != nil {
+		return repository, err
+	}
+	repository.URLRegex = urlRegex
+
+	priority, err := intOrZero(secret, "priority")
+	if err != nil {
+		return repository, err
+	}
+	repository.Priority = priority
+
+	enableLfs, err := boolOrFalse(secret, "enableLfs")
+	if err != nil {
+		return repository, err
+	}
+	repository.EnableLFS = enableLfs
+
+	forceProxy, err := boolOrFalse(secret, "forceProxy")
+	if err != nil {
+		return repository, err
+	}
+	repository.ForceProxy = forceProxy
+
 	return repository, nil
 }
 
@@ -451,6 +484,12 @@ func repoCredsToSecret(repoCreds *appsv1.RepoCreds, secret *corev1.Secret) {
 	updateSecretString(secret, "gcpServiceAccountKey", repoCreds.GCPServiceAccountKey)
 	updateSecretString(secret, "proxy", repoCreds.Proxy)
 	updateSecretBool(secret, "forceHttpBasicAuth", repoCreds.ForceHttpBasicAuth)
+	updateSecretBool(secret, "urlRegex", repoCreds.URLRegex)
+	updateSecretInt(secret, "priority", repoCreds.Priority)
+	updateSecretBool(secret, "enableLfs", repoCreds.EnableLFS)
+	updateSecretBool(secret, "forceProxy", repoCreds.ForceProxy)
+	updateSecretString(secret, "tlsCACertData", repoCreds.TLSCACertData)
+	updateSecretString(secret, "tlsServerName", repoCreds.TLSServerName)
 	addSecretMetadata(secret, common.LabelValueSecretTypeRepoCreds)
 }
 
@@ -483,17 +522,86 @@ func (s *secretsRepositoryBackend) getRepoCredsSecret(repoURL string) (*corev1.S
 	return secrets[index], nil
 }
 
+// getRepoCredsSecretByTemplateURL returns the credential template whose URL is exactly the given one, falling back to
+// the template that would be used for a repository with that URL. Regular expression templates can only be addressed
+// by their exact URL.
+func (s *secretsRepositoryBackend) getRepoCredsSecretByTemplateURL(templateURL string) (*corev1.Secret, error) {
+	secrets, err := s.db.listSecretsByType(common.LabelValueSecretTypeRepoCreds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		if string(secret.Data["url"]) == templateURL {
+			return secret, nil
+		}
+	}
+
+	index := s.getRepositoryCredentialIndex(secrets, templateURL)
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "repository credentials %q not found", templateURL)
+	}
+
+	return secrets[index], nil
+}
+
+// credentialURLRegexesSize is the maximum number of compiled URL regular expressions of credential templates which are
+// cached, so that the patterns of deleted or updated templates do not accumulate
+const credentialURLRegexesSize = 1000
+
+// credentialURLRegexes caches the compiled URL regular expressions of the credential templates, indexed by pattern
+var credentialURLRegexes = lru.New(credentialURLRegexesSize)
+
+// compileCredentialURLRegex returns the regular expression of a credential template URL, anchored so that it must
+// match the whole repository URL
+func compileCredentialURLRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := credentialURLRegexes.Get(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	credentialURLRegexes.Add(pattern, re)
+	return re, nil
+}
+
+// getRepositoryCredentialIndex returns the index of the credential template matching the repository URL. If several
+// templates match, the one with the highest priority is preferred, then the one with the longest URL.
 func (s *secretsRepositoryBackend) getRepositoryCredentialIndex(repoCredentials []*corev1.Secret, repoURL string) int {
-	var max, idx = 0, -1
-	repoURL = git.NormalizeGitURL(repoURL)
+	var max, maxPriority, idx = 0, int64(0), -1
+	normalizedRepoURL := git.NormalizeGitURL(repoURL)
 	for i, cred := range repoCredentials {
-		credUrl := git.NormalizeGitURL(string(cred.Data["url"]))
-		if strings.HasPrefix(repoURL, credUrl) {
-			if len(credUrl) > max {
-				max = len(credUrl)
-				idx = i
+		credUrl := string(cred.Data["url"])
+		urlRegex, err := boolOrFalse(cred, "urlRegex")
+		if err != nil {
+			log.Warnf("Error while parsing urlRegex of repository credentials '%s': %v", cred.Name, err)
+			continue
+		}
+		if urlRegex {
+			re, err := compileCredentialURLRegex(credUrl)
+			if err != nil {
+				log.Warnf("Invalid URL regular expression of repository credentials '%s': %v", cred.Name, err)
+				continue
+			}
+			if !re.MatchString(repoURL) {
+				continue
+			}
+		} else {
+			credUrl = git.NormalizeGitURL(credUrl)
+			if !strings.HasPrefix(normalizedRepoURL, credUrl) {
+				continue
 			}
 		}
+		priority, err := intOrZero(cred, "priority")
+		if err != nil {
+			log.Warnf("Error while parsing priority of repository credentials '%s': %v", cred.Name, err)
+		}
+		if idx < 0 || priority > maxPriority || (priority == maxPriority && len(credUrl) > max) {
+			max = len(credUrl)
+			maxPriority = priority
+			idx = i
+		}
 	}
 	return idx
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -84,7 +85,7 @@ func TestSecretsRepositoryBackend_CreateRepository(t *testing.T) {
 		// given
 		t.Parallel()
 		secret := &corev1.Secret{}
-		repositoryToSecret(repo, secret)
+		RepositoryToSecret(repo, secret)
 		delete(secret.Labels, common.LabelKeySecretType)
 		f := setupWithK8sObjects(secret)
 		f.clientSet.ReactionChain = nil
@@ -120,7 +121,7 @@ func TestSecretsRepositoryBackend_CreateRepository(t *testing.T) {
 				Namespace: "default",
 			},
 		}
-		repositoryToSecret(repo, secret)
+		RepositoryToSecret(repo, secret)
 		f := setupWithK8sObjects(secret)
 		f.clientSet.ReactionChain = nil
 		f.clientSet.WatchReactionChain = nil
@@ -536,6 +537,112 @@ func TestSecretsRepositoryBackend_GetRepoCreds(t *testing.T) {
 	assert.Equal(t, "someOtherPassword", repoCred.Password)
 }
 
+func TestSecretsRepositoryBackend_GetRepoCreds_RegexAndPriority(t *testing.T) {
+	newRepoCredSecret := func(name string, data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      name,
+				Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds},
+			},
+			Data: map[string][]byte{},
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return secret
+	}
+	repoCredSecrets := []runtime.Object{
+		newRepoCredSecret("prefix", map[string]string{
+			"url":      "https://github.com/argoproj",
+			"username": "prefixUsername",
+		}),
+		newRepoCredSecret("regex", map[string]string{
+			"url":      `^https://github\.com/argoproj/argo-.*$`,
+			"urlRegex": "true",
+			"username": "regexUsername",
+		}),
+		newRepoCredSecret("priority", map[string]string{
+			"url":        "https://github.com/",
+			"priority":   "10",
+			"username":   "priorityUsername",
+			"enableLfs":  "true",
+			"forceProxy": "true",
+			"proxy":      "http://proxy:8080",
+		}),
+		newRepoCredSecret("invalid-regex", map[string]string{
+			"url":      "(",
+			"urlRegex": "true",
+			"priority": "100",
+			"username": "invalidUsername",
+		}),
+		newRepoCredSecret("gitlab-regex", map[string]string{
+			"url":      `^https://gitlab\.com/.*/infra$`,
+			"urlRegex": "true",
+			"username": "gitlabUsername",
+		}),
+		newRepoCredSecret("unanchored-regex", map[string]string{
+			"url":      `example\.com/team`,
+			"urlRegex": "true",
+			"username": "exampleUsername",
+		}),
+	}
+
+	clientset := getClientset(map[string]string{}, repoCredSecrets...)
+	testee := &secretsRepositoryBackend{db: &db{
+		ns:            testNamespace,
+		kubeclientset: clientset,
+		settingsMgr:   settings.NewSettingsManager(context.TODO(), clientset, testNamespace),
+	}}
+
+	t.Run("Highest priority wins", func(t *testing.T) {
+		repoCred, err := testee.GetRepoCreds(context.TODO(), "https://github.com/argoproj/argo-cd")
+		assert.NoError(t, err)
+		if assert.NotNil(t, repoCred) {
+			assert.Equal(t, "priorityUsername", repoCred.Username)
+			assert.Equal(t, int64(10), repoCred.Priority)
+			assert.True(t, repoCred.EnableLFS)
+			assert.True(t, repoCred.ForceProxy)
+		}
+	})
+
+	t.Run("Regex match", func(t *testing.T) {
+		repoCred, err := testee.GetRepoCreds(context.TODO(), "https://gitlab.com/team/infra")
+		assert.NoError(t, err)
+		if assert.NotNil(t, repoCred) {
+			assert.Equal(t, "gitlabUsername", repoCred.Username)
+			assert.True(t, repoCred.URLRegex)
+		}
+	})
+
+	t.Run("Regex not matching", func(t *testing.T) {
+		repoCred, err := testee.GetRepoCreds(context.TODO(), "https://gitlab.com/team/apps")
+		assert.NoError(t, err)
+		assert.Nil(t, repoCred)
+	})
+
+	t.Run("Regex must match the whole URL", func(t *testing.T) {
+		repoCred, err := testee.GetRepoCreds(context.TODO(), "https://evil.example.com/team/apps")
+		assert.NoError(t, err)
+		assert.Nil(t, repoCred)
+	})
+
+	t.Run("Regex template addressed by exact URL", func(t *testing.T) {
+		exists, err := testee.RepoCredsExists(context.TODO(), `^https://github\.com/argoproj/argo-.*$`)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		err = testee.DeleteRepoCreds(context.TODO(), `^https://github\.com/argoproj/argo-.*$`)
+		assert.NoError(t, err)
+		secret, err := clientset.CoreV1().Secrets(testNamespace).Get(context.TODO(), "regex", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.NotContains(t, secret.Labels, common.LabelKeySecretType)
+		secret, err = clientset.CoreV1().Secrets(testNamespace).Get(context.TODO(), "priority", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Contains(t, secret.Labels, common.LabelKeySecretType)
+	})
+}
+
 func TestSecretsRepositoryBackend_ListRepoCreds(t *testing.T) {
 	repoCredSecrets := []runtime.Object{
 		&corev1.Secret{
@@ -776,6 +883,11 @@ func TestRepoCredsToSecret(t *testing.T) {
 		GithubAppId:                123,
 		GithubAppInstallationId:    456,
 		GitHubAppEnterpriseBaseURL: "GitHubAppEnterpriseBaseURL",
+		URLRegex:                   true,
+		Priority:                   5,
+		EnableLFS:                  true,
+		ForceProxy:                 true,
+		TLSCACertData:              "TLSCACertData",
 	}
 	repoCredsToSecret(creds, s)
 	assert.Equal(t, []byte(creds.URL), s.Data["url"])
@@ -790,6 +902,53 @@ func TestRepoCredsToSecret(t *testing.T) {
 	assert.Equal(t, []byte(strconv.FormatInt(creds.GithubAppId, 10)), s.Data["githubAppID"])
 	assert.Equal(t, []byte(strconv.FormatInt(creds.GithubAppInstallationId, 10)), s.Data["githubAppInstallationID"])
 	assert.Equal(t, []byte(creds.GitHubAppEnterpriseBaseURL), s.Data["githubAppEnterpriseBaseUrl"])
+	assert.Equal(t, []byte("true"), s.Data["urlRegex"])
+	assert.Equal(t, []byte("5"), s.Data["priority"])
+	assert.Equal(t, []byte("true"), s.Data["enableLfs"])
+	assert.Equal(t, []byte("true"), s.Data["forceProxy"])
+	assert.Equal(t, []byte(creds.TLSCACertData), s.Data["tlsCACertData"])
 	assert.Equal(t, map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD}, s.Annotations)
 	assert.Equal(t, map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds}, s.Labels)
 }
+
+func TestSecretsRepositoryBackend_TLSServerName(t *testing.T) {
+	clientset := getClientset(map[string]string{})
+	testee := &secretsRepositoryBackend{db: &db{
+		ns:            testNamespace,
+		kubeclientset: clientset,
+		settingsMgr:   settings.NewSettingsManager(context.TODO(), clientset, testNamespace),
+	}}
+
+	_, err := testee.CreateRepository(context.TODO(), &appsv1.Repository{Repo: "https://10.0.0.10/repo.git", TLSCACertData: "ca", TLSServerName: "git.example.com"})
+	require.NoError(t, err)
+	_, err = testee.CreateRepoCreds(context.TODO(), &appsv1.RepoCreds{URL: "https://10.0.0.10/", TLSServerName: "git.example.com"})
+	require.NoError(t, err)
+
+	repository, err := testee.GetRepository(context.TODO(), "https://10.0.0.10/repo.git")
+	require.NoError(t, err)
+	assert.Equal(t, "ca", repository.TLSCACertData)
+	assert.Equal(t, "git.example.com", repository.TLSServerName)
+
+	repoCreds, err := testee.GetRepoCreds(context.TODO(), "https://10.0.0.10/")
+	require.NoError(t, err)
+	assert.Equal(t, "git.example.com", repoCreds.TLSServerName)
+}
+
+func TestCompileCredentialURLRegex(t *testing.T) {
+	re, err := compileCredentialURLRegex(`https://github\.com/argoproj/.*`)
+	require.NoError(t, err)
+	assert.True(t, re.MatchString("https://github.com/argoproj/argo-cd"))
+	assert.False(t, re.MatchString("https://example.com/?https://github.com/argoproj/argo-cd"))
+	cached, err := compileCredentialURLRegex(`https://github\.com/argoproj/.*`)
+	require.NoError(t, err)
+	assert.Same(t, re, cached)
+
+	for i := 0; i < credentialURLRegexesSize+10; i++ {
+		_, err := compileCredentialURLRegex(`https://git\.example\.com/team-` + strconv.Itoa(i) + `/.*`)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, credentialURLRegexesSize, credentialURLRegexes.Len())
+
+	_, err = compileCredentialURLRegex(`https://github.com/(`)
+	assert.Error(t, err)
+}
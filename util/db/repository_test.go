@@ -318,3 +318,49 @@ func Test_GetProjectRepositories(t *testing.T) {
 	assert.Len(t, repos, 1)
 	assert.Equal(t, "git@github.com:argoproj/argo-cd", repos[0].Repo)
 }
+
+func Test_GetWriteRepository(t *testing.T) {
+	writeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "some-write-secret",
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeRepositoryWrite,
+			},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://github.com/argoproj/argo-cd"),
+			"username": []byte("writer"),
+			"password": []byte("write-token"),
+		},
+	}
+	readSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "some-repo-secret",
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeRepository,
+			},
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://github.com/argoproj/argocd-example-apps"),
+			"username": []byte("reader"),
+			"password": []byte("read-token"),
+		},
+	}
+
+	clientset := getClientset(map[string]string{}, writeSecret, readSecret)
+	argoDB := NewDB(testNamespace, settings.NewSettingsManager(context.TODO(), clientset, testNamespace), clientset)
+
+	repo, err := argoDB.GetWriteRepository(context.TODO(), "https://github.com/argoproj/argo-cd.git")
+	assert.NoError(t, err)
+	if assert.NotNil(t, repo) {
+		assert.Equal(t, "writer", repo.Username)
+		assert.Equal(t, "write-token", repo.Password)
+	}
+
+	// the credentials of the repository secrets are never allowed to push
+	repo, err = argoDB.GetWriteRepository(context.TODO(), "https://github.com/argoproj/argocd-example-apps")
+	assert.NoError(t, err)
+	assert.Nil(t, repo)
+}
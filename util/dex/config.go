@@ -9,6 +9,16 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
+// ValidateDexConfig returns an error if the dex.config of the settings is invalid. An invalid dex.config is
+// otherwise treated as if Dex was not configured.
+func ValidateDexConfig(argoCDSettings *settings.ArgoCDSettings) error {
+	if _, err := settings.UnmarshalDexConfig(argoCDSettings.DexConfig); err != nil {
+		return fmt.Errorf("failed to unmarshal dex.config from configmap: %v", err)
+	}
+	_, err := GenerateDexConfigYAML(argoCDSettings, true)
+	return err
+}
+
 func GenerateDexConfigYAML(settings *settings.ArgoCDSettings, disableTls bool) ([]byte, error) {
 	if !settings.IsDexConfigured() {
 		return nil, nil
@@ -2,6 +2,7 @@ package dex
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -137,3 +138,26 @@ func DexServerAddressWithProtocol(orig string, tlsConfig *DexTLSConfig) string {
 		}
 	}
 }
+
+// CheckDiscovery checks that Dex serves the discovery document of the given issuer
+func CheckDiscovery(ctx context.Context, serverAddr string, tlsConfig *DexTLSConfig, issuerURL string) error {
+	issuer, err := url.Parse(issuerURL)
+	if err != nil {
+		return fmt.Errorf("error parsing the issuer URL: %w", err)
+	}
+	discoveryURL := DexServerAddressWithProtocol(serverAddr, tlsConfig) + issuer.Path + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: TLSConfig(tlsConfig)}}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error reaching Dex: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from Dex: %s", resp.Status)
+	}
+	return nil
+}
@@ -148,6 +148,13 @@ var goodSecretswithCRLF = map[string]string{
 	"dex.acme.clientSecret":   "barfoo\n\r",
 }
 
+func Test_ValidateDexConfig(t *testing.T) {
+	assert.NoError(t, ValidateDexConfig(&settings.ArgoCDSettings{}))
+	assert.NoError(t, ValidateDexConfig(&settings.ArgoCDSettings{URL: "http://localhost", DexConfig: goodDexConfig}))
+	assert.Error(t, ValidateDexConfig(&settings.ArgoCDSettings{URL: "http://localhost", DexConfig: malformedDexConfig}))
+	assert.Error(t, ValidateDexConfig(&settings.ArgoCDSettings{URL: invalidURL, DexConfig: goodDexConfig}))
+}
+
 func Test_GenerateDexConfig(t *testing.T) {
 
 	t.Run("Empty settings", func(t *testing.T) {
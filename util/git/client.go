@@ -2,6 +2,7 @@ package git
 
 import (
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -68,9 +69,12 @@ type Client interface {
 	LsRemote(revision string) (string, error)
 	LsFiles(path string) ([]string, error)
 	LsLargeFiles() ([]string, error)
+	ChangedFiles(revision string, targetRevision string) ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
 	VerifyCommitSignature(string) (string, error)
+	CheckoutOrOrphan(branch string) error
+	CommitAndPush(branch, authorName, authorEmail, message string) (bool, error)
 }
 
 type EventHandlers struct {
@@ -153,6 +157,9 @@ func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, pr
 }
 
 func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, enableLfs bool, proxy string, opts ...ClientOpts) (Client, error) {
+	if fetcher := getFetcher(rawRepoURL); fetcher != nil {
+		return newFetcherClient(fetcher, rawRepoURL, root, creds, insecure, proxy, opts...), nil
+	}
 	client := &nativeGitClient{
 		repoURL:   rawRepoURL,
 		root:      root,
@@ -220,20 +227,26 @@ func GetRepoHTTPClient(repoURL string, insecure bool, creds Creds, proxyURL stri
 		DisableKeepAlives: true,
 	}
 	customHTTPClient.Transport = transport
+	if httpsCreds, ok := creds.(GenericHTTPSCreds); ok {
+		transport.TLSClientConfig.ServerName = httpsCreds.GetServerName()
+	}
 	if insecure {
 		transport.TLSClientConfig.InsecureSkipVerify = true
 		return customHTTPClient
 	}
-	parsedURL, err := url.Parse(repoURL)
-	if err != nil {
-		return customHTTPClient
+	var caData []string
+	if httpsCreds, ok := creds.(GenericHTTPSCreds); ok && httpsCreds.GetCAData() != "" {
+		caData = append(caData, httpsCreds.GetCAData())
 	}
-	serverCertificatePem, err := certutil.GetCertificateForConnect(parsedURL.Host)
-	if err != nil {
-		return customHTTPClient
+	parsedURL, err := url.Parse(repoURL)
+	if err == nil {
+		serverCertificatePem, err := certutil.GetCertificateForConnect(parsedURL.Host)
+		if err == nil {
+			caData = append(caData, serverCertificatePem...)
+		}
 	}
-	if len(serverCertificatePem) > 0 {
-		certPool := certutil.GetCertPoolFromPEMData(serverCertificatePem)
+	if len(caData) > 0 {
+		certPool := certutil.GetCertPoolFromPEMData(caData)
 		transport.TLSClientConfig.RootCAs = certPool
 	}
 	return customHTTPClient
@@ -265,6 +278,10 @@ func newAuth(repoURL string, creds Creds) (transport.AuthMethod, error) {
 		}
 		return auth, nil
 	case HTTPSCreds:
+		// the repositories authenticating with TLS client certificates only have no username nor password
+		if creds.username == "" && creds.password == "" {
+			return nil, nil
+		}
 		auth := githttp.BasicAuth{Username: creds.username, Password: creds.password}
 		if auth.Username == "" {
 			auth.Username = "x-access-token"
@@ -385,6 +402,24 @@ func (m *nativeGitClient) LsLargeFiles() ([]string, error) {
 	return ss, nil
 }
 
+// ChangedFiles lists the files which differ between the given revisions
+func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) ([]string, error) {
+	if revision == targetRevision {
+		return []string{}, nil
+	}
+	out, err := m.runCmd("diff", "--name-only", "-z", revision, targetRevision, "--")
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s..%s: %w", revision, targetRevision, err)
+	}
+	files := []string{}
+	for _, file := range strings.Split(out, "\000") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
 // Submodule embed other repositories into this repository
 func (m *nativeGitClient) Submodule() error {
 	if err := m.runCredentialedCmd("git", "submodule", "sync", "--recursive"); err != nil {
@@ -624,6 +659,64 @@ func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (strin
 	return m.runCmdOutput(cmd)
 }
 
+// CheckoutOrOrphan checks out the latest commit of the given branch, or an orphan branch without any files if the
+// branch does not exist yet
+func (m *nativeGitClient) CheckoutOrOrphan(branch string) error {
+	refs, err := m.LsRefs()
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		refs = &Refs{}
+	} else if err != nil {
+		return err
+	}
+	exists := false
+	for _, b := range refs.Branches {
+		if b == branch {
+			exists = true
+			break
+		}
+	}
+	if exists {
+		if err := m.runCredentialedCmd("git", "fetch", "origin", "--force", fmt.Sprintf("+refs/heads/%s:refs/remotes/origin/%s", branch, branch)); err != nil {
+			return err
+		}
+		if _, err := m.runCmd("checkout", "--force", "-B", branch, "origin/"+branch); err != nil {
+			return err
+		}
+	} else {
+		if _, err := m.runCmd("checkout", "--force", "--orphan", branch); err != nil {
+			return err
+		}
+		// the orphan branch starts with the files of the previous checkout
+		if _, err := m.runCmd("rm", "-r", "--cached", "--ignore-unmatch", "--quiet", "."); err != nil {
+			return err
+		}
+	}
+	_, err = m.runCmd("clean", "-fdx")
+	return err
+}
+
+// CommitAndPush commits all changes of the working tree and pushes them to the given branch. It returns false if
+// there are no changes to commit.
+func (m *nativeGitClient) CommitAndPush(branch, authorName, authorEmail, message string) (bool, error) {
+	if _, err := m.runCmd("add", "--all"); err != nil {
+		return false, err
+	}
+	out, err := m.runCmd("status", "--porcelain")
+	if err != nil {
+		return false, err
+	}
+	if out == "" {
+		return false, nil
+	}
+	if _, err := m.runCmd("-c", "user.name="+authorName, "-c", "user.email="+authorEmail, "-c", "commit.gpgsign=false", "commit", "--message", message); err != nil {
+		return false, err
+	}
+	if err := m.runCredentialedCmd("git", "push", "origin", "HEAD:refs/heads/"+branch); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // runCmd is a convenience function to run a command in a given directory and return its output
 func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 	cmd := exec.Command("git", args...)
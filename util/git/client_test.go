@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -158,3 +159,73 @@ func TestNewClient_invalidSSHURL(t *testing.T) {
 	assert.Nil(t, client)
 	assert.ErrorIs(t, err, ErrInvalidRepoURL)
 }
+
+func Test_nativeGitClient_ChangedFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	commit := func(message string) string {
+		err := runCmd(tempDir, "git", "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", message, "--allow-empty")
+		require.NoError(t, err)
+		out, err := exec.Command("git", "-C", tempDir, "rev-parse", "HEAD").Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+	writeFile := func(name string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, filepath.Dir(name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(name), 0644))
+		require.NoError(t, runCmd(tempDir, "git", "add", name))
+	}
+
+	require.NoError(t, runCmd(tempDir, "git", "init"))
+	writeFile("app/deployment.yaml")
+	initial := commit("Initial commit")
+	writeFile("other/deployment.yaml")
+	writeFile("README.md")
+	second := commit("Second commit")
+
+	client, err := NewClientExt(fmt.Sprintf("file://%s", tempDir), tempDir, NopCreds{}, true, false, "")
+	require.NoError(t, err)
+
+	changedFiles, err := client.ChangedFiles(initial, second)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"README.md", "other/deployment.yaml"}, changedFiles)
+
+	changedFiles, err = client.ChangedFiles(second, second)
+	require.NoError(t, err)
+	assert.Empty(t, changedFiles)
+
+	_, err = client.ChangedFiles("0000000000000000000000000000000000000000", second)
+	assert.Error(t, err)
+}
+
+func Test_nativeGitClient_CommitAndPush(t *testing.T) {
+	remoteDir := t.TempDir()
+	require.NoError(t, runCmd(remoteDir, "git", "init", "--bare"))
+
+	client, err := NewClientExt("file://"+remoteDir, t.TempDir(), NopCreds{}, false, false, "")
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+
+	// the branch does not exist yet
+	require.NoError(t, client.CheckoutOrOrphan("hydrated"))
+	require.NoError(t, os.WriteFile(filepath.Join(client.Root(), "manifest.yaml"), []byte("kind: ConfigMap\n"), 0644))
+	pushed, err := client.CommitAndPush("hydrated", "Argo CD", "argo-cd@example.com", "Hydrate")
+	require.NoError(t, err)
+	assert.True(t, pushed)
+
+	// there are no changes
+	pushed, err = client.CommitAndPush("hydrated", "Argo CD", "argo-cd@example.com", "Hydrate")
+	require.NoError(t, err)
+	assert.False(t, pushed)
+
+	other, err := NewClientExt("file://"+remoteDir, t.TempDir(), NopCreds{}, false, false, "")
+	require.NoError(t, err)
+	require.NoError(t, other.Init())
+	require.NoError(t, other.CheckoutOrOrphan("hydrated"))
+	data, err := os.ReadFile(filepath.Join(other.Root(), "manifest.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap\n", string(data))
+	metadata, err := other.RevisionMetadata("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "Argo CD <argo-cd@example.com>", metadata.Author)
+	assert.Equal(t, "Hydrate", metadata.Message)
+}
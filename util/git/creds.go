@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -20,7 +22,9 @@ import (
 
 	argoio "github.com/argoproj/gitops-engine/pkg/utils/io"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
+	"github.com/argoproj/pkg/sync"
 	"github.com/bradleyfalzon/ghinstallation/v2"
+	"github.com/google/go-github/v35/github"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -31,6 +35,8 @@ import (
 var (
 	// In memory cache for storing github APP api token credentials
 	githubAppTokenCache *gocache.Cache
+	// Lock preventing concurrent creation of github APP api tokens for the same credentials
+	githubAppTransportLock = sync.NewKeyLock()
 	// In memory cache for storing oauth2.TokenSource used to generate Google Cloud OAuth tokens
 	googleCloudTokenSource *gocache.Cache
 )
@@ -46,7 +52,7 @@ const (
 func init() {
 	githubAppCredsExp := common.GithubAppCredsExpirationDuration
 	if exp := os.Getenv(common.EnvGithubAppCredsExpirationDuration); exp != "" {
-		if qps, err := strconv.Atoi(exp); err != nil {
+		if qps, err := strconv.Atoi(exp); err == nil {
 			githubAppCredsExp = time.Duration(qps) * time.Minute
 		}
 	}
@@ -107,6 +113,8 @@ type GenericHTTPSCreds interface {
 	HasClientCert() bool
 	GetClientCertData() string
 	GetClientCertKey() string
+	GetCAData() string
+	GetServerName() string
 	Environ() (io.Closer, []string, error)
 }
 
@@ -124,6 +132,10 @@ type HTTPSCreds struct {
 	clientCertData string
 	// Client certificate key to use
 	clientCertKey string
+	// CA certificates used to verify the server certificate
+	caData string
+	// Server name sent with SNI and used to verify the server certificate, instead of the host of the URL
+	serverName string
 	// HTTP/HTTPS proxy used to access repository
 	proxy string
 	// temporal credentials store
@@ -132,13 +144,15 @@ type HTTPSCreds struct {
 	forceBasicAuth bool
 }
 
-func NewHTTPSCreds(username string, password string, clientCertData string, clientCertKey string, insecure bool, proxy string, store CredsStore, forceBasicAuth bool) GenericHTTPSCreds {
+func NewHTTPSCreds(username string, password string, clientCertData string, clientCertKey string, caData string, serverName string, insecure bool, proxy string, store CredsStore, forceBasicAuth bool) GenericHTTPSCreds {
 	return HTTPSCreds{
 		username,
 		password,
 		insecure,
 		clientCertData,
 		clientCertKey,
+		caData,
+		serverName,
 		proxy,
 		store,
 		forceBasicAuth,
@@ -209,6 +223,16 @@ func (c HTTPSCreds) Environ() (io.Closer, []string, error) {
 		// GIT_SSL_KEY is the full path to a client certificate's key to be used
 		env = append(env, fmt.Sprintf("GIT_SSL_KEY=%s", keyFile.Name()))
 	}
+	if c.caData != "" {
+		caFile, err := writeCAFile(c.caData)
+		if err != nil {
+			httpCloser.Close()
+			return NopCloser{}, nil, err
+		}
+		httpCloser = append(httpCloser, caFile)
+		// GIT_SSL_CAINFO is the full path to the CA certificates used to verify the server certificate
+		env = append(env, fmt.Sprintf("GIT_SSL_CAINFO=%s", caFile))
+	}
 	// If at least password is set, we will set ARGOCD_BASIC_AUTH_HEADER to
 	// hold the HTTP authorization header, so auth mechanism negotiation is
 	// skipped. This is insecure, but some environments may need it.
@@ -235,6 +259,30 @@ func (c HTTPSCreds) GetClientCertKey() string {
 	return c.clientCertKey
 }
 
+func (c HTTPSCreds) GetCAData() string {
+	return c.caData
+}
+
+func (c HTTPSCreds) GetServerName() string {
+	return c.serverName
+}
+
+// writeCAFile writes the CA certificates to a temporary file and returns its path
+func writeCAFile(caData string) (string, error) {
+	caFile, err := os.CreateTemp(argoio.TempDir, "")
+	if err != nil {
+		return "", err
+	}
+	defer caFile.Close()
+	if _, err = caFile.WriteString(caData); err != nil {
+		if removeErr := os.Remove(caFile.Name()); removeErr != nil {
+			log.Errorf("Could not remove previously created tempfile %s: %v", caFile.Name(), removeErr)
+		}
+		return "", err
+	}
+	return caFile.Name(), nil
+}
+
 // SSH implementation
 type SSHCreds struct {
 	sshPrivateKey string
@@ -316,14 +364,16 @@ type GitHubAppCreds struct {
 	repoURL        string
 	clientCertData string
 	clientCertKey  string
+	caData         string
+	serverName     string
 	insecure       bool
 	proxy          string
 	store          CredsStore
 }
 
 // NewGitHubAppCreds provide github app credentials
-func NewGitHubAppCreds(appID int64, appInstallId int64, privateKey string, baseURL string, repoURL string, clientCertData string, clientCertKey string, insecure bool, proxy string, store CredsStore) GenericHTTPSCreds {
-	return GitHubAppCreds{appID: appID, appInstallId: appInstallId, privateKey: privateKey, baseURL: baseURL, repoURL: repoURL, clientCertData: clientCertData, clientCertKey: clientCertKey, insecure: insecure, proxy: proxy, store: store}
+func NewGitHubAppCreds(appID int64, appInstallId int64, privateKey string, baseURL string, repoURL string, clientCertData string, clientCertKey string, caData string, serverName string, insecure bool, proxy string, store CredsStore) GenericHTTPSCreds {
+	return GitHubAppCreds{appID: appID, appInstallId: appInstallId, privateKey: privateKey, baseURL: baseURL, repoURL: repoURL, clientCertData: clientCertData, clientCertKey: clientCertKey, caData: caData, serverName: serverName, insecure: insecure, proxy: proxy, store: store}
 }
 
 func (g GitHubAppCreds) Environ() (io.Closer, []string, error) {
@@ -385,6 +435,16 @@ func (g GitHubAppCreds) Environ() (io.Closer, []string, error) {
 		env = append(env, fmt.Sprintf("GIT_SSL_KEY=%s", keyFile.Name()))
 
 	}
+	if g.caData != "" {
+		caFile, err := writeCAFile(g.caData)
+		if err != nil {
+			httpCloser.Close()
+			return NopCloser{}, nil, err
+		}
+		httpCloser = append(httpCloser, caFile)
+		// GIT_SSL_CAINFO is the full path to the CA certificates used to verify the server certificate
+		env = append(env, fmt.Sprintf("GIT_SSL_CAINFO=%s", caFile))
+	}
 	nonce := g.store.Add(githubAccessTokenUsername, token)
 	env = append(env, getGitAskPassEnv(nonce)...)
 	return argoioutils.NewCloser(func() error {
@@ -400,45 +460,169 @@ func (g GitHubAppCreds) getAccessToken() (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
+	itr, err := g.getInstallationTransport(ctx)
+	if err != nil {
+		return "", err
+	}
+	// This method caches the token and if it's expired retrieves a new one
+	return itr.Token(ctx)
+}
+
+// getInstallationTransport returns the GitHub installation transport for the credentials. Transports are cached
+// process wide, so that the installation token they hold is minted once and shared by all operations until it expires.
+func (g GitHubAppCreds) getInstallationTransport(ctx context.Context) (*ghinstallation.Transport, error) {
+	// When the installation is discovered, it depends on the owner of the repository
+	owner := ""
+	if g.appInstallId == 0 {
+		var err error
+		owner, _, err = parseGitHubRepoURL(g.repoURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Compute hash of creds for lookup in cache
 	h := sha256.New()
-	_, err := h.Write([]byte(fmt.Sprintf("%s %d %d %s", g.privateKey, g.appID, g.appInstallId, g.baseURL)))
+	_, err := h.Write([]byte(fmt.Sprintf("%s %d %d %s %s", g.privateKey, g.appID, g.appInstallId, g.baseURL, owner)))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	key := fmt.Sprintf("%x", h.Sum(nil))
 
 	// Check cache for GitHub transport which helps fetch an API token
-	t, found := githubAppTokenCache.Get(key)
-	if found {
-		itr := t.(*ghinstallation.Transport)
-		// This method caches the token and if it's expired retrieves a new one
-		return itr.Token(ctx)
+	if t, found := githubAppTokenCache.Get(key); found {
+		return t.(*ghinstallation.Transport), nil
 	}
 
-	// GitHub API url
-	baseUrl := "https://api.github.com"
-	if g.baseURL != "" {
-		baseUrl = strings.TrimSuffix(g.baseURL, "/")
+	// Prevent concurrent operations from minting a token each for the same credentials
+	githubAppTransportLock.Lock(key)
+	defer githubAppTransportLock.Unlock(key)
+	if t, found := githubAppTokenCache.Get(key); found {
+		return t.(*ghinstallation.Transport), nil
 	}
 
+	baseUrl := g.apiBaseURL()
+
 	// Create a new GitHub transport
 	c := GetRepoHTTPClient(baseUrl, g.insecure, g, g.proxy)
+	appInstallId := g.appInstallId
+	if appInstallId == 0 {
+		appInstallId, err = g.discoverInstallationID(ctx, c.Transport, baseUrl)
+		if err != nil {
+			return nil, err
+		}
+	}
 	itr, err := ghinstallation.New(c.Transport,
 		g.appID,
-		g.appInstallId,
+		appInstallId,
 		[]byte(g.privateKey),
 	)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	itr.BaseURL = baseUrl
 
 	// Add transport to cache
-	githubAppTokenCache.Set(key, itr, time.Minute*60)
+	githubAppTokenCache.SetDefault(key, itr)
 
-	return itr.Token(ctx)
+	return itr, nil
+}
+
+// discoverInstallationID looks up the installation of the GitHub App on the repository, or on the organization or
+// user when the URL does not point to a single repository.
+func (g GitHubAppCreds) discoverInstallationID(ctx context.Context, transport http.RoundTripper, baseUrl string) (int64, error) {
+	owner, repo, err := parseGitHubRepoURL(g.repoURL)
+	if err != nil {
+		return 0, err
+	}
+	atr, err := ghinstallation.NewAppsTransport(transport, g.appID, []byte(g.privateKey))
+	if err != nil {
+		return 0, err
+	}
+	atr.BaseURL = baseUrl
+	client, err := newGitHubClient(baseUrl, atr)
+	if err != nil {
+		return 0, err
+	}
+
+	var installation *github.Installation
+	if repo != "" {
+		installation, _, err = client.Apps.FindRepositoryInstallation(ctx, owner, repo)
+	} else {
+		installation, _, err = client.Apps.FindOrganizationInstallation(ctx, owner)
+		if err != nil {
+			installation, _, err = client.Apps.FindUserInstallation(ctx, owner)
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to discover installation of GitHub App %d for %s: %w", g.appID, g.repoURL, err)
+	}
+	return installation.GetID(), nil
+}
+
+// GitHubClient returns a client of the GitHub API authenticated as the GitHub App installation
+func (g GitHubAppCreds) GitHubClient(ctx context.Context) (*github.Client, error) {
+	itr, err := g.getInstallationTransport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newGitHubClient(g.apiBaseURL(), itr)
+}
+
+// ListInstallationRepositories returns the repositories accessible to the GitHub App installation
+func (g GitHubAppCreds) ListInstallationRepositories(ctx context.Context) ([]*github.Repository, error) {
+	client, err := g.GitHubClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []*github.Repository
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		list, resp, err := client.Apps.ListRepos(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories of GitHub App installation: %w", err)
+		}
+		repos = append(repos, list.Repositories...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return repos, nil
+}
+
+// apiBaseURL returns the GitHub API url
+func (g GitHubAppCreds) apiBaseURL() string {
+	if g.baseURL != "" {
+		return strings.TrimSuffix(g.baseURL, "/")
+	}
+	return "https://api.github.com"
+}
+
+func newGitHubClient(baseUrl string, transport http.RoundTripper) (*github.Client, error) {
+	httpClient := &http.Client{Transport: transport}
+	if baseUrl == "https://api.github.com" {
+		return github.NewClient(httpClient), nil
+	}
+	return github.NewEnterpriseClient(baseUrl, baseUrl, httpClient)
+}
+
+// parseGitHubRepoURL returns the owner and, if present, the repository name of a GitHub HTTPS URL
+func parseGitHubRepoURL(repoURL string) (string, string, error) {
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse repository URL %q: %w", repoURL, err)
+	}
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if parts[0] == "" {
+		return "", "", fmt.Errorf("repository URL %q has no owner, cannot discover GitHub App installation", repoURL)
+	}
+	if len(parts) < 2 {
+		return parts[0], "", nil
+	}
+	return parts[0], strings.TrimSuffix(parts[1], ".git"), nil
 }
 
 func (g GitHubAppCreds) HasClientCert() bool {
@@ -453,6 +637,14 @@ func (g GitHubAppCreds) GetClientCertKey() string {
 	return g.clientCertKey
 }
 
+func (g GitHubAppCreds) GetCAData() string {
+	return g.caData
+}
+
+func (g GitHubAppCreds) GetServerName() string {
+	return g.serverName
+}
+
 // GoogleCloudCreds to authenticate to Google Cloud Source repositories
 type GoogleCloudCreds struct {
 	creds *google.Credentials
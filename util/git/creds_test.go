@@ -1,13 +1,23 @@
 package git
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"regexp"
 	"strings"
+	gosync "sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -43,7 +53,7 @@ func (s *memoryCredsStore) Remove(id string) {
 
 func TestHTTPSCreds_Environ_no_cert_cleanup(t *testing.T) {
 	store := &memoryCredsStore{creds: make(map[string]cred)}
-	creds := NewHTTPSCreds("", "", "", "", true, "", store, false)
+	creds := NewHTTPSCreds("", "", "", "", "", "", true, "", store, false)
 	closer, env, err := creds.Environ()
 	require.NoError(t, err)
 	var nonce string
@@ -59,7 +69,7 @@ func TestHTTPSCreds_Environ_no_cert_cleanup(t *testing.T) {
 }
 
 func TestHTTPSCreds_Environ_insecure_true(t *testing.T) {
-	creds := NewHTTPSCreds("", "", "", "", true, "", &NoopCredsStore{}, false)
+	creds := NewHTTPSCreds("", "", "", "", "", "", true, "", &NoopCredsStore{}, false)
 	closer, env, err := creds.Environ()
 	t.Cleanup(func() {
 		io.Close(closer)
@@ -76,7 +86,7 @@ func TestHTTPSCreds_Environ_insecure_true(t *testing.T) {
 }
 
 func TestHTTPSCreds_Environ_insecure_false(t *testing.T) {
-	creds := NewHTTPSCreds("", "", "", "", false, "", &NoopCredsStore{}, false)
+	creds := NewHTTPSCreds("", "", "", "", "", "", false, "", &NoopCredsStore{}, false)
 	closer, env, err := creds.Environ()
 	t.Cleanup(func() {
 		io.Close(closer)
@@ -95,7 +105,7 @@ func TestHTTPSCreds_Environ_insecure_false(t *testing.T) {
 func TestHTTPSCreds_Environ_forceBasicAuth(t *testing.T) {
 	t.Run("Enabled and credentials set", func(t *testing.T) {
 		store := &memoryCredsStore{creds: make(map[string]cred)}
-		creds := NewHTTPSCreds("username", "password", "", "", false, "", store, true)
+		creds := NewHTTPSCreds("username", "password", "", "", "", "", false, "", store, true)
 		closer, env, err := creds.Environ()
 		require.NoError(t, err)
 		defer closer.Close()
@@ -113,7 +123,7 @@ func TestHTTPSCreds_Environ_forceBasicAuth(t *testing.T) {
 	})
 	t.Run("Enabled but credentials not set", func(t *testing.T) {
 		store := &memoryCredsStore{creds: make(map[string]cred)}
-		creds := NewHTTPSCreds("", "", "", "", false, "", store, true)
+		creds := NewHTTPSCreds("", "", "", "", "", "", false, "", store, true)
 		closer, env, err := creds.Environ()
 		require.NoError(t, err)
 		defer closer.Close()
@@ -130,7 +140,7 @@ func TestHTTPSCreds_Environ_forceBasicAuth(t *testing.T) {
 	})
 	t.Run("Disabled with credentials set", func(t *testing.T) {
 		store := &memoryCredsStore{creds: make(map[string]cred)}
-		creds := NewHTTPSCreds("username", "password", "", "", false, "", store, false)
+		creds := NewHTTPSCreds("username", "password", "", "", "", "", false, "", store, false)
 		closer, env, err := creds.Environ()
 		require.NoError(t, err)
 		defer closer.Close()
@@ -148,7 +158,7 @@ func TestHTTPSCreds_Environ_forceBasicAuth(t *testing.T) {
 
 	t.Run("Disabled with credentials not set", func(t *testing.T) {
 		store := &memoryCredsStore{creds: make(map[string]cred)}
-		creds := NewHTTPSCreds("", "", "", "", false, "", store, false)
+		creds := NewHTTPSCreds("", "", "", "", "", "", false, "", store, false)
 		closer, env, err := creds.Environ()
 		require.NoError(t, err)
 		defer closer.Close()
@@ -167,7 +177,7 @@ func TestHTTPSCreds_Environ_forceBasicAuth(t *testing.T) {
 
 func TestHTTPSCreds_Environ_clientCert(t *testing.T) {
 	store := &memoryCredsStore{creds: make(map[string]cred)}
-	creds := NewHTTPSCreds("", "", "clientCertData", "clientCertKey", false, "", store, false)
+	creds := NewHTTPSCreds("", "", "clientCertData", "clientCertKey", "", "", false, "", store, false)
 	closer, env, err := creds.Environ()
 	require.NoError(t, err)
 	var cert, key string
@@ -199,6 +209,29 @@ func TestHTTPSCreds_Environ_clientCert(t *testing.T) {
 	assert.ErrorIs(t, err, os.ErrNotExist)
 }
 
+func TestHTTPSCreds_Environ_caData(t *testing.T) {
+	store := &memoryCredsStore{creds: make(map[string]cred)}
+	creds := NewHTTPSCreds("", "", "", "", "caData", "", false, "", store, false)
+	closer, env, err := creds.Environ()
+	require.NoError(t, err)
+	var ca string
+	for _, envVar := range env {
+		if strings.HasPrefix(envVar, "GIT_SSL_CAINFO=") {
+			ca = envVar[15:]
+		}
+	}
+	assert.NotEmpty(t, ca)
+
+	caBytes, err := os.ReadFile(ca)
+	assert.NoError(t, err)
+	assert.Equal(t, "caData", string(caBytes))
+
+	io.Close(closer)
+
+	_, err = os.Stat(ca)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
 func Test_SSHCreds_Environ(t *testing.T) {
 	for _, insecureIgnoreHostKey := range []bool{false, true} {
 		tempDir := t.TempDir()
@@ -287,3 +320,79 @@ func TestGoogleCloudCreds_Environ(t *testing.T) {
 
 	assert.Equal(t, []string{"GIT_ASKPASS=git-ask-pass.sh", "GIT_USERNAME=argocd-service-account@my-google-project.iam.gserviceaccount.com", "GIT_PASSWORD=token"}, env)
 }
+
+func Test_parseGitHubRepoURL(t *testing.T) {
+	owner, repo, err := parseGitHubRepoURL("https://github.com/argoproj/argo-cd.git")
+	require.NoError(t, err)
+	assert.Equal(t, "argoproj", owner)
+	assert.Equal(t, "argo-cd", repo)
+
+	owner, repo, err = parseGitHubRepoURL("https://github.com/argoproj/")
+	require.NoError(t, err)
+	assert.Equal(t, "argoproj", owner)
+	assert.Equal(t, "", repo)
+
+	_, _, err = parseGitHubRepoURL("https://github.com/")
+	assert.Error(t, err)
+}
+
+// newFakeGitHubAPI returns a server faking the GitHub API, to be used as proxy of the GitHub App credentials
+func newFakeGitHubAPI(t *testing.T) (*httptest.Server, *int32) {
+	var minted int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/argoproj/argo-cd/installation", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 42}`))
+	})
+	mux.HandleFunc("/api/v3/app/installations/42/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&minted, 1)
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"token": "token", "expires_at": %q}`, time.Now().Add(time.Hour).Format(time.RFC3339))))
+	})
+	mux.HandleFunc("/api/v3/installation/repositories", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"total_count": 2, "repositories": [{"full_name": "argoproj/argo-workflows"}]}`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/api/v3/installation/repositories?page=2>; rel="next"`, "http://"+r.Host))
+		_, _ = w.Write([]byte(`{"total_count": 2, "repositories": [{"full_name": "argoproj/argo-cd"}]}`))
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts, &minted
+}
+
+func newGitHubAppPrivateKey(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func TestGitHubAppCreds_getAccessToken_cached(t *testing.T) {
+	ts, minted := newFakeGitHubAPI(t)
+	privateKey := newGitHubAppPrivateKey(t)
+
+	creds := NewGitHubAppCreds(1, 0, privateKey, "http://github.example.com/api/v3", "https://github.com/argoproj/argo-cd", "", "", "", "", false, ts.URL, &NoopCredsStore{}).(GitHubAppCreds)
+	var wg gosync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := creds.getAccessToken()
+			assert.NoError(t, err)
+			assert.Equal(t, "token", token)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(minted))
+}
+
+func TestGitHubAppCreds_ListInstallationRepositories(t *testing.T) {
+	ts, _ := newFakeGitHubAPI(t)
+	privateKey := newGitHubAppPrivateKey(t)
+
+	creds := NewGitHubAppCreds(1, 42, privateKey, "http://github.example.com/api/v3", "https://github.com/argoproj/argo-cd", "", "", "", "", false, ts.URL, &NoopCredsStore{}).(GitHubAppCreds)
+	repos, err := creds.ListInstallationRepositories(context.Background())
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "argoproj/argo-cd", repos[0].GetFullName())
+	assert.Equal(t, "argoproj/argo-workflows", repos[1].GetFullName())
+}
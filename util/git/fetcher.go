@@ -0,0 +1,241 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+// fetchedRevisionFile is the file in the root of a fetched source which records the revision that was fetched
+const fetchedRevisionFile = ".argocd-fetched-revision"
+
+// FetchSource describes the repository a Fetcher downloads content from
+type FetchSource struct {
+	// RepoURL is the URL of the repository, including the scheme the fetcher is registered for
+	RepoURL string
+	// Creds are the credentials configured for the repository
+	Creds Creds
+	// Username and Password are the username and password credentials configured for the repository, if any
+	Username string
+	Password string
+	// Insecure indicates whether the certificate of the server should not be verified
+	Insecure bool
+	// Proxy is the HTTP/HTTPS proxy used to access the repository
+	Proxy string
+}
+
+// Fetcher downloads manifests which are stored outside of Git and Helm repositories, e.g. bundles in an object store,
+// so that they can be used as application sources. Fetchers are registered for a URL scheme at build time with
+// RegisterFetcher, typically from the init function of a package which is imported by the Argo CD binary.
+type Fetcher interface {
+	// ResolveRevision resolves a revision into an immutable revision, e.g. a digest or an object version, which
+	// identifies the content of the source. An empty revision or HEAD refer to the latest content.
+	ResolveRevision(source FetchSource, revision string) (string, error)
+	// Fetch downloads the content of a resolved revision into the existing, empty directory dir.
+	Fetch(source FetchSource, revision string, dir string) error
+}
+
+var (
+	fetchersLock sync.RWMutex
+	fetchers     = map[string]Fetcher{}
+)
+
+// RegisterFetcher registers the fetcher of the repositories whose URL has the given scheme, e.g. "s3". It panics if a
+// fetcher is already registered for the scheme.
+func RegisterFetcher(scheme string, fetcher Fetcher) {
+	fetchersLock.Lock()
+	defer fetchersLock.Unlock()
+	scheme = strings.ToLower(scheme)
+	if _, ok := fetchers[scheme]; ok {
+		panic(fmt.Sprintf("fetcher for scheme '%s' is already registered", scheme))
+	}
+	fetchers[scheme] = fetcher
+}
+
+// FetcherSchemes returns the URL schemes for which fetchers are registered
+func FetcherSchemes() []string {
+	fetchersLock.RLock()
+	defer fetchersLock.RUnlock()
+	var schemes []string
+	for scheme := range fetchers {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+	return schemes
+}
+
+// getFetcher returns the fetcher registered for the scheme of the given repository URL, or nil if there is none
+func getFetcher(repoURL string) Fetcher {
+	u, err := url.Parse(strings.TrimSpace(repoURL))
+	if err != nil || u.Scheme == "" {
+		return nil
+	}
+	fetchersLock.RLock()
+	defer fetchersLock.RUnlock()
+	return fetchers[strings.ToLower(u.Scheme)]
+}
+
+// fetcherClient implements the Client interface for repositories whose content is downloaded by a Fetcher. Since
+// such repositories have no history, the content of a revision is fetched into the root on checkout.
+type fetcherClient struct {
+	EventHandlers
+
+	fetcher Fetcher
+	source  FetchSource
+	// Root path of repository
+	root string
+}
+
+func newFetcherClient(fetcher Fetcher, rawRepoURL string, root string, creds Creds, insecure bool, proxy string, opts ...ClientOpts) *fetcherClient {
+	// the options are defined for the native client, so only the applicable ones are picked from it
+	native := &nativeGitClient{}
+	for i := range opts {
+		opts[i](native)
+	}
+	source := FetchSource{RepoURL: rawRepoURL, Creds: creds, Insecure: insecure, Proxy: proxy}
+	if httpsCreds, ok := creds.(HTTPSCreds); ok {
+		source.Username = httpsCreds.username
+		source.Password = httpsCreds.password
+	}
+	return &fetcherClient{
+		EventHandlers: native.EventHandlers,
+		fetcher:       fetcher,
+		source:        source,
+		root:          root,
+	}
+}
+
+func (c *fetcherClient) Root() string {
+	return c.root
+}
+
+// Init creates the root of the repository if it does not exist
+func (c *fetcherClient) Init() error {
+	return os.MkdirAll(c.root, 0755)
+}
+
+// Fetch is a no-op, since the content of a revision is fetched on checkout
+func (c *fetcherClient) Fetch(_ string) error {
+	return nil
+}
+
+// Submodule is a no-op, since fetched sources have no submodules
+func (c *fetcherClient) Submodule() error {
+	return nil
+}
+
+// Checkout replaces the content of the root with the content of the given resolved revision, unless it has been
+// fetched already
+func (c *fetcherClient) Checkout(revision string, _ bool) error {
+	if current, err := c.CommitSHA(); err == nil && current == revision {
+		return nil
+	}
+	if c.OnFetch != nil {
+		done := c.OnFetch(c.source.RepoURL)
+		defer done()
+	}
+	// the content is removed rather than the root itself to preserve its permissions
+	entries, err := os.ReadDir(c.root)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(c.root, entry.Name())); err != nil {
+			return fmt.Errorf("failed to clean %s: %w", c.root, err)
+		}
+	}
+	if err := c.fetcher.Fetch(c.source, revision, c.root); err != nil {
+		return fmt.Errorf("failed to fetch revision %s of %s: %w", revision, c.source.RepoURL, err)
+	}
+	return os.WriteFile(filepath.Join(c.root, fetchedRevisionFile), []byte(revision), 0644)
+}
+
+// LsRefs returns no refs, since fetched sources have no branches or tags
+func (c *fetcherClient) LsRefs() (*Refs, error) {
+	return &Refs{}, nil
+}
+
+// LsRemote resolves the given revision with the fetcher
+func (c *fetcherClient) LsRemote(revision string) (string, error) {
+	if c.OnLsRemote != nil {
+		done := c.OnLsRemote(c.source.RepoURL)
+		defer done()
+	}
+	resolved, err := c.fetcher.ResolveRevision(c.source, revision)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve revision '%s' of %s: %w", revision, c.source.RepoURL, err)
+	}
+	return resolved, nil
+}
+
+// LsFiles lists the fetched files matching the given pattern, which matches across directories like a git pathspec
+func (c *fetcherClient) LsFiles(pattern string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(c.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(c.root, path)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if relPath != fetchedRevisionFile && (relPath == pattern || glob.Match(pattern, relPath)) {
+			files = append(files, relPath)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// LsLargeFiles returns no files, since fetched sources do not use LFS
+func (c *fetcherClient) LsLargeFiles() ([]string, error) {
+	return nil, nil
+}
+
+// ChangedFiles is not supported, since fetched sources have no history
+func (c *fetcherClient) ChangedFiles(revision string, targetRevision string) ([]string, error) {
+	if revision == targetRevision {
+		return []string{}, nil
+	}
+	return nil, errors.New("listing changed files is not supported for fetched sources")
+}
+
+// CommitSHA returns the revision which has been fetched into the root
+func (c *fetcherClient) CommitSHA() (string, error) {
+	data, err := os.ReadFile(filepath.Join(c.root, fetchedRevisionFile))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// RevisionMetadata returns empty metadata, since fetched sources have no commit information
+func (c *fetcherClient) RevisionMetadata(_ string) (*RevisionMetadata, error) {
+	return &RevisionMetadata{}, nil
+}
+
+// VerifyCommitSignature returns no signature, since fetched sources are not signed
+func (c *fetcherClient) VerifyCommitSignature(_ string) (string, error) {
+	return "", nil
+}
+
+// CheckoutOrOrphan is not supported, since fetched sources cannot be written to
+func (c *fetcherClient) CheckoutOrOrphan(_ string) error {
+	return errors.New("writing is not supported for fetched sources")
+}
+
+// CommitAndPush is not supported, since fetched sources cannot be written to
+func (c *fetcherClient) CommitAndPush(_, _, _, _ string) (bool, error) {
+	return false, errors.New("writing is not supported for fetched sources")
+}
@@ -0,0 +1,96 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFetcher struct {
+	revisions map[string]string
+}
+
+func (f *fakeFetcher) ResolveRevision(_ FetchSource, revision string) (string, error) {
+	if revision == "" {
+		revision = "HEAD"
+	}
+	return f.revisions[revision], nil
+}
+
+func (f *fakeFetcher) Fetch(source FetchSource, revision string, dir string) error {
+	if err := os.MkdirAll(filepath.Join(dir, "apps", "guestbook"), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "apps", "guestbook", "config.json"), []byte(source.RepoURL+"@"+revision), 0644)
+}
+
+var testFetcher = &fakeFetcher{revisions: map[string]string{"HEAD": "v2", "v1": "v1"}}
+
+func init() {
+	RegisterFetcher("test-fetch", testFetcher)
+}
+
+func TestRegisterFetcher(t *testing.T) {
+	assert.Contains(t, FetcherSchemes(), "test-fetch")
+	assert.Panics(t, func() {
+		RegisterFetcher("Test-Fetch", &fakeFetcher{})
+	})
+	assert.Equal(t, testFetcher, getFetcher("TEST-FETCH://bucket/path"))
+	assert.Nil(t, getFetcher("https://github.com/argoproj/argocd-example-apps"))
+	assert.Nil(t, getFetcher("git@github.com:argoproj/argocd-example-apps.git"))
+}
+
+func Test_fetcherClient(t *testing.T) {
+	root := t.TempDir()
+	var lsRemoteCalls, fetchCalls int
+	client, err := NewClientExt("test-fetch://bucket/path", root, NewHTTPSCreds("user", "secret", "", "", "", "", false, "", &NoopCredsStore{}, false), false, false, "", WithEventHandlers(EventHandlers{
+		OnLsRemote: func(_ string) func() {
+			lsRemoteCalls++
+			return func() {}
+		},
+		OnFetch: func(_ string) func() {
+			fetchCalls++
+			return func() {}
+		},
+	}))
+	require.NoError(t, err)
+	require.IsType(t, &fetcherClient{}, client)
+	assert.Equal(t, "user", client.(*fetcherClient).source.Username)
+	assert.Equal(t, "secret", client.(*fetcherClient).source.Password)
+	assert.Equal(t, root, client.Root())
+
+	revision, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", revision)
+	assert.Equal(t, 1, lsRemoteCalls)
+
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch(""))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "stale.yaml"), []byte{}, 0644))
+	require.NoError(t, client.Checkout(revision, false))
+	assert.NoFileExists(t, filepath.Join(root, "stale.yaml"))
+	data, err := os.ReadFile(filepath.Join(root, "apps", "guestbook", "config.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-fetch://bucket/path@v2", string(data))
+
+	sha, err := client.CommitSHA()
+	require.NoError(t, err)
+	assert.Equal(t, "v2", sha)
+
+	// the revision has been fetched already
+	require.NoError(t, client.Checkout(revision, false))
+	assert.Equal(t, 1, fetchCalls)
+
+	files, err := client.LsFiles("apps/*/config.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps/guestbook/config.json"}, files)
+	files, err = client.LsFiles("*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps/guestbook/config.json"}, files)
+
+	_, err = client.ChangedFiles("v1", "v2")
+	assert.Error(t, err)
+}
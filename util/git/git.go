@@ -3,6 +3,7 @@ package git
 import (
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -80,6 +81,31 @@ func IsSSHURL(url string) (bool, string) {
 	return false, ""
 }
 
+// SSHHostPort returns the host and the port of the SSH server of the supplied SSH URL, the port being 22 unless the
+// URL sets another one, or false if the URL is not an SSH URL
+func SSHHostPort(repo string) (string, int, bool) {
+	repo = strings.TrimSpace(repo)
+	if yes, _ := IsSSHURL(repo); !yes {
+		return "", 0, false
+	}
+	if !strings.HasPrefix(repo, "ssh://") {
+		// the first colon of git@server... style SSH URLs separates the path rather than the port
+		repo = "ssh://" + strings.Replace(repo, ":", "/", 1)
+	}
+	repoURL, err := url.Parse(repo)
+	if err != nil || repoURL.Hostname() == "" {
+		return "", 0, false
+	}
+	port := 22
+	if repoURL.Port() != "" {
+		port, err = strconv.Atoi(repoURL.Port())
+		if err != nil {
+			return "", 0, false
+		}
+	}
+	return strings.ToLower(repoURL.Hostname()), port, true
+}
+
 // IsHTTPSURL returns true if supplied URL is HTTPS URL
 func IsHTTPSURL(url string) bool {
 	return httpsURLRegex.MatchString(url)
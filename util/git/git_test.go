@@ -102,6 +102,27 @@ func TestIsSSHURLUserName(t *testing.T) {
 
 }
 
+func TestSSHHostPort(t *testing.T) {
+	data := map[string]struct {
+		host string
+		port int
+	}{
+		"git@GitHub.com:argoproj/argo-cd.git":             {"github.com", 22},
+		"ssh://git@github.com/argoproj/argo-cd.git":       {"github.com", 22},
+		"ssh://john@john-server.org:29418/project":        {"john-server.org", 29418},
+		"john@john-server.org:29418/project":              {"john-server.org", 22},
+		"ssh://john@doe.org@john-server.org:2222/project": {"john-server.org", 2222},
+	}
+	for repo, expected := range data {
+		host, port, ok := SSHHostPort(repo)
+		assert.True(t, ok, repo)
+		assert.Equal(t, expected.host, host, repo)
+		assert.Equal(t, expected.port, port, repo)
+	}
+	_, _, ok := SSHHostPort("https://github.com/argoproj/argo-cd.git")
+	assert.False(t, ok)
+}
+
 func TestSameURL(t *testing.T) {
 	data := map[string]string{
 		"git@GITHUB.com:argoproj/test":                     "git@github.com:argoproj/test.git",
@@ -147,7 +168,7 @@ func TestCustomHTTPClient(t *testing.T) {
 	assert.NotEqual(t, "", string(keyData))
 
 	// Get HTTPSCreds with client cert creds specified, and insecure connection
-	creds := NewHTTPSCreds("test", "test", string(certData), string(keyData), false, "http://proxy:5000", &NoopCredsStore{}, false)
+	creds := NewHTTPSCreds("test", "test", string(certData), string(keyData), "", "", false, "http://proxy:5000", &NoopCredsStore{}, false)
 	client := GetRepoHTTPClient("https://localhost:9443/foo/bar", false, creds, "http://proxy:5000")
 	assert.NotNil(t, client)
 	assert.NotNil(t, client.Transport)
@@ -178,7 +199,7 @@ func TestCustomHTTPClient(t *testing.T) {
 	}()
 
 	// Get HTTPSCreds without client cert creds, but insecure connection
-	creds = NewHTTPSCreds("test", "test", "", "", true, "", &NoopCredsStore{}, false)
+	creds = NewHTTPSCreds("test", "test", "", "", "", "", true, "", &NoopCredsStore{}, false)
 	client = GetRepoHTTPClient("https://localhost:9443/foo/bar", true, creds, "")
 	assert.NotNil(t, client)
 	assert.NotNil(t, client.Transport)
@@ -222,6 +243,19 @@ func TestCustomHTTPClient(t *testing.T) {
 		assert.Equal(t, false, transport.TLSClientConfig.InsecureSkipVerify)
 		assert.NotNil(t, transport.TLSClientConfig.RootCAs)
 	}
+
+	// GetRepoHTTPClient with server name and CA data of the repository
+	creds = NewHTTPSCreds("", "", "", "", string(cert), "git.example.com", false, "", &NoopCredsStore{}, false)
+	client = GetRepoHTTPClient("https://10.0.0.10/foo/bar", false, creds, "")
+	assert.NotNil(t, client)
+	if client.Transport != nil {
+		transport := client.Transport.(*http.Transport)
+		assert.Equal(t, "git.example.com", transport.TLSClientConfig.ServerName)
+		assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+	}
+	auth, err := newAuth("https://10.0.0.10/foo/bar", creds)
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
 }
 
 func TestLsRemote(t *testing.T) {
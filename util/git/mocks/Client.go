@@ -1,4 +1,4 @@
-// Code generated by mockery v2.10.0. DO NOT EDIT.
+// Code generated by mockery v2.14.1. DO NOT EDIT.
 
 package mocks
 
@@ -12,6 +12,29 @@ type Client struct {
 	mock.Mock
 }
 
+// ChangedFiles provides a mock function with given fields: revision, targetRevision
+func (_m *Client) ChangedFiles(revision string, targetRevision string) ([]string, error) {
+	ret := _m.Called(revision, targetRevision)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = rf(revision, targetRevision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(revision, targetRevision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Checkout provides a mock function with given fields: revision, submoduleEnabled
 func (_m *Client) Checkout(revision string, submoduleEnabled bool) error {
 	ret := _m.Called(revision, submoduleEnabled)
@@ -26,6 +49,41 @@ func (_m *Client) Checkout(revision string, submoduleEnabled bool) error {
 	return r0
 }
 
+// CheckoutOrOrphan provides a mock function with given fields: branch
+func (_m *Client) CheckoutOrOrphan(branch string) error {
+	ret := _m.Called(branch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommitAndPush provides a mock function with given fields: branch, authorName, authorEmail, message
+func (_m *Client) CommitAndPush(branch string, authorName string, authorEmail string, message string) (bool, error) {
+	ret := _m.Called(branch, authorName, authorEmail, message)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, string, string) bool); ok {
+		r0 = rf(branch, authorName, authorEmail, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(branch, authorName, authorEmail, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CommitSHA provides a mock function with given fields:
 func (_m *Client) CommitSHA() (string, error) {
 	ret := _m.Called()
@@ -236,3 +294,18 @@ func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
 
 	return r0, r1
 }
+
+type mockConstructorTestingTNewClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewClient(t mockConstructorTestingTNewClient) *Client {
+	mock := &Client{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
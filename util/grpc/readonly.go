@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReadOnlyUnaryServerInterceptor returns a UnaryServerInterceptor which rejects the requests that isReadOnly does not
+// accept, so that the server does not mutate any state
+func ReadOnlyUnaryServerInterceptor(isReadOnly func(fullMethod string, req interface{}) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isReadOnly(info.FullMethod, req) {
+			return nil, readOnlyError(info.FullMethod)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ReadOnlyStreamServerInterceptor returns a StreamServerInterceptor which rejects the streams that isReadOnly does not
+// accept, so that the server does not mutate any state. The request is not available to isReadOnly and is nil.
+func ReadOnlyStreamServerInterceptor(isReadOnly func(fullMethod string, req interface{}) bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isReadOnly(info.FullMethod, nil) {
+			return readOnlyError(info.FullMethod)
+		}
+		return handler(srv, stream)
+	}
+}
+
+func readOnlyError(fullMethod string) error {
+	return status.Errorf(codes.PermissionDenied, "%s is not permitted: the API server runs in read-only mode", fullMethod)
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadOnlyUnaryServerInterceptor(t *testing.T) {
+	interceptor := ReadOnlyUnaryServerInterceptor(func(fullMethod string, req interface{}) bool {
+		return fullMethod == "/application.ApplicationService/Get"
+	})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	res, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "/application.ApplicationService/Sync is not permitted: the API server runs in read-only mode")
+}
+
+func TestReadOnlyStreamServerInterceptor(t *testing.T) {
+	interceptor := ReadOnlyStreamServerInterceptor(func(fullMethod string, req interface{}) bool {
+		return fullMethod == "/application.ApplicationService/Watch"
+	})
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+
+	err := interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Watch"}, handler)
+	require.NoError(t, err)
+
+	err = interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/GetManifestsWithFiles"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
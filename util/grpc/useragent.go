@@ -79,6 +79,21 @@ func userAgentEnforcer(ctx context.Context, clientName, constraintStr string, se
 	return nil
 }
 
+// UserAgent returns the user agent of the client of the given request. The user agent of the HTTP clients of the
+// grpc-gateway, such as the browsers, is preferred over the one of the grpc-gateway itself.
+func UserAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md[key]; len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
+
 // isLegacyClient checks if the request was made from a legacy Argo CD client (i.e. v0.10 CLI).
 // The heuristic is that a single default 'grpc-go' user-agent was specified with one of the
 // previous versions of grpc-go we used in the past (1.15.0, 1.10.0).
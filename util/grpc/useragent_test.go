@@ -59,3 +59,19 @@ func Test_UserAgentEnforcer(t *testing.T) {
 		require.Contains(t, err.Error(), "could not parse version")
 	})
 }
+
+func Test_UserAgent(t *testing.T) {
+	t.Run("Test without metadata", func(t *testing.T) {
+		require.Equal(t, "", UserAgent(context.Background()))
+	})
+	t.Run("Test gRPC client", func(t *testing.T) {
+		md := metadata.New(map[string]string{"user-agent": "argocd-client/v2.8.0 grpc-go/1.56.2"})
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		require.Equal(t, "argocd-client/v2.8.0 grpc-go/1.56.2", UserAgent(ctx))
+	})
+	t.Run("Test grpc-gateway client", func(t *testing.T) {
+		md := metadata.New(map[string]string{"user-agent": "argocd-client/v2.8.0 grpc-go/1.56.2", "grpcgateway-user-agent": "curl/8.0.1"})
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		require.Equal(t, "curl/8.0.1", UserAgent(ctx))
+	})
+}
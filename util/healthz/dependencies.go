@@ -0,0 +1,292 @@
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// DependencyCheckInterval is the interval at which the dependencies are checked
+	DependencyCheckInterval = 10 * time.Second
+	// dependencyCheckTimeout is the maximum duration of the check of a dependency
+	dependencyCheckTimeout = 5 * time.Second
+)
+
+// The names of the dependencies of the Argo CD components
+const (
+	DependencyRedis      = "redis"
+	DependencyDex        = "dex"
+	DependencyKubernetes = "kubernetes"
+	DependencyInformers  = "informers"
+	DependencyRepoServer = "repo-server"
+)
+
+// Requirement designates whether a dependency must be ready for a component to be ready
+type Requirement string
+
+const (
+	// RequirementHard dependencies must be ready for the component to be ready
+	RequirementHard Requirement = "hard"
+	// RequirementSoft dependencies are reported, but do not affect the readiness of the component
+	RequirementSoft Requirement = "soft"
+)
+
+// Dependency is a service a component depends on, such as Redis or the Kubernetes API
+type Dependency struct {
+	Name string
+	// Check returns an error if the dependency is not ready, and nil otherwise
+	Check func(ctx context.Context) error
+}
+
+// DependencyState is the last observed state of a dependency
+type DependencyState struct {
+	Name          string      `json:"name"`
+	Requirement   Requirement `json:"requirement"`
+	Ready         bool        `json:"ready"`
+	Message       string      `json:"message,omitempty"`
+	LastCheckTime *time.Time  `json:"lastCheckTime,omitempty"`
+}
+
+// HealthReport is the response of the health check endpoint to the requests of the full health report
+type HealthReport struct {
+	// Healthy is false if the component is not able to serve, and should be restarted
+	Healthy bool `json:"healthy"`
+	// Ready is false if a hard dependency of the component is not ready
+	Ready        bool              `json:"ready"`
+	Message      string            `json:"message,omitempty"`
+	Dependencies []DependencyState `json:"dependencies"`
+}
+
+// KubernetesDependency returns the dependency on the Kubernetes API server
+func KubernetesDependency(client discovery.ServerVersionInterface) Dependency {
+	return Dependency{Name: DependencyKubernetes, Check: func(ctx context.Context) error {
+		if _, err := client.ServerVersion(); err != nil {
+			return fmt.Errorf("error getting the version of the Kubernetes API server: %w", err)
+		}
+		return nil
+	}}
+}
+
+// InformersDependency returns the dependency on the initial sync of the given informers, indexed by the name of the
+// resources they watch
+func InformersDependency(informers map[string]cache.InformerSynced) Dependency {
+	return Dependency{Name: DependencyInformers, Check: func(ctx context.Context) error {
+		var notSynced []string
+		for name, hasSynced := range informers {
+			if !hasSynced() {
+				notSynced = append(notSynced, name)
+			}
+		}
+		if len(notSynced) > 0 {
+			sort.Strings(notSynced)
+			return fmt.Errorf("informers have not synced: %s", strings.Join(notSynced, ", "))
+		}
+		return nil
+	}}
+}
+
+// DependencyChecker periodically checks the dependencies of a component, and reports their states. The methods of a
+// nil DependencyChecker report no dependencies.
+type DependencyChecker struct {
+	dependencies []Dependency
+	lock         sync.RWMutex
+	hard         map[string]bool
+	states       map[string]DependencyState
+	observer     func(state DependencyState)
+}
+
+// NewDependencyChecker returns a checker of the given dependencies, which are all soft requirements
+func NewDependencyChecker(dependencies ...Dependency) *DependencyChecker {
+	return &DependencyChecker{dependencies: dependencies, hard: map[string]bool{}, states: map[string]DependencyState{}}
+}
+
+// SetHardDependencies designates the dependencies which must be ready for the component to be ready
+func (c *DependencyChecker) SetHardDependencies(names []string) error {
+	hard := map[string]bool{}
+	for _, name := range names {
+		if !c.hasDependency(name) {
+			return fmt.Errorf("unknown dependency '%s', must be one of: %s", name, strings.Join(c.Names(), ", "))
+		}
+		hard[name] = true
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.hard = hard
+	return nil
+}
+
+// SetStateObserver sets the function notified of the state of the dependencies after each of their checks
+func (c *DependencyChecker) SetStateObserver(observer func(state DependencyState)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.observer = observer
+}
+
+// Names returns the names of the dependencies
+func (c *DependencyChecker) Names() []string {
+	var names []string
+	if c == nil {
+		return names
+	}
+	for _, dependency := range c.dependencies {
+		names = append(names, dependency.Name)
+	}
+	return names
+}
+
+func (c *DependencyChecker) hasDependency(name string) bool {
+	for _, dependency := range c.dependencies {
+		if dependency.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *DependencyChecker) requirement(name string) Requirement {
+	if c.hard[name] {
+		return RequirementHard
+	}
+	return RequirementSoft
+}
+
+// Check checks all the dependencies at once, logs the changes of their states, and notifies the state observer
+func (c *DependencyChecker) Check(ctx context.Context) {
+	results := make([]error, len(c.dependencies))
+	var wg sync.WaitGroup
+	for i := range c.dependencies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, dependencyCheckTimeout)
+			defer cancel()
+			results[i] = c.dependencies[i].Check(checkCtx)
+		}(i)
+	}
+	wg.Wait()
+
+	now := time.Now()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i, dependency := range c.dependencies {
+		state := DependencyState{Name: dependency.Name, Requirement: c.requirement(dependency.Name), Ready: results[i] == nil, LastCheckTime: &now}
+		if results[i] != nil {
+			state.Message = results[i].Error()
+		}
+		prev, checked := c.states[dependency.Name]
+		switch {
+		case state.Ready && (!checked || !prev.Ready):
+			log.Infof("Dependency %s (%s) is ready", state.Name, state.Requirement)
+		case !state.Ready && (!checked || prev.Ready || prev.Message != state.Message):
+			log.Warnf("Dependency %s (%s) is not ready: %s", state.Name, state.Requirement, state.Message)
+		}
+		c.states[dependency.Name] = state
+		if c.observer != nil {
+			c.observer(state)
+		}
+	}
+}
+
+// Run checks the dependencies at the given interval until the context is done
+func (c *DependencyChecker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		c.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// States returns the last observed states of the dependencies. The dependencies which have not been checked yet are
+// reported as not ready.
+func (c *DependencyChecker) States() []DependencyState {
+	states := []DependencyState{}
+	if c == nil {
+		return states
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, dependency := range c.dependencies {
+		state, ok := c.states[dependency.Name]
+		if !ok {
+			state = DependencyState{Name: dependency.Name, Message: "not checked yet"}
+		}
+		// the requirement may have changed since the last check
+		state.Requirement = c.requirement(dependency.Name)
+		states = append(states, state)
+	}
+	return states
+}
+
+// Ready returns an error naming the hard dependencies which are not ready, and nil otherwise
+func (c *DependencyChecker) Ready() error {
+	var notReady []string
+	for _, state := range c.States() {
+		if state.Requirement == RequirementHard && !state.Ready {
+			notReady = append(notReady, state.Name)
+		}
+	}
+	if len(notReady) > 0 {
+		sort.Strings(notReady)
+		return fmt.Errorf("hard dependencies are not ready: %s", strings.Join(notReady, ", "))
+	}
+	return nil
+}
+
+// ServeDependencyHealthCheck serves the health check endpoint of a component with dependencies. See
+// DependencyHealthCheckHandler.
+func ServeDependencyHealthCheck(mux *http.ServeMux, f func(r *http.Request) error, checker *DependencyChecker) {
+	mux.HandleFunc(HealthPath, DependencyHealthCheckHandler(f, checker))
+}
+
+// DependencyHealthCheckHandler returns the handler of the health check endpoint of a component with dependencies.
+// The component is ready if the provided function returns nil and its hard dependencies are ready. The requests with
+// the full=true query parameter, which are made by the liveness probes, are answered with a HealthReport, and fail
+// only if the provided function returns an error, so that the component is not restarted when a dependency is not
+// ready.
+func DependencyHealthCheckHandler(f func(r *http.Request) error, checker *DependencyChecker) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := f(r)
+		readyErr := checker.Ready()
+		if val, ok := r.URL.Query()["full"]; ok && len(val) > 0 && val[0] == "true" {
+			report := HealthReport{Healthy: err == nil, Ready: err == nil && readyErr == nil, Dependencies: checker.States()}
+			if err != nil {
+				report.Message = err.Error()
+				log.Errorln(err)
+			} else if readyErr != nil {
+				report.Message = readyErr.Error()
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err != nil {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			_ = json.NewEncoder(w).Encode(report)
+			return
+		}
+		switch {
+		case err != nil:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			log.Errorln(err)
+		case readyErr != nil:
+			// the changes of the states of the dependencies are already logged by the checker
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprintln(w, readyErr)
+		default:
+			fmt.Fprintln(w, "ok")
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestDependencyChecker(t *testing.T) {
+	var redisErr error
+	checker := NewDependencyChecker(
+		Dependency{Name: DependencyRedis, Check: func(ctx context.Context) error { return redisErr }},
+		Dependency{Name: DependencyRepoServer, Check: func(ctx context.Context) error { return errors.New("connection refused") }},
+	)
+	assert.EqualError(t, checker.SetHardDependencies([]string{"dex"}), "unknown dependency 'dex', must be one of: redis, repo-server")
+	require.NoError(t, checker.SetHardDependencies([]string{DependencyRedis}))
+	observed := map[string]bool{}
+	checker.SetStateObserver(func(state DependencyState) {
+		observed[state.Name] = state.Ready
+	})
+
+	t.Run("NotCheckedYet", func(t *testing.T) {
+		states := checker.States()
+		require.Len(t, states, 2)
+		assert.Equal(t, DependencyState{Name: DependencyRedis, Requirement: RequirementHard, Message: "not checked yet"}, states[0])
+		assert.EqualError(t, checker.Ready(), "hard dependencies are not ready: redis")
+	})
+
+	t.Run("HardDependencyReady", func(t *testing.T) {
+		checker.Check(context.Background())
+		states := checker.States()
+		assert.True(t, states[0].Ready)
+		assert.NotNil(t, states[0].LastCheckTime)
+		assert.False(t, states[1].Ready)
+		assert.Equal(t, RequirementSoft, states[1].Requirement)
+		assert.Equal(t, "connection refused", states[1].Message)
+		assert.NoError(t, checker.Ready())
+		assert.Equal(t, map[string]bool{DependencyRedis: true, DependencyRepoServer: false}, observed)
+	})
+
+	t.Run("HardDependencyNotReady", func(t *testing.T) {
+		redisErr = errors.New("i/o timeout")
+		checker.Check(context.Background())
+		assert.EqualError(t, checker.Ready(), "hard dependencies are not ready: redis")
+		assert.False(t, observed[DependencyRedis])
+	})
+}
+
+func TestInformersDependency(t *testing.T) {
+	synced := false
+	dependency := InformersDependency(map[string]cache.InformerSynced{
+		"applications": func() bool { return synced },
+		"appprojects":  func() bool { return true },
+	})
+	assert.Equal(t, DependencyInformers, dependency.Name)
+	assert.EqualError(t, dependency.Check(context.Background()), "informers have not synced: applications")
+	synced = true
+	assert.NoError(t, dependency.Check(context.Background()))
+}
+
+func TestDependencyHealthCheckHandler(t *testing.T) {
+	checker := NewDependencyChecker(Dependency{Name: DependencyRedis, Check: func(ctx context.Context) error { return errors.New("i/o timeout") }})
+	checker.Check(context.Background())
+	var healthErr error
+	handler := DependencyHealthCheckHandler(func(r *http.Request) error { return healthErr }, checker)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	t.Run("SoftDependencyNotReady", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve("/healthz").Code)
+	})
+
+	require.NoError(t, checker.SetHardDependencies([]string{DependencyRedis}))
+
+	t.Run("HardDependencyNotReady", func(t *testing.T) {
+		assert.Equal(t, http.StatusServiceUnavailable, serve("/healthz").Code)
+
+		// the liveness is not affected by the dependencies
+		w := serve("/healthz?full=true")
+		assert.Equal(t, http.StatusOK, w.Code)
+		var report HealthReport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.True(t, report.Healthy)
+		assert.False(t, report.Ready)
+		assert.Equal(t, "hard dependencies are not ready: redis", report.Message)
+		require.Len(t, report.Dependencies, 1)
+		assert.Equal(t, DependencyRedis, report.Dependencies[0].Name)
+		assert.Equal(t, RequirementHard, report.Dependencies[0].Requirement)
+		assert.Equal(t, "i/o timeout", report.Dependencies[0].Message)
+	})
+
+	t.Run("Unhealthy", func(t *testing.T) {
+		healthErr = errors.New("corrupted informer")
+		w := serve("/healthz?full=true")
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		var report HealthReport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.False(t, report.Healthy)
+		assert.Equal(t, "corrupted informer", report.Message)
+	})
+
+	t.Run("NoDependencies", func(t *testing.T) {
+		healthErr = nil
+		w := httptest.NewRecorder()
+		DependencyHealthCheckHandler(func(r *http.Request) error { return nil }, nil)(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "ok\n", w.Body.String())
+	})
+}
@@ -7,10 +7,13 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// HealthPath is the path of the health check endpoint
+const HealthPath = "/healthz"
+
 // ServeHealthCheck serves the health check endpoint.
 // ServeHealthCheck relies on the provided function to return an error if unhealthy and nil otherwise.
 func ServeHealthCheck(mux *http.ServeMux, f func(r *http.Request) error) {
-	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc(HealthPath, func(w http.ResponseWriter, r *http.Request) {
 		if err := f(r); err != nil {
 			w.WriteHeader(http.StatusServiceUnavailable)
 			log.Errorln(w, err)
@@ -36,11 +36,15 @@ var (
 )
 
 type Creds struct {
-	Username           string
-	Password           string
-	CAPath             string
-	CertData           []byte
-	KeyData            []byte
+	Username string
+	Password string
+	CAPath   string
+	// CAData holds CA certificates in PEM format trusted in addition to the ones of CAPath
+	CAData   []byte
+	CertData []byte
+	KeyData  []byte
+	// ServerName is sent with SNI and used to verify the server certificate instead of the host of the URL
+	ServerName         string
 	InsecureSkipVerify bool
 }
 
@@ -53,6 +57,7 @@ type Client interface {
 	CleanChartCache(chart string, version string) error
 	ExtractChart(chart string, version string, passCredentials bool) (string, argoio.Closer, error)
 	GetIndex(noCache bool) (*Index, error)
+	GetChartVersions(chart string, noCache bool) (Entries, error)
 	GetTags(chart string, noCache bool) (*TagsList, error)
 	TestHelmOCI() (bool, error)
 }
@@ -71,6 +76,16 @@ func WithChartPaths(chartPaths argoio.TempPaths) ClientOpts {
 	}
 }
 
+// WithIndexProvider lists the versions of charts with the API of the given index provider rather than with the index of
+// the repository. The provider is detected from the repository URL if empty.
+func WithIndexProvider(indexProvider string) ClientOpts {
+	return func(c *nativeHelmChart) {
+		if indexProvider != "" {
+			c.indexProvider = indexProvider
+		}
+	}
+}
+
 func NewClient(repoURL string, creds Creds, enableOci bool, proxy string, opts ...ClientOpts) Client {
 	return NewClientWithLock(repoURL, creds, globalLock, enableOci, proxy, opts...)
 }
@@ -83,6 +98,7 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, enabl
 		enableOci:       enableOci,
 		proxy:           proxy,
 		chartCachePaths: argoio.NewRandomizedTempPaths(os.TempDir()),
+		indexProvider:   DetectIndexProvider(repoURL),
 	}
 	for i := range opts {
 		opts[i](c)
@@ -100,6 +116,7 @@ type nativeHelmChart struct {
 	enableOci       bool
 	indexCache      indexCache
 	proxy           string
+	indexProvider   string
 }
 
 func fileExist(filePath string) (bool, error) {
@@ -249,6 +266,74 @@ func (c *nativeHelmChart) GetIndex(noCache bool) (*Index, error) {
 	return index, nil
 }
 
+// GetChartVersions returns the versions of a chart. If the repository has an index provider, the versions are listed
+// with its API, which avoids downloading the index of very large repositories, and are cached per chart. The index is
+// used otherwise, or if the API of the provider fails.
+func (c *nativeHelmChart) GetChartVersions(chart string, noCache bool) (Entries, error) {
+	if c.indexProvider != "" {
+		entries, err := c.getChartVersionsFromProvider(chart, noCache)
+		if err == nil {
+			return entries, nil
+		}
+		if err == errChartNotFound {
+			return nil, fmt.Errorf("chart '%s' not found in repository", chart)
+		}
+		log.Warnf("Failed to list versions of chart %s with the %s API of repo %s, falling back to the index: %v", chart, c.indexProvider, c.repoURL, err)
+	}
+	index, err := c.GetIndex(noCache)
+	if err != nil {
+		return nil, err
+	}
+	return index.GetEntries(chart)
+}
+
+func (c *nativeHelmChart) getChartVersionsFromProvider(chart string, noCache bool) (Entries, error) {
+	versionsURL, err := getChartVersionsURL(c.indexProvider, c.repoURL, chart)
+	if err != nil {
+		return nil, err
+	}
+	indexLock.Lock(versionsURL)
+	defer indexLock.Unlock(versionsURL)
+
+	var data []byte
+	if !noCache && c.indexCache != nil {
+		if err := c.indexCache.GetHelmIndex(versionsURL, &data); err != nil && err != cache.ErrCacheMiss {
+			log.Warnf("Failed to load chart versions cache for repo: %s: %v", versionsURL, err)
+		}
+	}
+
+	var entries Entries
+	if len(data) == 0 {
+		start := time.Now()
+		entries, err = c.loadChartVersions(versionsURL)
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) == 0 {
+			return nil, errChartNotFound
+		}
+		log.WithFields(
+			log.Fields{"seconds": time.Since(start).Seconds(), "chart": chart, "repo": c.repoURL},
+		).Info("took to get chart versions")
+
+		if c.indexCache != nil {
+			data, err := json.Marshal(entries)
+			if err != nil {
+				return nil, err
+			}
+			if err := c.indexCache.SetHelmIndex(versionsURL, data); err != nil {
+				log.Warnf("Failed to store chart versions cache for repo: %s: %v", versionsURL, err)
+			}
+		}
+		return entries, nil
+	}
+
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode chart versions: %v", err)
+	}
+	return entries, nil
+}
+
 func (c *nativeHelmChart) TestHelmOCI() (bool, error) {
 	start := time.Now()
 
@@ -295,16 +380,10 @@ func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
 		req.SetBasicAuth(c.creds.Username, c.creds.Password)
 	}
 
-	tlsConf, err := newTLSConfig(c.creds)
+	client, err := c.newHTTPClient()
 	if err != nil {
 		return nil, err
 	}
-
-	tr := &http.Transport{
-		Proxy:           proxy.GetCallback(c.proxy),
-		TLSClientConfig: tlsConf,
-	}
-	client := http.Client{Transport: tr}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -317,16 +396,31 @@ func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
 	return io.ReadAll(resp.Body)
 }
 
+func (c *nativeHelmChart) newHTTPClient() (*http.Client, error) {
+	tlsConf, err := newTLSConfig(c.creds)
+	if err != nil {
+		return nil, err
+	}
+	tr := &http.Transport{
+		Proxy:           proxy.GetCallback(c.proxy),
+		TLSClientConfig: tlsConf,
+	}
+	return &http.Client{Transport: tr}, nil
+}
+
 func newTLSConfig(creds Creds) (*tls.Config, error) {
-	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify, ServerName: creds.ServerName}
 
-	if creds.CAPath != "" {
-		caData, err := os.ReadFile(creds.CAPath)
-		if err != nil {
-			return nil, err
-		}
+	if creds.CAPath != "" || len(creds.CAData) > 0 {
 		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caData)
+		if creds.CAPath != "" {
+			caData, err := os.ReadFile(creds.CAPath)
+			if err != nil {
+				return nil, err
+			}
+			caCertPool.AppendCertsFromPEM(caData)
+		}
+		caCertPool.AppendCertsFromPEM(creds.CAData)
 		tlsConfig.RootCAs = caCertPool
 	}
 
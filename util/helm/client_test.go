@@ -232,3 +232,18 @@ func Test_getTagsListURL(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, tagsListURL, "https://account.dkr.ecr.eu-central-1.amazonaws.com/v2/charts%25%2Fdss/tags/list")
 }
+
+func Test_newTLSConfig(t *testing.T) {
+	caData, err := os.ReadFile("../../test/fixture/certs/argocd-test-ca.crt")
+	require.NoError(t, err)
+
+	tlsConfig, err := newTLSConfig(Creds{})
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig.RootCAs)
+	assert.Empty(t, tlsConfig.ServerName)
+
+	tlsConfig, err = newTLSConfig(Creds{CAData: caData, ServerName: "helm.example.com"})
+	require.NoError(t, err)
+	assert.NotNil(t, tlsConfig.RootCAs)
+	assert.Equal(t, "helm.example.com", tlsConfig.ServerName)
+}
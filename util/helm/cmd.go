@@ -37,6 +37,13 @@ func NewCmd(workDir string, version string, proxy string) (*Cmd, error) {
 	return nil, fmt.Errorf("helm chart version '%s' is not supported", version)
 }
 
+// NewCmdWithBinaryPath creates a Helm v3 command running the binary at the given path
+func NewCmdWithBinaryPath(workDir string, binaryPath string, proxy string) (*Cmd, error) {
+	version := HelmV3
+	version.binaryName = binaryPath
+	return NewCmdWithVersion(workDir, version, false, proxy)
+}
+
 func NewCmdWithVersion(workDir string, version HelmVer, isHelmOci bool, proxy string) (*Cmd, error) {
 	tmpDir, err := os.MkdirTemp("", "helm")
 	if err != nil {
@@ -119,8 +126,13 @@ func (c *Cmd) RepoAdd(name string, url string, opts Creds, passCredentials bool)
 		args = append(args, "--password", opts.Password)
 	}
 
-	if opts.CAPath != "" {
-		args = append(args, "--ca-file", opts.CAPath)
+	caPath, caCloser, err := writeCAFile(opts)
+	if err != nil {
+		return "", err
+	}
+	defer argoio.Close(caCloser)
+	if caPath != "" {
+		args = append(args, "--ca-file", caPath)
 	}
 
 	if opts.InsecureSkipVerify && c.insecureSkipVerifySupported {
@@ -185,6 +197,23 @@ func writeToTmp(data []byte) (string, argoio.Closer, error) {
 	}), nil
 }
 
+// writeCAFile returns the path of the file holding the CA certificates of the given credentials, which is a temporary
+// file concatenating the certificates of CAPath and CAData if the credentials have CA data
+func writeCAFile(creds Creds) (string, argoio.Closer, error) {
+	if len(creds.CAData) == 0 {
+		return creds.CAPath, argoio.NopCloser, nil
+	}
+	var data []byte
+	if creds.CAPath != "" {
+		caPathData, err := os.ReadFile(creds.CAPath)
+		if err != nil {
+			return "", nil, err
+		}
+		data = append(caPathData, '\n')
+	}
+	return writeToTmp(append(data, creds.CAData...))
+}
+
 func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, passCredentials bool) (string, error) {
 	args := []string{c.pullCommand, "--destination", destination}
 	if version != "" {
@@ -202,8 +231,13 @@ func (c *Cmd) Fetch(repo, chartName, version, destination string, creds Creds, p
 
 	args = append(args, "--repo", repo, chartName)
 
-	if creds.CAPath != "" {
-		args = append(args, "--ca-file", creds.CAPath)
+	caPath, caCloser, err := writeCAFile(creds)
+	if err != nil {
+		return "", err
+	}
+	defer argoio.Close(caCloser)
+	if caPath != "" {
+		args = append(args, "--ca-file", caPath)
 	}
 	if len(creds.CertData) > 0 {
 		filePath, closer, err := writeToTmp(creds.CertData)
@@ -2,9 +2,11 @@ package helm
 
 import (
 	"log"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_cmd_redactor(t *testing.T) {
@@ -35,6 +37,13 @@ func TestNewCmd_helmDefaultVersion(t *testing.T) {
 	assert.Equal(t, "helm", cmd.HelmVer.binaryName)
 }
 
+func TestNewCmdWithBinaryPath(t *testing.T) {
+	cmd, err := NewCmdWithBinaryPath(".", "/custom-tools/helm-v3.10.3", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "/custom-tools/helm-v3.10.3", cmd.HelmVer.binaryName)
+	assert.True(t, cmd.HelmVer.includeCrds)
+}
+
 func TestNewCmd_helmInvalidVersion(t *testing.T) {
 	_, err := NewCmd(".", "abcd", "")
 	log.Println(err)
@@ -46,3 +55,22 @@ func TestNewCmd_withProxy(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "https://proxy:8888", cmd.proxy)
 }
+
+func Test_writeCAFile(t *testing.T) {
+	caPath := "../../test/fixture/certs/argocd-test-ca.crt"
+	caData, err := os.ReadFile(caPath)
+	require.NoError(t, err)
+
+	path, closer, err := writeCAFile(Creds{CAPath: caPath})
+	require.NoError(t, err)
+	assert.Equal(t, caPath, path)
+	assert.NoError(t, closer.Close())
+
+	path, closer, err = writeCAFile(Creds{CAPath: caPath, CAData: []byte("data")})
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(caData)+"\ndata", string(data))
+	assert.NoError(t, closer.Close())
+	assert.NoFileExists(t, path)
+}
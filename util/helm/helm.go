@@ -37,9 +37,16 @@ type Helm interface {
 	Dispose()
 }
 
-// NewHelmApp create a new wrapper to run commands on the `helm` command-line tool.
-func NewHelmApp(workDir string, repos []HelmRepository, isLocal bool, version string, proxy string, passCredentials bool) (Helm, error) {
-	cmd, err := NewCmd(workDir, version, proxy)
+// NewHelmApp create a new wrapper to run commands on the `helm` command-line tool. The binary at the given path is run
+// rather than the one of the given version if the path is not empty.
+func NewHelmApp(workDir string, repos []HelmRepository, isLocal bool, version string, binaryPath string, proxy string, passCredentials bool) (Helm, error) {
+	var cmd *Cmd
+	var err error
+	if binaryPath != "" {
+		cmd, err = NewCmdWithBinaryPath(workDir, binaryPath, proxy)
+	} else {
+		cmd, err = NewCmd(workDir, version, proxy)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,182 @@
+package helm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// IndexProviderChartMuseum lists chart versions with the API of ChartMuseum
+	IndexProviderChartMuseum = "chartmuseum"
+	// IndexProviderHarbor lists chart versions with the chart repository API of Harbor
+	IndexProviderHarbor = "harbor"
+	// IndexProviderArtifactory lists chart versions with the property search API of JFrog Artifactory
+	IndexProviderArtifactory = "artifactory"
+	// IndexProviderNexus lists chart versions with the search API of Sonatype Nexus
+	IndexProviderNexus = "nexus"
+)
+
+// IndexProviders are the supported index providers
+var IndexProviders = []string{IndexProviderChartMuseum, IndexProviderHarbor, IndexProviderArtifactory, IndexProviderNexus}
+
+// DetectIndexProvider returns the index provider of a Helm repository which can be recognized from its URL, i.e.
+// Artifactory (https://<host>/artifactory/api/helm/<repo>) and Harbor (https://<host>/chartrepo/<project>) repositories,
+// or an empty string otherwise.
+func DetectIndexProvider(repoURL string) string {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return ""
+	}
+	switch {
+	case strings.Contains(u.Path, "/api/helm/"):
+		return IndexProviderArtifactory
+	case strings.HasPrefix(u.Path, "/chartrepo/"):
+		return IndexProviderHarbor
+	default:
+		return ""
+	}
+}
+
+// getChartVersionsURL returns the URL of the API of the provider listing the versions of a chart
+func getChartVersionsURL(provider string, repoURL string, chart string) (string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", err
+	}
+	repoPath := strings.TrimSuffix(u.Path, "/")
+	u.RawQuery = ""
+	switch provider {
+	case IndexProviderChartMuseum:
+		// Multitenant ChartMuseum serves the repository /<org>/<repo> at /api/<org>/<repo>
+		u.Path = fmt.Sprintf("/api%s/charts/%s", repoPath, url.PathEscape(chart))
+	case IndexProviderHarbor:
+		u.Path = fmt.Sprintf("/api%s/charts/%s", repoPath, url.PathEscape(chart))
+	case IndexProviderArtifactory:
+		i := strings.Index(repoPath, "/api/helm/")
+		if i < 0 {
+			return "", fmt.Errorf("repository URL %s is not an Artifactory Helm repository URL", repoURL)
+		}
+		u.Path = repoPath[:i] + "/api/search/prop"
+		u.RawQuery = url.Values{"chart.name": {chart}, "repos": {repoPath[i+len("/api/helm/"):]}}.Encode()
+	case IndexProviderNexus:
+		i := strings.Index(repoPath, "/repository/")
+		if i < 0 {
+			return "", fmt.Errorf("repository URL %s is not a Nexus repository URL", repoURL)
+		}
+		u.Path = repoPath[:i] + "/service/rest/v1/search"
+		u.RawQuery = url.Values{"format": {"helm"}, "name": {chart}, "repository": {repoPath[i+len("/repository/"):]}}.Encode()
+	default:
+		return "", fmt.Errorf("unknown Helm index provider '%s'", provider)
+	}
+	return u.String(), nil
+}
+
+type chartMuseumChartVersion struct {
+	Version string    `json:"version"`
+	Created time.Time `json:"created"`
+}
+
+type artifactorySearchResult struct {
+	Results []struct {
+		Created    time.Time           `json:"created"`
+		Properties map[string][]string `json:"properties"`
+	} `json:"results"`
+}
+
+type nexusSearchResult struct {
+	Items []struct {
+		Version string `json:"version"`
+	} `json:"items"`
+	ContinuationToken string `json:"continuationToken"`
+}
+
+// loadChartVersions lists the versions of a chart with the API of the index provider of the repository
+func (c *nativeHelmChart) loadChartVersions(versionsURL string) (Entries, error) {
+	client, err := c.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	entries := Entries{}
+	switch c.indexProvider {
+	case IndexProviderChartMuseum, IndexProviderHarbor:
+		var versions []chartMuseumChartVersion
+		if err := c.getJSON(client, versionsURL, nil, &versions); err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			entries = append(entries, Entry{Version: v.Version, Created: v.Created})
+		}
+	case IndexProviderArtifactory:
+		var res artifactorySearchResult
+		if err := c.getJSON(client, versionsURL, map[string]string{"X-Result-Detail": "info, properties"}, &res); err != nil {
+			return nil, err
+		}
+		for _, r := range res.Results {
+			for _, v := range r.Properties["chart.version"] {
+				entries = append(entries, Entry{Version: v, Created: r.Created})
+			}
+		}
+	case IndexProviderNexus:
+		nextURL := versionsURL
+		for nextURL != "" {
+			var res nexusSearchResult
+			if err := c.getJSON(client, nextURL, nil, &res); err != nil {
+				return nil, err
+			}
+			for _, item := range res.Items {
+				entries = append(entries, Entry{Version: item.Version})
+			}
+			nextURL = ""
+			if res.ContinuationToken != "" {
+				u, err := url.Parse(versionsURL)
+				if err != nil {
+					return nil, err
+				}
+				query := u.Query()
+				query.Set("continuationToken", res.ContinuationToken)
+				u.RawQuery = query.Encode()
+				nextURL = u.String()
+			}
+		}
+	}
+	return entries, nil
+}
+
+func (c *nativeHelmChart) getJSON(client *http.Client, rawURL string, headers map[string]string, v interface{}) error {
+	req, err := http.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return err
+	}
+	if c.creds.Username != "" || c.creds.Password != "" {
+		// only basic supported
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errChartNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("failed to list chart versions: " + resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read body: %v", err)
+	}
+	return json.Unmarshal(data, v)
+}
+
+var errChartNotFound = errors.New("chart not found")
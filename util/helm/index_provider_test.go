@@ -0,0 +1,147 @@
+package helm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectIndexProvider(t *testing.T) {
+	assert.Equal(t, IndexProviderArtifactory, DetectIndexProvider("https://example.jfrog.io/artifactory/api/helm/helm-remote"))
+	assert.Equal(t, IndexProviderHarbor, DetectIndexProvider("https://harbor.example.com/chartrepo/library"))
+	assert.Equal(t, "", DetectIndexProvider("https://charts.example.com"))
+	assert.Equal(t, "", DetectIndexProvider("https://nexus.example.com/repository/helm-hosted/"))
+}
+
+func Test_getChartVersionsURL(t *testing.T) {
+	tests := []struct {
+		provider string
+		repoURL  string
+		expected string
+	}{
+		{IndexProviderChartMuseum, "https://charts.example.com", "https://charts.example.com/api/charts/my-chart"},
+		{IndexProviderChartMuseum, "https://charts.example.com/org/repo/", "https://charts.example.com/api/org/repo/charts/my-chart"},
+		{IndexProviderHarbor, "https://harbor.example.com/chartrepo/library", "https://harbor.example.com/api/chartrepo/library/charts/my-chart"},
+		{IndexProviderArtifactory, "https://example.jfrog.io/artifactory/api/helm/helm-remote", "https://example.jfrog.io/artifactory/api/search/prop?chart.name=my-chart&repos=helm-remote"},
+		{IndexProviderNexus, "https://nexus.example.com/repository/helm-hosted/", "https://nexus.example.com/service/rest/v1/search?format=helm&name=my-chart&repository=helm-hosted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			versionsURL, err := getChartVersionsURL(tt.provider, tt.repoURL, "my-chart")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, versionsURL)
+		})
+	}
+
+	_, err := getChartVersionsURL(IndexProviderArtifactory, "https://charts.example.com", "my-chart")
+	assert.Error(t, err)
+	_, err = getChartVersionsURL("unknown", "https://charts.example.com", "my-chart")
+	assert.Error(t, err)
+}
+
+func TestGetChartVersions(t *testing.T) {
+	t.Run("ChartMuseum", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			if assert.True(t, ok) {
+				assert.Equal(t, "admin", user)
+				assert.Equal(t, "secret", password)
+			}
+			switch r.URL.Path {
+			case "/api/charts/my-chart":
+				_, _ = w.Write([]byte(`[{"name":"my-chart","version":"1.1.0","created":"2023-01-02T00:00:00Z"},{"name":"my-chart","version":"1.0.0","created":"2023-01-01T00:00:00Z"}]`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		cache := &fakeIndexCache{}
+		client := NewClient(server.URL, Creds{Username: "admin", Password: "secret"}, false, "", WithIndexProvider(IndexProviderChartMuseum), WithIndexCache(cache))
+		entries, err := client.GetChartVersions("my-chart", false)
+		require.NoError(t, err)
+		if assert.Len(t, entries, 2) {
+			assert.Equal(t, "1.1.0", entries[0].Version)
+			assert.Equal(t, 2023, entries[0].Created.Year())
+		}
+
+		var cached Entries
+		require.NoError(t, json.Unmarshal(cache.data, &cached))
+		assert.Equal(t, entries, cached)
+
+		_, err = client.GetChartVersions("other-chart", true)
+		assert.EqualError(t, err, "chart 'other-chart' not found in repository")
+	})
+
+	t.Run("Artifactory", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/artifactory/api/search/prop", r.URL.Path)
+			assert.Equal(t, "my-chart", r.URL.Query().Get("chart.name"))
+			assert.Equal(t, "helm-remote", r.URL.Query().Get("repos"))
+			assert.Equal(t, "info, properties", r.Header.Get("X-Result-Detail"))
+			_, _ = w.Write([]byte(`{"results":[{"created":"2023-01-01T00:00:00.000Z","properties":{"chart.name":["my-chart"],"chart.version":["1.0.0"]}}]}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL+"/artifactory/api/helm/helm-remote", Creds{}, false, "")
+		entries, err := client.GetChartVersions("my-chart", true)
+		require.NoError(t, err)
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, "1.0.0", entries[0].Version)
+		}
+	})
+
+	t.Run("Nexus", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/service/rest/v1/search", r.URL.Path)
+			assert.Equal(t, "helm-hosted", r.URL.Query().Get("repository"))
+			if r.URL.Query().Get("continuationToken") == "" {
+				_, _ = w.Write([]byte(`{"items":[{"name":"my-chart","version":"1.0.0"}],"continuationToken":"next"}`))
+			} else {
+				_, _ = w.Write([]byte(`{"items":[{"name":"my-chart","version":"1.1.0"}],"continuationToken":null}`))
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL+"/repository/helm-hosted/", Creds{}, false, "", WithIndexProvider(IndexProviderNexus))
+		entries, err := client.GetChartVersions("my-chart", true)
+		require.NoError(t, err)
+		assert.Equal(t, Entries{{Version: "1.0.0"}, {Version: "1.1.0"}}, entries)
+	})
+
+	t.Run("FallbackToIndex", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/chartrepo/library/index.yaml":
+				_, _ = w.Write([]byte("entries:\n  my-chart:\n  - version: 1.0.0\n"))
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL+"/chartrepo/library", Creds{}, false, "")
+		entries, err := client.GetChartVersions("my-chart", true)
+		require.NoError(t, err)
+		assert.Equal(t, Entries{{Version: "1.0.0"}}, entries)
+	})
+
+	t.Run("Index", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/index.yaml", r.URL.Path)
+			_, _ = w.Write([]byte("entries:\n  my-chart:\n  - version: 1.0.0\n"))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, Creds{}, false, "")
+		entries, err := client.GetChartVersions("my-chart", true)
+		require.NoError(t, err)
+		assert.Equal(t, Entries{{Version: "1.0.0"}}, entries)
+		_, err = client.GetChartVersions("other-chart", true)
+		assert.EqualError(t, err, "chart 'other-chart' not found in index")
+	})
+}
@@ -58,6 +58,29 @@ func (_m *Client) ExtractChart(chart string, version string, passCredentials boo
 	return r0, r1, r2
 }
 
+// GetChartVersions provides a mock function with given fields: chart, noCache
+func (_m *Client) GetChartVersions(chart string, noCache bool) (helm.Entries, error) {
+	ret := _m.Called(chart, noCache)
+
+	var r0 helm.Entries
+	if rf, ok := ret.Get(0).(func(string, bool) helm.Entries); ok {
+		r0 = rf(chart, noCache)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(helm.Entries)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(chart, noCache)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetIndex provides a mock function with given fields: noCache
 func (_m *Client) GetIndex(noCache bool) (*helm.Index, error) {
 	ret := _m.Called(noCache)
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/handlers"
+)
+
+// corsAllowedMethods are the methods of the REST API allowed in cross-origin requests
+var corsAllowedMethods = []string{http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// DefaultCORSAllowedHeaders are the request headers allowed in cross-origin requests by default, in addition to the
+// headers always allowed by the browsers
+var DefaultCORSAllowedHeaders = []string{"Authorization", "Content-Type"}
+
+// CORSOptions configure the Cross-Origin Resource Sharing (CORS) of the REST API, which allows web applications served
+// from other origins, e.g. alternative frontends and developer portals, to call the API from the browser
+type CORSOptions struct {
+	// AllowedOrigins are the origins allowed to call the API, e.g. https://portal.example.com, or * for all the origins.
+	// The cross-origin requests are not allowed if empty.
+	AllowedOrigins []string
+	// AllowedHeaders are the request headers allowed in cross-origin requests, in addition to the headers always
+	// allowed by the browsers
+	AllowedHeaders []string
+	// AllowCredentials allows the cross-origin requests to send the cookies holding the auth token
+	AllowCredentials bool
+}
+
+// Enabled returns whether cross-origin requests are allowed
+func (o CORSOptions) Enabled() bool {
+	return len(o.AllowedOrigins) > 0
+}
+
+// Validate returns an error if the options are not valid
+func (o CORSOptions) Validate() error {
+	for _, origin := range o.AllowedOrigins {
+		if origin == "*" {
+			if o.AllowCredentials {
+				return fmt.Errorf("credentials cannot be allowed in the cross-origin requests of all origins")
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			return fmt.Errorf("invalid allowed origin %q: must be a scheme and a host, e.g. https://portal.example.com", origin)
+		}
+	}
+	return nil
+}
+
+// Handler returns the handler answering the CORS preflight requests and setting the CORS headers of the responses
+// of the given handler. The given handler is returned unchanged if cross-origin requests are not allowed.
+func (o CORSOptions) Handler(handler http.Handler) http.Handler {
+	if !o.Enabled() {
+		return handler
+	}
+	origins := make([]string, 0, len(o.AllowedOrigins))
+	for _, origin := range o.AllowedOrigins {
+		// the browsers send the origins without trailing slash
+		origins = append(origins, strings.TrimSuffix(origin, "/"))
+	}
+	opts := []handlers.CORSOption{
+		handlers.AllowedOrigins(origins),
+		handlers.AllowedMethods(corsAllowedMethods),
+		handlers.AllowedHeaders(o.AllowedHeaders),
+		handlers.OptionStatusCode(http.StatusNoContent),
+	}
+	if o.AllowCredentials {
+		opts = append(opts, handlers.AllowCredentials())
+	}
+	cors := handlers.CORS(opts...)(handler)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the responses differ depending on the origin of the requests, which caches must take into account
+		w.Header().Add("Vary", "Origin")
+		cors.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSOptions_Validate(t *testing.T) {
+	assert.NoError(t, CORSOptions{}.Validate())
+	assert.NoError(t, CORSOptions{AllowedOrigins: []string{"https://portal.example.com", "http://localhost:3000/"}, AllowCredentials: true}.Validate())
+	assert.NoError(t, CORSOptions{AllowedOrigins: []string{"*"}}.Validate())
+	assert.ErrorContains(t, CORSOptions{AllowedOrigins: []string{"*"}, AllowCredentials: true}.Validate(), "credentials cannot be allowed")
+	assert.ErrorContains(t, CORSOptions{AllowedOrigins: []string{"portal.example.com"}}.Validate(), "invalid allowed origin")
+	assert.ErrorContains(t, CORSOptions{AllowedOrigins: []string{"https://portal.example.com/apps"}}.Validate(), "invalid allowed origin")
+}
+
+func TestCORSOptions_Handler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	request := func(handler http.Handler, method, origin string, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/api/v1/applications", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, r)
+		return rr
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		rr := request(CORSOptions{}.Handler(next), http.MethodGet, "https://portal.example.com", nil)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	handler := CORSOptions{
+		AllowedOrigins:   []string{"https://portal.example.com/"},
+		AllowedHeaders:   DefaultCORSAllowedHeaders,
+		AllowCredentials: true,
+	}.Handler(next)
+
+	t.Run("Preflight", func(t *testing.T) {
+		rr := request(handler, http.MethodOptions, "https://portal.example.com", map[string]string{
+			"Access-Control-Request-Method":  http.MethodDelete,
+			"Access-Control-Request-Headers": "authorization, content-type",
+		})
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		assert.Equal(t, "https://portal.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "true", rr.Header().Get("Access-Control-Allow-Credentials"))
+		assert.Equal(t, "Authorization,Content-Type", rr.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, http.MethodDelete, rr.Header().Get("Access-Control-Allow-Methods"))
+
+		rr = request(handler, http.MethodOptions, "https://portal.example.com", map[string]string{
+			"Access-Control-Request-Method":  http.MethodGet,
+			"Access-Control-Request-Headers": "x-custom",
+		})
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("AllowedOrigin", func(t *testing.T) {
+		rr := request(handler, http.MethodGet, "https://portal.example.com", nil)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "https://portal.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "Origin", rr.Header().Get("Vary"))
+	})
+
+	t.Run("DisallowedOrigin", func(t *testing.T) {
+		rr := request(handler, http.MethodGet, "https://evil.example.com", nil)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("SameOrigin", func(t *testing.T) {
+		rr := request(handler, http.MethodGet, "", nil)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, rr.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
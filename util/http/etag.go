@@ -0,0 +1,81 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// etagResponseWriter buffers the response so that its ETag can be computed before it is written
+type etagResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *etagResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *etagResponseWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(data)
+}
+
+// ETag returns the weak ETag of the given response body. The ETag is weak because the response may be compressed.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// matchesETag returns true if the If-None-Match header value matches the given ETag
+func matchesETag(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// WithETag returns a handler which sets an ETag on the successful responses to the GET requests accepted by the given
+// function, and replies with 304 Not Modified when the ETag matches the If-None-Match header of the request. Clients
+// polling for changes therefore only transfer the response when its content changed.
+func WithETag(handler http.Handler, accept func(r *http.Request) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !accept(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		ew := &etagResponseWriter{ResponseWriter: w}
+		handler.ServeHTTP(ew, r)
+		if ew.status == 0 {
+			ew.status = http.StatusOK
+		}
+		if ew.status != http.StatusOK {
+			w.WriteHeader(ew.status)
+			_, _ = w.Write(ew.body.Bytes())
+			return
+		}
+
+		etag := ETag(ew.body.Bytes())
+		w.Header().Set("ETag", etag)
+		// responses depend on the permissions of the user, they must be revalidated before being reused
+		w.Header().Set("Cache-Control", "private, no-cache")
+		if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && matchesETag(ifNoneMatch, etag) {
+			w.Header().Del("Content-Length")
+			w.Header().Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(ew.body.Bytes())
+	})
+}
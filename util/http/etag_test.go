@@ -0,0 +1,72 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithETag(t *testing.T) {
+	body := `{"metadata":{"resourceVersion":"123"}}`
+	status := http.StatusOK
+	handler := WithETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}), func(r *http.Request) bool {
+		return r.URL.Path == "/api/v1/applications"
+	})
+	serve := func(method string, path string, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(http.MethodGet, "/api/v1/applications", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, body, w.Body.String())
+	etag := w.Header().Get("ETag")
+	assert.Equal(t, ETag([]byte(body)), etag)
+	assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+
+	t.Run("NotModified", func(t *testing.T) {
+		w := serve(http.MethodGet, "/api/v1/applications", etag)
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+
+		w = serve(http.MethodGet, "/api/v1/applications", `W/"other", `+etag)
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("Modified", func(t *testing.T) {
+		w := serve(http.MethodGet, "/api/v1/applications", `W/"other"`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, body, w.Body.String())
+	})
+
+	t.Run("NotAccepted", func(t *testing.T) {
+		w := serve(http.MethodGet, "/api/v1/projects", etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
+
+		w = serve(http.MethodPost, "/api/v1/applications", etag)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		status = http.StatusForbidden
+		defer func() { status = http.StatusOK }()
+		w := serve(http.MethodGet, "/api/v1/applications", etag)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, body, w.Body.String())
+		assert.Empty(t, w.Header().Get("ETag"))
+	})
+}
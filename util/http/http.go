@@ -5,6 +5,7 @@ import (
 	"math"
 	"net/http"
 	"net/http/httputil"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -21,6 +22,78 @@ const maxCookieLength = 4093
 // widest range of browsers, we shouldn't create more than 30 cookies per domain
 var maxCookieNumber = env.ParseNumFromEnv(common.EnvMaxCookieNumber, 10, 0, 30)
 
+// SameSite modes of the cookies holding the auth token
+const (
+	SameSiteLax    = "lax"
+	SameSiteStrict = "strict"
+	SameSiteNone   = "none"
+)
+
+// CookieOptions are the attributes of the cookies holding the auth token, which are customized e.g. when the UI is
+// served from a subdomain distinct from the API. The zero value holds the default attributes.
+type CookieOptions struct {
+	// Name is the name of the cookie, argocd.token by default. The chunks of the tokens split into several cookies are
+	// stored in the cookies named after it, suffixed with -1, -2, etc.
+	Name string
+	// Domain is the domain the cookie is sent to. The cookie is only sent to the host which set it if empty.
+	Domain string
+	// SameSite is the SameSite mode of the cookie: lax, which is the default, strict or none
+	SameSite string
+	// Partitioned makes the cookie be stored separately for each top-level site embedding Argo CD (CHIPS)
+	Partitioned bool
+}
+
+// CookieName returns the name of the cookie
+func (o CookieOptions) CookieName() string {
+	if o.Name == "" {
+		return common.AuthCookieName
+	}
+	return o.Name
+}
+
+func (o CookieOptions) sameSite() string {
+	if o.SameSite == "" {
+		return SameSiteLax
+	}
+	return strings.ToLower(o.SameSite)
+}
+
+// Validate returns an error if the attributes are not valid
+func (o CookieOptions) Validate() error {
+	if o.Name != "" && !cookieNamePattern.MatchString(o.Name) {
+		return fmt.Errorf("invalid cookie name %q: must only contain letters, digits and the characters !#$%%&'*+-.^_`|~", o.Name)
+	}
+	if strings.ContainsAny(o.Domain, ";, ") {
+		return fmt.Errorf("invalid cookie domain %q", o.Domain)
+	}
+	switch o.sameSite() {
+	case SameSiteLax, SameSiteStrict, SameSiteNone:
+	default:
+		return fmt.Errorf("invalid cookie SameSite mode %q: must be one of %s, %s or %s", o.SameSite, SameSiteLax, SameSiteStrict, SameSiteNone)
+	}
+	return nil
+}
+
+// Flags returns the attributes of a cookie with the given path. The cookie is only sent over HTTPS if secure is true,
+// or if its SameSite mode is none or it is partitioned, which the browsers only accept for such cookies.
+func (o CookieOptions) Flags(path string, secure bool) []string {
+	flags := []string{"path=" + path}
+	if o.Domain != "" {
+		flags = append(flags, "Domain="+o.Domain)
+	}
+	flags = append(flags, "SameSite="+o.sameSite(), "httpOnly")
+	if secure || o.sameSite() == SameSiteNone || o.Partitioned {
+		flags = append(flags, "Secure")
+	}
+	if o.Partitioned {
+		flags = append(flags, "Partitioned")
+	}
+	return flags
+}
+
+// cookieNamePattern matches the cookie names, which are tokens as defined by RFC 6265
+var cookieNamePattern = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
 // MakeCookieMetadata generates a string representing a Web cookie.  Yum!
 func MakeCookieMetadata(key, value string, flags ...string) ([]string, error) {
 	attributes := strings.Join(flags, "; ")
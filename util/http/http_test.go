@@ -19,6 +19,27 @@ func TestCookieMaxLength(t *testing.T) {
 	assert.Equal(t, 0, len(cookies))
 }
 
+func TestCookieOptions(t *testing.T) {
+	var options CookieOptions
+	assert.NoError(t, options.Validate())
+	assert.Equal(t, "argocd.token", options.CookieName())
+	assert.Equal(t, []string{"path=/", "SameSite=lax", "httpOnly"}, options.Flags("/", false))
+	assert.Equal(t, []string{"path=/argocd", "SameSite=lax", "httpOnly", "Secure"}, options.Flags("/argocd", true))
+
+	options = CookieOptions{Name: "argocd.ui.token", Domain: "example.com", SameSite: "Strict"}
+	assert.NoError(t, options.Validate())
+	assert.Equal(t, "argocd.ui.token", options.CookieName())
+	assert.Equal(t, []string{"path=/", "Domain=example.com", "SameSite=strict", "httpOnly"}, options.Flags("/", false))
+
+	// the browsers only accept the cookies with the SameSite mode none or partitioned if they are secure
+	options = CookieOptions{SameSite: SameSiteNone, Partitioned: true}
+	assert.Equal(t, []string{"path=/", "SameSite=none", "httpOnly", "Secure", "Partitioned"}, options.Flags("/", false))
+
+	assert.ErrorContains(t, CookieOptions{Name: "argocd token"}.Validate(), "invalid cookie name")
+	assert.ErrorContains(t, CookieOptions{Domain: "example.com; path=/"}.Validate(), "invalid cookie domain")
+	assert.ErrorContains(t, CookieOptions{SameSite: "always"}.Validate(), "invalid cookie SameSite mode")
+}
+
 func TestSplitCookie(t *testing.T) {
 	cookieValue := strings.Repeat("_", (maxCookieLength-6)*4)
 	cookies, err := MakeCookieMetadata("foo", cookieValue)
@@ -0,0 +1,131 @@
+package kube
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// DeprecatedAPI is a version of the API of a kind which is deprecated, and eventually removed, by Kubernetes
+type DeprecatedAPI struct {
+	GroupVersionKind schema.GroupVersionKind
+	// DeprecatedIn is the Kubernetes version deprecating the API, e.g. "1.21"
+	DeprecatedIn string
+	// RemovedIn is the Kubernetes version removing the API, e.g. "1.25"
+	RemovedIn string
+	// Replacement is the API version replacing the deprecated API, if any, e.g. "batch/v1"
+	Replacement string
+}
+
+// APIDeprecation is the deprecation of the API used by a resource in a given Kubernetes version
+type APIDeprecation struct {
+	DeprecatedAPI
+	// KubeVersion is the Kubernetes version the API has been checked against
+	KubeVersion string
+	// Removed is true if the API is no longer served by the Kubernetes version
+	Removed bool
+}
+
+// Message returns a human readable description of the deprecation
+func (d *APIDeprecation) Message() string {
+	var msg string
+	switch {
+	case d.Removed:
+		msg = fmt.Sprintf("%s is removed since Kubernetes %s", d.GroupVersionKind.GroupVersion().String(), d.RemovedIn)
+	case isVersionAtLeast(d.KubeVersion, d.DeprecatedIn):
+		msg = fmt.Sprintf("%s is deprecated since Kubernetes %s and removed in %s", d.GroupVersionKind.GroupVersion().String(), d.DeprecatedIn, d.RemovedIn)
+	default:
+		msg = fmt.Sprintf("%s is removed in Kubernetes %s", d.GroupVersionKind.GroupVersion().String(), d.RemovedIn)
+	}
+	if d.Replacement != "" {
+		msg = fmt.Sprintf("%s, use %s instead", msg, d.Replacement)
+	}
+	return msg
+}
+
+func deprecatedAPI(group, apiVersion, kind, deprecatedIn, removedIn, replacement string) DeprecatedAPI {
+	return DeprecatedAPI{
+		GroupVersionKind: schema.GroupVersionKind{Group: group, Version: apiVersion, Kind: kind},
+		DeprecatedIn:     deprecatedIn,
+		RemovedIn:        removedIn,
+		Replacement:      replacement,
+	}
+}
+
+// deprecatedAPIs are the APIs deprecated by Kubernetes, as documented in the deprecated API migration guide
+var deprecatedAPIs = []DeprecatedAPI{
+	deprecatedAPI("extensions", "v1beta1", "DaemonSet", "1.8", "1.16", "apps/v1"),
+	deprecatedAPI("extensions", "v1beta1", "Deployment", "1.8", "1.16", "apps/v1"),
+	deprecatedAPI("extensions", "v1beta1", "ReplicaSet", "1.8", "1.16", "apps/v1"),
+	deprecatedAPI("extensions", "v1beta1", "NetworkPolicy", "1.9", "1.16", "networking.k8s.io/v1"),
+	deprecatedAPI("extensions", "v1beta1", "PodSecurityPolicy", "1.10", "1.16", "policy/v1beta1"),
+	deprecatedAPI("extensions", "v1beta1", "Ingress", "1.14", "1.22", "networking.k8s.io/v1"),
+	deprecatedAPI("apps", "v1beta1", "Deployment", "1.9", "1.16", "apps/v1"),
+	deprecatedAPI("apps", "v1beta1", "StatefulSet", "1.9", "1.16", "apps/v1"),
+	deprecatedAPI("apps", "v1beta2", "DaemonSet", "1.9", "1.16", "apps/v1"),
+	deprecatedAPI("apps", "v1beta2", "Deployment", "1.9", "1.16", "apps/v1"),
+	deprecatedAPI("apps", "v1beta2", "ReplicaSet", "1.9", "1.16", "apps/v1"),
+	deprecatedAPI("apps", "v1beta2", "StatefulSet", "1.9", "1.16", "apps/v1"),
+	deprecatedAPI("networking.k8s.io", "v1beta1", "Ingress", "1.19", "1.22", "networking.k8s.io/v1"),
+	deprecatedAPI("networking.k8s.io", "v1beta1", "IngressClass", "1.19", "1.22", "networking.k8s.io/v1"),
+	deprecatedAPI("apiextensions.k8s.io", "v1beta1", "CustomResourceDefinition", "1.16", "1.22", "apiextensions.k8s.io/v1"),
+	deprecatedAPI("admissionregistration.k8s.io", "v1beta1", "MutatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1"),
+	deprecatedAPI("admissionregistration.k8s.io", "v1beta1", "ValidatingWebhookConfiguration", "1.16", "1.22", "admissionregistration.k8s.io/v1"),
+	deprecatedAPI("apiregistration.k8s.io", "v1beta1", "APIService", "1.19", "1.22", "apiregistration.k8s.io/v1"),
+	deprecatedAPI("certificates.k8s.io", "v1beta1", "CertificateSigningRequest", "1.19", "1.22", "certificates.k8s.io/v1"),
+	deprecatedAPI("coordination.k8s.io", "v1beta1", "Lease", "1.19", "1.22", "coordination.k8s.io/v1"),
+	deprecatedAPI("rbac.authorization.k8s.io", "v1beta1", "ClusterRole", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecatedAPI("rbac.authorization.k8s.io", "v1beta1", "ClusterRoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecatedAPI("rbac.authorization.k8s.io", "v1beta1", "Role", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecatedAPI("rbac.authorization.k8s.io", "v1beta1", "RoleBinding", "1.17", "1.22", "rbac.authorization.k8s.io/v1"),
+	deprecatedAPI("scheduling.k8s.io", "v1beta1", "PriorityClass", "1.14", "1.22", "scheduling.k8s.io/v1"),
+	deprecatedAPI("storage.k8s.io", "v1beta1", "CSIDriver", "1.19", "1.22", "storage.k8s.io/v1"),
+	deprecatedAPI("storage.k8s.io", "v1beta1", "CSINode", "1.17", "1.22", "storage.k8s.io/v1"),
+	deprecatedAPI("storage.k8s.io", "v1beta1", "StorageClass", "1.19", "1.22", "storage.k8s.io/v1"),
+	deprecatedAPI("storage.k8s.io", "v1beta1", "VolumeAttachment", "1.19", "1.22", "storage.k8s.io/v1"),
+	deprecatedAPI("batch", "v1beta1", "CronJob", "1.21", "1.25", "batch/v1"),
+	deprecatedAPI("discovery.k8s.io", "v1beta1", "EndpointSlice", "1.21", "1.25", "discovery.k8s.io/v1"),
+	deprecatedAPI("events.k8s.io", "v1beta1", "Event", "1.19", "1.25", "events.k8s.io/v1"),
+	deprecatedAPI("autoscaling", "v2beta1", "HorizontalPodAutoscaler", "1.22", "1.25", "autoscaling/v2"),
+	deprecatedAPI("policy", "v1beta1", "PodDisruptionBudget", "1.21", "1.25", "policy/v1"),
+	deprecatedAPI("policy", "v1beta1", "PodSecurityPolicy", "1.21", "1.25", ""),
+	deprecatedAPI("node.k8s.io", "v1beta1", "RuntimeClass", "1.20", "1.25", "node.k8s.io/v1"),
+	deprecatedAPI("autoscaling", "v2beta2", "HorizontalPodAutoscaler", "1.23", "1.26", "autoscaling/v2"),
+	deprecatedAPI("flowcontrol.apiserver.k8s.io", "v1beta1", "FlowSchema", "1.23", "1.26", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	deprecatedAPI("flowcontrol.apiserver.k8s.io", "v1beta1", "PriorityLevelConfiguration", "1.23", "1.26", "flowcontrol.apiserver.k8s.io/v1beta2"),
+	deprecatedAPI("storage.k8s.io", "v1beta1", "CSIStorageCapacity", "1.24", "1.27", "storage.k8s.io/v1"),
+	deprecatedAPI("flowcontrol.apiserver.k8s.io", "v1beta2", "FlowSchema", "1.26", "1.29", "flowcontrol.apiserver.k8s.io/v1beta3"),
+	deprecatedAPI("flowcontrol.apiserver.k8s.io", "v1beta2", "PriorityLevelConfiguration", "1.26", "1.29", "flowcontrol.apiserver.k8s.io/v1beta3"),
+}
+
+// GetAPIDeprecation returns the deprecation of the API of the given kind in the given Kubernetes version, e.g. "1.24",
+// or nil if the API is neither deprecated nor removed in this version or in the next minor version, i.e. if it does
+// not need to be migrated yet. Nil is returned as well if the Kubernetes version is unknown.
+func GetAPIDeprecation(gvk schema.GroupVersionKind, kubeVersion string) *APIDeprecation {
+	current, err := version.ParseGeneric(kubeVersion)
+	if err != nil {
+		return nil
+	}
+	next := version.MustParseGeneric(fmt.Sprintf("%d.%d", current.Major(), current.Minor()+1))
+	for _, api := range deprecatedAPIs {
+		if api.GroupVersionKind != gvk {
+			continue
+		}
+		removedIn := version.MustParseGeneric(api.RemovedIn)
+		deprecatedIn := version.MustParseGeneric(api.DeprecatedIn)
+		if !next.AtLeast(removedIn) && !current.AtLeast(deprecatedIn) {
+			return nil
+		}
+		return &APIDeprecation{DeprecatedAPI: api, KubeVersion: kubeVersion, Removed: current.AtLeast(removedIn)}
+	}
+	return nil
+}
+
+func isVersionAtLeast(v string, min string) bool {
+	parsed, err := version.ParseGeneric(v)
+	if err != nil {
+		return false
+	}
+	return parsed.AtLeast(version.MustParseGeneric(min))
+}
@@ -0,0 +1,52 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGetAPIDeprecation(t *testing.T) {
+	cronJob := schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
+
+	t.Run("Removed", func(t *testing.T) {
+		deprecation := GetAPIDeprecation(cronJob, "1.25")
+		if assert.NotNil(t, deprecation) {
+			assert.True(t, deprecation.Removed)
+			assert.Equal(t, "batch/v1beta1 is removed since Kubernetes 1.25, use batch/v1 instead", deprecation.Message())
+		}
+	})
+
+	t.Run("Deprecated", func(t *testing.T) {
+		deprecation := GetAPIDeprecation(cronJob, "v1.22.3")
+		if assert.NotNil(t, deprecation) {
+			assert.False(t, deprecation.Removed)
+			assert.Equal(t, "batch/v1beta1 is deprecated since Kubernetes 1.21 and removed in 1.25, use batch/v1 instead", deprecation.Message())
+		}
+	})
+
+	t.Run("RemovedInNextVersion", func(t *testing.T) {
+		deprecation := GetAPIDeprecation(schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "PodSecurityPolicy"}, "1.15")
+		if assert.NotNil(t, deprecation) {
+			assert.False(t, deprecation.Removed)
+			assert.Equal(t, "extensions/v1beta1 is deprecated since Kubernetes 1.10 and removed in 1.16, use policy/v1beta1 instead", deprecation.Message())
+		}
+		deprecation = GetAPIDeprecation(schema.GroupVersionKind{Group: "policy", Version: "v1beta1", Kind: "PodSecurityPolicy"}, "1.24")
+		if assert.NotNil(t, deprecation) {
+			assert.Equal(t, "policy/v1beta1 is deprecated since Kubernetes 1.21 and removed in 1.25", deprecation.Message())
+		}
+	})
+
+	t.Run("NotDeprecatedYet", func(t *testing.T) {
+		assert.Nil(t, GetAPIDeprecation(cronJob, "1.20"))
+	})
+
+	t.Run("NotDeprecated", func(t *testing.T) {
+		assert.Nil(t, GetAPIDeprecation(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}, "1.25"))
+	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		assert.Nil(t, GetAPIDeprecation(cronJob, ""))
+	})
+}
@@ -0,0 +1,35 @@
+package kube
+
+import (
+	"net/http"
+
+	"k8s.io/client-go/rest"
+)
+
+type dryRunRoundTripper struct {
+	roundTripper http.RoundTripper
+}
+
+func (drt *dryRunRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		r = r.Clone(r.Context())
+		query := r.URL.Query()
+		query.Set("dryRun", "All")
+		r.URL.RawQuery = query.Encode()
+	}
+	return drt.roundTripper.RoundTrip(r)
+}
+
+// AddDryRunWrapper adds a transport wrapper which turns every mutating kubernetes request into a server-side dry run,
+// so that the requests are validated by the API server without persisting any change
+func AddDryRunWrapper(config *rest.Config) *rest.Config {
+	wrap := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		if wrap != nil {
+			rt = wrap(rt)
+		}
+		return &dryRunRoundTripper{roundTripper: rt}
+	}
+	return config
+}
@@ -0,0 +1,36 @@
+package kube
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+func TestAddDryRunWrapper(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.Method+" "+r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	config := AddDryRunWrapper(&rest.Config{Host: server.URL})
+	client, err := rest.HTTPClientFor(config)
+	require.NoError(t, err)
+
+	for _, method := range []string{http.MethodGet, http.MethodPatch, http.MethodDelete} {
+		req, err := http.NewRequest(method, server.URL+"/apis/argoproj.io/v1alpha1/applications?fieldManager=test", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Equal(t, []string{
+		"GET fieldManager=test",
+		"PATCH dryRun=All&fieldManager=test",
+		"DELETE dryRun=All&fieldManager=test",
+	}, queries)
+}
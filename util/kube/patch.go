@@ -0,0 +1,58 @@
+package kube
+
+import (
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+const (
+	// PatchTypeJSON is the type of JSON patches, as defined by RFC 6902
+	PatchTypeJSON = "json"
+	// PatchTypeMerge is the type of JSON merge patches, as defined by RFC 7386
+	PatchTypeMerge = "merge"
+	// PatchTypeStrategic is the type of Kubernetes strategic merge patches
+	PatchTypeStrategic = "strategic"
+)
+
+// ApplyPatch applies a patch of the given type to the JSON document of an object, and returns the patched document.
+// The data struct is the Go type of the object, which is required by strategic merge patches to look up the patch
+// strategies of its fields. An empty patch type is a JSON patch.
+func ApplyPatch(original []byte, patch string, patchType string, dataStruct interface{}) ([]byte, error) {
+	switch patchType {
+	case PatchTypeJSON, "":
+		decoded, err := jsonpatch.DecodePatch([]byte(patch))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding json patch: %w", err)
+		}
+		patched, err := decoded.Apply(original)
+		if err != nil {
+			return nil, fmt.Errorf("error applying patch: %w", err)
+		}
+		return patched, nil
+	case PatchTypeMerge:
+		patched, err := jsonpatch.MergePatch(original, []byte(patch))
+		if err != nil {
+			return nil, fmt.Errorf("error calculating merge patch: %w", err)
+		}
+		return patched, nil
+	case PatchTypeStrategic:
+		patched, err := strategicpatch.StrategicMergePatch(original, []byte(patch), dataStruct)
+		if err != nil {
+			return nil, fmt.Errorf("error calculating strategic merge patch: %w", err)
+		}
+		return patched, nil
+	default:
+		return nil, &UnsupportedPatchTypeError{PatchType: patchType}
+	}
+}
+
+// UnsupportedPatchTypeError is returned when applying a patch of an unknown type
+type UnsupportedPatchTypeError struct {
+	PatchType string
+}
+
+func (e *UnsupportedPatchTypeError) Error() string {
+	return fmt.Sprintf("Patch type '%s' is not supported", e.PatchType)
+}
@@ -0,0 +1,189 @@
+package kubeschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// errSchemaNotFound is returned when no schema exists for a resource kind, e.g. for custom resources
+var errSchemaNotFound = errors.New("schema not found")
+
+// kubeVersionRegexp matches the Kubernetes versions reported by the clusters, e.g. "1.24", "1.24+" or "v1.24.2+k3s1"
+var kubeVersionRegexp = regexp.MustCompile(`^v?(\d+)\.(\d+)\+?(?:\.(\d+))?`)
+
+// schemaLocationParams are the parameters available to the schema location template. The names are those of the
+// kubeconform schema locations, so that the same schema repositories can be used.
+type schemaLocationParams struct {
+	// NormalizedKubernetesVersion is the Kubernetes version of the destination cluster, e.g. "v1.24.0", or "master"
+	// if it is unknown
+	NormalizedKubernetesVersion string
+	// ResourceKind is the lowercase kind of the resource, e.g. "deployment"
+	ResourceKind string
+	// ResourceAPIVersion is the version of the API of the resource, e.g. "v1"
+	ResourceAPIVersion string
+	// Group is the lowercase API group of the resource, e.g. "apps"
+	Group string
+	// KindSuffix is the suffix of the kind in the file names of the schemas, e.g. "-apps-v1"
+	KindSuffix string
+}
+
+// Validator validates manifests against the JSON schemas of the Kubernetes version of their destination cluster. The
+// schemas are loaded from a location template, e.g.
+// "https://raw.githubusercontent.com/yannh/kubernetes-json-schema/master/{{ .NormalizedKubernetesVersion }}-standalone-strict/{{ .ResourceKind }}{{ .KindSuffix }}.json",
+// and are cached per Kubernetes version.
+type Validator struct {
+	location   *template.Template
+	httpClient *http.Client
+	lock       sync.Mutex
+	// validators contains the validators of the schemas by location; a nil validator means that the schema does not
+	// exist
+	validators map[string]*validate.SchemaValidator
+}
+
+// NewValidator returns a validator loading the schemas from the given location template, which is either a URL or a
+// local path
+func NewValidator(location string) (*Validator, error) {
+	tmpl, err := template.New("location").Option("missingkey=error").Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema location '%s': %w", location, err)
+	}
+	return &Validator{
+		location:   tmpl,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		validators: map[string]*validate.SchemaValidator{},
+	}, nil
+}
+
+// Validate validates the given manifests against the schemas of the given Kubernetes version, and returns the
+// validation errors of each manifest. Manifests without schema, e.g. custom resources, are not validated.
+func (v *Validator) Validate(kubeVersion string, objs []*unstructured.Unstructured) ([]string, error) {
+	var validationErrors []string
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		validator, err := v.getValidator(kubeVersion, obj)
+		if err != nil {
+			return nil, err
+		}
+		if validator == nil {
+			continue
+		}
+		res := validator.Validate(obj.Object)
+		if !res.HasErrors() {
+			continue
+		}
+		resource := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+		if obj.GetNamespace() != "" {
+			resource = fmt.Sprintf("%s/%s", obj.GetNamespace(), resource)
+		}
+		for _, err := range flattenErrors(res.Errors) {
+			// the properties of the root object are reported with a leading dot, e.g. ".spec"
+			validationErrors = append(validationErrors, fmt.Sprintf("%s: %s", resource, strings.TrimPrefix(err.Error(), ".")))
+		}
+	}
+	return validationErrors, nil
+}
+
+func (v *Validator) getValidator(kubeVersion string, obj *unstructured.Unstructured) (*validate.SchemaValidator, error) {
+	gvk := obj.GroupVersionKind()
+	params := schemaLocationParams{
+		NormalizedKubernetesVersion: "master",
+		ResourceKind:                strings.ToLower(gvk.Kind),
+		ResourceAPIVersion:          gvk.Version,
+		Group:                       strings.ToLower(gvk.Group),
+		KindSuffix:                  "-" + gvk.Version,
+	}
+	if match := kubeVersionRegexp.FindStringSubmatch(kubeVersion); match != nil {
+		patch := match[3]
+		if patch == "" {
+			patch = "0"
+		}
+		params.NormalizedKubernetesVersion = fmt.Sprintf("v%s.%s.%s", match[1], match[2], patch)
+	}
+	if gvk.Group != "" {
+		params.KindSuffix = fmt.Sprintf("-%s-%s", strings.ToLower(strings.Split(gvk.Group, ".")[0]), gvk.Version)
+	}
+	var buf bytes.Buffer
+	if err := v.location.Execute(&buf, params); err != nil {
+		return nil, fmt.Errorf("failed to render schema location: %w", err)
+	}
+	location := buf.String()
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if validator, ok := v.validators[location]; ok {
+		return validator, nil
+	}
+	data, err := v.loadSchema(location)
+	if errors.Is(err, errSchemaNotFound) {
+		log.Debugf("No schema found for %s at %s, skipping validation", gvk.String(), location)
+		v.validators[location] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema of %s: %w", gvk.String(), err)
+	}
+	schema := &spec.Schema{}
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema of %s from %s: %w", gvk.String(), location, err)
+	}
+	validator := validate.NewSchemaValidator(schema, nil, "", strfmt.Default)
+	v.validators[location] = validator
+	return validator, nil
+}
+
+func (v *Validator) loadSchema(location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		data, err := os.ReadFile(location)
+		if os.IsNotExist(err) {
+			return nil, errSchemaNotFound
+		}
+		return data, err
+	}
+	res, err := v.httpClient.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, errSchemaNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d getting %s", res.StatusCode, location)
+	}
+	return io.ReadAll(res.Body)
+}
+
+// flattenErrors returns the individual errors of composite validation errors
+func flattenErrors(errs []error) []error {
+	var res []error
+	for _, err := range errs {
+		var composite *openapierrors.CompositeError
+		if errors.As(err, &composite) {
+			res = append(res, flattenErrors(composite.Errors)...)
+		} else {
+			res = append(res, err)
+		}
+	}
+	return res
+}
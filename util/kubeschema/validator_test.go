@@ -0,0 +1,123 @@
+package kubeschema
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const testLocation = "testdata/{{ .NormalizedKubernetesVersion }}-standalone-strict/{{ .ResourceKind }}{{ .KindSuffix }}.json"
+
+func unmarshalObj(t *testing.T, manifest string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &obj.Object))
+	return obj
+}
+
+func TestValidate(t *testing.T) {
+	validator, err := NewValidator(testLocation)
+	require.NoError(t, err)
+
+	t.Run("Valid", func(t *testing.T) {
+		validationErrors, err := validator.Validate("1.24", []*unstructured.Unstructured{
+			unmarshalObj(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: guestbook
+data:
+  foo: bar`),
+			unmarshalObj(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  replicas: 2
+  selector: {}`),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, validationErrors)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		validationErrors, err := validator.Validate("v1.24.0", []*unstructured.Unstructured{
+			unmarshalObj(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: two
+  template: {}`),
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			`default/Deployment/guestbook: spec.replicas in body must be of type integer,null: "string"`,
+			`default/Deployment/guestbook: spec.selector in body is required`,
+			`default/Deployment/guestbook: spec.template in body is a forbidden property`,
+		}, validationErrors)
+	})
+
+	t.Run("MissingSchema", func(t *testing.T) {
+		validationErrors, err := validator.Validate("1.24", []*unstructured.Unstructured{
+			unmarshalObj(t, `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: guestbook
+spec:
+  anything: goes`),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, validationErrors)
+	})
+
+	t.Run("InvalidSchema", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "master"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "master", "configmap-v1.json"), []byte("{"), 0644))
+		validator, err := NewValidator(filepath.Join(dir, "{{ .NormalizedKubernetesVersion }}", "{{ .ResourceKind }}{{ .KindSuffix }}.json"))
+		require.NoError(t, err)
+
+		_, err = validator.Validate("", []*unstructured.Unstructured{unmarshalObj(t, `{"apiVersion": "v1", "kind": "ConfigMap"}`)})
+		assert.ErrorContains(t, err, "failed to parse schema of /v1, Kind=ConfigMap")
+	})
+}
+
+func TestValidate_CachesSchemas(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.ServeFile(w, r, filepath.Join("testdata", r.URL.Path))
+	}))
+	defer server.Close()
+
+	validator, err := NewValidator(server.URL + "/{{ .NormalizedKubernetesVersion }}-standalone-strict/{{ .ResourceKind }}{{ .KindSuffix }}.json")
+	require.NoError(t, err)
+
+	objs := []*unstructured.Unstructured{
+		unmarshalObj(t, `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "guestbook"}, "spec": {}}`),
+		unmarshalObj(t, `{"apiVersion": "example.com/v1", "kind": "Widget", "metadata": {"name": "guestbook"}}`),
+	}
+	for i := 0; i < 2; i++ {
+		validationErrors, err := validator.Validate("1.24", objs)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ConfigMap/guestbook: spec in body is a forbidden property"}, validationErrors)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestNewValidator_InvalidLocation(t *testing.T) {
+	_, err := NewValidator("testdata/{{ .NormalizedKubernetesVersion")
+	assert.ErrorContains(t, err, "failed to parse schema location")
+}
@@ -0,0 +1,185 @@
+package ldap
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	gocache "github.com/patrickmn/go-cache"
+
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// dnAttr is the pseudo attribute designating the DN of an entry in the group search
+const dnAttr = "DN"
+
+// conn is the subset of an LDAP connection used to resolve the groups of the users
+type conn interface {
+	Bind(username, password string) error
+	Search(searchRequest *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close()
+}
+
+// GroupResolver resolves the groups of the users from an LDAP directory, caching the groups of each user
+type GroupResolver struct {
+	config *settings.LDAPGroupsConfig
+	cache  *gocache.Cache
+	dial   func(config *settings.LDAPGroupsConfig) (conn, error)
+}
+
+// NewGroupResolver returns a resolver of the groups of the users from the LDAP directory of the given configuration
+func NewGroupResolver(config *settings.LDAPGroupsConfig) *GroupResolver {
+	expiration := config.CacheExpirationOrDefault()
+	return &GroupResolver{
+		config: config,
+		cache:  gocache.New(expiration, 2*expiration),
+		dial:   dial,
+	}
+}
+
+// Config returns the configuration of the resolver
+func (r *GroupResolver) Config() *settings.LDAPGroupsConfig {
+	return r.config
+}
+
+func dial(config *settings.LDAPGroupsConfig) (conn, error) {
+	tlsConfig, err := config.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	host := config.Host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		if config.InsecureNoSSL || config.StartTLS {
+			host = net.JoinHostPort(host, "389")
+		} else {
+			host = net.JoinHostPort(host, "636")
+		}
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName, _, _ = net.SplitHostPort(host)
+	}
+	var c *ldap.Conn
+	if config.InsecureNoSSL || config.StartTLS {
+		c, err = ldap.DialURL("ldap://" + host)
+	} else {
+		c, err = ldap.DialURL("ldaps://"+host, ldap.DialWithTLSConfig(tlsConfig))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", host, err)
+	}
+	if config.StartTLS {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed to start TLS with %s: %w", host, err)
+		}
+	}
+	c.SetTimeout(30 * time.Second)
+	return c, nil
+}
+
+// Groups returns the names of the groups of the user whose username attribute has the given value, including the
+// groups these groups are members of if nested groups are enabled. The groups are cached.
+func (r *GroupResolver) Groups(username string) ([]string, error) {
+	if groups, ok := r.cache.Get(username); ok {
+		return groups.([]string), nil
+	}
+	groups, err := r.lookupGroups(username)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.SetDefault(username, groups)
+	return groups, nil
+}
+
+func (r *GroupResolver) lookupGroups(username string) ([]string, error) {
+	c, err := r.dial(r.config)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+	if r.config.BindDN != "" {
+		if err := c.Bind(r.config.BindDN, r.config.BindPW); err != nil {
+			return nil, fmt.Errorf("failed to bind as %s: %w", r.config.BindDN, err)
+		}
+	}
+
+	userSearch := r.config.UserSearch
+	groupSearch := r.config.GroupSearch
+	var userAttrs []string
+	if groupSearch.UserAttr != dnAttr {
+		userAttrs = []string{groupSearch.UserAttr}
+	}
+	res, err := c.Search(ldap.NewSearchRequest(
+		userSearch.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false,
+		andFilter(userSearch.Filter, fmt.Sprintf("(%s=%s)", userSearch.Username, ldap.EscapeFilter(username))),
+		userAttrs, nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search the user %s: %w", username, err)
+	}
+	switch len(res.Entries) {
+	case 0:
+		return []string{}, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("found %d users matching %s", len(res.Entries), username)
+	}
+	user := res.Entries[0]
+	memberValues := []string{user.DN}
+	if groupSearch.UserAttr != dnAttr {
+		memberValues = user.GetAttributeValues(groupSearch.UserAttr)
+	}
+
+	names := map[string]bool{}
+	visited := map[string]bool{}
+	maxDepth := 1
+	if groupSearch.Nested {
+		maxDepth = groupSearch.MaxDepthOrDefault()
+	}
+	for depth := 0; depth < maxDepth && len(memberValues) > 0; depth++ {
+		var next []string
+		for _, member := range memberValues {
+			groups, err := c.Search(ldap.NewSearchRequest(
+				groupSearch.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+				andFilter(groupSearch.Filter, fmt.Sprintf("(%s=%s)", groupSearch.GroupAttr, ldap.EscapeFilter(member))),
+				[]string{groupSearch.NameAttr}, nil,
+			))
+			if err != nil {
+				return nil, fmt.Errorf("failed to search the groups of %s: %w", member, err)
+			}
+			for _, group := range groups.Entries {
+				if visited[group.DN] {
+					continue
+				}
+				visited[group.DN] = true
+				for _, name := range group.GetAttributeValues(groupSearch.NameAttr) {
+					names[name] = true
+				}
+				// nested groups reference the groups they contain by DN
+				next = append(next, group.DN)
+			}
+		}
+		memberValues = next
+	}
+
+	groups := make([]string, 0, len(names))
+	for name := range names {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+	return groups, nil
+}
+
+// andFilter combines an optional filter with a required one
+func andFilter(filter string, required string) string {
+	if filter == "" {
+		return required
+	}
+	if !strings.HasPrefix(filter, "(") {
+		filter = "(" + filter + ")"
+	}
+	return fmt.Sprintf("(&%s%s)", filter, required)
+}
@@ -0,0 +1,153 @@
+package ldap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+type fakeConn struct {
+	// entries holds the entries returned by the searches of each filter
+	entries  map[string][]*ldap.Entry
+	bindDN   string
+	searches int
+	closed   bool
+}
+
+func (c *fakeConn) Bind(username, password string) error {
+	if password != "password" {
+		return fmt.Errorf("invalid credentials")
+	}
+	c.bindDN = username
+	return nil
+}
+
+func (c *fakeConn) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	c.searches++
+	return &ldap.SearchResult{Entries: c.entries[req.Filter]}, nil
+}
+
+func (c *fakeConn) Close() {
+	c.closed = true
+}
+
+func newTestConfig(nested bool) *settings.LDAPGroupsConfig {
+	return &settings.LDAPGroupsConfig{
+		Host:   "ldap.example.com",
+		BindDN: "cn=admin,dc=example,dc=com",
+		BindPW: "password",
+		UserSearch: settings.LDAPUserSearch{
+			BaseDN:   "ou=users,dc=example,dc=com",
+			Filter:   "(objectClass=person)",
+			Username: "mail",
+		},
+		GroupSearch: settings.LDAPGroupSearch{
+			BaseDN:    "ou=groups,dc=example,dc=com",
+			Filter:    "objectClass=groupOfNames",
+			UserAttr:  "DN",
+			GroupAttr: "member",
+			NameAttr:  "cn",
+			Nested:    nested,
+		},
+	}
+}
+
+func newTestConn() *fakeConn {
+	return &fakeConn{entries: map[string][]*ldap.Entry{
+		"(&(objectClass=person)(mail=jane@example.com))": {
+			ldap.NewEntry("uid=jane,ou=users,dc=example,dc=com", nil),
+		},
+		"(&(objectClass=groupOfNames)(member=uid=jane,ou=users,dc=example,dc=com))": {
+			ldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com", map[string][]string{"cn": {"developers"}}),
+			ldap.NewEntry("cn=oncall,ou=groups,dc=example,dc=com", map[string][]string{"cn": {"oncall"}}),
+		},
+		"(&(objectClass=groupOfNames)(member=cn=developers,ou=groups,dc=example,dc=com))": {
+			ldap.NewEntry("cn=engineering,ou=groups,dc=example,dc=com", map[string][]string{"cn": {"engineering"}}),
+		},
+		"(&(objectClass=groupOfNames)(member=cn=engineering,ou=groups,dc=example,dc=com))": {
+			// cycles are ignored
+			ldap.NewEntry("cn=developers,ou=groups,dc=example,dc=com", map[string][]string{"cn": {"developers"}}),
+		},
+	}}
+}
+
+func newTestResolver(config *settings.LDAPGroupsConfig, c *fakeConn) *GroupResolver {
+	r := NewGroupResolver(config)
+	r.dial = func(config *settings.LDAPGroupsConfig) (conn, error) {
+		return c, nil
+	}
+	return r
+}
+
+func TestGroupResolver_Groups(t *testing.T) {
+	c := newTestConn()
+	r := newTestResolver(newTestConfig(false), c)
+
+	groups, err := r.Groups("jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers", "oncall"}, groups)
+	assert.Equal(t, "cn=admin,dc=example,dc=com", c.bindDN)
+	assert.True(t, c.closed)
+}
+
+func TestGroupResolver_NestedGroups(t *testing.T) {
+	r := newTestResolver(newTestConfig(true), newTestConn())
+
+	groups, err := r.Groups("jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers", "engineering", "oncall"}, groups)
+}
+
+func TestGroupResolver_MaxDepth(t *testing.T) {
+	config := newTestConfig(true)
+	config.GroupSearch.MaxDepth = 1
+	r := newTestResolver(config, newTestConn())
+
+	groups, err := r.Groups("jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers", "oncall"}, groups)
+}
+
+func TestGroupResolver_UnknownUser(t *testing.T) {
+	r := newTestResolver(newTestConfig(true), newTestConn())
+
+	groups, err := r.Groups("john@example.com")
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
+
+func TestGroupResolver_Cache(t *testing.T) {
+	c := newTestConn()
+	r := newTestResolver(newTestConfig(false), c)
+
+	_, err := r.Groups("jane@example.com")
+	require.NoError(t, err)
+	searches := c.searches
+	groups, err := r.Groups("jane@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers", "oncall"}, groups)
+	assert.Equal(t, searches, c.searches)
+}
+
+func TestGroupResolver_BindError(t *testing.T) {
+	config := newTestConfig(false)
+	config.BindPW = "wrong"
+	r := newTestResolver(config, newTestConn())
+
+	_, err := r.Groups("jane@example.com")
+	assert.ErrorContains(t, err, "failed to bind as cn=admin,dc=example,dc=com")
+}
+
+func TestGroupResolver_EscapesUsername(t *testing.T) {
+	c := newTestConn()
+	r := newTestResolver(newTestConfig(false), c)
+
+	groups, err := r.Groups("*)(mail=jane@example.com")
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	luajson "layeh.com/gopher-json"
@@ -30,9 +32,25 @@ const (
 type ResourceHealthOverrides map[string]appv1.ResourceOverride
 
 func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
-	luaVM := VM{
-		ResourceOverrides: overrides,
-	}
+	return getResourceHealth(VM{ResourceOverrides: overrides}, obj)
+}
+
+// WithRelatedResources returns health overrides whose scripts can access the resources related to the resource they
+// are run against through the given getter
+func (overrides ResourceHealthOverrides) WithRelatedResources(relatedResources RelatedResourcesGetter) health.HealthOverride {
+	return relatedResourceHealthOverrides{overrides: overrides, relatedResources: relatedResources}
+}
+
+type relatedResourceHealthOverrides struct {
+	overrides        ResourceHealthOverrides
+	relatedResources RelatedResourcesGetter
+}
+
+func (o relatedResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	return getResourceHealth(VM{ResourceOverrides: o.overrides, RelatedResources: o.relatedResources}, obj)
+}
+
+func getResourceHealth(luaVM VM, obj *unstructured.Unstructured) (*health.HealthStatus, error) {
 	script, useOpenLibs, err := luaVM.GetHealthScript(obj)
 	if err != nil {
 		return nil, err
@@ -54,6 +72,14 @@ type VM struct {
 	ResourceOverrides map[string]appv1.ResourceOverride
 	// UseOpenLibs flag to enable open libraries. Libraries are disabled by default while running, but enabled during testing to allow the use of print statements
 	UseOpenLibs bool
+	// RelatedResources gives the scripts access to the resources related to the resource they are run against
+	RelatedResources RelatedResourcesGetter
+	// MaxRelatedResourceLookups is the maximum number of lookups of related resources per script run. Defaults to
+	// DefaultMaxRelatedResourceLookups
+	MaxRelatedResourceLookups int
+	// MaxRelatedResources is the maximum number of related resources returned to a script run. Defaults to
+	// DefaultMaxRelatedResources
+	MaxRelatedResources int
 }
 
 func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState, error) {
@@ -88,6 +114,7 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 	l.SetContext(ctx)
 	objectValue := decodeValue(l, obj.Object)
 	l.SetGlobal("obj", objectValue)
+	vm.setRelatedResourcesFunctions(l, obj)
 	err := l.DoString(script)
 	return l, err
 }
@@ -415,3 +442,43 @@ func decodeValue(L *lua.LState, value interface{}) lua.LValue {
 
 	return lua.LNil
 }
+
+// ValidateResourceOverrides checks the syntax of the health and action Lua scripts, and the health aggregations, of the
+// given resource overrides
+func ValidateResourceOverrides(overrides map[string]appv1.ResourceOverride) error {
+	for key, override := range overrides {
+		if err := validateSyntax(override.HealthLua); err != nil {
+			return fmt.Errorf("invalid health script of '%s': %w", key, err)
+		}
+		if override.HealthAggregation != nil {
+			if err := override.HealthAggregation.Validate(); err != nil {
+				return fmt.Errorf("invalid health aggregation of '%s': %w", key, err)
+			}
+		}
+		if override.Actions == "" {
+			continue
+		}
+		actions, err := override.GetActions()
+		if err != nil {
+			return fmt.Errorf("invalid actions of '%s': %w", key, err)
+		}
+		if err := validateSyntax(actions.ActionDiscoveryLua); err != nil {
+			return fmt.Errorf("invalid action discovery script of '%s': %w", key, err)
+		}
+		for _, definition := range actions.Definitions {
+			if err := validateSyntax(definition.ActionLua); err != nil {
+				return fmt.Errorf("invalid script of action '%s' of '%s': %w", definition.Name, key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSyntax parses the given Lua script without executing it
+func validateSyntax(script string) error {
+	if script == "" {
+		return nil
+	}
+	_, err := parse.Parse(strings.NewReader(script), "<script>")
+	return err
+}
@@ -465,3 +465,141 @@ return hs`
 		assert.Nil(t, status)
 	})
 }
+
+type fakeRelatedResources struct {
+	owned    []RelatedResource
+	byLabels []RelatedResource
+	labels   map[string]string
+}
+
+func (f *fakeRelatedResources) GetOwnedResources(_ *unstructured.Unstructured) ([]RelatedResource, error) {
+	return f.owned, nil
+}
+
+func (f *fakeRelatedResources) GetResourcesByLabels(_ *unstructured.Unstructured, group string, kind string, labels map[string]string) ([]RelatedResource, error) {
+	if group != "" || kind != "Pod" {
+		return nil, fmt.Errorf("unexpected group kind %s/%s", group, kind)
+	}
+	f.labels = labels
+	return f.byLabels, nil
+}
+
+func TestRelatedResources(t *testing.T) {
+	const ownedScript = `
+hs = {status = "Healthy", message = ""}
+for i, res in ipairs(getOwnedResources()) do
+  if res.health ~= nil and res.health.status ~= "Healthy" then
+    hs.status = res.health.status
+    hs.message = res.object.metadata.name .. ": " .. res.health.message
+  end
+end
+return hs`
+
+	const byLabelsScript = `
+hs = {status = "Progressing", message = ""}
+pods = getResourcesByLabels("", "Pod", {app = obj.metadata.name})
+hs.message = #pods .. " pods"
+if #pods > 0 then
+  hs.status = "Healthy"
+end
+return hs`
+
+	pod := func(name string) *unstructured.Unstructured {
+		return StrToUnstructured(fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: %s
+  namespace: default`, name))
+	}
+	relatedResources := &fakeRelatedResources{
+		owned: []RelatedResource{
+			{Object: pod("pod-1"), Health: &health.HealthStatus{Status: health.HealthStatusHealthy}},
+			{Object: pod("pod-2"), Health: &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "CrashLoopBackOff"}},
+			{Object: pod("pod-3")},
+		},
+		byLabels: []RelatedResource{{Object: pod("pod-1")}},
+	}
+
+	t.Run("OwnedResources", func(t *testing.T) {
+		vm := VM{RelatedResources: relatedResources}
+		status, err := vm.ExecuteHealthLua(StrToUnstructured(objJSON), ownedScript)
+		assert.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "pod-2: CrashLoopBackOff"}, status)
+	})
+
+	t.Run("ResourcesByLabels", func(t *testing.T) {
+		vm := VM{RelatedResources: relatedResources}
+		status, err := vm.ExecuteHealthLua(StrToUnstructured(objJSON), byLabelsScript)
+		assert.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "1 pods"}, status)
+		assert.Equal(t, map[string]string{"app": "helm-guestbook"}, relatedResources.labels)
+	})
+
+	t.Run("HealthOverrides", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{"argoproj.io/Rollout": appv1.ResourceOverride{HealthLua: ownedScript}}
+		status, err := overrides.WithRelatedResources(relatedResources).GetResourceHealth(StrToUnstructured(objJSON))
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, status.Status)
+	})
+
+	t.Run("NotAvailable", func(t *testing.T) {
+		vm := VM{}
+		_, err := vm.ExecuteHealthLua(StrToUnstructured(objJSON), ownedScript)
+		assert.ErrorContains(t, err, "related resources are not available")
+	})
+
+	t.Run("TooManyLookups", func(t *testing.T) {
+		vm := VM{RelatedResources: relatedResources, MaxRelatedResourceLookups: 1}
+		_, err := vm.ExecuteHealthLua(StrToUnstructured(objJSON), "getOwnedResources()\n"+ownedScript)
+		assert.ErrorContains(t, err, "at most 1 times")
+	})
+
+	t.Run("TooManyResources", func(t *testing.T) {
+		vm := VM{RelatedResources: relatedResources, MaxRelatedResources: 2}
+		_, err := vm.ExecuteHealthLua(StrToUnstructured(objJSON), ownedScript)
+		assert.ErrorContains(t, err, "at most 2 related resources")
+	})
+}
+
+func TestValidateResourceOverrides(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		err := ValidateResourceOverrides(map[string]appv1.ResourceOverride{
+			"apps/Deployment": {
+				HealthLua: `hs = {}
+hs.status = "Healthy"
+return hs`,
+				Actions: `discovery.lua: |
+  return {restart = {}}
+definitions:
+- name: restart
+  action.lua: |
+    return obj
+`,
+			},
+		})
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidHealthScript", func(t *testing.T) {
+		err := ValidateResourceOverrides(map[string]appv1.ResourceOverride{
+			"apps/Deployment": {HealthLua: `if obj.status then return {} `},
+		})
+		assert.ErrorContains(t, err, "invalid health script of 'apps/Deployment'")
+	})
+	t.Run("InvalidActionScript", func(t *testing.T) {
+		err := ValidateResourceOverrides(map[string]appv1.ResourceOverride{
+			"apps/Deployment": {Actions: `definitions:
+- name: restart
+  action.lua: |
+    return obj)
+`},
+		})
+		assert.ErrorContains(t, err, "invalid script of action 'restart' of 'apps/Deployment'")
+	})
+	t.Run("InvalidHealthAggregation", func(t *testing.T) {
+		err := ValidateResourceOverrides(map[string]appv1.ResourceOverride{
+			"example.com/Umbrella": {HealthAggregation: &appv1.HealthAggregation{Strategy: appv1.HealthAggregationStrategyQuorum}},
+		})
+		assert.ErrorContains(t, err, "invalid health aggregation of 'example.com/Umbrella': the quorum strategy requires a quorum")
+	})
+}
@@ -0,0 +1,115 @@
+package lua
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// DefaultMaxRelatedResourceLookups is the default maximum number of lookups of related resources per script run
+	DefaultMaxRelatedResourceLookups = 5
+	// DefaultMaxRelatedResources is the default maximum number of related resources returned to a script run
+	DefaultMaxRelatedResources = 100
+)
+
+// RelatedResource is a resource related to the resource a script is run against
+type RelatedResource struct {
+	// Object is the resource. Only its metadata is available if its whole manifest is not cached.
+	Object *unstructured.Unstructured
+	// Health is the health of the resource, if it has any
+	Health *health.HealthStatus
+}
+
+// RelatedResourcesGetter returns the resources related to the resource a script is run against
+type RelatedResourcesGetter interface {
+	// GetOwnedResources returns the resources owned by the given resource
+	GetOwnedResources(obj *unstructured.Unstructured) ([]RelatedResource, error)
+	// GetResourcesByLabels returns the resources of the given group and kind, in the namespace of the given resource,
+	// which have the given labels
+	GetResourcesByLabels(obj *unstructured.Unstructured, group string, kind string, labels map[string]string) ([]RelatedResource, error)
+}
+
+// relatedResourcesQuota bounds the cost of the lookups of related resources made by a script run
+type relatedResourcesQuota struct {
+	maxLookups   int
+	maxResources int
+	lookups      int
+	resources    int
+}
+
+func (q *relatedResourcesQuota) acquire(resources int) error {
+	q.lookups++
+	if q.lookups > q.maxLookups {
+		return fmt.Errorf("the scripts may look up related resources at most %d times", q.maxLookups)
+	}
+	q.resources += resources
+	if q.resources > q.maxResources {
+		return fmt.Errorf("the scripts may access at most %d related resources", q.maxResources)
+	}
+	return nil
+}
+
+// setRelatedResourcesFunctions sets the global functions giving the script access to the resources related to obj:
+// getOwnedResources() and getResourcesByLabels(group, kind, labels). Both return a list of tables with the object and
+// the health of each related resource, and raise an error if the related resources are not available.
+func (vm VM) setRelatedResourcesFunctions(l *lua.LState, obj *unstructured.Unstructured) {
+	maxLookups := vm.MaxRelatedResourceLookups
+	if maxLookups == 0 {
+		maxLookups = DefaultMaxRelatedResourceLookups
+	}
+	maxResources := vm.MaxRelatedResources
+	if maxResources == 0 {
+		maxResources = DefaultMaxRelatedResources
+	}
+	quota := &relatedResourcesQuota{maxLookups: maxLookups, maxResources: maxResources}
+
+	lookup := func(l *lua.LState, get func() ([]RelatedResource, error)) int {
+		if vm.RelatedResources == nil {
+			l.RaiseError("related resources are not available")
+			return 0
+		}
+		resources, err := get()
+		if err != nil {
+			l.RaiseError("failed to get related resources: %s", err.Error())
+			return 0
+		}
+		if err := quota.acquire(len(resources)); err != nil {
+			l.RaiseError(err.Error())
+			return 0
+		}
+		l.Push(decodeValue(l, relatedResourcesToValue(resources)))
+		return 1
+	}
+
+	l.SetGlobal("getOwnedResources", l.NewFunction(func(l *lua.LState) int {
+		return lookup(l, func() ([]RelatedResource, error) {
+			return vm.RelatedResources.GetOwnedResources(obj)
+		})
+	}))
+	l.SetGlobal("getResourcesByLabels", l.NewFunction(func(l *lua.LState) int {
+		group := l.CheckString(1)
+		kind := l.CheckString(2)
+		labels := make(map[string]string)
+		l.CheckTable(3).ForEach(func(key lua.LValue, value lua.LValue) {
+			labels[key.String()] = value.String()
+		})
+		return lookup(l, func() ([]RelatedResource, error) {
+			return vm.RelatedResources.GetResourcesByLabels(obj, group, kind, labels)
+		})
+	}))
+}
+
+func relatedResourcesToValue(resources []RelatedResource) []interface{} {
+	values := make([]interface{}, len(resources))
+	for i, res := range resources {
+		value := map[string]interface{}{"object": res.Object.Object}
+		if res.Health != nil {
+			value["health"] = map[string]interface{}{"status": string(res.Health.Status), "message": res.Health.Message}
+		}
+		values[i] = value
+	}
+	return values
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/util/env"
+	tlsutil "github.com/argoproj/argo-cd/v2/util/tls"
+)
+
+// AuthOptions configures the authorization of the requests made to a metrics endpoint. The endpoint is not protected
+// if no option is set. If several options are set, the requests must satisfy all of them.
+type AuthOptions struct {
+	// BearerTokenFile is the path of the file holding the bearer token the requests must present. The file is read on
+	// every request, so that the token can be rotated without restart.
+	BearerTokenFile string
+	// ClientCAFile is the path of the PEM encoded CA the client certificates of the requests must be signed by. Setting
+	// it serves the endpoint with TLS.
+	ClientCAFile string
+	// TLSCertFile is the path of the certificate the endpoint is served with when ClientCAFile is set. A self-signed
+	// certificate is generated if the certificate or the key is missing.
+	TLSCertFile string
+	// TLSKeyFile is the path of the key of TLSCertFile
+	TLSKeyFile string
+}
+
+// AddAuthFlagsToCmd adds the flags configuring the authorization of the requests made to the metrics endpoint of the
+// command, whose defaults are read from the environment variables with the given prefix
+func AddAuthFlagsToCmd(cmd *cobra.Command, envPrefix string) *AuthOptions {
+	opts := AuthOptions{}
+	cmd.Flags().StringVar(&opts.BearerTokenFile, "metrics-bearer-token-file", env.StringFromEnv(envPrefix+"_METRICS_BEARER_TOKEN_FILE", ""), "Path of the file holding the bearer token the requests made to the metrics endpoint must present (disabled by default)")
+	cmd.Flags().StringVar(&opts.ClientCAFile, "metrics-client-ca-file", env.StringFromEnv(envPrefix+"_METRICS_CLIENT_CA_FILE", ""), "Path of the CA the client certificates of the requests made to the metrics endpoint must be signed by. Setting it serves the metrics endpoint with TLS (disabled by default)")
+	cmd.Flags().StringVar(&opts.TLSCertFile, "metrics-tls-cert-file", env.StringFromEnv(envPrefix+"_METRICS_TLS_CERT_FILE", ""), "Path of the certificate the metrics endpoint is served with when --metrics-client-ca-file is set (a self-signed certificate is generated by default)")
+	cmd.Flags().StringVar(&opts.TLSKeyFile, "metrics-tls-key-file", env.StringFromEnv(envPrefix+"_METRICS_TLS_KEY_FILE", ""), "Path of the key of --metrics-tls-cert-file")
+	return &opts
+}
+
+// Enabled returns whether the requests made to the metrics endpoint must be authorized
+func (o *AuthOptions) Enabled() bool {
+	return o != nil && (o.BearerTokenFile != "" || o.ClientCAFile != "")
+}
+
+// TLSConfig returns the TLS config the metrics endpoint is served with, or nil if it is served without TLS
+func (o *AuthOptions) TLSConfig() (*tls.Config, error) {
+	if o == nil || o.ClientCAFile == "" {
+		return nil, nil
+	}
+	caPEM, err := os.ReadFile(o.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the client CA: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificate found in the client CA %s", o.ClientCAFile)
+	}
+	tlsConfig, err := tlsutil.CreateServerTLSConfig(o.TLSCertFile, o.TLSKeyFile, []string{"localhost"})
+	if err != nil {
+		return nil, fmt.Errorf("error creating the TLS config of the metrics endpoint: %w", err)
+	}
+	tlsConfig.ClientCAs = clientCAs
+	// the certificates are required by the handler, so that the health checks do not need one
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	return tlsConfig, nil
+}
+
+// Handler returns a handler which requires the requests to be authorized before passing them to the given handler,
+// except the requests made to the given public paths, such as the health checks
+func (o *AuthOptions) Handler(next http.Handler, publicPaths ...string) http.Handler {
+	if !o.Enabled() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, path := range publicPaths {
+			if r.URL.Path == path {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+		if err := o.authorize(r); err != nil {
+			log.Debugf("Unauthorized request to the metrics endpoint from %s: %v", r.RemoteAddr, err)
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (o *AuthOptions) authorize(r *http.Request) error {
+	if o.ClientCAFile != "" && (r.TLS == nil || len(r.TLS.VerifiedChains) == 0) {
+		return fmt.Errorf("no verified client certificate")
+	}
+	if o.BearerTokenFile != "" {
+		expected, err := os.ReadFile(o.BearerTokenFile)
+		if err != nil {
+			return fmt.Errorf("error reading the bearer token: %w", err)
+		}
+		expectedToken := strings.TrimSpace(string(expected))
+		header := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(header, "Bearer ")
+		if token == header || expectedToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expectedToken)) != 1 {
+			return fmt.Errorf("invalid bearer token")
+		}
+	}
+	return nil
+}
+
+// ListenAndServe serves the given metrics server, with TLS if its TLS config is set
+func ListenAndServe(server *http.Server) error {
+	if server.TLSConfig != nil {
+		return server.ListenAndServeTLS("", "")
+	}
+	return server.ListenAndServe()
+}
@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandler(t *testing.T, opts *AuthOptions) http.Handler {
+	t.Helper()
+	return opts.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), "/healthz")
+}
+
+func serve(handler http.Handler, path string, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr
+}
+
+func TestAuthOptions_Handler(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0600))
+	handler := newTestHandler(t, &AuthOptions{BearerTokenFile: tokenFile})
+
+	t.Run("ValidToken", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(handler, "/metrics", "secret").Code)
+	})
+	t.Run("InvalidToken", func(t *testing.T) {
+		rr := serve(handler, "/metrics", "wrong")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+	})
+	t.Run("MissingToken", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, serve(handler, "/metrics", "").Code)
+	})
+	t.Run("PublicPath", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve(handler, "/healthz", "").Code)
+	})
+	t.Run("RotatedToken", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(tokenFile, []byte("rotated"), 0600))
+		assert.Equal(t, http.StatusUnauthorized, serve(handler, "/metrics", "secret").Code)
+		assert.Equal(t, http.StatusOK, serve(handler, "/metrics", "rotated").Code)
+	})
+	t.Run("EmptyTokenFile", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(tokenFile, nil, 0600))
+		assert.Equal(t, http.StatusUnauthorized, serve(handler, "/metrics", "").Code)
+	})
+}
+
+func TestAuthOptions_HandlerClientCertificate(t *testing.T) {
+	handler := newTestHandler(t, &AuthOptions{ClientCAFile: "ca.crt"})
+	assert.Equal(t, http.StatusUnauthorized, serve(handler, "/metrics", "").Code)
+	assert.Equal(t, http.StatusOK, serve(handler, "/healthz", "").Code)
+}
+
+func TestAuthOptions_Disabled(t *testing.T) {
+	var opts *AuthOptions
+	assert.False(t, opts.Enabled())
+	tlsConfig, err := opts.TLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+	assert.Equal(t, http.StatusOK, serve(newTestHandler(t, &AuthOptions{}), "/metrics", "").Code)
+}
+
+func TestAuthOptions_TLSConfig(t *testing.T) {
+	_, err := (&AuthOptions{ClientCAFile: filepath.Join(t.TempDir(), "missing")}).TLSConfig()
+	assert.ErrorContains(t, err, "error reading the client CA")
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0600))
+	_, err = (&AuthOptions{ClientCAFile: caFile}).TLSConfig()
+	assert.ErrorContains(t, err, "no certificate found")
+}
@@ -0,0 +1,153 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+// LabelRule designates a label dropped from the series of the metrics matching a glob pattern
+type LabelRule struct {
+	// Metric is the glob pattern of the names of the metrics the label is dropped from, all the metrics if empty
+	Metric string
+	// Label is the name of the dropped label
+	Label string
+}
+
+// AddDropLabelsFlagToCmd adds the flag designating the labels dropped from the series exported by the metrics endpoint
+// of the command, whose default is read from the environment variable with the given prefix
+func AddDropLabelsFlagToCmd(cmd *cobra.Command, envPrefix string) *[]string {
+	var rules []string
+	cmd.Flags().StringSliceVar(&rules, "metrics-drop-labels", env.StringsFromEnv(envPrefix+"_METRICS_DROP_LABELS", []string{}, ","), "List of labels dropped from the series exported by the metrics endpoint, of the form [<metric>:]<label> where <metric> is a glob pattern, e.g. argocd_app_*:name. The series left with the same labels are aggregated")
+	return &rules
+}
+
+// ParseLabelRules parses rules of the form [<metric>:]<label>, e.g. revision or argocd_app_*:name
+func ParseLabelRules(rules []string) ([]LabelRule, error) {
+	var res []LabelRule
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		// metric names can contain colons but label names cannot
+		var labelRule LabelRule
+		if i := strings.LastIndex(rule, ":"); i >= 0 {
+			labelRule = LabelRule{Metric: rule[:i], Label: rule[i+1:]}
+		} else {
+			labelRule = LabelRule{Label: rule}
+		}
+		if labelRule.Label == "" {
+			return nil, fmt.Errorf("invalid label rule %q: the label is missing", rule)
+		}
+		res = append(res, labelRule)
+	}
+	return res, nil
+}
+
+func (r LabelRule) matches(metric string, label string) bool {
+	return r.Label == label && (r.Metric == "" || glob.Match(r.Metric, metric))
+}
+
+// DropLabels returns the given metric families without the labels designated by the given rules. The series left with
+// the same labels are aggregated: the values of the counters and gauges are summed, as well as the counts, sums and
+// buckets of the histograms and the counts and sums of the summaries, whose quantiles cannot be aggregated and are
+// dropped.
+func DropLabels(families []*dto.MetricFamily, rules []LabelRule) []*dto.MetricFamily {
+	if len(rules) == 0 {
+		return families
+	}
+	for _, family := range families {
+		dropped := false
+		for _, metric := range family.Metric {
+			var labels []*dto.LabelPair
+			for _, label := range metric.Label {
+				if dropLabel(family.GetName(), label.GetName(), rules) {
+					dropped = true
+				} else {
+					labels = append(labels, label)
+				}
+			}
+			metric.Label = labels
+		}
+		if dropped {
+			family.Metric = aggregate(family.GetType(), family.Metric)
+		}
+	}
+	return families
+}
+
+func dropLabel(metric string, label string, rules []LabelRule) bool {
+	for _, rule := range rules {
+		if rule.matches(metric, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregate merges the series with the same labels, keeping the order of their first occurrence
+func aggregate(metricType dto.MetricType, metrics []*dto.Metric) []*dto.Metric {
+	var res []*dto.Metric
+	byLabels := map[string]*dto.Metric{}
+	for _, metric := range metrics {
+		key := labelsKey(metric.Label)
+		existing, ok := byLabels[key]
+		if !ok {
+			metric = proto.Clone(metric).(*dto.Metric)
+			if metricType == dto.MetricType_SUMMARY && metric.Summary != nil {
+				metric.Summary.Quantile = nil
+			}
+			byLabels[key] = metric
+			res = append(res, metric)
+			continue
+		}
+		merge(existing, metric)
+	}
+	return res
+}
+
+func labelsKey(labels []*dto.LabelPair) string {
+	pairs := make([]string, 0, len(labels))
+	for _, label := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", label.GetName(), label.GetValue()))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func merge(dst *dto.Metric, src *dto.Metric) {
+	switch {
+	case dst.Counter != nil && src.Counter != nil:
+		dst.Counter.Value = proto.Float64(dst.Counter.GetValue() + src.Counter.GetValue())
+	case dst.Gauge != nil && src.Gauge != nil:
+		dst.Gauge.Value = proto.Float64(dst.Gauge.GetValue() + src.Gauge.GetValue())
+	case dst.Untyped != nil && src.Untyped != nil:
+		dst.Untyped.Value = proto.Float64(dst.Untyped.GetValue() + src.Untyped.GetValue())
+	case dst.Histogram != nil && src.Histogram != nil:
+		dst.Histogram.SampleCount = proto.Uint64(dst.Histogram.GetSampleCount() + src.Histogram.GetSampleCount())
+		dst.Histogram.SampleSum = proto.Float64(dst.Histogram.GetSampleSum() + src.Histogram.GetSampleSum())
+		buckets := map[float64]*dto.Bucket{}
+		for _, bucket := range dst.Histogram.Bucket {
+			buckets[bucket.GetUpperBound()] = bucket
+		}
+		for _, bucket := range src.Histogram.Bucket {
+			if existing, ok := buckets[bucket.GetUpperBound()]; ok {
+				existing.CumulativeCount = proto.Uint64(existing.GetCumulativeCount() + bucket.GetCumulativeCount())
+			}
+		}
+	case dst.Summary != nil && src.Summary != nil:
+		dst.Summary.SampleCount = proto.Uint64(dst.Summary.GetSampleCount() + src.Summary.GetSampleCount())
+		dst.Summary.SampleSum = proto.Float64(dst.Summary.GetSampleSum() + src.Summary.GetSampleSum())
+	}
+	if src.GetTimestampMs() > dst.GetTimestampMs() {
+		dst.TimestampMs = src.TimestampMs
+	}
+}
@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLabels(pairs ...string) []*dto.LabelPair {
+	var labels []*dto.LabelPair
+	for i := 0; i < len(pairs); i += 2 {
+		labels = append(labels, &dto.LabelPair{Name: proto.String(pairs[i]), Value: proto.String(pairs[i+1])})
+	}
+	return labels
+}
+
+func newCounter(value float64, pairs ...string) *dto.Metric {
+	return &dto.Metric{Label: newLabels(pairs...), Counter: &dto.Counter{Value: proto.Float64(value)}}
+}
+
+func newHistogram(count uint64, sum float64, buckets []uint64, pairs ...string) *dto.Metric {
+	histogram := &dto.Histogram{SampleCount: proto.Uint64(count), SampleSum: proto.Float64(sum)}
+	for i, bucket := range buckets {
+		histogram.Bucket = append(histogram.Bucket, &dto.Bucket{UpperBound: proto.Float64(float64(i + 1)), CumulativeCount: proto.Uint64(bucket)})
+	}
+	return &dto.Metric{Label: newLabels(pairs...), Histogram: histogram}
+}
+
+func labelNames(metric *dto.Metric) []string {
+	var names []string
+	for _, label := range metric.Label {
+		names = append(names, label.GetName())
+	}
+	return names
+}
+
+func TestParseLabelRules(t *testing.T) {
+	rules, err := ParseLabelRules([]string{"revision", " argocd_app_*:name ", "", "job:rate5m:name"})
+	require.NoError(t, err)
+	assert.Equal(t, []LabelRule{
+		{Label: "revision"},
+		{Metric: "argocd_app_*", Label: "name"},
+		{Metric: "job:rate5m", Label: "name"},
+	}, rules)
+
+	_, err = ParseLabelRules([]string{"argocd_app_info:"})
+	assert.ErrorContains(t, err, "the label is missing")
+}
+
+func TestDropLabels(t *testing.T) {
+	families := []*dto.MetricFamily{{
+		Name: proto.String("argocd_app_sync_total"),
+		Type: dto.MetricType_COUNTER.Enum(),
+		Metric: []*dto.Metric{
+			newCounter(1, "name", "guestbook", "project", "default", "phase", "Succeeded"),
+			newCounter(2, "name", "billing", "project", "default", "phase", "Succeeded"),
+			newCounter(4, "name", "billing", "project", "default", "phase", "Failed"),
+			newCounter(8, "name", "secret", "project", "internal", "phase", "Succeeded"),
+		},
+	}, {
+		Name: proto.String("argocd_app_reconcile"),
+		Type: dto.MetricType_HISTOGRAM.Enum(),
+		Metric: []*dto.Metric{
+			newHistogram(2, 1.5, []uint64{1, 2}, "name", "guestbook", "namespace", "argocd"),
+			newHistogram(3, 4, []uint64{0, 3}, "name", "billing", "namespace", "argocd"),
+		},
+	}, {
+		Name:   proto.String("argocd_cluster_info"),
+		Type:   dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{{Label: newLabels("name", "in-cluster"), Gauge: &dto.Gauge{Value: proto.Float64(1)}}},
+	}}
+
+	families = DropLabels(families, []LabelRule{{Metric: "argocd_app_*", Label: "name"}})
+
+	syncs := families[0].Metric
+	require.Len(t, syncs, 3)
+	assert.Equal(t, []string{"project", "phase"}, labelNames(syncs[0]))
+	assert.Equal(t, 3.0, syncs[0].Counter.GetValue())
+	assert.Equal(t, 4.0, syncs[1].Counter.GetValue())
+	assert.Equal(t, 8.0, syncs[2].Counter.GetValue())
+
+	reconciles := families[1].Metric
+	require.Len(t, reconciles, 1)
+	assert.Equal(t, uint64(5), reconciles[0].Histogram.GetSampleCount())
+	assert.Equal(t, 5.5, reconciles[0].Histogram.GetSampleSum())
+	assert.Equal(t, uint64(1), reconciles[0].Histogram.Bucket[0].GetCumulativeCount())
+	assert.Equal(t, uint64(5), reconciles[0].Histogram.Bucket[1].GetCumulativeCount())
+
+	// the metrics not matching the rules are kept
+	assert.Equal(t, []string{"name"}, labelNames(families[2].Metric[0]))
+}
+
+func TestDropLabels_Summary(t *testing.T) {
+	newSummary := func(count uint64, sum float64, name string) *dto.Metric {
+		return &dto.Metric{Label: newLabels("name", name), Summary: &dto.Summary{
+			SampleCount: proto.Uint64(count),
+			SampleSum:   proto.Float64(sum),
+			Quantile:    []*dto.Quantile{{Quantile: proto.Float64(0.5), Value: proto.Float64(sum / float64(count))}},
+		}}
+	}
+	families := DropLabels([]*dto.MetricFamily{{
+		Name:   proto.String("request_duration"),
+		Type:   dto.MetricType_SUMMARY.Enum(),
+		Metric: []*dto.Metric{newSummary(1, 1, "a"), newSummary(2, 6, "b")},
+	}}, []LabelRule{{Label: "name"}})
+
+	require.Len(t, families[0].Metric, 1)
+	summary := families[0].Metric[0].Summary
+	assert.Equal(t, uint64(3), summary.GetSampleCount())
+	assert.Equal(t, 7.0, summary.GetSampleSum())
+	assert.Empty(t, summary.Quantile)
+}
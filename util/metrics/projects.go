@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+// projectLabel is the label holding the project of the applications in the series of the application metrics
+const projectLabel = "project"
+
+// FilterProjects returns the given metric families without the series whose project label does not match any of the
+// given projects, which can be glob patterns. The series without project label are kept. All the series are kept if
+// no project is given.
+func FilterProjects(families []*dto.MetricFamily, projects []string) []*dto.MetricFamily {
+	if len(projects) == 0 {
+		return families
+	}
+	var res []*dto.MetricFamily
+	for _, family := range families {
+		var metrics []*dto.Metric
+		for _, metric := range family.Metric {
+			if projectAllowed(metric, projects) {
+				metrics = append(metrics, metric)
+			}
+		}
+		if len(metrics) > 0 {
+			family.Metric = metrics
+			res = append(res, family)
+		}
+	}
+	return res
+}
+
+func projectAllowed(metric *dto.Metric, projects []string) bool {
+	for _, label := range metric.Label {
+		if label.GetName() != projectLabel {
+			continue
+		}
+		for _, project := range projects {
+			if glob.Match(project, label.GetValue()) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func newMetric(labels map[string]string) *dto.Metric {
+	metric := &dto.Metric{}
+	for name, value := range labels {
+		metric.Label = append(metric.Label, &dto.LabelPair{Name: proto.String(name), Value: proto.String(value)})
+	}
+	return metric
+}
+
+func newFamilies() []*dto.MetricFamily {
+	return []*dto.MetricFamily{{
+		Name: proto.String("argocd_app_info"),
+		Metric: []*dto.Metric{
+			newMetric(map[string]string{"name": "guestbook", "project": "default"}),
+			newMetric(map[string]string{"name": "billing", "project": "team-billing"}),
+			newMetric(map[string]string{"name": "secret", "project": "internal"}),
+		},
+	}, {
+		Name:   proto.String("argocd_cluster_info"),
+		Metric: []*dto.Metric{newMetric(map[string]string{"server": "https://kubernetes.default.svc"})},
+	}, {
+		Name:   proto.String("argocd_app_sync_total"),
+		Metric: []*dto.Metric{newMetric(map[string]string{"name": "secret", "project": "internal"})},
+	}}
+}
+
+func appNames(family *dto.MetricFamily) []string {
+	var names []string
+	for _, metric := range family.Metric {
+		for _, label := range metric.Label {
+			if label.GetName() == "name" {
+				names = append(names, label.GetValue())
+			}
+		}
+	}
+	return names
+}
+
+func TestFilterProjects(t *testing.T) {
+	t.Run("NoProjects", func(t *testing.T) {
+		families := FilterProjects(newFamilies(), nil)
+		assert.Len(t, families, 3)
+		assert.Len(t, families[0].Metric, 3)
+	})
+
+	t.Run("Projects", func(t *testing.T) {
+		families := FilterProjects(newFamilies(), []string{"default", "team-*"})
+		if assert.Len(t, families, 2) {
+			assert.Equal(t, "argocd_app_info", families[0].GetName())
+			assert.Equal(t, []string{"guestbook", "billing"}, appNames(families[0]))
+			// the series without project are kept
+			assert.Equal(t, "argocd_cluster_info", families[1].GetName())
+		}
+	})
+}
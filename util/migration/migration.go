@@ -0,0 +1,237 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// HistorySecretName is the name of the secret recording the migrations applied to the Argo CD installation
+	HistorySecretName = "argocd-migration-history"
+	// LeaseName is the name of the lease held by the replica applying the migrations
+	LeaseName = "argocd-migration"
+)
+
+// GetHistorySecretName returns the name of the secret recording the migrations applied to the Argo CD instance
+func GetHistorySecretName() string {
+	return common.InstanceResourceName(HistorySecretName)
+}
+
+// GetLeaseName returns the name of the lease held by the replica applying the migrations to the Argo CD instance
+func GetLeaseName() string {
+	return common.InstanceResourceName(LeaseName)
+}
+
+// Migration is a versioned change of the configuration or of the state stored by Argo CD, which is applied once when
+// Argo CD is upgraded to a version introducing it
+type Migration struct {
+	// Version orders the migrations, which are applied in increasing order of version
+	Version int
+	// Name describes the migration
+	Name string
+	// Migrate applies the migration. As a migration interrupted before being recorded is applied again, it must be
+	// idempotent.
+	Migrate func(ctx context.Context, c *Context) error
+}
+
+// Context holds the clients the migrations are applied with
+type Context struct {
+	// Namespace is the namespace of the Argo CD installation
+	Namespace string
+	// ApplicationNamespaces are the additional namespaces of the applications
+	ApplicationNamespaces []string
+	KubeClientset         kubernetes.Interface
+	AppClientset          appclientset.Interface
+	SettingsMgr           *settings.SettingsManager
+}
+
+// Record is the entry of the migration history of an applied migration
+type Record struct {
+	Version       int         `json:"version"`
+	Name          string      `json:"name"`
+	AppliedAt     metav1.Time `json:"appliedAt"`
+	ArgoCDVersion string      `json:"argocdVersion"`
+}
+
+// Migrator applies the pending migrations and records them in the migration history
+type Migrator struct {
+	context    *Context
+	migrations []Migration
+}
+
+// NewMigrator returns a migrator of the given migrations
+func NewMigrator(c *Context, migrations []Migration) *Migrator {
+	migrations = append([]Migration{}, migrations...)
+	sort.SliceStable(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return &Migrator{context: c, migrations: migrations}
+}
+
+// Migrations returns the known migrations in increasing order of version
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// History returns the records of the applied migrations in increasing order of version
+func (m *Migrator) History(ctx context.Context) ([]Record, error) {
+	secret, err := m.context.KubeClientset.CoreV1().Secrets(m.context.Namespace).Get(ctx, GetHistorySecretName(), metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting migration history: %w", err)
+	}
+	var history []Record
+	for key, data := range secret.Data {
+		var record Record
+		if err := json.Unmarshal(data, &record); err != nil {
+			return nil, fmt.Errorf("invalid record %s of migration history: %w", key, err)
+		}
+		history = append(history, record)
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Version < history[j].Version
+	})
+	return history, nil
+}
+
+// Pending returns the migrations which are not recorded in the migration history, in increasing order of version
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	history, err := m.History(ctx)
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[int]bool)
+	for _, record := range history {
+		applied[record.Version] = true
+	}
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate applies the pending migrations in increasing order of version, recording each one once applied. It stops at
+// the first failed migration, which is applied again by the next call.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	for _, migration := range pending {
+		log.Infof("Applying migration %d (%s)", migration.Version, migration.Name)
+		if err := migration.Migrate(ctx, m.context); err != nil {
+			return fmt.Errorf("error applying migration %d (%s): %w", migration.Version, migration.Name, err)
+		}
+		if err := m.record(ctx, migration); err != nil {
+			return err
+		}
+		log.Infof("Applied migration %d (%s)", migration.Version, migration.Name)
+	}
+	return nil
+}
+
+// record adds the given migration to the migration history
+func (m *Migrator) record(ctx context.Context, migration Migration) error {
+	data, err := json.Marshal(Record{
+		Version:       migration.Version,
+		Name:          migration.Name,
+		AppliedAt:     metav1.Now(),
+		ArgoCDVersion: common.GetVersion().Version,
+	})
+	if err != nil {
+		return err
+	}
+	secrets := m.context.KubeClientset.CoreV1().Secrets(m.context.Namespace)
+	key := strconv.Itoa(migration.Version)
+	secret, err := secrets.Get(ctx, GetHistorySecretName(), metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = secrets.Create(ctx, &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   GetHistorySecretName(),
+				Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+			Data: map[string][]byte{key: data},
+		}, metav1.CreateOptions{})
+	} else if err == nil {
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[key] = data
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("error recording migration %d (%s): %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// MigrateWithLeaderElection applies the pending migrations once the given lock is acquired, so that a single replica
+// of the Argo CD components applies them while the other ones wait for it. It returns once the migrations are applied,
+// by this replica or by another one, and releases the lock right away.
+func (m *Migrator) MigrateWithLeaderElection(ctx context.Context, lock resourcelock.Interface, leaseDuration, renewDeadline, retryPeriod time.Duration) error {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var migrateErr error
+	done := false
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            lock.Describe(),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				migrateErr = m.Migrate(leaderCtx)
+				done = true
+				cancel()
+			},
+			OnStoppedLeading: func() {},
+			OnNewLeader: func(leader string) {
+				if leader != lock.Identity() {
+					log.Infof("Replica %s is applying the migrations, waiting", leader)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating leader elector: %w", err)
+	}
+	elector.Run(ctx)
+	if migrateErr != nil {
+		return migrateErr
+	}
+	if !done {
+		return ctx.Err()
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appsfake "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testNamespace = "argocd"
+
+func newTestContext(t *testing.T, kubeObjects []runtime.Object, appObjects ...runtime.Object) *Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	kubeClientset := fake.NewSimpleClientset(kubeObjects...)
+	return &Context{
+		Namespace:     testNamespace,
+		KubeClientset: kubeClientset,
+		AppClientset:  appsfake.NewSimpleClientset(appObjects...),
+		SettingsMgr:   settings.NewSettingsManager(ctx, kubeClientset, testNamespace),
+	}
+}
+
+func TestMigrator_Migrate(t *testing.T) {
+	c := newTestContext(t, nil)
+	var applied []int
+	migrate := func(version int, err error) Migration {
+		return Migration{Version: version, Name: "test", Migrate: func(ctx context.Context, c *Context) error {
+			if err == nil {
+				applied = append(applied, version)
+			}
+			return err
+		}}
+	}
+
+	migrator := NewMigrator(c, []Migration{migrate(2, nil), migrate(1, nil)})
+	require.NoError(t, migrator.Migrate(context.Background()))
+	assert.Equal(t, []int{1, 2}, applied)
+
+	history, err := migrator.History(context.Background())
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 1, history[0].Version)
+	assert.Equal(t, 2, history[1].Version)
+
+	// applied migrations are not applied again
+	applied = nil
+	migrator = NewMigrator(c, []Migration{migrate(1, nil), migrate(2, nil), migrate(3, errors.New("boom")), migrate(4, nil)})
+	err = migrator.Migrate(context.Background())
+	assert.EqualError(t, err, "error applying migration 3 (test): boom")
+	assert.Empty(t, applied)
+
+	pending, err := migrator.Pending(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, 3, pending[0].Version)
+	assert.Equal(t, 4, pending[1].Version)
+}
+
+func TestLabelConfigMapsAndSecrets(t *testing.T) {
+	c := newTestContext(t, []runtime.Object{
+		&apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace}},
+		&apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace}},
+		&apiv1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: testNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		}},
+		&apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: testNamespace}},
+	})
+	ctx := context.Background()
+	require.NoError(t, labelConfigMapsAndSecrets(ctx, c))
+
+	cm, err := c.KubeClientset.CoreV1().ConfigMaps(testNamespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "argocd", cm.Labels["app.kubernetes.io/part-of"])
+	for _, name := range []string{common.ArgoCDSecretName, "my-cluster"} {
+		secret, err := c.KubeClientset.CoreV1().Secrets(testNamespace).Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "argocd", secret.Labels["app.kubernetes.io/part-of"], name)
+	}
+	secret, err := c.KubeClientset.CoreV1().Secrets(testNamespace).Get(ctx, "unrelated", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, secret.Labels)
+}
+
+func TestMoveLegacyRepositoriesToSecrets(t *testing.T) {
+	c := newTestContext(t, []runtime.Object{
+		&apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data: map[string]string{
+				"repositories": "- url: https://github.com/argoproj/argocd-example-apps\n  passwordSecret:\n    name: repo-secret\n    key: password\n",
+			},
+		},
+		&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "repo-secret", Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		},
+		&apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		},
+	})
+	ctx := context.Background()
+	require.NoError(t, moveLegacyRepositoriesToSecrets(ctx, c))
+
+	cm, err := c.KubeClientset.CoreV1().ConfigMaps(testNamespace).Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, cm.Data, "repositories")
+
+	secrets, err := c.KubeClientset.CoreV1().Secrets(testNamespace).List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeySecretType + "=" + common.LabelValueSecretTypeRepository})
+	require.NoError(t, err)
+	require.Len(t, secrets.Items, 1)
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", string(secrets.Items[0].Data["url"]))
+	assert.Equal(t, "secret", string(secrets.Items[0].Data["password"]))
+}
+
+func TestMoveObservedAtToReconciledAt(t *testing.T) {
+	observedAt := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	reconciledAt := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	c := newTestContext(t, nil,
+		&v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "observed", Namespace: testNamespace},
+			Status:     v1alpha1.ApplicationStatus{ObservedAt: &observedAt},
+		},
+		&v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "reconciled", Namespace: testNamespace},
+			Status:     v1alpha1.ApplicationStatus{ObservedAt: &observedAt, ReconciledAt: &reconciledAt},
+		},
+	)
+	ctx := context.Background()
+	require.NoError(t, moveObservedAtToReconciledAt(ctx, c))
+
+	app, err := c.AppClientset.ArgoprojV1alpha1().Applications(testNamespace).Get(ctx, "observed", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Status.ObservedAt)
+	require.NotNil(t, app.Status.ReconciledAt)
+	assert.True(t, observedAt.Equal(app.Status.ReconciledAt))
+
+	app, err = c.AppClientset.ArgoprojV1alpha1().Applications(testNamespace).Get(ctx, "reconciled", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Status.ObservedAt)
+	require.NotNil(t, app.Status.ReconciledAt)
+	assert.True(t, reconciledAt.Equal(app.Status.ReconciledAt))
+}
+
+func TestMigrator_MigrateWithLeaderElection(t *testing.T) {
+	c := newTestContext(t, nil)
+	applied := 0
+	migrator := NewMigrator(c, []Migration{{Version: 1, Name: "test", Migrate: func(ctx context.Context, c *Context) error {
+		applied++
+		return nil
+	}}})
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Name: LeaseName, Namespace: testNamespace},
+		Client:     c.KubeClientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: "replica-0"},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, migrator.MigrateWithLeaderElection(ctx, lock, 15*time.Second, 10*time.Second, 100*time.Millisecond))
+	require.NoError(t, migrator.MigrateWithLeaderElection(ctx, lock, 15*time.Second, 10*time.Second, 100*time.Millisecond))
+	assert.Equal(t, 1, applied)
+
+	pending, err := migrator.Pending(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
@@ -0,0 +1,137 @@
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+const (
+	labelKeyPartOf   = "app.kubernetes.io/part-of"
+	labelValuePartOf = "argocd"
+)
+
+// DefaultMigrations returns the migrations of the Argo CD installations shipped with this version of Argo CD. The
+// versions of the released migrations must never change.
+func DefaultMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Name: "label-argocd-config-maps-and-secrets", Migrate: labelConfigMapsAndSecrets},
+		{Version: 2, Name: "move-legacy-repositories-to-secrets", Migrate: moveLegacyRepositoriesToSecrets},
+		{Version: 3, Name: "move-observed-at-to-reconciled-at", Migrate: moveObservedAtToReconciledAt},
+	}
+}
+
+// moveLegacyRepositoriesToSecrets moves the repositories and the repository credentials still declared in the
+// argocd-cm config map into repository secrets
+func moveLegacyRepositoriesToSecrets(ctx context.Context, c *Context) error {
+	// the config maps and secrets labeled by the previous migration may not be cached by the settings manager yet
+	if err := c.SettingsMgr.ResyncInformers(); err != nil {
+		return err
+	}
+	migrated, err := db.MigrateLegacyRepositories(ctx, c.Namespace, c.SettingsMgr, c.KubeClientset)
+	if err != nil {
+		return err
+	}
+	log.Infof("Moved %d legacy repositories and repository credentials to secrets", migrated)
+	return nil
+}
+
+// labelConfigMapsAndSecrets adds the label the settings of Argo CD are looked up with to the Argo CD config maps and
+// secrets and to the secrets of the clusters and repositories, which used to be found without it
+func labelConfigMapsAndSecrets(ctx context.Context, c *Context) error {
+	configMaps := c.KubeClientset.CoreV1().ConfigMaps(c.Namespace)
+	for _, name := range []string{
+		common.GetArgoCDConfigMapName(),
+		common.GetArgoCDRBACConfigMapName(),
+		common.GetArgoCDKnownHostsConfigMapName(),
+		common.GetArgoCDTLSCertsConfigMapName(),
+		common.GetArgoCDGPGKeysConfigMapName(),
+	} {
+		cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error getting config map %s: %w", name, err)
+		}
+		if cm.Labels[labelKeyPartOf] == labelValuePartOf {
+			continue
+		}
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[labelKeyPartOf] = labelValuePartOf
+		if _, err := configMaps.Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error labeling config map %s: %w", name, err)
+		}
+		log.Infof("Labeled config map %s", name)
+	}
+
+	secrets := c.KubeClientset.CoreV1().Secrets(c.Namespace)
+	list, err := secrets.List(ctx, metav1.ListOptions{LabelSelector: common.LabelKeySecretType})
+	if err != nil {
+		return fmt.Errorf("error listing secrets: %w", err)
+	}
+	if secret, err := secrets.Get(ctx, common.GetArgoCDSecretName(), metav1.GetOptions{}); err == nil {
+		list.Items = append(list.Items, *secret)
+	} else if !apierr.IsNotFound(err) {
+		return fmt.Errorf("error getting secret %s: %w", common.GetArgoCDSecretName(), err)
+	}
+	for i := range list.Items {
+		secret := &list.Items[i]
+		if secret.Labels[labelKeyPartOf] == labelValuePartOf {
+			continue
+		}
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[labelKeyPartOf] = labelValuePartOf
+		if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error labeling secret %s: %w", secret.Name, err)
+		}
+		log.Infof("Labeled secret %s", secret.Name)
+	}
+	return nil
+}
+
+// moveObservedAtToReconciledAt moves the deprecated observedAt field of the status of the applications, which is no
+// longer updated, into the reconciledAt field if the application was not reconciled since
+func moveObservedAtToReconciledAt(ctx context.Context, c *Context) error {
+	namespace := c.Namespace
+	if len(c.ApplicationNamespaces) > 0 {
+		namespace = metav1.NamespaceAll
+	}
+	list, err := c.AppClientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing applications: %w", err)
+	}
+	for _, app := range list.Items {
+		if app.Status.ObservedAt == nil {
+			continue
+		}
+		if app.Namespace != c.Namespace && !glob.MatchStringInList(c.ApplicationNamespaces, app.Namespace, false) {
+			continue
+		}
+		status := map[string]interface{}{"observedAt": nil}
+		if app.Status.ReconciledAt == nil {
+			status["reconciledAt"] = app.Status.ObservedAt
+		}
+		patch, err := json.Marshal(map[string]interface{}{"status": status})
+		if err != nil {
+			return err
+		}
+		if _, err := c.AppClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(ctx, app.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return fmt.Errorf("error patching application %s/%s: %w", app.Namespace, app.Name, err)
+		}
+	}
+	return nil
+}
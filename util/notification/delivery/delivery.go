@@ -0,0 +1,89 @@
+package delivery
+
+import (
+	"sync"
+	"time"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+)
+
+const (
+	deliveriesKey = "notification|deliveries"
+	// deliveriesCacheExpiration is the expiration of the deliveries list, which is refreshed every time a delivery
+	// attempt is recorded
+	deliveriesCacheExpiration = 7 * 24 * time.Hour
+	// DeliveriesLimit is the maximum number of deliveries kept in the store
+	DeliveriesLimit = 1000
+)
+
+// Delivery holds the outcome of sending the notifications of a trigger to a single destination
+type Delivery struct {
+	// ID uniquely identifies the delivery across its attempts
+	ID string `json:"id"`
+	// Application is the name of the application the notification is about
+	Application string `json:"application"`
+	// AppNamespace is the namespace of the application the notification is about
+	AppNamespace string `json:"appNamespace"`
+	// Project is the project of the application the notification is about
+	Project string `json:"project"`
+	// Trigger is the name of the trigger which fired the notification
+	Trigger string `json:"trigger"`
+	// Service is the name of the notification service used to deliver the notification
+	Service string `json:"service"`
+	// Recipient is the recipient of the notification
+	Recipient string `json:"recipient"`
+	// Templates are the names of the templates used to format the notification
+	Templates []string `json:"templates,omitempty"`
+	// Attempts is the number of attempts made so far to deliver the notification
+	Attempts int `json:"attempts"`
+	// Succeeded is true once the notification has been delivered
+	Succeeded bool `json:"succeeded"`
+	// Retrying is true while a failed delivery is queued for another attempt
+	Retrying bool `json:"retrying,omitempty"`
+	// Error is the error returned by the last failed attempt
+	Error string `json:"error,omitempty"`
+	// Time is the time of the last attempt
+	Time time.Time `json:"time"`
+}
+
+// Store keeps the most recent notification deliveries in the shared cache
+type Store struct {
+	cache *cacheutil.Cache
+	lock  sync.Mutex
+}
+
+// NewStore returns a new deliveries store backed by the given cache
+func NewStore(cache *cacheutil.Cache) *Store {
+	return &Store{cache: cache}
+}
+
+// List returns the recorded deliveries, most recently attempted first
+func (s *Store) List() ([]Delivery, error) {
+	deliveries := make([]Delivery, 0)
+	if err := s.cache.GetItem(deliveriesKey, &deliveries); err != nil && err != cacheutil.ErrCacheMiss {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// Record stores the given delivery in front of the list, replacing any previous record of the same delivery and
+// dropping the oldest deliveries once the list exceeds DeliveriesLimit
+func (s *Store) Record(delivery Delivery) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	deliveries, err := s.List()
+	if err != nil {
+		return err
+	}
+	res := []Delivery{delivery}
+	for _, d := range deliveries {
+		if d.ID != delivery.ID {
+			res = append(res, d)
+		}
+	}
+	if len(res) > DeliveriesLimit {
+		res = res[:DeliveriesLimit]
+	}
+	return s.cache.SetItem(deliveriesKey, res, deliveriesCacheExpiration, false)
+}
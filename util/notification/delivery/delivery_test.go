@@ -0,0 +1,61 @@
+package delivery
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+)
+
+func newTestStore() *Store {
+	return NewStore(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)))
+}
+
+func TestStore_ListEmpty(t *testing.T) {
+	deliveries, err := newTestStore().List()
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestStore_Record(t *testing.T) {
+	store := newTestStore()
+
+	require.NoError(t, store.Record(Delivery{ID: "1", Application: "guestbook", Trigger: "on-deployed", Attempts: 1, Retrying: true, Error: "timeout"}))
+	require.NoError(t, store.Record(Delivery{ID: "2", Application: "guestbook", Trigger: "on-sync-failed", Attempts: 1, Succeeded: true}))
+
+	deliveries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, "2", deliveries[0].ID)
+	assert.Equal(t, "1", deliveries[1].ID)
+
+	t.Run("RecordingAnotherAttemptReplacesTheDelivery", func(t *testing.T) {
+		require.NoError(t, store.Record(Delivery{ID: "1", Application: "guestbook", Trigger: "on-deployed", Attempts: 2, Succeeded: true}))
+
+		deliveries, err := store.List()
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		assert.Equal(t, "1", deliveries[0].ID)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.True(t, deliveries[0].Succeeded)
+		assert.False(t, deliveries[0].Retrying)
+		assert.Equal(t, "2", deliveries[1].ID)
+	})
+}
+
+func TestStore_RecordLimit(t *testing.T) {
+	store := newTestStore()
+	for i := 0; i < DeliveriesLimit+5; i++ {
+		require.NoError(t, store.Record(Delivery{ID: fmt.Sprintf("%d", i)}))
+	}
+
+	deliveries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deliveries, DeliveriesLimit)
+	assert.Equal(t, fmt.Sprintf("%d", DeliveriesLimit+4), deliveries[0].ID)
+	assert.Equal(t, "5", deliveries[DeliveriesLimit-1].ID)
+}
@@ -0,0 +1,36 @@
+package settings
+
+import (
+	"sort"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/subscriptions"
+)
+
+// GetSubscribedDestinations returns the destinations subscribed to using the given annotations, regardless of the
+// triggers they are subscribed to. Both the current and the legacy subscription annotations are taken into account.
+func GetSubscribedDestinations(annotations map[string]string) []services.Destination {
+	// the subscriptions without trigger are kept using a placeholder default trigger
+	defaultTriggers := []string{""}
+	destinations := subscriptions.NewAnnotations(annotations).GetDestinations(defaultTriggers, nil)
+	destinations.Merge(GetLegacyDestinations(annotations, defaultTriggers, nil))
+
+	seen := map[services.Destination]bool{}
+	var res []services.Destination
+	for _, triggerDestinations := range destinations {
+		for _, dest := range triggerDestinations {
+			if dest.Service == "" || seen[dest] {
+				continue
+			}
+			seen[dest] = true
+			res = append(res, dest)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Service != res[j].Service {
+			return res[i].Service < res[j].Service
+		}
+		return res[i].Recipient < res[j].Recipient
+	})
+	return res
+}
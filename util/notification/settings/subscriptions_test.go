@@ -0,0 +1,34 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubscribedDestinations(t *testing.T) {
+	destinations := GetSubscribedDestinations(map[string]string{
+		"notifications.argoproj.io/subscribe.on-deployed.slack":          "team-a;team-b",
+		"notifications.argoproj.io/subscribe.on-sync-failed.slack":       "team-a",
+		"notifications.argoproj.io/subscribe.slack":                      "team-c",
+		"notifications.argoproj.io/subscriptions":                        "- trigger: [on-created]\n  destinations:\n  - service: webhook\n    recipients: [github]\n",
+		"recipients.argocd-notifications.argoproj.io":                    "email:admin@example.com",
+		"on-health-degraded.recipients.argocd-notifications.argoproj.io": "slack:team-d",
+		"app.kubernetes.io/name":                                         "guestbook",
+	})
+
+	assert.Equal(t, []services.Destination{
+		{Service: "email", Recipient: "admin@example.com"},
+		{Service: "slack", Recipient: "team-a"},
+		{Service: "slack", Recipient: "team-b"},
+		{Service: "slack", Recipient: "team-c"},
+		{Service: "slack", Recipient: "team-d"},
+		{Service: "webhook", Recipient: "github"},
+	}, destinations)
+}
+
+func TestGetSubscribedDestinations_NoSubscriptions(t *testing.T) {
+	assert.Empty(t, GetSubscribedDestinations(nil))
+	assert.Empty(t, GetSubscribedDestinations(map[string]string{"app.kubernetes.io/name": "guestbook"}))
+}
@@ -0,0 +1,128 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+var (
+	// defaultDevicePollInterval is the interval between the polls of the token endpoint if the provider does not
+	// specify one
+	defaultDevicePollInterval = 5 * time.Second
+	// devicePollSlowDown is the increase of the poll interval requested by the slow_down error
+	devicePollSlowDown = 5 * time.Second
+)
+
+// DeviceAuthorization is the response of the device authorization endpoint, see
+// https://www.rfc-editor.org/rfc/rfc8628#section-3.2
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
+// deviceTokenResponse is the response of the token endpoint to the device access token request
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	RefreshToken     string `json:"refresh_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// RequestDeviceAuthorization starts the device authorization grant of the given client, which lets users log in
+// with a browser running on another device
+func RequestDeviceAuthorization(ctx context.Context, client *http.Client, endpoint string, oauth2conf *oauth2.Config) (*DeviceAuthorization, error) {
+	values := url.Values{"client_id": {oauth2conf.ClientID}}
+	if len(oauth2conf.Scopes) > 0 {
+		values.Set("scope", strings.Join(oauth2conf.Scopes, " "))
+	}
+	var auth DeviceAuthorization
+	status, err := postForm(ctx, client, endpoint, values, &auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device authorization: %w", err)
+	}
+	if status != http.StatusOK || auth.DeviceCode == "" {
+		return nil, fmt.Errorf("failed to request device authorization: unexpected status %d", status)
+	}
+	return &auth, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user completes the device authorization, and returns the ID
+// token and the refresh token, if any
+func PollDeviceToken(ctx context.Context, client *http.Client, oauth2conf *oauth2.Config, auth *DeviceAuthorization) (string, string, error) {
+	interval := defaultDevicePollInterval
+	if auth.Interval > 0 {
+		interval = time.Duration(auth.Interval) * time.Second
+	}
+	if auth.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(auth.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	values := url.Values{
+		"grant_type":  {GrantTypeDeviceCode},
+		"device_code": {auth.DeviceCode},
+		"client_id":   {oauth2conf.ClientID},
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return "", "", fmt.Errorf("device authorization expired")
+		case <-time.After(interval):
+		}
+		var res deviceTokenResponse
+		if _, err := postForm(ctx, client, oauth2conf.Endpoint.TokenURL, values, &res); err != nil {
+			return "", "", fmt.Errorf("failed to request token: %w", err)
+		}
+		switch res.Error {
+		case "":
+			if res.IDToken == "" {
+				return "", "", fmt.Errorf("no id_token in token response")
+			}
+			return res.IDToken, res.RefreshToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += devicePollSlowDown
+		default:
+			return "", "", fmt.Errorf("%s: %s", res.Error, res.ErrorDescription)
+		}
+	}
+}
+
+// postForm posts the given form and decodes the JSON response, returning its status code
+func postForm(ctx context.Context, client *http.Client, endpoint string, values url.Values, v interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1024*1024))
+	if err != nil {
+		return res.StatusCode, err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return res.StatusCode, fmt.Errorf("failed to decode response with status %d: %w", res.StatusCode, err)
+	}
+	return res.StatusCode, nil
+}
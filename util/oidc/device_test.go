@@ -0,0 +1,77 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func newDeviceProvider(t *testing.T, tokenResponses ...map[string]string) (*httptest.Server, *oauth2.Config) {
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "argo-cd-cli", r.FormValue("client_id"))
+		assert.Equal(t, "openid groups", r.FormValue("scope"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": "https://provider.example.com/device",
+			"expires_in":       60,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, GrantTypeDeviceCode, r.FormValue("grant_type"))
+		assert.Equal(t, "device-code", r.FormValue("device_code"))
+		res := tokenResponses[polls]
+		polls++
+		if res["error"] != "" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts, &oauth2.Config{
+		ClientID: "argo-cd-cli",
+		Scopes:   []string{"openid", "groups"},
+		Endpoint: oauth2.Endpoint{TokenURL: ts.URL + "/token"},
+	}
+}
+
+func TestDeviceAuthorization(t *testing.T) {
+	defaultDevicePollInterval = 10 * time.Millisecond
+	ts, oauth2conf := newDeviceProvider(t,
+		map[string]string{"error": "authorization_pending"},
+		map[string]string{"id_token": "id-token", "refresh_token": "refresh-token"},
+	)
+
+	auth, err := RequestDeviceAuthorization(context.Background(), ts.Client(), ts.URL+"/device/code", oauth2conf)
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD-EFGH", auth.UserCode)
+	assert.Equal(t, "https://provider.example.com/device", auth.VerificationURI)
+
+	idToken, refreshToken, err := PollDeviceToken(context.Background(), ts.Client(), oauth2conf, auth)
+	require.NoError(t, err)
+	assert.Equal(t, "id-token", idToken)
+	assert.Equal(t, "refresh-token", refreshToken)
+}
+
+func TestDeviceAuthorization_Denied(t *testing.T) {
+	defaultDevicePollInterval = 10 * time.Millisecond
+	ts, oauth2conf := newDeviceProvider(t,
+		map[string]string{"error": "access_denied", "error_description": "the user denied the request"},
+	)
+
+	auth, err := RequestDeviceAuthorization(context.Background(), ts.Client(), ts.URL+"/device/code", oauth2conf)
+	require.NoError(t, err)
+
+	_, _, err = PollDeviceToken(context.Background(), ts.Client(), oauth2conf, auth)
+	assert.EqualError(t, err, "access_denied: the user denied the request")
+}
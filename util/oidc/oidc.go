@@ -33,6 +33,7 @@ var InvalidRedirectURLError = fmt.Errorf("invalid return URL")
 const (
 	GrantTypeAuthorizationCode = "authorization_code"
 	GrantTypeImplicit          = "implicit"
+	GrantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
 	ResponseTypeCode           = "code"
 )
 
@@ -42,6 +43,8 @@ type OIDCConfiguration struct {
 	ScopesSupported        []string `json:"scopes_supported"`
 	ResponseTypesSupported []string `json:"response_types_supported"`
 	GrantTypesSupported    []string `json:"grant_types_supported,omitempty"`
+	// DeviceAuthorizationEndpoint is the endpoint of the device authorization grant, if the provider supports it
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
 }
 
 type ClaimsRequest struct {
@@ -70,8 +73,16 @@ type ClientApp struct {
 	encryptionKey []byte
 	// provider is the OIDC provider
 	provider Provider
+	// loginRecorder is notified of the outcome of the logins
+	loginRecorder LoginRecorder
+	// cookieOptions are the attributes of the cookies holding the token of the users who logged in
+	cookieOptions httputil.CookieOptions
 }
 
+// LoginRecorder is notified of the outcome of the logins handled by the client app. connectorID is the ID of the Dex
+// connector the user logged in with, which is empty if it is unknown.
+type LoginRecorder func(connectorID string, err error)
+
 func GetScopesOrDefault(scopes []string) []string {
 	if len(scopes) == 0 {
 		return []string{"openid", "profile", "email", "groups"}
@@ -135,6 +146,32 @@ func NewClientApp(settings *settings.ArgoCDSettings, dexServerAddr string, dexTl
 	return &a, nil
 }
 
+// SetLoginRecorder sets the function which is notified of the outcome of the logins
+func (a *ClientApp) SetLoginRecorder(recorder LoginRecorder) {
+	a.loginRecorder = recorder
+}
+
+// SetCookieOptions sets the attributes of the cookies holding the token of the users who logged in
+func (a *ClientApp) SetCookieOptions(options httputil.CookieOptions) {
+	a.cookieOptions = options
+}
+
+func (a *ClientApp) recordLogin(connectorID string, err error) {
+	if a.loginRecorder != nil {
+		a.loginRecorder(connectorID, err)
+	}
+}
+
+// dexConnectorID returns the ID of the Dex connector from the federated claims of a token issued by Dex
+func dexConnectorID(claims jwt.MapClaims) string {
+	federatedClaims, ok := claims["federated_claims"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	id, _ := federatedClaims["connector_id"].(string)
+	return id
+}
+
 func (a *ClientApp) oauth2Config(scopes []string) (*oauth2.Config, error) {
 	endpoint, err := a.provider.Endpoint()
 	if err != nil {
@@ -327,6 +364,7 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	log.Infof("Callback: %s", r.URL)
 	if errMsg := r.FormValue("error"); errMsg != "" {
 		errorDesc := r.FormValue("error_description")
+		a.recordLogin("", fmt.Errorf("%s: %s", errMsg, errorDesc))
 		http.Error(w, html.EscapeString(errMsg)+": "+html.EscapeString(errorDesc), http.StatusBadRequest)
 		return
 	}
@@ -345,6 +383,7 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := gooidc.ClientContext(r.Context(), a.client)
 	token, err := oauth2Config.Exchange(ctx, code)
 	if err != nil {
+		a.recordLogin("", fmt.Errorf("failed to get token: %w", err))
 		http.Error(w, fmt.Sprintf("failed to get token: %v", err), http.StatusInternalServerError)
 		return
 	}
@@ -358,6 +397,7 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 
 	if err != nil {
 		log.Warnf("Failed to verify token: %s", err)
+		a.recordLogin("", fmt.Errorf("failed to verify token: %w", err))
 		http.Error(w, common.TokenVerificationError, http.StatusInternalServerError)
 		return
 	}
@@ -365,11 +405,8 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	if a.baseHRef != "" {
 		path = strings.TrimRight(strings.TrimLeft(a.baseHRef, "/"), "/")
 	}
-	cookiePath := fmt.Sprintf("path=/%s", path)
-	flags := []string{cookiePath, "SameSite=lax", "httpOnly"}
-	if a.secureCookie {
-		flags = append(flags, "Secure")
-	}
+	cookiePath := fmt.Sprintf("/%s", path)
+	flags := a.cookieOptions.Flags(cookiePath, a.secureCookie)
 	var claims jwt.MapClaims
 	err = idToken.Claims(&claims)
 	if err != nil {
@@ -377,7 +414,7 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if idTokenRAW != "" {
-		cookies, err := httputil.MakeCookieMetadata(common.AuthCookieName, idTokenRAW, flags...)
+		cookies, err := httputil.MakeCookieMetadata(a.cookieOptions.CookieName(), idTokenRAW, flags...)
 		if err != nil {
 			claimsJSON, _ := json.Marshal(claims)
 			http.Error(w, fmt.Sprintf("claims=%s, err=%v", claimsJSON, err), http.StatusInternalServerError)
@@ -391,6 +428,7 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 
 	claimsJSON, _ := json.Marshal(claims)
 	log.Infof("Web login successful. Claims: %s", claimsJSON)
+	a.recordLogin(dexConnectorID(claims), nil)
 	if os.Getenv(common.EnvVarSSODebug) == "1" {
 		claimsJSON, _ := json.MarshalIndent(claims, "", "  ")
 		renderToken(w, a.redirectURI, idTokenRAW, token.RefreshToken, claimsJSON)
@@ -412,7 +450,7 @@ var returnURL = "{{ .ReturnURL }}";
 if (state != "" && returnURL == "") {
 	window.location.href = window.location.href.split("#")[0] + "?state=" + result['state'] + window.location.hash;
 } else if (returnURL != "") {
-	document.cookie = "{{ .CookieName }}=" + idToken + "; path=/";
+	document.cookie = "{{ .CookieName }}=" + idToken + "; path=/{{ if .CookieDomain }}; domain={{ .CookieDomain }}{{ end }}";
 	window.location.href = returnURL;
 }
 </script>`))
@@ -424,11 +462,13 @@ if (state != "" && returnURL == "") {
 // the return URL.
 func (a *ClientApp) handleImplicitFlow(r *http.Request, w http.ResponseWriter, state string) {
 	type implicitFlowValues struct {
-		CookieName string
-		ReturnURL  string
+		CookieName   string
+		CookieDomain string
+		ReturnURL    string
 	}
 	vals := implicitFlowValues{
-		CookieName: common.AuthCookieName,
+		CookieName:   a.cookieOptions.CookieName(),
+		CookieDomain: a.cookieOptions.Domain,
 	}
 	if state != "" {
 		returnURL, err := a.verifyAppState(r, w, state)
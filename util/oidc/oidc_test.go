@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
@@ -109,6 +110,33 @@ func TestHandleCallback(t *testing.T) {
 	assert.Equal(t, "login-failed: &lt;script&gt;alert(&#39;hello&#39;)&lt;/script&gt;\n", w.Body.String())
 }
 
+func TestHandleCallback_RecordsLogin(t *testing.T) {
+	app := ClientApp{provider: &fakeProvider{}}
+	var connectorID string
+	var loginErr error
+	app.SetLoginRecorder(func(id string, err error) {
+		connectorID = id
+		loginErr = err
+	})
+
+	req := httptest.NewRequest("GET", "http://example.com/foo", nil)
+	req.Form = url.Values{
+		"error":             []string{"access_denied"},
+		"error_description": []string{"user denied access"},
+	}
+	app.HandleCallback(httptest.NewRecorder(), req)
+
+	assert.Empty(t, connectorID)
+	assert.EqualError(t, loginErr, "access_denied: user denied access")
+}
+
+func TestDexConnectorID(t *testing.T) {
+	assert.Equal(t, "github", dexConnectorID(jwt.MapClaims{
+		"federated_claims": map[string]interface{}{"connector_id": "github", "user_id": "1"},
+	}))
+	assert.Empty(t, dexConnectorID(jwt.MapClaims{"sub": "admin"}))
+}
+
 func TestClientApp_HandleLogin(t *testing.T) {
 	oidcTestServer := test.GetOIDCTestServer(t)
 	t.Cleanup(oidcTestServer.Close)
@@ -0,0 +1,467 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+// The kyverno evaluator implements the subset of Kyverno needed to validate rendered manifests without a cluster:
+// the match/exclude resource filters and the validate.pattern/validate.anyPattern rules, including the
+// conditional "(key)", equality "=(key)" and negation "X(key)" anchors and the wildcard, negation ("!"),
+// alternative ("|") and numeric comparison operators of pattern values. Policies are parsed strictly: rules using
+// anything else, such as mutate, generate and verifyImages rules, validate.deny conditions or preconditions, are
+// rejected rather than ignored, so that the policies they implement never pass unchecked.
+
+type kyvernoPolicy struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+	Spec struct {
+		ValidationFailureAction string        `json:"validationFailureAction"`
+		Rules                   []kyvernoRule `json:"rules"`
+	} `json:"spec"`
+}
+
+type kyvernoRule struct {
+	Name     string             `json:"name"`
+	Match    kyvernoMatch       `json:"match"`
+	Exclude  kyvernoMatch       `json:"exclude"`
+	Validate *kyvernoValidation `json:"validate"`
+}
+
+type kyvernoMatch struct {
+	Any       []kyvernoFilter   `json:"any"`
+	All       []kyvernoFilter   `json:"all"`
+	Resources *kyvernoResources `json:"resources"`
+}
+
+type kyvernoFilter struct {
+	Resources kyvernoResources `json:"resources"`
+}
+
+type kyvernoResources struct {
+	Kinds      []string              `json:"kinds"`
+	Name       string                `json:"name"`
+	Names      []string              `json:"names"`
+	Namespaces []string              `json:"namespaces"`
+	Selector   *metav1.LabelSelector `json:"selector"`
+}
+
+type kyvernoValidation struct {
+	Message    string        `json:"message"`
+	Pattern    interface{}   `json:"pattern"`
+	AnyPattern []interface{} `json:"anyPattern"`
+}
+
+type kyvernoEvaluator struct {
+	policies []kyvernoPolicy
+}
+
+func newKyvernoEvaluator(bundleDir string) (*kyvernoEvaluator, error) {
+	files, err := findFiles(bundleDir, ".yaml", ".yml", ".json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy files: %w", err)
+	}
+	evaluator := &kyvernoEvaluator{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		objs, err := kube.SplitYAML(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		for _, obj := range objs {
+			if !strings.HasPrefix(obj.GetAPIVersion(), "kyverno.io/") || (obj.GetKind() != "ClusterPolicy" && obj.GetKind() != "Policy") {
+				continue
+			}
+			policy, err := parseKyvernoPolicy(obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse policy %s in %s: %w", obj.GetName(), file, err)
+			}
+			evaluator.policies = append(evaluator.policies, *policy)
+		}
+	}
+	return evaluator, nil
+}
+
+func parseKyvernoPolicy(obj *unstructured.Unstructured) (*kyvernoPolicy, error) {
+	rules, _, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	if err != nil {
+		return nil, err
+	}
+	unstructured.RemoveNestedField(obj.Object, "spec", "rules")
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	var policy kyvernoPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		rule, err := parseKyvernoRule(rules[i])
+		if err != nil {
+			return nil, err
+		}
+		policy.Spec.Rules = append(policy.Spec.Rules, *rule)
+	}
+	return &policy, nil
+}
+
+// parseKyvernoRule parses a rule, returning an error if it uses any field the evaluator does not implement
+func parseKyvernoRule(obj interface{}) (*kyvernoRule, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var rule kyvernoRule
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&rule); err != nil {
+		return nil, fmt.Errorf("rule '%s' is not supported: %w", rule.Name, err)
+	}
+	if rule.Validate == nil || (rule.Validate.Pattern == nil && len(rule.Validate.AnyPattern) == 0) {
+		return nil, fmt.Errorf("rule '%s' is not supported: only validate rules with a pattern or anyPattern are supported", rule.Name)
+	}
+	return &rule, nil
+}
+
+func (e *kyvernoEvaluator) Evaluate(objs []*unstructured.Unstructured) ([]Violation, error) {
+	var violations []Violation
+	for _, obj := range objs {
+		for _, policy := range e.policies {
+			if policy.Kind == "Policy" && policy.Metadata.Namespace != obj.GetNamespace() {
+				continue
+			}
+			warning := strings.EqualFold(policy.Spec.ValidationFailureAction, "audit")
+			for _, rule := range policy.Spec.Rules {
+				if !rule.Match.matches(obj, true) || rule.Exclude.matches(obj, false) {
+					continue
+				}
+				if message, ok := rule.Validate.validate(obj.Object); !ok {
+					violations = append(violations, Violation{
+						Policy:   policy.Metadata.Name,
+						Rule:     rule.Name,
+						Message:  message,
+						Resource: kube.GetResourceKey(obj),
+						Warning:  warning,
+					})
+				}
+			}
+		}
+	}
+	return violations, nil
+}
+
+// matches returns whether the object is selected by the match or exclude block. An empty block selects all
+// objects if it is a match block and none if it is an exclude block.
+func (m *kyvernoMatch) matches(obj *unstructured.Unstructured, isMatch bool) bool {
+	if m.Resources == nil && len(m.Any) == 0 && len(m.All) == 0 {
+		return isMatch
+	}
+	if m.Resources != nil && !m.Resources.matches(obj) {
+		return false
+	}
+	if len(m.Any) > 0 {
+		matched := false
+		for _, filter := range m.Any {
+			if filter.Resources.matches(obj) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, filter := range m.All {
+		if !filter.Resources.matches(obj) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *kyvernoResources) matches(obj *unstructured.Unstructured) bool {
+	if len(r.Kinds) > 0 {
+		gvk := obj.GroupVersionKind()
+		matched := false
+		for _, kind := range r.Kinds {
+			if kindMatches(kind, gvk.Group, gvk.Version, gvk.Kind) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if r.Name != "" && !glob.Match(r.Name, obj.GetName()) {
+		return false
+	}
+	if len(r.Names) > 0 && !matchesAny(r.Names, obj.GetName()) {
+		return false
+	}
+	if len(r.Namespaces) > 0 && !matchesAny(r.Namespaces, obj.GetNamespace()) {
+		return false
+	}
+	if r.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(r.Selector)
+		if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+			return false
+		}
+	}
+	return true
+}
+
+// kindMatches matches a kind filter in one of the forms Kind, Version/Kind or Group/Version/Kind
+func kindMatches(filter, group, version, kind string) bool {
+	parts := strings.Split(filter, "/")
+	switch len(parts) {
+	case 1:
+		return glob.Match(parts[0], kind)
+	case 2:
+		return glob.Match(parts[0], version) && glob.Match(parts[1], kind)
+	default:
+		return glob.Match(parts[0], group) && glob.Match(parts[1], version) && glob.Match(parts[2], kind)
+	}
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if glob.Match(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *kyvernoValidation) validate(obj map[string]interface{}) (string, bool) {
+	var failedPath string
+	if v.Pattern != nil {
+		_, path, ok := validatePattern(obj, v.Pattern, "/")
+		if ok {
+			return "", true
+		}
+		failedPath = path
+	} else if len(v.AnyPattern) > 0 {
+		for _, pattern := range v.AnyPattern {
+			_, path, ok := validatePattern(obj, pattern, "/")
+			if ok {
+				return "", true
+			}
+			failedPath = path
+		}
+	} else {
+		return "", true
+	}
+	message := v.Message
+	if message == "" {
+		message = "validation failed"
+	}
+	return fmt.Sprintf("%s (failed at path %s)", message, failedPath), false
+}
+
+// validatePattern validates a value against a pattern. It returns whether the pattern was skipped because a
+// conditional anchor did not match, the path of the first mismatch and whether the value is valid.
+func validatePattern(value interface{}, pattern interface{}, path string) (bool, string, bool) {
+	switch typedPattern := pattern.(type) {
+	case map[string]interface{}:
+		typedValue, ok := value.(map[string]interface{})
+		if !ok {
+			return false, path, false
+		}
+		return validateMap(typedValue, typedPattern, path)
+	case []interface{}:
+		typedValue, ok := value.([]interface{})
+		if !ok {
+			return false, path, false
+		}
+		failedPath := path
+		valid := validateArray(typedValue, typedPattern, path, &failedPath)
+		return false, failedPath, valid
+	default:
+		return false, path, validateValue(value, pattern)
+	}
+}
+
+func validateMap(value map[string]interface{}, pattern map[string]interface{}, path string) (bool, string, bool) {
+	keys := make([]string, 0, len(pattern))
+	for key := range pattern {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	// conditional anchors are evaluated first: if any of them does not match, the whole map is skipped
+	for _, key := range keys {
+		subPattern := pattern[key]
+		if name, ok := anchorKey(key, "("); ok {
+			subValue, exists := value[name]
+			if !exists {
+				return true, "", true
+			}
+			if _, _, valid := validatePattern(subValue, subPattern, path+name+"/"); !valid {
+				return true, "", true
+			}
+		}
+	}
+	for _, key := range keys {
+		subPattern := pattern[key]
+		if _, ok := anchorKey(key, "("); ok {
+			continue
+		}
+		if name, ok := anchorKey(key, "X("); ok {
+			if _, exists := value[name]; exists {
+				return false, path + name + "/", false
+			}
+			continue
+		}
+		name, optional := anchorKey(key, "=(")
+		if !optional {
+			name = key
+		}
+		subValue, exists := value[name]
+		if !exists {
+			if optional || subPattern == "*" {
+				continue
+			}
+			return false, path + name + "/", false
+		}
+		skipped, failedPath, valid := validatePattern(subValue, subPattern, path+name+"/")
+		if skipped {
+			return true, "", true
+		}
+		if !valid {
+			return false, failedPath, false
+		}
+	}
+	return false, "", true
+}
+
+func validateArray(value []interface{}, pattern []interface{}, path string, failedPath *string) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+	for i, element := range value {
+		elementPattern := pattern[0]
+		if len(pattern) > 1 {
+			if i >= len(pattern) {
+				*failedPath = fmt.Sprintf("%s%d/", path, i)
+				return false
+			}
+			elementPattern = pattern[i]
+		}
+		_, elementPath, valid := validatePattern(element, elementPattern, fmt.Sprintf("%s%d/", path, i))
+		if !valid {
+			*failedPath = elementPath
+			return false
+		}
+	}
+	return true
+}
+
+func anchorKey(key string, prefix string) (string, bool) {
+	if strings.HasPrefix(key, prefix) && strings.HasSuffix(key, ")") {
+		return key[len(prefix) : len(key)-1], true
+	}
+	return "", false
+}
+
+// validateValue validates a scalar value against a pattern value
+func validateValue(value interface{}, pattern interface{}) bool {
+	switch typedPattern := pattern.(type) {
+	case nil:
+		return value == nil
+	case bool:
+		typedValue, ok := value.(bool)
+		return ok && typedValue == typedPattern
+	case int64, float64:
+		actual, ok := toFloat(value)
+		expected, _ := toFloat(typedPattern)
+		return ok && actual == expected
+	case string:
+		if value == nil {
+			return false
+		}
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+		for _, alternative := range strings.Split(typedPattern, "|") {
+			if validateConjunction(value, strings.TrimSpace(alternative)) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+func validateConjunction(value interface{}, pattern string) bool {
+	for _, condition := range strings.Split(pattern, "&") {
+		if !validateCondition(value, strings.TrimSpace(condition)) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateCondition(value interface{}, pattern string) bool {
+	for _, operator := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(pattern, operator) {
+			expected, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimPrefix(pattern, operator)), 64)
+			if err != nil {
+				return false
+			}
+			actual, ok := toFloat(value)
+			if !ok {
+				return false
+			}
+			switch operator {
+			case ">=":
+				return actual >= expected
+			case "<=":
+				return actual <= expected
+			case ">":
+				return actual > expected
+			default:
+				return actual < expected
+			}
+		}
+	}
+	str := fmt.Sprint(value)
+	if strings.HasPrefix(pattern, "!") {
+		return !glob.Match(strings.TrimPrefix(pattern, "!"), str)
+	}
+	return glob.Match(pattern, str)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch typedValue := value.(type) {
+	case int64:
+		return float64(typedValue), true
+	case int:
+		return float64(typedValue), true
+	case float64:
+		return typedValue, true
+	case string:
+		f, err := strconv.ParseFloat(typedValue, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
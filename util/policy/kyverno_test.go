@@ -0,0 +1,129 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newPod(namespace string, labels map[string]interface{}, image string, pullPolicy string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "my-pod",
+			"namespace": namespace,
+			"labels":    labels,
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "main", "image": image, "imagePullPolicy": pullPolicy},
+			},
+		},
+	}}
+}
+
+func TestKyvernoEvaluator(t *testing.T) {
+	evaluator, err := NewEvaluator(v1alpha1.PolicyEngineKyverno, "testdata/kyverno")
+	require.NoError(t, err)
+
+	t.Run("Valid", func(t *testing.T) {
+		violations, err := evaluator.Evaluate([]*unstructured.Unstructured{
+			newPod("default", map[string]interface{}{"team": "a"}, "nginx:1.23", "IfNotPresent"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("MissingLabel", func(t *testing.T) {
+		violations, err := evaluator.Evaluate([]*unstructured.Unstructured{
+			newPod("default", map[string]interface{}{"team": ""}, "nginx:1.23", "IfNotPresent"),
+		})
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, Violation{
+			Policy:   "require-labels",
+			Rule:     "check-team",
+			Message:  "label 'team' is required (failed at path /metadata/labels/team/)",
+			Resource: kube.ResourceKey{Kind: "Pod", Namespace: "default", Name: "my-pod"},
+		}, violations[0])
+	})
+
+	t.Run("ExcludedNamespace", func(t *testing.T) {
+		violations, err := evaluator.Evaluate([]*unstructured.Unstructured{
+			newPod("kube-system", nil, "nginx:1.23", "IfNotPresent"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("ConditionalAnchor", func(t *testing.T) {
+		violations, err := evaluator.Evaluate([]*unstructured.Unstructured{
+			newPod("default", map[string]interface{}{"team": "a"}, "nginx:latest", "IfNotPresent"),
+		})
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "disallow-latest-tag", violations[0].Policy)
+		assert.Equal(t, "images using the latest tag must be pulled always (failed at path /spec/containers/0/imagePullPolicy/)", violations[0].Message)
+		assert.True(t, violations[0].Warning)
+
+		violations, err = evaluator.Evaluate([]*unstructured.Unstructured{
+			newPod("default", map[string]interface{}{"team": "a"}, "nginx:latest", "Always"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+}
+
+func TestNewKyvernoEvaluator_UnsupportedRule(t *testing.T) {
+	_, err := NewEvaluator(v1alpha1.PolicyEngineKyverno, "testdata/kyverno-unsupported")
+	assert.ErrorContains(t, err, `rule 'deny-privileged-containers' is not supported: json: unknown field "deny"`)
+
+	_, err = parseKyvernoRule(map[string]interface{}{"name": "add-labels", "mutate": map[string]interface{}{}})
+	assert.ErrorContains(t, err, `rule 'add-labels' is not supported: json: unknown field "mutate"`)
+
+	_, err = parseKyvernoRule(map[string]interface{}{"name": "empty", "validate": map[string]interface{}{"message": "foo"}})
+	assert.EqualError(t, err, "rule 'empty' is not supported: only validate rules with a pattern or anyPattern are supported")
+}
+
+func TestValidateValue(t *testing.T) {
+	testCases := []struct {
+		value    interface{}
+		pattern  interface{}
+		expected bool
+	}{
+		{"abc", "a*", true},
+		{"abc", "!a*", false},
+		{"abc", "x* | a*", true},
+		{"", "?*", false},
+		{int64(3), ">2", true},
+		{int64(3), ">=1 & <3", false},
+		{"3", int64(3), true},
+		{true, true, true},
+		{true, "true", true},
+		{nil, "*", false},
+		{map[string]interface{}{}, "*", false},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, validateValue(tc.value, tc.pattern), "value %v, pattern %v", tc.value, tc.pattern)
+	}
+}
+
+func TestValidatePatternAnchors(t *testing.T) {
+	value := map[string]interface{}{"spec": map[string]interface{}{"hostNetwork": false}}
+
+	_, path, valid := validatePattern(value, map[string]interface{}{"spec": map[string]interface{}{"X(hostNetwork)": "null"}}, "/")
+	assert.False(t, valid)
+	assert.Equal(t, "/spec/hostNetwork/", path)
+
+	_, _, valid = validatePattern(value, map[string]interface{}{"spec": map[string]interface{}{"=(hostPID)": false}}, "/")
+	assert.True(t, valid)
+
+	_, _, valid = validatePattern(value, map[string]interface{}{"spec": map[string]interface{}{"=(hostNetwork)": true}}, "/")
+	assert.False(t, valid)
+}
@@ -0,0 +1,70 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// Violation is a single policy violation reported for a resource
+type Violation struct {
+	// Policy is the name of the violated policy
+	Policy string
+	// Rule is the name of the violated rule within the policy, if any
+	Rule string
+	// Message is the human readable description of the violation
+	Message string
+	// Resource identifies the resource violating the policy
+	Resource kube.ResourceKey
+	// Warning is true if the policy only warns about the violation
+	Warning bool
+}
+
+// Evaluator evaluates resources against the policies of a bundle
+type Evaluator interface {
+	Evaluate(objs []*unstructured.Unstructured) ([]Violation, error)
+}
+
+// NewEvaluator returns the evaluator of the given engine for the policies found in the bundle directory
+func NewEvaluator(engine string, bundleDir string) (Evaluator, error) {
+	switch engine {
+	case v1alpha1.PolicyEngineKyverno:
+		return newKyvernoEvaluator(bundleDir)
+	case v1alpha1.PolicyEngineRego:
+		return newRegoEvaluator(bundleDir)
+	}
+	return nil, fmt.Errorf("unsupported policy engine '%s'", engine)
+}
+
+// findFiles returns the files in the directory tree whose extension is one of the given extensions
+func findFiles(dir string, extensions ...string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		for _, ext := range extensions {
+			if strings.HasSuffix(info.Name(), ext) {
+				files = append(files, path)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
@@ -0,0 +1,88 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/open-policy-agent/opa/rego"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// regoQuery is the query evaluated for every resource. Policies follow the conftest conventions: resources are passed
+// as input and violations are reported by the deny, violation and warn rules of package main.
+const regoQuery = "data.main"
+
+type regoEvaluator struct {
+	query rego.PreparedEvalQuery
+}
+
+func newRegoEvaluator(bundleDir string) (*regoEvaluator, error) {
+	files, err := findFiles(bundleDir, ".rego")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy files: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Rego policies found")
+	}
+	// the policies are compiled once with the Open Policy Agent library, and the data documents of the bundle, if
+	// any, are loaded along with them
+	query, err := rego.New(rego.Query(regoQuery), rego.Load([]string{bundleDir}, nil)).PrepareForEval(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile Rego policies: %w", err)
+	}
+	return &regoEvaluator{query: query}, nil
+}
+
+func (e *regoEvaluator) Evaluate(objs []*unstructured.Unstructured) ([]Violation, error) {
+	var violations []Violation
+	for _, obj := range objs {
+		results, err := e.query.Eval(context.Background(), rego.EvalInput(obj.Object))
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate Rego policies: %w", err)
+		}
+		key := kube.GetResourceKey(obj)
+		for _, result := range results {
+			for _, expr := range result.Expressions {
+				value, ok := expr.Value.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				for _, rule := range []string{"deny", "violation", "warn"} {
+					for _, message := range regoMessages(value[rule]) {
+						violations = append(violations, Violation{
+							Policy:   "main",
+							Rule:     rule,
+							Message:  message,
+							Resource: key,
+							Warning:  rule == "warn",
+						})
+					}
+				}
+			}
+		}
+	}
+	return violations, nil
+}
+
+// regoMessages returns the messages of a rule result, which is a set of either strings or objects with a msg field
+func regoMessages(value interface{}) []string {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	var messages []string
+	for _, item := range items {
+		switch typedItem := item.(type) {
+		case string:
+			messages = append(messages, typedItem)
+		case map[string]interface{}:
+			if msg, ok := typedItem["msg"].(string); ok {
+				messages = append(messages, msg)
+			}
+		}
+	}
+	sort.Strings(messages)
+	return messages
+}
@@ -0,0 +1,39 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestRegoMessages(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, regoMessages([]interface{}{"b", map[string]interface{}{"msg": "a"}, map[string]interface{}{"foo": "bar"}}))
+	assert.Nil(t, regoMessages(nil))
+}
+
+func TestNewRegoEvaluator_NoPolicies(t *testing.T) {
+	_, err := NewEvaluator(v1alpha1.PolicyEngineRego, "testdata/kyverno")
+	assert.EqualError(t, err, "no Rego policies found")
+}
+
+func TestNewRegoEvaluator_InvalidPolicy(t *testing.T) {
+	_, err := NewEvaluator(v1alpha1.PolicyEngineRego, "testdata/rego-invalid")
+	assert.ErrorContains(t, err, "failed to compile Rego policies")
+}
+
+func TestRegoEvaluator(t *testing.T) {
+	evaluator, err := NewEvaluator(v1alpha1.PolicyEngineRego, "testdata/rego")
+	require.NoError(t, err)
+	violations, err := evaluator.Evaluate([]*unstructured.Unstructured{
+		newPod("default", map[string]interface{}{"team": "a"}, "nginx:1.23", "Always"),
+		newPod("default", nil, "nginx:1.23", "Always"),
+	})
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "label 'team' is required", violations[0].Message)
+	assert.False(t, violations[0].Warning)
+}
@@ -0,0 +1,7 @@
+package main
+
+deny[msg] {
+  input.kind == "Pod"
+  not input.metadata.labels.team
+  msg := "label 'team' is required"
+}
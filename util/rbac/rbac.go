@@ -17,6 +17,7 @@ import (
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
 	"github.com/casbin/casbin/v2/util"
+	"github.com/ghodss/yaml"
 	"github.com/golang-jwt/jwt/v4"
 	gocache "github.com/patrickmn/go-cache"
 	log "github.com/sirupsen/logrus"
@@ -417,6 +418,22 @@ func ValidatePolicy(policy string) error {
 	return nil
 }
 
+// ValidateConfigMap verifies the policy, match mode and scopes stored in the given RBAC config map
+func ValidateConfigMap(cm *apiv1.ConfigMap) error {
+	if matchMode := cm.Data[ConfigMapMatchModeKey]; matchMode != "" && matchMode != GlobMatchMode && matchMode != RegexMatchMode {
+		return fmt.Errorf("invalid '%s' key: unsupported match mode '%s'", ConfigMapMatchModeKey, matchMode)
+	}
+	if scopes := cm.Data[ConfigMapScopesKey]; scopes != "" {
+		if err := yaml.Unmarshal([]byte(scopes), &[]string{}); err != nil {
+			return fmt.Errorf("invalid '%s' key: %w", ConfigMapScopesKey, err)
+		}
+	}
+	if err := ValidatePolicy(cm.Data[ConfigMapPolicyCSVKey]); err != nil {
+		return fmt.Errorf("invalid '%s' key: %w", ConfigMapPolicyCSVKey, err)
+	}
+	return nil
+}
+
 // newBuiltInModel is a helper to return a brand new casbin model from the built-in model string.
 // This is needed because it is not safe to re-use the same casbin Model when instantiating new
 // casbin enforcers.
@@ -439,3 +439,18 @@ func TestLoadPolicyLine(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestValidateConfigMap(t *testing.T) {
+	newConfigMap := func(data map[string]string) *apiv1.ConfigMap {
+		return &apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: fakeConfigMapName}, Data: data}
+	}
+	assert.NoError(t, ValidateConfigMap(newConfigMap(map[string]string{
+		ConfigMapPolicyCSVKey:     "g, admins, role:admin",
+		ConfigMapPolicyDefaultKey: "role:readonly",
+		ConfigMapScopesKey:        "[groups, email]",
+		ConfigMapMatchModeKey:     RegexMatchMode,
+	})))
+	assert.ErrorContains(t, ValidateConfigMap(newConfigMap(map[string]string{ConfigMapMatchModeKey: "fuzzy"})), ConfigMapMatchModeKey)
+	assert.ErrorContains(t, ValidateConfigMap(newConfigMap(map[string]string{ConfigMapScopesKey: "groups: email"})), ConfigMapScopesKey)
+	assert.ErrorContains(t, ValidateConfigMap(newConfigMap(map[string]string{ConfigMapPolicyCSVKey: "g, admins"})), ConfigMapPolicyCSVKey)
+}
@@ -0,0 +1,141 @@
+package scm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+// bitbucketClient is a client of the REST API 2.0 of Bitbucket Cloud
+type bitbucketClient struct {
+	httpClient *http.Client
+	apiURL     string
+	username   string
+	password   string
+	// repoPath is the path of the repository, e.g. workspace/repository
+	repoPath string
+}
+
+var _ Client = &bitbucketClient{}
+
+func newBitbucketClient(repo *v1alpha1.Repository, apiURL string, repoPath string) (*bitbucketClient, error) {
+	if repo.Password == "" {
+		return nil, fmt.Errorf("repository %s has no app password or access token", repo.Repo)
+	}
+	return &bitbucketClient{
+		httpClient: git.GetRepoHTTPClient(apiURL, repo.IsInsecure(), nil, repo.Proxy),
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		username:   repo.Username,
+		password:   repo.Password,
+		repoPath:   repoPath,
+	}, nil
+}
+
+// bitbucketBuildStates maps the commit states to the build states of Bitbucket
+var bitbucketBuildStates = map[CommitState]string{
+	CommitStatePending: "INPROGRESS",
+	CommitStateSuccess: "SUCCESSFUL",
+	CommitStateFailure: "FAILED",
+	CommitStateError:   "FAILED",
+}
+
+type bitbucketComment struct {
+	ID      int64 `json:"id,omitempty"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+}
+
+type bitbucketComments struct {
+	Values []bitbucketComment `json:"values"`
+	Next   string             `json:"next"`
+}
+
+func (c *bitbucketClient) SetCommitStatus(ctx context.Context, sha string, status CommitStatus) error {
+	targetURL := status.TargetURL
+	if targetURL == "" {
+		// the URL of the statuses is required by Bitbucket
+		targetURL = fmt.Sprintf("https://bitbucket.org/%s/commits/%s", c.repoPath, sha)
+	}
+	body := map[string]string{
+		"key":         status.Context,
+		"name":        status.Context,
+		"state":       bitbucketBuildStates[status.State],
+		"description": truncateDescription(status.Description),
+		"url":         targetURL,
+	}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/repositories/%s/commit/%s/statuses/build", c.apiURL, c.repoPath, sha), body, nil); err != nil {
+		return fmt.Errorf("failed to set status of commit %s: %w", sha, err)
+	}
+	return nil
+}
+
+func (c *bitbucketClient) UpsertPullRequestComment(ctx context.Context, number int, marker string, body string) error {
+	commentsURL := fmt.Sprintf("%s/repositories/%s/pullrequests/%d/comments", c.apiURL, c.repoPath, number)
+	comment := bitbucketComment{}
+	comment.Content.Raw = body
+	for pageURL := commentsURL + "?pagelen=100"; pageURL != ""; {
+		var comments bitbucketComments
+		if err := c.do(ctx, http.MethodGet, pageURL, nil, &comments); err != nil {
+			return fmt.Errorf("failed to list comments of pull request %d: %w", number, err)
+		}
+		for _, existing := range comments.Values {
+			if strings.Contains(existing.Content.Raw, marker) {
+				if err := c.do(ctx, http.MethodPut, fmt.Sprintf("%s/%d", commentsURL, existing.ID), comment, nil); err != nil {
+					return fmt.Errorf("failed to update comment of pull request %d: %w", number, err)
+				}
+				return nil
+			}
+		}
+		pageURL = comments.Next
+	}
+	if err := c.do(ctx, http.MethodPost, commentsURL, comment, nil); err != nil {
+		return fmt.Errorf("failed to comment pull request %d: %w", number, err)
+	}
+	return nil
+}
+
+// do sends a request with the given JSON body to the API, and decodes the JSON response into the given result if any
+func (c *bitbucketClient) do(ctx context.Context, method string, url string, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s returned %s: %s", method, url, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v35/github"
+	"golang.org/x/oauth2"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+type gitHubClient struct {
+	client *github.Client
+	owner  string
+	repo   string
+}
+
+var _ Client = &gitHubClient{}
+
+func newGitHubClient(ctx context.Context, repo *v1alpha1.Repository, scheme string, host string, repoPath string) (*gitHubClient, error) {
+	owner, name, _ := strings.Cut(repoPath, "/")
+	if appCreds, ok := repo.GetGitCreds(nil).(git.GitHubAppCreds); ok {
+		client, err := appCreds.GitHubClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to authenticate as GitHub App: %w", err)
+		}
+		return &gitHubClient{client: client, owner: owner, repo: name}, nil
+	}
+	if repo.Password == "" {
+		return nil, fmt.Errorf("repository %s has no token or GitHub App credentials", repo.Repo)
+	}
+
+	apiURL := "https://api.github.com/"
+	if host != "github.com" {
+		apiURL = fmt.Sprintf("%s://%s/api/v3/", scheme, host)
+	}
+	httpClient := git.GetRepoHTTPClient(apiURL, repo.IsInsecure(), nil, repo.Proxy)
+	httpClient.Transport = &oauth2.Transport{
+		Source: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: repo.Password}),
+		Base:   httpClient.Transport,
+	}
+	if host == "github.com" {
+		return &gitHubClient{client: github.NewClient(httpClient), owner: owner, repo: name}, nil
+	}
+	client, err := github.NewEnterpriseClient(apiURL, apiURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &gitHubClient{client: client, owner: owner, repo: name}, nil
+}
+
+func (c *gitHubClient) SetCommitStatus(ctx context.Context, sha string, status CommitStatus) error {
+	repoStatus := &github.RepoStatus{
+		State:       github.String(string(status.State)),
+		Context:     github.String(status.Context),
+		Description: github.String(truncateDescription(status.Description)),
+	}
+	if status.TargetURL != "" {
+		repoStatus.TargetURL = github.String(status.TargetURL)
+	}
+	if _, _, err := c.client.Repositories.CreateStatus(ctx, c.owner, c.repo, sha, repoStatus); err != nil {
+		return fmt.Errorf("failed to set status of commit %s: %w", sha, err)
+	}
+	return nil
+}
+
+func (c *gitHubClient) UpsertPullRequestComment(ctx context.Context, number int, marker string, body string) error {
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		comments, resp, err := c.client.Issues.ListComments(ctx, c.owner, c.repo, number, opts)
+		if err != nil {
+			return fmt.Errorf("failed to list comments of pull request %d: %w", number, err)
+		}
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				if _, _, err := c.client.Issues.EditComment(ctx, c.owner, c.repo, comment.GetID(), &github.IssueComment{Body: github.String(body)}); err != nil {
+					return fmt.Errorf("failed to update comment of pull request %d: %w", number, err)
+				}
+				return nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if _, _, err := c.client.Issues.CreateComment(ctx, c.owner, c.repo, number, &github.IssueComment{Body: github.String(body)}); err != nil {
+		return fmt.Errorf("failed to comment pull request %d: %w", number, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+type gitLabClient struct {
+	client *gitlab.Client
+	// project is the path of the project, e.g. group/subgroup/project
+	project string
+}
+
+var _ Client = &gitLabClient{}
+
+func newGitLabClient(repo *v1alpha1.Repository, apiURL string, repoPath string) (*gitLabClient, error) {
+	if repo.Password == "" {
+		return nil, fmt.Errorf("repository %s has no token", repo.Repo)
+	}
+	client, err := gitlab.NewClient(repo.Password, gitlab.WithBaseURL(apiURL), gitlab.WithHTTPClient(git.GetRepoHTTPClient(apiURL, repo.IsInsecure(), nil, repo.Proxy)))
+	if err != nil {
+		return nil, err
+	}
+	return &gitLabClient{client: client, project: repoPath}, nil
+}
+
+// gitLabBuildStates maps the commit states to the build states of GitLab
+var gitLabBuildStates = map[CommitState]gitlab.BuildStateValue{
+	CommitStatePending: gitlab.Pending,
+	CommitStateSuccess: gitlab.Success,
+	CommitStateFailure: gitlab.Failed,
+	CommitStateError:   gitlab.Failed,
+}
+
+func (c *gitLabClient) SetCommitStatus(ctx context.Context, sha string, status CommitStatus) error {
+	opts := &gitlab.SetCommitStatusOptions{
+		State:       gitLabBuildStates[status.State],
+		Name:        gitlab.String(status.Context),
+		Description: gitlab.String(truncateDescription(status.Description)),
+	}
+	if status.TargetURL != "" {
+		opts.TargetURL = gitlab.String(status.TargetURL)
+	}
+	if _, _, err := c.client.Commits.SetCommitStatus(c.project, sha, opts, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to set status of commit %s: %w", sha, err)
+	}
+	return nil
+}
+
+func (c *gitLabClient) UpsertPullRequestComment(ctx context.Context, number int, marker string, body string) error {
+	opts := &gitlab.ListMergeRequestNotesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		notes, resp, err := c.client.Notes.ListMergeRequestNotes(c.project, number, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to list notes of merge request %d: %w", number, err)
+		}
+		for _, note := range notes {
+			if strings.Contains(note.Body, marker) {
+				if _, _, err := c.client.Notes.UpdateMergeRequestNote(c.project, number, note.ID, &gitlab.UpdateMergeRequestNoteOptions{Body: gitlab.String(body)}, gitlab.WithContext(ctx)); err != nil {
+					return fmt.Errorf("failed to update note of merge request %d: %w", number, err)
+				}
+				return nil
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if _, _, err := c.client.Notes.CreateMergeRequestNote(c.project, number, &gitlab.CreateMergeRequestNoteOptions{Body: gitlab.String(body)}, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to comment merge request %d: %w", number, err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// Provider is an SCM provider hosting Git repositories, whose API is used to give feedback on pull requests and
+// commits
+type Provider string
+
+const (
+	ProviderGitHub    Provider = "github"
+	ProviderGitLab    Provider = "gitlab"
+	ProviderBitbucket Provider = "bitbucket"
+)
+
+// CommitState is the state of a commit status
+type CommitState string
+
+const (
+	CommitStatePending CommitState = "pending"
+	CommitStateSuccess CommitState = "success"
+	CommitStateFailure CommitState = "failure"
+	CommitStateError   CommitState = "error"
+)
+
+// maxDescriptionLength is the maximum length of the descriptions of the commit statuses accepted by all the providers
+const maxDescriptionLength = 140
+
+// CommitStatus is the status of a commit, e.g. the outcome of the deployment of the commit
+type CommitStatus struct {
+	State CommitState
+	// Context identifies the status among the statuses of the commit, e.g. argocd/preview
+	Context string
+	// Description is a short description of the status, truncated to 140 characters
+	Description string
+	// TargetURL is the URL of the page giving the details of the status, if any
+	TargetURL string
+}
+
+// Client gives feedback on the pull requests and commits of a repository through the API of its SCM provider
+type Client interface {
+	// SetCommitStatus creates or updates the status of the given commit with the context of the given status
+	SetCommitStatus(ctx context.Context, sha string, status CommitStatus) error
+	// UpsertPullRequestComment updates the comment of the given pull request containing the given marker, or creates
+	// it if there is none, so that the feedback on a pull request is not repeated on every change of the pull request
+	UpsertPullRequestComment(ctx context.Context, number int, marker string, body string) error
+}
+
+// defaultProviderHosts are the hosts of the public instances of the providers
+var defaultProviderHosts = map[string]Provider{
+	"github.com":    ProviderGitHub,
+	"gitlab.com":    ProviderGitLab,
+	"bitbucket.org": ProviderBitbucket,
+}
+
+// ParseProviderHosts parses the given host=provider pairs, e.g. github.example.com=github, which configure the
+// providers of the self-hosted instances
+func ParseProviderHosts(pairs []string) (map[string]Provider, error) {
+	hosts := map[string]Provider{}
+	for _, pair := range pairs {
+		host, provider, ok := strings.Cut(pair, "=")
+		if !ok || host == "" {
+			return nil, fmt.Errorf("invalid provider host %q, expected host=provider", pair)
+		}
+		switch Provider(provider) {
+		case ProviderGitHub, ProviderGitLab, ProviderBitbucket:
+			hosts[strings.ToLower(host)] = Provider(provider)
+		default:
+			return nil, fmt.Errorf("unknown provider %q of host %s, expected one of %s, %s or %s", provider, host, ProviderGitHub, ProviderGitLab, ProviderBitbucket)
+		}
+	}
+	return hosts, nil
+}
+
+// DetectProvider returns the provider of the given repository URL from its host, looked up in the given hosts of the
+// self-hosted instances then among the public instances, or an empty provider if the host is unknown
+func DetectProvider(repoURL string, hosts map[string]Provider) Provider {
+	_, host, _, err := parseRepoURL(repoURL)
+	if err != nil {
+		return ""
+	}
+	host = strings.ToLower(host)
+	if provider, ok := hosts[host]; ok {
+		return provider
+	}
+	return defaultProviderHosts[host]
+}
+
+// NewClient returns a client of the API of the given provider for the given repository, authenticated with the
+// credentials of the repository
+func NewClient(ctx context.Context, provider Provider, repo *v1alpha1.Repository) (Client, error) {
+	scheme, host, repoPath, err := parseRepoURL(repo.Repo)
+	if err != nil {
+		return nil, err
+	}
+	switch provider {
+	case ProviderGitHub:
+		return newGitHubClient(ctx, repo, scheme, host, repoPath)
+	case ProviderGitLab:
+		return newGitLabClient(repo, fmt.Sprintf("%s://%s/api/v4", scheme, host), repoPath)
+	case ProviderBitbucket:
+		if host != "bitbucket.org" {
+			return nil, fmt.Errorf("only Bitbucket Cloud is supported, not %s", host)
+		}
+		return newBitbucketClient(repo, "https://api.bitbucket.org/2.0", repoPath)
+	}
+	return nil, fmt.Errorf("unsupported SCM provider %q", provider)
+}
+
+var scpLikeURLRegexp = regexp.MustCompile(`^[\w.-]+@([^:/]+):(.+)$`)
+
+// parseRepoURL returns the scheme of the web URLs, the host and the path of the repository of a Git URL, e.g. https,
+// github.com and argoproj/argo-cd for git@github.com:argoproj/argo-cd.git
+func parseRepoURL(repoURL string) (string, string, string, error) {
+	var scheme, host, repoPath string
+	if matches := scpLikeURLRegexp.FindStringSubmatch(repoURL); matches != nil {
+		scheme, host, repoPath = "https", matches[1], matches[2]
+	} else {
+		parsed, err := url.Parse(repoURL)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to parse repository URL %q: %w", repoURL, err)
+		}
+		switch parsed.Scheme {
+		case "http", "https":
+			scheme, host = parsed.Scheme, parsed.Host
+		case "ssh":
+			scheme, host = "https", parsed.Hostname()
+		default:
+			return "", "", "", fmt.Errorf("unsupported repository URL %q", repoURL)
+		}
+		repoPath = parsed.Path
+	}
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if host == "" || !strings.Contains(repoPath, "/") {
+		return "", "", "", fmt.Errorf("repository URL %q does not point to a repository", repoURL)
+	}
+	return scheme, host, repoPath, nil
+}
+
+// truncateDescription truncates the given description of a commit status to the length accepted by the providers
+func truncateDescription(description string) string {
+	if len(description) <= maxDescriptionLength {
+		return description
+	}
+	return description[:maxDescriptionLength-3] + "..."
+}
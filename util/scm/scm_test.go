@@ -0,0 +1,165 @@
+package scm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func Test_parseRepoURL(t *testing.T) {
+	for _, tc := range []struct {
+		repoURL  string
+		scheme   string
+		host     string
+		repoPath string
+	}{
+		{"https://github.com/argoproj/argo-cd.git", "https", "github.com", "argoproj/argo-cd"},
+		{"https://github.com/argoproj/argo-cd/", "https", "github.com", "argoproj/argo-cd"},
+		{"http://gitlab.example.com:8080/group/subgroup/project", "http", "gitlab.example.com:8080", "group/subgroup/project"},
+		{"git@github.com:argoproj/argo-cd.git", "https", "github.com", "argoproj/argo-cd"},
+		{"ssh://git@bitbucket.org:22/workspace/repo.git", "https", "bitbucket.org", "workspace/repo"},
+	} {
+		t.Run(tc.repoURL, func(t *testing.T) {
+			scheme, host, repoPath, err := parseRepoURL(tc.repoURL)
+			require.NoError(t, err)
+			assert.Equal(t, tc.scheme, scheme)
+			assert.Equal(t, tc.host, host)
+			assert.Equal(t, tc.repoPath, repoPath)
+		})
+	}
+
+	_, _, _, err := parseRepoURL("https://github.com/argoproj")
+	assert.ErrorContains(t, err, "does not point to a repository")
+	_, _, _, err = parseRepoURL("file:///tmp/repo")
+	assert.ErrorContains(t, err, "unsupported repository URL")
+}
+
+func TestDetectProvider(t *testing.T) {
+	hosts := map[string]Provider{"git.example.com": ProviderGitLab}
+	assert.Equal(t, ProviderGitHub, DetectProvider("https://github.com/argoproj/argo-cd", hosts))
+	assert.Equal(t, ProviderGitLab, DetectProvider("https://gitlab.com/group/project.git", hosts))
+	assert.Equal(t, ProviderBitbucket, DetectProvider("https://bitbucket.org/workspace/repo.git", hosts))
+	assert.Equal(t, ProviderGitLab, DetectProvider("git@GIT.example.com:org/repo.git", hosts))
+	// the hosts are not guessed from their names
+	assert.Equal(t, Provider(""), DetectProvider("git@github.example.com:org/repo.git", hosts))
+	assert.Equal(t, Provider(""), DetectProvider("https://git.example.com/org/repo.git", nil))
+}
+
+func TestParseProviderHosts(t *testing.T) {
+	hosts, err := ParseProviderHosts([]string{"GitHub.example.com=github", "git.example.com=gitlab"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Provider{"github.example.com": ProviderGitHub, "git.example.com": ProviderGitLab}, hosts)
+	_, err = ParseProviderHosts([]string{"git.example.com"})
+	assert.Error(t, err)
+	_, err = ParseProviderHosts([]string{"git.example.com=gitea"})
+	assert.Error(t, err)
+}
+
+func Test_truncateDescription(t *testing.T) {
+	assert.Equal(t, "Synced", truncateDescription("Synced"))
+	truncated := truncateDescription(strings.Repeat("a", 200))
+	assert.Len(t, truncated, maxDescriptionLength)
+	assert.True(t, strings.HasSuffix(truncated, "..."))
+}
+
+// request is a request received by a fake API
+type request struct {
+	method string
+	path   string
+	auth   string
+	body   map[string]interface{}
+}
+
+// newFakeAPI returns a server recording the requests it receives, and answering them with the response of the given
+// path if any, or an empty JSON object
+func newFakeAPI(t *testing.T, responses map[string]string) (*httptest.Server, *[]request) {
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{method: r.Method, path: r.URL.Path, auth: r.Header.Get("Authorization")}
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if len(data) > 0 {
+			require.NoError(t, json.Unmarshal(data, &req.body))
+		}
+		requests = append(requests, req)
+		w.Header().Set("Content-Type", "application/json")
+		if response, ok := responses[r.Method+" "+r.URL.Path]; ok {
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGitHubClient(t *testing.T) {
+	server, requests := newFakeAPI(t, map[string]string{
+		"GET /api/v3/repos/org/repo/issues/42/comments": `[{"id": 1, "body": "LGTM"}, {"id": 2, "body": "<!-- marker -->\nprevious preview"}]`,
+		"GET /api/v3/repos/org/repo/issues/43/comments": `[]`,
+	})
+	client, err := NewClient(context.Background(), ProviderGitHub, &v1alpha1.Repository{Repo: server.URL + "/org/repo.git", Password: "token"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.SetCommitStatus(context.Background(), "abc", CommitStatus{State: CommitStateSuccess, Context: "argocd/preview", Description: "No changes", TargetURL: "https://argocd.example.com"}))
+	require.NoError(t, client.UpsertPullRequestComment(context.Background(), 42, "<!-- marker -->", "<!-- marker -->\nnew preview"))
+	require.NoError(t, client.UpsertPullRequestComment(context.Background(), 43, "<!-- marker -->", "<!-- marker -->\nfirst preview"))
+
+	require.Len(t, *requests, 5)
+	assert.Equal(t, request{method: "POST", path: "/api/v3/repos/org/repo/statuses/abc", auth: "Bearer token", body: map[string]interface{}{
+		"state": "success", "context": "argocd/preview", "description": "No changes", "target_url": "https://argocd.example.com",
+	}}, (*requests)[0])
+	assert.Equal(t, "PATCH /api/v3/repos/org/repo/issues/comments/2", (*requests)[2].method+" "+(*requests)[2].path)
+	assert.Equal(t, "<!-- marker -->\nnew preview", (*requests)[2].body["body"])
+	assert.Equal(t, "POST /api/v3/repos/org/repo/issues/43/comments", (*requests)[4].method+" "+(*requests)[4].path)
+
+	_, err = NewClient(context.Background(), ProviderGitHub, &v1alpha1.Repository{Repo: server.URL + "/org/repo.git"})
+	assert.ErrorContains(t, err, "has no token")
+}
+
+func TestGitLabClient(t *testing.T) {
+	server, requests := newFakeAPI(t, map[string]string{
+		"GET /api/v4/projects/group/subgroup/project/merge_requests/7/notes": `[{"id": 3, "body": "<!-- marker -->\nprevious preview"}]`,
+	})
+	client, err := NewClient(context.Background(), ProviderGitLab, &v1alpha1.Repository{Repo: server.URL + "/group/subgroup/project", Password: "token"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.SetCommitStatus(context.Background(), "abc", CommitStatus{State: CommitStateFailure, Context: "argocd/sync", Description: "Sync failed"}))
+	require.NoError(t, client.UpsertPullRequestComment(context.Background(), 7, "<!-- marker -->", "<!-- marker -->\nnew preview"))
+
+	// the first request detects the rate limits of the API
+	require.Len(t, *requests, 4)
+	assert.Equal(t, "POST /api/v4/projects/group/subgroup/project/statuses/abc", (*requests)[1].method+" "+(*requests)[1].path)
+	assert.Equal(t, map[string]interface{}{"state": "failed", "name": "argocd/sync", "description": "Sync failed"}, (*requests)[1].body)
+	assert.Equal(t, "PUT /api/v4/projects/group/subgroup/project/merge_requests/7/notes/3", (*requests)[3].method+" "+(*requests)[3].path)
+}
+
+func TestBitbucketClient(t *testing.T) {
+	server, requests := newFakeAPI(t, map[string]string{
+		"GET /2.0/repositories/workspace/repo/pullrequests/5/comments": `{"values": [{"id": 9, "content": {"raw": "LGTM"}}]}`,
+	})
+	client, err := newBitbucketClient(&v1alpha1.Repository{Repo: "https://bitbucket.org/workspace/repo.git", Username: "user", Password: "app-password"}, server.URL+"/2.0", "workspace/repo")
+	require.NoError(t, err)
+
+	require.NoError(t, client.SetCommitStatus(context.Background(), "abc", CommitStatus{State: CommitStatePending, Context: "argocd/preview", Description: "Computing"}))
+	require.NoError(t, client.UpsertPullRequestComment(context.Background(), 5, "<!-- marker -->", "<!-- marker -->\nfirst preview"))
+
+	require.Len(t, *requests, 3)
+	assert.Equal(t, request{method: "POST", path: "/2.0/repositories/workspace/repo/commit/abc/statuses/build", auth: "Basic dXNlcjphcHAtcGFzc3dvcmQ=", body: map[string]interface{}{
+		"key": "argocd/preview", "name": "argocd/preview", "state": "INPROGRESS", "description": "Computing", "url": "https://bitbucket.org/workspace/repo/commits/abc",
+	}}, (*requests)[0])
+	assert.Equal(t, "POST /2.0/repositories/workspace/repo/pullrequests/5/comments", (*requests)[2].method+" "+(*requests)[2].path)
+	assert.Equal(t, map[string]interface{}{"content": map[string]interface{}{"raw": "<!-- marker -->\nfirst preview"}}, (*requests)[2].body)
+
+	_, err = NewClient(context.Background(), ProviderBitbucket, &v1alpha1.Repository{Repo: "https://bitbucket.example.com/scm/project/repo.git", Password: "token"})
+	assert.ErrorContains(t, err, "only Bitbucket Cloud is supported")
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
@@ -27,6 +28,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/env"
 	httputil "github.com/argoproj/argo-cd/v2/util/http"
 	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	ldaputil "github.com/argoproj/argo-cd/v2/util/ldap"
 	oidcutil "github.com/argoproj/argo-cd/v2/util/oidc"
 	passwordutil "github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/settings"
@@ -41,6 +43,17 @@ type SessionManager struct {
 	storage                       UserStateStorage
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
+	ldapGroups                    *ldapGroupsCache
+	ldapGroupsLock                sync.Mutex
+}
+
+// ldapGroupsCache holds the resolver of the groups of the users from LDAP along with the raw configuration it was
+// created from, so that the configuration is only parsed again when it changes
+type ldapGroupsCache struct {
+	raw string
+	// config is the parsed configuration, with the references to secrets unresolved, or nil if it is invalid
+	config   *settings.LDAPGroupsConfig
+	resolver *ldaputil.GroupResolver
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -175,6 +188,21 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 }
 
 func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
+	signing, err := mgr.settingsMgr.GetTokenSigningSettings()
+	if err != nil {
+		return "", err
+	}
+	if signing.IsAsymmetric() {
+		// the tokens signed with the asymmetric keys reference their key, so that they can be verified with the public
+		// keys published by the API server, including after the key has been rotated
+		key, err := mgr.settingsMgr.GetActiveTokenSigningKey()
+		if err != nil {
+			return "", err
+		}
+		token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+		token.Header["kid"] = key.ID
+		return token.SignedString(key.Signer())
+	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	settings, err := mgr.settingsMgr.GetSettings()
 	if err != nil {
@@ -210,12 +238,33 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	if err != nil {
 		return nil, "", err
 	}
+	signing, err := mgr.settingsMgr.GetTokenSigningSettings()
+	if err != nil {
+		return nil, "", err
+	}
 	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
 		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			// the server signature is shared by every replica, so the tokens signed with it are no longer accepted once
+			// the tokens are signed with asymmetric keys
+			if signing.IsAsymmetric() {
+				return nil, fmt.Errorf("unexpected signing method %v: tokens are signed with %s", token.Header["alg"], signing.Algorithm)
+			}
+			return argoCDSettings.ServerSignature, nil
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+			kid, _ := token.Header["kid"].(string)
+			key, err := mgr.settingsMgr.GetTokenVerificationKey(kid)
+			if err != nil {
+				return nil, err
+			}
+			if key.Algorithm != token.Method.Alg() {
+				return nil, fmt.Errorf("unexpected signing method %v for key %s", token.Header["alg"], kid)
+			}
+			return key.PublicKey(), nil
+		default:
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return argoCDSettings.ServerSignature, nil
 	})
 	if err != nil {
 		return nil, "", err
@@ -464,10 +513,11 @@ func (mgr *SessionManager) VerifyUsernamePassword(username string, password stri
 }
 
 // AuthMiddlewareFunc returns a function that can be used as an
-// authentication middleware for HTTP requests.
-func (mgr *SessionManager) AuthMiddlewareFunc(disabled bool) func(http.Handler) http.Handler {
+// authentication middleware for HTTP requests, authenticated with the token
+// of the cookies of the given name.
+func (mgr *SessionManager) AuthMiddlewareFunc(disabled bool, cookieName string) func(http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
-		return WithAuthMiddleware(disabled, mgr, h)
+		return WithAuthMiddleware(disabled, mgr, cookieName, h)
 	}
 }
 
@@ -478,13 +528,14 @@ type TokenVerifier interface {
 }
 
 // WithAuthMiddleware is an HTTP middleware used to ensure incoming
-// requests are authenticated before invoking the target handler. If
-// disabled is true, it will just invoke the next handler in the chain.
-func WithAuthMiddleware(disabled bool, authn TokenVerifier, next http.Handler) http.Handler {
+// requests are authenticated, with the token of the cookies of the given name,
+// before invoking the target handler. If disabled is true, it will just invoke
+// the next handler in the chain.
+func WithAuthMiddleware(disabled bool, authn TokenVerifier, cookieName string, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !disabled {
 			cookies := r.Cookies()
-			tokenString, err := httputil.JoinCookies(common.AuthCookieName, cookies)
+			tokenString, err := httputil.JoinCookies(cookieName, cookies)
 			if err != nil {
 				http.Error(w, "Auth cookie not found", http.StatusBadRequest)
 				return
@@ -554,10 +605,78 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return nil, "", err
 		}
+		mgr.addLDAPGroups(claims, argoSettings)
 		return claims, "", nil
 	}
 }
 
+// ldapGroupResolver returns the resolver of the groups of the users from LDAP, which is recreated when its
+// configuration or the credentials it binds with change, or nil if the lookup of the groups in LDAP is not configured
+func (mgr *SessionManager) ldapGroupResolver(argoSettings *settings.ArgoCDSettings) *ldaputil.GroupResolver {
+	raw := argoSettings.LDAPGroupsConfigRAW
+	mgr.ldapGroupsLock.Lock()
+	defer mgr.ldapGroupsLock.Unlock()
+	if raw == "" {
+		mgr.ldapGroups = nil
+		return nil
+	}
+	if mgr.ldapGroups == nil || mgr.ldapGroups.raw != raw {
+		config, err := settings.ParseLDAPGroupsConfig(raw)
+		if err != nil {
+			log.Warnf("invalid ldap groups config: %v", err)
+		}
+		mgr.ldapGroups = &ldapGroupsCache{raw: raw, config: config}
+	}
+	cache := mgr.ldapGroups
+	if cache.config == nil {
+		return nil
+	}
+	config := cache.config.WithSecrets(argoSettings.Secrets)
+	if cache.resolver == nil || cache.resolver.Config().BindDN != config.BindDN || cache.resolver.Config().BindPW != config.BindPW {
+		cache.resolver = ldaputil.NewGroupResolver(config)
+	}
+	return cache.resolver
+}
+
+// addLDAPGroups adds the groups of the user found in LDAP to the claims of a token issued by the IDP without
+// groups claim. The email claim identifies the user only if the IDP verified it, since the users may otherwise set
+// the email of another user.
+func (mgr *SessionManager) addLDAPGroups(claims jwt.MapClaims, argoSettings *settings.ArgoCDSettings) {
+	if _, ok := claims["groups"]; ok {
+		return
+	}
+	resolver := mgr.ldapGroupResolver(argoSettings)
+	if resolver == nil {
+		return
+	}
+	claim := resolver.Config().ClaimOrDefault()
+	username := jwtutil.StringField(claims, claim)
+	if username == "" {
+		return
+	}
+	if claim == "email" && !isEmailVerified(claims) {
+		log.Warnf("Not looking up the groups of %s from LDAP: the email is not verified", username)
+		return
+	}
+	groups, err := resolver.Groups(username)
+	if err != nil {
+		log.Warnf("Failed to get the groups of %s from LDAP: %v", username, err)
+		return
+	}
+	claims["groups"] = groups
+}
+
+// isEmailVerified returns whether the email_verified claim is true. Some IDPs set it as a string.
+func isEmailVerified(claims jwt.MapClaims) bool {
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		return verified
+	case string:
+		return verified == "true"
+	}
+	return false
+}
+
 func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
 	if mgr.prov != nil {
 		return mgr.prov, nil
@@ -106,6 +106,60 @@ func TestSessionManager_AdminToken(t *testing.T) {
 	}
 }
 
+func TestSessionManager_AdminToken_AsymmetricSigning(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	kubeClient := getKubeClient("pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["server.token.signing.algorithm"] = "ES256"
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	// the tokens signed with the server signature are rejected once the tokens are signed with asymmetric keys
+	hmacToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Issuer: SessionManagerClaimsIssuer, Subject: "admin:login", ID: "hmac", IssuedAt: jwt.NewNumericDate(time.Now()),
+	}).SignedString([]byte("Hello, world!"))
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(hmacToken)
+	assert.ErrorContains(t, err, "unexpected signing method HS256")
+
+	token, err := mgr.Create("admin:login", 0, "123")
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwt.MapClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "ES256", parsed.Header["alg"])
+	kid := parsed.Header["kid"]
+	assert.NotEmpty(t, kid)
+	require.NoError(t, settingsMgr.ResyncInformers())
+	_, _, err = mgr.Parse(token)
+	assert.NoError(t, err)
+
+	// the tokens signed with a rotated key are accepted during the retention period
+	_, err = settingsMgr.RotateTokenSigningKeys(time.Now().Add(settings.DefaultTokenSigningRotationPeriod), nil)
+	require.NoError(t, err)
+	require.NoError(t, settingsMgr.ResyncInformers())
+	_, _, err = mgr.Parse(token)
+	assert.NoError(t, err)
+	newToken, err := mgr.Create("admin:login", 0, "456")
+	require.NoError(t, err)
+	parsed, _, err = jwt.NewParser().ParseUnverified(newToken, &jwt.MapClaims{})
+	require.NoError(t, err)
+	assert.NotEqual(t, kid, parsed.Header["kid"])
+
+	// the tokens signed with unknown keys are rejected
+	parsed.Header["kid"] = "unknown"
+	key, err := settingsMgr.GetActiveTokenSigningKey()
+	require.NoError(t, err)
+	forged, err := parsed.SignedString(key.Signer())
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(forged)
+	assert.ErrorContains(t, err, "unknown or expired token signing key")
+}
+
 func TestSessionManager_AdminToken_ExpiringSoon(t *testing.T) {
 	redisClient, closer := test.NewInMemoryRedis()
 	defer closer()
@@ -328,7 +382,7 @@ func TestSessionManager_WithAuthMiddleware(t *testing.T) {
 				claims: tc.verifiedClaims,
 				err:    tc.verifyTokenErr,
 			}
-			ts := httptest.NewServer(WithAuthMiddleware(tc.authDisabled, tm, mux))
+			ts := httptest.NewServer(WithAuthMiddleware(tc.authDisabled, tm, common.AuthCookieName, mux))
 			defer ts.Close()
 			req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
 			if err != nil {
@@ -1188,3 +1242,44 @@ requestedScopes: ["oidc"]`, oidcTestServer.URL),
 		assert.ErrorIs(t, err, common.TokenVerificationErr)
 	})
 }
+
+func TestSessionManager_LDAPGroupResolver(t *testing.T) {
+	const config = `host: ldap.example.com:636
+bindDN: $ldap.bindDN
+bindPW: $ldap.bindPW
+userSearch:
+  baseDN: ou=users,dc=example,dc=com
+  username: mail
+groupSearch:
+  baseDN: ou=groups,dc=example,dc=com
+  userAttr: DN
+  groupAttr: member
+  nameAttr: cn
+`
+	mgr := &SessionManager{}
+	argoSettings := &settings.ArgoCDSettings{
+		LDAPGroupsConfigRAW: config,
+		Secrets:             map[string]string{"ldap.bindDN": "cn=admin,dc=example,dc=com", "ldap.bindPW": "password"},
+	}
+
+	resolver := mgr.ldapGroupResolver(argoSettings)
+	require.NotNil(t, resolver)
+	assert.Equal(t, "password", resolver.Config().BindPW)
+	// the resolver is reused while the configuration and the credentials are unchanged
+	assert.Same(t, resolver, mgr.ldapGroupResolver(argoSettings))
+
+	argoSettings.Secrets = map[string]string{"ldap.bindDN": "cn=admin,dc=example,dc=com", "ldap.bindPW": "rotated"}
+	rotated := mgr.ldapGroupResolver(argoSettings)
+	assert.NotSame(t, resolver, rotated)
+	assert.Equal(t, "rotated", rotated.Config().BindPW)
+
+	assert.Nil(t, mgr.ldapGroupResolver(&settings.ArgoCDSettings{LDAPGroupsConfigRAW: "host: ldap.example.com"}))
+	assert.Nil(t, mgr.ldapGroupResolver(&settings.ArgoCDSettings{}))
+}
+
+func TestIsEmailVerified(t *testing.T) {
+	assert.True(t, isEmailVerified(jwt.MapClaims{"email_verified": true}))
+	assert.True(t, isEmailVerified(jwt.MapClaims{"email_verified": "true"}))
+	assert.False(t, isEmailVerified(jwt.MapClaims{"email_verified": false}))
+	assert.False(t, isEmailVerified(jwt.MapClaims{}))
+}
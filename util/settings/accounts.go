@@ -149,11 +149,11 @@ func (mgr *SettingsManager) GetAccounts() (map[string]Account, error) {
 	if err != nil {
 		return nil, err
 	}
-	secret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDSecretName)
+	secret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.GetArgoCDSecretName())
 	if err != nil {
 		return nil, err
 	}
-	cm, err := mgr.configmaps.ConfigMaps(mgr.namespace).Get(common.ArgoCDConfigMapName)
+	cm, err := mgr.configmaps.ConfigMaps(mgr.namespace).Get(common.GetArgoCDConfigMapName())
 	if err != nil {
 		return nil, err
 	}
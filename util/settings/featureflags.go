@@ -0,0 +1,119 @@
+package settings
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+)
+
+// featureFlagsKey is the key of argocd-cm enabling or disabling the feature flags of the Argo CD instance
+const featureFlagsKey = "featureFlags"
+
+// Feature flags gating the subsystems of Argo CD which are rolled out gradually
+const (
+	// FeatureFlagServerSideApply allows the applications to be synced with server-side apply, using the
+	// ServerSideApply=true sync option. When disabled, the option is ignored and client-side apply is used.
+	FeatureFlagServerSideApply = "serverSideApply"
+	// FeatureFlagProgressiveSyncs enables the progressive syncs of the applications generated by ApplicationSets,
+	// like the --enable-progressive-syncs flag of the ApplicationSet controller
+	FeatureFlagProgressiveSyncs = "progressiveSyncs"
+	// FeatureFlagManifestHydration allows the repo server to write the generated manifests of the applications to the
+	// hydrated branch, when it runs with the --hydrated-branch flag
+	FeatureFlagManifestHydration = "manifestHydration"
+)
+
+// FeatureFlag is a feature flag known to this version of Argo CD
+type FeatureFlag struct {
+	// Name is the name of the flag in the featureFlags key of argocd-cm
+	Name string
+	// Description describes the subsystem gated by the flag
+	Description string
+	// Default is whether the flag is enabled when it is not set in argocd-cm
+	Default bool
+}
+
+// KnownFeatureFlags are the feature flags known to this version of Argo CD
+var KnownFeatureFlags = []FeatureFlag{
+	{
+		Name:        FeatureFlagServerSideApply,
+		Description: "Sync the applications with the ServerSideApply=true sync option with server-side apply",
+		Default:     true,
+	},
+	{
+		Name:        FeatureFlagProgressiveSyncs,
+		Description: "Sync the applications generated by ApplicationSets progressively, according to their strategy",
+		Default:     false,
+	},
+	{
+		Name:        FeatureFlagManifestHydration,
+		Description: "Write the generated manifests of the applications to the hydrated branch of their repository",
+		Default:     true,
+	},
+}
+
+// FeatureFlags holds whether each feature flag is enabled, by name
+type FeatureFlags map[string]bool
+
+// Enabled returns whether the given feature flag is enabled. Flags which are neither set nor known are disabled.
+func (f FeatureFlags) Enabled(name string) bool {
+	if enabled, ok := f[name]; ok {
+		return enabled
+	}
+	for _, flag := range KnownFeatureFlags {
+		if flag.Name == name {
+			return flag.Default
+		}
+	}
+	return false
+}
+
+// Names returns the names of the given feature flags in alphabetical order
+func (f FeatureFlags) Names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func unmarshalFeatureFlags(value string) (FeatureFlags, error) {
+	flags := FeatureFlags{}
+	if value == "" {
+		return flags, nil
+	}
+	if err := yaml.Unmarshal([]byte(value), &flags); err != nil {
+		return nil, fmt.Errorf("feature flags must map the names of the flags to true or false: %w", err)
+	}
+	return flags, nil
+}
+
+// GetConfiguredFeatureFlags returns the feature flags set in the featureFlags key of argocd-cm, including the ones
+// unknown to this version of Argo CD
+func (mgr *SettingsManager) GetConfiguredFeatureFlags() (FeatureFlags, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	flags, err := unmarshalFeatureFlags(argoCDCM.Data[featureFlagsKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid '%s' key: %w", featureFlagsKey, err)
+	}
+	return flags, nil
+}
+
+// GetFeatureFlags returns whether each known feature flag is enabled, according to the featureFlags key of argocd-cm
+// and to the defaults of the flags. The flags set in argocd-cm which are unknown to this version of Argo CD, e.g. the
+// ones introduced by a newer version, are ignored.
+func (mgr *SettingsManager) GetFeatureFlags() (FeatureFlags, error) {
+	configured, err := mgr.GetConfiguredFeatureFlags()
+	if err != nil {
+		return nil, err
+	}
+	flags := FeatureFlags{}
+	for _, flag := range KnownFeatureFlags {
+		flags[flag.Name] = configured.Enabled(flag.Name)
+	}
+	return flags, nil
+}
@@ -0,0 +1,56 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+func TestGetFeatureFlags(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{})
+		flags, err := settingsManager.GetFeatureFlags()
+		require.NoError(t, err)
+		assert.Equal(t, FeatureFlags{FeatureFlagServerSideApply: true, FeatureFlagProgressiveSyncs: false, FeatureFlagManifestHydration: true}, flags)
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"featureFlags": "serverSideApply: false\nprogressiveSyncs: true\nfutureSubsystem: true\n",
+		})
+		flags, err := settingsManager.GetFeatureFlags()
+		require.NoError(t, err)
+		assert.Equal(t, FeatureFlags{FeatureFlagServerSideApply: false, FeatureFlagProgressiveSyncs: true, FeatureFlagManifestHydration: true}, flags)
+
+		configured, err := settingsManager.GetConfiguredFeatureFlags()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"futureSubsystem", FeatureFlagProgressiveSyncs, FeatureFlagServerSideApply}, configured.Names())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"featureFlags": "serverSideApply: maybe"})
+		_, err := settingsManager.GetFeatureFlags()
+		assert.ErrorContains(t, err, "invalid 'featureFlags' key")
+	})
+}
+
+func TestFeatureFlags_Enabled(t *testing.T) {
+	flags := FeatureFlags{"futureSubsystem": true}
+	assert.True(t, flags.Enabled("futureSubsystem"))
+	assert.True(t, flags.Enabled(FeatureFlagServerSideApply))
+	assert.False(t, flags.Enabled(FeatureFlagProgressiveSyncs))
+	assert.False(t, flags.Enabled("unknown"))
+}
+
+func TestValidateArgoCDConfigMap_FeatureFlags(t *testing.T) {
+	cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName}}
+	cm.Data = map[string]string{"featureFlags": "serverSideApply: false"}
+	assert.NoError(t, ValidateArgoCDConfigMap(cm))
+	cm.Data = map[string]string{"featureFlags": "- serverSideApply"}
+	assert.ErrorContains(t, ValidateArgoCDConfigMap(cm), "invalid 'featureFlags' key")
+}
@@ -1,11 +1,23 @@
 package settings
 
-import "github.com/argoproj/argo-cd/v2/util/glob"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
 
 type FilteredResource struct {
 	APIGroups []string `json:"apiGroups,omitempty"`
 	Kinds     []string `json:"kinds,omitempty"`
 	Clusters  []string `json:"clusters,omitempty"`
+	// ClusterNames restricts the rule to the clusters whose name matches one of the given patterns
+	ClusterNames []string `json:"clusterNames,omitempty"`
+	// ClusterSelector restricts the rule to the clusters whose labels match the selector
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+	// Projects restricts the rule to the clusters scoped to a project matching one of the given patterns
+	Projects []string `json:"projects,omitempty"`
 }
 
 func (r FilteredResource) matchGroup(apiGroup string) bool {
@@ -35,6 +47,44 @@ func (r FilteredResource) MatchCluster(cluster string) bool {
 	return len(r.Clusters) == 0
 }
 
+func (r FilteredResource) matchClusterName(name string) bool {
+	for _, clusterName := range r.ClusterNames {
+		if glob.Match(clusterName, name) {
+			return true
+		}
+	}
+	return len(r.ClusterNames) == 0
+}
+
+func (r FilteredResource) matchClusterLabels(clusterLabels map[string]string) bool {
+	if r.ClusterSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(r.ClusterSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(clusterLabels))
+}
+
+func (r FilteredResource) matchProject(project string) bool {
+	for _, p := range r.Projects {
+		if glob.Match(p, project) {
+			return true
+		}
+	}
+	return len(r.Projects) == 0
+}
+
+// MatchClusterScope returns whether the rule applies to the given cluster, based on its URL, name, labels and project
+func (r FilteredResource) MatchClusterScope(cluster *v1alpha1.Cluster) bool {
+	return r.MatchCluster(cluster.Server) && r.matchClusterName(cluster.Name) && r.matchClusterLabels(cluster.Labels) && r.matchProject(cluster.Project)
+}
+
 func (r FilteredResource) Match(apiGroup, kind, cluster string) bool {
-	return r.matchGroup(apiGroup) && r.matchKind(kind) && r.MatchCluster(cluster)
+	return r.matchResource(apiGroup, kind, &v1alpha1.Cluster{Server: cluster})
+}
+
+func (r FilteredResource) matchResource(apiGroup, kind string, cluster *v1alpha1.Cluster) bool {
+	return r.matchGroup(apiGroup) && r.matchKind(kind) && r.MatchClusterScope(cluster)
 }
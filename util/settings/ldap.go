@@ -0,0 +1,171 @@
+package settings
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	timeutil "github.com/argoproj/pkg/time"
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultLDAPGroupsClaim is the claim of the tokens identifying the user in the LDAP directory
+	defaultLDAPGroupsClaim = "email"
+	// defaultLDAPGroupsCacheExpiration is how long the groups of a user are cached
+	defaultLDAPGroupsCacheExpiration = 5 * time.Minute
+	// defaultLDAPGroupsMaxDepth is the maximum depth of the nested groups which are resolved
+	defaultLDAPGroupsMaxDepth = 10
+)
+
+// LDAPGroupsConfig is the configuration of the lookup of the groups of the users of an OIDC provider in an LDAP
+// directory, for the OIDC providers whose tokens lack a groups claim. The fields follow the LDAP connector of Dex.
+type LDAPGroupsConfig struct {
+	// Host is the host and the optional port of the LDAP server, e.g. ldap.example.com:636
+	Host string `json:"host"`
+	// InsecureNoSSL connects to the LDAP server without TLS
+	InsecureNoSSL bool `json:"insecureNoSSL,omitempty"`
+	// InsecureSkipVerify skips the verification of the certificate of the LDAP server
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// StartTLS connects to the LDAP server without TLS, then upgrades the connection with StartTLS
+	StartTLS bool `json:"startTLS,omitempty"`
+	// RootCA is the PEM encoded certificate of the CA which signed the certificate of the LDAP server
+	RootCA string `json:"rootCA,omitempty"`
+	// BindDN is the DN the lookups are performed with, usually a reference to a secret, e.g. $ldap.bindDN
+	BindDN string `json:"bindDN,omitempty"`
+	// BindPW is the password of BindDN, usually a reference to a secret, e.g. $ldap.bindPW
+	BindPW string `json:"bindPW,omitempty"`
+	// Claim is the claim of the tokens which is matched against the username attribute of the users, defaults to email.
+	// The email claim is only trusted when the email_verified claim is true.
+	Claim string `json:"claim,omitempty"`
+	// CacheExpiration is how long the groups of a user are cached, defaults to 5m
+	CacheExpiration string `json:"cacheExpiration,omitempty"`
+	// UserSearch configures how the users are found
+	UserSearch LDAPUserSearch `json:"userSearch"`
+	// GroupSearch configures how the groups of the users are found
+	GroupSearch LDAPGroupSearch `json:"groupSearch"`
+}
+
+// LDAPUserSearch configures how the users are found in the LDAP directory
+type LDAPUserSearch struct {
+	// BaseDN is the DN to search the users from, e.g. ou=users,dc=example,dc=com
+	BaseDN string `json:"baseDN"`
+	// Filter is an optional filter applied to the users, e.g. (objectClass=person)
+	Filter string `json:"filter,omitempty"`
+	// Username is the attribute of the users matched against the claim, e.g. mail
+	Username string `json:"username"`
+}
+
+// LDAPGroupSearch configures how the groups of the users are found in the LDAP directory
+type LDAPGroupSearch struct {
+	// BaseDN is the DN to search the groups from, e.g. ou=groups,dc=example,dc=com
+	BaseDN string `json:"baseDN"`
+	// Filter is an optional filter applied to the groups, e.g. (objectClass=groupOfNames)
+	Filter string `json:"filter,omitempty"`
+	// UserAttr is the attribute of the users which the groups reference, e.g. DN
+	UserAttr string `json:"userAttr"`
+	// GroupAttr is the attribute of the groups which references their members, e.g. member
+	GroupAttr string `json:"groupAttr"`
+	// NameAttr is the attribute of the groups holding their name, e.g. cn
+	NameAttr string `json:"nameAttr"`
+	// Nested resolves the groups the groups of the users are members of. The groups reference the groups they
+	// contain by DN through GroupAttr.
+	Nested bool `json:"nested,omitempty"`
+	// MaxDepth is the maximum depth of the nested groups which are resolved, defaults to 10
+	MaxDepth int `json:"maxDepth,omitempty"`
+}
+
+// ParseLDAPGroupsConfig parses and validates the given LDAP groups configuration, leaving the references to secrets
+// unresolved
+func ParseLDAPGroupsConfig(configStr string) (*LDAPGroupsConfig, error) {
+	var config LDAPGroupsConfig
+	if err := yaml.Unmarshal([]byte(configStr), &config); err != nil {
+		return nil, err
+	}
+	if config.Host == "" {
+		return nil, fmt.Errorf("host is required")
+	}
+	if config.UserSearch.BaseDN == "" || config.UserSearch.Username == "" {
+		return nil, fmt.Errorf("userSearch.baseDN and userSearch.username are required")
+	}
+	if config.GroupSearch.BaseDN == "" || config.GroupSearch.UserAttr == "" || config.GroupSearch.GroupAttr == "" || config.GroupSearch.NameAttr == "" {
+		return nil, fmt.Errorf("groupSearch.baseDN, groupSearch.userAttr, groupSearch.groupAttr and groupSearch.nameAttr are required")
+	}
+	if config.CacheExpiration != "" {
+		if _, err := timeutil.ParseDuration(config.CacheExpiration); err != nil {
+			return nil, fmt.Errorf("invalid cacheExpiration: %w", err)
+		}
+	}
+	return &config, nil
+}
+
+// ValidateLDAPGroupsConfig returns an error if the given LDAP groups configuration is invalid
+func ValidateLDAPGroupsConfig(configStr string) error {
+	_, err := ParseLDAPGroupsConfig(configStr)
+	return err
+}
+
+// LDAPGroupsConfig returns the configuration of the lookup of the groups of the users in an LDAP directory, with
+// the references to secrets replaced, or nil if the lookup is not configured or its configuration is invalid
+func (a *ArgoCDSettings) LDAPGroupsConfig() *LDAPGroupsConfig {
+	if a.LDAPGroupsConfigRAW == "" {
+		return nil
+	}
+	config, err := ParseLDAPGroupsConfig(a.LDAPGroupsConfigRAW)
+	if err != nil {
+		log.Warnf("invalid ldap groups config: %v", err)
+		return nil
+	}
+	return config.WithSecrets(a.Secrets)
+}
+
+// WithSecrets returns a copy of the configuration with the references to the given secrets replaced
+func (c *LDAPGroupsConfig) WithSecrets(secrets map[string]string) *LDAPGroupsConfig {
+	config := *c
+	config.BindDN = ReplaceStringSecret(c.BindDN, secrets)
+	config.BindPW = ReplaceStringSecret(c.BindPW, secrets)
+	return &config
+}
+
+// ClaimOrDefault returns the claim identifying the user in the LDAP directory
+func (c *LDAPGroupsConfig) ClaimOrDefault() string {
+	if c.Claim == "" {
+		return defaultLDAPGroupsClaim
+	}
+	return c.Claim
+}
+
+// CacheExpirationOrDefault returns how long the groups of a user are cached
+func (c *LDAPGroupsConfig) CacheExpirationOrDefault() time.Duration {
+	if c.CacheExpiration == "" {
+		return defaultLDAPGroupsCacheExpiration
+	}
+	expiration, err := timeutil.ParseDuration(c.CacheExpiration)
+	if err != nil {
+		return defaultLDAPGroupsCacheExpiration
+	}
+	return *expiration
+}
+
+// MaxDepthOrDefault returns the maximum depth of the nested groups which are resolved
+func (c *LDAPGroupSearch) MaxDepthOrDefault() int {
+	if c.MaxDepth <= 0 {
+		return defaultLDAPGroupsMaxDepth
+	}
+	return c.MaxDepth
+}
+
+// TLSConfig returns the TLS config used to connect to the LDAP server
+func (c *LDAPGroupsConfig) TLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify}
+	if c.RootCA != "" {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM([]byte(c.RootCA)) {
+			return nil, fmt.Errorf("failed to append the certificates of rootCA")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+	return tlsConfig, nil
+}
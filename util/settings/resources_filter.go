@@ -1,5 +1,9 @@
 package settings
 
+import (
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
 // The core exclusion list are K8s resources that we assume will never be managed by operators,
 // and are never child objects of managed resources that need to be presented in the resource tree.
 // This list contains high volume and  high churn metadata objects which we exclude for performance
@@ -21,10 +25,10 @@ func (rf *ResourcesFilter) getExcludedResources() []FilteredResource {
 	return append(coreExcludedResources, rf.ResourceExclusions...)
 }
 
-func (rf *ResourcesFilter) checkResourcePresence(apiGroup, kind, cluster string, filteredResources []FilteredResource) bool {
+func (rf *ResourcesFilter) checkResourcePresence(apiGroup, kind string, cluster *v1alpha1.Cluster, filteredResources []FilteredResource) bool {
 
 	for _, includedResource := range filteredResources {
-		if includedResource.Match(apiGroup, kind, cluster) {
+		if includedResource.matchResource(apiGroup, kind, cluster) {
 			return true
 		}
 	}
@@ -32,11 +36,11 @@ func (rf *ResourcesFilter) checkResourcePresence(apiGroup, kind, cluster string,
 	return false
 }
 
-func (rf *ResourcesFilter) isIncludedResource(apiGroup, kind, cluster string) bool {
+func (rf *ResourcesFilter) isIncludedResource(apiGroup, kind string, cluster *v1alpha1.Cluster) bool {
 	return rf.checkResourcePresence(apiGroup, kind, cluster, rf.ResourceInclusions)
 }
 
-func (rf *ResourcesFilter) isExcludedResource(apiGroup, kind, cluster string) bool {
+func (rf *ResourcesFilter) isExcludedResource(apiGroup, kind string, cluster *v1alpha1.Cluster) bool {
 	return rf.checkResourcePresence(apiGroup, kind, cluster, rf.getExcludedResources())
 }
 
@@ -63,7 +67,15 @@ func (rf *ResourcesFilter) isExcludedResource(apiGroup, kind, cluster string) bo
 // |   Present   |   Present   | Not Allowed |
 // +-------------+-------------+-------------+
 //
+// Rules restricted to cluster names, labels or projects never apply, since only the cluster URL is known. Use
+// IsExcludedClusterResource to evaluate them.
 func (rf *ResourcesFilter) IsExcludedResource(apiGroup, kind, cluster string) bool {
+	return rf.IsExcludedClusterResource(apiGroup, kind, &v1alpha1.Cluster{Server: cluster})
+}
+
+// IsExcludedClusterResource returns whether the given resource is excluded on the given cluster, which is matched
+// against the cluster URLs, names, labels and projects of the rules
+func (rf *ResourcesFilter) IsExcludedClusterResource(apiGroup, kind string, cluster *v1alpha1.Cluster) bool {
 	// if excluded, do not allow
 	if rf.isExcludedResource(apiGroup, kind, cluster) {
 		return true
@@ -76,7 +88,7 @@ func (rf *ResourcesFilter) IsExcludedResource(apiGroup, kind, cluster string) bo
 
 	// if inclusion rules defined for cluster, default is not allow
 	for _, includedResource := range rf.ResourceInclusions {
-		if includedResource.MatchCluster(cluster) {
+		if includedResource.MatchClusterScope(cluster) {
 			return true
 		}
 	}
@@ -84,3 +96,20 @@ func (rf *ResourcesFilter) IsExcludedResource(apiGroup, kind, cluster string) bo
 	// if no inclusion rules defined for cluster, default is allow
 	return false
 }
+
+// ForCluster returns the filter of the resources of the given cluster, which is used by the cache of the cluster
+func (rf *ResourcesFilter) ForCluster(cluster *v1alpha1.Cluster) *ClusterResourcesFilter {
+	return &ClusterResourcesFilter{filter: rf, cluster: cluster}
+}
+
+// ClusterResourcesFilter filters the resources of a single cluster
+type ClusterResourcesFilter struct {
+	filter  *ResourcesFilter
+	cluster *v1alpha1.Cluster
+}
+
+// IsExcludedResource returns whether the given resource is excluded on the cluster of the filter. The cluster URL
+// argument is ignored in favor of the cluster of the filter.
+func (f *ClusterResourcesFilter) IsExcludedResource(apiGroup, kind, _ string) bool {
+	return f.filter.IsExcludedClusterResource(apiGroup, kind, f.cluster)
+}
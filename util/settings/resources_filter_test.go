@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 func TestIsExcludedResource(t *testing.T) {
@@ -60,3 +63,41 @@ func TestResourceInclusionsExclusionMultiCluster(t *testing.T) {
 	assert.True(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-two"))
 	assert.False(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-three"))
 }
+
+func TestResourceExclusionsScopedToClusters(t *testing.T) {
+	filter := &ResourcesFilter{
+		ResourceExclusions: []FilteredResource{
+			{APIGroups: []string{"noisy.io"}, ClusterNames: []string{"prod-*"}},
+			{APIGroups: []string{"labeled.io"}, ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}},
+			{APIGroups: []string{"project.io"}, Projects: []string{"team-a"}},
+		},
+	}
+	prod := &v1alpha1.Cluster{Server: "https://prod", Name: "prod-1", Labels: map[string]string{"env": "prod"}, Project: "team-a"}
+	dev := &v1alpha1.Cluster{Server: "https://dev", Name: "dev-1", Labels: map[string]string{"env": "dev"}}
+
+	assert.True(t, filter.IsExcludedClusterResource("noisy.io", "Foo", prod))
+	assert.False(t, filter.IsExcludedClusterResource("noisy.io", "Foo", dev))
+	assert.True(t, filter.IsExcludedClusterResource("labeled.io", "Foo", prod))
+	assert.False(t, filter.IsExcludedClusterResource("labeled.io", "Foo", dev))
+	assert.True(t, filter.IsExcludedClusterResource("project.io", "Foo", prod))
+	assert.False(t, filter.IsExcludedClusterResource("project.io", "Foo", dev))
+
+	// scoped rules never apply when only the cluster URL is known
+	assert.False(t, filter.IsExcludedResource("noisy.io", "Foo", "https://prod"))
+
+	clusterFilter := filter.ForCluster(prod)
+	assert.True(t, clusterFilter.IsExcludedResource("noisy.io", "Foo", "https://prod"))
+	assert.False(t, clusterFilter.IsExcludedResource("other.io", "Foo", "https://prod"))
+}
+
+func TestResourceInclusionsScopedToClusters(t *testing.T) {
+	filter := &ResourcesFilter{
+		ResourceInclusions: []FilteredResource{{APIGroups: []string{"apps"}, ClusterNames: []string{"restricted"}}},
+	}
+	restricted := &v1alpha1.Cluster{Server: "https://restricted", Name: "restricted"}
+	other := &v1alpha1.Cluster{Server: "https://other", Name: "other"}
+
+	assert.False(t, filter.IsExcludedClusterResource("apps", "Deployment", restricted))
+	assert.True(t, filter.IsExcludedClusterResource("batch", "Job", restricted))
+	assert.False(t, filter.IsExcludedClusterResource("batch", "Job", other))
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util"
 	"github.com/argoproj/argo-cd/v2/util/crypto"
 	"github.com/argoproj/argo-cd/v2/util/kube"
+	"github.com/argoproj/argo-cd/v2/util/lua"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	tlsutil "github.com/argoproj/argo-cd/v2/util/tls"
 )
@@ -54,6 +55,8 @@ type ArgoCDSettings struct {
 	DexConfig string `json:"dexConfig,omitempty"`
 	// OIDCConfigRAW holds OIDC configuration as a raw string
 	OIDCConfigRAW string `json:"oidcConfig,omitempty"`
+	// LDAPGroupsConfigRAW holds the configuration of the lookup of the groups of the users in an LDAP directory as a raw string
+	LDAPGroupsConfigRAW string `json:"ldapGroupsConfig,omitempty"`
 	// ServerSignature holds the key used to generate JWT tokens.
 	ServerSignature []byte `json:"serverSignature,omitempty"`
 	// Certificate holds the certificate/private key for the Argo CD API server.
@@ -397,6 +400,8 @@ const (
 	settingDexConfigKey = "dex.config"
 	// settingsOIDCConfigKey designates the key for OIDC config
 	settingsOIDCConfigKey = "oidc.config"
+	// settingsLDAPGroupsConfigKey designates the key for the configuration of the lookup of the groups of the users in an LDAP directory
+	settingsLDAPGroupsConfigKey = "ldap.groups.config"
 	// statusBadgeEnabledKey holds the key which enables of disables status badge feature
 	statusBadgeEnabledKey = "statusbadge.enabled"
 	// statusBadgeRootUrlKey holds the key for the root badge URL override
@@ -415,8 +420,25 @@ const (
 	settingsApplicationInstanceLabelKey = "application.instanceLabelKey"
 	// settingsResourceTrackingMethodKey is the key to configure tracking method for application resources
 	settingsResourceTrackingMethodKey = "application.resourceTrackingMethod"
+	// settingsSourcePathChangeDetectionKey is the key to enable refreshing applications without the manifest-generate-paths
+	// annotation only when files under their source path have changed
+	settingsSourcePathChangeDetectionKey = "application.sourcePathChangeDetection"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
+	// resourceCustomizationsBundleKeyPrefix is the prefix of the keys configuring the bundle of resource customizations
+	// stored in Git
+	resourceCustomizationsBundleKeyPrefix = "resource.customizations.bundle."
+	// resourceCustomizationsBundleRepoURLKey is the key to the URL of the repository holding the bundle of resource
+	// customizations
+	resourceCustomizationsBundleRepoURLKey = "resource.customizations.bundle.repoURL"
+	// resourceCustomizationsBundlePathKey is the key to the directory of the repository holding the bundle of resource
+	// customizations
+	resourceCustomizationsBundlePathKey = "resource.customizations.bundle.path"
+	// resourceCustomizationsBundleTargetRevisionKey is the key to the revision of the bundle of resource customizations
+	resourceCustomizationsBundleTargetRevisionKey = "resource.customizations.bundle.targetRevision"
+	// resourceCustomizationsBundleSignatureKeysKey is the key to the comma separated list of the GnuPG keys allowed to
+	// sign the revisions of the bundle of resource customizations
+	resourceCustomizationsBundleSignatureKeysKey = "resource.customizations.bundle.signatureKeys"
 	// resourceExclusions is the key to the list of excluded resources
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
@@ -465,10 +487,20 @@ const (
 	settingsPasswordPatternKey = "passwordPattern"
 	// inClusterEnabledKey is the key to configure whether to allow in-cluster server address
 	inClusterEnabledKey = "cluster.inClusterEnabled"
+	// clusterMaxConcurrentSyncsKey is the key to configure the default maximum number of concurrent sync operations per destination cluster
+	clusterMaxConcurrentSyncsKey = "cluster.maxConcurrentSyncs"
+	// automationPausedKey is the key to pause the automated syncs of all the applications
+	automationPausedKey = "automation.paused"
+	// automationPausedReasonKey is the key holding the reason why the automated syncs of all the applications are paused
+	automationPausedReasonKey = "automation.paused.reason"
+	// impersonationEnabledKey is the key to configure whether the sync operations impersonate the service accounts of the project destinations
+	impersonationEnabledKey = "application.sync.impersonation.enabled"
 	// settingsServerRBACLogEnforceEnable is the key to configure whether logs RBAC enforcement is enabled
 	settingsServerRBACLogEnforceEnableKey = "server.rbac.log.enforce.enable"
 	// helmValuesFileSchemesKey is the key to configure the list of supported helm values file schemas
 	helmValuesFileSchemesKey = "helm.valuesFileSchemes"
+	// helmPathPrefixKey is the key prefix of the paths of the binaries of additional Helm versions
+	helmPathPrefixKey = "helm.path"
 	// execEnabledKey is the key to configure whether the UI exec feature is enabled
 	execEnabledKey = "exec.enabled"
 	// execShellsKey is the key to configure which shells are allowed for `exec` and in what order they are tried
@@ -508,6 +540,26 @@ type SettingsManager struct {
 	reposCache            []Repository
 	repoCredsCache        []RepositoryCredentials
 	reposOrClusterChanged func()
+	// bundleRevision is the revision of the bundle of resource customizations the bundleCustomizations were loaded from
+	bundleRevision       string
+	bundleCustomizations map[string]string
+	// tokenSigningKeys are the keys parsed from the version tokenSigningKeysVersion of the token signing keys secret
+	tokenSigningKeys        TokenSigningKeys
+	tokenSigningKeysVersion string
+	tokenSigningKeysLock    sync.Mutex
+}
+
+// ResourceCustomizationsBundle is the location in Git of a bundle of resource customizations
+type ResourceCustomizationsBundle struct {
+	// RepoURL is the URL of the repository holding the bundle
+	RepoURL string
+	// Path is the directory of the repository holding the config maps of the bundle
+	Path string
+	// TargetRevision is the revision of the repository to load the bundle from
+	TargetRevision string
+	// SignatureKeys are the IDs of the GnuPG keys allowed to sign the revisions of the bundle. The revisions are not
+	// verified if empty.
+	SignatureKeys []string
 }
 
 type incompleteSettingsError struct {
@@ -563,7 +615,7 @@ func (mgr *SettingsManager) updateSecret(callback func(*apiv1.Secret) error) err
 	if err != nil {
 		return err
 	}
-	argoCDSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDSecretName)
+	argoCDSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.GetArgoCDSecretName())
 	createSecret := false
 	if err != nil {
 		if !apierr.IsNotFound(err) {
@@ -571,7 +623,7 @@ func (mgr *SettingsManager) updateSecret(callback func(*apiv1.Secret) error) err
 		}
 		argoCDSecret = &apiv1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: common.ArgoCDSecretName,
+				Name: common.GetArgoCDSecretName(),
 			},
 			Data: make(map[string][]byte),
 		}
@@ -612,7 +664,7 @@ func (mgr *SettingsManager) updateConfigMap(callback func(*apiv1.ConfigMap) erro
 		}
 		argoCDCM = &apiv1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: common.ArgoCDConfigMapName,
+				Name: common.GetArgoCDConfigMapName(),
 			},
 		}
 		createCM = true
@@ -649,7 +701,7 @@ func (mgr *SettingsManager) getConfigMap() (*apiv1.ConfigMap, error) {
 	if err != nil {
 		return nil, err
 	}
-	argoCDCM, err := mgr.configmaps.ConfigMaps(mgr.namespace).Get(common.ArgoCDConfigMapName)
+	argoCDCM, err := mgr.configmaps.ConfigMaps(mgr.namespace).Get(common.GetArgoCDConfigMapName())
 	if err != nil {
 		return nil, err
 	}
@@ -745,6 +797,78 @@ func (mgr *SettingsManager) GetServerRBACLogEnforceEnable() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[settingsServerRBACLogEnforceEnableKey])
 }
 
+// GetSourcePathChangeDetectionEnabled returns whether the source path of applications without the manifest-generate-paths
+// annotation is used to detect whether a change in the repository affects them
+func (mgr *SettingsManager) GetSourcePathChangeDetectionEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+
+	if argoCDCM.Data[settingsSourcePathChangeDetectionKey] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(argoCDCM.Data[settingsSourcePathChangeDetectionKey])
+}
+
+// GetClusterMaxConcurrentSyncs returns the default maximum number of concurrent sync operations per destination
+// cluster. Zero means no limit.
+func (mgr *SettingsManager) GetClusterMaxConcurrentSyncs() (int64, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, err
+	}
+	value := argoCDCM.Data[clusterMaxConcurrentSyncsKey]
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", clusterMaxConcurrentSyncsKey, err)
+	}
+	if limit < 0 {
+		return 0, fmt.Errorf("%s must not be negative", clusterMaxConcurrentSyncsKey)
+	}
+	return limit, nil
+}
+
+// IsImpersonationEnabled returns whether the sync operations impersonate the service accounts configured for the
+// destinations of the projects
+func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	return argoCDCM.Data[impersonationEnabledKey] == "true", nil
+}
+
+// GetAutomationPaused returns whether the automated syncs of all the applications are paused, and the reason why
+func (mgr *SettingsManager) GetAutomationPaused() (bool, string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, "", err
+	}
+	return argoCDCM.Data[automationPausedKey] == "true", argoCDCM.Data[automationPausedReasonKey], nil
+}
+
+// SetAutomationPaused pauses or resumes the automated syncs of all the applications
+func (mgr *SettingsManager) SetAutomationPaused(paused bool, reason string) error {
+	return mgr.updateConfigMap(func(argoCDCM *apiv1.ConfigMap) error {
+		if paused {
+			argoCDCM.Data[automationPausedKey] = "true"
+		} else {
+			delete(argoCDCM.Data, automationPausedKey)
+		}
+		if paused && reason != "" {
+			argoCDCM.Data[automationPausedReasonKey] = reason
+		} else {
+			delete(argoCDCM.Data, automationPausedReasonKey)
+		}
+		return nil
+	})
+}
+
 func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManagementPlugin, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -801,6 +925,13 @@ func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.Resource
 		return nil, err
 	}
 	resourceOverrides := map[string]v1alpha1.ResourceOverride{}
+	// the customizations of the config map take precedence over the ones of the bundle
+	mgr.mutex.Lock()
+	bundleCustomizations := mgr.bundleCustomizations
+	mgr.mutex.Unlock()
+	if err := appendResourceOverridesFromSplitKeys(bundleCustomizations, resourceOverrides); err != nil {
+		return nil, fmt.Errorf("invalid resource customizations bundle: %w", err)
+	}
 	if value, ok := argoCDCM.Data[resourceCustomizationsKey]; ok && value != "" {
 		err := yaml.Unmarshal([]byte(value), &resourceOverrides)
 		if err != nil {
@@ -808,7 +939,7 @@ func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.Resource
 		}
 	}
 
-	err = mgr.appendResourceOverridesFromSplitKeys(argoCDCM.Data, resourceOverrides)
+	err = appendResourceOverridesFromSplitKeys(argoCDCM.Data, resourceOverrides)
 	if err != nil {
 		return nil, err
 	}
@@ -865,9 +996,9 @@ func addIgnoreDiffItemOverrideToGK(resourceOverrides map[string]v1alpha1.Resourc
 	}
 }
 
-func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[string]string, resourceOverrides map[string]v1alpha1.ResourceOverride) error {
+func appendResourceOverridesFromSplitKeys(cmData map[string]string, resourceOverrides map[string]v1alpha1.ResourceOverride) error {
 	for k, v := range cmData {
-		if !strings.HasPrefix(k, resourceCustomizationsKey) {
+		if !strings.HasPrefix(k, resourceCustomizationsKey) || strings.HasPrefix(k, resourceCustomizationsBundleKeyPrefix) {
 			continue
 		}
 
@@ -903,6 +1034,13 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 			overrideVal.UseOpenLibs = useOpenLibs
 		case "actions":
 			overrideVal.Actions = v
+		case "healthAggregation":
+			var healthAggregation v1alpha1.HealthAggregation
+			err := yaml.Unmarshal([]byte(v), &healthAggregation)
+			if err != nil {
+				return err
+			}
+			overrideVal.HealthAggregation = &healthAggregation
 		case "ignoreDifferences":
 			overrideIgnoreDiff := v1alpha1.OverrideIgnoreDiff{}
 			err := yaml.Unmarshal([]byte(v), &overrideIgnoreDiff)
@@ -938,6 +1076,74 @@ func convertToOverrideKey(groupKind string) (string, error) {
 	return "", fmt.Errorf("group kind should be in format `resource.customizations.<type>.<group_kind>` or resource.customizations.<type>.<kind>`, got group kind: '%s'", groupKind)
 }
 
+// GetResourceCustomizationsBundle loads the location of the bundle of resource customizations from the argocd-cm
+// ConfigMap. It returns nil if no bundle is configured.
+func (mgr *SettingsManager) GetResourceCustomizationsBundle() (*ResourceCustomizationsBundle, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	repoURL := argoCDCM.Data[resourceCustomizationsBundleRepoURLKey]
+	if repoURL == "" {
+		return nil, nil
+	}
+	bundle := &ResourceCustomizationsBundle{
+		RepoURL:        repoURL,
+		Path:           argoCDCM.Data[resourceCustomizationsBundlePathKey],
+		TargetRevision: argoCDCM.Data[resourceCustomizationsBundleTargetRevisionKey],
+	}
+	if bundle.Path == "" {
+		bundle.Path = "."
+	}
+	if bundle.TargetRevision == "" {
+		bundle.TargetRevision = "HEAD"
+	}
+	for _, key := range strings.Split(argoCDCM.Data[resourceCustomizationsBundleSignatureKeysKey], ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			bundle.SignatureKeys = append(bundle.SignatureKeys, key)
+		}
+	}
+	return bundle, nil
+}
+
+// SetResourceCustomizationsBundle sets the resource customizations loaded from the given revision of the bundle, which
+// are merged with the ones of the argocd-cm ConfigMap, and notifies the subscribers to settings updates if the revision
+// changed
+func (mgr *SettingsManager) SetResourceCustomizationsBundle(revision string, customizations map[string]string) {
+	mgr.mutex.Lock()
+	changed := mgr.bundleRevision != revision
+	mgr.bundleRevision = revision
+	mgr.bundleCustomizations = customizations
+	mgr.mutex.Unlock()
+	if !changed {
+		return
+	}
+	newSettings, err := mgr.GetSettings()
+	if err != nil {
+		log.Warnf("Unable to parse updated settings: %v", err)
+		return
+	}
+	mgr.notifySubscribers(newSettings)
+}
+
+// GetResourceCustomizationsBundleRevision returns the revision of the bundle the resource customizations were loaded
+// from, or an empty string if none were loaded
+func (mgr *SettingsManager) GetResourceCustomizationsBundleRevision() string {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	return mgr.bundleRevision
+}
+
+// ValidateResourceCustomizations validates resource customizations defined with the
+// `resource.customizations.<type>.<group_kind>` keys
+func ValidateResourceCustomizations(customizations map[string]string) error {
+	resourceOverrides := map[string]v1alpha1.ResourceOverride{}
+	if err := appendResourceOverridesFromSplitKeys(customizations, resourceOverrides); err != nil {
+		return err
+	}
+	return lua.ValidateResourceOverrides(resourceOverrides)
+}
+
 func GetDefaultDiffOptions() ArgoCDDiffOptions {
 	return ArgoCDDiffOptions{IgnoreAggregatedRoles: false}
 }
@@ -978,6 +1184,15 @@ func (mgr *SettingsManager) GetHelmSettings() (*v1alpha1.HelmOptions, error) {
 	} else {
 		helmOptions.ValuesFileSchemes = []string{"https", "http"}
 	}
+	// extract version and path from helm.path.<version>
+	for k, v := range argoCDCM.Data {
+		if strings.HasPrefix(k, helmPathPrefixKey+".") {
+			if helmOptions.Versions == nil {
+				helmOptions.Versions = map[string]string{}
+			}
+			helmOptions.Versions[k[len(helmPathPrefixKey)+1:]] = v
+		}
+	}
 	return helmOptions, nil
 }
 
@@ -1185,11 +1400,11 @@ func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 	if err != nil {
 		return nil, err
 	}
-	argoCDCM, err := mgr.configmaps.ConfigMaps(mgr.namespace).Get(common.ArgoCDConfigMapName)
+	argoCDCM, err := mgr.configmaps.ConfigMaps(mgr.namespace).Get(common.GetArgoCDConfigMapName())
 	if err != nil {
 		return nil, err
 	}
-	argoCDSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDSecretName)
+	argoCDSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.GetArgoCDSecretName())
 	if err != nil {
 		return nil, err
 	}
@@ -1331,6 +1546,7 @@ func getDownloadBinaryUrlsFromConfigMap(argoCDCM *apiv1.ConfigMap) map[string]st
 func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.ConfigMap) {
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
+	settings.LDAPGroupsConfigRAW = argoCDCM.Data[settingsLDAPGroupsConfigKey]
 	settings.KustomizeBuildOptions = argoCDCM.Data[kustomizeBuildOptionsKey]
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	settings.StatusBadgeRootUrl = argoCDCM.Data[statusBadgeRootUrlKey]
@@ -1390,6 +1606,71 @@ func validateExternalURL(u string) error {
 	return nil
 }
 
+// ValidateArgoCDConfigMap validates the settings stored in the given argocd-cm config map, without applying them
+func ValidateArgoCDConfigMap(argoCDCM *apiv1.ConfigMap) error {
+	if err := validateExternalURL(argoCDCM.Data[settingURLKey]); err != nil {
+		return fmt.Errorf("invalid '%s' key: %w", settingURLKey, err)
+	}
+	if err := validateExternalURL(argoCDCM.Data[settingUiBannerURLKey]); err != nil {
+		return fmt.Errorf("invalid '%s' key: %w", settingUiBannerURLKey, err)
+	}
+	if value, ok := argoCDCM.Data[settingDexConfigKey]; ok {
+		if _, err := UnmarshalDexConfig(value); err != nil {
+			return fmt.Errorf("invalid '%s' key: %w", settingDexConfigKey, err)
+		}
+	}
+	if value, ok := argoCDCM.Data[settingsOIDCConfigKey]; ok {
+		if err := ValidateOIDCConfig(value); err != nil {
+			return fmt.Errorf("invalid '%s' key: %w", settingsOIDCConfigKey, err)
+		}
+	}
+	if value, ok := argoCDCM.Data[settingsLDAPGroupsConfigKey]; ok {
+		if err := ValidateLDAPGroupsConfig(value); err != nil {
+			return fmt.Errorf("invalid '%s' key: %w", settingsLDAPGroupsConfigKey, err)
+		}
+	}
+	if _, err := unmarshalFeatureFlags(argoCDCM.Data[featureFlagsKey]); err != nil {
+		return fmt.Errorf("invalid '%s' key: %w", featureFlagsKey, err)
+	}
+	if value, ok := argoCDCM.Data[userSessionDurationKey]; ok {
+		if _, err := timeutil.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid '%s' key: %w", userSessionDurationKey, err)
+		}
+	}
+	if _, err := parseTokenSigningSettings(argoCDCM.Data); err != nil {
+		return err
+	}
+	for _, key := range []string{resourceInclusionsKey, resourceExclusionsKey} {
+		if value, ok := argoCDCM.Data[key]; ok {
+			var resources []FilteredResource
+			if err := yaml.Unmarshal([]byte(value), &resources); err != nil {
+				return fmt.Errorf("invalid '%s' key: %w", key, err)
+			}
+			for _, resource := range resources {
+				if resource.ClusterSelector == nil {
+					continue
+				}
+				if _, err := metav1.LabelSelectorAsSelector(resource.ClusterSelector); err != nil {
+					return fmt.Errorf("invalid cluster selector in '%s' key: %w", key, err)
+				}
+			}
+		}
+	}
+	resourceOverrides := map[string]v1alpha1.ResourceOverride{}
+	if value, ok := argoCDCM.Data[resourceCustomizationsKey]; ok {
+		if err := yaml.Unmarshal([]byte(value), &resourceOverrides); err != nil {
+			return fmt.Errorf("invalid '%s' key: %w", resourceCustomizationsKey, err)
+		}
+	}
+	if err := appendResourceOverridesFromSplitKeys(argoCDCM.Data, resourceOverrides); err != nil {
+		return fmt.Errorf("invalid resource customizations: %w", err)
+	}
+	if err := lua.ValidateResourceOverrides(resourceOverrides); err != nil {
+		return fmt.Errorf("invalid resource customizations: %w", err)
+	}
+	return nil
+}
+
 // updateSettingsFromSecret transfers settings from a Kubernetes secret into an ArgoCDSettings struct.
 func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secret, secrets []*apiv1.Secret) error {
 	var errs []error
@@ -1420,7 +1701,7 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	// load it from argocd-secret or generate (and persist) a self-signed one.
 	cert, err := mgr.externalServerTLSCertificate()
 	if err != nil {
-		errs = append(errs, &incompleteSettingsError{message: fmt.Sprintf("could not read from secret %s/%s: %v", mgr.namespace, externalServerTLSSecretName, err)})
+		errs = append(errs, &incompleteSettingsError{message: fmt.Sprintf("could not read from secret %s/%s: %v", mgr.namespace, common.InstanceResourceName(externalServerTLSSecretName), err)})
 	} else {
 		if cert != nil {
 			settings.Certificate = cert
@@ -1461,7 +1742,7 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 // return values are nil, no external secret has been configured.
 func (mgr *SettingsManager) externalServerTLSCertificate() (*tls.Certificate, error) {
 	var cert tls.Certificate
-	secret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(mgr.ctx, externalServerTLSSecretName, metav1.GetOptions{})
+	secret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(mgr.ctx, common.InstanceResourceName(externalServerTLSSecretName), metav1.GetOptions{})
 	if err != nil {
 		if apierr.IsNotFound(err) {
 			return nil, nil
@@ -1496,6 +1777,11 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		} else {
 			delete(argoCDCM.Data, settingsOIDCConfigKey)
 		}
+		if settings.LDAPGroupsConfigRAW != "" {
+			argoCDCM.Data[settingsLDAPGroupsConfigKey] = settings.LDAPGroupsConfigRAW
+		} else {
+			delete(argoCDCM.Data, settingsLDAPGroupsConfigKey)
+		}
 		if settings.UiCssURL != "" {
 			argoCDCM.Data[settingUiCssURLKey] = settings.UiCssURL
 		}
@@ -1554,7 +1840,7 @@ func (mgr *SettingsManager) SaveSSHKnownHostsData(ctx context.Context, knownHost
 		return err
 	}
 
-	certCM, err := mgr.GetConfigMapByName(common.ArgoCDKnownHostsConfigMapName)
+	certCM, err := mgr.GetConfigMapByName(common.GetArgoCDKnownHostsConfigMapName())
 	if err != nil {
 		return err
 	}
@@ -1579,7 +1865,7 @@ func (mgr *SettingsManager) SaveTLSCertificateData(ctx context.Context, tlsCerti
 		return err
 	}
 
-	certCM, err := mgr.GetConfigMapByName(common.ArgoCDTLSCertsConfigMapName)
+	certCM, err := mgr.GetConfigMapByName(common.GetArgoCDTLSCertsConfigMapName())
 	if err != nil {
 		return err
 	}
@@ -1599,7 +1885,7 @@ func (mgr *SettingsManager) SaveGPGPublicKeyData(ctx context.Context, gpgPublicK
 		return err
 	}
 
-	keysCM, err := mgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	keysCM, err := mgr.GetConfigMapByName(common.GetArgoCDGPGKeysConfigMapName())
 	if err != nil {
 		return err
 	}
@@ -1925,7 +2211,7 @@ func (mgr *SettingsManager) InitializeSettings(insecureModeEnabled bool) (*ArgoC
 					return err
 				}
 				ku := kube.NewKubeUtil(mgr.clientset, mgr.ctx)
-				err = ku.CreateOrUpdateSecretField(mgr.namespace, initialPasswordSecretName, initialPasswordSecretField, initialPassword)
+				err = ku.CreateOrUpdateSecretField(mgr.namespace, common.InstanceResourceName(initialPasswordSecretName), initialPasswordSecretField, initialPassword)
 				if err != nil {
 					return err
 				}
@@ -1950,10 +2236,10 @@ func (mgr *SettingsManager) InitializeSettings(insecureModeEnabled bool) (*ArgoC
 		// generate TLS cert
 		hosts := []string{
 			"localhost",
-			"argocd-server",
-			fmt.Sprintf("argocd-server.%s", mgr.namespace),
-			fmt.Sprintf("argocd-server.%s.svc", mgr.namespace),
-			fmt.Sprintf("argocd-server.%s.svc.cluster.local", mgr.namespace),
+			common.GetDefaultServerServiceName(),
+			fmt.Sprintf("%s.%s", common.GetDefaultServerServiceName(), mgr.namespace),
+			fmt.Sprintf("%s.%s.svc", common.GetDefaultServerServiceName(), mgr.namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", common.GetDefaultServerServiceName(), mgr.namespace),
 		}
 		certOpts := tlsutil.CertOptions{
 			Hosts:        hosts,
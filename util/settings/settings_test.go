@@ -197,6 +197,59 @@ func TestGetAppInstanceLabelKey(t *testing.T) {
 	assert.Equal(t, "testLabel", label)
 }
 
+func TestGetClusterMaxConcurrentSyncs(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	limit, err := settingsManager.GetClusterMaxConcurrentSyncs()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), limit)
+
+	_, settingsManager = fixtures(map[string]string{
+		"cluster.maxConcurrentSyncs": "4",
+	})
+	limit, err = settingsManager.GetClusterMaxConcurrentSyncs()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), limit)
+
+	_, settingsManager = fixtures(map[string]string{
+		"cluster.maxConcurrentSyncs": "-1",
+	})
+	_, err = settingsManager.GetClusterMaxConcurrentSyncs()
+	assert.Error(t, err)
+}
+
+func TestIsImpersonationEnabled(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	enabled, err := settingsManager.IsImpersonationEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.sync.impersonation.enabled": "true",
+	})
+	enabled, err = settingsManager.IsImpersonationEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestAutomationPaused(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	paused, _, err := settingsManager.GetAutomationPaused()
+	assert.NoError(t, err)
+	assert.False(t, paused)
+
+	assert.NoError(t, settingsManager.SetAutomationPaused(true, "cluster upgrade"))
+	paused, reason, err := settingsManager.GetAutomationPaused()
+	assert.NoError(t, err)
+	assert.True(t, paused)
+	assert.Equal(t, "cluster upgrade", reason)
+
+	assert.NoError(t, settingsManager.SetAutomationPaused(false, ""))
+	paused, reason, err = settingsManager.GetAutomationPaused()
+	assert.NoError(t, err)
+	assert.False(t, paused)
+	assert.Empty(t, reason)
+}
+
 func TestGetServerRBACLogEnforceEnableKeyDefaultFalse(t *testing.T) {
 	_, settingsManager := fixtures(nil)
 	serverRBACLogEnforceEnable, err := settingsManager.GetServerRBACLogEnforceEnable()
@@ -318,6 +371,65 @@ func TestSettingsManager_GetResourceOverrides_with_empty_string(t *testing.T) {
 	assert.Len(t, overrides, 1)
 }
 
+func TestGetResourceOverrides_with_bundle(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"resource.customizations.bundle.repoURL":            "https://github.com/example/customizations",
+		"resource.customizations.health.example.com_Widget": "hs = {status = 'Degraded'}\nreturn hs",
+	})
+	settingsManager.SetResourceCustomizationsBundle("abc123", map[string]string{
+		"resource.customizations.health.example.com_Widget":  "hs = {status = 'Healthy'}\nreturn hs",
+		"resource.customizations.actions.example.com_Widget": "discovery.lua: return {}",
+		"resource.customizations.health.example.com_Gadget":  "hs = {status = 'Healthy'}\nreturn hs",
+	})
+	assert.Equal(t, "abc123", settingsManager.GetResourceCustomizationsBundleRevision())
+
+	overrides, err := settingsManager.GetResourceOverrides()
+	require.NoError(t, err)
+	assert.Equal(t, "hs = {status = 'Degraded'}\nreturn hs", overrides["example.com/Widget"].HealthLua)
+	assert.Equal(t, "discovery.lua: return {}", overrides["example.com/Widget"].Actions)
+	assert.Equal(t, "hs = {status = 'Healthy'}\nreturn hs", overrides["example.com/Gadget"].HealthLua)
+
+	settingsManager.SetResourceCustomizationsBundle("", nil)
+	overrides, err = settingsManager.GetResourceOverrides()
+	require.NoError(t, err)
+	assert.NotContains(t, overrides, "example.com/Gadget")
+}
+
+func TestGetResourceCustomizationsBundle(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{})
+		bundle, err := settingsManager.GetResourceCustomizationsBundle()
+		require.NoError(t, err)
+		assert.Nil(t, bundle)
+	})
+
+	t.Run("Defaults", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.bundle.repoURL": "https://github.com/example/customizations",
+		})
+		bundle, err := settingsManager.GetResourceCustomizationsBundle()
+		require.NoError(t, err)
+		assert.Equal(t, &ResourceCustomizationsBundle{RepoURL: "https://github.com/example/customizations", Path: ".", TargetRevision: "HEAD"}, bundle)
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"resource.customizations.bundle.repoURL":        "https://github.com/example/customizations",
+			"resource.customizations.bundle.path":           "argocd",
+			"resource.customizations.bundle.targetRevision": "v1.0.0",
+			"resource.customizations.bundle.signatureKeys":  "4AEE18F83AFDEB23, 9B2E7E5E",
+		})
+		bundle, err := settingsManager.GetResourceCustomizationsBundle()
+		require.NoError(t, err)
+		assert.Equal(t, &ResourceCustomizationsBundle{
+			RepoURL:        "https://github.com/example/customizations",
+			Path:           "argocd",
+			TargetRevision: "v1.0.0",
+			SignatureKeys:  []string{"4AEE18F83AFDEB23", "9B2E7E5E"},
+		}, bundle)
+	})
+}
+
 func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 	data := map[string]string{
 		"resource.customizations": `
@@ -368,6 +480,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.useOpenLibs.cert-manager.io_Certificate":    "true",
 			"resource.customizations.actions.apps_Deployment":                    "bar",
 			"resource.customizations.actions.Deployment":                         "bar",
+			"resource.customizations.healthAggregation.apps_Deployment":          "strategy: quorum\nquorum: 50%",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
 			"resource.customizations.health.Iamrole":                             "bar",
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
@@ -399,6 +512,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, true, overrides["cert-manager.io/Certificate"].UseOpenLibs)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
+		assert.Equal(t, &v1alpha1.HealthAggregation{Strategy: "quorum", Quorum: "50%"}, overrides["apps/Deployment"].HealthAggregation)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
 		assert.Equal(t, "bar", overrides["Iamrole"].HealthLua)
 		assert.Equal(t, 1, len(overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers))
@@ -1296,6 +1410,19 @@ func TestGetHelmSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestGetHelmSettings_Versions(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"helm.path.v3.10.3": "/custom-tools/helm-v3.10.3",
+		"helm.path.v3.11.0": "/custom-tools/helm-v3.11.0",
+	})
+
+	helmSettings, err := settingsManager.GetHelmSettings()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"v3.10.3": "/custom-tools/helm-v3.10.3", "v3.11.0": "/custom-tools/helm-v3.11.0"}, helmSettings.Versions)
+	assert.Equal(t, "/custom-tools/helm-v3.10.3", helmSettings.GetBinaryPath("v3.10.3"))
+	assert.Equal(t, "", helmSettings.GetBinaryPath("v3"))
+}
 func TestArgoCDSettings_OIDCTLSConfig_OIDCTLSInsecureSkipVerify(t *testing.T) {
 	certParsed, err := tls.X509KeyPair(test.Cert, test.PrivateKey)
 	require.NoError(t, err)
@@ -1426,3 +1553,82 @@ allowedAudiences: ["aud1", "aud2"]`},
 		})
 	}
 }
+
+func TestValidateArgoCDConfigMap(t *testing.T) {
+	newConfigMap := func(data map[string]string) *v1.ConfigMap {
+		return &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName}, Data: data}
+	}
+	t.Run("Valid", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{
+			"url":                     "https://argocd.example.com",
+			"users.session.duration":  "12h",
+			"resource.exclusions":     "- apiGroups: [\"*\"]\n  kinds: [Event]\n  clusters: [\"*\"]\n",
+			"resource.customizations": "apps/Deployment:\n  ignoreDifferences: |\n    jsonPointers:\n    - /spec/replicas\n",
+			"oidc.config":             "name: Okta\nissuer: https://dev.okta.com\n",
+		}))
+		assert.NoError(t, err)
+	})
+	t.Run("InvalidURL", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"url": "argocd.example.com"}))
+		assert.ErrorContains(t, err, "'url'")
+	})
+	t.Run("InvalidSessionDuration", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"users.session.duration": "forever"}))
+		assert.ErrorContains(t, err, "'users.session.duration'")
+	})
+	t.Run("InvalidResourceExclusions", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"resource.exclusions": "kinds: Event"}))
+		assert.ErrorContains(t, err, "'resource.exclusions'")
+	})
+	t.Run("InvalidResourceCustomizationScript", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"resource.customizations.health.apps_Deployment": "return {"}))
+		assert.ErrorContains(t, err, "invalid health script of 'apps/Deployment'")
+	})
+	t.Run("InvalidClusterSelector", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"resource.exclusions": "- kinds: [Event]\n  clusterSelector:\n    matchExpressions:\n    - key: env\n      operator: Unknown\n"}))
+		assert.ErrorContains(t, err, "invalid cluster selector in 'resource.exclusions'")
+	})
+	t.Run("InvalidOIDCConfig", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"oidc.config": "[invalid"}))
+		assert.ErrorContains(t, err, "'oidc.config'")
+	})
+	t.Run("InvalidLDAPGroupsConfig", func(t *testing.T) {
+		err := ValidateArgoCDConfigMap(newConfigMap(map[string]string{"ldap.groups.config": "host: ldap.example.com\n"}))
+		assert.ErrorContains(t, err, "'ldap.groups.config'")
+	})
+}
+
+func TestArgoCDSettings_LDAPGroupsConfig(t *testing.T) {
+	const config = `host: ldap.example.com:636
+bindDN: $ldap.bindDN
+bindPW: $ldap.bindPW
+cacheExpiration: 10m
+userSearch:
+  baseDN: ou=users,dc=example,dc=com
+  username: mail
+groupSearch:
+  baseDN: ou=groups,dc=example,dc=com
+  userAttr: DN
+  groupAttr: member
+  nameAttr: cn
+  nested: true
+`
+	s := ArgoCDSettings{
+		LDAPGroupsConfigRAW: config,
+		Secrets: map[string]string{
+			"ldap.bindDN": "cn=admin,dc=example,dc=com",
+			"ldap.bindPW": "password",
+		},
+	}
+	ldapConfig := s.LDAPGroupsConfig()
+	require.NotNil(t, ldapConfig)
+	assert.Equal(t, "cn=admin,dc=example,dc=com", ldapConfig.BindDN)
+	assert.Equal(t, "password", ldapConfig.BindPW)
+	assert.Equal(t, "email", ldapConfig.ClaimOrDefault())
+	assert.Equal(t, 10*time.Minute, ldapConfig.CacheExpirationOrDefault())
+	assert.Equal(t, 10, ldapConfig.GroupSearch.MaxDepthOrDefault())
+	assert.True(t, ldapConfig.GroupSearch.Nested)
+
+	assert.Nil(t, (&ArgoCDSettings{}).LDAPGroupsConfig())
+	assert.Nil(t, (&ArgoCDSettings{LDAPGroupsConfigRAW: "host: ldap.example.com"}).LDAPGroupsConfig())
+}
@@ -0,0 +1,497 @@
+package settings
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	timeutil "github.com/argoproj/pkg/time"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/square/go-jose.v2"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+const (
+	// tokenSigningAlgorithmKey is the key of argocd-cm designating the algorithm signing the tokens issued by Argo CD
+	tokenSigningAlgorithmKey = "server.token.signing.algorithm"
+	// tokenSigningRotationPeriodKey is the key of argocd-cm designating the period after which the asymmetric key
+	// signing the tokens is replaced by a new one
+	tokenSigningRotationPeriodKey = "server.token.signing.rotation.period"
+	// tokenSigningRetentionPeriodKey is the key of argocd-cm designating the minimum period during which the tokens
+	// signed with a replaced key are still accepted
+	tokenSigningRetentionPeriodKey = "server.token.signing.retention.period"
+	// tokenSigningKeysKey is the key of the token signing keys secret holding the keys
+	tokenSigningKeysKey = "keys"
+)
+
+// Algorithms signing the tokens issued by Argo CD
+const (
+	// TokenSigningAlgorithmHS256 signs the tokens with the server.secretkey key of argocd-secret. This is the default.
+	// The tokens signed with HS256 are no longer accepted once the tokens are signed with an asymmetric algorithm.
+	TokenSigningAlgorithmHS256 = "HS256"
+	// TokenSigningAlgorithmRS256 signs the tokens with 2048 bits RSA keys
+	TokenSigningAlgorithmRS256 = "RS256"
+	// TokenSigningAlgorithmES256 signs the tokens with P-256 ECDSA keys
+	TokenSigningAlgorithmES256 = "ES256"
+)
+
+const (
+	// DefaultTokenSigningRotationPeriod is the default period after which the key signing the tokens is replaced
+	DefaultTokenSigningRotationPeriod = 30 * 24 * time.Hour
+	// DefaultTokenSigningRetentionPeriod is the default minimum period during which the tokens signed with a replaced
+	// key are still accepted
+	DefaultTokenSigningRetentionPeriod = 30 * 24 * time.Hour
+	// tokenSigningKeyIssuanceGracePeriod is the margin around the period a key was active within which the tokens were
+	// possibly signed with it, since the replicas of the API server learn about rotations with some delay
+	tokenSigningKeyIssuanceGracePeriod = 10 * time.Minute
+)
+
+// TokenSigningSettings holds how the tokens issued by Argo CD are signed
+type TokenSigningSettings struct {
+	// Algorithm is the algorithm signing the tokens
+	Algorithm string
+	// RotationPeriod is the period after which the asymmetric key signing the tokens is replaced. The keys are not
+	// rotated if zero.
+	RotationPeriod time.Duration
+	// RetentionPeriod is the minimum period during which the tokens signed with a replaced key are still accepted. The
+	// replaced keys are kept longer as long as unexpired API tokens of accounts or project roles were issued while
+	// they were active.
+	RetentionPeriod time.Duration
+}
+
+// IsAsymmetric returns whether the tokens are signed with the asymmetric keys of the token signing keys secret,
+// which can be verified with the public keys published by the API server
+func (s *TokenSigningSettings) IsAsymmetric() bool {
+	return s.Algorithm != TokenSigningAlgorithmHS256
+}
+
+func parseTokenSigningSettings(data map[string]string) (*TokenSigningSettings, error) {
+	s := &TokenSigningSettings{
+		Algorithm:       TokenSigningAlgorithmHS256,
+		RotationPeriod:  DefaultTokenSigningRotationPeriod,
+		RetentionPeriod: DefaultTokenSigningRetentionPeriod,
+	}
+	if value, ok := data[tokenSigningAlgorithmKey]; ok && value != "" {
+		switch value {
+		case TokenSigningAlgorithmHS256, TokenSigningAlgorithmRS256, TokenSigningAlgorithmES256:
+			s.Algorithm = value
+		default:
+			return nil, fmt.Errorf("invalid '%s' key: unsupported algorithm %s, must be one of %s, %s or %s",
+				tokenSigningAlgorithmKey, value, TokenSigningAlgorithmHS256, TokenSigningAlgorithmRS256, TokenSigningAlgorithmES256)
+		}
+	}
+	for key, period := range map[string]*time.Duration{tokenSigningRotationPeriodKey: &s.RotationPeriod, tokenSigningRetentionPeriodKey: &s.RetentionPeriod} {
+		if value, ok := data[key]; ok && value != "" {
+			duration, err := timeutil.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid '%s' key: %w", key, err)
+			}
+			*period = *duration
+		}
+	}
+	return s, nil
+}
+
+// GetTokenSigningSettings returns how the tokens issued by Argo CD are signed, according to argocd-cm
+func (mgr *SettingsManager) GetTokenSigningSettings() (*TokenSigningSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenSigningSettings(argoCDCM.Data)
+}
+
+// TokenSigningKey is an asymmetric key signing the tokens issued by Argo CD
+type TokenSigningKey struct {
+	// ID is the ID of the key, set in the kid header of the tokens it signs. It is the thumbprint of the public key.
+	ID string `json:"id"`
+	// Algorithm is the algorithm signing the tokens with the key
+	Algorithm string `json:"algorithm"`
+	// PrivateKey is the private key, PEM encoded in the PKCS #8 format
+	PrivateKey string `json:"privateKey"`
+	// CreatedAt is the time the key was created
+	CreatedAt time.Time `json:"createdAt"`
+	// RetiredAt is the time the key was replaced by a new key, after which it no longer signs tokens
+	RetiredAt *time.Time `json:"retiredAt,omitempty"`
+
+	privateKey crypto.Signer
+}
+
+// Signer returns the private key, i.e. an *rsa.PrivateKey or an *ecdsa.PrivateKey
+func (k *TokenSigningKey) Signer() crypto.Signer {
+	return k.privateKey
+}
+
+// PublicKey returns the public key verifying the tokens signed with the key
+func (k *TokenSigningKey) PublicKey() crypto.PublicKey {
+	return k.privateKey.Public()
+}
+
+// IsRetired returns whether the key was replaced by a new key
+func (k *TokenSigningKey) IsRetired() bool {
+	return k.RetiredAt != nil
+}
+
+// isExpired returns whether the key was retired longer than the retention period ago
+func (k *TokenSigningKey) isExpired(retention time.Duration, now time.Time) bool {
+	return k.RetiredAt != nil && now.Sub(*k.RetiredAt) >= retention
+}
+
+// mayHaveSigned returns whether the given token, which is unexpired at the given time, was possibly signed with the key
+func (k *TokenSigningKey) mayHaveSigned(token Token, now time.Time) bool {
+	if token.ExpiresAt > 0 && time.Unix(token.ExpiresAt, 0).Before(now) {
+		return false
+	}
+	issuedAt := time.Unix(token.IssuedAt, 0)
+	if issuedAt.Before(k.CreatedAt.Add(-tokenSigningKeyIssuanceGracePeriod)) {
+		return false
+	}
+	return k.RetiredAt == nil || !issuedAt.After(k.RetiredAt.Add(tokenSigningKeyIssuanceGracePeriod))
+}
+
+// JSONWebKey returns the public key as a JSON Web Key
+func (k *TokenSigningKey) JSONWebKey() jose.JSONWebKey {
+	return jose.JSONWebKey{Key: k.PublicKey(), KeyID: k.ID, Algorithm: k.Algorithm, Use: "sig"}
+}
+
+func (k *TokenSigningKey) parse() error {
+	block, _ := pem.Decode([]byte(k.PrivateKey))
+	if block == nil {
+		return fmt.Errorf("private key of key %s is not PEM encoded", k.ID)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse private key of key %s: %w", k.ID, err)
+	}
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		if k.Algorithm != TokenSigningAlgorithmRS256 {
+			return fmt.Errorf("key %s is an RSA key, which cannot sign tokens with %s", k.ID, k.Algorithm)
+		}
+		k.privateKey = key
+	case *ecdsa.PrivateKey:
+		if k.Algorithm != TokenSigningAlgorithmES256 {
+			return fmt.Errorf("key %s is an ECDSA key, which cannot sign tokens with %s", k.ID, k.Algorithm)
+		}
+		k.privateKey = key
+	default:
+		return fmt.Errorf("key %s is neither an RSA nor an ECDSA key", k.ID)
+	}
+	return nil
+}
+
+// newTokenSigningKey generates a key signing the tokens with the given algorithm
+func newTokenSigningKey(algorithm string, now time.Time) (*TokenSigningKey, error) {
+	var privateKey crypto.Signer
+	var err error
+	switch algorithm {
+	case TokenSigningAlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	case TokenSigningAlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("cannot generate a key for algorithm %s", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s key: %w", algorithm, err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s key: %w", algorithm, err)
+	}
+	thumbprint, err := (&jose.JSONWebKey{Key: privateKey.Public()}).Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute thumbprint of %s key: %w", algorithm, err)
+	}
+	return &TokenSigningKey{
+		ID:         base64.RawURLEncoding.EncodeToString(thumbprint),
+		Algorithm:  algorithm,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		CreatedAt:  now.UTC(),
+		privateKey: privateKey,
+	}, nil
+}
+
+// TokenSigningKeys are the keys of the token signing keys secret, from the oldest to the newest
+type TokenSigningKeys []*TokenSigningKey
+
+// Active returns the newest key which is not retired and signs the tokens with the given algorithm, or nil if there
+// is none
+func (keys TokenSigningKeys) Active(algorithm string) *TokenSigningKey {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if !keys[i].IsRetired() && keys[i].Algorithm == algorithm {
+			return keys[i]
+		}
+	}
+	return nil
+}
+
+// Get returns the key with the given ID, or nil if there is none
+func (keys TokenSigningKeys) Get(id string) *TokenSigningKey {
+	for _, key := range keys {
+		if key.ID == id {
+			return key
+		}
+	}
+	return nil
+}
+
+// JSONWebKeySet returns the public keys as a JSON Web Key Set
+func (keys TokenSigningKeys) JSONWebKeySet() jose.JSONWebKeySet {
+	set := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		set.Keys = append(set.Keys, key.JSONWebKey())
+	}
+	return set
+}
+
+func unmarshalTokenSigningKeys(data []byte) (TokenSigningKeys, error) {
+	var keys TokenSigningKeys
+	if len(data) == 0 {
+		return keys, nil
+	}
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token signing keys: %w", err)
+	}
+	for _, key := range keys {
+		if err := key.parse(); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// rotateTokenSigningKeys returns the keys after the keys which are due have been rotated, and whether they changed.
+// A new key is generated when there is no active key of the configured algorithm or when the active key is older
+// than the rotation period, in which case the active keys are retired. The retired keys are removed once the
+// retention period elapsed and none of the given tokens, which are the long-lived tokens issued by Argo CD, was
+// possibly signed with them. The keys are never removed if the tokens are nil.
+func rotateTokenSigningKeys(keys TokenSigningKeys, signing *TokenSigningSettings, now time.Time, tokens []Token) (TokenSigningKeys, bool, error) {
+	changed := false
+	var rotated TokenSigningKeys
+	for _, key := range keys {
+		if tokens != nil && key.isExpired(signing.RetentionPeriod, now) && !key.signedAny(tokens, now) {
+			changed = true
+			continue
+		}
+		rotated = append(rotated, key)
+	}
+
+	retire := !signing.IsAsymmetric()
+	if signing.IsAsymmetric() {
+		active := rotated.Active(signing.Algorithm)
+		if active == nil || (signing.RotationPeriod > 0 && now.Sub(active.CreatedAt) >= signing.RotationPeriod) {
+			retire = true
+		}
+	}
+	if retire {
+		for i, key := range rotated {
+			if !key.IsRetired() {
+				retired := *key
+				retiredAt := now.UTC()
+				retired.RetiredAt = &retiredAt
+				rotated[i] = &retired
+				changed = true
+			}
+		}
+		if signing.IsAsymmetric() {
+			key, err := newTokenSigningKey(signing.Algorithm, now)
+			if err != nil {
+				return nil, false, err
+			}
+			rotated = append(rotated, key)
+			changed = true
+		}
+	}
+	return rotated, changed, nil
+}
+
+// signedAny returns whether any of the given tokens was possibly signed with the key and is still unexpired
+func (k *TokenSigningKey) signedAny(tokens []Token, now time.Time) bool {
+	for _, token := range tokens {
+		if k.mayHaveSigned(token, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTokenSigningKeys returns the keys of the token signing keys secret
+func (mgr *SettingsManager) GetTokenSigningKeys() (TokenSigningKeys, error) {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.GetArgoCDTokenSigningKeysSecretName())
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	mgr.tokenSigningKeysLock.Lock()
+	defer mgr.tokenSigningKeysLock.Unlock()
+	// the keys are parsed once per version of the secret, since they are looked up to verify every token
+	if secret.ResourceVersion != "" && mgr.tokenSigningKeysVersion == secret.ResourceVersion {
+		return mgr.tokenSigningKeys, nil
+	}
+	keys, err := unmarshalTokenSigningKeys(secret.Data[tokenSigningKeysKey])
+	if err != nil {
+		return nil, err
+	}
+	mgr.tokenSigningKeysVersion = secret.ResourceVersion
+	mgr.tokenSigningKeys = keys
+	return keys, nil
+}
+
+// GetActiveTokenSigningKey returns the key signing the tokens with the configured asymmetric algorithm. The key is
+// generated if there is none yet.
+func (mgr *SettingsManager) GetActiveTokenSigningKey() (*TokenSigningKey, error) {
+	signing, err := mgr.GetTokenSigningSettings()
+	if err != nil {
+		return nil, err
+	}
+	if !signing.IsAsymmetric() {
+		return nil, fmt.Errorf("tokens are signed with %s, which does not use asymmetric keys", signing.Algorithm)
+	}
+	keys, err := mgr.GetTokenSigningKeys()
+	if err != nil {
+		return nil, err
+	}
+	if key := keys.Active(signing.Algorithm); key != nil {
+		return key, nil
+	}
+	// the key is generated on demand by any replica, without removing the retired keys which requires the tokens
+	// issued to project roles
+	keys, err = mgr.updateTokenSigningKeys(signing, time.Now(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if key := keys.Active(signing.Algorithm); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("no active %s token signing key", signing.Algorithm)
+}
+
+// GetTokenVerificationKey returns the key with the given ID, which verifies the tokens it signed. The keys are removed
+// from the token signing keys secret once the tokens they signed are no longer accepted.
+func (mgr *SettingsManager) GetTokenVerificationKey(id string) (*TokenSigningKey, error) {
+	keys, err := mgr.GetTokenSigningKeys()
+	if err != nil {
+		return nil, err
+	}
+	key := keys.Get(id)
+	if key == nil {
+		return nil, fmt.Errorf("unknown or expired token signing key %q", id)
+	}
+	return key, nil
+}
+
+// GetTokenVerificationKeySet returns the public keys which verify the tokens issued by Argo CD
+func (mgr *SettingsManager) GetTokenVerificationKeySet() (*jose.JSONWebKeySet, error) {
+	keys, err := mgr.GetTokenSigningKeys()
+	if err != nil {
+		return nil, err
+	}
+	set := keys.JSONWebKeySet()
+	return &set, nil
+}
+
+// RotateTokenSigningKeys generates a new key when the active key is due for rotation, retires the replaced keys and
+// removes the expired ones, and returns the resulting keys. The retired keys are kept as long as the API tokens of the
+// accounts or the given tokens of the project roles which were possibly signed with them are unexpired, since these
+// tokens might never expire. It must be called by a single replica of the API server at a time.
+func (mgr *SettingsManager) RotateTokenSigningKeys(now time.Time, projectTokens []Token) (TokenSigningKeys, error) {
+	signing, err := mgr.GetTokenSigningSettings()
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := mgr.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+	tokens := append([]Token{}, projectTokens...)
+	for _, account := range accounts {
+		tokens = append(tokens, account.Tokens...)
+	}
+	return mgr.updateTokenSigningKeys(signing, now, tokens)
+}
+
+// updateTokenSigningKeys rotates the keys and writes them into the token signing keys secret. Concurrent updates are
+// detected with the resource version of the secret: the update of a replica which read an outdated version fails, and
+// the keys written by the other replica are returned instead.
+func (mgr *SettingsManager) updateTokenSigningKeys(signing *TokenSigningSettings, now time.Time, tokens []Token) (TokenSigningKeys, error) {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.GetArgoCDTokenSigningKeysSecretName())
+	createSecret := false
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return nil, err
+		}
+		secret = &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   common.GetArgoCDTokenSigningKeysSecretName(),
+				Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+		}
+		createSecret = true
+	}
+	keys, err := unmarshalTokenSigningKeys(secret.Data[tokenSigningKeysKey])
+	if err != nil {
+		return nil, err
+	}
+	rotated, changed, err := rotateTokenSigningKeys(keys, signing, now, tokens)
+	if err != nil || !changed {
+		return rotated, err
+	}
+	data, err := json.Marshal(rotated)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := secret.DeepCopy()
+	if updated.Data == nil {
+		updated.Data = map[string][]byte{}
+	}
+	updated.Data[tokenSigningKeysKey] = data
+	secrets := mgr.clientset.CoreV1().Secrets(mgr.namespace)
+	if createSecret {
+		_, err = secrets.Create(context.Background(), updated, metav1.CreateOptions{})
+	} else {
+		_, err = secrets.Update(context.Background(), updated, metav1.UpdateOptions{})
+	}
+	if apierr.IsAlreadyExists(err) || apierr.IsConflict(err) {
+		log.Debugf("Token signing keys were rotated concurrently: %v", err)
+		secret, err = secrets.Get(context.Background(), common.GetArgoCDTokenSigningKeysSecretName(), metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return unmarshalTokenSigningKeys(secret.Data[tokenSigningKeysKey])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if active := rotated.Active(signing.Algorithm); active != nil {
+		log.Infof("Rotated token signing keys, the active %s key is %s", signing.Algorithm, active.ID)
+	} else {
+		log.Infof("Retired token signing keys, the tokens are signed with %s", signing.Algorithm)
+	}
+	return rotated, nil
+}
@@ -0,0 +1,144 @@
+package settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+func TestGetTokenSigningSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	signing, err := settingsManager.GetTokenSigningSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &TokenSigningSettings{
+		Algorithm:       TokenSigningAlgorithmHS256,
+		RotationPeriod:  DefaultTokenSigningRotationPeriod,
+		RetentionPeriod: DefaultTokenSigningRetentionPeriod,
+	}, signing)
+	assert.False(t, signing.IsAsymmetric())
+
+	_, settingsManager = fixtures(map[string]string{
+		"server.token.signing.algorithm":        "ES256",
+		"server.token.signing.rotation.period":  "168h",
+		"server.token.signing.retention.period": "48h",
+	})
+	signing, err = settingsManager.GetTokenSigningSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &TokenSigningSettings{Algorithm: TokenSigningAlgorithmES256, RotationPeriod: 168 * time.Hour, RetentionPeriod: 48 * time.Hour}, signing)
+	assert.True(t, signing.IsAsymmetric())
+}
+
+func TestValidateArgoCDConfigMap_TokenSigning(t *testing.T) {
+	cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName}}
+	cm.Data = map[string]string{"server.token.signing.algorithm": "RS256"}
+	assert.NoError(t, ValidateArgoCDConfigMap(cm))
+	cm.Data = map[string]string{"server.token.signing.algorithm": "none"}
+	assert.ErrorContains(t, ValidateArgoCDConfigMap(cm), "invalid 'server.token.signing.algorithm' key")
+	cm.Data = map[string]string{"server.token.signing.rotation.period": "monthly"}
+	assert.ErrorContains(t, ValidateArgoCDConfigMap(cm), "invalid 'server.token.signing.rotation.period' key")
+}
+
+func TestRotateTokenSigningKeys(t *testing.T) {
+	now := time.Now()
+	signing := &TokenSigningSettings{Algorithm: TokenSigningAlgorithmES256, RotationPeriod: 24 * time.Hour, RetentionPeriod: 2 * time.Hour}
+
+	keys, changed, err := rotateTokenSigningKeys(nil, signing, now, []Token{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.Len(t, keys, 1)
+	first := keys.Active(TokenSigningAlgorithmES256)
+	require.NotNil(t, first)
+	assert.NotEmpty(t, first.ID)
+
+	// the active key is kept until the rotation period elapsed
+	keys, changed, err = rotateTokenSigningKeys(keys, signing, now.Add(time.Hour), []Token{})
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, keys, 1)
+
+	// the replaced key still verifies the tokens during the retention period
+	keys, changed, err = rotateTokenSigningKeys(keys, signing, now.Add(24*time.Hour), []Token{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.Len(t, keys, 2)
+	assert.True(t, keys.Get(first.ID).IsRetired())
+	assert.False(t, first.IsRetired(), "the given keys must not be modified")
+	second := keys.Active(TokenSigningAlgorithmES256)
+	require.NotNil(t, second)
+	assert.NotEqual(t, first.ID, second.ID)
+	keys, changed, err = rotateTokenSigningKeys(keys, signing, now.Add(25*time.Hour), []Token{})
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, keys, 2)
+
+	// the replaced key is kept as long as a token issued while it was active is unexpired, or when the tokens are unknown
+	apiToken := Token{ID: "api", IssuedAt: now.Add(time.Hour).Unix()}
+	keys, changed, err = rotateTokenSigningKeys(keys, signing, now.Add(26*time.Hour), []Token{apiToken})
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, keys, 2)
+	keys, changed, err = rotateTokenSigningKeys(keys, signing, now.Add(26*time.Hour), nil)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, keys, 2)
+
+	// the replaced key is removed once the retention period elapsed and the tokens it signed expired
+	expiredToken := Token{ID: "expired", IssuedAt: now.Add(time.Hour).Unix(), ExpiresAt: now.Add(2 * time.Hour).Unix()}
+	laterToken := Token{ID: "later", IssuedAt: now.Add(25 * time.Hour).Unix()}
+	keys, changed, err = rotateTokenSigningKeys(keys, signing, now.Add(26*time.Hour), []Token{expiredToken, laterToken})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.Len(t, keys, 1)
+	assert.Equal(t, second.ID, keys[0].ID)
+
+	// the keys are retired when switching back to HS256
+	keys, changed, err = rotateTokenSigningKeys(keys, &TokenSigningSettings{Algorithm: TokenSigningAlgorithmHS256, RetentionPeriod: time.Hour}, now.Add(27*time.Hour), []Token{})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.Len(t, keys, 1)
+	assert.True(t, keys[0].IsRetired())
+	assert.Nil(t, keys.Active(TokenSigningAlgorithmES256))
+}
+
+func TestSettingsManager_RotateTokenSigningKeys(t *testing.T) {
+	kubeClient, settingsManager := fixtures(map[string]string{"server.token.signing.algorithm": "RS256"})
+
+	key, err := settingsManager.GetActiveTokenSigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, TokenSigningAlgorithmRS256, key.Algorithm)
+
+	secret, err := kubeClient.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDTokenSigningKeysSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	keys, err := unmarshalTokenSigningKeys(secret.Data["keys"])
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, key.ID, keys[0].ID)
+
+	require.NoError(t, settingsManager.ResyncInformers())
+	verification, err := settingsManager.GetTokenVerificationKey(key.ID)
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey(), verification.PublicKey())
+	_, err = settingsManager.GetTokenVerificationKey("unknown")
+	assert.Error(t, err)
+
+	keySet, err := settingsManager.GetTokenVerificationKeySet()
+	require.NoError(t, err)
+	require.Len(t, keySet.Keys, 1)
+	assert.Equal(t, key.ID, keySet.Keys[0].KeyID)
+	assert.True(t, keySet.Keys[0].IsPublic())
+
+	// the keys are not rotated before the rotation period elapsed
+	rotated, err := settingsManager.RotateTokenSigningKeys(time.Now(), nil)
+	require.NoError(t, err)
+	assert.Len(t, rotated, 1)
+	rotated, err = settingsManager.RotateTokenSigningKeys(time.Now().Add(DefaultTokenSigningRotationPeriod), nil)
+	require.NoError(t, err)
+	assert.Len(t, rotated, 2)
+}
@@ -0,0 +1,350 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+	"gopkg.in/go-playground/webhooks.v5/bitbucket"
+	"gopkg.in/go-playground/webhooks.v5/github"
+	"gopkg.in/go-playground/webhooks.v5/gitlab"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/scm"
+)
+
+const (
+	// pullRequestPreviewTimeout is the maximum duration of the preview of a pull request
+	pullRequestPreviewTimeout = 10 * time.Minute
+	// pullRequestPreviewContext is the context of the commit statuses of the previews
+	pullRequestPreviewContext = "argocd/preview"
+	// pullRequestPreviewMarker identifies the comments of the previews, which are updated on every change of the pull
+	// requests rather than repeated
+	pullRequestPreviewMarker = "<!-- argocd-preview -->"
+	// maxPullRequestCommentLength is the maximum length of the comments of the previews, below the limits of the providers
+	maxPullRequestCommentLength = 60000
+)
+
+// PullRequestPreviewer computes the differences between the manifests of an application at two revisions
+type PullRequestPreviewer interface {
+	PreviewRevisions(ctx context.Context, app *v1alpha1.Application, baseRevision string, headRevision string) (*application.ApplicationRevisionsDiffResponse, error)
+}
+
+// pullRequestInfo is the information of a pull request event required to preview its changes
+type pullRequestInfo struct {
+	provider scm.Provider
+	webURL   string
+	number   int
+	// baseBranch is the branch the pull request is merged into
+	baseBranch string
+	// headSHA is the last commit of the pull request
+	headSHA string
+	// baseIsHead is whether the base branch is the default branch of the repository
+	baseIsHead bool
+	// fork is whether the pull request is opened from another repository than the one it is merged into
+	fork bool
+}
+
+// SetPullRequestPreviewer makes the handler preview the changes of the pull requests opened or updated against the
+// target revision of applications: the differences between the manifests of the applications at the target revision
+// and at the head of the pull request are computed with the given previewer, then posted as a comment and a commit
+// status of the pull request, using the credentials of the repository. At most the given number of pull requests are
+// previewed at once, the events received while all of them are in progress are dropped. The pull requests opened from
+// forks are only previewed if previewForks is set, since their head can be pushed by anyone.
+func (a *ArgoCDWebhookHandler) SetPullRequestPreviewer(previewer PullRequestPreviewer, parallelism int64, previewForks bool) {
+	a.pullRequestPreviewer = previewer
+	a.pullRequestPreviewSemaphore = semaphore.NewWeighted(parallelism)
+	a.pullRequestPreviewForks = previewForks
+}
+
+// pullRequestEventInfo returns the information of the given pull request event, or nil if the event does not change
+// the commits of a pull request
+func pullRequestEventInfo(payloadIf interface{}) *pullRequestInfo {
+	switch payload := payloadIf.(type) {
+	case github.PullRequestPayload:
+		if payload.Action != "opened" && payload.Action != "synchronize" && payload.Action != "reopened" {
+			return nil
+		}
+		return &pullRequestInfo{
+			provider:   scm.ProviderGitHub,
+			webURL:     payload.Repository.HTMLURL,
+			number:     int(payload.Number),
+			baseBranch: payload.PullRequest.Base.Ref,
+			headSHA:    payload.PullRequest.Head.Sha,
+			baseIsHead: payload.PullRequest.Base.Ref == payload.Repository.DefaultBranch,
+			fork:       payload.PullRequest.Head.Repo.FullName != payload.PullRequest.Base.Repo.FullName,
+		}
+	case gitlab.MergeRequestEventPayload:
+		if payload.ObjectAttributes.Action != "open" && payload.ObjectAttributes.Action != "update" && payload.ObjectAttributes.Action != "reopen" {
+			return nil
+		}
+		return &pullRequestInfo{
+			provider:   scm.ProviderGitLab,
+			webURL:     payload.Project.WebURL,
+			number:     int(payload.ObjectAttributes.IID),
+			baseBranch: payload.ObjectAttributes.TargetBranch,
+			headSHA:    payload.ObjectAttributes.LastCommit.ID,
+			baseIsHead: payload.ObjectAttributes.TargetBranch == payload.Project.DefaultBranch,
+			fork:       payload.ObjectAttributes.SourceProjectID != payload.ObjectAttributes.TargetProjectID,
+		}
+	case bitbucket.PullRequestCreatedPayload:
+		return bitbucketPullRequestInfo(payload.PullRequest, payload.Repository)
+	case bitbucket.PullRequestUpdatedPayload:
+		return bitbucketPullRequestInfo(payload.PullRequest, payload.Repository)
+	}
+	return nil
+}
+
+func bitbucketPullRequestInfo(pullRequest bitbucket.PullRequest, repository bitbucket.Repository) *pullRequestInfo {
+	return &pullRequestInfo{
+		provider:   scm.ProviderBitbucket,
+		webURL:     repository.Links.HTML.Href,
+		number:     int(pullRequest.ID),
+		baseBranch: pullRequest.Destination.Branch.Name,
+		headSHA:    pullRequest.Source.Commit.Hash,
+		// The payload does not tell the default branch of the repository, so the applications targeting HEAD are
+		// previewed too, like the controller is left to check the pushes to HEAD.
+		baseIsHead: true,
+		fork:       pullRequest.Source.Repository.FullName != pullRequest.Destination.Repository.FullName,
+	}
+}
+
+// HandlePullRequestEvent previews the changes of a pull request event on the affected applications, if the handler
+// previews pull requests
+func (a *ArgoCDWebhookHandler) HandlePullRequestEvent(payload interface{}) {
+	info := pullRequestEventInfo(payload)
+	if info == nil || info.webURL == "" || info.headSHA == "" {
+		log.Info("Ignoring pull request webhook event")
+		return
+	}
+	if a.pullRequestPreviewer == nil {
+		log.Debugf("Ignoring pull request %d of %s, since pull request previews are disabled", info.number, info.webURL)
+		return
+	}
+	if info.fork && !a.pullRequestPreviewForks {
+		log.Infof("Ignoring pull request %d of %s, since it is opened from a fork", info.number, info.webURL)
+		return
+	}
+	log.Infof("Received pull request event repo: %s, pull request: %d, base: %s, head: %s", info.webURL, info.number, info.baseBranch, info.headSHA)
+
+	repoRegexp, err := getWebUrlRegex(info.webURL)
+	if err != nil {
+		log.Warnf("Failed to get repoRegexp: %s", err)
+		return
+	}
+	apps, err := a.appClientset.ArgoprojV1alpha1().Applications(a.ns).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Failed to list applications: %v", err)
+		return
+	}
+	var affected []*v1alpha1.Application
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		// the differences are only computed for the applications with a single source
+		if app.Spec.HasMultipleSources() {
+			continue
+		}
+		source := app.Spec.GetSource()
+		if sourceRevisionHasChanged(source, info.baseBranch, info.baseIsHead) && sourceUsesURL(source, info.webURL, repoRegexp) {
+			affected = append(affected, app)
+		}
+	}
+	if len(affected) == 0 {
+		log.Infof("No application is affected by pull request %d of %s", info.number, info.webURL)
+		return
+	}
+	if !a.pullRequestPreviewSemaphore.TryAcquire(1) {
+		log.Warnf("Dropped pull request %d of %s, since too many pull requests are being previewed", info.number, info.webURL)
+		return
+	}
+	go a.previewPullRequest(info, affected)
+}
+
+// previewPullRequest computes the differences between the manifests of the given applications at their target
+// revision and at the head of the pull request, and posts them back to the pull request. The caller must have acquired
+// the preview semaphore, which is released once done.
+func (a *ArgoCDWebhookHandler) previewPullRequest(info *pullRequestInfo, apps []*v1alpha1.Application) {
+	defer a.pullRequestPreviewSemaphore.Release(1)
+	logCtx := log.WithFields(log.Fields{"repo": info.webURL, "pullRequest": info.number})
+	ctx, cancel := context.WithTimeout(context.Background(), pullRequestPreviewTimeout)
+	defer cancel()
+
+	repo, err := a.db.GetRepository(ctx, apps[0].Spec.GetSource().RepoURL)
+	if err != nil {
+		logCtx.Warnf("Failed to get repository: %v", err)
+		return
+	}
+	client, err := a.newSCMClient(ctx, info.provider, repo)
+	if err != nil {
+		logCtx.Warnf("Failed to create %s client: %v", info.provider, err)
+		return
+	}
+	if err := client.SetCommitStatus(ctx, info.headSHA, scm.CommitStatus{
+		State:       scm.CommitStatePending,
+		Context:     pullRequestPreviewContext,
+		Description: fmt.Sprintf("Computing the changes of %d application(s)", len(apps)),
+	}); err != nil {
+		logCtx.Warnf("Failed to set commit status: %v", err)
+	}
+
+	previews := make([]appPreview, len(apps))
+	failed, changed := 0, 0
+	for i, app := range apps {
+		previews[i].app = app
+		previews[i].diff, previews[i].err = a.pullRequestPreviewer.PreviewRevisions(ctx, app, app.Spec.GetSource().TargetRevision, info.headSHA)
+		if previews[i].err != nil {
+			logCtx.WithField("application", app.Name).Warnf("Failed to preview the changes: %v", previews[i].err)
+			failed++
+		} else if previews[i].changes() > 0 {
+			changed++
+		}
+	}
+
+	status := scm.CommitStatus{
+		State:       scm.CommitStateSuccess,
+		Context:     pullRequestPreviewContext,
+		Description: fmt.Sprintf("%d of %d application(s) changed", changed, len(apps)),
+	}
+	if failed > 0 {
+		status.State = scm.CommitStateError
+		status.Description = fmt.Sprintf("Failed to compute the changes of %d of %d application(s)", failed, len(apps))
+	}
+	// the URL is read on every preview, so that the changes of the settings apply without a restart
+	argoCDURL := ""
+	if argoCDSettings, err := a.settingsSrc.GetSettings(); err != nil {
+		logCtx.Warnf("Failed to get settings, the preview is not linked to the applications: %v", err)
+	} else {
+		argoCDURL = argoCDSettings.URL
+	}
+	if len(apps) == 1 {
+		status.TargetURL = appURL(argoCDURL, apps[0])
+	}
+	if err := client.UpsertPullRequestComment(ctx, info.number, pullRequestPreviewMarker, renderPreviewComment(argoCDURL, info.headSHA, previews)); err != nil {
+		logCtx.Warnf("Failed to comment the pull request: %v", err)
+	}
+	if err := client.SetCommitStatus(ctx, info.headSHA, status); err != nil {
+		logCtx.Warnf("Failed to set commit status: %v", err)
+	}
+	logCtx.Infof("Previewed the changes of %d application(s)", len(apps))
+}
+
+// appPreview is the preview of the changes of an application
+type appPreview struct {
+	app  *v1alpha1.Application
+	diff *application.ApplicationRevisionsDiffResponse
+	err  error
+}
+
+// changes returns the number of changed resources
+func (p appPreview) changes() int {
+	changes := 0
+	for _, item := range p.diff.Items {
+		if item.GetModified() {
+			changes++
+		}
+	}
+	return changes
+}
+
+// appURL returns the URL of the given application in the UI of Argo CD at the given URL, or an empty string if the
+// URL of Argo CD is unknown
+func appURL(argoCDURL string, app *v1alpha1.Application) string {
+	if argoCDURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/applications/%s/%s", strings.TrimSuffix(argoCDURL, "/"), app.Namespace, app.Name)
+}
+
+// renderPreviewComment renders the comment of the previews of the applications affected by a pull request in
+// Markdown: a summary of the changed resources of every application, followed by the unified diff of their manifests.
+// The applications are linked to the UI of Argo CD at the given URL, if any.
+func renderPreviewComment(argoCDURL string, headSHA string, previews []appPreview) string {
+	// the diffs share the length of the comment, so that the comment stays below the limits of the providers
+	maxDiffLength := (maxPullRequestCommentLength - 1000*len(previews)) / len(previews)
+	var comment strings.Builder
+	comment.WriteString(pullRequestPreviewMarker + "\n")
+	comment.WriteString(fmt.Sprintf("## Argo CD preview of %s\n", headSHA))
+	for _, preview := range previews {
+		name := preview.app.Name
+		if url := appURL(argoCDURL, preview.app); url != "" {
+			name = fmt.Sprintf("[%s](%s)", name, url)
+		}
+		comment.WriteString(fmt.Sprintf("\n### %s\n\n", name))
+		if preview.err != nil {
+			comment.WriteString(fmt.Sprintf("Failed to compute the changes: `%s`\n", strings.ReplaceAll(preview.err.Error(), "`", "'")))
+			continue
+		}
+		added, removed, modified := 0, 0, 0
+		var diffs strings.Builder
+		for _, item := range preview.diff.Items {
+			if !item.GetModified() {
+				continue
+			}
+			switch {
+			case item.GetBaseState() == "":
+				added++
+			case item.GetHeadState() == "":
+				removed++
+			default:
+				modified++
+			}
+			diffs.WriteString(resourceDiff(item))
+		}
+		if added+removed+modified == 0 {
+			comment.WriteString("No changes\n")
+			continue
+		}
+		comment.WriteString(fmt.Sprintf("%d added, %d removed, %d modified resource(s)\n\n", added, removed, modified))
+		comment.WriteString("<details><summary>Diff</summary>\n\n```diff\n")
+		comment.WriteString(truncateDiff(diffs.String(), maxDiffLength))
+		comment.WriteString("```\n\n</details>\n")
+	}
+	return comment.String()
+}
+
+// truncateDiff truncates the given diff to the last line fitting the given length
+func truncateDiff(diff string, length int) string {
+	if len(diff) <= length {
+		return diff
+	}
+	diff = diff[:length]
+	if i := strings.LastIndex(diff, "\n"); i >= 0 {
+		diff = diff[:i+1]
+	}
+	return diff + "# the diff is truncated\n"
+}
+
+// resourceDiff returns the unified diff of the YAML manifests of a resource at the base and head revisions
+func resourceDiff(item *application.ResourceRevisionsDiff) string {
+	key := strings.Trim(strings.Join([]string{item.GetGroup(), item.GetKind(), item.GetNamespace(), item.GetName()}, "/"), "/")
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(manifestYAML(item.GetBaseState())),
+		B:        difflib.SplitLines(manifestYAML(item.GetHeadState())),
+		FromFile: "base/" + key,
+		ToFile:   "head/" + key,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("# failed to compute the diff of %s: %v\n", key, err)
+	}
+	return diff
+}
+
+// manifestYAML converts a JSON manifest to YAML, which is easier to review
+func manifestYAML(manifest string) string {
+	if manifest == "" {
+		return ""
+	}
+	data, err := yaml.JSONToYAML([]byte(manifest))
+	if err != nil {
+		return manifest
+	}
+	return string(data)
+}
@@ -7,12 +7,13 @@ import (
 	"html"
 	"net/http"
 	"net/url"
-	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	gogsclient "github.com/gogits/go-gogs-client"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
 	"gopkg.in/go-playground/webhooks.v5/bitbucket"
 	bitbucketserver "gopkg.in/go-playground/webhooks.v5/bitbucket-server"
 	"gopkg.in/go-playground/webhooks.v5/github"
@@ -25,19 +26,31 @@ import (
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/cache"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/util/app/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
-	"github.com/argoproj/argo-cd/v2/util/security"
+	"github.com/argoproj/argo-cd/v2/util/scm"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 type settingsSource interface {
 	GetAppInstanceLabelKey() (string, error)
 	GetTrackingMethod() (string, error)
+	GetSourcePathChangeDetectionEnabled() (bool, error)
+	GetSettings() (*settings.ArgoCDSettings, error)
 }
 
 var _ settingsSource = &settings.SettingsManager{}
 
+// manifestWarmingTimeout is the maximum duration of the warming of the manifests of an application, including the
+// time spent waiting for the other warmings to complete
+const manifestWarmingTimeout = 5 * time.Minute
+
+// ManifestWarmer generates the manifests of applications ahead of their comparison by the application controller
+type ManifestWarmer interface {
+	WarmManifests(ctx context.Context, app *v1alpha1.Application) error
+}
+
 type ArgoCDWebhookHandler struct {
 	repoCache       *cache.Cache
 	serverCache     *servercache.Cache
@@ -50,6 +63,18 @@ type ArgoCDWebhookHandler struct {
 	bitbucketserver *bitbucketserver.Webhook
 	gogs            *gogs.Webhook
 	settingsSrc     settingsSource
+	// manifestWarmer generates the manifests of the refreshed applications before they are refreshed, if set
+	manifestWarmer ManifestWarmer
+	// manifestWarmingSemaphore limits the number of concurrent warmings
+	manifestWarmingSemaphore *semaphore.Weighted
+	// pullRequestPreviewer computes the changes of the pull requests, which are previewed only if set
+	pullRequestPreviewer PullRequestPreviewer
+	// pullRequestPreviewSemaphore limits the number of concurrent previews
+	pullRequestPreviewSemaphore *semaphore.Weighted
+	// pullRequestPreviewForks is whether the pull requests opened from forks are previewed
+	pullRequestPreviewForks bool
+	// newSCMClient creates the clients posting the previews to the SCM providers
+	newSCMClient func(ctx context.Context, provider scm.Provider, repo *v1alpha1.Repository) (scm.Client, error)
 }
 
 func NewHandler(namespace string, appClientset appclientset.Interface, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB) *ArgoCDWebhookHandler {
@@ -86,11 +111,20 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 		repoCache:       repoCache,
 		serverCache:     serverCache,
 		db:              argoDB,
+		newSCMClient:    scm.NewClient,
 	}
 
 	return &acdWebhook
 }
 
+// SetManifestWarmer makes the handler generate the manifests of the applications affected by a push with the given
+// warmer before refreshing them, so that their comparison hits the manifest cache of the repo server. At most the
+// given number of applications are warmed at once.
+func (a *ArgoCDWebhookHandler) SetManifestWarmer(warmer ManifestWarmer, parallelism int64) {
+	a.manifestWarmer = warmer
+	a.manifestWarmingSemaphore = semaphore.NewWeighted(parallelism)
+}
+
 func parseRevision(ref string) string {
 	refParts := strings.SplitN(ref, "/", 3)
 	return refParts[len(refParts)-1]
@@ -229,6 +263,11 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		log.Warnf("Failed to get appInstanceLabelKey: %v", err)
 		return
 	}
+	sourcePathChangeDetection, err := a.settingsSrc.GetSourcePathChangeDetectionEnabled()
+	if err != nil {
+		log.Warnf("Failed to get sourcePathChangeDetection: %v", err)
+		return
+	}
 
 	for _, webURL := range webURLs {
 		repoRegexp, err := getWebUrlRegex(webURL)
@@ -239,7 +278,12 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		for _, app := range apps.Items {
 			for _, source := range app.Spec.GetSources() {
 				if sourceRevisionHasChanged(source, revision, touchedHead) && sourceUsesURL(source, webURL, repoRegexp) {
-					if appFilesHaveChanged(&app, changedFiles) {
+					if appFilesHaveChanged(&app, changedFiles, sourcePathChangeDetection) {
+						if a.manifestWarmer != nil {
+							go a.warmManifestsAndRefresh(app.DeepCopy())
+							// No need to refresh multiple times if multiple sources match.
+							break
+						}
 						_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
 						if err != nil {
 							log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
@@ -248,7 +292,7 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 						// No need to refresh multiple times if multiple sources match.
 						break
 					} else if change.shaBefore != "" && change.shaAfter != "" {
-						if err := a.storePreviouslyCachedManifests(&app, change, trackingMethod, appInstanceLabelKey); err != nil {
+						if err := a.storePreviouslyCachedManifests(&app, change, trackingMethod, appInstanceLabelKey, path.GetAppRefreshPaths(&app, sourcePathChangeDetection)); err != nil {
 							log.Warnf("Failed to store cached manifests of previous revision for app '%s': %v", app.Name, err)
 						}
 					}
@@ -258,6 +302,30 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 	}
 }
 
+// warmManifestsAndRefresh generates the manifests of the given application, then refreshes it whether the generation
+// succeeded or not
+func (a *ArgoCDWebhookHandler) warmManifestsAndRefresh(app *v1alpha1.Application) {
+	logCtx := log.WithField("application", app.Name)
+	ctx, cancel := context.WithTimeout(context.Background(), manifestWarmingTimeout)
+	defer cancel()
+	if err := a.manifestWarmingSemaphore.Acquire(ctx, 1); err != nil {
+		logCtx.Warnf("Skipped warming the manifests: %v", err)
+	} else {
+		start := time.Now()
+		err := a.manifestWarmer.WarmManifests(ctx, app)
+		a.manifestWarmingSemaphore.Release(1)
+		if err != nil {
+			logCtx.Warnf("Failed to warm the manifests: %v", err)
+		} else {
+			logCtx.Infof("Warmed the manifests in %v", time.Since(start))
+		}
+	}
+	appIf := a.appClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	if _, err := argo.RefreshApp(appIf, app.Name, v1alpha1.RefreshTypeNormal); err != nil {
+		logCtx.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.Name, err)
+	}
+}
+
 // getWebUrlRegex compiles a regex that will match any targetRevision referring to the same repo as the given webURL.
 // webURL is expected to be a URL from an SCM webhook payload pointing to the web page for the repo.
 func getWebUrlRegex(webURL string) (*regexp.Regexp, error) {
@@ -277,7 +345,7 @@ func getWebUrlRegex(webURL string) (*regexp.Regexp, error) {
 	return repoRegexp, nil
 }
 
-func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Application, change changeInfo, trackingMethod string, appInstanceLabelKey string) error {
+func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Application, change changeInfo, trackingMethod string, appInstanceLabelKey string, refreshPaths []string) error {
 	err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, a.db)
 	if err != nil {
 		return fmt.Errorf("error validating destination: %w", err)
@@ -294,83 +362,28 @@ func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Appl
 		return fmt.Errorf("error getting ref sources: %w", err)
 	}
 	source := app.Spec.GetSource()
-	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "webhook app revision changed", change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil)
+	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "webhook app revision changed", change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil, refreshPaths)
 
 	var cachedManifests cache.CachedManifestResponse
-	if err := a.repoCache.GetManifests(change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, &cachedManifests, nil); err != nil {
+	if err := a.repoCache.GetManifests(change.shaBefore, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, &cachedManifests, nil, refreshPaths); err != nil {
 		return err
 	}
 
-	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "webhook app revision changed", change.shaAfter, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil)
+	cache.LogDebugManifestCacheKeyFields("setting manifests cache", "webhook app revision changed", change.shaAfter, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, nil, refreshPaths)
 
-	if err = a.repoCache.SetManifests(change.shaAfter, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, &cachedManifests, nil); err != nil {
+	if err = a.repoCache.SetManifests(change.shaAfter, &source, refSources, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, &cachedManifests, nil, refreshPaths); err != nil {
 		return err
 	}
 	return nil
 }
 
-func getAppRefreshPaths(app *v1alpha1.Application) []string {
-	var paths []string
-	if val, ok := app.Annotations[v1alpha1.AnnotationKeyManifestGeneratePaths]; ok && val != "" {
-		for _, item := range strings.Split(val, ";") {
-			if item == "" {
-				continue
-			}
-			if filepath.IsAbs(item) {
-				paths = append(paths, item[1:])
-			} else {
-				for _, source := range app.Spec.GetSources() {
-					paths = append(paths, filepath.Clean(filepath.Join(source.Path, item)))
-				}
-			}
-		}
-	}
-	return paths
-}
-
-func appFilesHaveChanged(app *v1alpha1.Application, changedFiles []string) bool {
-	// an empty slice of changed files means that the payload didn't include a list
-	// of changed files and w have to assume that a refresh is required
-	if len(changedFiles) == 0 {
-		return true
-	}
-
-	// Check to see if the app has requested refreshes only on a specific prefix
-	refreshPaths := getAppRefreshPaths(app)
-
-	if len(refreshPaths) == 0 {
-		// Apps without a given refreshed paths always be refreshed, regardless of changed files
-		// this is the "default" behavior
-		return true
-	}
-
-	// At last one changed file must be under refresh path
-	for _, f := range changedFiles {
-		f = ensureAbsPath(f)
-		for _, item := range refreshPaths {
-			item = ensureAbsPath(item)
-			changed := false
-			if f == item {
-				changed = true
-			} else if _, err := security.EnforceToCurrentRoot(item, f); err == nil {
-				changed = true
-			}
-			if changed {
-				log.WithField("application", app.Name).Debugf("Application uses files that have changed")
-				return true
-			}
-		}
-	}
-
-	log.WithField("application", app.Name).Debugf("Application does not use any of the files that have changed")
-	return false
-}
-
-func ensureAbsPath(input string) string {
-	if !filepath.IsAbs(input) {
-		return string(filepath.Separator) + input
+func appFilesHaveChanged(app *v1alpha1.Application, changedFiles []string, sourcePathChangeDetection bool) bool {
+	if !path.AppFilesHaveChanged(path.GetAppRefreshPaths(app, sourcePathChangeDetection), changedFiles) {
+		log.WithField("application", app.Name).Debugf("Application does not use any of the files that have changed")
+		return false
 	}
-	return input
+	log.WithField("application", app.Name).Debugf("Application uses files that have changed")
+	return true
 }
 
 func sourceRevisionHasChanged(source v1alpha1.ApplicationSource, revision string, touchedHead bool) bool {
@@ -411,17 +424,17 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("Gogs webhook HMAC verification failed")
 		}
 	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = a.github.Parse(r, github.PushEvent, github.PingEvent)
+		payload, err = a.github.Parse(r, github.PushEvent, github.PingEvent, github.PullRequestEvent)
 		if errors.Is(err, github.ErrHMACVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitHub webhook HMAC verification failed")
 		}
 	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents)
+		payload, err = a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents, gitlab.MergeRequestEvents)
 		if errors.Is(err, gitlab.ErrGitLabTokenVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("GitLab webhook token verification failed")
 		}
 	case r.Header.Get("X-Hook-UUID") != "":
-		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
+		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent, bitbucket.PullRequestCreatedEvent, bitbucket.PullRequestUpdatedEvent)
 		if errors.Is(err, bitbucket.ErrUUIDVerificationFailed) {
 			log.WithField(common.SecurityField, common.SecurityHigh).Infof("BitBucket webhook UUID verification failed")
 		}
@@ -446,5 +459,10 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	a.HandleEvent(payload)
+	switch payload.(type) {
+	case github.PullRequestPayload, gitlab.MergeRequestEventPayload, bitbucket.PullRequestCreatedPayload, bitbucket.PullRequestUpdatedPayload:
+		a.HandlePullRequestEvent(payload)
+	default:
+		a.HandleEvent(payload)
+	}
 }
@@ -2,12 +2,14 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"gopkg.in/go-playground/webhooks.v5/gitlab"
 	"k8s.io/apimachinery/pkg/runtime"
 	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-cd/v2/util/cache/appstate"
 
@@ -27,16 +30,21 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v2/reposerver/cache"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/scm"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 type fakeSettingsSrc struct {
+	url string
 }
 
 func (f fakeSettingsSrc) GetAppInstanceLabelKey() (string, error) {
@@ -47,6 +55,14 @@ func (f fakeSettingsSrc) GetTrackingMethod() (string, error) {
 	return "", nil
 }
 
+func (f fakeSettingsSrc) GetSourcePathChangeDetectionEnabled() (bool, error) {
+	return false, nil
+}
+
+func (f fakeSettingsSrc) GetSettings() (*settings.ArgoCDSettings, error) {
+	return &settings.ArgoCDSettings{URL: f.url}, nil
+}
+
 type reactorDef struct {
 	verb     string
 	resource string
@@ -141,6 +157,79 @@ func TestGitHubCommitEvent_MultiSource_Refresh(t *testing.T) {
 	hook.Reset()
 }
 
+type fakeManifestWarmer struct {
+	mu     sync.Mutex
+	warmed []string
+}
+
+func (f *fakeManifestWarmer) WarmManifests(_ context.Context, app *v1alpha1.Application) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.warmed = append(f.warmed, app.Name)
+	return nil
+}
+
+func (f *fakeManifestWarmer) getWarmed() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.warmed...)
+}
+
+// TestGitHubCommitEvent_WarmManifests makes sure that a webhook generates the manifests of the affected apps before
+// refreshing them when a manifest warmer is set.
+func TestGitHubCommitEvent_WarmManifests(t *testing.T) {
+	var mu sync.Mutex
+	var patched []string
+	reaction := func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		patched = append(patched, action.(kubetesting.PatchAction).GetName())
+		return true, nil, nil
+	}
+	h := NewMockHandler(&reactorDef{"patch", "applications", reaction}, &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app-to-refresh",
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Sources: v1alpha1.ApplicationSources{
+				{
+					RepoURL: "https://github.com/jessesuen/test-repo",
+					Path:    ".",
+				},
+			},
+		},
+	}, &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app-to-ignore",
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Sources: v1alpha1.ApplicationSources{
+				{
+					RepoURL: "https://github.com/some/unrelated-repo",
+					Path:    ".",
+				},
+			},
+		},
+	})
+	warmer := &fakeManifestWarmer{}
+	h.SetManifestWarmer(warmer, 1)
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := os.ReadFile("testdata/github-commit-event.json")
+	assert.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(patched) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"app-to-refresh"}, warmer.getWarmed())
+	assert.Equal(t, []string{"app-to-refresh"}, patched)
+}
+
 func TestGitHubTagEvent(t *testing.T) {
 	hook := test.NewGlobal()
 	h := NewMockHandler(nil)
@@ -309,6 +398,12 @@ func getMultiSourceApp(annotation string, paths ...string) *v1alpha1.Application
 	}
 }
 
+func getAppWithWatchPaths(watchPaths string, sourcePath string) *v1alpha1.Application {
+	app := getApp("", sourcePath)
+	app.Annotations = map[string]string{v1alpha1.AnnotationKeyManifestWatchPaths: watchPaths}
+	return app
+}
+
 func Test_getAppRefreshPrefix(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -346,12 +441,15 @@ func Test_getAppRefreshPrefix(t *testing.T) {
 		{"file two relative paths, multi source - matching", getMultiSourceApp("./README.md;../shared/my-deployment.yaml", "my-app", "other-path"), []string{"shared/my-deployment.yaml"}, true},
 		{"file two relative paths - not matching", getApp(".README.md;../shared/my-deployment.yaml", "my-app"), []string{"kustomization.yaml"}, false},
 		{"file two relative paths, multi source - not matching", getMultiSourceApp(".README.md;../shared/my-deployment.yaml", "my-app", "other-path"), []string{"kustomization.yaml"}, false},
+		{"watch paths - matching", getAppWithWatchPaths("/libs/**", "my-app"), []string{"libs/common/templates/deployment.yaml"}, true},
+		{"watch paths, source path - matching", getAppWithWatchPaths("/libs/**", "my-app"), []string{"my-app/kustomization.yaml"}, true},
+		{"watch paths - not matching", getAppWithWatchPaths("/libs/**", "my-app"), []string{"other-app/kustomization.yaml"}, false},
 	}
 	for _, tt := range tests {
 		ttc := tt
 		t.Run(ttc.name, func(t *testing.T) {
 			t.Parallel()
-			if got := appFilesHaveChanged(ttc.app, ttc.files); got != ttc.changeExpected {
+			if got := appFilesHaveChanged(ttc.app, ttc.files, false); got != ttc.changeExpected {
 				t.Errorf("getAppRefreshPrefix() = %v, want %v", got, ttc.changeExpected)
 			}
 		})
@@ -548,3 +646,178 @@ func Test_getWebUrlRegex(t *testing.T) {
 		})
 	}
 }
+
+type fakePullRequestPreviewer struct{}
+
+func (f *fakePullRequestPreviewer) PreviewRevisions(_ context.Context, app *v1alpha1.Application, baseRevision string, headRevision string) (*application.ApplicationRevisionsDiffResponse, error) {
+	if app.Name == "app-failing" {
+		return nil, fmt.Errorf("failed to generate manifests at %s", headRevision)
+	}
+	return &application.ApplicationRevisionsDiffResponse{
+		BaseRevision: pointer.String(baseRevision),
+		HeadRevision: pointer.String(headRevision),
+		Items: []*application.ResourceRevisionsDiff{{
+			Group:     pointer.String("apps"),
+			Kind:      pointer.String("Deployment"),
+			Namespace: pointer.String("default"),
+			Name:      pointer.String("guestbook-ui"),
+			BaseState: pointer.String(`{"spec":{"replicas":1}}`),
+			HeadState: pointer.String(`{"spec":{"replicas":3}}`),
+			Modified:  pointer.Bool(true),
+		}},
+	}, nil
+}
+
+type fakeSCMClient struct {
+	mu       sync.Mutex
+	statuses []scm.CommitStatus
+	comments []string
+}
+
+func (f *fakeSCMClient) SetCommitStatus(_ context.Context, _ string, status scm.CommitStatus) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statuses = append(f.statuses, status)
+	return nil
+}
+
+func (f *fakeSCMClient) UpsertPullRequestComment(_ context.Context, _ int, marker string, body string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.comments = append(f.comments, body)
+	return nil
+}
+
+func (f *fakeSCMClient) getStatuses() []scm.CommitStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]scm.CommitStatus{}, f.statuses...)
+}
+
+// TestGitHubPullRequestEvent_Preview makes sure that the changes of the apps tracking the base branch of a pull request
+// are posted back to the pull request when a previewer is set.
+func TestGitHubPullRequestEvent_Preview(t *testing.T) {
+	newApp := func(name string, repoURL string, targetRevision string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: repoURL, Path: ".", TargetRevision: targetRevision},
+			},
+		}
+	}
+	h := NewMockHandler(nil,
+		newApp("app-to-preview", "https://github.com/jessesuen/test-repo.git", "HEAD"),
+		newApp("app-failing", "git@github.com:jessesuen/test-repo.git", "master"),
+		newApp("app-tracking-other-branch", "https://github.com/jessesuen/test-repo", "release-1.0"),
+		newApp("app-of-other-repo", "https://github.com/some/unrelated-repo", "HEAD"),
+	)
+	h.settingsSrc = &fakeSettingsSrc{url: "https://argocd.example.com/"}
+	argoDB := &mocks.ArgoDB{}
+	argoDB.On("GetRepository", mock.Anything, mock.Anything).Return(&v1alpha1.Repository{Repo: "https://github.com/jessesuen/test-repo.git", Password: "token"}, nil)
+	h.db = argoDB
+	client := &fakeSCMClient{}
+	h.newSCMClient = func(_ context.Context, provider scm.Provider, _ *v1alpha1.Repository) (scm.Client, error) {
+		assert.Equal(t, scm.ProviderGitHub, provider)
+		return client, nil
+	}
+	h.SetPullRequestPreviewer(&fakePullRequestPreviewer{}, 1, false)
+
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	eventJSON, err := os.ReadFile("testdata/github-pull-request-event.json")
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	assert.Eventually(t, func() bool {
+		return len(client.getStatuses()) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+	statuses := client.getStatuses()
+	assert.Equal(t, scm.CommitStatePending, statuses[0].State)
+	assert.Equal(t, scm.CommitStatus{
+		State:       scm.CommitStateError,
+		Context:     "argocd/preview",
+		Description: "Failed to compute the changes of 1 of 2 application(s)",
+	}, statuses[1])
+
+	require.Len(t, client.comments, 1)
+	comment := client.comments[0]
+	assert.Contains(t, comment, pullRequestPreviewMarker)
+	assert.Contains(t, comment, "### [app-to-preview](https://argocd.example.com/applications/argocd/app-to-preview)")
+	assert.Contains(t, comment, "0 added, 0 removed, 1 modified resource(s)")
+	assert.Contains(t, comment, "--- base/apps/Deployment/default/guestbook-ui\n+++ head/apps/Deployment/default/guestbook-ui\n")
+	assert.Contains(t, comment, "-  replicas: 1\n+  replicas: 3\n")
+	assert.Contains(t, comment, "Failed to compute the changes: `failed to generate manifests at 63738bb582c8b540af7bcfc18f87c575c3ed66e0`")
+	assert.NotContains(t, comment, "app-tracking-other-branch")
+	assert.NotContains(t, comment, "app-of-other-repo")
+}
+
+// TestGitHubPullRequestEvent_PreviewDisabled makes sure that pull request events are ignored when no previewer is set.
+func TestGitHubPullRequestEvent_PreviewDisabled(t *testing.T) {
+	h := NewMockHandler(nil)
+	h.newSCMClient = func(_ context.Context, _ scm.Provider, _ *v1alpha1.Repository) (scm.Client, error) {
+		t.Fatal("no SCM client must be created")
+		return nil, nil
+	}
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	eventJSON, err := os.ReadFile("testdata/github-pull-request-event.json")
+	require.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestGitHubPullRequestEvent_PreviewSkipped makes sure that the pull requests opened from forks are not previewed
+// unless enabled, and that the events are dropped while all the previews are in progress.
+func TestGitHubPullRequestEvent_PreviewSkipped(t *testing.T) {
+	eventJSON, err := os.ReadFile("testdata/github-pull-request-event.json")
+	require.NoError(t, err)
+	newHandler := func(previewForks bool) *ArgoCDWebhookHandler {
+		h := NewMockHandler(nil, &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-to-preview", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: "https://github.com/jessesuen/test-repo.git", Path: ".", TargetRevision: "HEAD"},
+			},
+		})
+		h.newSCMClient = func(_ context.Context, _ scm.Provider, _ *v1alpha1.Repository) (scm.Client, error) {
+			t.Fatal("no SCM client must be created")
+			return nil, nil
+		}
+		h.SetPullRequestPreviewer(&fakePullRequestPreviewer{}, 1, previewForks)
+		return h
+	}
+
+	t.Run("Fork", func(t *testing.T) {
+		var payload github.PullRequestPayload
+		require.NoError(t, json.Unmarshal(eventJSON, &payload))
+		payload.PullRequest.Head.Repo.FullName = "someone/test-repo"
+		payload.PullRequest.Base.Repo.FullName = "jessesuen/test-repo"
+		info := pullRequestEventInfo(payload)
+		require.NotNil(t, info)
+		assert.True(t, info.fork)
+		h := newHandler(false)
+		h.HandlePullRequestEvent(payload)
+		// the semaphore is still available, since no preview was started
+		assert.True(t, h.pullRequestPreviewSemaphore.TryAcquire(1))
+	})
+
+	t.Run("Busy", func(t *testing.T) {
+		var payload github.PullRequestPayload
+		require.NoError(t, json.Unmarshal(eventJSON, &payload))
+		h := newHandler(false)
+		require.True(t, h.pullRequestPreviewSemaphore.TryAcquire(1))
+		h.HandlePullRequestEvent(payload)
+		h.pullRequestPreviewSemaphore.Release(1)
+		// the dropped event did not hold the semaphore
+		assert.True(t, h.pullRequestPreviewSemaphore.TryAcquire(1))
+	})
+}
+
+func Test_truncateDiff(t *testing.T) {
+	assert.Equal(t, "-a\n+b\n", truncateDiff("-a\n+b\n", 10))
+	assert.Equal(t, "-a\n# the diff is truncated\n", truncateDiff("-a\n+b\n", 4))
+}